@@ -3,16 +3,26 @@ package main
 import (
 	"context"
 	"log"
+	"os"
 
+	"Aicon-assignment/internal/infrastructure/config"
+	"Aicon-assignment/internal/infrastructure/logging"
 	"Aicon-assignment/internal/infrastructure/server"
+	"Aicon-assignment/internal/interfaces/masking"
 )
 
 func main() {
 	ctx := context.Background()
 
-	server := server.NewServer()
+	cfg, err := config.Load()
+	if err != nil {
+		log.Fatal(err)
+	}
+	masking.Configure(cfg.Masking.Fields)
+	logging.Setup(cfg.LogLevel)
 
-	if err := server.Run(ctx); err != nil {
-		log.Fatalf("Failed to start server: %v", err)
+	// go run ./cmd [serve|migrate|import-csv|export|recalculate-summaries|help]
+	if err := server.RunCLI(ctx, cfg, os.Args[1:], os.Stdout); err != nil {
+		log.Fatal(err)
 	}
 }
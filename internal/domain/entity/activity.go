@@ -0,0 +1,107 @@
+package entity
+
+import (
+	"bytes"
+	"encoding/json"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+)
+
+// アクティビティの件数の既定値と上限（さかのぼれるのは新しい方から MaxActivityOffset 件まで）
+const (
+	DefaultActivityLimit = 20
+	MaxActivityLimit     = 100
+	MaxActivityOffset    = 1000
+)
+
+// アクティビティの種類（アイテムのイベントと監査ログの操作）
+var ActivityTypes = []string{EventItemCreated, EventItemUpdated, EventItemDeleted, EventItemSold, AuditCategoryReassigned}
+
+func IsKnownActivityType(activityType string) bool {
+	return slices.Contains(ActivityTypes, activityType)
+}
+
+// アクティビティの取得条件
+type ActivityQuery struct {
+	Types  []string // 空の場合はすべての種類
+	Limit  int
+	Offset int
+}
+
+// 取得条件のバリデーション（誤りがあればFieldErrorsを返す）
+func (q ActivityQuery) Validate() error {
+	var errs FieldErrors
+	add := func(field string, value any, rule, message string) {
+		errs = append(errs, FieldError{Field: field, Value: value, Rule: rule, Message: message})
+	}
+
+	for _, activityType := range q.Types {
+		if !IsKnownActivityType(activityType) {
+			add("type", activityType, "oneof", "type must be one of "+strings.Join(ActivityTypes, ", "))
+		}
+	}
+	if q.Limit < 1 || q.Limit > MaxActivityLimit {
+		add("limit", q.Limit, "range", "limit must be between 1 and 100")
+	}
+	if q.Offset < 0 || q.Offset > MaxActivityOffset {
+		add("offset", q.Offset, "range", "offset must be between 0 and 1000")
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+
+	return nil
+}
+
+// コレクションで起きた変更1件
+type Activity struct {
+	Type     string `json:"type"`
+	ItemID   int64  `json:"item_id,omitempty"`
+	ItemName string `json:"item_name,omitempty"` // 変更した時点の名前
+	// item.updated で変わった項目（JSONのフィールド名）
+	Changes []string `json:"changes,omitempty"`
+	// 監査ログの操作の内容と結果
+	Detail    json.RawMessage `json:"detail,omitempty"`
+	CreatedAt time.Time       `json:"created_at"`
+}
+
+// 新しい順のアクティビティと、続きがあるか
+type ActivityPage struct {
+	Activities []*Activity `json:"activities"`
+	HasMore    bool        `json:"has_more"`
+}
+
+// 変更前後のアイテム（JSON）で値が変わった項目を名前の順に返す（更新のたびに変わる更新日時と版は除く）
+func ChangedItemFields(before, after string) ([]string, error) {
+	var beforeFields, afterFields map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(before), &beforeFields); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal([]byte(after), &afterFields); err != nil {
+		return nil, err
+	}
+
+	var changes []string
+	for field, value := range afterFields {
+		if field == "updated_at" || field == "version" {
+			continue
+		}
+		if !bytes.Equal(compactJSON(beforeFields[field]), compactJSON(value)) {
+			changes = append(changes, field)
+		}
+	}
+	sort.Strings(changes)
+	return changes, nil
+}
+
+// PostgreSQLのJSONBは空白を加えて返すため、詰めてから比べる
+func compactJSON(value json.RawMessage) []byte {
+	var compacted bytes.Buffer
+	if err := json.Compact(&compacted, value); err != nil {
+		return value
+	}
+	return compacted.Bytes()
+}
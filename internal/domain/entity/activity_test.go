@@ -0,0 +1,64 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestActivityQuery_Validate(t *testing.T) {
+	tests := []struct {
+		name       string
+		query      ActivityQuery
+		wantFields []string
+	}{
+		{name: "正常系: 種類の指定なし", query: ActivityQuery{Limit: 20}},
+		{name: "正常系: 種類を指定", query: ActivityQuery{Types: []string{EventItemCreated, AuditCategoryReassigned}, Limit: 100, Offset: 1000}},
+		{name: "異常系: 知らない種類", query: ActivityQuery{Types: []string{"item.viewed"}, Limit: 20}, wantFields: []string{"type"}},
+		{name: "異常系: 件数と開始位置が範囲外", query: ActivityQuery{Limit: 101, Offset: 1001}, wantFields: []string{"limit", "offset"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.query.Validate()
+
+			if tt.wantFields == nil {
+				assert.NoError(t, err)
+				return
+			}
+			var fieldErrors FieldErrors
+			require.ErrorAs(t, err, &fieldErrors)
+			var fields []string
+			for _, fieldError := range fieldErrors {
+				fields = append(fields, fieldError.Field)
+			}
+			assert.Equal(t, tt.wantFields, fields)
+		})
+	}
+}
+
+func TestChangedItemFields(t *testing.T) {
+	t.Run("正常系: 更新日時と版を除いて変わった項目を返す", func(t *testing.T) {
+		changes, err := ChangedItemFields(
+			`{"id":1,"name":"ロレックス デイトナ","purchase_price":1500000,"attributes":{"size":"40mm"},"updated_at":"2026-10-01T09:00:00Z","version":1}`,
+			`{"id": 1, "name": "ロレックス デイトナ", "purchase_price": 1600000, "attributes": {"size": "41mm"}, "updated_at": "2026-10-02T09:00:00Z", "version": 2}`,
+		)
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"attributes", "purchase_price"}, changes)
+	})
+
+	t.Run("正常系: 変わった項目がない", func(t *testing.T) {
+		changes, err := ChangedItemFields(`{"id":1,"favorite":false}`, `{"id":1,"favorite":false}`)
+
+		require.NoError(t, err)
+		assert.Empty(t, changes)
+	})
+
+	t.Run("異常系: JSONではない", func(t *testing.T) {
+		_, err := ChangedItemFields(`{"id":1}`, `not json`)
+
+		assert.Error(t, err)
+	})
+}
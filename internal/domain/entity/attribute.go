@@ -0,0 +1,113 @@
+package entity
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// カテゴリーごとに定義できるカスタム属性
+type AttributeDefinition struct {
+	Key           string   `json:"key"`
+	AllowedValues []string `json:"allowed_values,omitempty"` // 空なら自由入力
+}
+
+// カテゴリー別の属性スキーマ（スキーマが無いカテゴリーは任意のキーを受け付ける）
+var CategoryAttributeSchemas = map[string][]AttributeDefinition{
+	"時計": {
+		{Key: "movement", AllowedValues: []string{"automatic", "manual", "quartz"}},
+		{Key: "case_size"},
+		{Key: "dial_color"},
+	},
+	"バッグ": {
+		{Key: "material"},
+		{Key: "color"},
+		{Key: "size"},
+	},
+}
+
+var attributeKeyPattern = regexp.MustCompile(`^[a-z0-9_]{1,50}$`)
+
+// 属性キーの形式チェック（英小文字・数字・アンダースコアのみ）
+func IsValidAttributeKey(key string) bool {
+	return attributeKeyPattern.MatchString(key)
+}
+
+// カテゴリーのスキーマに沿って属性をバリデーションする
+func validateAttributes(category string, attributes map[string]string) FieldErrors {
+	var errs FieldErrors
+
+	keys := make([]string, 0, len(attributes))
+	for key := range attributes {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	schema, hasSchema := CategoryAttributeSchemas[category]
+
+	for _, key := range keys {
+		value := attributes[key]
+		field := "attributes." + key
+
+		if !IsValidAttributeKey(key) {
+			errs = append(errs, FieldError{Field: field, Value: value, Rule: "key_format", Message: field + ": key must be 1-50 characters of a-z, 0-9 or _"})
+			continue
+		}
+		if len(value) > 255 {
+			errs = append(errs, FieldError{Field: field, Value: value, Rule: "max_length", Message: field + " must be 255 characters or less"})
+			continue
+		}
+
+		if !hasSchema {
+			continue
+		}
+
+		definition, ok := findAttributeDefinition(schema, key)
+		if !ok {
+			errs = append(errs, FieldError{Field: field, Value: value, Rule: "defined", Message: fmt.Sprintf("%s is not defined for category %s", field, category)})
+			continue
+		}
+		if len(definition.AllowedValues) > 0 && !containsString(definition.AllowedValues, value) {
+			errs = append(errs, FieldError{Field: field, Value: value, Rule: "one_of", Message: field + " must be one of: " + strings.Join(definition.AllowedValues, ", ")})
+		}
+	}
+
+	return errs
+}
+
+func findAttributeDefinition(schema []AttributeDefinition, key string) (AttributeDefinition, bool) {
+	for _, definition := range schema {
+		if definition.Key == key {
+			return definition, true
+		}
+	}
+	return AttributeDefinition{}, false
+}
+
+func containsString(values []string, target string) bool {
+	for _, v := range values {
+		if v == target {
+			return true
+		}
+	}
+	return false
+}
+
+// 属性の値の前後の空白を取り除いたコピーを返す
+func normalizeAttributes(attributes map[string]string) map[string]string {
+	if attributes == nil {
+		return nil
+	}
+
+	normalized := make(map[string]string, len(attributes))
+	for key, value := range attributes {
+		normalized[strings.TrimSpace(key)] = strings.TrimSpace(value)
+	}
+	return normalized
+}
+
+// カテゴリー別の属性スキーマの取得
+func GetCategoryAttributeSchemas() map[string][]AttributeDefinition {
+	return CategoryAttributeSchemas
+}
@@ -0,0 +1,29 @@
+package entity
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// 監査ログの操作の種別
+const (
+	AuditCategoryReassigned = "category.reassigned"
+)
+
+// 一括変更のように、アイテムごとのイベントの代わりに1件だけ残す変更の記録
+type AuditEntry struct {
+	ID        int64           `json:"id"`
+	Action    string          `json:"action"`
+	Detail    json.RawMessage `json:"detail"` // 操作の内容と結果（JSON）
+	CreatedAt time.Time       `json:"created_at"`
+}
+
+func NewCategoryReassignedAudit(reassignment CategoryReassignment) *AuditEntry {
+	// 文字列と数値だけの構造体なので失敗しない
+	detail, _ := json.Marshal(reassignment)
+	return &AuditEntry{
+		Action:    AuditCategoryReassigned,
+		Detail:    detail,
+		CreatedAt: time.Now(),
+	}
+}
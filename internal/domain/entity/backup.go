@@ -0,0 +1,206 @@
+package entity
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// 現在のバックアップの形式。形式を変えた場合は上げ、古い形式の読み込みを残す
+const BackupVersion = 1
+
+// アイテムと関連するテーブルのバックアップ（IDはそのまま保持する）
+// Webhookは署名用の鍵を含むため、配信ログやアウトボックスは運用中の状態のため含めない
+type Backup struct {
+	Version         int              `json:"version"`
+	CreatedAt       time.Time        `json:"created_at"`
+	Locations       []*Location      `json:"locations"`
+	Items           []*Item          `json:"items"`
+	Loans           []*Loan          `json:"loans"`
+	LocationHistory []*LocationMove  `json:"location_history"`
+	Templates       []*ItemTemplate  `json:"templates"`
+	Comments        []*ItemComment   `json:"comments"`   // 追加前のバックアップでは空
+	Relations       []*ItemRelation  `json:"relations"`  // 追加前のバックアップでは空
+	Valuations      []*ItemValuation `json:"valuations"` // 追加前のバックアップでは空
+	Sales           []*ItemSale      `json:"sales"`      // 追加前のバックアップでは空
+	Insurances      []*ItemInsurance `json:"insurances"` // 追加前のバックアップでは空
+}
+
+// 各行のバリデーションと、IDの重複・参照先の有無を確認する
+// 作成後に追加されたカテゴリー別ルールで復元できなくならないよう、ルールは評価しない
+func (b *Backup) Validate() error {
+	var errs []string
+	fail := func(format string, args ...interface{}) {
+		errs = append(errs, fmt.Sprintf(format, args...))
+	}
+
+	if b.Version != BackupVersion {
+		return fmt.Errorf("unsupported backup version %d (must be %d)", b.Version, BackupVersion)
+	}
+
+	locationIDs := map[int64]bool{}
+	for i, location := range b.Locations {
+		if location == nil || location.ID <= 0 || locationIDs[location.ID] {
+			fail("locations[%d]: id must be a unique positive number", i)
+			continue
+		}
+		locationIDs[location.ID] = true
+		if err := location.Validate(); err != nil {
+			fail("locations[%d]: %v", i, err)
+		}
+	}
+
+	itemIDs := map[int64]bool{}
+	serialNumbers := map[string]bool{}
+	for i, item := range b.Items {
+		if item == nil || item.ID <= 0 || itemIDs[item.ID] {
+			fail("items[%d]: id must be a unique positive number", i)
+			continue
+		}
+		itemIDs[item.ID] = true
+		if err := item.Validate(); err != nil {
+			fail("items[%d]: %v", i, err)
+		}
+		if item.SerialNumber != "" {
+			if serialNumbers[item.SerialNumber] {
+				fail("items[%d]: serial_number %q is duplicated", i, item.SerialNumber)
+			}
+			serialNumbers[item.SerialNumber] = true
+		}
+		if item.LocationID != nil && !locationIDs[*item.LocationID] {
+			fail("items[%d]: location %d is not in the backup", i, *item.LocationID)
+		}
+	}
+
+	loanIDs := map[int64]bool{}
+	activeLoans := map[int64]bool{}
+	for i, loan := range b.Loans {
+		if loan == nil || loan.ID <= 0 || loanIDs[loan.ID] {
+			fail("loans[%d]: id must be a unique positive number", i)
+			continue
+		}
+		loanIDs[loan.ID] = true
+		if err := loan.Validate(); err != nil {
+			fail("loans[%d]: %v", i, err)
+		}
+		if !itemIDs[loan.ItemID] {
+			fail("loans[%d]: item %d is not in the backup", i, loan.ItemID)
+		}
+		if !loan.IsReturned() {
+			if activeLoans[loan.ItemID] {
+				fail("loans[%d]: item %d has more than one active loan", i, loan.ItemID)
+			}
+			activeLoans[loan.ItemID] = true
+		}
+	}
+
+	// 移動元・移動先の保管場所は削除済みでもよい（DBでも外部キーを張っていない）
+	moveIDs := map[int64]bool{}
+	for i, move := range b.LocationHistory {
+		if move == nil || move.ID <= 0 || moveIDs[move.ID] {
+			fail("location_history[%d]: id must be a unique positive number", i)
+			continue
+		}
+		moveIDs[move.ID] = true
+		if !itemIDs[move.ItemID] {
+			fail("location_history[%d]: item %d is not in the backup", i, move.ItemID)
+		}
+	}
+
+	templateIDs := map[int64]bool{}
+	for i, template := range b.Templates {
+		if template == nil || template.ID <= 0 || templateIDs[template.ID] {
+			fail("templates[%d]: id must be a unique positive number", i)
+			continue
+		}
+		templateIDs[template.ID] = true
+		if err := template.Validate(); err != nil {
+			fail("templates[%d]: %v", i, err)
+		}
+	}
+
+	commentIDs := map[int64]bool{}
+	for i, comment := range b.Comments {
+		if comment == nil || comment.ID <= 0 || commentIDs[comment.ID] {
+			fail("comments[%d]: id must be a unique positive number", i)
+			continue
+		}
+		commentIDs[comment.ID] = true
+		if err := comment.Validate(); err != nil {
+			fail("comments[%d]: %v", i, err)
+		}
+		if !itemIDs[comment.ItemID] {
+			fail("comments[%d]: item %d is not in the backup", i, comment.ItemID)
+		}
+	}
+
+	relationIDs := map[int64]bool{}
+	for i, relation := range b.Relations {
+		if relation == nil || relation.ID <= 0 || relationIDs[relation.ID] {
+			fail("relations[%d]: id must be a unique positive number", i)
+			continue
+		}
+		relationIDs[relation.ID] = true
+		if err := relation.Validate(); err != nil {
+			fail("relations[%d]: %v", i, err)
+		}
+		if !itemIDs[relation.ItemID] {
+			fail("relations[%d]: item %d is not in the backup", i, relation.ItemID)
+		}
+		if !itemIDs[relation.RelatedItemID] {
+			fail("relations[%d]: item %d is not in the backup", i, relation.RelatedItemID)
+		}
+	}
+
+	valuationIDs := map[int64]bool{}
+	for i, valuation := range b.Valuations {
+		if valuation == nil || valuation.ID <= 0 || valuationIDs[valuation.ID] {
+			fail("valuations[%d]: id must be a unique positive number", i)
+			continue
+		}
+		valuationIDs[valuation.ID] = true
+		if valuation.PreviousValue < 0 || valuation.Value < 0 {
+			fail("valuations[%d]: previous_value and value must be 0 or greater", i)
+		}
+		if !itemIDs[valuation.ItemID] {
+			fail("valuations[%d]: item %d is not in the backup", i, valuation.ItemID)
+		}
+	}
+
+	saleIDs := map[int64]bool{}
+	for i, sale := range b.Sales {
+		if sale == nil || sale.ID <= 0 || saleIDs[sale.ID] {
+			fail("sales[%d]: id must be a unique positive number", i)
+			continue
+		}
+		saleIDs[sale.ID] = true
+		if err := sale.Validate(); err != nil {
+			fail("sales[%d]: %v", i, err)
+		}
+		if !itemIDs[sale.ItemID] {
+			fail("sales[%d]: item %d is not in the backup", i, sale.ItemID)
+		}
+	}
+
+	insuredItemIDs := map[int64]bool{}
+	for i, insurance := range b.Insurances {
+		if insurance == nil || !itemIDs[insurance.ItemID] {
+			fail("insurances[%d]: item must be in the backup", i)
+			continue
+		}
+		if insuredItemIDs[insurance.ItemID] {
+			fail("insurances[%d]: item %d has more than one insurance", i, insurance.ItemID)
+		}
+		insuredItemIDs[insurance.ItemID] = true
+		if err := insurance.Validate(); err != nil {
+			fail("insurances[%d]: %v", i, err)
+		}
+	}
+
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, "; "))
+	}
+
+	return nil
+}
@@ -0,0 +1,70 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBackup_Validate(t *testing.T) {
+	validItem := func(id int64, serialNumber string) *Item {
+		return &Item{ID: id, Name: "ロレックス デイトナ", Category: "時計", Brand: "ROLEX", PurchasePrice: 1500000, PurchaseDate: "2023-01-15", SerialNumber: serialNumber}
+	}
+
+	tests := []struct {
+		name   string
+		backup *Backup
+		want   []string
+	}{
+		{
+			name: "正常系: 空のバックアップ",
+			backup: &Backup{
+				Version: BackupVersion,
+			},
+		},
+		{
+			name: "正常系: 作成後に追加されたルールは評価しない",
+			backup: &Backup{
+				Version: BackupVersion,
+				Items:   []*Item{validItem(1, "")},
+			},
+		},
+		{
+			name:   "異常系: 知らない形式",
+			backup: &Backup{Version: 99},
+			want:   []string{"unsupported backup version 99"},
+		},
+		{
+			name: "異常系: IDとシリアル番号の重複、参照先のない貸出",
+			backup: &Backup{
+				Version: BackupVersion,
+				Items:   []*Item{validItem(1, "D123456"), validItem(1, "X"), validItem(2, "D123456")},
+				Loans: []*Loan{
+					{ID: 1, ItemID: 3, Borrower: "山田", DueDate: "2023-02-01"},
+					{ID: 2, ItemID: 1, Borrower: "山田", DueDate: "2023-02-01"},
+					{ID: 3, ItemID: 1, Borrower: "佐藤", DueDate: "2023-02-01"},
+				},
+			},
+			want: []string{
+				"items[1]: id must be a unique positive number",
+				`items[2]: serial_number "D123456" is duplicated`,
+				"loans[0]: item 3 is not in the backup",
+				"loans[2]: item 1 has more than one active loan",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.backup.Validate()
+
+			if len(tt.want) == 0 {
+				assert.NoError(t, err)
+				return
+			}
+			for _, want := range tt.want {
+				assert.ErrorContains(t, err, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,102 @@
+package entity
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// 既存のブランドとの照合の結果
+type BrandMatch struct {
+	Exact   string   // 大文字・小文字と空白だけが違う既存のブランド（なければ空文字）
+	Similar []string // 表記の揺れとみなす既存のブランド（編集距離の近い順）
+}
+
+// 入力されたブランドを既存のブランドと照合する
+func MatchBrand(brand string, existing []string) BrandMatch {
+	normalized := normalizeBrand(brand)
+	if normalized == "" {
+		return BrandMatch{}
+	}
+
+	type candidate struct {
+		brand    string
+		distance int
+	}
+	var candidates []candidate
+	for _, e := range existing {
+		other := normalizeBrand(e)
+		if other == normalized {
+			return BrandMatch{Exact: e}
+		}
+		a, b := []rune(normalized), []rune(other)
+		if distance := editDistance(a, b); distance <= brandDistanceLimit(a, b) {
+			candidates = append(candidates, candidate{brand: e, distance: distance})
+		}
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if candidates[i].distance != candidates[j].distance {
+			return candidates[i].distance < candidates[j].distance
+		}
+		return candidates[i].brand < candidates[j].brand
+	})
+	match := BrandMatch{}
+	for _, c := range candidates {
+		match.Similar = append(match.Similar, c.brand)
+	}
+	return match
+}
+
+// 比べるときの表記（大文字・小文字と空白の違いを無視する）
+func normalizeBrand(brand string) string {
+	return strings.Join(strings.Fields(strings.ToLower(brand)), " ")
+}
+
+// 表記の揺れとみなす編集距離の上限
+// 短いブランドは1文字違いでも別のブランドであることが多いため比べない
+func brandDistanceLimit(a, b []rune) int {
+	switch n := min(len(a), len(b)); {
+	case n < 4:
+		return 0
+	case n < 8:
+		return 1
+	default:
+		return 2
+	}
+}
+
+// 挿入・削除・置換を1回と数えるレーベンシュタイン距離
+func editDistance(a, b []rune) int {
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(b)]
+}
+
+// 既存のブランドに似ているため、クライアントに確認を求める
+type BrandSuggestion struct {
+	Brand       string   `json:"brand"`       // 入力されたブランド
+	Suggestions []string `json:"suggestions"` // 似ている既存のブランド（近い順）
+}
+
+func (s *BrandSuggestion) Error() string {
+	quoted := make([]string, 0, len(s.Suggestions))
+	for _, suggestion := range s.Suggestions {
+		quoted = append(quoted, fmt.Sprintf("%q", suggestion))
+	}
+	return fmt.Sprintf("brand %q is similar to existing %s", s.Brand, strings.Join(quoted, ", "))
+}
@@ -0,0 +1,54 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMatchBrand(t *testing.T) {
+	existing := []string{"ROLEX", "OMEGA", "HERMES", "LOUIS VUITTON", "LV"}
+
+	tests := []struct {
+		name  string
+		brand string
+		want  BrandMatch
+	}{
+		{
+			name:  "正常系: 大文字・小文字と空白だけが違う",
+			brand: " rolex ",
+			want:  BrandMatch{Exact: "ROLEX"},
+		},
+		{
+			name:  "正常系: 連続する空白をまとめて比べる",
+			brand: "Louis  Vuitton",
+			want:  BrandMatch{Exact: "LOUIS VUITTON"},
+		},
+		{
+			name:  "正常系: 1文字の違いは似ているブランド",
+			brand: "Rollex",
+			want:  BrandMatch{Similar: []string{"ROLEX"}},
+		},
+		{
+			name:  "正常系: 長いブランドは2文字まで違ってよい",
+			brand: "Louis Viton",
+			want:  BrandMatch{Similar: []string{"LOUIS VUITTON"}},
+		},
+		{
+			name:  "正常系: 短いブランドは比べない",
+			brand: "LW",
+			want:  BrandMatch{},
+		},
+		{
+			name:  "正常系: 似ていない新しいブランド",
+			brand: "CHANEL",
+			want:  BrandMatch{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, MatchBrand(tt.brand, existing))
+		})
+	}
+}
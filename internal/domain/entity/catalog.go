@@ -0,0 +1,43 @@
+package entity
+
+import "slices"
+
+// 公開カタログで見せられる項目（購入価格・購入日・シリアル番号・保管場所は公開しない）
+var CatalogFields = []string{ShareFieldName, ShareFieldCategory, ShareFieldBrand, ShareFieldCondition, ShareFieldAttributes}
+
+// 項目を指定しない場合に公開する項目
+var DefaultCatalogFields = []string{ShareFieldName, ShareFieldCategory, ShareFieldBrand, ShareFieldCondition}
+
+func IsCatalogField(field string) bool {
+	return slices.Contains(CatalogFields, field)
+}
+
+// 公開カタログで見せるアイテムの内容（選んだ項目だけを含める）
+type CatalogItem struct {
+	ID         int64             `json:"id"`
+	Name       string            `json:"name,omitempty"`
+	Category   string            `json:"category,omitempty"`
+	Brand      string            `json:"brand,omitempty"`
+	Condition  string            `json:"condition,omitempty"`
+	Attributes map[string]string `json:"attributes,omitempty"`
+}
+
+// fields のうち公開カタログで見せられる項目だけを写す
+func NewCatalogItem(item *Item, fields []string) *CatalogItem {
+	catalogItem := &CatalogItem{ID: item.ID}
+	for _, field := range fields {
+		switch field {
+		case ShareFieldName:
+			catalogItem.Name = item.Name
+		case ShareFieldCategory:
+			catalogItem.Category = item.Category
+		case ShareFieldBrand:
+			catalogItem.Brand = item.Brand
+		case ShareFieldCondition:
+			catalogItem.Condition = item.Condition
+		case ShareFieldAttributes:
+			catalogItem.Attributes = item.Attributes
+		}
+	}
+	return catalogItem
+}
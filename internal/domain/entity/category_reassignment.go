@@ -0,0 +1,83 @@
+package entity
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// カテゴリーの一括付け替えの内容と、付け替えたアイテムの数
+type CategoryReassignment struct {
+	From     string `json:"from"`
+	To       string `json:"to"`
+	Affected int    `json:"affected"`
+}
+
+func NewCategoryReassignment(from, to string) (*CategoryReassignment, error) {
+	reassignment := &CategoryReassignment{
+		From: strings.TrimSpace(from),
+		To:   strings.TrimSpace(to),
+	}
+
+	if err := reassignment.Validate(); err != nil {
+		return nil, err
+	}
+
+	return reassignment, nil
+}
+
+// 付け替え元は廃止したカテゴリーでも指定できるよう、定義済みのカテゴリーに限らない
+func (r *CategoryReassignment) Validate() error {
+	var errs FieldErrors
+	add := func(field string, value any, rule, message string) {
+		errs = append(errs, FieldError{Field: field, Value: value, Rule: rule, Message: message})
+	}
+
+	if r.From == "" {
+		add("from", r.From, "required", "from is required")
+	}
+
+	if r.To == "" {
+		add("to", r.To, "required", "to is required")
+	} else if !isValidCategory(r.To) {
+		add("to", r.To, "one_of", "to must be one of: "+strings.Join(ValidCategories, ", "))
+	} else if r.To == r.From {
+		add("to", r.To, "not_same", "to must be different from the current category")
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+
+	return nil
+}
+
+// 付け替えた後のアイテムが付け替え先のカテゴリーの属性スキーマとルールを満たすかを確認する
+// 満たさない項目は、どのアイテムのものか分かるよう items[<ID>].<フィールド> として返す
+func (r *CategoryReassignment) CheckItems(items []*Item) FieldErrors {
+	var errs FieldErrors
+	for _, item := range items {
+		moved := *item
+		moved.Category = r.To
+
+		for _, e := range validateAttributes(moved.Category, moved.Attributes) {
+			errs = append(errs, FieldError{
+				Field:   fmt.Sprintf("items[%d].%s", item.ID, e.Field),
+				Value:   e.Value,
+				Rule:    e.Rule,
+				Message: fmt.Sprintf("item %d: %s", item.ID, e.Message),
+			})
+		}
+		var violations RuleViolations
+		if errors.As(moved.EvaluateRules(), &violations) {
+			for _, v := range violations {
+				errs = append(errs, FieldError{
+					Field:   fmt.Sprintf("items[%d].%s", item.ID, v.Field),
+					Rule:    v.Rule,
+					Message: fmt.Sprintf("item %d: %s", item.ID, v.Message),
+				})
+			}
+		}
+	}
+	return errs
+}
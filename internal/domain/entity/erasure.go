@@ -0,0 +1,24 @@
+package entity
+
+import "time"
+
+// 削除依頼の状態
+const (
+	ErasurePending   = "pending"   // 猶予期間中（取り消せる）
+	ErasureCancelled = "cancelled" // 猶予期間中に取り消された
+	ErasureCompleted = "completed" // 削除した
+)
+
+// 全データの削除依頼（猶予期間が過ぎてから削除する）
+type Erasure struct {
+	ID          int64      `json:"id"`
+	Status      string     `json:"status"`
+	RequestedAt time.Time  `json:"requested_at"`
+	ScheduledAt time.Time  `json:"scheduled_at"`
+	FinishedAt  *time.Time `json:"finished_at"`
+}
+
+// 猶予期間が過ぎているか
+func (e *Erasure) IsDue(now time.Time) bool {
+	return e.Status == ErasurePending && !now.Before(e.ScheduledAt)
+}
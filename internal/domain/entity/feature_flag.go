@@ -0,0 +1,27 @@
+package entity
+
+import (
+	"slices"
+	"time"
+)
+
+// 再デプロイせずに切り替えられる機能
+const (
+	FeatureWebhooks           = "webhooks"            // Webhookの登録・送信
+	FeatureGraphQL            = "graphql"             // POST /graphql
+	FeatureDuplicateDetection = "duplicate_detection" // 重複候補の検出と統合
+)
+
+// 切り替えられる機能の一覧（設定も保存された値もない場合は有効）
+var Features = []string{FeatureWebhooks, FeatureGraphQL, FeatureDuplicateDetection}
+
+func IsKnownFeature(name string) bool {
+	return slices.Contains(Features, name)
+}
+
+// 管理者が保存した機能の有効・無効（設定ファイル・環境変数の値より優先する）
+type FeatureFlag struct {
+	Name      string    `json:"name"`
+	Enabled   bool      `json:"enabled"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
@@ -0,0 +1,26 @@
+package entity
+
+import "strings"
+
+// 入力値の誤り1件分
+type FieldError struct {
+	Field   string `json:"field"`
+	Value   any    `json:"value"` // 受け付けなかった値
+	Rule    string `json:"rule"`
+	Message string `json:"message"`
+}
+
+// 入力値の誤りの一覧（errorとして返せる）
+type FieldErrors []FieldError
+
+func (e FieldErrors) Error() string {
+	return strings.Join(e.Messages(), ", ")
+}
+
+func (e FieldErrors) Messages() []string {
+	messages := make([]string, 0, len(e))
+	for _, fieldError := range e {
+		messages = append(messages, fieldError.Message)
+	}
+	return messages
+}
@@ -0,0 +1,87 @@
+package entity
+
+import (
+	"sort"
+	"time"
+)
+
+// 保険契約ごとの補償対象のアイテムの一覧（更新の際に保険会社へ出す資料）
+type InsuranceSchedule struct {
+	Policies  []*InsurancePolicySchedule `json:"policies"`
+	Total     int                        `json:"total"` // 全契約の評価額の合計
+	CreatedAt time.Time                  `json:"created_at"`
+}
+
+type InsurancePolicySchedule struct {
+	Insurer      string         `json:"insurer"`
+	PolicyNumber string         `json:"policy_number"`
+	Items        []*InsuredItem `json:"items"`
+	Total        int            `json:"total"`
+}
+
+type InsuredItem struct {
+	ItemID         int64    `json:"item_id"`
+	Name           string   `json:"name"`
+	Category       string   `json:"category"`
+	Brand          string   `json:"brand"`
+	SerialNumber   string   `json:"serial_number"`
+	AppraisedValue int      `json:"appraised_value"` // 評価額（再評価していなければ購入価格）
+	Photos         []string `json:"photos"`
+}
+
+// items のうち保険を設定したものを、保険会社・証券番号ごとにまとめる
+// 契約は保険会社・証券番号の順、アイテムはIDの順に並べる
+func NewInsuranceSchedule(items []*Item, insurances []*ItemInsurance) *InsuranceSchedule {
+	byItemID := make(map[int64]*ItemInsurance, len(insurances))
+	for _, insurance := range insurances {
+		byItemID[insurance.ItemID] = insurance
+	}
+
+	schedule := &InsuranceSchedule{Policies: []*InsurancePolicySchedule{}, CreatedAt: time.Now()}
+	policies := map[[2]string]*InsurancePolicySchedule{}
+	for _, item := range items {
+		insurance, ok := byItemID[item.ID]
+		if !ok {
+			continue
+		}
+
+		key := [2]string{insurance.Insurer, insurance.PolicyNumber}
+		policy, ok := policies[key]
+		if !ok {
+			policy = &InsurancePolicySchedule{Insurer: insurance.Insurer, PolicyNumber: insurance.PolicyNumber, Items: []*InsuredItem{}}
+			policies[key] = policy
+			schedule.Policies = append(schedule.Policies, policy)
+		}
+
+		value := item.PurchasePrice
+		if item.CurrentValue != nil {
+			value = *item.CurrentValue
+		}
+		policy.Items = append(policy.Items, &InsuredItem{
+			ItemID:         item.ID,
+			Name:           item.Name,
+			Category:       item.Category,
+			Brand:          item.Brand,
+			SerialNumber:   item.SerialNumber,
+			AppraisedValue: value,
+			Photos:         append([]string{}, insurance.Photos...),
+		})
+		policy.Total += value
+		schedule.Total += value
+	}
+
+	sort.Slice(schedule.Policies, func(i, j int) bool {
+		a, b := schedule.Policies[i], schedule.Policies[j]
+		if a.Insurer != b.Insurer {
+			return a.Insurer < b.Insurer
+		}
+		return a.PolicyNumber < b.PolicyNumber
+	})
+	for _, policy := range schedule.Policies {
+		sort.Slice(policy.Items, func(i, j int) bool {
+			return policy.Items[i].ItemID < policy.Items[j].ItemID
+		})
+	}
+
+	return schedule
+}
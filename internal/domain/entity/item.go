@@ -1,25 +1,35 @@
 package entity
 
 import (
-	"errors"
 	"strings"
 	"time"
 )
 
 type Item struct {
-	ID            int64     `json:"id"`
-	Name          string    `json:"name"`
-	Category      string    `json:"category"`
-	Brand         string    `json:"brand"`
-	PurchasePrice int       `json:"purchase_price"`
-	PurchaseDate  string    `json:"purchase_date"` // YYYY-MM-DD 形式
-	CreatedAt     time.Time `json:"created_at"`
-	UpdatedAt     time.Time `json:"updated_at"`
+	ID            int64             `json:"id"`
+	Name          string            `json:"name"`
+	Category      string            `json:"category"`
+	Brand         string            `json:"brand"`
+	PurchasePrice int               `json:"purchase_price"`
+	CurrentValue  *int              `json:"current_value"` // 現在の評価額（未評価ならnull）
+	PurchaseDate  string            `json:"purchase_date"` // YYYY-MM-DD 形式
+	Condition     string            `json:"condition"`     // コンディションランク（未評価なら空文字）
+	SerialNumber  string            `json:"serial_number"` // シリアル番号（未登録なら空文字）
+	Attributes    map[string]string `json:"attributes"`    // カテゴリーごとのカスタム属性
+	LocationID    *int64            `json:"location_id"`   // 保管場所（未設定ならnull）
+	OnLoan        bool              `json:"on_loan"`       // 貸出中かどうか
+	ArchivedAt    *time.Time        `json:"archived_at"`   // アーカイブした日時（アーカイブしていなければnull）
+	CreatedAt     time.Time         `json:"created_at"`
+	UpdatedAt     time.Time         `json:"updated_at"`
+	Version       int               `json:"version"` // 更新のたびに1増える（同時に変更されたことを検出するため）
 }
 
 // カテゴリー定義
 var ValidCategories = []string{"時計", "バッグ", "ジュエリー", "靴", "その他"}
 
+// コンディションランク定義（N: 新品, S: 未使用に近い, A: 美品, B: 使用感あり, C: 難あり）
+var ValidConditions = []string{"N", "S", "A", "B", "C"}
+
 func NewItem(name, category, brand string, purchasePrice int, purchaseDate string) (*Item, error) {
 	item := &Item{
 		Name:          strings.TrimSpace(name),
@@ -38,40 +48,57 @@ func NewItem(name, category, brand string, purchasePrice int, purchaseDate strin
 	return item, nil
 }
 
-// アイテムフィールドのバリデーション
+// アイテムフィールドのバリデーション（誤りがあればFieldErrorsを返す）
 func (i *Item) Validate() error {
-	var errs []string
+	var errs FieldErrors
+	add := func(field string, value any, rule, message string) {
+		errs = append(errs, FieldError{Field: field, Value: value, Rule: rule, Message: message})
+	}
 
 	if i.Name == "" {
-		errs = append(errs, "name is required")
+		add("name", i.Name, "required", "name is required")
 	} else if len(i.Name) > 100 {
-		errs = append(errs, "name must be 100 characters or less")
+		add("name", i.Name, "max_length", "name must be 100 characters or less")
 	}
 
 	if i.Category == "" {
-		errs = append(errs, "category is required")
+		add("category", i.Category, "required", "category is required")
 	} else if !isValidCategory(i.Category) {
-		errs = append(errs, "category must be one of: 時計, バッグ, ジュエリー, 靴, その他")
+		add("category", i.Category, "one_of", "category must be one of: 時計, バッグ, ジュエリー, 靴, その他")
 	}
 
 	if i.Brand == "" {
-		errs = append(errs, "brand is required")
+		add("brand", i.Brand, "required", "brand is required")
 	} else if len(i.Brand) > 100 {
-		errs = append(errs, "brand must be 100 characters or less")
+		add("brand", i.Brand, "max_length", "brand must be 100 characters or less")
 	}
 
 	if i.PurchasePrice < 0 {
-		errs = append(errs, "purchase_price must be 0 or greater")
+		add("purchase_price", i.PurchasePrice, "min", "purchase_price must be 0 or greater")
+	}
+
+	if i.CurrentValue != nil && *i.CurrentValue < 0 {
+		add("current_value", *i.CurrentValue, "min", "current_value must be 0 or greater")
 	}
 
 	if i.PurchaseDate == "" {
-		errs = append(errs, "purchase_date is required")
+		add("purchase_date", i.PurchaseDate, "required", "purchase_date is required")
 	} else if !isValidDateFormat(i.PurchaseDate) {
-		errs = append(errs, "purchase_date must be in YYYY-MM-DD format")
+		add("purchase_date", i.PurchaseDate, "date", "purchase_date must be in YYYY-MM-DD format")
+	}
+
+	if i.Condition != "" && !isValidCondition(i.Condition) {
+		add("condition", i.Condition, "one_of", "condition must be one of: N, S, A, B, C")
+	}
+
+	if len(i.SerialNumber) > 100 {
+		add("serial_number", i.SerialNumber, "max_length", "serial_number must be 100 characters or less")
 	}
 
+	errs = append(errs, validateAttributes(i.Category, i.Attributes)...)
+
 	if len(errs) > 0 {
-		return errors.New(strings.Join(errs, ", "))
+		return errs
 	}
 
 	return nil
@@ -101,6 +128,21 @@ func (i *Item) PartialUpdate(updateData map[string]interface{}) error {
 			i.Brand = strings.TrimSpace(brandStr)
 		}
 	}
+	if condition, exists := updateData["condition"]; exists {
+		if conditionStr, ok := condition.(string); ok {
+			i.Condition = strings.ToUpper(strings.TrimSpace(conditionStr))
+		}
+	}
+	if serialNumber, exists := updateData["serial_number"]; exists {
+		if serialNumberStr, ok := serialNumber.(string); ok {
+			i.SerialNumber = strings.TrimSpace(serialNumberStr)
+		}
+	}
+	if attributes, exists := updateData["attributes"]; exists {
+		if attributesMap, ok := attributes.(map[string]string); ok {
+			i.Attributes = normalizeAttributes(attributesMap)
+		}
+	}
 	if purchasePrice, exists := updateData["purchase_price"]; exists {
 		// JSONの数値はfloat64として来る可能性があるのでついか
 		switch v := purchasePrice.(type) {
@@ -116,6 +158,61 @@ func (i *Item) PartialUpdate(updateData map[string]interface{}) error {
 	return i.Validate()
 }
 
+// 保管場所の移動（移動履歴を返す）
+func (i *Item) MoveTo(locationID *int64) *LocationMove {
+	move := &LocationMove{
+		ItemID:         i.ID,
+		FromLocationID: i.LocationID,
+		ToLocationID:   locationID,
+		MovedAt:        time.Now(),
+	}
+
+	i.LocationID = locationID
+	i.UpdatedAt = move.MovedAt
+
+	return move
+}
+
+// アーカイブ（譲渡済み・長期保管など、削除せずに一覧と集計から外す）
+func (i *Item) Archive() {
+	now := time.Now()
+	i.ArchivedAt = &now
+	i.UpdatedAt = now
+}
+
+// アーカイブの解除
+func (i *Item) Unarchive() {
+	i.ArchivedAt = nil
+	i.UpdatedAt = time.Now()
+}
+
+func (i *Item) IsArchived() bool {
+	return i.ArchivedAt != nil
+}
+
+// 現在の評価額（まだ評価していなければ購入価格）
+func (i *Item) CurrentValueOrPurchasePrice() int {
+	if i.CurrentValue != nil {
+		return *i.CurrentValue
+	}
+	return i.PurchasePrice
+}
+
+// 評価額の変更（変更の記録を返す）
+func (i *Item) Revalue(value int) *ItemValuation {
+	valuation := &ItemValuation{
+		ItemID:        i.ID,
+		PreviousValue: i.CurrentValueOrPurchasePrice(),
+		Value:         value,
+		CreatedAt:     time.Now(),
+	}
+
+	i.CurrentValue = &value
+	i.UpdatedAt = valuation.CreatedAt
+
+	return valuation
+}
+
 // カテゴリーのバリデーション
 func isValidCategory(category string) bool {
 	for _, valid := range ValidCategories {
@@ -126,6 +223,40 @@ func isValidCategory(category string) bool {
 	return false
 }
 
+// コンディションランクの設定
+func (i *Item) SetCondition(condition string) error {
+	i.Condition = strings.ToUpper(strings.TrimSpace(condition))
+	i.UpdatedAt = time.Now()
+
+	return i.Validate()
+}
+
+// シリアル番号の設定
+func (i *Item) SetSerialNumber(serialNumber string) error {
+	i.SerialNumber = strings.TrimSpace(serialNumber)
+	i.UpdatedAt = time.Now()
+
+	return i.Validate()
+}
+
+// カスタム属性の設定（既存の属性は置き換える）
+func (i *Item) SetAttributes(attributes map[string]string) error {
+	i.Attributes = normalizeAttributes(attributes)
+	i.UpdatedAt = time.Now()
+
+	return i.Validate()
+}
+
+// コンディションランクのバリデーション
+func isValidCondition(condition string) bool {
+	for _, valid := range ValidConditions {
+		if condition == valid {
+			return true
+		}
+	}
+	return false
+}
+
 // デート形式のバリデーション
 func isValidDateFormat(dateStr string) bool {
 	_, err := time.Parse("2006-01-02", dateStr)
@@ -136,3 +267,8 @@ func isValidDateFormat(dateStr string) bool {
 func GetValidCategories() []string {
 	return ValidCategories
 }
+
+// コンディションランクの取得
+func GetValidConditions() []string {
+	return ValidConditions
+}
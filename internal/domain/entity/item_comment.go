@@ -0,0 +1,64 @@
+package entity
+
+import (
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// 文字数の上限
+const (
+	MaxCommentAuthorLength = 100
+	MaxCommentBodyLength   = 4000
+)
+
+// アイテムに残すメモ（修理・メンテナンスの記録、由来、覚え書きなど）
+// 利用者のアカウントはないため、書いた人は名前で記録する
+type ItemComment struct {
+	ID        int64     `json:"id"`
+	ItemID    int64     `json:"item_id"`
+	Author    string    `json:"author"`
+	Body      string    `json:"body"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func NewItemComment(itemID int64, author, body string) (*ItemComment, error) {
+	comment := &ItemComment{
+		ItemID:    itemID,
+		Author:    strings.TrimSpace(author),
+		Body:      strings.TrimSpace(body),
+		CreatedAt: time.Now(),
+	}
+
+	if err := comment.Validate(); err != nil {
+		return nil, err
+	}
+
+	return comment, nil
+}
+
+// メモのバリデーション（誤りがあればFieldErrorsを返す）
+func (c *ItemComment) Validate() error {
+	var errs FieldErrors
+	add := func(field string, value any, rule, message string) {
+		errs = append(errs, FieldError{Field: field, Value: value, Rule: rule, Message: message})
+	}
+
+	if c.Author == "" {
+		add("author", c.Author, "required", "author is required")
+	} else if utf8.RuneCountInString(c.Author) > MaxCommentAuthorLength {
+		add("author", c.Author, "max_length", "author must be 100 characters or less")
+	}
+
+	if c.Body == "" {
+		add("body", c.Body, "required", "body is required")
+	} else if utf8.RuneCountInString(c.Body) > MaxCommentBodyLength {
+		add("body", c.Body, "max_length", "body must be 4000 characters or less")
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+
+	return nil
+}
@@ -0,0 +1,20 @@
+package entity
+
+import "time"
+
+// 閲覧者がお気に入りにしたアイテム（閲覧者とアイテムの組ごとに1件）
+// アイテムの内容ではないため、設定・解除してもアイテムは更新しない（版や更新日時は変わらない）
+// 利用者ごとのアカウントはないため、認証で利用者が決まらないリクエストは空の閲覧者として記録する
+type ItemFavorite struct {
+	Viewer    string    `json:"-"`
+	ItemID    int64     `json:"item_id"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func NewItemFavorite(viewer string, itemID int64) *ItemFavorite {
+	return &ItemFavorite{
+		Viewer:    viewer,
+		ItemID:    itemID,
+		CreatedAt: time.Now(),
+	}
+}
@@ -0,0 +1,35 @@
+package entity
+
+import (
+	"errors"
+	"fmt"
+)
+
+// アイテム一覧の絞り込み条件
+type ItemFilter struct {
+	LocationID *int64            // 保管場所ID
+	Category   string            // カテゴリー
+	Brand      string            // ブランド（完全一致）
+	Condition  string            // コンディションランク
+	Attributes map[string]string // カスタム属性（キーと値が完全一致するもの）
+	Favorites  bool              // Viewer のお気に入りのみ
+	Viewer     string            // お気に入りで絞り込む閲覧者（認証で利用者が決まらない場合は空文字）
+	Keywords   []string          // 名前・ブランド・カテゴリー・シリアル番号のいずれかに全ての語を含むもの（大文字・小文字を区別しない）
+
+	IncludeArchived bool // アーカイブしたアイテムも含める
+}
+
+// 絞り込み条件のバリデーション
+func (f ItemFilter) Validate() error {
+	if f.Condition != "" && !isValidCondition(f.Condition) {
+		return errors.New("condition must be one of: N, S, A, B, C")
+	}
+
+	for key := range f.Attributes {
+		if !IsValidAttributeKey(key) {
+			return fmt.Errorf("attr.%s: key must be 1-50 characters of a-z, 0-9 or _", key)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,85 @@
+package entity
+
+import (
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// 保険の設定の上限
+const (
+	MaxInsuranceInsurerLength = 100
+	MaxPolicyNumberLength     = 50
+	MaxInsurancePhotos        = 20
+	MaxInsurancePhotoLength   = 500
+)
+
+// アイテムを補償する保険契約（1つのアイテムに1件）
+// 写真はAPIで保存しないため、保管先のURLやファイル名を参照として残す
+type ItemInsurance struct {
+	ItemID       int64     `json:"item_id"`
+	Insurer      string    `json:"insurer"`       // 保険会社
+	PolicyNumber string    `json:"policy_number"` // 証券番号
+	Photos       []string  `json:"photos"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+// 前後の空白を取り除き、空の写真の参照は除いてから確認する
+func NewItemInsurance(itemID int64, insurer, policyNumber string, photos []string) (*ItemInsurance, error) {
+	insurance := &ItemInsurance{
+		ItemID:       itemID,
+		Insurer:      strings.TrimSpace(insurer),
+		PolicyNumber: strings.TrimSpace(policyNumber),
+		Photos:       []string{},
+		UpdatedAt:    time.Now(),
+	}
+	for _, photo := range photos {
+		if photo = strings.TrimSpace(photo); photo != "" {
+			insurance.Photos = append(insurance.Photos, photo)
+		}
+	}
+
+	if err := insurance.Validate(); err != nil {
+		return nil, err
+	}
+
+	return insurance, nil
+}
+
+// 保険の設定のバリデーション（誤りがあればFieldErrorsを返す）
+func (i *ItemInsurance) Validate() error {
+	var errs FieldErrors
+	add := func(field string, value any, rule, message string) {
+		errs = append(errs, FieldError{Field: field, Value: value, Rule: rule, Message: message})
+	}
+
+	switch {
+	case i.Insurer == "":
+		add("insurer", i.Insurer, "required", "insurer is required")
+	case utf8.RuneCountInString(i.Insurer) > MaxInsuranceInsurerLength:
+		add("insurer", i.Insurer, "max_length", "insurer must be 100 characters or less")
+	}
+
+	switch {
+	case i.PolicyNumber == "":
+		add("policy_number", i.PolicyNumber, "required", "policy_number is required")
+	case utf8.RuneCountInString(i.PolicyNumber) > MaxPolicyNumberLength:
+		add("policy_number", i.PolicyNumber, "max_length", "policy_number must be 50 characters or less")
+	}
+
+	if len(i.Photos) > MaxInsurancePhotos {
+		add("photos", len(i.Photos), "max_items", "photos must have 20 entries or less")
+	}
+	for _, photo := range i.Photos {
+		if utf8.RuneCountInString(photo) > MaxInsurancePhotoLength {
+			add("photos", photo, "max_length", "each photo must be 500 characters or less")
+			break
+		}
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+
+	return nil
+}
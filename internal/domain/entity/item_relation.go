@@ -0,0 +1,94 @@
+package entity
+
+import (
+	"strings"
+	"time"
+)
+
+// アイテム同士の関連の種類
+const (
+	RelationBelongsTo   = "belongs_to"   // 箱・保証書など、本体に付属する（item が related_item に付属する）
+	RelationAccessoryOf = "accessory_of" // 替えベルトなど、本体と組み合わせて使う（item が related_item のアクセサリー）
+	RelationSet         = "set"          // 同じセットの組（向きはない）
+)
+
+// 関連の種類の定義
+var ValidRelationTypes = []string{RelationBelongsTo, RelationAccessoryOf, RelationSet}
+
+// アイテム同士の関連
+type ItemRelation struct {
+	ID            int64     `json:"id"`
+	ItemID        int64     `json:"item_id"`
+	RelatedItemID int64     `json:"related_item_id"`
+	Type          string    `json:"type"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+func NewItemRelation(itemID, relatedItemID int64, relationType string) (*ItemRelation, error) {
+	relation := &ItemRelation{
+		ItemID:        itemID,
+		RelatedItemID: relatedItemID,
+		Type:          strings.TrimSpace(relationType),
+		CreatedAt:     time.Now(),
+	}
+
+	if err := relation.Validate(); err != nil {
+		return nil, err
+	}
+
+	return relation, nil
+}
+
+// 関連のバリデーション（誤りがあればFieldErrorsを返す）
+func (r *ItemRelation) Validate() error {
+	var errs FieldErrors
+	add := func(field string, value any, rule, message string) {
+		errs = append(errs, FieldError{Field: field, Value: value, Rule: rule, Message: message})
+	}
+
+	if r.RelatedItemID <= 0 {
+		add("related_item_id", r.RelatedItemID, "required", "related_item_id is required")
+	} else if r.RelatedItemID == r.ItemID {
+		add("related_item_id", r.RelatedItemID, "not_self", "related_item_id must be another item")
+	}
+
+	if r.Type == "" {
+		add("type", r.Type, "required", "type is required")
+	} else if !isValidRelationType(r.Type) {
+		add("type", r.Type, "one_of", "type must be one of: "+strings.Join(ValidRelationTypes, ", "))
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+
+	return nil
+}
+
+// 相手のアイテムのID
+func (r *ItemRelation) OtherItemID(itemID int64) int64 {
+	if r.ItemID == itemID {
+		return r.RelatedItemID
+	}
+	return r.ItemID
+}
+
+// 同じアイテム同士の同じ種類の関連か（セットは向きを問わない）
+func (r *ItemRelation) SameAs(other *ItemRelation) bool {
+	if r.Type != other.Type {
+		return false
+	}
+	if r.ItemID == other.ItemID && r.RelatedItemID == other.RelatedItemID {
+		return true
+	}
+	return r.Type == RelationSet && r.ItemID == other.RelatedItemID && r.RelatedItemID == other.ItemID
+}
+
+func isValidRelationType(relationType string) bool {
+	for _, valid := range ValidRelationTypes {
+		if relationType == valid {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,77 @@
+package entity
+
+import "strings"
+
+// ルール違反1件分
+type RuleViolation struct {
+	Field   string `json:"field"`
+	Rule    string `json:"rule"`
+	Message string `json:"message"`
+}
+
+// ルール違反の一覧（errorとして返せる）
+type RuleViolations []RuleViolation
+
+func (v RuleViolations) Error() string {
+	messages := make([]string, 0, len(v))
+	for _, violation := range v {
+		messages = append(messages, violation.Message)
+	}
+	return strings.Join(messages, ", ")
+}
+
+// アイテムが満たすべきルール
+type ItemRule struct {
+	Field   string
+	Rule    string
+	Message string
+	Check   func(*Item) bool // ルールを満たしていればtrue
+}
+
+// 全カテゴリー共通のルール
+var GlobalItemRules = []ItemRule{
+	{
+		Field:   "purchase_price",
+		Rule:    "min",
+		Message: "purchase_price must be 0 or greater",
+		Check:   func(i *Item) bool { return i.PurchasePrice >= 0 },
+	},
+}
+
+// カテゴリー別のルール
+var CategoryItemRules = map[string][]ItemRule{
+	"時計": {
+		requiredRule("serial_number", func(i *Item) string { return i.SerialNumber }),
+	},
+}
+
+func requiredRule(field string, value func(*Item) string) ItemRule {
+	return ItemRule{
+		Field:   field,
+		Rule:    "required",
+		Message: field + " is required",
+		Check:   func(i *Item) bool { return value(i) != "" },
+	}
+}
+
+// 共通ルールとカテゴリー別ルールを評価する（違反があればRuleViolationsを返す）
+func (i *Item) EvaluateRules() error {
+	var violations RuleViolations
+
+	rules := append(append([]ItemRule{}, GlobalItemRules...), CategoryItemRules[i.Category]...)
+	for _, rule := range rules {
+		if !rule.Check(i) {
+			violations = append(violations, RuleViolation{
+				Field:   rule.Field,
+				Rule:    rule.Rule,
+				Message: rule.Message,
+			})
+		}
+	}
+
+	if len(violations) > 0 {
+		return violations
+	}
+
+	return nil
+}
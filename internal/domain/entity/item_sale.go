@@ -0,0 +1,75 @@
+package entity
+
+import (
+	"time"
+)
+
+// 売却などでアイテムを手放した記録
+// 後でアイテムを変更しても申告の内容が変わらないよう、名前・購入日・取得価額は売却した時点の値を残す
+type ItemSale struct {
+	ID            int64     `json:"id"`
+	ItemID        int64     `json:"item_id"`
+	ItemName      string    `json:"item_name"`
+	PurchaseDate  string    `json:"purchase_date"`  // YYYY-MM-DD 形式
+	PurchasePrice int       `json:"purchase_price"` // 取得価額
+	SoldOn        string    `json:"sold_on"`        // YYYY-MM-DD 形式
+	SalePrice     int       `json:"sale_price"`
+	Fees          int       `json:"fees"` // 売却にかかった手数料・送料など
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+func NewItemSale(item *Item, soldOn string, salePrice, fees int) (*ItemSale, error) {
+	sale := &ItemSale{
+		ItemID:        item.ID,
+		ItemName:      item.Name,
+		PurchaseDate:  item.PurchaseDate,
+		PurchasePrice: item.PurchasePrice,
+		SoldOn:        soldOn,
+		SalePrice:     salePrice,
+		Fees:          fees,
+		CreatedAt:     time.Now(),
+	}
+
+	if err := sale.Validate(); err != nil {
+		return nil, err
+	}
+
+	return sale, nil
+}
+
+// 売却の記録のバリデーション（誤りがあればFieldErrorsを返す）
+func (s *ItemSale) Validate() error {
+	var errs FieldErrors
+	add := func(field string, value any, rule, message string) {
+		errs = append(errs, FieldError{Field: field, Value: value, Rule: rule, Message: message})
+	}
+
+	switch {
+	case s.SoldOn == "":
+		add("sold_on", s.SoldOn, "required", "sold_on is required")
+	case !isValidDateFormat(s.SoldOn):
+		add("sold_on", s.SoldOn, "date", "sold_on must be in YYYY-MM-DD format")
+	case s.SoldOn < s.PurchaseDate:
+		// YYYY-MM-DD 形式なので文字列比較で日付の前後が判定できる
+		add("sold_on", s.SoldOn, "after_purchase", "sold_on must not be before the purchase date")
+	}
+
+	if s.SalePrice < 0 {
+		add("sale_price", s.SalePrice, "min", "sale_price must be 0 or greater")
+	}
+
+	if s.Fees < 0 {
+		add("fees", s.Fees, "min", "fees must be 0 or greater")
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+
+	return nil
+}
+
+// 売却による損益（売却価格から手数料と取得価額を引いたもの。損失は負の数）
+func (s *ItemSale) Gain() int {
+	return s.SalePrice - s.Fees - s.PurchasePrice
+}
@@ -0,0 +1,129 @@
+package entity
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// 検索の件数の既定値と上限、検索語の上限
+const (
+	DefaultSearchLimit  = 20
+	MaxSearchLimit      = 100
+	MaxSearchQueryChars = 200
+	MaxSearchKeywords   = 10
+)
+
+// 入力補完の候補の数
+const MaxSuggestions = 10
+
+// 入力補完の候補になるフィールド
+const (
+	SuggestionFieldBrand = "brand"
+	SuggestionFieldName  = "name"
+)
+
+// 検索に使った仕組み
+const (
+	SearchEngineIndex    = "index"    // 検索インデックス（関連度の順位付け）
+	SearchEngineDatabase = "database" // データベースの部分一致
+)
+
+// アイテムの全文検索の条件
+type ItemSearchQuery struct {
+	Query    string // 空白区切りの語を全て含むアイテムを探す
+	Category string // ファセットで選んだカテゴリー
+	Brand    string // ファセットで選んだブランド
+	Limit    int
+	Offset   int
+}
+
+// 空白で区切った検索語（大文字・小文字を区別しないため小文字にし、重複を除く）
+func (q ItemSearchQuery) Keywords() []string {
+	var keywords []string
+	seen := make(map[string]bool)
+	for _, keyword := range strings.Fields(strings.ToLower(q.Query)) {
+		if !seen[keyword] {
+			seen[keyword] = true
+			keywords = append(keywords, keyword)
+		}
+	}
+	return keywords
+}
+
+// 検索条件のバリデーション（誤りがあればFieldErrorsを返す）
+func (q ItemSearchQuery) Validate() error {
+	var errs FieldErrors
+	add := func(field string, value any, rule, message string) {
+		errs = append(errs, FieldError{Field: field, Value: value, Rule: rule, Message: message})
+	}
+
+	switch keywords := q.Keywords(); {
+	case len(keywords) == 0:
+		add("q", q.Query, "required", "q is required")
+	case utf8.RuneCountInString(q.Query) > MaxSearchQueryChars:
+		add("q", q.Query, "max", "q must be 200 characters or less")
+	case len(keywords) > MaxSearchKeywords:
+		add("q", q.Query, "max", "q must have 10 words or less")
+	}
+	if q.Limit < 1 || q.Limit > MaxSearchLimit {
+		add("limit", q.Limit, "range", "limit must be between 1 and 100")
+	}
+	if q.Offset < 0 {
+		add("offset", q.Offset, "min", "offset must be 0 or greater")
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+
+	return nil
+}
+
+// 検索結果の1件
+type ItemSearchHit struct {
+	ItemID     int64               `json:"-"`
+	Item       *Item               `json:"item"`
+	Score      float64             `json:"score"`
+	Highlights map[string][]string `json:"highlights"` // フィールドごとの一致した部分を <em> で囲んだ断片（HTMLエスケープ済み）
+}
+
+// ファセットの値ごとの件数
+type FacetCount struct {
+	Value string `json:"value"`
+	Count int    `json:"count"`
+}
+
+// 検索語に一致したアイテムのカテゴリー・ブランドごとの件数（category・brand の絞り込みをかける前の件数）
+type ItemSearchFacets struct {
+	Categories []FacetCount `json:"category"`
+	Brands     []FacetCount `json:"brand"`
+}
+
+// 全文検索の結果（Total は絞り込み後の全件数、Hits は Offset から Limit 件）
+type ItemSearchResult struct {
+	Total  int              `json:"total"`
+	Engine string           `json:"engine"`
+	Hits   []*ItemSearchHit `json:"hits"`
+	Facets ItemSearchFacets `json:"facets"`
+}
+
+// 入力補完の候補（アイテムの名前かブランド）
+type ItemSuggestion struct {
+	Value string `json:"value"`
+	Field string `json:"field"`
+}
+
+// 先頭か、空白の後の語の先頭が prefix に一致するか（大文字・小文字を区別しない）
+func MatchesSuggestionPrefix(value, prefix string) bool {
+	value, prefix = strings.ToLower(value), strings.ToLower(prefix)
+	if strings.HasPrefix(value, prefix) {
+		return true
+	}
+	for i, r := range value {
+		if unicode.IsSpace(r) && strings.HasPrefix(value[i+utf8.RuneLen(r):], prefix) {
+			return true
+		}
+	}
+	return false
+}
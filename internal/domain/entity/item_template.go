@@ -0,0 +1,74 @@
+package entity
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+// アイテム登録用のテンプレート（共通の項目を保存しておく）
+type ItemTemplate struct {
+	ID            int64     `json:"id"`
+	Name          string    `json:"name"`
+	Category      string    `json:"category"`
+	Brand         string    `json:"brand"`
+	PurchasePrice int       `json:"purchase_price"`
+	Condition     string    `json:"condition"`
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
+func NewItemTemplate(name, category, brand string, purchasePrice int, condition string) (*ItemTemplate, error) {
+	template := &ItemTemplate{
+		Name:          strings.TrimSpace(name),
+		Category:      strings.TrimSpace(category),
+		Brand:         strings.TrimSpace(brand),
+		PurchasePrice: purchasePrice,
+		Condition:     strings.ToUpper(strings.TrimSpace(condition)),
+		CreatedAt:     time.Now(),
+		UpdatedAt:     time.Now(),
+	}
+
+	if err := template.Validate(); err != nil {
+		return nil, err
+	}
+
+	return template, nil
+}
+
+// テンプレートフィールドのバリデーション
+func (t *ItemTemplate) Validate() error {
+	var errs []string
+
+	if t.Name == "" {
+		errs = append(errs, "name is required")
+	} else if len(t.Name) > 100 {
+		errs = append(errs, "name must be 100 characters or less")
+	}
+
+	if t.Category == "" {
+		errs = append(errs, "category is required")
+	} else if !isValidCategory(t.Category) {
+		errs = append(errs, "category must be one of: 時計, バッグ, ジュエリー, 靴, その他")
+	}
+
+	if t.Brand == "" {
+		errs = append(errs, "brand is required")
+	} else if len(t.Brand) > 100 {
+		errs = append(errs, "brand must be 100 characters or less")
+	}
+
+	if t.PurchasePrice < 0 {
+		errs = append(errs, "purchase_price must be 0 or greater")
+	}
+
+	if t.Condition != "" && !isValidCondition(t.Condition) {
+		errs = append(errs, "condition must be one of: N, S, A, B, C")
+	}
+
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, ", "))
+	}
+
+	return nil
+}
@@ -278,6 +278,27 @@ func TestItem_Validate(t *testing.T) {
 	}
 }
 
+func TestItem_Validate_FieldErrors(t *testing.T) {
+	item := &Item{
+		Name:          "ロレックス デイトナ",
+		Category:      "時計",
+		Brand:         "ROLEX",
+		PurchasePrice: -100,
+		PurchaseDate:  "2023/01/15",
+		Attributes:    map[string]string{"movement": "solar"},
+	}
+
+	err := item.Validate()
+
+	var fieldErrors FieldErrors
+	require.ErrorAs(t, err, &fieldErrors)
+	assert.Equal(t, FieldErrors{
+		{Field: "purchase_price", Value: -100, Rule: "min", Message: "purchase_price must be 0 or greater"},
+		{Field: "purchase_date", Value: "2023/01/15", Rule: "date", Message: "purchase_date must be in YYYY-MM-DD format"},
+		{Field: "attributes.movement", Value: "solar", Rule: "one_of", Message: "attributes.movement must be one of: automatic, manual, quartz"},
+	}, fieldErrors)
+}
+
 func TestIsValidCategory(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -335,3 +356,133 @@ func TestGetValidCategories(t *testing.T) {
 	assert.Equal(t, expected, categories)
 	assert.Len(t, categories, 5)
 }
+
+func TestItem_SetCondition(t *testing.T) {
+	tests := []struct {
+		name      string
+		condition string
+		want      string
+		wantErr   bool
+	}{
+		{"有効なランク: N", "N", "N", false},
+		{"有効なランク: 小文字", " a ", "A", false},
+		{"未評価: 空文字", "", "", false},
+		{"無効なランク: D", "D", "", true},
+		{"無効なランク: 日本語", "美品", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			item, err := NewItem("ロレックス デイトナ", "時計", "ROLEX", 1500000, "2023-01-15")
+			require.NoError(t, err)
+
+			err = item.SetCondition(tt.condition)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), "condition must be one of: N, S, A, B, C")
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, item.Condition)
+		})
+	}
+}
+
+func TestItemFilter_Validate(t *testing.T) {
+	assert.NoError(t, ItemFilter{}.Validate())
+	assert.NoError(t, ItemFilter{Condition: "S"}.Validate())
+	assert.Error(t, ItemFilter{Condition: "Z"}.Validate())
+	assert.NoError(t, ItemFilter{Attributes: map[string]string{"movement": "automatic"}}.Validate())
+	assert.Error(t, ItemFilter{Attributes: map[string]string{"Movement!": "automatic"}}.Validate())
+}
+
+func TestItem_SetAttributes(t *testing.T) {
+	tests := []struct {
+		name       string
+		category   string
+		attributes map[string]string
+		want       map[string]string
+		wantErr    string
+	}{
+		{
+			name:       "正常系: 定義済みのキーと許可値",
+			category:   "時計",
+			attributes: map[string]string{"movement": " automatic ", "case_size": "40mm"},
+			want:       map[string]string{"movement": "automatic", "case_size": "40mm"},
+		},
+		{
+			name:       "正常系: スキーマの無いカテゴリーは任意のキー",
+			category:   "その他",
+			attributes: map[string]string{"origin": "japan"},
+			want:       map[string]string{"origin": "japan"},
+		},
+		{
+			name:       "異常系: 許可されていない値",
+			category:   "時計",
+			attributes: map[string]string{"movement": "solar"},
+			wantErr:    "attributes.movement must be one of: automatic, manual, quartz",
+		},
+		{
+			name:       "異常系: 定義されていないキー",
+			category:   "時計",
+			attributes: map[string]string{"material": "leather"},
+			wantErr:    "attributes.material is not defined for category 時計",
+		},
+		{
+			name:       "異常系: 不正なキー形式",
+			category:   "その他",
+			attributes: map[string]string{"Case Size": "40mm"},
+			wantErr:    "key must be 1-50 characters",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			item, err := NewItem("テストアイテム", tt.category, "TEST", 1000, "2023-01-15")
+			require.NoError(t, err)
+
+			err = item.SetAttributes(tt.attributes)
+
+			if tt.wantErr != "" {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, item.Attributes)
+		})
+	}
+}
+
+func TestItem_EvaluateRules(t *testing.T) {
+	t.Run("時計はシリアル番号が必須", func(t *testing.T) {
+		item, err := NewItem("ロレックス デイトナ", "時計", "ROLEX", 1500000, "2023-01-15")
+		require.NoError(t, err)
+
+		err = item.EvaluateRules()
+
+		var violations RuleViolations
+		require.ErrorAs(t, err, &violations)
+		assert.Equal(t, RuleViolations{
+			{Field: "serial_number", Rule: "required", Message: "serial_number is required"},
+		}, violations)
+
+		require.NoError(t, item.SetSerialNumber("D123456"))
+		assert.NoError(t, item.EvaluateRules())
+	})
+
+	t.Run("カテゴリー別ルールの無いカテゴリーは共通ルールのみ", func(t *testing.T) {
+		item, err := NewItem("バーキン30", "バッグ", "HERMES", 2000000, "2023-01-15")
+		require.NoError(t, err)
+		assert.NoError(t, item.EvaluateRules())
+
+		item.PurchasePrice = -1
+		var violations RuleViolations
+		require.ErrorAs(t, item.EvaluateRules(), &violations)
+		assert.Equal(t, "purchase_price", violations[0].Field)
+		assert.Equal(t, "min", violations[0].Rule)
+	})
+}
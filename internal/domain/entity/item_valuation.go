@@ -0,0 +1,68 @@
+package entity
+
+import (
+	"math"
+	"time"
+)
+
+// 割合での調整の上限（%）
+const MaxValueAdjustmentPercentage = 1000
+
+// 評価額の変更の記録
+type ItemValuation struct {
+	ID            int64     `json:"id"`
+	ItemID        int64     `json:"item_id"`
+	PreviousValue int       `json:"previous_value"` // 変更前の評価額（未評価だった場合は購入価格）
+	Value         int       `json:"value"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+// 評価額の調整方法（割合か金額のどちらか一方を指定する）
+type ValueAdjustment struct {
+	Percentage *float64 `json:"percentage"` // 8 なら8%上げ、-10 なら10%下げる
+	Amount     *int     `json:"amount"`     // 加減する金額（円）
+}
+
+// 調整方法のバリデーション（誤りがあればFieldErrorsを返す）
+func (a ValueAdjustment) Validate() error {
+	var errs FieldErrors
+	add := func(field string, value any, rule, message string) {
+		errs = append(errs, FieldError{Field: field, Value: value, Rule: rule, Message: message})
+	}
+
+	switch {
+	case a.Percentage == nil && a.Amount == nil:
+		add("percentage", nil, "required", "either percentage or amount is required")
+	case a.Percentage != nil && a.Amount != nil:
+		add("amount", *a.Amount, "exclusive", "percentage and amount cannot be specified together")
+	case a.Percentage != nil:
+		if *a.Percentage <= -100 || *a.Percentage > MaxValueAdjustmentPercentage {
+			add("percentage", *a.Percentage, "range", "percentage must be greater than -100 and 1000 or less")
+		} else if *a.Percentage == 0 {
+			add("percentage", *a.Percentage, "not_zero", "percentage must not be 0")
+		}
+	case *a.Amount == 0:
+		add("amount", *a.Amount, "not_zero", "amount must not be 0")
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+
+	return nil
+}
+
+// 調整後の評価額（1円未満は四捨五入し、0円を下回る場合は0円にする）
+func (a ValueAdjustment) Apply(value int) int {
+	var adjusted int
+	if a.Percentage != nil {
+		adjusted = int(math.Round(float64(value) * (100 + *a.Percentage) / 100))
+	} else {
+		adjusted = value + *a.Amount
+	}
+
+	if adjusted < 0 {
+		return 0
+	}
+	return adjusted
+}
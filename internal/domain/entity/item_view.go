@@ -0,0 +1,25 @@
+package entity
+
+import "time"
+
+// 最近見たアイテムの件数の既定値と上限（閲覧者ごとに残すのも上限の件数まで）
+const (
+	DefaultRecentlyViewedLimit = 10
+	MaxRecentlyViewedLimit     = 50
+)
+
+// 閲覧者がアイテムの詳細を最後に見た日時（閲覧者とアイテムの組ごとに1件）
+// 利用者ごとのアカウントはないため、認証で利用者が決まらないリクエストは空の閲覧者として記録する
+type ItemView struct {
+	Viewer   string    `json:"-"`
+	ItemID   int64     `json:"item_id"`
+	ViewedAt time.Time `json:"viewed_at"`
+}
+
+func NewItemView(viewer string, itemID int64) *ItemView {
+	return &ItemView{
+		Viewer:   viewer,
+		ItemID:   itemID,
+		ViewedAt: time.Now(),
+	}
+}
@@ -0,0 +1,40 @@
+package entity
+
+import (
+	"slices"
+	"time"
+)
+
+// ジョブの状態
+const (
+	JobPending   = "pending"   // 実行を待っている（失敗して再試行を待つものを含む）
+	JobRunning   = "running"   // 実行中
+	JobSucceeded = "succeeded" // 成功した
+	JobFailed    = "failed"    // 試行の上限まで失敗した（再試行の操作で pending に戻せる）
+)
+
+var validJobStatuses = []string{JobPending, JobRunning, JobSucceeded, JobFailed}
+
+// ジョブの種類
+const (
+	JobWebhookDelivery = "webhook.delivery" // Webhookの送信（1件のWebhookへの1イベント）
+)
+
+// ジョブキューに登録した、バックグラウンドで実行する処理
+type Job struct {
+	ID          int64      `json:"id"`
+	Type        string     `json:"type"`
+	Payload     string     `json:"payload"` // 種類ごとの処理に渡す内容（JSON）
+	Status      string     `json:"status"`
+	Attempts    int        `json:"attempts"`
+	MaxAttempts int        `json:"max_attempts"`
+	LastError   string     `json:"last_error,omitempty"`
+	RunAt       time.Time  `json:"run_at"` // 次に実行する時刻（再試行を待つ間は先の時刻になる）
+	CreatedAt   time.Time  `json:"created_at"`
+	FinishedAt  *time.Time `json:"finished_at"`
+}
+
+// 状態として正しい値か
+func IsValidJobStatus(status string) bool {
+	return slices.Contains(validJobStatuses, status)
+}
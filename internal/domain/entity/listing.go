@@ -0,0 +1,23 @@
+package entity
+
+// 出品ページの種類
+const (
+	ListingSiteChrono24      = "chrono24"
+	ListingSiteYahooAuctions = "yahoo_auctions"
+)
+
+// 出品ページの通貨が日本円であることを表す（ISO 4217）
+const CurrencyJPY = "JPY"
+
+// マーケットプレイスの出品ページから読み取った内容
+// 読み取れなかった項目は空のまま（価格は0）にする
+type Listing struct {
+	URL      string   `json:"url"`
+	Site     string   `json:"site"`
+	Title    string   `json:"title"`
+	Brand    string   `json:"brand"`
+	Category string   `json:"category"` // サイトから分かる場合だけ（Chrono24は時計）
+	Price    int      `json:"price"`
+	Currency string   `json:"currency"`
+	Photos   []string `json:"photos"` // 画像のURL
+}
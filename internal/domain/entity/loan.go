@@ -0,0 +1,85 @@
+package entity
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+type Loan struct {
+	ID         int64      `json:"id"`
+	ItemID     int64      `json:"item_id"`
+	Borrower   string     `json:"borrower"`
+	DueDate    string     `json:"due_date"` // YYYY-MM-DD 形式
+	LoanedAt   time.Time  `json:"loaned_at"`
+	ReturnedAt *time.Time `json:"returned_at,omitempty"`
+}
+
+func NewLoan(itemID int64, borrower, dueDate string) (*Loan, error) {
+	loan := &Loan{
+		ItemID:   itemID,
+		Borrower: strings.TrimSpace(borrower),
+		DueDate:  strings.TrimSpace(dueDate),
+		LoanedAt: time.Now(),
+	}
+
+	if err := loan.Validate(); err != nil {
+		return nil, err
+	}
+
+	return loan, nil
+}
+
+// 貸出フィールドのバリデーション
+func (l *Loan) Validate() error {
+	var errs []string
+
+	if l.ItemID <= 0 {
+		errs = append(errs, "item_id is required")
+	}
+
+	if l.Borrower == "" {
+		errs = append(errs, "borrower is required")
+	} else if len(l.Borrower) > 100 {
+		errs = append(errs, "borrower must be 100 characters or less")
+	}
+
+	if l.DueDate == "" {
+		errs = append(errs, "due_date is required")
+	} else if !isValidDateFormat(l.DueDate) {
+		errs = append(errs, "due_date must be in YYYY-MM-DD format")
+	}
+
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, ", "))
+	}
+
+	return nil
+}
+
+// 返却済みかどうか
+func (l *Loan) IsReturned() bool {
+	return l.ReturnedAt != nil
+}
+
+// 返却処理
+func (l *Loan) Return() error {
+	if l.IsReturned() {
+		return errors.New("loan is already returned")
+	}
+
+	now := time.Now()
+	l.ReturnedAt = &now
+
+	return nil
+}
+
+// 指定日時点で返却期限を過ぎているかどうか
+func (l *Loan) IsOverdue(today time.Time) bool {
+	if l.IsReturned() {
+		return false
+	}
+
+	// YYYY-MM-DD 形式なので文字列比較で日付の前後が判定できる
+	return l.DueDate < today.Format("2006-01-02")
+}
@@ -0,0 +1,96 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewLoan(t *testing.T) {
+	tests := []struct {
+		name        string
+		itemID      int64
+		borrower    string
+		dueDate     string
+		wantErr     bool
+		expectedErr string
+	}{
+		{
+			name:     "正常系: 有効な貸出作成",
+			itemID:   1,
+			borrower: "山田 太郎",
+			dueDate:  "2023-06-30",
+			wantErr:  false,
+		},
+		{
+			name:        "異常系: 借り手が空",
+			itemID:      1,
+			borrower:    "",
+			dueDate:     "2023-06-30",
+			wantErr:     true,
+			expectedErr: "borrower is required",
+		},
+		{
+			name:        "異常系: 無効な返却期限",
+			itemID:      1,
+			borrower:    "山田 太郎",
+			dueDate:     "2023/06/30",
+			wantErr:     true,
+			expectedErr: "due_date must be in YYYY-MM-DD format",
+		},
+		{
+			name:        "異常系: アイテムIDが無効",
+			itemID:      0,
+			borrower:    "山田 太郎",
+			dueDate:     "2023-06-30",
+			wantErr:     true,
+			expectedErr: "item_id is required",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			loan, err := NewLoan(tt.itemID, tt.borrower, tt.dueDate)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedErr)
+				assert.Nil(t, loan)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.borrower, loan.Borrower)
+			assert.Equal(t, tt.dueDate, loan.DueDate)
+			assert.False(t, loan.LoanedAt.IsZero())
+			assert.False(t, loan.IsReturned())
+		})
+	}
+}
+
+func TestLoan_Return(t *testing.T) {
+	loan, err := NewLoan(1, "山田 太郎", "2023-06-30")
+	require.NoError(t, err)
+
+	assert.NoError(t, loan.Return())
+	assert.True(t, loan.IsReturned())
+
+	// 二重返却はエラー
+	assert.Error(t, loan.Return())
+}
+
+func TestLoan_IsOverdue(t *testing.T) {
+	today := time.Date(2023, 7, 1, 12, 0, 0, 0, time.Local)
+
+	overdue, _ := NewLoan(1, "山田 太郎", "2023-06-30")
+	dueToday, _ := NewLoan(1, "山田 太郎", "2023-07-01")
+
+	assert.True(t, overdue.IsOverdue(today))
+	assert.False(t, dueToday.IsOverdue(today))
+
+	// 返却済みなら期限切れ扱いしない
+	require.NoError(t, overdue.Return())
+	assert.False(t, overdue.IsOverdue(today))
+}
@@ -0,0 +1,70 @@
+package entity
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+// 保管場所（金庫、貸金庫、ショーケースなど）
+type Location struct {
+	ID          int64     `json:"id"`
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// アイテムの保管場所の移動履歴
+type LocationMove struct {
+	ID             int64     `json:"id"`
+	ItemID         int64     `json:"item_id"`
+	FromLocationID *int64    `json:"from_location_id"`
+	ToLocationID   *int64    `json:"to_location_id"`
+	MovedAt        time.Time `json:"moved_at"`
+}
+
+func NewLocation(name, description string) (*Location, error) {
+	location := &Location{
+		Name:        strings.TrimSpace(name),
+		Description: strings.TrimSpace(description),
+		CreatedAt:   time.Now(),
+		UpdatedAt:   time.Now(),
+	}
+
+	if err := location.Validate(); err != nil {
+		return nil, err
+	}
+
+	return location, nil
+}
+
+// 保管場所フィールドのバリデーション
+func (l *Location) Validate() error {
+	var errs []string
+
+	if l.Name == "" {
+		errs = append(errs, "name is required")
+	} else if len(l.Name) > 100 {
+		errs = append(errs, "name must be 100 characters or less")
+	}
+
+	if len(l.Description) > 255 {
+		errs = append(errs, "description must be 255 characters or less")
+	}
+
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, ", "))
+	}
+
+	return nil
+}
+
+// 保管場所フィールドのアップデート
+func (l *Location) Update(name, description string) error {
+	l.Name = strings.TrimSpace(name)
+	l.Description = strings.TrimSpace(description)
+	l.UpdatedAt = time.Now()
+
+	return l.Validate()
+}
@@ -0,0 +1,27 @@
+package entity
+
+// 通知の種類（種類ごとに件名と本文のテンプレートがある）
+const (
+	NotificationLoansOverdue = "loans_overdue" // 返却期限を過ぎた貸出
+)
+
+// 利用者へ送る通知
+type Notification struct {
+	Kind string
+	// テンプレートに渡す値（種類ごとに決まった型）
+	Data any
+}
+
+// NotificationLoansOverdue のテンプレートに渡す値
+type OverdueLoansNotice struct {
+	Date  string // 確認した日（YYYY-MM-DD）
+	Loans []OverdueLoan
+}
+
+type OverdueLoan struct {
+	LoanID   int64
+	ItemID   int64
+	ItemName string // アイテムが見つからない場合は空
+	Borrower string
+	DueDate  string
+}
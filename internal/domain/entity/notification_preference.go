@@ -0,0 +1,78 @@
+package entity
+
+import (
+	"slices"
+	"time"
+)
+
+// 通知を送る手段
+const (
+	NotificationChannelEmail = "email" // メール（SMTPを設定していない場合はログに出す）
+)
+
+// 通知の手段と種類の一覧（保存された設定がない場合は有効）
+var (
+	NotificationChannels = []string{NotificationChannelEmail}
+	NotificationKinds    = []string{NotificationLoansOverdue}
+)
+
+func IsKnownNotificationChannel(name string) bool {
+	return slices.Contains(NotificationChannels, name)
+}
+
+func IsKnownNotificationKind(name string) bool {
+	return slices.Contains(NotificationKinds, name)
+}
+
+// 通知の設定1件の対象
+const (
+	NotificationPreferenceChannel = "channel" // 手段ごと
+	NotificationPreferenceEvent   = "event"   // 種類ごと
+)
+
+// 保存された通知の設定1件
+type NotificationPreference struct {
+	Type      string // NotificationPreferenceChannel / NotificationPreferenceEvent
+	Name      string
+	Enabled   bool
+	UpdatedAt time.Time
+}
+
+// 通知の設定（手段と種類の両方が有効な場合だけ送る）
+type NotificationPreferences struct {
+	Channels map[string]bool `json:"channels"`
+	Events   map[string]bool `json:"events"`
+	// 最後に変更した日時（一度も変更していない場合はnull）
+	UpdatedAt *time.Time `json:"updated_at"`
+}
+
+// 保存された設定を既定値（すべて有効）に重ねる
+func NewNotificationPreferences(saved []*NotificationPreference) *NotificationPreferences {
+	prefs := &NotificationPreferences{
+		Channels: make(map[string]bool, len(NotificationChannels)),
+		Events:   make(map[string]bool, len(NotificationKinds)),
+	}
+	for _, name := range NotificationChannels {
+		prefs.Channels[name] = true
+	}
+	for _, name := range NotificationKinds {
+		prefs.Events[name] = true
+	}
+
+	for _, pref := range saved {
+		switch {
+		case pref.Type == NotificationPreferenceChannel && IsKnownNotificationChannel(pref.Name):
+			prefs.Channels[pref.Name] = pref.Enabled
+		case pref.Type == NotificationPreferenceEvent && IsKnownNotificationKind(pref.Name):
+			prefs.Events[pref.Name] = pref.Enabled
+		default:
+			// 手段や種類をなくした後に残った設定
+			continue
+		}
+		if prefs.UpdatedAt == nil || pref.UpdatedAt.After(*prefs.UpdatedAt) {
+			updatedAt := pref.UpdatedAt
+			prefs.UpdatedAt = &updatedAt
+		}
+	}
+	return prefs
+}
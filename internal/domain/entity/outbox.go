@@ -0,0 +1,13 @@
+package entity
+
+import "time"
+
+// アイテムの変更と同じトランザクションで記録し、後から配信するイベント
+type OutboxMessage struct {
+	ID          int64
+	EventType   string
+	AggregateID int64
+	Payload     string // イベント発生時点のアイテム（JSON）
+	Attempts    int
+	CreatedAt   time.Time
+}
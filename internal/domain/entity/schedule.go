@@ -0,0 +1,155 @@
+package entity
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// cron形式（分 時 日 月 曜日）の実行時刻
+// 各項目には "*"・"5"・"1-5"・"*/15"・"1-30/2" とそのカンマ区切りを書ける。曜日は0と7が日曜日
+type Schedule struct {
+	expr string
+
+	minutes, hours, days, months, weekdays uint64
+
+	// 日と曜日の両方を指定した場合は、cronと同じくどちらかに合えば実行する
+	anyDay, anyWeekday bool
+}
+
+type scheduleField struct {
+	name     string
+	min, max int
+}
+
+var scheduleFields = []scheduleField{
+	{"minute", 0, 59},
+	{"hour", 0, 23},
+	{"day of month", 1, 31},
+	{"month", 1, 12},
+	{"day of week", 0, 7},
+}
+
+func ParseSchedule(expr string) (Schedule, error) {
+	parts := strings.Fields(expr)
+	if len(parts) != len(scheduleFields) {
+		return Schedule{}, fmt.Errorf("schedule must have 5 fields (minute hour day month weekday), got %q", expr)
+	}
+
+	bits := make([]uint64, len(parts))
+	for i, part := range parts {
+		parsed, err := parseScheduleField(part, scheduleFields[i])
+		if err != nil {
+			return Schedule{}, fmt.Errorf("invalid schedule %q: %w", expr, err)
+		}
+		bits[i] = parsed
+	}
+
+	// 7も日曜日として扱う
+	weekdays := bits[4]
+	if weekdays&(1<<7) != 0 {
+		weekdays = weekdays&^(1<<7) | 1
+	}
+
+	return Schedule{
+		expr:       strings.Join(parts, " "),
+		minutes:    bits[0],
+		hours:      bits[1],
+		days:       bits[2],
+		months:     bits[3],
+		weekdays:   weekdays,
+		anyDay:     parts[2] == "*",
+		anyWeekday: parts[4] == "*",
+	}, nil
+}
+
+func parseScheduleField(part string, field scheduleField) (uint64, error) {
+	var bits uint64
+	for _, item := range strings.Split(part, ",") {
+		rangePart, stepPart, hasStep := strings.Cut(item, "/")
+
+		step := 1
+		if hasStep {
+			parsed, err := strconv.Atoi(stepPart)
+			if err != nil || parsed <= 0 {
+				return 0, fmt.Errorf("%s step must be a positive integer, got %q", field.name, item)
+			}
+			step = parsed
+		}
+
+		low, high := field.min, field.max
+		if rangePart != "*" {
+			lowPart, highPart, isRange := strings.Cut(rangePart, "-")
+			var err error
+			if low, err = strconv.Atoi(lowPart); err != nil {
+				return 0, fmt.Errorf("%s must be a number, range or \"*\", got %q", field.name, item)
+			}
+			high = low
+			if isRange {
+				if high, err = strconv.Atoi(highPart); err != nil {
+					return 0, fmt.Errorf("%s must be a number, range or \"*\", got %q", field.name, item)
+				}
+			} else if hasStep {
+				// "5/15" は5から最後まで
+				high = field.max
+			}
+		}
+		if low < field.min || high > field.max || low > high {
+			return 0, fmt.Errorf("%s must be between %d and %d, got %q", field.name, field.min, field.max, item)
+		}
+
+		for value := low; value <= high; value += step {
+			bits |= 1 << value
+		}
+	}
+	return bits, nil
+}
+
+func (s Schedule) String() string {
+	return s.expr
+}
+
+// after より後で最初に実行する時刻（分単位、after のタイムゾーンで数える）
+// 2月30日のように実行する日がない場合はゼロ値を返す
+func (s Schedule) Next(after time.Time) time.Time {
+	t := after.Truncate(time.Minute).Add(time.Minute)
+
+	// 日付の組み合わせは4年で一巡する
+	limit := t.AddDate(5, 0, 0)
+	for t.Before(limit) {
+		if s.months&(1<<uint(t.Month())) == 0 {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, t.Location())
+			continue
+		}
+		if !s.matchDay(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, t.Location())
+			continue
+		}
+		if s.hours&(1<<uint(t.Hour())) == 0 {
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, t.Location())
+			continue
+		}
+		if s.minutes&(1<<uint(t.Minute())) == 0 {
+			t = t.Add(time.Minute)
+			continue
+		}
+		return t
+	}
+	return time.Time{}
+}
+
+func (s Schedule) matchDay(t time.Time) bool {
+	day := s.days&(1<<uint(t.Day())) != 0
+	weekday := s.weekdays&(1<<uint(t.Weekday())) != 0
+	switch {
+	case s.anyDay && s.anyWeekday:
+		return true
+	case s.anyDay:
+		return weekday
+	case s.anyWeekday:
+		return day
+	default:
+		return day || weekday
+	}
+}
@@ -0,0 +1,63 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSchedule_Next(t *testing.T) {
+	// 2026-10-16 は金曜日
+	after := time.Date(2026, 10, 16, 10, 30, 20, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		expr     string
+		expected time.Time
+	}{
+		{"正常系: 毎分", "* * * * *", time.Date(2026, 10, 16, 10, 31, 0, 0, time.UTC)},
+		{"正常系: 毎時0分", "0 * * * *", time.Date(2026, 10, 16, 11, 0, 0, 0, time.UTC)},
+		{"正常系: 15分ごと", "*/15 * * * *", time.Date(2026, 10, 16, 10, 45, 0, 0, time.UTC)},
+		{"正常系: 毎日3時（翌日になる）", "0 3 * * *", time.Date(2026, 10, 17, 3, 0, 0, 0, time.UTC)},
+		{"正常系: 平日9時（週末を飛ばす）", "0 9 * * 1-5", time.Date(2026, 10, 19, 9, 0, 0, 0, time.UTC)},
+		{"正常系: 日曜日は7でもよい", "0 0 * * 7", time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC)},
+		{"正常系: 毎月1日と15日", "0 0 1,15 * *", time.Date(2026, 11, 1, 0, 0, 0, 0, time.UTC)},
+		{"正常系: 日と曜日の両方を指定した場合はどちらか", "0 0 1 * 6", time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC)},
+		{"正常系: 年をまたぐ", "0 0 1 1 *", time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"正常系: うるう日", "0 0 29 2 *", time.Date(2028, 2, 29, 0, 0, 0, 0, time.UTC)},
+		{"正常系: 存在しない日付", "0 0 30 2 *", time.Time{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schedule, err := ParseSchedule(tt.expr)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, schedule.Next(after))
+		})
+	}
+}
+
+func TestParseSchedule(t *testing.T) {
+	tests := []struct {
+		name        string
+		expr        string
+		expectedErr string
+	}{
+		{"異常系: 項目が足りない", "0 3 * *", `schedule must have 5 fields (minute hour day month weekday), got "0 3 * *"`},
+		{"異常系: 範囲外", "60 * * * *", `invalid schedule "60 * * * *": minute must be between 0 and 59, got "60"`},
+		{"異常系: 逆順の範囲", "* 5-1 * * *", `invalid schedule "* 5-1 * * *": hour must be between 0 and 23, got "5-1"`},
+		{"異常系: 数値でない", "* * * JAN *", `invalid schedule "* * * JAN *": month must be a number, range or "*", got "JAN"`},
+		{"異常系: 間隔が0", "*/0 * * * *", `invalid schedule "*/0 * * * *": minute step must be a positive integer, got "*/0"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseSchedule(tt.expr)
+
+			assert.EqualError(t, err, tt.expectedErr)
+		})
+	}
+}
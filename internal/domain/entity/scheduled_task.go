@@ -0,0 +1,48 @@
+package entity
+
+import (
+	"slices"
+	"time"
+)
+
+// 定期的に実行する保守のタスク
+const (
+	TaskPurgeTrash   = "purge_trash"   // 統合で論理削除したアイテムを完全に削除する
+	TaskPurgeJobs    = "purge_jobs"    // 成功したバックグラウンドジョブを削除する
+	TaskOverdueLoans = "overdue_loans" // 返却期限を過ぎた貸出を確認する
+)
+
+// タスクの一覧（設定がない場合は有効）
+var ScheduledTasks = []string{TaskPurgeTrash, TaskPurgeJobs, TaskOverdueLoans}
+
+// 設定がない場合の実行時刻（cron形式、サーバーのタイムゾーン）
+var DefaultSchedules = map[string]string{
+	TaskPurgeTrash:   "0 3 * * *",
+	TaskPurgeJobs:    "0 * * * *",
+	TaskOverdueLoans: "0 9 * * *",
+}
+
+func IsKnownScheduledTask(name string) bool {
+	return slices.Contains(ScheduledTasks, name)
+}
+
+// 最後の実行の結果
+const (
+	TaskRunSucceeded = "succeeded"
+	TaskRunFailed    = "failed"
+)
+
+// タスクの設定と実行の状態（このインスタンスでの実行のみ）
+type ScheduledTask struct {
+	Name           string     `json:"name"`
+	Schedule       string     `json:"schedule"`
+	Enabled        bool       `json:"enabled"`
+	Running        bool       `json:"running"`
+	LastStartedAt  *time.Time `json:"last_started_at"`
+	LastFinishedAt *time.Time `json:"last_finished_at"`
+	LastStatus     string     `json:"last_status,omitempty"`
+	LastError      string     `json:"last_error,omitempty"`
+	// 前の実行が終わっていなかったため見送った回数
+	SkippedRuns int        `json:"skipped_runs"`
+	NextRunAt   *time.Time `json:"next_run_at"` // 無効の場合はnull
+}
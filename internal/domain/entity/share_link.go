@@ -0,0 +1,136 @@
+package entity
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"slices"
+	"time"
+)
+
+// 共有リンクで公開できる項目（シリアル番号・保管場所・貸出の状態は公開しない）
+const (
+	ShareFieldName          = "name"
+	ShareFieldCategory      = "category"
+	ShareFieldBrand         = "brand"
+	ShareFieldCondition     = "condition"
+	ShareFieldPurchaseDate  = "purchase_date"
+	ShareFieldPurchasePrice = "purchase_price"
+	ShareFieldAttributes    = "attributes"
+)
+
+var ShareableFields = []string{
+	ShareFieldName, ShareFieldCategory, ShareFieldBrand, ShareFieldCondition,
+	ShareFieldPurchaseDate, ShareFieldPurchasePrice, ShareFieldAttributes,
+}
+
+// 項目を指定しない場合に公開する項目（購入価格・購入日は含めない）
+var DefaultShareFields = []string{ShareFieldName, ShareFieldCategory, ShareFieldBrand, ShareFieldCondition, ShareFieldAttributes}
+
+// 有効期限の日数
+const (
+	DefaultShareLinkDays = 7
+	MaxShareLinkDays     = 90
+)
+
+// アイテムを読み取り専用で公開するリンク
+// トークンはハッシュだけを保存するため、作成時のレスポンスでしか分からない
+type ShareLink struct {
+	ID        int64      `json:"id"`
+	ItemID    int64      `json:"item_id"`
+	TokenHash string     `json:"-"`
+	Fields    []string   `json:"fields"`
+	ExpiresAt time.Time  `json:"expires_at"`
+	RevokedAt *time.Time `json:"revoked_at"` // 取り消していなければnull
+	CreatedAt time.Time  `json:"created_at"`
+}
+
+// 新しいリンクと、URLに含めるトークンを作る（fields が空なら既定の項目、expiresInDays が0なら既定の日数）
+func NewShareLink(itemID int64, fields []string, expiresInDays int) (*ShareLink, string, error) {
+	if len(fields) == 0 {
+		fields = DefaultShareFields
+	}
+	if expiresInDays == 0 {
+		expiresInDays = DefaultShareLinkDays
+	}
+
+	var errs FieldErrors
+	unique := make([]string, 0, len(fields))
+	for _, field := range fields {
+		if !slices.Contains(ShareableFields, field) {
+			errs = append(errs, FieldError{Field: "fields", Value: field, Rule: "oneof", Message: fmt.Sprintf("fields must be some of %v", ShareableFields)})
+		} else if !slices.Contains(unique, field) {
+			unique = append(unique, field)
+		}
+	}
+	if expiresInDays < 1 || expiresInDays > MaxShareLinkDays {
+		errs = append(errs, FieldError{Field: "expires_in_days", Value: expiresInDays, Rule: "range", Message: fmt.Sprintf("expires_in_days must be between 1 and %d", MaxShareLinkDays)})
+	}
+	if len(errs) > 0 {
+		return nil, "", errs
+	}
+
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return nil, "", fmt.Errorf("failed to generate share token: %w", err)
+	}
+	token := base64.RawURLEncoding.EncodeToString(b)
+
+	now := time.Now()
+	return &ShareLink{
+		ItemID:    itemID,
+		TokenHash: HashShareToken(token),
+		Fields:    unique,
+		ExpiresAt: now.AddDate(0, 0, expiresInDays),
+		CreatedAt: now,
+	}, token, nil
+}
+
+// 保存・検索に使うトークンのハッシュ
+func HashShareToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// 取り消されておらず、期限内か
+func (l *ShareLink) IsActive(now time.Time) bool {
+	return l.RevokedAt == nil && now.Before(l.ExpiresAt)
+}
+
+// 共有リンクで見せるアイテムの内容（選んだ項目だけを含める）
+type SharedItem struct {
+	Name          string            `json:"name,omitempty"`
+	Category      string            `json:"category,omitempty"`
+	Brand         string            `json:"brand,omitempty"`
+	Condition     string            `json:"condition,omitempty"`
+	PurchaseDate  string            `json:"purchase_date,omitempty"`
+	PurchasePrice *int              `json:"purchase_price,omitempty"`
+	Attributes    map[string]string `json:"attributes,omitempty"`
+	ExpiresAt     time.Time         `json:"expires_at"`
+}
+
+func (l *ShareLink) View(item *Item) *SharedItem {
+	shared := &SharedItem{ExpiresAt: l.ExpiresAt}
+	for _, field := range l.Fields {
+		switch field {
+		case ShareFieldName:
+			shared.Name = item.Name
+		case ShareFieldCategory:
+			shared.Category = item.Category
+		case ShareFieldBrand:
+			shared.Brand = item.Brand
+		case ShareFieldCondition:
+			shared.Condition = item.Condition
+		case ShareFieldPurchaseDate:
+			shared.PurchaseDate = item.PurchaseDate
+		case ShareFieldPurchasePrice:
+			price := item.PurchasePrice
+			shared.PurchasePrice = &price
+		case ShareFieldAttributes:
+			shared.Attributes = item.Attributes
+		}
+	}
+	return shared
+}
@@ -0,0 +1,92 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewShareLink(t *testing.T) {
+	tests := []struct {
+		name          string
+		fields        []string
+		expiresInDays int
+		wantFields    []string
+		wantDays      int
+		wantRules     []string
+	}{
+		{
+			name:       "正常系: 省略時は購入価格を除く項目で7日間",
+			wantFields: DefaultShareFields,
+			wantDays:   7,
+		},
+		{
+			name:          "正常系: 指定した項目（重複は除く）",
+			fields:        []string{"name", "purchase_price", "name"},
+			expiresInDays: 30,
+			wantFields:    []string{"name", "purchase_price"},
+			wantDays:      30,
+		},
+		{
+			name:          "異常系: シリアル番号は公開できない",
+			fields:        []string{"name", "serial_number"},
+			expiresInDays: 1,
+			wantRules:     []string{"oneof"},
+		},
+		{
+			name:          "異常系: 有効期限が長すぎる",
+			expiresInDays: 91,
+			wantRules:     []string{"range"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			link, token, err := NewShareLink(1, tt.fields, tt.expiresInDays)
+
+			if len(tt.wantRules) > 0 {
+				var fieldErrors FieldErrors
+				require.ErrorAs(t, err, &fieldErrors)
+				var rules []string
+				for _, fieldError := range fieldErrors {
+					rules = append(rules, fieldError.Rule)
+				}
+				assert.Equal(t, tt.wantRules, rules)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Len(t, token, 43)
+			assert.Equal(t, HashShareToken(token), link.TokenHash)
+			assert.Equal(t, tt.wantFields, link.Fields)
+			assert.WithinDuration(t, time.Now().AddDate(0, 0, tt.wantDays), link.ExpiresAt, time.Minute)
+		})
+	}
+}
+
+func TestShareLink_IsActive(t *testing.T) {
+	now := time.Now()
+	revokedAt := now.Add(-time.Minute)
+
+	assert.True(t, (&ShareLink{ExpiresAt: now.Add(time.Hour)}).IsActive(now))
+	assert.False(t, (&ShareLink{ExpiresAt: now}).IsActive(now))
+	assert.False(t, (&ShareLink{ExpiresAt: now.Add(time.Hour), RevokedAt: &revokedAt}).IsActive(now))
+}
+
+func TestShareLink_View(t *testing.T) {
+	item := &Item{
+		Name:          "ロレックス デイトナ",
+		Category:      "時計",
+		Brand:         "ROLEX",
+		PurchasePrice: 1500000,
+		PurchaseDate:  "2023-01-15",
+		SerialNumber:  "D123456",
+	}
+	expiresAt := time.Date(2026, 10, 23, 0, 0, 0, 0, time.UTC)
+
+	shared := (&ShareLink{Fields: []string{"name", "brand"}, ExpiresAt: expiresAt}).View(item)
+
+	assert.Equal(t, &SharedItem{Name: "ロレックス デイトナ", Brand: "ROLEX", ExpiresAt: expiresAt}, shared)
+}
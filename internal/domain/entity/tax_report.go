@@ -0,0 +1,122 @@
+package entity
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// 税務申告用の明細で指定できる年の範囲
+const (
+	MinTaxReportYear = 1900
+	MaxTaxReportYear = 9999
+)
+
+// 1年（1月1日〜12月31日、個人の確定申告と同じ）に取得・売却したアイテムの明細
+type TaxReport struct {
+	Year         int               `json:"year"`
+	Acquisitions []*TaxAcquisition `json:"acquisitions"`
+	Disposals    []*TaxDisposal    `json:"disposals"`
+	Totals       TaxReportTotals   `json:"totals"`
+}
+
+// その年に購入したアイテム
+type TaxAcquisition struct {
+	ItemID        int64  `json:"item_id"`
+	Name          string `json:"name"`
+	Category      string `json:"category"`
+	Brand         string `json:"brand"`
+	PurchaseDate  string `json:"purchase_date"`
+	PurchasePrice int    `json:"purchase_price"`
+}
+
+// その年に売却したアイテムと損益
+type TaxDisposal struct {
+	ItemID        int64  `json:"item_id"`
+	Name          string `json:"name"`
+	PurchaseDate  string `json:"purchase_date"`
+	PurchasePrice int    `json:"purchase_price"`
+	SoldOn        string `json:"sold_on"`
+	SalePrice     int    `json:"sale_price"`
+	Fees          int    `json:"fees"`
+	Gain          int    `json:"gain"`
+}
+
+type TaxReportTotals struct {
+	Acquired  int `json:"acquired"` // 取得したアイテムの購入価格の合計
+	Cost      int `json:"cost"`     // 売却したアイテムの取得価額の合計
+	SalePrice int `json:"sale_price"`
+	Fees      int `json:"fees"`
+	Gain      int `json:"gain"`
+}
+
+// 年のバリデーション（誤りがあればFieldErrorsを返す）
+func ValidateTaxReportYear(year int) error {
+	if year < MinTaxReportYear || year > MaxTaxReportYear {
+		return FieldErrors{{Field: "year", Value: year, Rule: "range", Message: "year must be between 1900 and 9999"}}
+	}
+	return nil
+}
+
+// items のうち year に購入したものと、year に売却した sales から明細を作る
+// どちらも日付・IDの順に並べる
+func NewTaxReport(year int, items []*Item, sales []*ItemSale) *TaxReport {
+	report := &TaxReport{
+		Year:         year,
+		Acquisitions: []*TaxAcquisition{},
+		Disposals:    []*TaxDisposal{},
+	}
+	prefix := strconv.Itoa(year) + "-"
+
+	for _, item := range items {
+		if !strings.HasPrefix(item.PurchaseDate, prefix) {
+			continue
+		}
+		report.Acquisitions = append(report.Acquisitions, &TaxAcquisition{
+			ItemID:        item.ID,
+			Name:          item.Name,
+			Category:      item.Category,
+			Brand:         item.Brand,
+			PurchaseDate:  item.PurchaseDate,
+			PurchasePrice: item.PurchasePrice,
+		})
+		report.Totals.Acquired += item.PurchasePrice
+	}
+
+	for _, sale := range sales {
+		if !strings.HasPrefix(sale.SoldOn, prefix) {
+			continue
+		}
+		report.Disposals = append(report.Disposals, &TaxDisposal{
+			ItemID:        sale.ItemID,
+			Name:          sale.ItemName,
+			PurchaseDate:  sale.PurchaseDate,
+			PurchasePrice: sale.PurchasePrice,
+			SoldOn:        sale.SoldOn,
+			SalePrice:     sale.SalePrice,
+			Fees:          sale.Fees,
+			Gain:          sale.Gain(),
+		})
+		report.Totals.Cost += sale.PurchasePrice
+		report.Totals.SalePrice += sale.SalePrice
+		report.Totals.Fees += sale.Fees
+		report.Totals.Gain += sale.Gain()
+	}
+
+	sort.SliceStable(report.Acquisitions, func(i, j int) bool {
+		a, b := report.Acquisitions[i], report.Acquisitions[j]
+		if a.PurchaseDate != b.PurchaseDate {
+			return a.PurchaseDate < b.PurchaseDate
+		}
+		return a.ItemID < b.ItemID
+	})
+	sort.SliceStable(report.Disposals, func(i, j int) bool {
+		a, b := report.Disposals[i], report.Disposals[j]
+		if a.SoldOn != b.SoldOn {
+			return a.SoldOn < b.SoldOn
+		}
+		return a.ItemID < b.ItemID
+	})
+
+	return report
+}
@@ -0,0 +1,124 @@
+package entity
+
+import (
+	"errors"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// アイテムの変更イベントの種別
+const (
+	EventItemCreated = "item.created"
+	EventItemUpdated = "item.updated"
+	EventItemDeleted = "item.deleted"
+	EventItemSold    = "item.sold"
+)
+
+var validItemEventTypes = []string{EventItemCreated, EventItemUpdated, EventItemDeleted, EventItemSold}
+
+// アイテムのイベントを受け取る外部エンドポイント
+type Webhook struct {
+	ID         int64     `json:"id"`
+	URL        string    `json:"url"`
+	Secret     string    `json:"-"` // 署名用の鍵なのでレスポンスには含めない
+	EventTypes []string  `json:"event_types"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// Webhookの送信結果（リトライごとに1件記録する）
+type WebhookDelivery struct {
+	ID           int64     `json:"id"`
+	WebhookID    int64     `json:"webhook_id"`
+	EventType    string    `json:"event_type"`
+	Payload      string    `json:"payload"`
+	Attempt      int       `json:"attempt"`
+	StatusCode   int       `json:"status_code"`
+	Success      bool      `json:"success"`
+	ErrorMessage string    `json:"error_message,omitempty"`
+	DeliveredAt  time.Time `json:"delivered_at"`
+}
+
+func NewWebhook(rawURL, secret string, eventTypes []string) (*Webhook, error) {
+	webhook := &Webhook{
+		URL:        strings.TrimSpace(rawURL),
+		Secret:     secret,
+		EventTypes: normalizeEventTypes(eventTypes),
+		CreatedAt:  time.Now(),
+	}
+
+	if err := webhook.Validate(); err != nil {
+		return nil, err
+	}
+
+	return webhook, nil
+}
+
+// Webhookフィールドのバリデーション
+func (w *Webhook) Validate() error {
+	var errs []string
+
+	if w.URL == "" {
+		errs = append(errs, "url is required")
+	} else if len(w.URL) > 2048 {
+		errs = append(errs, "url must be 2048 characters or less")
+	} else if parsed, err := url.Parse(w.URL); err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		errs = append(errs, "url must be an absolute http or https URL")
+	}
+
+	if len(w.Secret) < 16 {
+		errs = append(errs, "secret must be at least 16 characters")
+	} else if len(w.Secret) > 255 {
+		errs = append(errs, "secret must be 255 characters or less")
+	}
+
+	if len(w.EventTypes) == 0 {
+		errs = append(errs, "event_types is required")
+	}
+	for _, eventType := range w.EventTypes {
+		if !IsValidItemEventType(eventType) {
+			errs = append(errs, "event_types must be one of: "+strings.Join(validItemEventTypes, ", "))
+			break
+		}
+	}
+
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, ", "))
+	}
+
+	return nil
+}
+
+// 指定したイベントを購読しているか
+func (w *Webhook) Subscribes(eventType string) bool {
+	for _, t := range w.EventTypes {
+		if t == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+func IsValidItemEventType(eventType string) bool {
+	for _, t := range validItemEventTypes {
+		if t == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// 前後の空白を除いて重複をなくす
+func normalizeEventTypes(eventTypes []string) []string {
+	seen := make(map[string]bool, len(eventTypes))
+	normalized := make([]string, 0, len(eventTypes))
+	for _, eventType := range eventTypes {
+		eventType = strings.TrimSpace(eventType)
+		if eventType == "" || seen[eventType] {
+			continue
+		}
+		seen[eventType] = true
+		normalized = append(normalized, eventType)
+	}
+	return normalized
+}
@@ -3,20 +3,185 @@ package errors
 import "errors"
 
 var (
-	ErrItemNotFound   = errors.New("item not found")
-	ErrInvalidInput   = errors.New("invalid input")
-	ErrDatabaseError  = errors.New("database error")
-	ErrDuplicateEntry = errors.New("duplicate entry")
+	ErrItemNotFound        = errors.New("item not found")
+	ErrInvalidInput        = errors.New("invalid input")
+	ErrDatabaseError       = errors.New("database error")
+	ErrDatabaseUnavailable = errors.New("database is unavailable")
+	ErrDuplicateEntry      = errors.New("duplicate entry")
+	ErrLoanNotFound        = errors.New("loan not found")
+	ErrItemAlreadyOnLoan   = errors.New("item is already on loan")
+	ErrLoanAlreadyReturned = errors.New("loan is already returned")
+	ErrLocationNotFound    = errors.New("location not found")
+	ErrDuplicateSerial     = errors.New("serial number already exists")
+	ErrMultipleActiveLoans = errors.New("more than one of the items is on loan")
+	ErrTemplateNotFound    = errors.New("template not found")
+	ErrWebhookNotFound     = errors.New("webhook not found")
+	ErrRestoreNotEmpty     = errors.New("database already has data (use force to replace it)")
+	ErrQuotaExceeded       = errors.New("quota exceeded")
+	ErrFeatureNotFound     = errors.New("feature not found")
+	ErrErasureNotFound     = errors.New("erasure not found")
+	ErrShareLinkNotFound   = errors.New("share link not found")
+	ErrCommentNotFound     = errors.New("comment not found")
+	ErrRelationNotFound    = errors.New("relation not found")
+	ErrDuplicateRelation   = errors.New("relation already exists")
+	ErrSimilarBrand        = errors.New("brand is similar to an existing brand")
+	ErrJobNotFound         = errors.New("job not found")
+	ErrJobNotFailed        = errors.New("job has not failed")
+	ErrVersionConflict     = errors.New("item was modified by another request")
+	ErrSaleNotFound        = errors.New("sale not found")
+	ErrItemAlreadySold     = errors.New("item is already sold")
+	ErrInsuranceNotFound   = errors.New("insurance not found")
+	ErrUnsupportedListing  = errors.New("listing site is not supported")
+	ErrListingUnavailable  = errors.New("failed to fetch listing")
 )
 
+// クライアントがエラーを判別するためのコード（レスポンスに含めるため、一度決めたら変えない）
+// 1つのエラーに複数が含まれる場合は先に書いたものを返す
+var codes = []struct {
+	err  error
+	code string
+}{
+	{ErrItemNotFound, "item_not_found"},
+	{ErrLoanNotFound, "loan_not_found"},
+	{ErrLocationNotFound, "location_not_found"},
+	{ErrTemplateNotFound, "template_not_found"},
+	{ErrWebhookNotFound, "webhook_not_found"},
+	{ErrFeatureNotFound, "feature_not_found"},
+	{ErrErasureNotFound, "erasure_not_found"},
+	{ErrShareLinkNotFound, "share_link_not_found"},
+	{ErrCommentNotFound, "comment_not_found"},
+	{ErrRelationNotFound, "relation_not_found"},
+	{ErrJobNotFound, "job_not_found"},
+	{ErrSaleNotFound, "sale_not_found"},
+	{ErrInsuranceNotFound, "insurance_not_found"},
+	{ErrItemAlreadyOnLoan, "item_already_on_loan"},
+	{ErrLoanAlreadyReturned, "loan_already_returned"},
+	{ErrDuplicateSerial, "duplicate_serial_number"},
+	{ErrMultipleActiveLoans, "multiple_active_loans"},
+	{ErrDuplicateRelation, "duplicate_relation"},
+	{ErrSimilarBrand, "similar_brand"},
+	{ErrJobNotFailed, "job_not_failed"},
+	{ErrVersionConflict, "version_conflict"},
+	{ErrItemAlreadySold, "item_already_sold"},
+	{ErrRestoreNotEmpty, "restore_not_empty"},
+	{ErrUnsupportedListing, "unsupported_listing_site"},
+	{ErrListingUnavailable, "listing_unavailable"},
+	{ErrQuotaExceeded, "quota_exceeded"},
+	{ErrDuplicateEntry, "duplicate_entry"},
+	{ErrInvalidInput, "invalid_input"},
+	{ErrDatabaseUnavailable, "database_unavailable"},
+	{ErrDatabaseError, "database_error"},
+}
+
+// エラーのコードを返す（このパッケージのエラーを含まない場合は空文字）
+func Code(err error) string {
+	for _, c := range codes {
+		if errors.Is(err, c.err) {
+			return c.code
+		}
+	}
+	return ""
+}
+
+// 全てのエラーのコード
+func Codes() []string {
+	result := make([]string, 0, len(codes))
+	for _, c := range codes {
+		result = append(result, c.code)
+	}
+	return result
+}
+
 func IsNotFoundError(err error) bool {
 	return errors.Is(err, ErrItemNotFound)
 }
 
+func IsLoanNotFoundError(err error) bool {
+	return errors.Is(err, ErrLoanNotFound)
+}
+
+func IsLocationNotFoundError(err error) bool {
+	return errors.Is(err, ErrLocationNotFound)
+}
+
+func IsTemplateNotFoundError(err error) bool {
+	return errors.Is(err, ErrTemplateNotFound)
+}
+
+func IsWebhookNotFoundError(err error) bool {
+	return errors.Is(err, ErrWebhookNotFound)
+}
+
+func IsFeatureNotFoundError(err error) bool {
+	return errors.Is(err, ErrFeatureNotFound)
+}
+
+func IsErasureNotFoundError(err error) bool {
+	return errors.Is(err, ErrErasureNotFound)
+}
+
+func IsShareLinkNotFoundError(err error) bool {
+	return errors.Is(err, ErrShareLinkNotFound)
+}
+
+func IsCommentNotFoundError(err error) bool {
+	return errors.Is(err, ErrCommentNotFound)
+}
+
+func IsRelationNotFoundError(err error) bool {
+	return errors.Is(err, ErrRelationNotFound)
+}
+
+func IsJobNotFoundError(err error) bool {
+	return errors.Is(err, ErrJobNotFound)
+}
+
+func IsSaleNotFoundError(err error) bool {
+	return errors.Is(err, ErrSaleNotFound)
+}
+
+func IsInsuranceNotFoundError(err error) bool {
+	return errors.Is(err, ErrInsuranceNotFound)
+}
+
+// 対応していないサイトのURLかどうか
+func IsUnsupportedListingError(err error) bool {
+	return errors.Is(err, ErrUnsupportedListing)
+}
+
+// 出品ページを取得・解析できなかったかどうか
+func IsListingUnavailableError(err error) bool {
+	return errors.Is(err, ErrListingUnavailable)
+}
+
+// データベースに接続できず、時間を置けば成功しうるかどうか
+func IsDatabaseUnavailableError(err error) bool {
+	return errors.Is(err, ErrDatabaseUnavailable)
+}
+
 func IsDatabaseError(err error) bool {
 	return errors.Is(err, ErrDatabaseError)
 }
 
+// 利用量の上限を超える操作かどうか
+func IsQuotaExceededError(err error) bool {
+	return errors.Is(err, ErrQuotaExceeded)
+}
+
 func IsValidationError(err error) bool {
 	return errors.Is(err, ErrInvalidInput)
 }
+
+// 現在の状態と矛盾する操作かどうか
+func IsConflictError(err error) bool {
+	return errors.Is(err, ErrItemAlreadyOnLoan) ||
+		errors.Is(err, ErrLoanAlreadyReturned) ||
+		errors.Is(err, ErrDuplicateSerial) ||
+		errors.Is(err, ErrMultipleActiveLoans) ||
+		errors.Is(err, ErrDuplicateRelation) ||
+		errors.Is(err, ErrSimilarBrand) ||
+		errors.Is(err, ErrJobNotFailed) ||
+		errors.Is(err, ErrVersionConflict) ||
+		errors.Is(err, ErrItemAlreadySold) ||
+		errors.Is(err, ErrRestoreNotEmpty)
+}
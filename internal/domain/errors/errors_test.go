@@ -0,0 +1,37 @@
+package errors
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCode(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want string
+	}{
+		{name: "正常系: そのままのエラー", err: ErrItemNotFound, want: "item_not_found"},
+		{name: "正常系: ラップしたエラー", err: fmt.Errorf("%w: name is required", ErrInvalidInput), want: "invalid_input"},
+		{name: "正常系: 複数含む場合は個別のエラーを優先する", err: errors.Join(ErrDatabaseError, ErrDuplicateSerial), want: "duplicate_serial_number"},
+		{name: "異常系: このパッケージ以外のエラー", err: errors.New("boom"), want: ""},
+		{name: "異常系: nil", err: nil, want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Code(tt.err))
+		})
+	}
+}
+
+func TestCodes(t *testing.T) {
+	seen := map[string]bool{}
+	for _, code := range Codes() {
+		assert.False(t, seen[code], "duplicated code %s", code)
+		seen[code] = true
+	}
+}
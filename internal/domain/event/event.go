@@ -0,0 +1,85 @@
+package event
+
+import (
+	"fmt"
+	"time"
+
+	"Aicon-assignment/internal/domain/entity"
+)
+
+// ドメインで起きた出来事（購読側は Name で種類を判別する）
+type Event interface {
+	Name() string
+	OccurredAt() time.Time
+}
+
+// アイテムに関するイベント
+type ItemEvent interface {
+	Event
+	Item() *entity.Item
+}
+
+type itemEvent struct {
+	item       *entity.Item
+	occurredAt time.Time
+}
+
+func (e itemEvent) Item() *entity.Item {
+	return e.item
+}
+
+func (e itemEvent) OccurredAt() time.Time {
+	return e.occurredAt
+}
+
+// アイテムが登録された（複製・テンプレートからの登録を含む）
+type ItemCreated struct{ itemEvent }
+
+func NewItemCreated(item *entity.Item) ItemCreated {
+	return ItemCreated{itemEvent{item: item, occurredAt: time.Now()}}
+}
+
+func (ItemCreated) Name() string { return entity.EventItemCreated }
+
+// アイテムが更新された（更新後のアイテムを持つ）
+type ItemUpdated struct{ itemEvent }
+
+func NewItemUpdated(item *entity.Item) ItemUpdated {
+	return ItemUpdated{itemEvent{item: item, occurredAt: time.Now()}}
+}
+
+func (ItemUpdated) Name() string { return entity.EventItemUpdated }
+
+// アイテムが削除された（削除前のアイテムを持つ）
+type ItemDeleted struct{ itemEvent }
+
+func NewItemDeleted(item *entity.Item) ItemDeleted {
+	return ItemDeleted{itemEvent{item: item, occurredAt: time.Now()}}
+}
+
+func (ItemDeleted) Name() string { return entity.EventItemDeleted }
+
+// アイテムが売却された（売却を記録してアーカイブした後のアイテムを持つ）
+type ItemSold struct{ itemEvent }
+
+func NewItemSold(item *entity.Item) ItemSold {
+	return ItemSold{itemEvent{item: item, occurredAt: time.Now()}}
+}
+
+func (ItemSold) Name() string { return entity.EventItemSold }
+
+// 保存しておいたイベント（アウトボックスなど）を復元する
+func RestoreItemEvent(name string, item *entity.Item, occurredAt time.Time) (ItemEvent, error) {
+	base := itemEvent{item: item, occurredAt: occurredAt}
+	switch name {
+	case entity.EventItemCreated:
+		return ItemCreated{base}, nil
+	case entity.EventItemUpdated:
+		return ItemUpdated{base}, nil
+	case entity.EventItemDeleted:
+		return ItemDeleted{base}, nil
+	case entity.EventItemSold:
+		return ItemSold{base}, nil
+	}
+	return nil, fmt.Errorf("unknown item event: %s", name)
+}
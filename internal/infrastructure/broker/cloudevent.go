@@ -0,0 +1,62 @@
+package broker
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"Aicon-assignment/internal/domain/event"
+)
+
+const (
+	cloudEventSpecVersion = "1.0"
+	cloudEventSource      = "/aicon-assignment/items"
+)
+
+// CloudEvents 1.0 の構造化モード（JSON）の形式
+type CloudEvent struct {
+	SpecVersion     string      `json:"specversion"`
+	ID              string      `json:"id"`
+	Source          string      `json:"source"`
+	Type            string      `json:"type"`
+	Subject         string      `json:"subject,omitempty"`
+	Time            time.Time   `json:"time"`
+	DataContentType string      `json:"datacontenttype"`
+	Data            interface{} `json:"data"`
+}
+
+// ドメインイベントをCloudEventsのJSONに変換する
+func MarshalCloudEvent(e event.Event) ([]byte, error) {
+	id, err := newEventID()
+	if err != nil {
+		return nil, err
+	}
+
+	cloudEvent := CloudEvent{
+		SpecVersion:     cloudEventSpecVersion,
+		ID:              id,
+		Source:          cloudEventSource,
+		Type:            e.Name(),
+		Time:            e.OccurredAt(),
+		DataContentType: "application/json",
+		Data:            e,
+	}
+	if itemEvent, ok := e.(event.ItemEvent); ok {
+		cloudEvent.Subject = fmt.Sprintf("items/%d", itemEvent.Item().ID)
+		cloudEvent.Data = itemEvent.Item()
+	}
+
+	return json.Marshal(cloudEvent)
+}
+
+// ランダムなUUID（バージョン4）を返す
+func newEventID() (string, error) {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return "", err
+	}
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16]), nil
+}
@@ -0,0 +1,31 @@
+package broker
+
+import (
+	"encoding/json"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"Aicon-assignment/internal/domain/entity"
+	"Aicon-assignment/internal/domain/event"
+)
+
+func TestMarshalCloudEvent(t *testing.T) {
+	e := event.NewItemUpdated(&entity.Item{ID: 3, Name: "ティファニー ネックレス"})
+
+	data, err := MarshalCloudEvent(e)
+	require.NoError(t, err)
+
+	var decoded map[string]interface{}
+	require.NoError(t, json.Unmarshal(data, &decoded))
+
+	assert.Equal(t, "1.0", decoded["specversion"])
+	assert.Regexp(t, regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`), decoded["id"])
+	assert.Equal(t, "/aicon-assignment/items", decoded["source"])
+	assert.Equal(t, "item.updated", decoded["type"])
+	assert.Equal(t, "items/3", decoded["subject"])
+	assert.Equal(t, "application/json", decoded["datacontenttype"])
+	assert.Equal(t, "ティファニー ネックレス", decoded["data"].(map[string]interface{})["name"])
+}
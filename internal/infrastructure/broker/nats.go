@@ -0,0 +1,53 @@
+package broker
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/nats-io/nats.go"
+
+	"Aicon-assignment/internal/domain/event"
+)
+
+// ドメインイベントをNATSに送る（サブジェクトは <接頭辞>.<イベント種別>）
+type NATSPublisher struct {
+	conn          *nats.Conn
+	subjectPrefix string
+}
+
+func NewNATSPublisher(url, subjectPrefix string) (*NATSPublisher, error) {
+	conn, err := nats.Connect(url,
+		nats.Name("aicon-assignment"),
+		nats.MaxReconnects(-1),
+		nats.ReconnectWait(2*time.Second),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &NATSPublisher{
+		conn:          conn,
+		subjectPrefix: subjectPrefix,
+	}, nil
+}
+
+// イベントバスの購読者（切断中はクライアントのバッファに溜まり、再接続後に送られる）
+func (p *NATSPublisher) HandleEvent(_ context.Context, e event.Event) {
+	data, err := MarshalCloudEvent(e)
+	if err != nil {
+		log.Printf("failed to encode cloud event %s: %v", e.Name(), err)
+		return
+	}
+
+	if err := p.conn.Publish(p.subjectPrefix+"."+e.Name(), data); err != nil {
+		log.Printf("failed to publish %s to NATS: %v", e.Name(), err)
+	}
+}
+
+// 未送信のメッセージを送り切ってから切断する
+func (p *NATSPublisher) Close() {
+	if err := p.conn.Drain(); err != nil {
+		log.Printf("failed to drain NATS connection: %v", err)
+	}
+}
@@ -0,0 +1,63 @@
+package cache
+
+import (
+	"context"
+
+	"Aicon-assignment/internal/domain/entity"
+	"Aicon-assignment/internal/usecase"
+)
+
+// 復元や全データの削除で置き換わったアイテムと集計のキーを削除する
+type BackupRepository struct {
+	usecase.BackupRepository
+	store Store
+}
+
+func NewBackupRepository(repo usecase.BackupRepository, store Store) *BackupRepository {
+	return &BackupRepository{
+		BackupRepository: repo,
+		store:            store,
+	}
+}
+
+func (r *BackupRepository) Restore(ctx context.Context, backup *entity.Backup, replace bool) error {
+	// 置き換える場合は、復元後に存在しなくなるアイテムのキーも削除するため先に読んでおく
+	var replaced []*entity.Item
+	if replace {
+		current, err := r.BackupRepository.Dump(ctx)
+		if err != nil {
+			return err
+		}
+		replaced = current.Items
+	}
+
+	if err := r.BackupRepository.Restore(ctx, backup, replace); err != nil {
+		return err
+	}
+
+	keys := []string{categorySummaryKey, conditionSummaryKey}
+	for _, item := range append(replaced, backup.Items...) {
+		keys = append(keys, itemKey(item.ID))
+	}
+	deleteKeys(ctx, r.store, keys...)
+	return nil
+}
+
+func (r *BackupRepository) Erase(ctx context.Context) error {
+	// 削除済みのアイテムは Dump に含まれないが、キャッシュにも載らないため読まなくてよい
+	current, err := r.BackupRepository.Dump(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := r.BackupRepository.Erase(ctx); err != nil {
+		return err
+	}
+
+	keys := []string{categorySummaryKey, conditionSummaryKey}
+	for _, item := range current.Items {
+		keys = append(keys, itemKey(item.ID))
+	}
+	deleteKeys(ctx, r.store, keys...)
+	return nil
+}
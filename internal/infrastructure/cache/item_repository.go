@@ -0,0 +1,190 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"Aicon-assignment/internal/domain/entity"
+	"Aicon-assignment/internal/usecase"
+)
+
+const (
+	categorySummaryKey  = "items:summary:category"
+	conditionSummaryKey = "items:summary:condition"
+)
+
+func itemKey(id int64) string {
+	return fmt.Sprintf("items:%d", id)
+}
+
+// アイテムの取得と集計をキャッシュするリポジトリのデコレーター
+// 変更系はそのまま委譲し、影響するキーを削除する。キャッシュの障害時はリポジトリから直接読む
+type ItemRepository struct {
+	usecase.ItemRepository
+	store      Store
+	itemTTL    time.Duration
+	summaryTTL time.Duration
+
+	// トランザクション内ではキャッシュを読み書きせず、削除するキーをここに溜めて終了後に削除する
+	pending *pendingKeys
+}
+
+func NewItemRepository(repo usecase.ItemRepository, store Store, itemTTL, summaryTTL time.Duration) *ItemRepository {
+	return &ItemRepository{
+		ItemRepository: repo,
+		store:          store,
+		itemTTL:        itemTTL,
+		summaryTTL:     summaryTTL,
+	}
+}
+
+func (r *ItemRepository) FindByID(ctx context.Context, id int64) (*entity.Item, error) {
+	var item entity.Item
+	if r.load(ctx, itemKey(id), &item) {
+		return &item, nil
+	}
+
+	found, err := r.ItemRepository.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	r.save(ctx, itemKey(id), found, r.itemTTL)
+	return found, nil
+}
+
+func (r *ItemRepository) GetSummaryByCategory(ctx context.Context) (map[string]int, error) {
+	return r.summary(ctx, categorySummaryKey, r.ItemRepository.GetSummaryByCategory)
+}
+
+func (r *ItemRepository) GetSummaryByCondition(ctx context.Context) (map[string]int, error) {
+	return r.summary(ctx, conditionSummaryKey, r.ItemRepository.GetSummaryByCondition)
+}
+
+func (r *ItemRepository) Create(ctx context.Context, item *entity.Item) (*entity.Item, error) {
+	created, err := r.ItemRepository.Create(ctx, item)
+	if err != nil {
+		return nil, err
+	}
+
+	r.invalidate(ctx)
+	return created, nil
+}
+
+func (r *ItemRepository) Update(ctx context.Context, item *entity.Item) error {
+	if err := r.ItemRepository.Update(ctx, item); err != nil {
+		return err
+	}
+
+	r.invalidate(ctx, item.ID)
+	return nil
+}
+
+func (r *ItemRepository) Delete(ctx context.Context, id int64) error {
+	if err := r.ItemRepository.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	r.invalidate(ctx, id)
+	return nil
+}
+
+func (r *ItemRepository) Merge(ctx context.Context, survivorID int64, duplicateIDs []int64) error {
+	if err := r.ItemRepository.Merge(ctx, survivorID, duplicateIDs); err != nil {
+		return err
+	}
+
+	r.invalidate(ctx, append([]int64{survivorID}, duplicateIDs...)...)
+	return nil
+}
+
+func (r *ItemRepository) ReassignCategory(ctx context.Context, from, to string) ([]int64, error) {
+	ids, err := r.ItemRepository.ReassignCategory(ctx, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	r.invalidate(ctx, ids...)
+	return ids, nil
+}
+
+func (r *ItemRepository) summary(ctx context.Context, key string, load func(context.Context) (map[string]int, error)) (map[string]int, error) {
+	var counts map[string]int
+	if r.load(ctx, key, &counts) {
+		return counts, nil
+	}
+
+	counts, err := load(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	r.save(ctx, key, counts, r.summaryTTL)
+	return counts, nil
+}
+
+// キャッシュにあれば dest に読み込んで true を返す
+func (r *ItemRepository) load(ctx context.Context, key string, dest interface{}) bool {
+	if r.pending != nil {
+		return false
+	}
+
+	value, found, err := r.store.Get(ctx, key)
+	if err != nil {
+		log.Printf("failed to read cache %s: %v", key, err)
+		return false
+	}
+	if !found {
+		return false
+	}
+
+	if err := json.Unmarshal(value, dest); err != nil {
+		log.Printf("failed to decode cache %s: %v", key, err)
+		return false
+	}
+	return true
+}
+
+func (r *ItemRepository) save(ctx context.Context, key string, value interface{}, ttl time.Duration) {
+	if r.pending != nil {
+		return
+	}
+
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		log.Printf("failed to encode cache %s: %v", key, err)
+		return
+	}
+
+	if err := r.store.Set(ctx, key, encoded, ttl); err != nil {
+		log.Printf("failed to write cache %s: %v", key, err)
+	}
+}
+
+// 集計は件数が変わりうるので常に削除する
+func (r *ItemRepository) invalidate(ctx context.Context, ids ...int64) {
+	keys := []string{categorySummaryKey, conditionSummaryKey}
+	for _, id := range ids {
+		keys = append(keys, itemKey(id))
+	}
+
+	if r.pending != nil {
+		r.pending.add(keys...)
+		return
+	}
+	deleteKeys(ctx, r.store, keys...)
+}
+
+// 集計のキャッシュを削除して、次の取得で保存先から集計し直させる
+func InvalidateSummaries(ctx context.Context, store Store) error {
+	return store.Delete(ctx, categorySummaryKey, conditionSummaryKey)
+}
+
+func deleteKeys(ctx context.Context, store Store, keys ...string) {
+	if err := store.Delete(ctx, keys...); err != nil {
+		log.Printf("failed to invalidate cache: %v", err)
+	}
+}
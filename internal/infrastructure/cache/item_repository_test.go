@@ -0,0 +1,225 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"Aicon-assignment/internal/domain/entity"
+	domainErrors "Aicon-assignment/internal/domain/errors"
+	"Aicon-assignment/internal/usecase"
+)
+
+// memoryStore はテスト用のメモリ上のキャッシュ
+type memoryStore struct {
+	mu      sync.Mutex
+	values  map[string][]byte
+	failing bool
+}
+
+func newMemoryStore() *memoryStore {
+	return &memoryStore{values: make(map[string][]byte)}
+}
+
+func (s *memoryStore) Get(ctx context.Context, key string) ([]byte, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.failing {
+		return nil, false, errors.New("connection refused")
+	}
+	value, found := s.values[key]
+	return value, found, nil
+}
+
+func (s *memoryStore) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.failing {
+		return errors.New("connection refused")
+	}
+	s.values[key] = value
+	return nil
+}
+
+func (s *memoryStore) Delete(ctx context.Context, keys ...string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, key := range keys {
+		delete(s.values, key)
+	}
+	return nil
+}
+
+// MockItemRepository はtestify/mockを使用したモックリポジトリ（使うメソッドのみ実装）
+type MockItemRepository struct {
+	usecase.ItemRepository
+	mock.Mock
+}
+
+func (m *MockItemRepository) FindByID(ctx context.Context, id int64) (*entity.Item, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.Item), args.Error(1)
+}
+
+func (m *MockItemRepository) Update(ctx context.Context, item *entity.Item) error {
+	args := m.Called(ctx, item)
+	return args.Error(0)
+}
+
+func (m *MockItemRepository) Create(ctx context.Context, item *entity.Item) (*entity.Item, error) {
+	args := m.Called(ctx, item)
+	return args.Get(0).(*entity.Item), args.Error(1)
+}
+
+func (m *MockItemRepository) GetSummaryByCategory(ctx context.Context) (map[string]int, error) {
+	args := m.Called(ctx)
+	return args.Get(0).(map[string]int), args.Error(1)
+}
+
+func TestItemRepository_FindByID(t *testing.T) {
+	item := &entity.Item{ID: 1, Name: "ロレックス デイトナ", Category: "時計"}
+
+	t.Run("正常系: 2回目以降はキャッシュから返す", func(t *testing.T) {
+		repo := new(MockItemRepository)
+		repo.On("FindByID", mock.Anything, int64(1)).Return(item, nil).Once()
+		cached := NewItemRepository(repo, newMemoryStore(), time.Minute, time.Minute)
+
+		for i := 0; i < 2; i++ {
+			found, err := cached.FindByID(context.Background(), 1)
+			require.NoError(t, err)
+			assert.Equal(t, "ロレックス デイトナ", found.Name)
+		}
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("正常系: 更新するとキャッシュを破棄する", func(t *testing.T) {
+		repo := new(MockItemRepository)
+		updated := &entity.Item{ID: 1, Name: "ロレックス サブマリーナ", Category: "時計"}
+		repo.On("FindByID", mock.Anything, int64(1)).Return(item, nil).Once()
+		repo.On("Update", mock.Anything, updated).Return(nil)
+		repo.On("FindByID", mock.Anything, int64(1)).Return(updated, nil).Once()
+		cached := NewItemRepository(repo, newMemoryStore(), time.Minute, time.Minute)
+
+		_, err := cached.FindByID(context.Background(), 1)
+		require.NoError(t, err)
+		require.NoError(t, cached.Update(context.Background(), updated))
+
+		found, err := cached.FindByID(context.Background(), 1)
+		require.NoError(t, err)
+		assert.Equal(t, "ロレックス サブマリーナ", found.Name)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("異常系: 見つからない結果はキャッシュしない", func(t *testing.T) {
+		repo := new(MockItemRepository)
+		repo.On("FindByID", mock.Anything, int64(999)).Return(nil, domainErrors.ErrItemNotFound).Twice()
+		cached := NewItemRepository(repo, newMemoryStore(), time.Minute, time.Minute)
+
+		for i := 0; i < 2; i++ {
+			_, err := cached.FindByID(context.Background(), 999)
+			assert.ErrorIs(t, err, domainErrors.ErrItemNotFound)
+		}
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("異常系: キャッシュの障害時はリポジトリから読む", func(t *testing.T) {
+		repo := new(MockItemRepository)
+		repo.On("FindByID", mock.Anything, int64(1)).Return(item, nil)
+		store := newMemoryStore()
+		store.failing = true
+		cached := NewItemRepository(repo, store, time.Minute, time.Minute)
+
+		found, err := cached.FindByID(context.Background(), 1)
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), found.ID)
+	})
+}
+
+func TestItemRepository_GetSummaryByCategory(t *testing.T) {
+	t.Run("正常系: 登録すると集計のキャッシュを破棄する", func(t *testing.T) {
+		repo := new(MockItemRepository)
+		repo.On("GetSummaryByCategory", mock.Anything).Return(map[string]int{"時計": 1}, nil).Once()
+		repo.On("Create", mock.Anything, mock.Anything).Return(&entity.Item{ID: 2}, nil)
+		repo.On("GetSummaryByCategory", mock.Anything).Return(map[string]int{"時計": 2}, nil).Once()
+		cached := NewItemRepository(repo, newMemoryStore(), time.Minute, time.Minute)
+
+		first, err := cached.GetSummaryByCategory(context.Background())
+		require.NoError(t, err)
+		again, err := cached.GetSummaryByCategory(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, first, again)
+
+		_, err = cached.Create(context.Background(), &entity.Item{Name: "ロレックス GMTマスター"})
+		require.NoError(t, err)
+
+		refreshed, err := cached.GetSummaryByCategory(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, 2, refreshed["時計"])
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("正常系: InvalidateSummariesの後は集計し直す", func(t *testing.T) {
+		repo := new(MockItemRepository)
+		repo.On("GetSummaryByCategory", mock.Anything).Return(map[string]int{"時計": 1}, nil).Once()
+		repo.On("GetSummaryByCategory", mock.Anything).Return(map[string]int{"時計": 3}, nil).Once()
+		store := newMemoryStore()
+		cached := NewItemRepository(repo, store, time.Minute, time.Minute)
+
+		_, err := cached.GetSummaryByCategory(context.Background())
+		require.NoError(t, err)
+		require.NoError(t, InvalidateSummaries(context.Background(), store))
+
+		refreshed, err := cached.GetSummaryByCategory(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, 3, refreshed["時計"])
+		repo.AssertExpectations(t)
+	})
+}
+
+// directTransactor はトランザクションを使わず、渡されたリポジトリでそのまま実行する
+type directTransactor struct {
+	repos usecase.Repositories
+}
+
+func (d *directTransactor) WithTx(ctx context.Context, fn func(repos usecase.Repositories) error) error {
+	return fn(d.repos)
+}
+
+func TestTransactor_WithTx(t *testing.T) {
+	t.Run("正常系: トランザクション内はキャッシュを使わず、終了後に変更したキーを破棄する", func(t *testing.T) {
+		ctx := context.Background()
+		item := &entity.Item{ID: 1, Name: "ロレックス デイトナ", Category: "時計"}
+		updated := &entity.Item{ID: 1, Name: "ロレックス サブマリーナ", Category: "時計"}
+		repo := new(MockItemRepository)
+		repo.On("FindByID", mock.Anything, int64(1)).Return(item, nil).Twice()
+		repo.On("Update", mock.Anything, updated).Return(nil)
+		store := newMemoryStore()
+		cached := NewItemRepository(repo, store, time.Minute, time.Minute)
+		transactor := NewTransactor(&directTransactor{repos: usecase.Repositories{Items: repo}}, store, time.Minute, time.Minute)
+
+		_, err := cached.FindByID(ctx, 1)
+		require.NoError(t, err)
+
+		err = transactor.WithTx(ctx, func(repos usecase.Repositories) error {
+			if _, err := repos.Items.FindByID(ctx, 1); err != nil {
+				return err
+			}
+			require.Contains(t, store.values, itemKey(1))
+			return repos.Items.Update(ctx, updated)
+		})
+		require.NoError(t, err)
+
+		assert.NotContains(t, store.values, itemKey(1))
+		repo.AssertExpectations(t)
+	})
+}
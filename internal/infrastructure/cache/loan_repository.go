@@ -0,0 +1,51 @@
+package cache
+
+import (
+	"context"
+
+	"Aicon-assignment/internal/domain/entity"
+	"Aicon-assignment/internal/usecase"
+)
+
+// 貸出状態（on_loan）はアイテムのキャッシュに含まれるため、貸出・返却時に該当アイテムのキーを削除する
+type LoanRepository struct {
+	usecase.LoanRepository
+	store Store
+
+	// ItemRepository.pending と同じ
+	pending *pendingKeys
+}
+
+func NewLoanRepository(repo usecase.LoanRepository, store Store) *LoanRepository {
+	return &LoanRepository{
+		LoanRepository: repo,
+		store:          store,
+	}
+}
+
+func (r *LoanRepository) Create(ctx context.Context, loan *entity.Loan) (*entity.Loan, error) {
+	created, err := r.LoanRepository.Create(ctx, loan)
+	if err != nil {
+		return nil, err
+	}
+
+	r.invalidate(ctx, loan.ItemID)
+	return created, nil
+}
+
+func (r *LoanRepository) Update(ctx context.Context, loan *entity.Loan) error {
+	if err := r.LoanRepository.Update(ctx, loan); err != nil {
+		return err
+	}
+
+	r.invalidate(ctx, loan.ItemID)
+	return nil
+}
+
+func (r *LoanRepository) invalidate(ctx context.Context, itemID int64) {
+	if r.pending != nil {
+		r.pending.add(itemKey(itemID))
+		return
+	}
+	deleteKeys(ctx, r.store, itemKey(itemID))
+}
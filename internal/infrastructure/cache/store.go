@@ -0,0 +1,66 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// キャッシュの保存先
+type Store interface {
+	// キーが無い場合は found が false になる
+	Get(ctx context.Context, key string) (value []byte, found bool, err error)
+	Set(ctx context.Context, key string, value []byte, ttl time.Duration) error
+	Delete(ctx context.Context, keys ...string) error
+}
+
+// Redisを保存先にする
+type RedisStore struct {
+	client *redis.Client
+}
+
+// url は redis://[:password@]host:port/db 形式
+func NewRedisStore(url string) (*RedisStore, error) {
+	options, err := redis.ParseURL(url)
+	if err != nil {
+		return nil, err
+	}
+
+	client := redis.NewClient(options)
+	if err := client.Ping(context.Background()).Err(); err != nil {
+		client.Close()
+		return nil, err
+	}
+
+	return &RedisStore{client: client}, nil
+}
+
+func (s *RedisStore) Get(ctx context.Context, key string) ([]byte, bool, error) {
+	value, err := s.client.Get(ctx, key).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	return value, true, nil
+}
+
+func (s *RedisStore) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	return s.client.Set(ctx, key, value, ttl).Err()
+}
+
+func (s *RedisStore) Delete(ctx context.Context, keys ...string) error {
+	return s.client.Del(ctx, keys...).Err()
+}
+
+func (s *RedisStore) Close() error {
+	return s.client.Close()
+}
+
+// 接続できるか確認する（レディネスチェック用）
+func (s *RedisStore) Ping(ctx context.Context) error {
+	return s.client.Ping(ctx).Err()
+}
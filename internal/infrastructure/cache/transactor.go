@@ -0,0 +1,57 @@
+package cache
+
+import (
+	"context"
+	"time"
+
+	"Aicon-assignment/internal/usecase"
+)
+
+// トランザクション内のリポジトリの変更もキャッシュに反映するデコレーター
+// コミット前に削除すると、コミットまでの間に読まれた古い値がキャッシュに残るため、終了後にまとめて削除する
+type Transactor struct {
+	transactor usecase.Transactor
+	store      Store
+	itemTTL    time.Duration
+	summaryTTL time.Duration
+}
+
+func NewTransactor(transactor usecase.Transactor, store Store, itemTTL, summaryTTL time.Duration) *Transactor {
+	return &Transactor{
+		transactor: transactor,
+		store:      store,
+		itemTTL:    itemTTL,
+		summaryTTL: summaryTTL,
+	}
+}
+
+func (t *Transactor) WithTx(ctx context.Context, fn func(repos usecase.Repositories) error) error {
+	pending := &pendingKeys{}
+
+	err := t.transactor.WithTx(ctx, func(repos usecase.Repositories) error {
+		items := NewItemRepository(repos.Items, t.store, t.itemTTL, t.summaryTTL)
+		items.pending = pending
+		loans := NewLoanRepository(repos.Loans, t.store)
+		loans.pending = pending
+
+		repos.Items = items
+		repos.Loans = loans
+		return fn(repos)
+	})
+
+	// ロールバックされた場合も、削除して困ることはないのでそのまま削除する
+	if len(pending.keys) > 0 {
+		deleteKeys(ctx, t.store, pending.keys...)
+	}
+
+	return err
+}
+
+// トランザクション終了後に削除するキー
+type pendingKeys struct {
+	keys []string
+}
+
+func (p *pendingKeys) add(keys ...string) {
+	p.keys = append(p.keys, keys...)
+}
@@ -1,38 +1,840 @@
 package config
 
 import (
+	"errors"
 	"fmt"
 	"log"
+	"net"
+	"net/mail"
+	"net/url"
 	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/joho/godotenv"
-)
+	"gopkg.in/yaml.v3"
 
-var (
-	DBUser     string
-	DBPassword string
-	DBHost     string
-	DBName     string
-	DBPort     string
+	"Aicon-assignment/internal/domain/entity"
+	"Aicon-assignment/internal/interfaces/masking"
 )
 
-func init() {
-	err := godotenv.Load()
-	if err != nil {
+// アプリケーションの設定
+// 既定値 → YAMLファイル（CONFIG_FILE） → 環境変数 の順に読み、後のものを優先する
+type Config struct {
+	// HTTPとgRPCの待ち受けアドレス（":8080" の形式）
+	Port     string `yaml:"port"`
+	GRPCPort string `yaml:"grpc_port"`
+
+	// mysql（既定）、postgres、sqlite、memory（DBを使わずメモリ上に保存）のいずれか
+	Storage string `yaml:"storage"`
+
+	// SQLiteの保存先ファイル
+	SQLitePath string `yaml:"sqlite_path"`
+
+	// 起動時に未適用のマイグレーションを適用するか（false の場合は migrate サブコマンドで適用する）
+	MigrateOnStartup bool `yaml:"migrate_on_startup"`
+
+	// debug・info・warn・error のいずれか
+	LogLevel string `yaml:"log_level"`
+
+	// development（既定）、staging、production のいずれか。production ではサンプルデータの登録などを禁止する
+	Env string `yaml:"env"`
+
+	// 管理者用のエンドポイント（/admin）の認証に使うトークン。空の場合は /admin を公開しない
+	AdminToken string `yaml:"admin_token"`
+
+	// X-Forwarded-For を付け替えるリバースプロキシのアドレス（CIDRかIPアドレス）
+	// 空の場合はヘッダーを見ず、接続元のアドレスをクライアントのIPアドレスにする
+	TrustedProxies []string `yaml:"trusted_proxies"`
+
+	// リクエストの本文の上限（バイト）。復元はバックアップ全体を送るため別に設定する
+	MaxBodyBytes        int64 `yaml:"max_body_bytes"`
+	MaxRestoreBodyBytes int64 `yaml:"max_restore_body_bytes"`
+
+	Database DatabaseConfig `yaml:"database"`
+	Cache    CacheConfig    `yaml:"cache"`
+	NATS     NATSConfig     `yaml:"nats"`
+	Search   SearchConfig   `yaml:"search"`
+	Shutdown ShutdownConfig `yaml:"shutdown"`
+
+	RateLimit RateLimitConfig `yaml:"rate_limit"`
+	CORS      CORSConfig      `yaml:"cors"`
+	Quota     QuotaConfig     `yaml:"quota"`
+	Features  FeaturesConfig  `yaml:"features"`
+	Privacy   PrivacyConfig   `yaml:"privacy"`
+	Webhook   WebhookConfig   `yaml:"webhook"`
+	Scheduler SchedulerConfig `yaml:"scheduler"`
+
+	Notification NotificationConfig `yaml:"notification"`
+
+	RequestTimeout RequestTimeoutConfig `yaml:"request_timeout"`
+
+	PublicCatalog PublicCatalogConfig `yaml:"public_catalog"`
+	Masking       MaskingConfig       `yaml:"masking"`
+
+	// OTLPの送信先（OTEL_EXPORTER_OTLP_ENDPOINT など）が設定されている場合のみトレースを送る
+	// 送信先以外の設定もOpenTelemetryの標準の環境変数をそのまま使うため、YAMLでは指定しない
+	TracingEnabled bool `yaml:"-"`
+}
+
+type DatabaseConfig struct {
+	Host     string `yaml:"host"`
+	Port     string `yaml:"port"`
+	User     string `yaml:"user"`
+	Password string `yaml:"password"`
+	Name     string `yaml:"name"`
+
+	// PostgreSQLの接続文字列（空の場合はHostなどから組み立てる）
+	URL string `yaml:"url"`
+
+	// リードレプリカの接続文字列（空の場合は読み取りもプライマリで行う）
+	ReplicaDSNs []string `yaml:"replica_dsns"`
+
+	Pool PoolConfig `yaml:"pool"`
+
+	// 1つの問い合わせの制限時間（0の場合は制限しない）
+	QueryTimeout time.Duration `yaml:"query_timeout"`
+
+	// これ以上かかった問い合わせをログに出力し、メトリクスで数える（0の場合は出力しない）
+	SlowQueryThreshold time.Duration `yaml:"slow_query_threshold"`
+
+	Retry RetryConfig `yaml:"retry"`
+}
+
+// 接続プール（0の場合は上限なし・期限なし）
+type PoolConfig struct {
+	MaxOpenConns    int           `yaml:"max_open_conns"`
+	MaxIdleConns    int           `yaml:"max_idle_conns"`
+	ConnMaxLifetime time.Duration `yaml:"conn_max_lifetime"`
+}
+
+type RetryConfig struct {
+	// デッドロックや接続エラーの再試行（MaxRetriesが0の場合は再試行しない）
+	MaxRetries int           `yaml:"max_retries"`
+	BaseDelay  time.Duration `yaml:"base_delay"`
+	MaxDelay   time.Duration `yaml:"max_delay"`
+
+	// 接続エラーがこの回数続いたら BreakerOpenDuration の間は接続せずに失敗させる（0の場合は使わない）
+	BreakerThreshold    int           `yaml:"breaker_threshold"`
+	BreakerOpenDuration time.Duration `yaml:"breaker_open_duration"`
+}
+
+type CacheConfig struct {
+	// 空の場合はキャッシュを使わない
+	RedisURL   string        `yaml:"redis_url"`
+	ItemTTL    time.Duration `yaml:"item_ttl"`
+	SummaryTTL time.Duration `yaml:"summary_ttl"`
+}
+
+type NATSConfig struct {
+	// 空の場合はメッセージブローカーに送らない
+	URL     string `yaml:"url"`
+	Subject string `yaml:"subject"`
+}
+
+type SearchConfig struct {
+	// OpenSearch（Elasticsearch互換）のURL。空の場合は検索インデックスを使わず、データベースの部分一致で検索する
+	URL   string `yaml:"url"`
+	Index string `yaml:"index"`
+}
+
+type ShutdownConfig struct {
+	// 停止時に処理中のリクエストとバックグラウンド処理を待つ上限
+	Timeout time.Duration `yaml:"timeout"`
+
+	// 停止を始めてから新しい接続を断るまでの待ち時間（レディネスチェックの失敗がロードバランサーに伝わるのを待つ）
+	Delay time.Duration `yaml:"delay"`
+}
+
+// リクエストの制限時間（0の場合は制限しない）
+// 過ぎた場合は処理中の問い合わせを打ち切り、504を返す
+type RequestTimeoutConfig struct {
+	Read  time.Duration `yaml:"read"`  // GET・HEAD
+	Write time.Duration `yaml:"write"` // それ以外のメソッド
+
+	// エクスポート・バックアップ・復元・統合など、多くのデータを扱う重いエンドポイント
+	Export time.Duration `yaml:"export"`
+}
+
+// クライアント（認証済みならユーザー、それ以外はIPアドレス）ごとのリクエスト数の上限
+// PerMinuteが0の場合は制限しない。Burstは続けて送れる数
+type RateLimitConfig struct {
+	PerMinute int `yaml:"per_minute"`
+	Burst     int `yaml:"burst"`
+
+	// エクスポートや一括操作など重いエンドポイントには、全体の上限とは別により厳しい上限を設ける
+	ExpensivePerMinute int `yaml:"expensive_per_minute"`
+	ExpensiveBurst     int `yaml:"expensive_burst"`
+}
+
+// ブラウザからの呼び出しを許可するオリジン（"https://app.example.com" の形式、"*" で全て）
+// 空の場合はCORSのヘッダーを返さない
+type CORSConfig struct {
+	AllowedOrigins []string      `yaml:"allowed_origins"`
+	AllowedMethods []string      `yaml:"allowed_methods"`
+	AllowedHeaders []string      `yaml:"allowed_headers"`
+	MaxAge         time.Duration `yaml:"max_age"`
+}
+
+// 利用量の上限（0の場合は上限なし）
+// 利用者ごとのアカウントはないため、保存先全体に対する上限になる
+type QuotaConfig struct {
+	MaxItems int `yaml:"max_items"`
+}
+
+// 機能ごとの有効・無効（含まれない機能は有効）
+// 管理者用のエンドポイントで切り替えた値がある場合はそちらを優先する
+type FeaturesConfig struct {
+	Flags map[string]bool `yaml:"flags"`
+
+	// 他のインスタンスで切り替えた値を読み直す間隔
+	RefreshInterval time.Duration `yaml:"refresh_interval"`
+}
+
+// 利用者のデータの削除
+type PrivacyConfig struct {
+	// DELETE /me から実際に削除するまでの猶予期間（この間は取り消せる）
+	ErasureGracePeriod time.Duration `yaml:"erasure_grace_period"`
+}
+
+// Webhookの送信
+type WebhookConfig struct {
+	// ループバック・プライベート・リンクローカルのアドレスへの登録と送信を許可するか（手元で受信側を動かす開発用）
+	AllowPrivateNetworks bool `yaml:"allow_private_networks"`
+}
+
+// 定期的に実行する保守のタスク
+type SchedulerConfig struct {
+	// タスクごとの有効・無効（含まれないタスクは有効）
+	Tasks map[string]bool `yaml:"tasks"`
+
+	// タスクごとの実行時刻（cron形式、サーバーのタイムゾーン）。含まれないタスクは既定の時刻に実行する
+	Schedules map[string]string `yaml:"schedules"`
+
+	// 統合で論理削除したアイテムを完全に削除するまでの期間
+	TrashRetention time.Duration `yaml:"trash_retention"`
+}
+
+// タスクの実行時刻（設定がない場合は既定の時刻）
+func (s SchedulerConfig) Schedule(name string) string {
+	if schedule, ok := s.Schedules[name]; ok {
+		return schedule
+	}
+	return entity.DefaultSchedules[name]
+}
+
+// タスクが有効か（設定がない場合は有効）
+func (s SchedulerConfig) Enabled(name string) bool {
+	enabled, ok := s.Tasks[name]
+	return !ok || enabled
+}
+
+// 利用者へのメールの通知（返却期限を過ぎた貸出など）
+// SMTPのホストが空の場合は送らずにログに出す
+type NotificationConfig struct {
+	SMTPHost     string `yaml:"smtp_host"`
+	SMTPPort     int    `yaml:"smtp_port"`
+	SMTPUsername string `yaml:"smtp_username"` // 空の場合は認証しない
+	SMTPPassword string `yaml:"smtp_password"`
+
+	// 差出人と送り先のアドレス
+	From string   `yaml:"from"`
+	To   []string `yaml:"to"`
+}
+
+// 認証なしで見られる読み取り専用のカタログ（/public/items）
+// 有効にした場合、それ以外のAPIには ADMIN_TOKEN を求める
+type PublicCatalogConfig struct {
+	Enabled bool `yaml:"enabled"`
+
+	// 公開するカテゴリー（空の場合は全て）
+	Categories []string `yaml:"categories"`
+
+	// 公開する項目（name, category, brand, condition, attributes から選ぶ。価格は公開できない）
+	Fields []string `yaml:"fields"`
+}
+
+// ログ・エラーのレスポンス・Webhookの送信ログで値を伏せる項目（JSONのキーとログの属性名）
+type MaskingConfig struct {
+	Fields []string `yaml:"fields"`
+}
+
+const minAdminTokenLength = 16
+
+// 何も設定しない場合の値
+func Default() *Config {
+	return &Config{
+		Port:                ":8080",
+		GRPCPort:            ":9090",
+		Storage:             "mysql",
+		SQLitePath:          "items.db",
+		MigrateOnStartup:    true,
+		LogLevel:            "info",
+		Env:                 "development",
+		MaxBodyBytes:        1 << 20,
+		MaxRestoreBodyBytes: 100 << 20,
+		Database: DatabaseConfig{
+			Pool: PoolConfig{
+				MaxOpenConns:    25,
+				MaxIdleConns:    25,
+				ConnMaxLifetime: 5 * time.Minute,
+			},
+			QueryTimeout:       10 * time.Second,
+			SlowQueryThreshold: 500 * time.Millisecond,
+			Retry: RetryConfig{
+				MaxRetries:          3,
+				BaseDelay:           50 * time.Millisecond,
+				MaxDelay:            time.Second,
+				BreakerThreshold:    5,
+				BreakerOpenDuration: 10 * time.Second,
+			},
+		},
+		Cache: CacheConfig{
+			ItemTTL:    5 * time.Minute,
+			SummaryTTL: 30 * time.Second,
+		},
+		NATS: NATSConfig{
+			Subject: "items.events",
+		},
+		Search: SearchConfig{
+			Index: "items",
+		},
+		Shutdown: ShutdownConfig{
+			Timeout: 30 * time.Second,
+		},
+		RequestTimeout: RequestTimeoutConfig{
+			Read:   15 * time.Second,
+			Write:  30 * time.Second,
+			Export: 5 * time.Minute,
+		},
+		RateLimit: RateLimitConfig{
+			PerMinute:          600,
+			Burst:              60,
+			ExpensivePerMinute: 10,
+			ExpensiveBurst:     3,
+		},
+		Features: FeaturesConfig{
+			RefreshInterval: 10 * time.Second,
+		},
+		Privacy: PrivacyConfig{
+			ErasureGracePeriod: 30 * 24 * time.Hour,
+		},
+		Scheduler: SchedulerConfig{
+			TrashRetention: 30 * 24 * time.Hour,
+		},
+		Notification: NotificationConfig{
+			SMTPPort: 587,
+		},
+		PublicCatalog: PublicCatalogConfig{
+			Fields: slices.Clone(entity.DefaultCatalogFields),
+		},
+		Masking: MaskingConfig{
+			Fields: slices.Clone(masking.DefaultFields),
+		},
+		CORS: CORSConfig{
+			AllowedMethods: []string{"GET", "HEAD", "POST", "PUT", "PATCH", "DELETE"},
+			AllowedHeaders: []string{"Content-Type", "Authorization", "X-Request-ID"},
+			MaxAge:         10 * time.Minute,
+		},
+	}
+}
+
+// .envがあれば読み込んだうえで設定を読み、誤りがあれば全てまとめて返す
+func Load() (*Config, error) {
+	if err := godotenv.Load(); err != nil {
 		log.Println("⚠️  .envファイルが見つかりませんでした。")
 	}
+	return load(os.LookupEnv)
+}
+
+func load(lookup func(key string) (string, bool)) (*Config, error) {
+	cfg := Default()
+
+	if path, ok := lookup("CONFIG_FILE"); ok && path != "" {
+		if err := cfg.loadFile(path); err != nil {
+			return nil, err
+		}
+	}
+
+	env := &envReader{lookup: lookup}
+	cfg.readEnv(env)
+	if len(env.errs) > 0 {
+		return nil, fmt.Errorf("invalid configuration:\n%w", errors.Join(env.errs...))
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+// 知らないキーは書き間違いとして扱う
+func (c *Config) loadFile(path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("failed to open config file: %w", err)
+	}
+	defer file.Close()
+
+	decoder := yaml.NewDecoder(file)
+	decoder.KnownFields(true)
+	if err := decoder.Decode(c); err != nil {
+		return fmt.Errorf("failed to parse config file %s: %w", path, err)
+	}
+	return nil
+}
+
+func (c *Config) readEnv(env *envReader) {
+	env.string("PORT", &c.Port)
+	env.string("GRPC_PORT", &c.GRPCPort)
+	env.string("STORAGE", &c.Storage)
+	env.string("SQLITE_PATH", &c.SQLitePath)
+	env.bool("MIGRATE_ON_STARTUP", &c.MigrateOnStartup)
+	env.string("LOG_LEVEL", &c.LogLevel)
+	env.string("APP_ENV", &c.Env)
+	env.string("ADMIN_TOKEN", &c.AdminToken)
+	env.list("TRUSTED_PROXIES", &c.TrustedProxies)
+	env.int64("MAX_BODY_BYTES", &c.MaxBodyBytes)
+	env.int64("MAX_RESTORE_BODY_BYTES", &c.MaxRestoreBodyBytes)
+
+	db := &c.Database
+	env.string("DB_HOST", &db.Host)
+	env.string("DB_PORT", &db.Port)
+	env.string("DB_USER", &db.User)
+	env.string("DB_PASSWORD", &db.Password)
+	env.string("DB_NAME", &db.Name)
+	env.string("DATABASE_URL", &db.URL)
+	env.list("DB_REPLICA_DSNS", &db.ReplicaDSNs)
+	env.int("DB_MAX_OPEN_CONNS", &db.Pool.MaxOpenConns)
+	env.int("DB_MAX_IDLE_CONNS", &db.Pool.MaxIdleConns)
+	env.duration("DB_CONN_MAX_LIFETIME", &db.Pool.ConnMaxLifetime)
+	env.duration("DB_QUERY_TIMEOUT", &db.QueryTimeout)
+	env.duration("DB_SLOW_QUERY_THRESHOLD", &db.SlowQueryThreshold)
+	env.int("DB_RETRY_MAX", &db.Retry.MaxRetries)
+	env.duration("DB_RETRY_BASE_DELAY", &db.Retry.BaseDelay)
+	env.duration("DB_RETRY_MAX_DELAY", &db.Retry.MaxDelay)
+	env.int("DB_BREAKER_THRESHOLD", &db.Retry.BreakerThreshold)
+	env.duration("DB_BREAKER_OPEN_DURATION", &db.Retry.BreakerOpenDuration)
+
+	env.string("REDIS_URL", &c.Cache.RedisURL)
+	env.duration("CACHE_ITEM_TTL", &c.Cache.ItemTTL)
+	env.duration("CACHE_SUMMARY_TTL", &c.Cache.SummaryTTL)
+
+	env.string("NATS_URL", &c.NATS.URL)
+	env.string("NATS_SUBJECT", &c.NATS.Subject)
+
+	env.string("SEARCH_URL", &c.Search.URL)
+	env.string("SEARCH_INDEX", &c.Search.Index)
+
+	env.duration("SHUTDOWN_TIMEOUT", &c.Shutdown.Timeout)
+	env.duration("SHUTDOWN_DELAY", &c.Shutdown.Delay)
+
+	env.duration("REQUEST_TIMEOUT_READ", &c.RequestTimeout.Read)
+	env.duration("REQUEST_TIMEOUT_WRITE", &c.RequestTimeout.Write)
+	env.duration("REQUEST_TIMEOUT_EXPORT", &c.RequestTimeout.Export)
+
+	env.int("RATE_LIMIT_PER_MINUTE", &c.RateLimit.PerMinute)
+	env.int("RATE_LIMIT_BURST", &c.RateLimit.Burst)
+	env.int("RATE_LIMIT_EXPENSIVE_PER_MINUTE", &c.RateLimit.ExpensivePerMinute)
+	env.int("RATE_LIMIT_EXPENSIVE_BURST", &c.RateLimit.ExpensiveBurst)
+
+	env.list("CORS_ALLOWED_ORIGINS", &c.CORS.AllowedOrigins)
+	env.list("CORS_ALLOWED_METHODS", &c.CORS.AllowedMethods)
+	env.list("CORS_ALLOWED_HEADERS", &c.CORS.AllowedHeaders)
+	env.duration("CORS_MAX_AGE", &c.CORS.MaxAge)
+
+	env.int("QUOTA_MAX_ITEMS", &c.Quota.MaxItems)
+
+	env.flags("FEATURE_FLAGS", &c.Features.Flags)
+	env.duration("FEATURE_FLAGS_REFRESH_INTERVAL", &c.Features.RefreshInterval)
+
+	env.duration("ERASURE_GRACE_PERIOD", &c.Privacy.ErasureGracePeriod)
+
+	env.bool("WEBHOOK_ALLOW_PRIVATE_NETWORKS", &c.Webhook.AllowPrivateNetworks)
+
+	// 実行時刻はカンマを含みうるため、タスクごとの環境変数（SCHEDULE_PURGE_TRASH など）で指定する
+	env.flags("SCHEDULED_TASKS", &c.Scheduler.Tasks)
+	for _, name := range entity.ScheduledTasks {
+		if value, ok := env.value("SCHEDULE_" + strings.ToUpper(name)); ok {
+			if c.Scheduler.Schedules == nil {
+				c.Scheduler.Schedules = map[string]string{}
+			}
+			c.Scheduler.Schedules[name] = value
+		}
+	}
+	env.duration("TRASH_RETENTION", &c.Scheduler.TrashRetention)
+
+	env.string("SMTP_HOST", &c.Notification.SMTPHost)
+	env.int("SMTP_PORT", &c.Notification.SMTPPort)
+	env.string("SMTP_USERNAME", &c.Notification.SMTPUsername)
+	env.string("SMTP_PASSWORD", &c.Notification.SMTPPassword)
+	env.string("NOTIFY_FROM", &c.Notification.From)
+	env.list("NOTIFY_TO", &c.Notification.To)
+
+	env.bool("PUBLIC_CATALOG_ENABLED", &c.PublicCatalog.Enabled)
+	env.list("PUBLIC_CATALOG_CATEGORIES", &c.PublicCatalog.Categories)
+	env.list("PUBLIC_CATALOG_FIELDS", &c.PublicCatalog.Fields)
 
-	DBUser = os.Getenv("DB_USER")
-	DBPassword = os.Getenv("DB_PASSWORD")
-	DBHost = os.Getenv("DB_HOST")
-	DBPort = os.Getenv("DB_PORT")
-	DBName = os.Getenv("DB_NAME")
+	env.list("MASKED_FIELDS", &c.Masking.Fields)
+
+	_, hasEndpoint := env.value("OTEL_EXPORTER_OTLP_ENDPOINT")
+	_, hasTracesEndpoint := env.value("OTEL_EXPORTER_OTLP_TRACES_ENDPOINT")
+	disabled, _ := env.value("OTEL_SDK_DISABLED")
+	c.TracingEnabled = disabled != "true" && (hasEndpoint || hasTracesEndpoint)
 }
 
-// DB接続文字列を返す
-func GetDSN() string {
+// 起動前に設定の誤りを全て見つけ、まとめて返す
+func (c *Config) Validate() error {
+	var errs []error
+	fail := func(format string, args ...interface{}) {
+		errs = append(errs, fmt.Errorf(format, args...))
+	}
+
+	for name, addr := range map[string]string{"port (PORT)": c.Port, "grpc_port (GRPC_PORT)": c.GRPCPort} {
+		if _, _, err := net.SplitHostPort(addr); err != nil {
+			fail("%s must be an address like \":8080\", got %q", name, addr)
+		}
+	}
+
+	switch c.Storage {
+	case "mysql", "postgres":
+		db := c.Database
+		if c.Storage == "mysql" || db.URL == "" {
+			if db.Host == "" || db.Port == "" || db.Name == "" {
+				fail("database.host (DB_HOST), database.port (DB_PORT) and database.name (DB_NAME) are required for storage %q", c.Storage)
+			}
+		}
+		if c.Storage == "postgres" && db.URL != "" {
+			if _, err := url.Parse(db.URL); err != nil {
+				fail("database.url (DATABASE_URL) is not a valid URL: %v", err)
+			}
+		}
+	case "sqlite":
+		if c.SQLitePath == "" {
+			fail("sqlite_path (SQLITE_PATH) is required for storage \"sqlite\"")
+		}
+		if len(c.Database.ReplicaDSNs) > 0 {
+			fail("database.replica_dsns (DB_REPLICA_DSNS) is not supported for storage \"sqlite\"")
+		}
+	case "memory":
+	default:
+		fail("storage (STORAGE) must be mysql, postgres, sqlite or memory, got %q", c.Storage)
+	}
+
+	switch c.LogLevel {
+	case "debug", "info", "warn", "error":
+	default:
+		fail("log_level (LOG_LEVEL) must be debug, info, warn or error, got %q", c.LogLevel)
+	}
+
+	switch c.Env {
+	case "development", "staging", "production":
+	default:
+		fail("env (APP_ENV) must be development, staging or production, got %q", c.Env)
+	}
+
+	// 推測されにくいよう短いトークンは受け付けない（値はエラーに含めない）
+	if c.AdminToken != "" && len(c.AdminToken) < minAdminTokenLength {
+		fail("admin_token (ADMIN_TOKEN) must be at least %d characters", minAdminTokenLength)
+	}
+
+	for _, proxy := range c.TrustedProxies {
+		if _, _, err := net.ParseCIDR(proxy); err != nil && net.ParseIP(proxy) == nil {
+			fail("trusted_proxies (TRUSTED_PROXIES) must be CIDRs or IP addresses like \"10.0.0.0/8\", got %q", proxy)
+		}
+	}
+
+	// 0は上限なし・無効を表すため、負の値のみ誤りとする
+	for name, value := range map[string]int{
+		"database.pool.max_open_conns (DB_MAX_OPEN_CONNS)":                  c.Database.Pool.MaxOpenConns,
+		"database.pool.max_idle_conns (DB_MAX_IDLE_CONNS)":                  c.Database.Pool.MaxIdleConns,
+		"database.retry.max_retries (DB_RETRY_MAX)":                         c.Database.Retry.MaxRetries,
+		"database.retry.breaker_threshold (DB_BREAKER_THRESHOLD)":           c.Database.Retry.BreakerThreshold,
+		"rate_limit.per_minute (RATE_LIMIT_PER_MINUTE)":                     c.RateLimit.PerMinute,
+		"rate_limit.burst (RATE_LIMIT_BURST)":                               c.RateLimit.Burst,
+		"rate_limit.expensive_per_minute (RATE_LIMIT_EXPENSIVE_PER_MINUTE)": c.RateLimit.ExpensivePerMinute,
+		"rate_limit.expensive_burst (RATE_LIMIT_EXPENSIVE_BURST)":           c.RateLimit.ExpensiveBurst,
+		"quota.max_items (QUOTA_MAX_ITEMS)":                                 c.Quota.MaxItems,
+	} {
+		if value < 0 {
+			fail("%s must be 0 or greater, got %d", name, value)
+		}
+	}
+	for name, value := range map[string]time.Duration{
+		"database.pool.conn_max_lifetime (DB_CONN_MAX_LIFETIME)":  c.Database.Pool.ConnMaxLifetime,
+		"database.query_timeout (DB_QUERY_TIMEOUT)":               c.Database.QueryTimeout,
+		"database.slow_query_threshold (DB_SLOW_QUERY_THRESHOLD)": c.Database.SlowQueryThreshold,
+		"shutdown.delay (SHUTDOWN_DELAY)":                         c.Shutdown.Delay,
+		"request_timeout.read (REQUEST_TIMEOUT_READ)":             c.RequestTimeout.Read,
+		"request_timeout.write (REQUEST_TIMEOUT_WRITE)":           c.RequestTimeout.Write,
+		"request_timeout.export (REQUEST_TIMEOUT_EXPORT)":         c.RequestTimeout.Export,
+		"cors.max_age (CORS_MAX_AGE)":                             c.CORS.MaxAge,
+	} {
+		if value < 0 {
+			fail("%s must be 0 or greater, got %s", name, value)
+		}
+	}
+	for name, value := range map[string]time.Duration{
+		"database.retry.base_delay (DB_RETRY_BASE_DELAY)":                 c.Database.Retry.BaseDelay,
+		"database.retry.max_delay (DB_RETRY_MAX_DELAY)":                   c.Database.Retry.MaxDelay,
+		"database.retry.breaker_open_duration (DB_BREAKER_OPEN_DURATION)": c.Database.Retry.BreakerOpenDuration,
+		"cache.item_ttl (CACHE_ITEM_TTL)":                                 c.Cache.ItemTTL,
+		"cache.summary_ttl (CACHE_SUMMARY_TTL)":                           c.Cache.SummaryTTL,
+		"shutdown.timeout (SHUTDOWN_TIMEOUT)":                             c.Shutdown.Timeout,
+		"features.refresh_interval (FEATURE_FLAGS_REFRESH_INTERVAL)":      c.Features.RefreshInterval,
+		"privacy.erasure_grace_period (ERASURE_GRACE_PERIOD)":             c.Privacy.ErasureGracePeriod,
+		"scheduler.trash_retention (TRASH_RETENTION)":                     c.Scheduler.TrashRetention,
+	} {
+		if value <= 0 {
+			fail("%s must be greater than 0, got %s", name, value)
+		}
+	}
+	if c.Database.Retry.BaseDelay > c.Database.Retry.MaxDelay {
+		fail("database.retry.base_delay (DB_RETRY_BASE_DELAY) must not exceed database.retry.max_delay (DB_RETRY_MAX_DELAY), got %s > %s", c.Database.Retry.BaseDelay, c.Database.Retry.MaxDelay)
+	}
+
+	// オリジンはブラウザが送る Origin ヘッダーと完全一致で比べるため、パスや末尾の "/" を含めない
+	for _, origin := range c.CORS.AllowedOrigins {
+		if origin == "*" {
+			continue
+		}
+		u, err := url.Parse(origin)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" || u.Path != "" || u.RawQuery != "" {
+			fail("cors.allowed_origins (CORS_ALLOWED_ORIGINS) must be \"*\" or an origin like \"https://app.example.com\", got %q", origin)
+		}
+	}
+	for _, method := range c.CORS.AllowedMethods {
+		switch method {
+		case "GET", "HEAD", "POST", "PUT", "PATCH", "DELETE", "OPTIONS":
+		default:
+			fail("cors.allowed_methods (CORS_ALLOWED_METHODS) must be HTTP methods in upper case, got %q", method)
+		}
+	}
+	if len(c.CORS.AllowedOrigins) > 0 && len(c.CORS.AllowedMethods) == 0 {
+		fail("cors.allowed_methods (CORS_ALLOWED_METHODS) is required when cors.allowed_origins (CORS_ALLOWED_ORIGINS) is set")
+	}
+
+	for name := range c.Features.Flags {
+		if !entity.IsKnownFeature(name) {
+			fail("features.flags (FEATURE_FLAGS) must be one of %s, got %q", strings.Join(entity.Features, ", "), name)
+		}
+	}
+
+	for name := range c.Scheduler.Tasks {
+		if !entity.IsKnownScheduledTask(name) {
+			fail("scheduler.tasks (SCHEDULED_TASKS) must be one of %s, got %q", strings.Join(entity.ScheduledTasks, ", "), name)
+		}
+	}
+	for name, schedule := range c.Scheduler.Schedules {
+		if !entity.IsKnownScheduledTask(name) {
+			fail("scheduler.schedules must be one of %s, got %q", strings.Join(entity.ScheduledTasks, ", "), name)
+			continue
+		}
+		if _, err := entity.ParseSchedule(schedule); err != nil {
+			fail("scheduler.schedules.%s (SCHEDULE_%s): %v", name, strings.ToUpper(name), err)
+		}
+	}
+
+	if n := c.Notification; n.SMTPHost != "" {
+		if n.SMTPPort <= 0 || n.SMTPPort > 65535 {
+			fail("notification.smtp_port (SMTP_PORT) must be between 1 and 65535, got %d", n.SMTPPort)
+		}
+		if _, err := mail.ParseAddress(n.From); err != nil {
+			fail("notification.from (NOTIFY_FROM) must be an email address when notification.smtp_host (SMTP_HOST) is set, got %q", n.From)
+		}
+		if len(n.To) == 0 {
+			fail("notification.to (NOTIFY_TO) is required when notification.smtp_host (SMTP_HOST) is set")
+		}
+		for _, to := range n.To {
+			if _, err := mail.ParseAddress(to); err != nil {
+				fail("notification.to (NOTIFY_TO) must be email addresses, got %q", to)
+			}
+		}
+	}
+
+	// 公開した状態で変更できないよう、それ以外のAPIはトークンで守る
+	if c.PublicCatalog.Enabled && c.AdminToken == "" {
+		fail("admin_token (ADMIN_TOKEN) is required when public_catalog.enabled (PUBLIC_CATALOG_ENABLED) is true")
+	}
+	for _, category := range c.PublicCatalog.Categories {
+		if !slices.Contains(entity.ValidCategories, category) {
+			fail("public_catalog.categories (PUBLIC_CATALOG_CATEGORIES) must be one of %s, got %q", strings.Join(entity.ValidCategories, ", "), category)
+		}
+	}
+	for _, field := range c.PublicCatalog.Fields {
+		if !entity.IsCatalogField(field) {
+			fail("public_catalog.fields (PUBLIC_CATALOG_FIELDS) must be one of %s, got %q", strings.Join(entity.CatalogFields, ", "), field)
+		}
+	}
+	if c.PublicCatalog.Enabled && len(c.PublicCatalog.Fields) == 0 {
+		fail("public_catalog.fields (PUBLIC_CATALOG_FIELDS) is required when public_catalog.enabled (PUBLIC_CATALOG_ENABLED) is true")
+	}
+
+	for _, field := range c.Masking.Fields {
+		if field == "" || strings.ContainsAny(field, " \t") {
+			fail("masking.fields (MASKED_FIELDS) must be field names like \"serial_number\", got %q", field)
+		}
+	}
+
+	for name, value := range map[string]int64{
+		"max_body_bytes (MAX_BODY_BYTES)":                 c.MaxBodyBytes,
+		"max_restore_body_bytes (MAX_RESTORE_BODY_BYTES)": c.MaxRestoreBodyBytes,
+	} {
+		if value <= 0 {
+			fail("%s must be greater than 0, got %d", name, value)
+		}
+	}
+
+	if c.NATS.URL != "" && c.NATS.Subject == "" {
+		fail("nats.subject (NATS_SUBJECT) is required when nats.url (NATS_URL) is set")
+	}
+	if c.Search.URL != "" && c.Search.Index == "" {
+		fail("search.index (SEARCH_INDEX) is required when search.url (SEARCH_URL) is set")
+	}
+
+	if len(errs) == 0 {
+		return nil
+	}
+	// mapの順序で結果が変わらないようにする
+	slices.SortFunc(errs, func(a, b error) int { return strings.Compare(a.Error(), b.Error()) })
+	return fmt.Errorf("invalid configuration:\n%w", errors.Join(errs...))
+}
+
+// MySQLの接続文字列を返す
+func (d DatabaseConfig) MySQLDSN() string {
 	return fmt.Sprintf(
 		"%s:%s@tcp(%s:%s)/%s?charset=utf8mb4&collation=utf8mb4_unicode_ci&parseTime=true&loc=Local&sql_mode=TRADITIONAL",
-		DBUser, DBPassword, DBHost, DBPort, DBName,
+		d.User, d.Password, d.Host, d.Port, d.Name,
 	)
 }
+
+// PostgreSQLの接続文字列を返す
+func (d DatabaseConfig) PostgresDSN() string {
+	if d.URL != "" {
+		return d.URL
+	}
+
+	dsn := url.URL{
+		Scheme:   "postgres",
+		User:     url.UserPassword(d.User, d.Password),
+		Host:     d.Host + ":" + d.Port,
+		Path:     "/" + d.Name,
+		RawQuery: "sslmode=disable",
+	}
+	return dsn.String()
+}
+
+// 環境変数を読み、値が不正な場合は既定値に戻さずエラーとして集める
+type envReader struct {
+	lookup func(key string) (string, bool)
+	errs   []error
+}
+
+// 空の値は未設定として扱う
+func (r *envReader) value(key string) (string, bool) {
+	value, ok := r.lookup(key)
+	return value, ok && value != ""
+}
+
+func (r *envReader) string(key string, dst *string) {
+	if value, ok := r.value(key); ok {
+		*dst = value
+	}
+}
+
+func (r *envReader) list(key string, dst *[]string) {
+	value, ok := r.value(key)
+	if !ok {
+		return
+	}
+
+	*dst = nil
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			*dst = append(*dst, item)
+		}
+	}
+}
+
+func (r *envReader) int(key string, dst *int) {
+	value, ok := r.value(key)
+	if !ok {
+		return
+	}
+
+	number, err := strconv.Atoi(value)
+	if err != nil {
+		r.errs = append(r.errs, fmt.Errorf("%s must be an integer, got %q", key, value))
+		return
+	}
+	*dst = number
+}
+
+func (r *envReader) int64(key string, dst *int64) {
+	value, ok := r.value(key)
+	if !ok {
+		return
+	}
+
+	number, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		r.errs = append(r.errs, fmt.Errorf("%s must be an integer, got %q", key, value))
+		return
+	}
+	*dst = number
+}
+
+func (r *envReader) duration(key string, dst *time.Duration) {
+	value, ok := r.value(key)
+	if !ok {
+		return
+	}
+
+	duration, err := time.ParseDuration(value)
+	if err != nil {
+		r.errs = append(r.errs, fmt.Errorf("%s must be a duration like \"30s\" or \"5m\", got %q", key, value))
+		return
+	}
+	*dst = duration
+}
+
+func (r *envReader) bool(key string, dst *bool) {
+	value, ok := r.value(key)
+	if !ok {
+		return
+	}
+
+	switch strings.ToLower(value) {
+	case "true", "1", "yes":
+		*dst = true
+	case "false", "0", "no":
+		*dst = false
+	default:
+		r.errs = append(r.errs, fmt.Errorf("%s must be true or false, got %q", key, value))
+	}
+}
+
+// "name=true,name=false" の形式
+func (r *envReader) flags(key string, dst *map[string]bool) {
+	value, ok := r.value(key)
+	if !ok {
+		return
+	}
+
+	flags := map[string]bool{}
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item == "" {
+			continue
+		}
+		name, enabled, found := strings.Cut(item, "=")
+		parsed, err := strconv.ParseBool(strings.TrimSpace(enabled))
+		if !found || err != nil {
+			r.errs = append(r.errs, fmt.Errorf("%s must be a list like \"webhooks=false,graphql=true\", got %q", key, value))
+			return
+		}
+		flags[strings.TrimSpace(name)] = parsed
+	}
+	*dst = flags
+}
@@ -0,0 +1,302 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func lookupFrom(env map[string]string) func(string) (string, bool) {
+	return func(key string) (string, bool) {
+		value, ok := env[key]
+		return value, ok
+	}
+}
+
+func writeFile(t *testing.T, content string) string {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+func TestLoad(t *testing.T) {
+	t.Run("正常系: 未設定の項目は既定値を使う", func(t *testing.T) {
+		cfg, err := load(lookupFrom(map[string]string{"STORAGE": "memory"}))
+
+		require.NoError(t, err)
+		want := Default()
+		want.Storage = "memory"
+		assert.Equal(t, want, cfg)
+	})
+
+	t.Run("正常系: 環境変数で上書きする", func(t *testing.T) {
+		cfg, err := load(lookupFrom(map[string]string{
+			"PORT":                           ":8081",
+			"DB_HOST":                        "localhost",
+			"DB_PORT":                        "3306",
+			"DB_NAME":                        "items_db",
+			"DB_REPLICA_DSNS":                " replica-1 , ,replica-2",
+			"DB_MAX_OPEN_CONNS":              "0",
+			"DB_QUERY_TIMEOUT":               "0",
+			"MIGRATE_ON_STARTUP":             "false",
+			"CACHE_ITEM_TTL":                 "1m",
+			"RATE_LIMIT_PER_MINUTE":          "0",
+			"MAX_BODY_BYTES":                 "2097152",
+			"QUOTA_MAX_ITEMS":                "500",
+			"FEATURE_FLAGS":                  "webhooks=false, graphql=true",
+			"ERASURE_GRACE_PERIOD":           "168h",
+			"WEBHOOK_ALLOW_PRIVATE_NETWORKS": "true",
+			"SCHEDULED_TASKS":                "overdue_loans=false",
+			"SCHEDULE_PURGE_TRASH":           "30 4 * * 1,4",
+			"PUBLIC_CATALOG_CATEGORIES":      "時計,バッグ",
+			"PUBLIC_CATALOG_FIELDS":          "name,brand",
+			"MASKED_FIELDS":                  "purchase_price,serial_number,brand",
+			"CORS_ALLOWED_ORIGINS":           "https://app.example.com,http://localhost:5173",
+			"TRUSTED_PROXIES":                "10.0.0.0/8, 192.0.2.1",
+			"OTEL_EXPORTER_OTLP_ENDPOINT":    "http://localhost:4318",
+		}))
+
+		require.NoError(t, err)
+		assert.Equal(t, ":8081", cfg.Port)
+		assert.Equal(t, "localhost", cfg.Database.Host)
+		assert.Equal(t, []string{"replica-1", "replica-2"}, cfg.Database.ReplicaDSNs)
+		assert.Equal(t, 0, cfg.Database.Pool.MaxOpenConns)
+		assert.Equal(t, time.Duration(0), cfg.Database.QueryTimeout)
+		assert.False(t, cfg.MigrateOnStartup)
+		assert.Equal(t, time.Minute, cfg.Cache.ItemTTL)
+		assert.Equal(t, 0, cfg.RateLimit.PerMinute)
+		assert.Equal(t, int64(2<<20), cfg.MaxBodyBytes)
+		assert.Equal(t, 500, cfg.Quota.MaxItems)
+		assert.Equal(t, map[string]bool{"webhooks": false, "graphql": true}, cfg.Features.Flags)
+		assert.Equal(t, 7*24*time.Hour, cfg.Privacy.ErasureGracePeriod)
+		assert.True(t, cfg.Webhook.AllowPrivateNetworks)
+		assert.False(t, cfg.Scheduler.Enabled("overdue_loans"))
+		assert.True(t, cfg.Scheduler.Enabled("purge_trash"))
+		assert.Equal(t, "30 4 * * 1,4", cfg.Scheduler.Schedule("purge_trash"))
+		assert.Equal(t, "0 * * * *", cfg.Scheduler.Schedule("purge_jobs"))
+		assert.Equal(t, []string{"時計", "バッグ"}, cfg.PublicCatalog.Categories)
+		assert.Equal(t, []string{"name", "brand"}, cfg.PublicCatalog.Fields)
+		assert.Equal(t, []string{"purchase_price", "serial_number", "brand"}, cfg.Masking.Fields)
+		assert.Equal(t, []string{"https://app.example.com", "http://localhost:5173"}, cfg.CORS.AllowedOrigins)
+		assert.Equal(t, []string{"10.0.0.0/8", "192.0.2.1"}, cfg.TrustedProxies)
+		assert.True(t, cfg.TracingEnabled)
+	})
+
+	t.Run("正常系: YAMLファイルを読み、環境変数を優先する", func(t *testing.T) {
+		path := writeFile(t, `
+storage: postgres
+log_level: debug
+database:
+  url: postgres://postgres:password@db:5432/items_db
+  pool:
+    max_open_conns: 10
+  retry:
+    base_delay: 100ms
+cache:
+  redis_url: redis://redis:6379/0
+`)
+
+		cfg, err := load(lookupFrom(map[string]string{"CONFIG_FILE": path, "LOG_LEVEL": "warn"}))
+
+		require.NoError(t, err)
+		assert.Equal(t, "postgres", cfg.Storage)
+		assert.Equal(t, "warn", cfg.LogLevel)
+		assert.Equal(t, "postgres://postgres:password@db:5432/items_db", cfg.Database.PostgresDSN())
+		assert.Equal(t, 10, cfg.Database.Pool.MaxOpenConns)
+		assert.Equal(t, 25, cfg.Database.Pool.MaxIdleConns)
+		assert.Equal(t, 100*time.Millisecond, cfg.Database.Retry.BaseDelay)
+		assert.Equal(t, "redis://redis:6379/0", cfg.Cache.RedisURL)
+	})
+
+	t.Run("異常系: 不正な環境変数の値を全てまとめて返す", func(t *testing.T) {
+		_, err := load(lookupFrom(map[string]string{
+			"STORAGE":            "memory",
+			"DB_MAX_OPEN_CONNS":  "many",
+			"CACHE_ITEM_TTL":     "5",
+			"MIGRATE_ON_STARTUP": "maybe",
+			"FEATURE_FLAGS":      "webhooks",
+		}))
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `DB_MAX_OPEN_CONNS must be an integer, got "many"`)
+		assert.Contains(t, err.Error(), `CACHE_ITEM_TTL must be a duration like "30s" or "5m", got "5"`)
+		assert.Contains(t, err.Error(), `MIGRATE_ON_STARTUP must be true or false, got "maybe"`)
+		assert.Contains(t, err.Error(), "FEATURE_FLAGS")
+	})
+
+	t.Run("異常系: YAMLファイルの知らないキー", func(t *testing.T) {
+		path := writeFile(t, "storage: memory\ncahce:\n  item_ttl: 1m\n")
+
+		_, err := load(lookupFrom(map[string]string{"CONFIG_FILE": path}))
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "field cahce not found")
+	})
+
+	t.Run("異常系: YAMLファイルがない", func(t *testing.T) {
+		_, err := load(lookupFrom(map[string]string{"CONFIG_FILE": filepath.Join(t.TempDir(), "missing.yaml")}))
+
+		assert.ErrorIs(t, err, os.ErrNotExist)
+	})
+}
+
+func TestConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(cfg *Config)
+		want   []string
+	}{
+		{
+			name:   "正常系: メモリ上に保存する場合はDBの設定は不要",
+			modify: func(cfg *Config) { cfg.Storage = "memory" },
+		},
+		{
+			name: "正常系: PostgreSQLは接続文字列だけでよい",
+			modify: func(cfg *Config) {
+				cfg.Storage = "postgres"
+				cfg.Database.URL = "postgres://localhost/items_db"
+			},
+		},
+		{
+			name:   "異常系: MySQLの接続先がない",
+			modify: func(cfg *Config) {},
+			want:   []string{"database.host (DB_HOST), database.port (DB_PORT) and database.name (DB_NAME) are required for storage \"mysql\""},
+		},
+		{
+			name: "異常系: 範囲外の値",
+			modify: func(cfg *Config) {
+				cfg.Storage = "memory"
+				cfg.Port = "8080"
+				cfg.LogLevel = "verbose"
+				cfg.Env = "prod"
+				cfg.AdminToken = "short"
+				cfg.Database.Pool.MaxOpenConns = -1
+				cfg.Cache.SummaryTTL = 0
+				cfg.Database.Retry.BaseDelay = 2 * time.Second
+				cfg.RateLimit.ExpensivePerMinute = -1
+				cfg.MaxBodyBytes = 0
+			},
+			want: []string{
+				`port (PORT) must be an address like ":8080", got "8080"`,
+				`log_level (LOG_LEVEL) must be debug, info, warn or error, got "verbose"`,
+				`env (APP_ENV) must be development, staging or production, got "prod"`,
+				"admin_token (ADMIN_TOKEN) must be at least 16 characters",
+				"database.pool.max_open_conns (DB_MAX_OPEN_CONNS) must be 0 or greater, got -1",
+				"max_body_bytes (MAX_BODY_BYTES) must be greater than 0, got 0",
+				"rate_limit.expensive_per_minute (RATE_LIMIT_EXPENSIVE_PER_MINUTE) must be 0 or greater, got -1",
+				"cache.summary_ttl (CACHE_SUMMARY_TTL) must be greater than 0, got 0s",
+				"database.retry.base_delay (DB_RETRY_BASE_DELAY) must not exceed database.retry.max_delay (DB_RETRY_MAX_DELAY), got 2s > 1s",
+			},
+		},
+		{
+			name: "異常系: CORSのオリジンとメソッドの形式",
+			modify: func(cfg *Config) {
+				cfg.Storage = "memory"
+				cfg.CORS.AllowedOrigins = []string{"*", "https://app.example.com", "https://app.example.com/", "app.example.com"}
+				cfg.CORS.AllowedMethods = []string{"GET", "patch"}
+			},
+			want: []string{
+				`cors.allowed_origins (CORS_ALLOWED_ORIGINS) must be "*" or an origin like "https://app.example.com", got "https://app.example.com/"`,
+				`cors.allowed_origins (CORS_ALLOWED_ORIGINS) must be "*" or an origin like "https://app.example.com", got "app.example.com"`,
+				`cors.allowed_methods (CORS_ALLOWED_METHODS) must be HTTP methods in upper case, got "patch"`,
+			},
+		},
+		{
+			name: "異常系: 信頼するプロキシの形式",
+			modify: func(cfg *Config) {
+				cfg.Storage = "memory"
+				cfg.TrustedProxies = []string{"10.0.0.0/8", "2001:db8::1", "proxy.internal"}
+			},
+			want: []string{`trusted_proxies (TRUSTED_PROXIES) must be CIDRs or IP addresses like "10.0.0.0/8", got "proxy.internal"`},
+		},
+		{
+			name: "異常系: 知らない保存先",
+			modify: func(cfg *Config) {
+				cfg.Storage = "mongodb"
+			},
+			want: []string{`storage (STORAGE) must be mysql, postgres, sqlite or memory, got "mongodb"`},
+		},
+		{
+			name: "異常系: SQLiteはリードレプリカを使えない",
+			modify: func(cfg *Config) {
+				cfg.Storage = "sqlite"
+				cfg.Database.ReplicaDSNs = []string{"replica"}
+			},
+			want: []string{`database.replica_dsns (DB_REPLICA_DSNS) is not supported for storage "sqlite"`},
+		},
+		{
+			name: "異常系: 知らない機能",
+			modify: func(cfg *Config) {
+				cfg.Storage = "memory"
+				cfg.Features.Flags = map[string]bool{"market_price": false}
+			},
+			want: []string{`features.flags (FEATURE_FLAGS) must be one of webhooks, graphql, duplicate_detection, got "market_price"`},
+		},
+		{
+			name: "異常系: 知らないタスクと不正な実行時刻",
+			modify: func(cfg *Config) {
+				cfg.Storage = "memory"
+				cfg.Scheduler.Tasks = map[string]bool{"market_revaluation": true}
+				cfg.Scheduler.Schedules = map[string]string{"purge_trash": "0 25 * * *"}
+			},
+			want: []string{
+				`scheduler.schedules.purge_trash (SCHEDULE_PURGE_TRASH): invalid schedule "0 25 * * *": hour must be between 0 and 23, got "25"`,
+				`scheduler.tasks (SCHEDULED_TASKS) must be one of purge_trash, purge_jobs, overdue_loans, got "market_revaluation"`,
+			},
+		},
+		{
+			name: "異常系: SMTPを使う場合はアドレスが必要",
+			modify: func(cfg *Config) {
+				cfg.Storage = "memory"
+				cfg.Notification.SMTPHost = "smtp.example.com"
+				cfg.Notification.From = "items"
+			},
+			want: []string{
+				`notification.from (NOTIFY_FROM) must be an email address when notification.smtp_host (SMTP_HOST) is set, got "items"`,
+				`notification.to (NOTIFY_TO) is required when notification.smtp_host (SMTP_HOST) is set`,
+			},
+		},
+		{
+			name: "異常系: 公開カタログにはトークンが必要",
+			modify: func(cfg *Config) {
+				cfg.Storage = "memory"
+				cfg.PublicCatalog.Enabled = true
+			},
+			want: []string{`admin_token (ADMIN_TOKEN) is required when public_catalog.enabled (PUBLIC_CATALOG_ENABLED) is true`},
+		},
+		{
+			name: "異常系: 公開カタログに価格は含められない",
+			modify: func(cfg *Config) {
+				cfg.Storage = "memory"
+				cfg.PublicCatalog.Categories = []string{"時計", "車"}
+				cfg.PublicCatalog.Fields = []string{"name", "purchase_price"}
+			},
+			want: []string{
+				`public_catalog.categories (PUBLIC_CATALOG_CATEGORIES) must be one of 時計, バッグ, ジュエリー, 靴, その他, got "車"`,
+				`public_catalog.fields (PUBLIC_CATALOG_FIELDS) must be one of name, category, brand, condition, attributes, got "purchase_price"`,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := Default()
+			tt.modify(cfg)
+
+			err := cfg.Validate()
+
+			if len(tt.want) == 0 {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			for _, want := range tt.want {
+				assert.Contains(t, err.Error(), want)
+			}
+		})
+	}
+}
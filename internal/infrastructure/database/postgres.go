@@ -0,0 +1,159 @@
+package databaseInfra
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/lib/pq"
+
+	"Aicon-assignment/internal/infrastructure/config"
+	"Aicon-assignment/internal/interfaces/database"
+)
+
+// PostgreSQL用のハンドラー。リポジトリは ? でプレースホルダーを書くので $1, $2, ... に変換して渡す
+type PostgresHandler struct {
+	Conn *sql.DB
+}
+
+// リードレプリカにも同じ接続プールの設定で接続する
+func NewPostgresHandler(dsn string, pool config.PoolConfig) database.SqlHandler {
+	conn, err := sql.Open("postgres", dsn)
+	if err != nil {
+		panic(fmt.Sprintf("❌ Failed to connect to database: %v", err))
+	}
+	configurePool(conn, pool)
+
+	if err := conn.Ping(); err != nil {
+		panic(fmt.Sprintf("❌ Failed to ping database: %v", err))
+	}
+
+	fmt.Println("✅ Successfully connected to the database!")
+
+	return &PostgresHandler{Conn: conn}
+}
+
+func (h *PostgresHandler) Execute(ctx context.Context, statement string, args ...interface{}) (database.Result, error) {
+	result, err := h.Conn.ExecContext(ctx, rebind(statement), args...)
+	if err != nil {
+		return nil, translatePostgresError(err)
+	}
+	return &mysqlResult{result: result}, nil
+}
+
+func (h *PostgresHandler) Query(ctx context.Context, statement string, args ...interface{}) (database.Rows, error) {
+	rows, err := h.Conn.QueryContext(ctx, rebind(statement), args...)
+	if err != nil {
+		return nil, translatePostgresError(err)
+	}
+	return &mysqlRows{rows: rows}, nil
+}
+
+func (h *PostgresHandler) QueryRow(ctx context.Context, statement string, args ...interface{}) database.Row {
+	row := h.Conn.QueryRowContext(ctx, rebind(statement), args...)
+	return &postgresRow{row: row}
+}
+
+func (h *PostgresHandler) Begin(ctx context.Context) (database.Tx, error) {
+	tx, err := h.Conn.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &postgresTx{tx: tx}, nil
+}
+
+func (h *PostgresHandler) Dialect() database.Dialect {
+	return database.DialectPostgres
+}
+
+func (h *PostgresHandler) Close() error {
+	if h.Conn != nil {
+		return h.Conn.Close()
+	}
+	return nil
+}
+
+type postgresTx struct {
+	tx *sql.Tx
+}
+
+func (t *postgresTx) Execute(ctx context.Context, statement string, args ...interface{}) (database.Result, error) {
+	result, err := t.tx.ExecContext(ctx, rebind(statement), args...)
+	if err != nil {
+		return nil, translatePostgresError(err)
+	}
+	return &mysqlResult{result: result}, nil
+}
+
+func (t *postgresTx) Query(ctx context.Context, statement string, args ...interface{}) (database.Rows, error) {
+	rows, err := t.tx.QueryContext(ctx, rebind(statement), args...)
+	if err != nil {
+		return nil, translatePostgresError(err)
+	}
+	return &mysqlRows{rows: rows}, nil
+}
+
+func (t *postgresTx) QueryRow(ctx context.Context, statement string, args ...interface{}) database.Row {
+	row := t.tx.QueryRowContext(ctx, rebind(statement), args...)
+	return &postgresRow{row: row}
+}
+
+func (t *postgresTx) Commit() error {
+	return t.tx.Commit()
+}
+
+func (t *postgresTx) Rollback() error {
+	return t.tx.Rollback()
+}
+
+// INSERT ... RETURNING の制約違反はScanで返るため変換する
+type postgresRow struct {
+	row *sql.Row
+}
+
+func (r *postgresRow) Scan(dest ...interface{}) error {
+	return translatePostgresError(r.row.Scan(dest...))
+}
+
+// ? を $1, $2, ... に置き換える（文字列リテラル内の ? はそのまま残す）
+func rebind(statement string) string {
+	var b strings.Builder
+	b.Grow(len(statement) + 8)
+
+	n := 0
+	inLiteral := false
+	for _, c := range statement {
+		switch {
+		case c == '\'':
+			inLiteral = !inLiteral
+			b.WriteRune(c)
+		case c == '?' && !inLiteral:
+			n++
+			b.WriteString("$" + strconv.Itoa(n))
+		default:
+			b.WriteRune(c)
+		}
+	}
+	return b.String()
+}
+
+// 制約違反をドライバーに依存しないエラーに変換する
+func translatePostgresError(err error) error {
+	var pqErr *pq.Error
+	if !errors.As(err, &pqErr) {
+		return err
+	}
+
+	switch pqErr.Code {
+	case "23505": // unique_violation
+		return fmt.Errorf("%w: %s", database.ErrUniqueViolation, err.Error())
+	case "23503": // foreign_key_violation
+		return fmt.Errorf("%w: %s", database.ErrForeignKeyViolation, err.Error())
+	case "40001", "40P01", "55P03": // serialization_failure, deadlock_detected, lock_not_available
+		return fmt.Errorf("%w: %s", database.ErrTransient, err.Error())
+	}
+	return err
+}
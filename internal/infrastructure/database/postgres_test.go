@@ -0,0 +1,45 @@
+package databaseInfra
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+
+	"Aicon-assignment/internal/interfaces/database"
+)
+
+func TestRebind(t *testing.T) {
+	tests := []struct {
+		name      string
+		statement string
+		want      string
+	}{
+		{
+			name:      "正常系: 順に番号を振る",
+			statement: "UPDATE items SET name = ?, brand = ? WHERE id = ?",
+			want:      "UPDATE items SET name = $1, brand = $2 WHERE id = $3",
+		},
+		{
+			name:      "正常系: 文字列リテラル内の?は置き換えない",
+			statement: "INSERT INTO items (serial_number) VALUES (NULLIF(?, '?'))",
+			want:      "INSERT INTO items (serial_number) VALUES (NULLIF($1, '?'))",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, rebind(tt.statement))
+		})
+	}
+}
+
+func TestTranslatePostgresError(t *testing.T) {
+	assert.ErrorIs(t, translatePostgresError(&pq.Error{Code: "23505"}), database.ErrUniqueViolation)
+	assert.ErrorIs(t, translatePostgresError(&pq.Error{Code: "23503"}), database.ErrForeignKeyViolation)
+
+	other := errors.New("connection reset")
+	assert.Equal(t, other, translatePostgresError(other))
+	assert.NoError(t, translatePostgresError(nil))
+}
@@ -0,0 +1,71 @@
+package databaseInfra
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+
+	"Aicon-assignment/internal/interfaces/database"
+)
+
+// 問い合わせをリードレプリカに振り分けるハンドラー
+// レプリカは順番に使い、接続できない場合はプライマリから読む。書き込みとトランザクションは常にプライマリで行う
+type ReplicaHandler struct {
+	database.SqlHandler
+	replicas []database.SqlHandler
+	next     atomic.Uint64
+}
+
+// replicas が空の場合は primary をそのまま返す
+func NewReplicaHandler(primary database.SqlHandler, replicas []database.SqlHandler) database.SqlHandler {
+	if len(replicas) == 0 {
+		return primary
+	}
+
+	return &ReplicaHandler{SqlHandler: primary, replicas: replicas}
+}
+
+func (h *ReplicaHandler) Query(ctx context.Context, statement string, args ...interface{}) (database.Rows, error) {
+	rows, err := h.replica().Query(ctx, statement, args...)
+	if isReplicaUnavailable(err) {
+		return h.SqlHandler.Query(ctx, statement, args...)
+	}
+	return rows, err
+}
+
+// エラーはScanまで分からないので、Scanの時点でレプリカから読み、接続できなければプライマリから読み直す
+func (h *ReplicaHandler) QueryRow(ctx context.Context, statement string, args ...interface{}) database.Row {
+	return &replicaRow{handler: h, ctx: ctx, statement: statement, args: args}
+}
+
+// プライマリは呼び出し側が閉じるので、レプリカだけを閉じる
+func (h *ReplicaHandler) Close() error {
+	var errs []error
+	for _, replica := range h.replicas {
+		errs = append(errs, replica.Close())
+	}
+	return errors.Join(errs...)
+}
+
+func (h *ReplicaHandler) replica() database.SqlHandler {
+	return h.replicas[(h.next.Add(1)-1)%uint64(len(h.replicas))]
+}
+
+type replicaRow struct {
+	handler   *ReplicaHandler
+	ctx       context.Context
+	statement string
+	args      []interface{}
+}
+
+func (r *replicaRow) Scan(dest ...interface{}) error {
+	err := r.handler.replica().QueryRow(r.ctx, r.statement, r.args...).Scan(dest...)
+	if isReplicaUnavailable(err) {
+		return r.handler.SqlHandler.QueryRow(r.ctx, r.statement, r.args...).Scan(dest...)
+	}
+	return err
+}
+
+func isReplicaUnavailable(err error) bool {
+	return isConnectionError(err) || errors.Is(err, ErrCircuitOpen)
+}
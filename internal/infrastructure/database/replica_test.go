@@ -0,0 +1,63 @@
+package databaseInfra
+
+import (
+	"context"
+	"database/sql/driver"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"Aicon-assignment/internal/interfaces/database"
+)
+
+func TestReplicaHandler(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("正常系: レプリカがなければプライマリをそのまま使う", func(t *testing.T) {
+		primary := &fakeHandler{}
+
+		assert.Same(t, primary, NewReplicaHandler(primary, nil))
+	})
+
+	t.Run("正常系: 読み取りはレプリカを順番に使い、書き込みはプライマリで行う", func(t *testing.T) {
+		primary, first, second := &fakeHandler{}, &fakeHandler{}, &fakeHandler{}
+		handler := NewReplicaHandler(primary, []database.SqlHandler{first, second})
+
+		for i := 0; i < 3; i++ {
+			_, err := handler.Query(ctx, "SELECT id FROM items")
+			assert.NoError(t, err)
+		}
+		var count int
+		assert.NoError(t, handler.QueryRow(ctx, "SELECT COUNT(*) FROM items").Scan(&count))
+		_, err := handler.Execute(ctx, "DELETE FROM items WHERE id = ?", 1)
+		assert.NoError(t, err)
+
+		assert.Equal(t, 2, first.calls)
+		assert.Equal(t, 2, second.calls)
+		assert.Equal(t, 1, primary.calls)
+	})
+
+	t.Run("異常系: レプリカに接続できない場合はプライマリから読む", func(t *testing.T) {
+		primary := &fakeHandler{}
+		replica := &fakeHandler{errs: []error{driver.ErrBadConn, ErrCircuitOpen}}
+		handler := NewReplicaHandler(primary, []database.SqlHandler{replica})
+
+		_, err := handler.Query(ctx, "SELECT id FROM items")
+		assert.NoError(t, err)
+		var count int
+		assert.NoError(t, handler.QueryRow(ctx, "SELECT COUNT(*) FROM items").Scan(&count))
+
+		assert.Equal(t, 2, primary.calls)
+	})
+
+	t.Run("異常系: 接続できない以外のエラーはそのまま返す", func(t *testing.T) {
+		primary := &fakeHandler{}
+		replica := &fakeHandler{errs: []error{deadlock}}
+		handler := NewReplicaHandler(primary, []database.SqlHandler{replica})
+
+		_, err := handler.Query(ctx, "SELECT id FROM items")
+
+		assert.ErrorIs(t, err, database.ErrTransient)
+		assert.Equal(t, 0, primary.calls)
+	})
+}
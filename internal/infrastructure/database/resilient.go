@@ -0,0 +1,266 @@
+package databaseInfra
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"net"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+
+	domainErrors "Aicon-assignment/internal/domain/errors"
+	"Aicon-assignment/internal/interfaces/database"
+)
+
+// サーキットブレーカーが開いている間に返すエラー（503で返せるようドメインのエラーを包む）
+var ErrCircuitOpen = fmt.Errorf("%w (circuit breaker is open)", domainErrors.ErrDatabaseUnavailable)
+
+type ResilienceConfig struct {
+	// 再試行の回数（0の場合は再試行しない）
+	MaxRetries int
+	// 再試行までの待ち時間は BaseDelay から倍々に増やし、MaxDelay で打ち止めにする（実際の待ち時間はその範囲でランダム）
+	BaseDelay time.Duration
+	MaxDelay  time.Duration
+
+	// 接続エラーがこの回数続いたらブレーカーを開く（0の場合はブレーカーを使わない）
+	FailureThreshold int
+	// ブレーカーを開いてから、試しに1回だけ接続するまでの時間
+	OpenDuration time.Duration
+}
+
+// 一時的なエラーを再試行し、データベースに接続できない間はすぐに失敗させるハンドラーのデコレーター
+// トランザクション内の文は途中から再実行できないため、文では再試行せず、RetryTx でトランザクションごとやり直す
+type ResilientHandler struct {
+	database.SqlHandler
+	config  ResilienceConfig
+	breaker *circuitBreaker
+	sleep   func(ctx context.Context, d time.Duration) error
+}
+
+func NewResilientHandler(handler database.SqlHandler, config ResilienceConfig) *ResilientHandler {
+	return &ResilientHandler{
+		SqlHandler: handler,
+		config:     config,
+		breaker:    &circuitBreaker{threshold: config.FailureThreshold, openDuration: config.OpenDuration, now: time.Now},
+		sleep:      sleepContext,
+	}
+}
+
+// 書き込みは、デッドロックなど文が取り消されたことが確実な場合のみ再試行する
+// （接続が切れた場合は反映されたか分からないため再試行しない）
+func (h *ResilientHandler) Execute(ctx context.Context, statement string, args ...interface{}) (database.Result, error) {
+	var result database.Result
+	err := h.do(ctx, isTransient, func() error {
+		var err error
+		result, err = h.SqlHandler.Execute(ctx, statement, args...)
+		return err
+	})
+	return result, err
+}
+
+func (h *ResilientHandler) Query(ctx context.Context, statement string, args ...interface{}) (database.Rows, error) {
+	var rows database.Rows
+	err := h.do(ctx, isRetriableRead, func() error {
+		var err error
+		rows, err = h.SqlHandler.Query(ctx, statement, args...)
+		return err
+	})
+	return rows, err
+}
+
+// エラーはScanまで分からないので、Scanの時点で実行して再試行する
+func (h *ResilientHandler) QueryRow(ctx context.Context, statement string, args ...interface{}) database.Row {
+	return &resilientRow{handler: h, ctx: ctx, statement: statement, args: args}
+}
+
+func (h *ResilientHandler) Begin(ctx context.Context) (database.Tx, error) {
+	var tx database.Tx
+	err := h.do(ctx, isRetriableRead, func() error {
+		var err error
+		tx, err = h.SqlHandler.Begin(ctx)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &resilientTx{Tx: tx, breaker: h.breaker}, nil
+}
+
+// デッドロックなどでトランザクションが取り消された場合は、Begin からやり直す
+func (h *ResilientHandler) RetryTx(ctx context.Context, fn func() error) error {
+	for attempt := 0; ; attempt++ {
+		err := fn()
+		if err == nil || !isTransient(err) || attempt >= h.config.MaxRetries {
+			return err
+		}
+
+		if err := h.sleep(ctx, h.backoff(attempt)); err != nil {
+			return err
+		}
+	}
+}
+
+// retriable なエラーの間は待ち時間を空けて再実行する
+func (h *ResilientHandler) do(ctx context.Context, retriable func(error) bool, fn func() error) error {
+	for attempt := 0; ; attempt++ {
+		if !h.breaker.allow() {
+			return ErrCircuitOpen
+		}
+
+		err := fn()
+		h.breaker.record(err)
+		if err == nil || !retriable(err) || attempt >= h.config.MaxRetries {
+			return err
+		}
+
+		if err := h.sleep(ctx, h.backoff(attempt)); err != nil {
+			return err
+		}
+	}
+}
+
+// BaseDelay * 2^attempt（MaxDelayまで）の範囲でランダムに待つ
+func (h *ResilientHandler) backoff(attempt int) time.Duration {
+	delay := h.config.BaseDelay << attempt
+	if delay <= 0 || (h.config.MaxDelay > 0 && delay > h.config.MaxDelay) {
+		delay = h.config.MaxDelay
+	}
+	if delay <= 0 {
+		return 0
+	}
+	return time.Duration(rand.Int63n(int64(delay) + 1))
+}
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+type resilientRow struct {
+	handler   *ResilientHandler
+	ctx       context.Context
+	statement string
+	args      []interface{}
+}
+
+func (r *resilientRow) Scan(dest ...interface{}) error {
+	return r.handler.do(r.ctx, isRetriableRead, func() error {
+		return r.handler.SqlHandler.QueryRow(r.ctx, r.statement, r.args...).Scan(dest...)
+	})
+}
+
+type resilientTx struct {
+	database.Tx
+	breaker *circuitBreaker
+}
+
+func (t *resilientTx) Execute(ctx context.Context, statement string, args ...interface{}) (database.Result, error) {
+	result, err := t.Tx.Execute(ctx, statement, args...)
+	t.breaker.record(err)
+	return result, err
+}
+
+func (t *resilientTx) Query(ctx context.Context, statement string, args ...interface{}) (database.Rows, error) {
+	rows, err := t.Tx.Query(ctx, statement, args...)
+	t.breaker.record(err)
+	return rows, err
+}
+
+func (t *resilientTx) Commit() error {
+	err := t.Tx.Commit()
+	t.breaker.record(err)
+	return err
+}
+
+// 同じ文を再実行すれば成功しうるエラー
+func isTransient(err error) bool {
+	return errors.Is(err, database.ErrTransient)
+}
+
+// 読み取りは接続エラーでも再試行してよい
+func isRetriableRead(err error) bool {
+	return isTransient(err) || isConnectionError(err)
+}
+
+// データベースに接続できないことを示すエラー（ブレーカーの判定に使う）
+func isConnectionError(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	var netErr net.Error
+	return errors.Is(err, driver.ErrBadConn) ||
+		errors.Is(err, mysql.ErrInvalidConn) ||
+		errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, syscall.ECONNREFUSED) ||
+		errors.Is(err, syscall.ECONNRESET) ||
+		errors.As(err, &netErr)
+}
+
+// 接続エラーが threshold 回続くと開き、openDuration 経過後に1回だけ試して成功すれば閉じる
+type circuitBreaker struct {
+	threshold    int
+	openDuration time.Duration
+	now          func() time.Time
+
+	mu       sync.Mutex
+	failures int
+	openedAt time.Time
+	probing  bool
+}
+
+func (b *circuitBreaker) allow() bool {
+	if b.threshold <= 0 {
+		return true
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.failures < b.threshold {
+		return true
+	}
+	if b.probing || b.now().Sub(b.openedAt) < b.openDuration {
+		return false
+	}
+
+	b.probing = true
+	return true
+}
+
+// 接続エラー以外（制約違反やデッドロックなど）はデータベースが動いている証拠なので成功として扱う
+func (b *circuitBreaker) record(err error) {
+	if b.threshold <= 0 {
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	wasProbing := b.probing
+	b.probing = false
+
+	if !isConnectionError(err) {
+		b.failures = 0
+		return
+	}
+
+	b.failures++
+	if b.failures >= b.threshold || wasProbing {
+		b.failures = b.threshold
+		b.openedAt = b.now()
+	}
+}
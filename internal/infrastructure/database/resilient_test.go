@@ -0,0 +1,230 @@
+package databaseInfra
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	domainErrors "Aicon-assignment/internal/domain/errors"
+	"Aicon-assignment/internal/interfaces/database"
+)
+
+// fakeHandler は errs の順にエラーを返すハンドラー（使い切った後は成功する）
+type fakeHandler struct {
+	database.SqlHandler
+	errs  []error
+	calls int
+}
+
+func (h *fakeHandler) next() error {
+	h.calls++
+	if len(h.errs) == 0 {
+		return nil
+	}
+	err := h.errs[0]
+	h.errs = h.errs[1:]
+	return err
+}
+
+func (h *fakeHandler) Execute(ctx context.Context, statement string, args ...interface{}) (database.Result, error) {
+	return nil, h.next()
+}
+
+func (h *fakeHandler) Query(ctx context.Context, statement string, args ...interface{}) (database.Rows, error) {
+	return nil, h.next()
+}
+
+func (h *fakeHandler) QueryRow(ctx context.Context, statement string, args ...interface{}) database.Row {
+	return fakeRow{err: h.next()}
+}
+
+type fakeRow struct {
+	err error
+}
+
+func (r fakeRow) Scan(dest ...interface{}) error {
+	return r.err
+}
+
+func newTestResilientHandler(inner database.SqlHandler, config ResilienceConfig) *ResilientHandler {
+	handler := NewResilientHandler(inner, config)
+	handler.sleep = func(ctx context.Context, d time.Duration) error { return nil }
+	return handler
+}
+
+var (
+	deadlock  = fmt.Errorf("%w: Deadlock found when trying to get lock", database.ErrTransient)
+	badConn   = driver.ErrBadConn
+	duplicate = fmt.Errorf("%w: Duplicate entry", database.ErrUniqueViolation)
+)
+
+func TestResilientHandler_Retry(t *testing.T) {
+	ctx := context.Background()
+	config := ResilienceConfig{MaxRetries: 2}
+
+	t.Run("正常系: デッドロックは再試行して成功する", func(t *testing.T) {
+		inner := &fakeHandler{errs: []error{deadlock, deadlock}}
+		handler := newTestResilientHandler(inner, config)
+
+		_, err := handler.Execute(ctx, "UPDATE loans SET returned_at = ? WHERE id = ?")
+
+		assert.NoError(t, err)
+		assert.Equal(t, 3, inner.calls)
+	})
+
+	t.Run("正常系: 読み取りは接続エラーも再試行する", func(t *testing.T) {
+		inner := &fakeHandler{errs: []error{badConn}}
+		handler := newTestResilientHandler(inner, config)
+
+		var count int
+		err := handler.QueryRow(ctx, "SELECT COUNT(*) FROM items").Scan(&count)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2, inner.calls)
+	})
+
+	t.Run("異常系: 書き込みは接続エラーでは再試行しない", func(t *testing.T) {
+		inner := &fakeHandler{errs: []error{badConn}}
+		handler := newTestResilientHandler(inner, config)
+
+		_, err := handler.Execute(ctx, "INSERT INTO loans (item_id) VALUES (?)")
+
+		assert.ErrorIs(t, err, driver.ErrBadConn)
+		assert.Equal(t, 1, inner.calls)
+	})
+
+	t.Run("異常系: 制約違反は再試行しない", func(t *testing.T) {
+		inner := &fakeHandler{errs: []error{duplicate}}
+		handler := newTestResilientHandler(inner, config)
+
+		_, err := handler.Execute(ctx, "INSERT INTO items (serial_number) VALUES (?)")
+
+		assert.ErrorIs(t, err, database.ErrUniqueViolation)
+		assert.Equal(t, 1, inner.calls)
+	})
+
+	t.Run("異常系: 回数を超えたら最後のエラーを返す", func(t *testing.T) {
+		inner := &fakeHandler{errs: []error{deadlock, deadlock, deadlock, deadlock}}
+		handler := newTestResilientHandler(inner, config)
+
+		_, err := handler.Query(ctx, "SELECT id FROM items")
+
+		assert.ErrorIs(t, err, database.ErrTransient)
+		assert.Equal(t, 3, inner.calls)
+	})
+}
+
+func TestResilientHandler_RetryTx(t *testing.T) {
+	ctx := context.Background()
+	handler := newTestResilientHandler(&fakeHandler{}, ResilienceConfig{MaxRetries: 2})
+
+	t.Run("正常系: リポジトリが包んだデッドロックもトランザクションごとやり直す", func(t *testing.T) {
+		calls := 0
+		err := handler.RetryTx(ctx, func() error {
+			calls++
+			if calls == 1 {
+				return fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, deadlock)
+			}
+			return nil
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("異常系: 一時的なエラー以外はやり直さない", func(t *testing.T) {
+		calls := 0
+		err := handler.RetryTx(ctx, func() error {
+			calls++
+			return domainErrors.ErrVersionConflict
+		})
+
+		assert.ErrorIs(t, err, domainErrors.ErrVersionConflict)
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("異常系: 回数を超えたら最後のエラーを返す", func(t *testing.T) {
+		calls := 0
+		err := handler.RetryTx(ctx, func() error {
+			calls++
+			return deadlock
+		})
+
+		assert.ErrorIs(t, err, database.ErrTransient)
+		assert.Equal(t, 3, calls)
+	})
+}
+
+func TestResilientHandler_CircuitBreaker(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("正常系: 接続エラーが続くと開き、時間が経つと試して閉じる", func(t *testing.T) {
+		inner := &fakeHandler{errs: []error{badConn, badConn}}
+		handler := newTestResilientHandler(inner, ResilienceConfig{FailureThreshold: 2, OpenDuration: time.Minute})
+		now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		handler.breaker.now = func() time.Time { return now }
+
+		for i := 0; i < 2; i++ {
+			_, err := handler.Query(ctx, "SELECT id FROM items")
+			assert.ErrorIs(t, err, driver.ErrBadConn)
+		}
+
+		_, err := handler.Query(ctx, "SELECT id FROM items")
+		assert.ErrorIs(t, err, ErrCircuitOpen)
+		assert.ErrorIs(t, err, domainErrors.ErrDatabaseUnavailable)
+		assert.Equal(t, 2, inner.calls)
+
+		now = now.Add(time.Minute)
+		_, err = handler.Query(ctx, "SELECT id FROM items")
+		assert.NoError(t, err)
+
+		_, err = handler.Query(ctx, "SELECT id FROM items")
+		assert.NoError(t, err)
+		assert.Equal(t, 4, inner.calls)
+	})
+
+	t.Run("異常系: 試した1回が失敗すると再び開く", func(t *testing.T) {
+		inner := &fakeHandler{errs: []error{badConn, badConn}}
+		handler := newTestResilientHandler(inner, ResilienceConfig{FailureThreshold: 1, OpenDuration: time.Minute})
+		now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		handler.breaker.now = func() time.Time { return now }
+
+		_, err := handler.Query(ctx, "SELECT id FROM items")
+		assert.ErrorIs(t, err, driver.ErrBadConn)
+
+		now = now.Add(time.Minute)
+		_, err = handler.Query(ctx, "SELECT id FROM items")
+		assert.ErrorIs(t, err, driver.ErrBadConn)
+
+		_, err = handler.Query(ctx, "SELECT id FROM items")
+		assert.ErrorIs(t, err, ErrCircuitOpen)
+	})
+
+	t.Run("正常系: 接続エラー以外はブレーカーを開かない", func(t *testing.T) {
+		inner := &fakeHandler{errs: []error{duplicate, duplicate, duplicate}}
+		handler := newTestResilientHandler(inner, ResilienceConfig{FailureThreshold: 2, OpenDuration: time.Minute})
+
+		for i := 0; i < 3; i++ {
+			_, err := handler.Execute(ctx, "INSERT INTO items (serial_number) VALUES (?)")
+			require.ErrorIs(t, err, database.ErrUniqueViolation)
+		}
+
+		_, err := handler.Execute(ctx, "INSERT INTO items (serial_number) VALUES (?)")
+		assert.NoError(t, err)
+	})
+}
+
+func TestTranslateTransientErrors(t *testing.T) {
+	assert.True(t, isTransient(translatePostgresError(&pq.Error{Code: "40P01"})))
+	assert.True(t, isTransient(translateMySQLError(&mysql.MySQLError{Number: 1213})))
+	assert.False(t, isTransient(translateMySQLError(&mysql.MySQLError{Number: 1062})))
+	assert.False(t, isTransient(errors.New("syntax error")))
+}
@@ -3,10 +3,10 @@ package databaseInfra
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
-	"os"
 
-	_ "github.com/go-sql-driver/mysql"
+	"github.com/go-sql-driver/mysql"
 
 	"Aicon-assignment/internal/infrastructure/config"
 	"Aicon-assignment/internal/interfaces/database"
@@ -16,12 +16,13 @@ type MySqlHandler struct {
 	Conn *sql.DB
 }
 
-func NewSqlHandler() database.SqlHandler {
-	dsn := config.GetDSN()
+// リードレプリカにも同じ接続プールの設定で接続する
+func NewSqlHandler(dsn string, pool config.PoolConfig) database.SqlHandler {
 	conn, err := sql.Open("mysql", dsn)
 	if err != nil {
 		panic(fmt.Sprintf("❌ Failed to connect to database: %v", err))
 	}
+	configurePool(conn, pool)
 
 	// DB接続が確立できているかを確認
 	if err := conn.Ping(); err != nil {
@@ -30,24 +31,13 @@ func NewSqlHandler() database.SqlHandler {
 
 	fmt.Println("✅ Successfully connected to the database!")
 
-	sqlBytes, err := os.ReadFile("sql/init.sql")
-	if err != nil {
-		fmt.Printf("❌ Failed to read init.sql: %v\n", err)
-	} else {
-		if _, err := conn.Exec(string(sqlBytes)); err != nil {
-			fmt.Printf("❌ Failed to execute init.sql: %v\n", err)
-		} else {
-			fmt.Println("✅ Successfully initialized database from init.sql")
-		}
-	}
-
 	return &MySqlHandler{Conn: conn}
 }
 
 func (h *MySqlHandler) Execute(ctx context.Context, statement string, args ...interface{}) (database.Result, error) {
 	result, err := h.Conn.ExecContext(ctx, statement, args...)
 	if err != nil {
-		return nil, err
+		return nil, translateMySQLError(err)
 	}
 	return &mysqlResult{result: result}, nil
 }
@@ -55,7 +45,7 @@ func (h *MySqlHandler) Execute(ctx context.Context, statement string, args ...in
 func (h *MySqlHandler) Query(ctx context.Context, statement string, args ...interface{}) (database.Rows, error) {
 	rows, err := h.Conn.QueryContext(ctx, statement, args...)
 	if err != nil {
-		return nil, err
+		return nil, translateMySQLError(err)
 	}
 	return &mysqlRows{rows: rows}, nil
 }
@@ -65,6 +55,18 @@ func (h *MySqlHandler) QueryRow(ctx context.Context, statement string, args ...i
 	return &mysqlRow{row: row}
 }
 
+func (h *MySqlHandler) Begin(ctx context.Context) (database.Tx, error) {
+	tx, err := h.Conn.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &mysqlTx{tx: tx}, nil
+}
+
+func (h *MySqlHandler) Dialect() database.Dialect {
+	return database.DialectMySQL
+}
+
 func (h *MySqlHandler) Close() error {
 	if h.Conn != nil {
 		return h.Conn.Close()
@@ -72,6 +74,46 @@ func (h *MySqlHandler) Close() error {
 	return nil
 }
 
+// 接続プールの設定を反映する
+func configurePool(conn *sql.DB, pool config.PoolConfig) {
+	conn.SetMaxOpenConns(pool.MaxOpenConns)
+	conn.SetMaxIdleConns(pool.MaxIdleConns)
+	conn.SetConnMaxLifetime(pool.ConnMaxLifetime)
+}
+
+type mysqlTx struct {
+	tx *sql.Tx
+}
+
+func (t *mysqlTx) Execute(ctx context.Context, statement string, args ...interface{}) (database.Result, error) {
+	result, err := t.tx.ExecContext(ctx, statement, args...)
+	if err != nil {
+		return nil, translateMySQLError(err)
+	}
+	return &mysqlResult{result: result}, nil
+}
+
+func (t *mysqlTx) Query(ctx context.Context, statement string, args ...interface{}) (database.Rows, error) {
+	rows, err := t.tx.QueryContext(ctx, statement, args...)
+	if err != nil {
+		return nil, translateMySQLError(err)
+	}
+	return &mysqlRows{rows: rows}, nil
+}
+
+func (t *mysqlTx) QueryRow(ctx context.Context, statement string, args ...interface{}) database.Row {
+	row := t.tx.QueryRowContext(ctx, statement, args...)
+	return &mysqlRow{row: row}
+}
+
+func (t *mysqlTx) Commit() error {
+	return t.tx.Commit()
+}
+
+func (t *mysqlTx) Rollback() error {
+	return t.tx.Rollback()
+}
+
 type mysqlResult struct {
 	result sql.Result
 }
@@ -109,5 +151,23 @@ type mysqlRow struct {
 }
 
 func (r *mysqlRow) Scan(dest ...interface{}) error {
-	return r.row.Scan(dest...)
+	return translateMySQLError(r.row.Scan(dest...))
+}
+
+// 制約違反と一時的なエラーをドライバーに依存しないエラーに変換する
+func translateMySQLError(err error) error {
+	var mysqlErr *mysql.MySQLError
+	if !errors.As(err, &mysqlErr) {
+		return err
+	}
+
+	switch mysqlErr.Number {
+	case 1062: // ER_DUP_ENTRY
+		return fmt.Errorf("%w: %s", database.ErrUniqueViolation, err.Error())
+	case 1451, 1452: // ER_ROW_IS_REFERENCED_2, ER_NO_REFERENCED_ROW_2
+		return fmt.Errorf("%w: %s", database.ErrForeignKeyViolation, err.Error())
+	case 1205, 1213: // ER_LOCK_WAIT_TIMEOUT, ER_LOCK_DEADLOCK
+		return fmt.Errorf("%w: %s", database.ErrTransient, err.Error())
+	}
+	return err
 }
@@ -0,0 +1,133 @@
+package databaseInfra
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/url"
+
+	"modernc.org/sqlite"
+	sqlite3 "modernc.org/sqlite/lib"
+
+	"Aicon-assignment/internal/interfaces/database"
+)
+
+// SQLite用のハンドラー。Cを使わないドライバーなので、サーバーなしで1つのバイナリとファイルだけで動く
+type SQLiteHandler struct {
+	Conn *sql.DB
+}
+
+// path は保存先のファイル（":memory:" の場合はメモリ上）
+func NewSQLiteHandler(path string) (database.SqlHandler, error) {
+	// 外部キー制約を有効にし、書き込みのトランザクションは開始時にロックを取って途中で失敗しないようにする
+	query := url.Values{}
+	query.Add("_pragma", "foreign_keys(1)")
+	query.Add("_pragma", "busy_timeout(5000)")
+	query.Set("_txlock", "immediate")
+	query.Set("_time_format", "sqlite")
+
+	conn, err := sql.Open("sqlite", "file:"+path+"?"+query.Encode())
+	if err != nil {
+		return nil, fmt.Errorf("failed to open database: %w", err)
+	}
+
+	// 書き込みは1つずつしかできないため、接続を1つにしてロックの競合を避ける（":memory:" では接続ごとに別のDBになるのも防ぐ）
+	conn.SetMaxOpenConns(1)
+
+	return &SQLiteHandler{Conn: conn}, nil
+}
+
+func (h *SQLiteHandler) Execute(ctx context.Context, statement string, args ...interface{}) (database.Result, error) {
+	result, err := h.Conn.ExecContext(ctx, statement, args...)
+	if err != nil {
+		return nil, translateSQLiteError(err)
+	}
+	return &mysqlResult{result: result}, nil
+}
+
+func (h *SQLiteHandler) Query(ctx context.Context, statement string, args ...interface{}) (database.Rows, error) {
+	rows, err := h.Conn.QueryContext(ctx, statement, args...)
+	if err != nil {
+		return nil, translateSQLiteError(err)
+	}
+	return &mysqlRows{rows: rows}, nil
+}
+
+func (h *SQLiteHandler) QueryRow(ctx context.Context, statement string, args ...interface{}) database.Row {
+	row := h.Conn.QueryRowContext(ctx, statement, args...)
+	return &mysqlRow{row: row}
+}
+
+func (h *SQLiteHandler) Begin(ctx context.Context) (database.Tx, error) {
+	tx, err := h.Conn.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &sqliteTx{tx: tx}, nil
+}
+
+func (h *SQLiteHandler) Dialect() database.Dialect {
+	return database.DialectSQLite
+}
+
+func (h *SQLiteHandler) Close() error {
+	if h.Conn != nil {
+		return h.Conn.Close()
+	}
+	return nil
+}
+
+type sqliteTx struct {
+	tx *sql.Tx
+}
+
+func (t *sqliteTx) Execute(ctx context.Context, statement string, args ...interface{}) (database.Result, error) {
+	result, err := t.tx.ExecContext(ctx, statement, args...)
+	if err != nil {
+		return nil, translateSQLiteError(err)
+	}
+	return &mysqlResult{result: result}, nil
+}
+
+func (t *sqliteTx) Query(ctx context.Context, statement string, args ...interface{}) (database.Rows, error) {
+	rows, err := t.tx.QueryContext(ctx, statement, args...)
+	if err != nil {
+		return nil, translateSQLiteError(err)
+	}
+	return &mysqlRows{rows: rows}, nil
+}
+
+func (t *sqliteTx) QueryRow(ctx context.Context, statement string, args ...interface{}) database.Row {
+	row := t.tx.QueryRowContext(ctx, statement, args...)
+	return &mysqlRow{row: row}
+}
+
+func (t *sqliteTx) Commit() error {
+	return t.tx.Commit()
+}
+
+func (t *sqliteTx) Rollback() error {
+	return t.tx.Rollback()
+}
+
+// 制約違反をドライバーに依存しないエラーに変換する
+func translateSQLiteError(err error) error {
+	var sqliteErr *sqlite.Error
+	if !errors.As(err, &sqliteErr) {
+		return err
+	}
+
+	switch sqliteErr.Code() {
+	case sqlite3.SQLITE_CONSTRAINT_UNIQUE, sqlite3.SQLITE_CONSTRAINT_PRIMARYKEY:
+		return fmt.Errorf("%w: %s", database.ErrUniqueViolation, err.Error())
+	case sqlite3.SQLITE_CONSTRAINT_FOREIGNKEY:
+		return fmt.Errorf("%w: %s", database.ErrForeignKeyViolation, err.Error())
+	}
+	// 拡張コードの下位8ビットが基本のコード
+	switch sqliteErr.Code() & 0xff {
+	case sqlite3.SQLITE_BUSY, sqlite3.SQLITE_LOCKED:
+		return fmt.Errorf("%w: %s", database.ErrTransient, err.Error())
+	}
+	return err
+}
@@ -0,0 +1,242 @@
+package databaseInfra
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"Aicon-assignment/internal/domain/entity"
+	domainErrors "Aicon-assignment/internal/domain/errors"
+	"Aicon-assignment/internal/infrastructure/migration"
+	"Aicon-assignment/internal/interfaces/database"
+	"Aicon-assignment/internal/usecase"
+)
+
+// 初期データ入りのSQLiteをメモリ上に作る
+func newSQLiteHandler(t *testing.T) database.SqlHandler {
+	handler, err := NewSQLiteHandler(":memory:")
+	require.NoError(t, err)
+	t.Cleanup(func() { handler.Close() })
+
+	runner, err := migration.NewRunner(handler)
+	require.NoError(t, err)
+	_, err = runner.Up(context.Background())
+	require.NoError(t, err)
+
+	return handler
+}
+
+func TestSQLiteHandler_ItemRepository(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("正常系: 初期データと集計", func(t *testing.T) {
+		repo := &database.ItemRepository{SqlHandler: newSQLiteHandler(t)}
+
+		items, err := repo.FindAll(ctx, entity.ItemFilter{})
+		require.NoError(t, err)
+		assert.Len(t, items, 5)
+
+		found, err := repo.FindBySerialNumber(ctx, "D123456")
+		require.NoError(t, err)
+		assert.Equal(t, "ロレックス デイトナ", found.Name)
+		assert.Equal(t, "2023-01-15", found.PurchaseDate)
+
+		summary, err := repo.GetSummaryByCategory(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 1, summary["時計"])
+	})
+
+	t.Run("正常系: 登録・更新・属性での絞り込み", func(t *testing.T) {
+		repo := &database.ItemRepository{SqlHandler: newSQLiteHandler(t)}
+
+		item, err := entity.NewItem("オメガ スピードマスター", "時計", "OMEGA", 800000, "2024-06-01")
+		require.NoError(t, err)
+		item.Attributes = map[string]string{"movement": "manual"}
+		created, err := repo.Create(ctx, item)
+		require.NoError(t, err)
+		assert.Equal(t, int64(6), created.ID)
+		assert.False(t, created.CreatedAt.IsZero())
+
+		created.Condition = "A"
+		created.UpdatedAt = time.Now()
+		require.NoError(t, repo.Update(ctx, created))
+
+		items, err := repo.FindAll(ctx, entity.ItemFilter{Attributes: map[string]string{"movement": "manual"}, Condition: "A"})
+		require.NoError(t, err)
+		require.Len(t, items, 1)
+		assert.Equal(t, created.ID, items[0].ID)
+
+		conditions, err := repo.GetSummaryByCondition(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]int{"A": 1}, conditions)
+	})
+
+	t.Run("正常系: 削除で貸出も消え、変更がアウトボックスに残る", func(t *testing.T) {
+		handler := newSQLiteHandler(t)
+		repo := &database.ItemRepository{SqlHandler: handler}
+		loanRepo := &database.LoanRepository{SqlHandler: handler}
+		outboxRepo := &database.OutboxRepository{SqlHandler: handler}
+
+		loan, err := loanRepo.Create(ctx, &entity.Loan{ItemID: 1, Borrower: "山田", DueDate: "2099-01-01", LoanedAt: time.Now()})
+		require.NoError(t, err)
+		assert.Equal(t, "2099-01-01", loan.DueDate)
+
+		found, err := repo.FindByID(ctx, 1)
+		require.NoError(t, err)
+		assert.True(t, found.OnLoan)
+
+		require.NoError(t, repo.Delete(ctx, 1))
+
+		_, err = loanRepo.FindByID(ctx, loan.ID)
+		assert.ErrorIs(t, err, domainErrors.ErrLoanNotFound)
+
+		messages, err := outboxRepo.FindPending(ctx, 10)
+		require.NoError(t, err)
+		require.Len(t, messages, 1)
+		assert.Equal(t, entity.EventItemDeleted, messages[0].EventType)
+		assert.Equal(t, int64(1), messages[0].AggregateID)
+	})
+
+	t.Run("正常系: 統合で重複を論理削除し集計から除く", func(t *testing.T) {
+		repo := &database.ItemRepository{SqlHandler: newSQLiteHandler(t)}
+
+		require.NoError(t, repo.Merge(ctx, 1, []int64{5}))
+
+		_, err := repo.FindByID(ctx, 5)
+		assert.ErrorIs(t, err, domainErrors.ErrItemNotFound)
+
+		summary, err := repo.GetSummaryByCategory(ctx)
+		require.NoError(t, err)
+		assert.NotContains(t, summary, "その他")
+	})
+
+	t.Run("異常系: 制約違反をドメインエラーに変換", func(t *testing.T) {
+		handler := newSQLiteHandler(t)
+		repo := &database.ItemRepository{SqlHandler: handler}
+		loanRepo := &database.LoanRepository{SqlHandler: handler}
+
+		item, err := entity.NewItem("ロレックス デイトナ", "時計", "ROLEX", 1500000, "2023-01-15")
+		require.NoError(t, err)
+		item.SerialNumber = "D123456"
+		_, err = repo.Create(ctx, item)
+		assert.ErrorIs(t, err, domainErrors.ErrDuplicateSerial)
+
+		_, err = loanRepo.Create(ctx, &entity.Loan{ItemID: 999, Borrower: "山田", DueDate: "2099-01-01", LoanedAt: time.Now()})
+		assert.ErrorIs(t, err, domainErrors.ErrItemNotFound)
+	})
+}
+
+func TestSQLiteHandler_Transactor(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("正常系: 複数のリポジトリの変更をまとめてコミットする", func(t *testing.T) {
+		handler := newSQLiteHandler(t)
+		transactor := &database.Transactor{SqlHandler: handler}
+
+		err := transactor.WithTx(ctx, func(repos usecase.Repositories) error {
+			item, err := entity.NewItem("オメガ スピードマスター", "時計", "OMEGA", 800000, "2024-06-01")
+			require.NoError(t, err)
+			created, err := repos.Items.Create(ctx, item)
+			if err != nil {
+				return err
+			}
+
+			loan, err := entity.NewLoan(created.ID, "山田", "2099-01-01")
+			require.NoError(t, err)
+			_, err = repos.Loans.Create(ctx, loan)
+			return err
+		})
+		require.NoError(t, err)
+
+		found, err := (&database.ItemRepository{SqlHandler: handler}).FindByID(ctx, 6)
+		require.NoError(t, err)
+		assert.True(t, found.OnLoan)
+	})
+
+	t.Run("異常系: 途中で失敗するとリポジトリ内のトランザクションも含めてロールバックする", func(t *testing.T) {
+		handler := newSQLiteHandler(t)
+		transactor := &database.Transactor{SqlHandler: handler}
+
+		err := transactor.WithTx(ctx, func(repos usecase.Repositories) error {
+			item, err := entity.NewItem("オメガ スピードマスター", "時計", "OMEGA", 800000, "2024-06-01")
+			require.NoError(t, err)
+			if _, err := repos.Items.Create(ctx, item); err != nil {
+				return err
+			}
+
+			if err := repos.Items.Merge(ctx, 1, []int64{2}); err != nil {
+				return err
+			}
+
+			// 存在しないアイテムへの貸出は外部キー制約違反
+			loan, err := entity.NewLoan(999, "山田", "2099-01-01")
+			require.NoError(t, err)
+			_, err = repos.Loans.Create(ctx, loan)
+			return err
+		})
+		assert.ErrorIs(t, err, domainErrors.ErrItemNotFound)
+
+		repo := &database.ItemRepository{SqlHandler: handler}
+		items, err := repo.FindAll(ctx, entity.ItemFilter{})
+		require.NoError(t, err)
+		assert.Len(t, items, 5)
+
+		summary, err := repo.GetSummaryByCategory(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 1, summary["時計"])
+	})
+}
+
+func TestSQLiteHandler_BackupRepository(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("正常系: 書き出した内容をIDを保ったまま別のデータベースに復元する", func(t *testing.T) {
+		source := newSQLiteHandler(t)
+		location, err := (&database.LocationRepository{SqlHandler: source}).Create(ctx, &entity.Location{Name: "金庫"})
+		require.NoError(t, err)
+		loan, err := entity.NewLoan(2, "山田", "2099-01-01")
+		require.NoError(t, err)
+		_, err = (&database.LoanRepository{SqlHandler: source}).Create(ctx, loan)
+		require.NoError(t, err)
+		require.NoError(t, (&database.ItemRepository{SqlHandler: source}).Delete(ctx, 5))
+
+		backup, err := (&database.BackupRepository{SqlHandler: source}).Dump(ctx)
+		require.NoError(t, err)
+		assert.Len(t, backup.Items, 4)
+		assert.Len(t, backup.Loans, 1)
+		assert.Equal(t, location.ID, backup.Locations[0].ID)
+		backup.Version = entity.BackupVersion
+		require.NoError(t, backup.Validate())
+
+		target := newSQLiteHandler(t)
+		restoreRepo := &database.BackupRepository{SqlHandler: target}
+		err = restoreRepo.Restore(ctx, backup, false)
+		assert.ErrorIs(t, err, domainErrors.ErrRestoreNotEmpty)
+
+		require.NoError(t, restoreRepo.Restore(ctx, backup, true))
+
+		itemRepo := &database.ItemRepository{SqlHandler: target}
+		restored, err := itemRepo.FindByID(ctx, 2)
+		require.NoError(t, err)
+		assert.Equal(t, "エルメス バーキン", restored.Name)
+		assert.True(t, restored.OnLoan)
+		_, err = itemRepo.FindByID(ctx, 5)
+		assert.ErrorIs(t, err, domainErrors.ErrItemNotFound)
+
+		summary, err := itemRepo.GetSummaryByCategory(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 0, summary["その他"])
+		assert.Equal(t, 1, summary["時計"])
+
+		// 採番は復元したIDの続きから
+		item, err := entity.NewItem("オメガ スピードマスター", "時計", "OMEGA", 800000, "2024-06-01")
+		require.NoError(t, err)
+		item.SerialNumber = "OM-0001"
+		created, err := itemRepo.Create(ctx, item)
+		require.NoError(t, err)
+		assert.Equal(t, int64(6), created.ID)
+	})
+}
@@ -0,0 +1,61 @@
+package eventbus
+
+import (
+	"context"
+	"log"
+	"sync"
+
+	"Aicon-assignment/internal/domain/event"
+)
+
+// イベントを受け取る処理（時間のかかる処理は自分でゴルーチンに逃がすこと）
+type Handler func(ctx context.Context, e event.Event)
+
+// プロセス内で同期的にイベントを配る usecase.EventBus の実装
+type Bus struct {
+	mu       sync.RWMutex
+	handlers map[string][]Handler
+	all      []Handler
+}
+
+func New() *Bus {
+	return &Bus{
+		handlers: make(map[string][]Handler),
+	}
+}
+
+// 指定した種類のイベントを購読する
+func (b *Bus) Subscribe(name string, handler Handler) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.handlers[name] = append(b.handlers[name], handler)
+}
+
+// 全てのイベントを購読する
+func (b *Bus) SubscribeAll(handler Handler) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.all = append(b.all, handler)
+}
+
+func (b *Bus) Publish(ctx context.Context, e event.Event) {
+	b.mu.RLock()
+	handlers := make([]Handler, 0, len(b.all)+len(b.handlers[e.Name()]))
+	handlers = append(handlers, b.all...)
+	handlers = append(handlers, b.handlers[e.Name()]...)
+	b.mu.RUnlock()
+
+	for _, handler := range handlers {
+		b.dispatch(ctx, e, handler)
+	}
+}
+
+// 購読側のpanicで発行元の操作や他の購読者を巻き込まない
+func (b *Bus) dispatch(ctx context.Context, e event.Event, handler Handler) {
+	defer func() {
+		if r := recover(); r != nil {
+			log.Printf("event handler for %s panicked: %v", e.Name(), r)
+		}
+	}()
+	handler(ctx, e)
+}
@@ -0,0 +1,33 @@
+package eventbus
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"Aicon-assignment/internal/domain/entity"
+	"Aicon-assignment/internal/domain/event"
+)
+
+func TestBus_Publish(t *testing.T) {
+	bus := New()
+
+	var all, deleted []string
+	bus.SubscribeAll(func(_ context.Context, e event.Event) {
+		all = append(all, e.Name())
+	})
+	bus.Subscribe(entity.EventItemDeleted, func(_ context.Context, e event.Event) {
+		deleted = append(deleted, e.Name())
+	})
+	// panicする購読者がいても他の購読者には届く
+	bus.SubscribeAll(func(context.Context, event.Event) {
+		panic("boom")
+	})
+
+	bus.Publish(context.Background(), event.NewItemCreated(&entity.Item{ID: 1}))
+	bus.Publish(context.Background(), event.NewItemDeleted(&entity.Item{ID: 1}))
+
+	assert.Equal(t, []string{entity.EventItemCreated, entity.EventItemDeleted}, all)
+	assert.Equal(t, []string{entity.EventItemDeleted}, deleted)
+}
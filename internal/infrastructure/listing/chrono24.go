@@ -0,0 +1,73 @@
+package listing
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"Aicon-assignment/internal/domain/entity"
+	domainErrors "Aicon-assignment/internal/domain/errors"
+)
+
+// 国ごとのChrono24のドメイン
+var chrono24Domains = []string{
+	"chrono24.jp", "chrono24.com", "chrono24.de", "chrono24.fr", "chrono24.it", "chrono24.es",
+	"chrono24.co.uk", "chrono24.ch", "chrono24.at", "chrono24.nl", "chrono24.com.au", "chrono24.hk", "chrono24.sg",
+}
+
+// Chrono24の出品ページ（構造化データの Product を読み取る）
+type Chrono24Provider struct {
+	client *http.Client
+}
+
+func NewChrono24Provider() *Chrono24Provider {
+	p := &Chrono24Provider{}
+	p.client = newClient(p.Supports)
+	return p
+}
+
+func (p *Chrono24Provider) Supports(u *url.URL) bool {
+	host := strings.TrimPrefix(strings.ToLower(u.Hostname()), "www.")
+	for _, domain := range chrono24Domains {
+		if host == domain {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *Chrono24Provider) Fetch(ctx context.Context, u *url.URL) (*entity.Listing, error) {
+	pg, err := fetchPage(ctx, p.client, u)
+	if err != nil {
+		return nil, err
+	}
+	return parseChrono24(u, pg)
+}
+
+func parseChrono24(u *url.URL, pg *page) (*entity.Listing, error) {
+	listing := &entity.Listing{
+		URL:      u.String(),
+		Site:     entity.ListingSiteChrono24,
+		Category: "時計",
+	}
+
+	var photos []string
+	if product, ok := pg.product(); ok {
+		listing.Title = product.name
+		listing.Brand = product.brand
+		listing.Price = product.price
+		listing.Currency = product.currency
+		photos = product.images
+	}
+	if listing.Title == "" {
+		listing.Title = pg.metaContent("og:title")
+	}
+	if listing.Title == "" {
+		return nil, fmt.Errorf("%w: no listing found on the page", domainErrors.ErrListingUnavailable)
+	}
+	photos = append(photos, pg.meta["og:image"]...)
+	listing.Photos = resolvePhotos(u, photos)
+	return listing, nil
+}
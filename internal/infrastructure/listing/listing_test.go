@@ -0,0 +1,175 @@
+package listing
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	domainErrors "Aicon-assignment/internal/domain/errors"
+)
+
+const chrono24Page = `<!DOCTYPE html>
+<html><head>
+<meta property="og:title" content="Rolex Daytona | Chrono24">
+<meta property="og:image" content="https://img.chrono24.com/images/uhren/1-front.jpg">
+<script type="application/ld+json">{"@context":"https://schema.org","@graph":[
+  {"@type":"BreadcrumbList","itemListElement":[]},
+  {"@type":"Product","name":"Rolex Daytona 116500LN","brand":{"@type":"Brand","name":"Rolex"},
+   "image":["https://img.chrono24.com/images/uhren/1-front.jpg","/images/uhren/1-back.jpg"],
+   "offers":{"@type":"Offer","price":"3,480,000","priceCurrency":"jpy"}}
+]}</script>
+</head><body></body></html>`
+
+const yahooAuctionsPage = `<!DOCTYPE html>
+<html><head>
+<meta property="og:title" content="HERMES バーキン30 トゴ ゴールド - ヤフオク!">
+<meta property="og:image" content="https://auctions.c.yimg.jp/images.auctions.yahoo.co.jp/image/dr000/auc0101/users/1/i-img1200x900-1.jpg">
+<meta property="og:image" content="https://auctions.c.yimg.jp/images.auctions.yahoo.co.jp/image/dr000/auc0101/users/1/i-img1200x900-2.jpg">
+<script>
+var pageData = {"items":{"productID":"x1000000001","productName":"HERMES バーキン30 トゴ ゴールド","price":"1800000","taxinPrice":"1980000"}};
+</script>
+</head><body></body></html>`
+
+func mustParseURL(t *testing.T, raw string) *url.URL {
+	t.Helper()
+	u, err := url.Parse(raw)
+	require.NoError(t, err)
+	return u
+}
+
+func TestChrono24Provider(t *testing.T) {
+	p := NewChrono24Provider()
+
+	t.Run("正常系: 国ごとのドメインに対応する", func(t *testing.T) {
+		assert.True(t, p.Supports(mustParseURL(t, "https://www.chrono24.jp/rolex/daytona--id1.htm")))
+		assert.True(t, p.Supports(mustParseURL(t, "https://www.chrono24.co.uk/rolex/daytona--id1.htm")))
+		assert.False(t, p.Supports(mustParseURL(t, "https://chrono24.example.com/rolex/daytona--id1.htm")))
+	})
+
+	t.Run("正常系: 構造化データの Product を読み取る", func(t *testing.T) {
+		u := mustParseURL(t, "https://www.chrono24.jp/rolex/daytona--id1.htm")
+		pg, err := parsePage(strings.NewReader(chrono24Page))
+		require.NoError(t, err)
+
+		listing, err := parseChrono24(u, pg)
+		require.NoError(t, err)
+
+		assert.Equal(t, "chrono24", listing.Site)
+		assert.Equal(t, "Rolex Daytona 116500LN", listing.Title)
+		assert.Equal(t, "Rolex", listing.Brand)
+		assert.Equal(t, "時計", listing.Category)
+		assert.Equal(t, 3480000, listing.Price)
+		assert.Equal(t, "JPY", listing.Currency)
+		// 相対URLは出品ページを基準にし、og:image と重複する写真は1つにする
+		assert.Equal(t, []string{
+			"https://img.chrono24.com/images/uhren/1-front.jpg",
+			"https://www.chrono24.jp/images/uhren/1-back.jpg",
+		}, listing.Photos)
+	})
+
+	t.Run("異常系: 出品の情報がないページ", func(t *testing.T) {
+		pg, err := parsePage(strings.NewReader(`<html><head><title>Not Found</title></head></html>`))
+		require.NoError(t, err)
+
+		_, err = parseChrono24(mustParseURL(t, "https://www.chrono24.jp/"), pg)
+		assert.ErrorIs(t, err, domainErrors.ErrListingUnavailable)
+	})
+}
+
+func TestYahooAuctionsProvider(t *testing.T) {
+	p := NewYahooAuctionsProvider()
+
+	t.Run("正常系: 出品ページのドメインに対応する", func(t *testing.T) {
+		assert.True(t, p.Supports(mustParseURL(t, "https://page.auctions.yahoo.co.jp/jp/auction/x1000000001")))
+		assert.False(t, p.Supports(mustParseURL(t, "https://shopping.yahoo.co.jp/")))
+	})
+
+	t.Run("正常系: 埋め込みの商品情報から税込みの価格を読み取る", func(t *testing.T) {
+		u := mustParseURL(t, "https://page.auctions.yahoo.co.jp/jp/auction/x1000000001")
+		pg, err := parsePage(strings.NewReader(yahooAuctionsPage))
+		require.NoError(t, err)
+
+		listing, err := parseYahooAuctions(u, pg)
+		require.NoError(t, err)
+
+		assert.Equal(t, "yahoo_auctions", listing.Site)
+		assert.Equal(t, "HERMES バーキン30 トゴ ゴールド", listing.Title)
+		assert.Empty(t, listing.Brand)
+		assert.Equal(t, 1980000, listing.Price)
+		assert.Equal(t, "JPY", listing.Currency)
+		assert.Len(t, listing.Photos, 2)
+	})
+
+	t.Run("正常系: 商品情報がなければ og:title からサイト名を除く", func(t *testing.T) {
+		pg, err := parsePage(strings.NewReader(`<meta property="og:title" content="OMEGA スピードマスター - ヤフオク!">`))
+		require.NoError(t, err)
+
+		listing, err := parseYahooAuctions(mustParseURL(t, "https://page.auctions.yahoo.co.jp/jp/auction/x1"), pg)
+		require.NoError(t, err)
+		assert.Equal(t, "OMEGA スピードマスター", listing.Title)
+		assert.Zero(t, listing.Price)
+	})
+}
+
+func TestFetchPage(t *testing.T) {
+	t.Run("正常系: 取得したページを解析する", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, userAgent, r.Header.Get("User-Agent"))
+			io.WriteString(w, chrono24Page)
+		}))
+		defer server.Close()
+
+		pg, err := fetchPage(context.Background(), server.Client(), mustParseURL(t, server.URL))
+		require.NoError(t, err)
+		assert.Equal(t, "Rolex Daytona | Chrono24", pg.metaContent("og:title"))
+	})
+
+	t.Run("異常系: 200以外の応答", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+		}))
+		defer server.Close()
+
+		_, err := fetchPage(context.Background(), server.Client(), mustParseURL(t, server.URL))
+		assert.ErrorIs(t, err, domainErrors.ErrListingUnavailable)
+		assert.Contains(t, err.Error(), "status 404")
+	})
+
+	t.Run("異常系: 対応するサイトの外へのリダイレクト", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.Redirect(w, r, "http://169.254.169.254/latest/meta-data/", http.StatusFound)
+		}))
+		defer server.Close()
+
+		client := newClient(func(u *url.URL) bool { return u.Host == mustParseURL(t, server.URL).Host })
+		_, err := fetchPage(context.Background(), client, mustParseURL(t, server.URL))
+		assert.ErrorIs(t, err, domainErrors.ErrListingUnavailable)
+		assert.Contains(t, err.Error(), "unsupported host")
+	})
+}
+
+func TestParsePrice(t *testing.T) {
+	tests := []struct {
+		in   any
+		want int
+		ok   bool
+	}{
+		{in: 3480000.0, want: 3480000, ok: true},
+		{in: "¥3,480,000", want: 3480000, ok: true},
+		{in: "12500.50", want: 12501, ok: true},
+		{in: "お問い合わせください", ok: false},
+		{in: nil, ok: false},
+	}
+	for _, tt := range tests {
+		got, ok := parsePrice(tt.in)
+		assert.Equal(t, tt.ok, ok, "%v", tt.in)
+		assert.Equal(t, tt.want, got, "%v", tt.in)
+	}
+}
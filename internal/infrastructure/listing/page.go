@@ -0,0 +1,307 @@
+package listing
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/net/html"
+
+	domainErrors "Aicon-assignment/internal/domain/errors"
+)
+
+const (
+	// 読み込む出品ページの大きさの上限
+	maxPageBytes = 5 << 20
+	// 下書きに含める写真の数の上限
+	maxPhotos = 20
+	userAgent = "Mozilla/5.0 (compatible; Aicon-assignment listing import)"
+)
+
+// 出品ページから取り出した、サイトによらない内容
+type page struct {
+	meta    map[string][]string // <meta> の property または name ごとの content（出現順）
+	jsonLD  []any               // <script type="application/ld+json"> の内容
+	scripts []string            // それ以外の <script> の内容
+}
+
+// 対応するサイトの外へはリダイレクトしない HTTPクライアント
+func newClient(supports func(u *url.URL) bool) *http.Client {
+	return &http.Client{
+		Timeout: 10 * time.Second,
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if len(via) >= 5 {
+				return fmt.Errorf("stopped after %d redirects", len(via))
+			}
+			if !supports(req.URL) {
+				return fmt.Errorf("redirected to unsupported host %s", req.URL.Hostname())
+			}
+			return nil
+		},
+	}
+}
+
+// 出品ページを取得して解析する
+func fetchPage(ctx context.Context, client *http.Client, u *url.URL) (*page, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", domainErrors.ErrListingUnavailable, err)
+	}
+	req.Header.Set("User-Agent", userAgent)
+	req.Header.Set("Accept", "text/html")
+	req.Header.Set("Accept-Language", "ja,en;q=0.8")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", domainErrors.ErrListingUnavailable, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		io.Copy(io.Discard, resp.Body)
+		return nil, fmt.Errorf("%w: status %d", domainErrors.ErrListingUnavailable, resp.StatusCode)
+	}
+
+	p, err := parsePage(io.LimitReader(resp.Body, maxPageBytes))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", domainErrors.ErrListingUnavailable, err)
+	}
+	return p, nil
+}
+
+func parsePage(r io.Reader) (*page, error) {
+	p := &page{meta: map[string][]string{}}
+	z := html.NewTokenizer(r)
+	inScript, jsonLD := false, false
+	for {
+		switch z.Next() {
+		case html.ErrorToken:
+			if err := z.Err(); err != io.EOF {
+				return nil, err
+			}
+			return p, nil
+		case html.StartTagToken, html.SelfClosingTagToken:
+			token := z.Token()
+			switch token.Data {
+			case "meta":
+				key, content := "", ""
+				for _, attr := range token.Attr {
+					switch attr.Key {
+					case "property", "name", "itemprop":
+						if key == "" {
+							key = strings.ToLower(attr.Val)
+						}
+					case "content":
+						content = strings.TrimSpace(attr.Val)
+					}
+				}
+				if key != "" && content != "" {
+					p.meta[key] = append(p.meta[key], content)
+				}
+			case "script":
+				inScript, jsonLD = true, false
+				for _, attr := range token.Attr {
+					if attr.Key == "type" && strings.EqualFold(attr.Val, "application/ld+json") {
+						jsonLD = true
+					}
+				}
+			}
+		case html.EndTagToken:
+			inScript = false
+		case html.TextToken:
+			if !inScript {
+				continue
+			}
+			text := string(z.Text())
+			if !jsonLD {
+				p.scripts = append(p.scripts, text)
+				continue
+			}
+			var v any
+			// 壊れた構造化データは読み飛ばし、他の情報から読み取る
+			if json.Unmarshal([]byte(text), &v) == nil {
+				p.jsonLD = append(p.jsonLD, v)
+			}
+		}
+	}
+}
+
+// 最初の content（なければ空文字）
+func (p *page) metaContent(keys ...string) string {
+	for _, key := range keys {
+		if values := p.meta[key]; len(values) > 0 {
+			return values[0]
+		}
+	}
+	return ""
+}
+
+// 構造化データ（schema.org）の Product
+type product struct {
+	name     string
+	brand    string
+	price    int
+	currency string
+	images   []string
+}
+
+// JSON-LD から最初の Product を探す（@graph や配列の中も探す）
+func (p *page) product() (*product, bool) {
+	for _, v := range p.jsonLD {
+		if obj, ok := findProduct(v); ok {
+			return newProduct(obj), true
+		}
+	}
+	return nil, false
+}
+
+func findProduct(v any) (map[string]any, bool) {
+	switch v := v.(type) {
+	case []any:
+		for _, e := range v {
+			if obj, ok := findProduct(e); ok {
+				return obj, true
+			}
+		}
+	case map[string]any:
+		if hasType(v["@type"], "Product") {
+			return v, true
+		}
+		if graph, ok := v["@graph"]; ok {
+			return findProduct(graph)
+		}
+	}
+	return nil, false
+}
+
+func hasType(v any, want string) bool {
+	switch v := v.(type) {
+	case string:
+		return v == want
+	case []any:
+		for _, e := range v {
+			if s, ok := e.(string); ok && s == want {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func newProduct(obj map[string]any) *product {
+	p := &product{
+		name:   stringValue(obj["name"]),
+		brand:  nameValue(obj["brand"]),
+		images: stringValues(obj["image"]),
+	}
+	// 複数の Offer がある場合は最初のものを使う
+	offer := obj["offers"]
+	if offers, ok := offer.([]any); ok && len(offers) > 0 {
+		offer = offers[0]
+	}
+	if o, ok := offer.(map[string]any); ok {
+		price := o["price"]
+		if price == nil {
+			price = o["lowPrice"]
+		}
+		p.price, _ = parsePrice(price)
+		p.currency = strings.ToUpper(stringValue(o["priceCurrency"]))
+	}
+	return p
+}
+
+func stringValue(v any) string {
+	if s, ok := v.(string); ok {
+		return strings.TrimSpace(html.UnescapeString(s))
+	}
+	return ""
+}
+
+// 文字列か {"name": ...} の name
+func nameValue(v any) string {
+	switch v := v.(type) {
+	case map[string]any:
+		return stringValue(v["name"])
+	case []any:
+		if len(v) > 0 {
+			return nameValue(v[0])
+		}
+	}
+	return stringValue(v)
+}
+
+// 文字列・{"url": ...}・それらの配列を文字列の配列にする
+func stringValues(v any) []string {
+	switch v := v.(type) {
+	case string:
+		return []string{strings.TrimSpace(v)}
+	case map[string]any:
+		if s := stringValue(v["url"]); s != "" {
+			return []string{s}
+		}
+		return stringValues(v["contentUrl"])
+	case []any:
+		var result []string
+		for _, e := range v {
+			result = append(result, stringValues(e)...)
+		}
+		return result
+	}
+	return nil
+}
+
+// 数値か "3,500,000"・"¥3,500,000"・"3500000.00" のような文字列を円単位の整数にする
+func parsePrice(v any) (int, bool) {
+	switch v := v.(type) {
+	case float64:
+		return int(math.Round(v)), v >= 0
+	case string:
+		s := strings.Map(func(r rune) rune {
+			if (r >= '0' && r <= '9') || r == '.' {
+				return r
+			}
+			if r == ',' || r == ' ' || r == '¥' || r == '￥' || r == '円' {
+				return -1
+			}
+			return r
+		}, strings.TrimSpace(v))
+		f, err := strconv.ParseFloat(s, 64)
+		if err != nil || f < 0 {
+			return 0, false
+		}
+		return int(math.Round(f)), true
+	}
+	return 0, false
+}
+
+// 写真のURLを出品ページのURLを基準に絶対URLにし、重複を除いて上限までにする
+func resolvePhotos(base *url.URL, photos []string) []string {
+	result := []string{}
+	seen := map[string]bool{}
+	for _, photo := range photos {
+		ref, err := url.Parse(strings.TrimSpace(photo))
+		if err != nil || photo == "" {
+			continue
+		}
+		resolved := base.ResolveReference(ref)
+		if resolved.Scheme != "http" && resolved.Scheme != "https" {
+			continue
+		}
+		s := resolved.String()
+		if seen[s] {
+			continue
+		}
+		seen[s] = true
+		result = append(result, s)
+		if len(result) == maxPhotos {
+			break
+		}
+	}
+	return result
+}
@@ -0,0 +1,110 @@
+package listing
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"Aicon-assignment/internal/domain/entity"
+	domainErrors "Aicon-assignment/internal/domain/errors"
+)
+
+// ヤフオク!の出品ページのドメイン
+var yahooAuctionsDomains = []string{"page.auctions.yahoo.co.jp", "auctions.yahoo.co.jp"}
+
+// 出品ページに埋め込まれた商品情報（var pageData = {...};）
+var yahooPageData = regexp.MustCompile(`(?s)var\s+pageData\s*=\s*(\{.*?\})\s*;`)
+
+// タイトルの末尾に付くサイト名
+var yahooTitleSuffixes = []string{" - ヤフオク!", " - Yahoo!オークション"}
+
+// ヤフオク!の出品ページ（構造化データと埋め込みの商品情報を読み取る）
+// ブランドは出品ページに項目がないため、タイトルから既存のブランドを探して補う
+type YahooAuctionsProvider struct {
+	client *http.Client
+}
+
+func NewYahooAuctionsProvider() *YahooAuctionsProvider {
+	p := &YahooAuctionsProvider{}
+	p.client = newClient(p.Supports)
+	return p
+}
+
+func (p *YahooAuctionsProvider) Supports(u *url.URL) bool {
+	host := strings.ToLower(u.Hostname())
+	for _, domain := range yahooAuctionsDomains {
+		if host == domain {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *YahooAuctionsProvider) Fetch(ctx context.Context, u *url.URL) (*entity.Listing, error) {
+	pg, err := fetchPage(ctx, p.client, u)
+	if err != nil {
+		return nil, err
+	}
+	return parseYahooAuctions(u, pg)
+}
+
+func parseYahooAuctions(u *url.URL, pg *page) (*entity.Listing, error) {
+	listing := &entity.Listing{
+		URL:      u.String(),
+		Site:     entity.ListingSiteYahooAuctions,
+		Currency: entity.CurrencyJPY,
+	}
+
+	var photos []string
+	if product, ok := pg.product(); ok {
+		listing.Title = product.name
+		listing.Brand = product.brand
+		listing.Price = product.price
+		photos = product.images
+	}
+	if item, ok := yahooPageDataItem(pg); ok {
+		if listing.Title == "" {
+			listing.Title = stringValue(item["productName"])
+		}
+		// 税込みの価格があればそちらを使う
+		for _, key := range []string{"taxinPrice", "price"} {
+			if price, ok := parsePrice(item[key]); ok && price > 0 {
+				listing.Price = price
+				break
+			}
+		}
+	}
+	if listing.Title == "" {
+		listing.Title = pg.metaContent("og:title")
+	}
+	for _, suffix := range yahooTitleSuffixes {
+		listing.Title = strings.TrimSuffix(listing.Title, suffix)
+	}
+	if listing.Title == "" {
+		return nil, fmt.Errorf("%w: no listing found on the page", domainErrors.ErrListingUnavailable)
+	}
+	photos = append(photos, pg.meta["og:image"]...)
+	listing.Photos = resolvePhotos(u, photos)
+	return listing, nil
+}
+
+// pageData の items（商品情報）
+func yahooPageDataItem(pg *page) (map[string]any, bool) {
+	for _, script := range pg.scripts {
+		match := yahooPageData.FindStringSubmatch(script)
+		if match == nil {
+			continue
+		}
+		var data struct {
+			Items map[string]any `json:"items"`
+		}
+		if json.Unmarshal([]byte(match[1]), &data) == nil && data.Items != nil {
+			return data.Items, true
+		}
+	}
+	return nil, false
+}
@@ -0,0 +1,84 @@
+package logging
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"strings"
+
+	"Aicon-assignment/internal/interfaces/masking"
+)
+
+// 標準出力にJSONで出力するロガーを既定にする
+// log.Printf で出力しているログも同じ形式になる
+func Setup(level string) {
+	handler := slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{Level: parseLevel(level), ReplaceAttr: maskAttr})
+	slog.SetDefault(slog.New(&contextHandler{Handler: handler}))
+}
+
+// 購入価格などの伏せる項目を属性として渡しても、値は出力しない
+func maskAttr(groups []string, attr slog.Attr) slog.Attr {
+	if masking.IsSensitive(attr.Key) {
+		return slog.String(attr.Key, masking.Redacted)
+	}
+	return attr
+}
+
+// debug・info・warn・error のいずれか（それ以外はinfo）
+func parseLevel(level string) slog.Level {
+	switch strings.ToLower(level) {
+	case "debug":
+		return slog.LevelDebug
+	case "warn":
+		return slog.LevelWarn
+	case "error":
+		return slog.LevelError
+	default:
+		return slog.LevelInfo
+	}
+}
+
+type requestIDKey struct{}
+
+func WithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, requestID)
+}
+
+// リクエストの外では空文字を返す
+func RequestID(ctx context.Context) string {
+	requestID, _ := ctx.Value(requestIDKey{}).(string)
+	return requestID
+}
+
+type handlerKey struct{}
+
+// リクエストを処理するハンドラー（"GET /items/:id" の形式）
+func WithHandler(ctx context.Context, handler string) context.Context {
+	return context.WithValue(ctx, handlerKey{}, handler)
+}
+
+// リクエストの外やルートに一致しなかった場合は空文字を返す
+func Handler(ctx context.Context) string {
+	handler, _ := ctx.Value(handlerKey{}).(string)
+	return handler
+}
+
+// slog.InfoContext などに渡したコンテキストからリクエストIDを取り出して出力する
+type contextHandler struct {
+	slog.Handler
+}
+
+func (h *contextHandler) Handle(ctx context.Context, record slog.Record) error {
+	if requestID := RequestID(ctx); requestID != "" {
+		record.AddAttrs(slog.String("request_id", requestID))
+	}
+	return h.Handler.Handle(ctx, record)
+}
+
+func (h *contextHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &contextHandler{Handler: h.Handler.WithAttrs(attrs)}
+}
+
+func (h *contextHandler) WithGroup(name string) slog.Handler {
+	return &contextHandler{Handler: h.Handler.WithGroup(name)}
+}
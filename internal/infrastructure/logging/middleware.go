@@ -0,0 +1,163 @@
+package logging
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"Aicon-assignment/internal/interfaces/masking"
+)
+
+// 認証を行うミドルウェアがユーザーIDを c.Set(UserKey, id) で設定すると、アクセスログに出力する
+const UserKey = "user"
+
+// 呼び出し元から受け取るリクエストIDの最大長
+const maxRequestIDLength = 128
+
+// リクエストごとにアクセスログを1行出力する
+// X-Request-ID ヘッダーがあれば引き継ぎ、なければ生成してレスポンスのヘッダーとエラーの本文に含める
+func Middleware() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			start := time.Now()
+			req := c.Request()
+
+			requestID := req.Header.Get(echo.HeaderXRequestID)
+			if !validRequestID(requestID) {
+				requestID = newRequestID()
+			}
+			ctx := WithRequestID(req.Context(), requestID)
+			// 遅い問い合わせのログなど、リクエストの中で出力するログに処理したハンドラーを含める
+			if route := c.Path(); route != "" {
+				ctx = WithHandler(ctx, req.Method+" "+route)
+			}
+			c.SetRequest(req.WithContext(ctx))
+
+			res := c.Response()
+			res.Header().Set(echo.HeaderXRequestID, requestID)
+			writer := &errorBodyWriter{ResponseWriter: res.Writer, requestID: requestID}
+			res.Writer = writer
+
+			// エラーのレスポンスにもリクエストIDを含めるため、ここでエラーハンドラーを呼ぶ
+			if err := next(c); err != nil {
+				c.Error(err)
+			}
+			writer.flush()
+			res.Writer = writer.ResponseWriter
+
+			attrs := []slog.Attr{
+				slog.String("method", req.Method),
+				slog.String("path", masking.Path(req.URL.Path, c.Path(), c.ParamNames(), c.ParamValues())),
+				slog.String("route", c.Path()),
+				slog.Int("status", res.Status),
+				slog.Float64("latency_ms", float64(time.Since(start).Microseconds())/1000),
+				slog.Int64("bytes_out", res.Size),
+				slog.String("remote_ip", c.RealIP()),
+			}
+			if user, ok := c.Get(UserKey).(string); ok && user != "" {
+				attrs = append(attrs, slog.String("user", user))
+			}
+
+			slog.LogAttrs(ctx, levelFor(res.Status), "request", attrs...)
+			return nil
+		}
+	}
+}
+
+func levelFor(status int) slog.Level {
+	switch {
+	case status >= http.StatusInternalServerError:
+		return slog.LevelError
+	case status >= http.StatusBadRequest:
+		return slog.LevelWarn
+	default:
+		return slog.LevelInfo
+	}
+}
+
+// ログに出力しても安全な、英数字と記号だけからなる長さ制限内の値のみ引き継ぐ
+func validRequestID(requestID string) bool {
+	if requestID == "" || len(requestID) > maxRequestIDLength {
+		return false
+	}
+	return strings.IndexFunc(requestID, func(r rune) bool { return r < '!' || r > '~' }) < 0
+}
+
+func newRequestID() string {
+	b := make([]byte, 16)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// 400以上のJSON（problem+jsonを含む）のレスポンスを溜めておき、本文のオブジェクトに request_id を加えてから書き出す
+type errorBodyWriter struct {
+	http.ResponseWriter
+	requestID string
+
+	status   int
+	buffered bool
+	body     bytes.Buffer
+}
+
+func (w *errorBodyWriter) WriteHeader(status int) {
+	contentType := w.Header().Get(echo.HeaderContentType)
+	isJSON := strings.HasPrefix(contentType, echo.MIMEApplicationJSON) || strings.HasPrefix(contentType, "application/problem+json")
+	if status >= http.StatusBadRequest && isJSON {
+		w.status = status
+		w.buffered = true
+		return
+	}
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (w *errorBodyWriter) Write(b []byte) (int, error) {
+	if w.buffered {
+		return w.body.Write(b)
+	}
+	return w.ResponseWriter.Write(b)
+}
+
+// SSEやWebSocketのため、Flush・Hijackは元のライターに任せる
+func (w *errorBodyWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+func (w *errorBodyWriter) flush() {
+	if !w.buffered {
+		return
+	}
+	w.buffered = false
+
+	body := withRequestID(w.body.Bytes(), w.requestID)
+	w.Header().Del(echo.HeaderContentLength)
+	w.ResponseWriter.WriteHeader(w.status)
+	w.ResponseWriter.Write(body)
+}
+
+// フィールドの順序を変えないよう、閉じ括弧の前に追加する（オブジェクト以外はそのまま返す）
+func withRequestID(body []byte, requestID string) []byte {
+	trimmed := bytes.TrimSpace(body)
+	if len(trimmed) < 2 || trimmed[0] != '{' || !json.Valid(trimmed) {
+		return body
+	}
+
+	value, _ := json.Marshal(requestID)
+	field := append([]byte(`"request_id":`), value...)
+
+	inner := bytes.TrimSpace(trimmed[1 : len(trimmed)-1])
+	result := []byte{'{'}
+	if len(inner) > 0 {
+		result = append(result, inner...)
+		result = append(result, ',')
+	}
+	result = append(result, field...)
+	result = append(result, '}', '\n')
+	return result
+}
@@ -0,0 +1,152 @@
+package logging
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// 出力したログを返すロガーに差し替える
+func captureLogs(t *testing.T) *bytes.Buffer {
+	var buf bytes.Buffer
+	previous := slog.Default()
+	slog.SetDefault(slog.New(&contextHandler{Handler: slog.NewJSONHandler(&buf, &slog.HandlerOptions{ReplaceAttr: maskAttr})}))
+	t.Cleanup(func() { slog.SetDefault(previous) })
+	return &buf
+}
+
+func newTestServer() *echo.Echo {
+	e := echo.New()
+	e.Use(Middleware())
+	e.GET("/items/:id", func(c echo.Context) error {
+		c.Set(UserKey, "user-1")
+		slog.InfoContext(c.Request().Context(), "handling")
+		switch c.Param("id") {
+		case "404":
+			return c.JSON(http.StatusNotFound, map[string]string{"error": "item not found"})
+		case "500":
+			return echo.NewHTTPError(http.StatusInternalServerError, "boom")
+		}
+		return c.JSON(http.StatusOK, map[string]int{"id": 1})
+	})
+	e.GET("/items/by-serial/:serial", func(c echo.Context) error {
+		slog.InfoContext(c.Request().Context(), "handling", "serial_number", c.Param("serial"))
+		return c.JSON(http.StatusOK, map[string]int{"id": 1})
+	})
+	return e
+}
+
+func TestMiddleware(t *testing.T) {
+	t.Run("正常系: リクエストIDを生成してヘッダーとログに出力する", func(t *testing.T) {
+		logs := captureLogs(t)
+		rec := httptest.NewRecorder()
+		newTestServer().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/items/1", nil))
+
+		requestID := rec.Header().Get(echo.HeaderXRequestID)
+		assert.Len(t, requestID, 32)
+		assert.JSONEq(t, `{"id":1}`, rec.Body.String())
+
+		lines := bytes.Split(bytes.TrimSpace(logs.Bytes()), []byte("\n"))
+		require.Len(t, lines, 2)
+
+		var handling, access map[string]interface{}
+		require.NoError(t, json.Unmarshal(lines[0], &handling))
+		require.NoError(t, json.Unmarshal(lines[1], &access))
+		assert.Equal(t, requestID, handling["request_id"])
+		assert.Equal(t, "INFO", access["level"])
+		assert.Equal(t, requestID, access["request_id"])
+		assert.Equal(t, "GET", access["method"])
+		assert.Equal(t, "/items/1", access["path"])
+		assert.Equal(t, "/items/:id", access["route"])
+		assert.Equal(t, float64(http.StatusOK), access["status"])
+		assert.Equal(t, "user-1", access["user"])
+		assert.Contains(t, access, "latency_ms")
+	})
+
+	t.Run("正常系: 呼び出し元のリクエストIDを引き継ぎ、エラーの本文に含める", func(t *testing.T) {
+		captureLogs(t)
+		req := httptest.NewRequest(http.MethodGet, "/items/404", nil)
+		req.Header.Set(echo.HeaderXRequestID, "abc-123")
+		rec := httptest.NewRecorder()
+		newTestServer().ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+		assert.Equal(t, "abc-123", rec.Header().Get(echo.HeaderXRequestID))
+		assert.Equal(t, `{"error":"item not found","request_id":"abc-123"}`+"\n", rec.Body.String())
+	})
+
+	t.Run("正常系: エラーハンドラーのレスポンスにも含める", func(t *testing.T) {
+		logs := captureLogs(t)
+		rec := httptest.NewRecorder()
+		newTestServer().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/items/500", nil))
+
+		assert.Equal(t, http.StatusInternalServerError, rec.Code)
+		var body map[string]string
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+		assert.Equal(t, rec.Header().Get(echo.HeaderXRequestID), body["request_id"])
+		assert.Contains(t, logs.String(), `"level":"ERROR"`)
+	})
+
+	t.Run("正常系: シリアル番号はパスにも属性にも出力しない", func(t *testing.T) {
+		logs := captureLogs(t)
+		rec := httptest.NewRecorder()
+		newTestServer().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/items/by-serial/116500LN", nil))
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.NotContains(t, logs.String(), "116500LN")
+		assert.Contains(t, logs.String(), `"path":"/items/by-serial/[REDACTED]"`)
+		assert.Contains(t, logs.String(), `"serial_number":"[REDACTED]"`)
+	})
+
+	t.Run("正常系: 処理するハンドラーをコンテキストに入れる", func(t *testing.T) {
+		captureLogs(t)
+		e := echo.New()
+		e.Use(Middleware())
+		e.PATCH("/items/:id", func(c echo.Context) error {
+			return c.String(http.StatusOK, Handler(c.Request().Context()))
+		})
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, httptest.NewRequest(http.MethodPatch, "/items/1", nil))
+
+		assert.Equal(t, "PATCH /items/:id", rec.Body.String())
+	})
+
+	t.Run("異常系: 不正なリクエストIDは引き継がずに生成する", func(t *testing.T) {
+		captureLogs(t)
+		for _, requestID := range []string{"has space", "line\nbreak", string(make([]byte, 200))} {
+			req := httptest.NewRequest(http.MethodGet, "/items/1", nil)
+			req.Header.Set(echo.HeaderXRequestID, requestID)
+			rec := httptest.NewRecorder()
+			newTestServer().ServeHTTP(rec, req)
+
+			assert.NotEqual(t, requestID, rec.Header().Get(echo.HeaderXRequestID))
+			assert.Len(t, rec.Header().Get(echo.HeaderXRequestID), 32)
+		}
+	})
+}
+
+func TestWithRequestID(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		want string
+	}{
+		{name: "正常系: 最後のフィールドの後に追加する", body: `{"error":"x","details":["a"]}`, want: `{"error":"x","details":["a"],"request_id":"id"}` + "\n"},
+		{name: "正常系: 空のオブジェクト", body: `{}`, want: `{"request_id":"id"}` + "\n"},
+		{name: "異常系: 配列はそのまま", body: `["x"]`, want: `["x"]`},
+		{name: "異常系: 壊れたJSONはそのまま", body: `{"error":`, want: `{"error":`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, string(withRequestID([]byte(tt.body), "id")))
+		})
+	}
+}
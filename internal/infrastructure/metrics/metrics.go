@@ -0,0 +1,116 @@
+package metrics
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+
+	domainErrors "Aicon-assignment/internal/domain/errors"
+)
+
+// Prometheus形式で公開するメトリクス
+// HTTPはEchoのミドルウェア、リポジトリはデコレーター、SQLはハンドラーのラッパーで計測する
+type Metrics struct {
+	registry *prometheus.Registry
+
+	httpRequests  *prometheus.CounterVec
+	httpDuration  *prometheus.HistogramVec
+	repoDuration  *prometheus.HistogramVec
+	repoErrors    *prometheus.CounterVec
+	dbDuration    *prometheus.HistogramVec
+	dbSlowQueries *prometheus.CounterVec
+}
+
+func New() *Metrics {
+	m := &Metrics{
+		registry: prometheus.NewRegistry(),
+		httpRequests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "http_requests_total",
+			Help: "Number of HTTP requests by method, route and status code.",
+		}, []string{"method", "route", "status"}),
+		httpDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "http_request_duration_seconds",
+			Help:    "HTTP request latency by method and route.",
+			Buckets: prometheus.DefBuckets,
+		}, []string{"method", "route"}),
+		repoDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "repository_query_duration_seconds",
+			Help:    "Repository call latency by repository and method.",
+			Buckets: []float64{.001, .0025, .005, .01, .025, .05, .1, .25, .5, 1, 2.5},
+		}, []string{"repository", "method"}),
+		repoErrors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "repository_errors_total",
+			Help: "Number of repository calls that failed with a database error.",
+		}, []string{"repository", "method"}),
+		dbDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "db_query_duration_seconds",
+			Help:    "SQL statement latency by operation, including reading the rows.",
+			Buckets: []float64{.001, .0025, .005, .01, .025, .05, .1, .25, .5, 1, 2.5},
+		}, []string{"operation"}),
+		dbSlowQueries: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "db_slow_queries_total",
+			Help: "Number of SQL statements that took longer than the slow query threshold.",
+		}, []string{"operation"}),
+	}
+
+	m.registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		m.httpRequests,
+		m.httpDuration,
+		m.repoDuration,
+		m.repoErrors,
+		m.dbDuration,
+		m.dbSlowQueries,
+	)
+
+	return m
+}
+
+// GET /metrics のハンドラー
+func (m *Metrics) Handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{})
+}
+
+// ルートはパラメーターを含まない登録時の形（/items/:id）で記録し、系列が増え続けないようにする
+func (m *Metrics) Middleware() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			start := time.Now()
+			err := next(c)
+
+			// エラーを返したハンドラーはこの後のエラーハンドラーでレスポンスが決まるため、ステータスを先に求める
+			status := c.Response().Status
+			if err != nil {
+				status = http.StatusInternalServerError
+				if httpErr, ok := err.(*echo.HTTPError); ok {
+					status = httpErr.Code
+				}
+			}
+
+			route := c.Path()
+			if route == "" {
+				route = "unmatched"
+			}
+
+			method := c.Request().Method
+			m.httpRequests.WithLabelValues(method, route, strconv.Itoa(status)).Inc()
+			m.httpDuration.WithLabelValues(method, route).Observe(time.Since(start).Seconds())
+
+			return err
+		}
+	}
+}
+
+// 見つからないなどの業務上のエラーは数えず、データベースのエラーのみ数える
+func (m *Metrics) observeRepository(repository, method string, start time.Time, err error) {
+	m.repoDuration.WithLabelValues(repository, method).Observe(time.Since(start).Seconds())
+	if domainErrors.IsDatabaseError(err) {
+		m.repoErrors.WithLabelValues(repository, method).Inc()
+	}
+}
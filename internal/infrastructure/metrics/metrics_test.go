@@ -0,0 +1,166 @@
+package metrics
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"Aicon-assignment/internal/domain/entity"
+	domainErrors "Aicon-assignment/internal/domain/errors"
+	"Aicon-assignment/internal/infrastructure/logging"
+	"Aicon-assignment/internal/interfaces/database"
+	"Aicon-assignment/internal/usecase"
+)
+
+// /metrics の出力を返す
+func scrape(t *testing.T, m *Metrics) string {
+	rec := httptest.NewRecorder()
+	m.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	return rec.Body.String()
+}
+
+func TestMetrics_Middleware(t *testing.T) {
+	t.Run("正常系: パラメーターを含まないルートとステータスごとに数える", func(t *testing.T) {
+		m := New()
+		e := echo.New()
+		e.Use(m.Middleware())
+		e.GET("/items/:id", func(c echo.Context) error {
+			if c.Param("id") == "999" {
+				return echo.NewHTTPError(http.StatusNotFound)
+			}
+			return c.NoContent(http.StatusOK)
+		})
+
+		for _, path := range []string{"/items/1", "/items/2", "/items/999", "/unknown"} {
+			e.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, path, nil))
+		}
+
+		body := scrape(t, m)
+		assert.Contains(t, body, `http_requests_total{method="GET",route="/items/:id",status="200"} 2`)
+		assert.Contains(t, body, `http_requests_total{method="GET",route="/items/:id",status="404"} 1`)
+		assert.Contains(t, body, `http_requests_total{method="GET",route="unmatched",status="404"} 1`)
+		assert.Contains(t, body, `http_request_duration_seconds_count{method="GET",route="/items/:id"} 3`)
+	})
+}
+
+// 常にデータベースのエラーを返すリポジトリ
+type failingItemRepository struct {
+	usecase.ItemRepository
+}
+
+func (r *failingItemRepository) FindByID(ctx context.Context, id int64) (*entity.Item, error) {
+	if id == 999 {
+		return nil, domainErrors.ErrItemNotFound
+	}
+	return nil, fmt.Errorf("%w: %s", domainErrors.ErrDatabaseError, errors.New("connection refused"))
+}
+
+func TestItemRepository(t *testing.T) {
+	t.Run("正常系: 時間は全て記録し、データベースのエラーのみ数える", func(t *testing.T) {
+		m := New()
+		repo := NewItemRepository(&failingItemRepository{}, m)
+
+		_, err := repo.FindByID(context.Background(), 1)
+		assert.ErrorIs(t, err, domainErrors.ErrDatabaseError)
+		_, err = repo.FindByID(context.Background(), 999)
+		assert.ErrorIs(t, err, domainErrors.ErrItemNotFound)
+
+		body := scrape(t, m)
+		assert.Contains(t, body, `repository_query_duration_seconds_count{method="FindByID",repository="item"} 2`)
+		assert.Contains(t, body, `repository_errors_total{method="FindByID",repository="item"} 1`)
+	})
+}
+
+// 何もせずに成功するハンドラー
+type stubSqlHandler struct {
+	database.SqlHandler
+}
+
+func (h *stubSqlHandler) Execute(ctx context.Context, statement string, args ...interface{}) (database.Result, error) {
+	return nil, nil
+}
+
+func (h *stubSqlHandler) Query(ctx context.Context, statement string, args ...interface{}) (database.Rows, error) {
+	return &stubRows{}, nil
+}
+
+type stubRows struct {
+	database.Rows
+}
+
+func (r *stubRows) Close() error { return nil }
+
+func TestWrapSqlHandler(t *testing.T) {
+	var logs bytes.Buffer
+	previous := slog.Default()
+	slog.SetDefault(slog.New(slog.NewJSONHandler(&logs, nil)))
+	t.Cleanup(func() { slog.SetDefault(previous) })
+
+	t.Run("正常系: 閾値以上の問い合わせを正規化してハンドラーと共にログに出力し、数える", func(t *testing.T) {
+		logs.Reset()
+		m := New()
+		handler := m.WrapSqlHandler(&stubSqlHandler{}, time.Nanosecond)
+		ctx := logging.WithHandler(context.Background(), "GET /items/:id")
+
+		_, err := handler.Execute(ctx, "UPDATE items\n    SET name = 'secret'\n    WHERE id IN (?, ?, ?)")
+		require.NoError(t, err)
+		rows, err := handler.Query(ctx, "SELECT id FROM items WHERE id = ?", 1)
+		require.NoError(t, err)
+		rows.Close()
+		rows.Close()
+
+		body := scrape(t, m)
+		assert.Contains(t, body, `db_slow_queries_total{operation="UPDATE"} 1`)
+		assert.Contains(t, body, `db_slow_queries_total{operation="SELECT"} 1`)
+		assert.Contains(t, body, `db_query_duration_seconds_count{operation="SELECT"} 1`)
+
+		assert.Contains(t, logs.String(), `"msg":"slow query"`)
+		assert.Contains(t, logs.String(), `"statement":"UPDATE items SET name = ? WHERE id IN (?)"`)
+		assert.Contains(t, logs.String(), `"handler":"GET /items/:id"`)
+		assert.NotContains(t, logs.String(), "secret")
+	})
+
+	t.Run("正常系: 閾値が0の場合は時間だけを記録する", func(t *testing.T) {
+		logs.Reset()
+		m := New()
+		handler := m.WrapSqlHandler(&stubSqlHandler{}, 0)
+
+		_, err := handler.Execute(context.Background(), "DELETE FROM items WHERE id = ?", 1)
+		require.NoError(t, err)
+
+		body := scrape(t, m)
+		assert.Contains(t, body, `db_query_duration_seconds_count{operation="DELETE"} 1`)
+		assert.NotContains(t, body, `db_slow_queries_total{`)
+		assert.Empty(t, logs.String())
+	})
+}
+
+func TestNormalizeSQL(t *testing.T) {
+	tests := []struct {
+		name      string
+		statement string
+		want      string
+	}{
+		{name: "正常系: 空白を詰める", statement: "SELECT id\n\t FROM items", want: "SELECT id FROM items"},
+		{name: "正常系: 値を ? にする", statement: "SELECT * FROM items WHERE name = 'it''s' AND price > 1500.5 LIMIT 20", want: "SELECT * FROM items WHERE name = ? AND price > ? LIMIT ?"},
+		{name: "正常系: 複数行のVALUESを1つにする", statement: "INSERT INTO items (name, brand) VALUES (?, ?), (?, ?)", want: "INSERT INTO items (name, brand) VALUES (?)"},
+		{name: "正常系: 名前に含まれる数字は残す", statement: "SELECT * FROM items2 WHERE idx_1 = ?", want: "SELECT * FROM items2 WHERE idx_1 = ?"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, normalizeSQL(tt.statement))
+		})
+	}
+}
@@ -0,0 +1,589 @@
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"Aicon-assignment/internal/domain/entity"
+	"Aicon-assignment/internal/usecase"
+)
+
+// 呼び出しにかかった時間とデータベースのエラーを記録する
+func observe[T any](m *Metrics, repository, method string, call func() (T, error)) (T, error) {
+	start := time.Now()
+	value, err := call()
+	m.observeRepository(repository, method, start, err)
+	return value, err
+}
+
+func observeErr(m *Metrics, repository, method string, call func() error) error {
+	start := time.Now()
+	err := call()
+	m.observeRepository(repository, method, start, err)
+	return err
+}
+
+// ItemRepository の呼び出しを計測するデコレーター
+type ItemRepository struct {
+	repo    usecase.ItemRepository
+	metrics *Metrics
+}
+
+func NewItemRepository(repo usecase.ItemRepository, m *Metrics) *ItemRepository {
+	return &ItemRepository{repo: repo, metrics: m}
+}
+
+func (r *ItemRepository) FindAll(ctx context.Context, filter entity.ItemFilter) ([]*entity.Item, error) {
+	return observe(r.metrics, "item", "FindAll", func() ([]*entity.Item, error) { return r.repo.FindAll(ctx, filter) })
+}
+
+func (r *ItemRepository) Count(ctx context.Context, filter entity.ItemFilter) (int, error) {
+	return observe(r.metrics, "item", "Count", func() (int, error) { return r.repo.Count(ctx, filter) })
+}
+
+func (r *ItemRepository) CountLocked(ctx context.Context) (int, error) {
+	return observe(r.metrics, "item", "CountLocked", func() (int, error) { return r.repo.CountLocked(ctx) })
+}
+
+func (r *ItemRepository) FindByID(ctx context.Context, id int64) (*entity.Item, error) {
+	return observe(r.metrics, "item", "FindByID", func() (*entity.Item, error) { return r.repo.FindByID(ctx, id) })
+}
+
+func (r *ItemRepository) FindBySerialNumber(ctx context.Context, serialNumber string) (*entity.Item, error) {
+	return observe(r.metrics, "item", "FindBySerialNumber", func() (*entity.Item, error) { return r.repo.FindBySerialNumber(ctx, serialNumber) })
+}
+
+func (r *ItemRepository) Create(ctx context.Context, item *entity.Item) (*entity.Item, error) {
+	return observe(r.metrics, "item", "Create", func() (*entity.Item, error) { return r.repo.Create(ctx, item) })
+}
+
+func (r *ItemRepository) Update(ctx context.Context, item *entity.Item) error {
+	return observeErr(r.metrics, "item", "Update", func() error { return r.repo.Update(ctx, item) })
+}
+
+func (r *ItemRepository) Delete(ctx context.Context, id int64) error {
+	return observeErr(r.metrics, "item", "Delete", func() error { return r.repo.Delete(ctx, id) })
+}
+
+func (r *ItemRepository) Merge(ctx context.Context, survivorID int64, duplicateIDs []int64) error {
+	return observeErr(r.metrics, "item", "Merge", func() error { return r.repo.Merge(ctx, survivorID, duplicateIDs) })
+}
+
+func (r *ItemRepository) PurgeDeleted(ctx context.Context, before time.Time) (int, error) {
+	return observe(r.metrics, "item", "PurgeDeleted", func() (int, error) { return r.repo.PurgeDeleted(ctx, before) })
+}
+
+func (r *ItemRepository) ReassignCategory(ctx context.Context, from, to string) ([]int64, error) {
+	return observe(r.metrics, "item", "ReassignCategory", func() ([]int64, error) { return r.repo.ReassignCategory(ctx, from, to) })
+}
+
+func (r *ItemRepository) GetSummaryByCategory(ctx context.Context) (map[string]int, error) {
+	return observe(r.metrics, "item", "GetSummaryByCategory", func() (map[string]int, error) { return r.repo.GetSummaryByCategory(ctx) })
+}
+
+func (r *ItemRepository) FindBrands(ctx context.Context) ([]string, error) {
+	return observe(r.metrics, "item", "FindBrands", func() ([]string, error) { return r.repo.FindBrands(ctx) })
+}
+
+func (r *ItemRepository) Suggest(ctx context.Context, prefix string, limit int) ([]entity.ItemSuggestion, error) {
+	return observe(r.metrics, "item", "Suggest", func() ([]entity.ItemSuggestion, error) { return r.repo.Suggest(ctx, prefix, limit) })
+}
+
+func (r *ItemRepository) GetSummaryByCondition(ctx context.Context) (map[string]int, error) {
+	return observe(r.metrics, "item", "GetSummaryByCondition", func() (map[string]int, error) { return r.repo.GetSummaryByCondition(ctx) })
+}
+
+// LoanRepository の呼び出しを計測するデコレーター
+type LoanRepository struct {
+	repo    usecase.LoanRepository
+	metrics *Metrics
+}
+
+func NewLoanRepository(repo usecase.LoanRepository, m *Metrics) *LoanRepository {
+	return &LoanRepository{repo: repo, metrics: m}
+}
+
+func (r *LoanRepository) FindByID(ctx context.Context, id int64) (*entity.Loan, error) {
+	return observe(r.metrics, "loan", "FindByID", func() (*entity.Loan, error) { return r.repo.FindByID(ctx, id) })
+}
+
+func (r *LoanRepository) FindActiveByItemID(ctx context.Context, itemID int64) (*entity.Loan, error) {
+	return observe(r.metrics, "loan", "FindActiveByItemID", func() (*entity.Loan, error) { return r.repo.FindActiveByItemID(ctx, itemID) })
+}
+
+func (r *LoanRepository) FindOverdue(ctx context.Context, today string) ([]*entity.Loan, error) {
+	return observe(r.metrics, "loan", "FindOverdue", func() ([]*entity.Loan, error) { return r.repo.FindOverdue(ctx, today) })
+}
+
+func (r *LoanRepository) Create(ctx context.Context, loan *entity.Loan) (*entity.Loan, error) {
+	return observe(r.metrics, "loan", "Create", func() (*entity.Loan, error) { return r.repo.Create(ctx, loan) })
+}
+
+func (r *LoanRepository) Update(ctx context.Context, loan *entity.Loan) error {
+	return observeErr(r.metrics, "loan", "Update", func() error { return r.repo.Update(ctx, loan) })
+}
+
+// LocationRepository の呼び出しを計測するデコレーター
+type LocationRepository struct {
+	repo    usecase.LocationRepository
+	metrics *Metrics
+}
+
+func NewLocationRepository(repo usecase.LocationRepository, m *Metrics) *LocationRepository {
+	return &LocationRepository{repo: repo, metrics: m}
+}
+
+func (r *LocationRepository) FindAll(ctx context.Context) ([]*entity.Location, error) {
+	return observe(r.metrics, "location", "FindAll", func() ([]*entity.Location, error) { return r.repo.FindAll(ctx) })
+}
+
+func (r *LocationRepository) FindByID(ctx context.Context, id int64) (*entity.Location, error) {
+	return observe(r.metrics, "location", "FindByID", func() (*entity.Location, error) { return r.repo.FindByID(ctx, id) })
+}
+
+func (r *LocationRepository) Create(ctx context.Context, location *entity.Location) (*entity.Location, error) {
+	return observe(r.metrics, "location", "Create", func() (*entity.Location, error) { return r.repo.Create(ctx, location) })
+}
+
+func (r *LocationRepository) Update(ctx context.Context, location *entity.Location) error {
+	return observeErr(r.metrics, "location", "Update", func() error { return r.repo.Update(ctx, location) })
+}
+
+func (r *LocationRepository) Delete(ctx context.Context, id int64) error {
+	return observeErr(r.metrics, "location", "Delete", func() error { return r.repo.Delete(ctx, id) })
+}
+
+func (r *LocationRepository) CreateMove(ctx context.Context, move *entity.LocationMove) (*entity.LocationMove, error) {
+	return observe(r.metrics, "location", "CreateMove", func() (*entity.LocationMove, error) { return r.repo.CreateMove(ctx, move) })
+}
+
+func (r *LocationRepository) FindMovesByItemID(ctx context.Context, itemID int64) ([]*entity.LocationMove, error) {
+	return observe(r.metrics, "location", "FindMovesByItemID", func() ([]*entity.LocationMove, error) { return r.repo.FindMovesByItemID(ctx, itemID) })
+}
+
+// ItemTemplateRepository の呼び出しを計測するデコレーター
+type ItemTemplateRepository struct {
+	repo    usecase.ItemTemplateRepository
+	metrics *Metrics
+}
+
+func NewItemTemplateRepository(repo usecase.ItemTemplateRepository, m *Metrics) *ItemTemplateRepository {
+	return &ItemTemplateRepository{repo: repo, metrics: m}
+}
+
+func (r *ItemTemplateRepository) FindAll(ctx context.Context) ([]*entity.ItemTemplate, error) {
+	return observe(r.metrics, "item_template", "FindAll", func() ([]*entity.ItemTemplate, error) { return r.repo.FindAll(ctx) })
+}
+
+func (r *ItemTemplateRepository) FindByID(ctx context.Context, id int64) (*entity.ItemTemplate, error) {
+	return observe(r.metrics, "item_template", "FindByID", func() (*entity.ItemTemplate, error) { return r.repo.FindByID(ctx, id) })
+}
+
+func (r *ItemTemplateRepository) Create(ctx context.Context, template *entity.ItemTemplate) (*entity.ItemTemplate, error) {
+	return observe(r.metrics, "item_template", "Create", func() (*entity.ItemTemplate, error) { return r.repo.Create(ctx, template) })
+}
+
+func (r *ItemTemplateRepository) Delete(ctx context.Context, id int64) error {
+	return observeErr(r.metrics, "item_template", "Delete", func() error { return r.repo.Delete(ctx, id) })
+}
+
+// WebhookRepository の呼び出しを計測するデコレーター
+type WebhookRepository struct {
+	repo    usecase.WebhookRepository
+	metrics *Metrics
+}
+
+func NewWebhookRepository(repo usecase.WebhookRepository, m *Metrics) *WebhookRepository {
+	return &WebhookRepository{repo: repo, metrics: m}
+}
+
+func (r *WebhookRepository) FindAll(ctx context.Context) ([]*entity.Webhook, error) {
+	return observe(r.metrics, "webhook", "FindAll", func() ([]*entity.Webhook, error) { return r.repo.FindAll(ctx) })
+}
+
+func (r *WebhookRepository) FindByID(ctx context.Context, id int64) (*entity.Webhook, error) {
+	return observe(r.metrics, "webhook", "FindByID", func() (*entity.Webhook, error) { return r.repo.FindByID(ctx, id) })
+}
+
+func (r *WebhookRepository) Create(ctx context.Context, webhook *entity.Webhook) (*entity.Webhook, error) {
+	return observe(r.metrics, "webhook", "Create", func() (*entity.Webhook, error) { return r.repo.Create(ctx, webhook) })
+}
+
+func (r *WebhookRepository) Delete(ctx context.Context, id int64) error {
+	return observeErr(r.metrics, "webhook", "Delete", func() error { return r.repo.Delete(ctx, id) })
+}
+
+func (r *WebhookRepository) CreateDelivery(ctx context.Context, delivery *entity.WebhookDelivery) (*entity.WebhookDelivery, error) {
+	return observe(r.metrics, "webhook", "CreateDelivery", func() (*entity.WebhookDelivery, error) { return r.repo.CreateDelivery(ctx, delivery) })
+}
+
+func (r *WebhookRepository) FindDeliveriesByWebhookID(ctx context.Context, webhookID int64) ([]*entity.WebhookDelivery, error) {
+	return observe(r.metrics, "webhook", "FindDeliveriesByWebhookID", func() ([]*entity.WebhookDelivery, error) { return r.repo.FindDeliveriesByWebhookID(ctx, webhookID) })
+}
+
+// FeatureFlagRepository の呼び出しを計測するデコレーター
+type FeatureFlagRepository struct {
+	repo    usecase.FeatureFlagRepository
+	metrics *Metrics
+}
+
+func NewFeatureFlagRepository(repo usecase.FeatureFlagRepository, m *Metrics) *FeatureFlagRepository {
+	return &FeatureFlagRepository{repo: repo, metrics: m}
+}
+
+func (r *FeatureFlagRepository) FindAll(ctx context.Context) ([]*entity.FeatureFlag, error) {
+	return observe(r.metrics, "feature_flag", "FindAll", func() ([]*entity.FeatureFlag, error) { return r.repo.FindAll(ctx) })
+}
+
+func (r *FeatureFlagRepository) Save(ctx context.Context, flag *entity.FeatureFlag) (*entity.FeatureFlag, error) {
+	return observe(r.metrics, "feature_flag", "Save", func() (*entity.FeatureFlag, error) { return r.repo.Save(ctx, flag) })
+}
+
+func (r *FeatureFlagRepository) Delete(ctx context.Context, name string) error {
+	return observeErr(r.metrics, "feature_flag", "Delete", func() error { return r.repo.Delete(ctx, name) })
+}
+
+// NotificationPreferenceRepository の呼び出しを計測するデコレーター
+type NotificationPreferenceRepository struct {
+	repo    usecase.NotificationPreferenceRepository
+	metrics *Metrics
+}
+
+func NewNotificationPreferenceRepository(repo usecase.NotificationPreferenceRepository, m *Metrics) *NotificationPreferenceRepository {
+	return &NotificationPreferenceRepository{repo: repo, metrics: m}
+}
+
+func (r *NotificationPreferenceRepository) FindAll(ctx context.Context) ([]*entity.NotificationPreference, error) {
+	return observe(r.metrics, "notification_preference", "FindAll", func() ([]*entity.NotificationPreference, error) { return r.repo.FindAll(ctx) })
+}
+
+func (r *NotificationPreferenceRepository) Save(ctx context.Context, pref *entity.NotificationPreference) error {
+	return observeErr(r.metrics, "notification_preference", "Save", func() error { return r.repo.Save(ctx, pref) })
+}
+
+// ShareLinkRepository の呼び出しを計測するデコレーター
+type ShareLinkRepository struct {
+	repo    usecase.ShareLinkRepository
+	metrics *Metrics
+}
+
+func NewShareLinkRepository(repo usecase.ShareLinkRepository, m *Metrics) *ShareLinkRepository {
+	return &ShareLinkRepository{repo: repo, metrics: m}
+}
+
+func (r *ShareLinkRepository) FindByItemID(ctx context.Context, itemID int64) ([]*entity.ShareLink, error) {
+	return observe(r.metrics, "share_link", "FindByItemID", func() ([]*entity.ShareLink, error) { return r.repo.FindByItemID(ctx, itemID) })
+}
+
+func (r *ShareLinkRepository) FindByTokenHash(ctx context.Context, tokenHash string) (*entity.ShareLink, error) {
+	return observe(r.metrics, "share_link", "FindByTokenHash", func() (*entity.ShareLink, error) { return r.repo.FindByTokenHash(ctx, tokenHash) })
+}
+
+func (r *ShareLinkRepository) Create(ctx context.Context, link *entity.ShareLink) (*entity.ShareLink, error) {
+	return observe(r.metrics, "share_link", "Create", func() (*entity.ShareLink, error) { return r.repo.Create(ctx, link) })
+}
+
+func (r *ShareLinkRepository) Revoke(ctx context.Context, itemID, id int64) error {
+	return observeErr(r.metrics, "share_link", "Revoke", func() error { return r.repo.Revoke(ctx, itemID, id) })
+}
+
+// ItemCommentRepository の呼び出しを計測するデコレーター
+type ItemCommentRepository struct {
+	repo    usecase.ItemCommentRepository
+	metrics *Metrics
+}
+
+func NewItemCommentRepository(repo usecase.ItemCommentRepository, m *Metrics) *ItemCommentRepository {
+	return &ItemCommentRepository{repo: repo, metrics: m}
+}
+
+func (r *ItemCommentRepository) FindByItemID(ctx context.Context, itemID int64) ([]*entity.ItemComment, error) {
+	return observe(r.metrics, "item_comment", "FindByItemID", func() ([]*entity.ItemComment, error) { return r.repo.FindByItemID(ctx, itemID) })
+}
+
+func (r *ItemCommentRepository) Create(ctx context.Context, comment *entity.ItemComment) (*entity.ItemComment, error) {
+	return observe(r.metrics, "item_comment", "Create", func() (*entity.ItemComment, error) { return r.repo.Create(ctx, comment) })
+}
+
+func (r *ItemCommentRepository) Delete(ctx context.Context, itemID, id int64) error {
+	return observeErr(r.metrics, "item_comment", "Delete", func() error { return r.repo.Delete(ctx, itemID, id) })
+}
+
+// ItemRelationRepository の呼び出しを計測するデコレーター
+type ItemRelationRepository struct {
+	repo    usecase.ItemRelationRepository
+	metrics *Metrics
+}
+
+func NewItemRelationRepository(repo usecase.ItemRelationRepository, m *Metrics) *ItemRelationRepository {
+	return &ItemRelationRepository{repo: repo, metrics: m}
+}
+
+func (r *ItemRelationRepository) FindByItemID(ctx context.Context, itemID int64) ([]*entity.ItemRelation, error) {
+	return observe(r.metrics, "item_relation", "FindByItemID", func() ([]*entity.ItemRelation, error) { return r.repo.FindByItemID(ctx, itemID) })
+}
+
+func (r *ItemRelationRepository) Create(ctx context.Context, relation *entity.ItemRelation) (*entity.ItemRelation, error) {
+	return observe(r.metrics, "item_relation", "Create", func() (*entity.ItemRelation, error) { return r.repo.Create(ctx, relation) })
+}
+
+func (r *ItemRelationRepository) Delete(ctx context.Context, itemID, id int64) error {
+	return observeErr(r.metrics, "item_relation", "Delete", func() error { return r.repo.Delete(ctx, itemID, id) })
+}
+
+// ItemValuationRepository の呼び出しを計測するデコレーター
+type ItemValuationRepository struct {
+	repo    usecase.ItemValuationRepository
+	metrics *Metrics
+}
+
+func NewItemValuationRepository(repo usecase.ItemValuationRepository, m *Metrics) *ItemValuationRepository {
+	return &ItemValuationRepository{repo: repo, metrics: m}
+}
+
+func (r *ItemValuationRepository) FindByItemID(ctx context.Context, itemID int64) ([]*entity.ItemValuation, error) {
+	return observe(r.metrics, "item_valuation", "FindByItemID", func() ([]*entity.ItemValuation, error) { return r.repo.FindByItemID(ctx, itemID) })
+}
+
+func (r *ItemValuationRepository) Create(ctx context.Context, valuation *entity.ItemValuation) (*entity.ItemValuation, error) {
+	return observe(r.metrics, "item_valuation", "Create", func() (*entity.ItemValuation, error) { return r.repo.Create(ctx, valuation) })
+}
+
+// ItemSaleRepository の呼び出しを計測するデコレーター
+type ItemSaleRepository struct {
+	repo    usecase.ItemSaleRepository
+	metrics *Metrics
+}
+
+func NewItemSaleRepository(repo usecase.ItemSaleRepository, m *Metrics) *ItemSaleRepository {
+	return &ItemSaleRepository{repo: repo, metrics: m}
+}
+
+func (r *ItemSaleRepository) FindByItemID(ctx context.Context, itemID int64) ([]*entity.ItemSale, error) {
+	return observe(r.metrics, "item_sale", "FindByItemID", func() ([]*entity.ItemSale, error) { return r.repo.FindByItemID(ctx, itemID) })
+}
+
+func (r *ItemSaleRepository) FindSoldBetween(ctx context.Context, from, to string) ([]*entity.ItemSale, error) {
+	return observe(r.metrics, "item_sale", "FindSoldBetween", func() ([]*entity.ItemSale, error) { return r.repo.FindSoldBetween(ctx, from, to) })
+}
+
+func (r *ItemSaleRepository) Create(ctx context.Context, sale *entity.ItemSale) (*entity.ItemSale, error) {
+	return observe(r.metrics, "item_sale", "Create", func() (*entity.ItemSale, error) { return r.repo.Create(ctx, sale) })
+}
+
+func (r *ItemSaleRepository) DeleteByItemID(ctx context.Context, itemID int64) error {
+	return observeErr(r.metrics, "item_sale", "DeleteByItemID", func() error { return r.repo.DeleteByItemID(ctx, itemID) })
+}
+
+// ItemInsuranceRepository の呼び出しを計測するデコレーター
+type ItemInsuranceRepository struct {
+	repo    usecase.ItemInsuranceRepository
+	metrics *Metrics
+}
+
+func NewItemInsuranceRepository(repo usecase.ItemInsuranceRepository, m *Metrics) *ItemInsuranceRepository {
+	return &ItemInsuranceRepository{repo: repo, metrics: m}
+}
+
+func (r *ItemInsuranceRepository) FindByItemID(ctx context.Context, itemID int64) (*entity.ItemInsurance, error) {
+	return observe(r.metrics, "item_insurance", "FindByItemID", func() (*entity.ItemInsurance, error) { return r.repo.FindByItemID(ctx, itemID) })
+}
+
+func (r *ItemInsuranceRepository) FindAll(ctx context.Context) ([]*entity.ItemInsurance, error) {
+	return observe(r.metrics, "item_insurance", "FindAll", func() ([]*entity.ItemInsurance, error) { return r.repo.FindAll(ctx) })
+}
+
+func (r *ItemInsuranceRepository) Save(ctx context.Context, insurance *entity.ItemInsurance) (*entity.ItemInsurance, error) {
+	return observe(r.metrics, "item_insurance", "Save", func() (*entity.ItemInsurance, error) { return r.repo.Save(ctx, insurance) })
+}
+
+func (r *ItemInsuranceRepository) DeleteByItemID(ctx context.Context, itemID int64) error {
+	return observeErr(r.metrics, "item_insurance", "DeleteByItemID", func() error { return r.repo.DeleteByItemID(ctx, itemID) })
+}
+
+// ItemViewRepository の呼び出しを計測するデコレーター
+type ItemViewRepository struct {
+	repo    usecase.ItemViewRepository
+	metrics *Metrics
+}
+
+func NewItemViewRepository(repo usecase.ItemViewRepository, m *Metrics) *ItemViewRepository {
+	return &ItemViewRepository{repo: repo, metrics: m}
+}
+
+func (r *ItemViewRepository) Save(ctx context.Context, view *entity.ItemView, keep int) error {
+	return observeErr(r.metrics, "item_view", "Save", func() error { return r.repo.Save(ctx, view, keep) })
+}
+
+func (r *ItemViewRepository) FindRecentByViewer(ctx context.Context, viewer string, limit int) ([]*entity.ItemView, error) {
+	return observe(r.metrics, "item_view", "FindRecentByViewer", func() ([]*entity.ItemView, error) { return r.repo.FindRecentByViewer(ctx, viewer, limit) })
+}
+
+// ItemFavoriteRepository の呼び出しを計測するデコレーター
+type ItemFavoriteRepository struct {
+	repo    usecase.ItemFavoriteRepository
+	metrics *Metrics
+}
+
+func NewItemFavoriteRepository(repo usecase.ItemFavoriteRepository, m *Metrics) *ItemFavoriteRepository {
+	return &ItemFavoriteRepository{repo: repo, metrics: m}
+}
+
+func (r *ItemFavoriteRepository) Add(ctx context.Context, favorite *entity.ItemFavorite) error {
+	return observeErr(r.metrics, "item_favorite", "Add", func() error { return r.repo.Add(ctx, favorite) })
+}
+
+func (r *ItemFavoriteRepository) Remove(ctx context.Context, viewer string, itemID int64) error {
+	return observeErr(r.metrics, "item_favorite", "Remove", func() error { return r.repo.Remove(ctx, viewer, itemID) })
+}
+
+// AuditLogRepository の呼び出しを計測するデコレーター
+type AuditLogRepository struct {
+	repo    usecase.AuditLogRepository
+	metrics *Metrics
+}
+
+func NewAuditLogRepository(repo usecase.AuditLogRepository, m *Metrics) *AuditLogRepository {
+	return &AuditLogRepository{repo: repo, metrics: m}
+}
+
+func (r *AuditLogRepository) FindRecent(ctx context.Context, limit int) ([]*entity.AuditEntry, error) {
+	return observe(r.metrics, "audit_log", "FindRecent", func() ([]*entity.AuditEntry, error) { return r.repo.FindRecent(ctx, limit) })
+}
+
+// ErasureRepository の呼び出しを計測するデコレーター
+type ErasureRepository struct {
+	repo    usecase.ErasureRepository
+	metrics *Metrics
+}
+
+func NewErasureRepository(repo usecase.ErasureRepository, m *Metrics) *ErasureRepository {
+	return &ErasureRepository{repo: repo, metrics: m}
+}
+
+func (r *ErasureRepository) FindPending(ctx context.Context) (*entity.Erasure, error) {
+	return observe(r.metrics, "erasure", "FindPending", func() (*entity.Erasure, error) { return r.repo.FindPending(ctx) })
+}
+
+func (r *ErasureRepository) Create(ctx context.Context, erasure *entity.Erasure) (*entity.Erasure, error) {
+	return observe(r.metrics, "erasure", "Create", func() (*entity.Erasure, error) { return r.repo.Create(ctx, erasure) })
+}
+
+func (r *ErasureRepository) Finish(ctx context.Context, id int64, status string) (*entity.Erasure, error) {
+	return observe(r.metrics, "erasure", "Finish", func() (*entity.Erasure, error) { return r.repo.Finish(ctx, id, status) })
+}
+
+// OutboxRepository の呼び出しを計測するデコレーター
+type OutboxRepository struct {
+	repo    usecase.OutboxRepository
+	metrics *Metrics
+}
+
+func NewOutboxRepository(repo usecase.OutboxRepository, m *Metrics) *OutboxRepository {
+	return &OutboxRepository{repo: repo, metrics: m}
+}
+
+func (r *OutboxRepository) FindPending(ctx context.Context, limit int) ([]*entity.OutboxMessage, error) {
+	return observe(r.metrics, "outbox", "FindPending", func() ([]*entity.OutboxMessage, error) { return r.repo.FindPending(ctx, limit) })
+}
+
+func (r *OutboxRepository) MarkPublished(ctx context.Context, id int64) error {
+	return observeErr(r.metrics, "outbox", "MarkPublished", func() error { return r.repo.MarkPublished(ctx, id) })
+}
+
+func (r *OutboxRepository) MarkFailed(ctx context.Context, id int64, reason string) error {
+	return observeErr(r.metrics, "outbox", "MarkFailed", func() error { return r.repo.MarkFailed(ctx, id, reason) })
+}
+
+func (r *OutboxRepository) FindRecent(ctx context.Context, eventTypes []string, limit int) ([]*entity.OutboxMessage, error) {
+	return observe(r.metrics, "outbox", "FindRecent", func() ([]*entity.OutboxMessage, error) { return r.repo.FindRecent(ctx, eventTypes, limit) })
+}
+
+func (r *OutboxRepository) FindPrevious(ctx context.Context, aggregateID, beforeID int64) (*entity.OutboxMessage, error) {
+	return observe(r.metrics, "outbox", "FindPrevious", func() (*entity.OutboxMessage, error) { return r.repo.FindPrevious(ctx, aggregateID, beforeID) })
+}
+
+// JobRepository の呼び出しを計測するデコレーター
+type JobRepository struct {
+	repo    usecase.JobRepository
+	metrics *Metrics
+}
+
+func NewJobRepository(repo usecase.JobRepository, m *Metrics) *JobRepository {
+	return &JobRepository{repo: repo, metrics: m}
+}
+
+func (r *JobRepository) Create(ctx context.Context, job *entity.Job) (*entity.Job, error) {
+	return observe(r.metrics, "job", "Create", func() (*entity.Job, error) { return r.repo.Create(ctx, job) })
+}
+
+func (r *JobRepository) FindByID(ctx context.Context, id int64) (*entity.Job, error) {
+	return observe(r.metrics, "job", "FindByID", func() (*entity.Job, error) { return r.repo.FindByID(ctx, id) })
+}
+
+func (r *JobRepository) FindByStatus(ctx context.Context, statuses []string, limit int) ([]*entity.Job, error) {
+	return observe(r.metrics, "job", "FindByStatus", func() ([]*entity.Job, error) { return r.repo.FindByStatus(ctx, statuses, limit) })
+}
+
+func (r *JobRepository) Claim(ctx context.Context, now, lockedUntil time.Time, limit int) ([]*entity.Job, error) {
+	return observe(r.metrics, "job", "Claim", func() ([]*entity.Job, error) { return r.repo.Claim(ctx, now, lockedUntil, limit) })
+}
+
+func (r *JobRepository) Complete(ctx context.Context, id int64) error {
+	return observeErr(r.metrics, "job", "Complete", func() error { return r.repo.Complete(ctx, id) })
+}
+
+func (r *JobRepository) Fail(ctx context.Context, id int64, reason string, retryAt *time.Time) error {
+	return observeErr(r.metrics, "job", "Fail", func() error { return r.repo.Fail(ctx, id, reason, retryAt) })
+}
+
+func (r *JobRepository) Retry(ctx context.Context, id int64) (*entity.Job, error) {
+	return observe(r.metrics, "job", "Retry", func() (*entity.Job, error) { return r.repo.Retry(ctx, id) })
+}
+
+func (r *JobRepository) DeleteSucceeded(ctx context.Context, before time.Time) (int, error) {
+	return observe(r.metrics, "job", "DeleteSucceeded", func() (int, error) { return r.repo.DeleteSucceeded(ctx, before) })
+}
+
+// BackupRepository の呼び出しを計測するデコレーター
+type BackupRepository struct {
+	repo    usecase.BackupRepository
+	metrics *Metrics
+}
+
+func NewBackupRepository(repo usecase.BackupRepository, m *Metrics) *BackupRepository {
+	return &BackupRepository{repo: repo, metrics: m}
+}
+
+func (r *BackupRepository) Dump(ctx context.Context) (*entity.Backup, error) {
+	return observe(r.metrics, "backup", "Dump", func() (*entity.Backup, error) { return r.repo.Dump(ctx) })
+}
+
+func (r *BackupRepository) Restore(ctx context.Context, backup *entity.Backup, replace bool) error {
+	return observeErr(r.metrics, "backup", "Restore", func() error { return r.repo.Restore(ctx, backup, replace) })
+}
+
+func (r *BackupRepository) Erase(ctx context.Context) error {
+	return observeErr(r.metrics, "backup", "Erase", func() error { return r.repo.Erase(ctx) })
+}
+
+// トランザクション内のリポジトリも計測するデコレーター
+type Transactor struct {
+	transactor usecase.Transactor
+	metrics    *Metrics
+}
+
+func NewTransactor(transactor usecase.Transactor, m *Metrics) *Transactor {
+	return &Transactor{transactor: transactor, metrics: m}
+}
+
+func (t *Transactor) WithTx(ctx context.Context, fn func(repos usecase.Repositories) error) error {
+	return t.transactor.WithTx(ctx, func(repos usecase.Repositories) error {
+		repos.Items = NewItemRepository(repos.Items, t.metrics)
+		repos.Loans = NewLoanRepository(repos.Loans, t.metrics)
+		repos.Locations = NewLocationRepository(repos.Locations, t.metrics)
+		repos.Valuations = NewItemValuationRepository(repos.Valuations, t.metrics)
+		repos.Sales = NewItemSaleRepository(repos.Sales, t.metrics)
+		return fn(repos)
+	})
+}
@@ -0,0 +1,163 @@
+package metrics
+
+import (
+	"context"
+	"log/slog"
+	"regexp"
+	"strings"
+	"time"
+
+	"Aicon-assignment/internal/infrastructure/logging"
+	"Aicon-assignment/internal/interfaces/database"
+)
+
+// 問い合わせごとの時間を記録し、threshold 以上かかった問い合わせをログに出力して数える
+// threshold が0以下の場合は時間の記録だけを行う
+func (m *Metrics) WrapSqlHandler(handler database.SqlHandler, threshold time.Duration) database.SqlHandler {
+	return &sqlHandler{SqlHandler: handler, observer: &statementObserver{metrics: m, threshold: threshold}}
+}
+
+type sqlHandler struct {
+	database.SqlHandler
+	observer *statementObserver
+}
+
+func (h *sqlHandler) Execute(ctx context.Context, statement string, args ...interface{}) (database.Result, error) {
+	start := time.Now()
+	result, err := h.SqlHandler.Execute(ctx, statement, args...)
+	h.observer.observe(ctx, statement, start)
+	return result, err
+}
+
+func (h *sqlHandler) Query(ctx context.Context, statement string, args ...interface{}) (database.Rows, error) {
+	return h.observer.query(ctx, h.SqlHandler, statement, args...)
+}
+
+func (h *sqlHandler) QueryRow(ctx context.Context, statement string, args ...interface{}) database.Row {
+	return &sqlRow{q: h.SqlHandler, observer: h.observer, ctx: ctx, statement: statement, args: args}
+}
+
+func (h *sqlHandler) Begin(ctx context.Context) (database.Tx, error) {
+	tx, err := h.SqlHandler.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &sqlTx{Tx: tx, observer: h.observer}, nil
+}
+
+type sqlTx struct {
+	database.Tx
+	observer *statementObserver
+}
+
+func (t *sqlTx) Execute(ctx context.Context, statement string, args ...interface{}) (database.Result, error) {
+	start := time.Now()
+	result, err := t.Tx.Execute(ctx, statement, args...)
+	t.observer.observe(ctx, statement, start)
+	return result, err
+}
+
+func (t *sqlTx) Query(ctx context.Context, statement string, args ...interface{}) (database.Rows, error) {
+	return t.observer.query(ctx, t.Tx, statement, args...)
+}
+
+func (t *sqlTx) QueryRow(ctx context.Context, statement string, args ...interface{}) database.Row {
+	return &sqlRow{q: t.Tx, observer: t.observer, ctx: ctx, statement: statement, args: args}
+}
+
+type querier interface {
+	Query(ctx context.Context, statement string, args ...interface{}) (database.Rows, error)
+	QueryRow(ctx context.Context, statement string, args ...interface{}) database.Row
+}
+
+type statementObserver struct {
+	metrics   *Metrics
+	threshold time.Duration
+}
+
+// 行を読み終えるまでを1つの問い合わせの時間にする
+func (o *statementObserver) query(ctx context.Context, q querier, statement string, args ...interface{}) (database.Rows, error) {
+	start := time.Now()
+	rows, err := q.Query(ctx, statement, args...)
+	if err != nil {
+		o.observe(ctx, statement, start)
+		return nil, err
+	}
+	return &sqlRows{Rows: rows, done: func() { o.observe(ctx, statement, start) }}, nil
+}
+
+func (o *statementObserver) observe(ctx context.Context, statement string, start time.Time) {
+	duration := time.Since(start)
+	op := operation(statement)
+	o.metrics.dbDuration.WithLabelValues(op).Observe(duration.Seconds())
+	if o.threshold <= 0 || duration < o.threshold {
+		return
+	}
+
+	o.metrics.dbSlowQueries.WithLabelValues(op).Inc()
+	attrs := []slog.Attr{
+		slog.Float64("duration_ms", float64(duration.Microseconds())/1000),
+		slog.String("statement", normalizeSQL(statement)),
+	}
+	if handler := logging.Handler(ctx); handler != "" {
+		attrs = append(attrs, slog.String("handler", handler))
+	}
+	slog.LogAttrs(ctx, slog.LevelWarn, "slow query", attrs...)
+}
+
+type sqlRows struct {
+	database.Rows
+	done func()
+}
+
+// 重ねて閉じても1回だけ記録する
+func (r *sqlRows) Close() error {
+	err := r.Rows.Close()
+	if r.done != nil {
+		r.done()
+		r.done = nil
+	}
+	return err
+}
+
+// エラーはScanまで分からないので、Scanの時点で実行する
+type sqlRow struct {
+	q         querier
+	observer  *statementObserver
+	ctx       context.Context
+	statement string
+	args      []interface{}
+}
+
+func (r *sqlRow) Scan(dest ...interface{}) error {
+	start := time.Now()
+	err := r.q.QueryRow(r.ctx, r.statement, r.args...).Scan(dest...)
+	r.observer.observe(r.ctx, r.statement, start)
+	return err
+}
+
+// 系列が増え続けないよう、SELECT・INSERT などの操作ごとに数える
+func operation(statement string) string {
+	fields := strings.Fields(statement)
+	if len(fields) == 0 {
+		return "SQL"
+	}
+	return strings.ToUpper(fields[0])
+}
+
+var (
+	stringLiteral  = regexp.MustCompile(`'(?:[^']|'')*'`)
+	numberLiteral  = regexp.MustCompile(`\b\d+(?:\.\d+)?\b`)
+	placeholders   = regexp.MustCompile(`\(\s*\?(?:\s*,\s*\?)+\s*\)`)
+	valuesRowsList = regexp.MustCompile(`\(\?\)(?:\s*,\s*\(\?\))+`)
+)
+
+// 同じ問い合わせが1つにまとまるよう、空白を詰めて値を ? にし、IN・VALUES の並びを1つにする
+// 値はログに出力しない
+func normalizeSQL(statement string) string {
+	normalized := strings.Join(strings.Fields(statement), " ")
+	normalized = stringLiteral.ReplaceAllString(normalized, "?")
+	normalized = numberLiteral.ReplaceAllString(normalized, "?")
+	normalized = placeholders.ReplaceAllString(normalized, "(?)")
+	return valuesRowsList.ReplaceAllString(normalized, "(?)")
+}
@@ -0,0 +1,217 @@
+package migration
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"Aicon-assignment/internal/interfaces/database"
+	sqlSchema "Aicon-assignment/sql"
+)
+
+const createVersionTable = `
+    CREATE TABLE IF NOT EXISTS schema_migrations (
+        version BIGINT PRIMARY KEY,
+        name VARCHAR(255) NOT NULL,
+        applied_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+    )
+`
+
+// 1つのSQLファイル
+type Migration struct {
+	Version int64
+	Name    string
+	SQL     string
+}
+
+// マイグレーションと適用状況
+type Status struct {
+	Migration
+	AppliedAt *time.Time
+}
+
+// 埋め込んだマイグレーションをバージョン順に適用し、適用済みのバージョンを schema_migrations に記録する
+type Runner struct {
+	handler    database.SqlHandler
+	migrations []Migration
+}
+
+func NewRunner(handler database.SqlHandler) (*Runner, error) {
+	migrations, err := load(sqlSchema.Migrations, path.Join("migrations", string(handler.Dialect())))
+	if err != nil {
+		return nil, err
+	}
+
+	return &Runner{handler: handler, migrations: migrations}, nil
+}
+
+// 未適用のマイグレーションを順に適用し、適用したものを返す
+// MySQLではDDLがトランザクションに含まれないため、途中で失敗した場合に備えてSQLは再実行できるように書くこと
+func (r *Runner) Up(ctx context.Context) ([]Migration, error) {
+	statuses, err := r.Status(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var applied []Migration
+	for _, status := range statuses {
+		if status.AppliedAt != nil {
+			continue
+		}
+		if err := r.apply(ctx, status.Migration); err != nil {
+			return applied, err
+		}
+		applied = append(applied, status.Migration)
+	}
+
+	return applied, nil
+}
+
+// 全てのマイグレーションの適用状況を返す
+func (r *Runner) Status(ctx context.Context) ([]Status, error) {
+	if _, err := r.handler.Execute(ctx, createVersionTable); err != nil {
+		return nil, fmt.Errorf("failed to create schema_migrations: %w", err)
+	}
+
+	rows, err := r.handler.Query(ctx, `SELECT version, applied_at FROM schema_migrations`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read schema_migrations: %w", err)
+	}
+	defer rows.Close()
+
+	appliedAt := make(map[int64]time.Time)
+	for rows.Next() {
+		var version int64
+		var at time.Time
+		if err := rows.Scan(&version, &at); err != nil {
+			return nil, fmt.Errorf("failed to read schema_migrations: %w", err)
+		}
+		appliedAt[version] = at
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read schema_migrations: %w", err)
+	}
+
+	statuses := make([]Status, 0, len(r.migrations))
+	for _, m := range r.migrations {
+		status := Status{Migration: m}
+		if at, ok := appliedAt[m.Version]; ok {
+			status.AppliedAt = &at
+		}
+		statuses = append(statuses, status)
+	}
+
+	return statuses, nil
+}
+
+func (r *Runner) apply(ctx context.Context, m Migration) (err error) {
+	tx, err := r.handler.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin migration %04d_%s: %w", m.Version, m.Name, err)
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	for _, statement := range splitStatements(m.SQL) {
+		if _, err = tx.Execute(ctx, statement); err != nil {
+			return fmt.Errorf("failed to apply migration %04d_%s: %w", m.Version, m.Name, err)
+		}
+	}
+
+	if _, err = tx.Execute(ctx, `INSERT INTO schema_migrations (version, name) VALUES (?, ?)`, m.Version, m.Name); err != nil {
+		return fmt.Errorf("failed to record migration %04d_%s: %w", m.Version, m.Name, err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit migration %04d_%s: %w", m.Version, m.Name, err)
+	}
+
+	return nil
+}
+
+// dir 直下の <バージョン>_<名前>.sql をバージョン順に読み込む
+func load(fsys fs.FS, dir string) ([]Migration, error) {
+	entries, err := fs.ReadDir(fsys, dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read migrations: %w", err)
+	}
+
+	var migrations []Migration
+	seen := make(map[int64]string)
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".sql") {
+			continue
+		}
+
+		base := strings.TrimSuffix(entry.Name(), ".sql")
+		versionPart, name, ok := strings.Cut(base, "_")
+		version, err := strconv.ParseInt(versionPart, 10, 64)
+		if !ok || err != nil || version <= 0 {
+			return nil, fmt.Errorf("invalid migration file name %q (must be <version>_<name>.sql)", entry.Name())
+		}
+		if other, exists := seen[version]; exists {
+			return nil, fmt.Errorf("duplicate migration version %d: %s and %s", version, other, entry.Name())
+		}
+		seen[version] = entry.Name()
+
+		content, err := fs.ReadFile(fsys, path.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read migration %s: %w", entry.Name(), err)
+		}
+
+		migrations = append(migrations, Migration{Version: version, Name: name, SQL: string(content)})
+	}
+
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].Version < migrations[j].Version
+	})
+
+	return migrations, nil
+}
+
+// セミコロンで文に分ける（文字列リテラル内と -- コメント内のセミコロンは区切りとみなさない）
+// MySQLのドライバーは1回に1文しか実行できないため、どの方言でも1文ずつ実行する
+func splitStatements(sql string) []string {
+	var statements []string
+	var current strings.Builder
+	inLiteral := false
+	inComment := false
+
+	for i := 0; i < len(sql); i++ {
+		c := sql[i]
+		switch {
+		case inComment:
+			if c == '\n' {
+				inComment = false
+				current.WriteByte(c)
+			}
+			continue
+		case !inLiteral && c == '-' && i+1 < len(sql) && sql[i+1] == '-':
+			inComment = true
+			continue
+		case c == '\'':
+			inLiteral = !inLiteral
+		case c == ';' && !inLiteral:
+			if statement := strings.TrimSpace(current.String()); statement != "" {
+				statements = append(statements, statement)
+			}
+			current.Reset()
+			continue
+		}
+		current.WriteByte(c)
+	}
+
+	if statement := strings.TrimSpace(current.String()); statement != "" {
+		statements = append(statements, statement)
+	}
+
+	return statements
+}
@@ -0,0 +1,113 @@
+package migration
+
+import (
+	"context"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	databaseInfra "Aicon-assignment/internal/infrastructure/database"
+)
+
+func TestRunner_Up(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("正常系: 全てのマイグレーションを適用し、2回目は何もしない", func(t *testing.T) {
+		handler, err := databaseInfra.NewSQLiteHandler(":memory:")
+		require.NoError(t, err)
+		defer handler.Close()
+
+		runner, err := NewRunner(handler)
+		require.NoError(t, err)
+
+		applied, err := runner.Up(ctx)
+		require.NoError(t, err)
+		require.NotEmpty(t, applied)
+		assert.Equal(t, int64(1), applied[0].Version)
+
+		var count int
+		require.NoError(t, handler.QueryRow(ctx, `SELECT COUNT(*) FROM items`).Scan(&count))
+		assert.Equal(t, 5, count)
+
+		applied, err = runner.Up(ctx)
+		require.NoError(t, err)
+		assert.Empty(t, applied)
+
+		statuses, err := runner.Status(ctx)
+		require.NoError(t, err)
+		for _, status := range statuses {
+			assert.NotNil(t, status.AppliedAt, "version %d", status.Version)
+		}
+	})
+}
+
+func TestRunner_Status(t *testing.T) {
+	t.Run("正常系: 適用前は全て未適用", func(t *testing.T) {
+		handler, err := databaseInfra.NewSQLiteHandler(":memory:")
+		require.NoError(t, err)
+		defer handler.Close()
+
+		runner, err := NewRunner(handler)
+		require.NoError(t, err)
+
+		statuses, err := runner.Status(context.Background())
+		require.NoError(t, err)
+		require.NotEmpty(t, statuses)
+		for _, status := range statuses {
+			assert.Nil(t, status.AppliedAt)
+		}
+	})
+}
+
+func TestLoad(t *testing.T) {
+	t.Run("正常系: バージョン順に並べる", func(t *testing.T) {
+		fsys := fstest.MapFS{
+			"m/0010_add_index.sql":    {Data: []byte("CREATE INDEX a ON t (a);")},
+			"m/0002_create_table.sql": {Data: []byte("CREATE TABLE t (a INT);")},
+			"m/README.md":             {Data: []byte("ignored")},
+		}
+
+		migrations, err := load(fsys, "m")
+		require.NoError(t, err)
+		require.Len(t, migrations, 2)
+		assert.Equal(t, int64(2), migrations[0].Version)
+		assert.Equal(t, "create_table", migrations[0].Name)
+		assert.Equal(t, int64(10), migrations[1].Version)
+	})
+
+	t.Run("異常系: バージョンのないファイル名", func(t *testing.T) {
+		fsys := fstest.MapFS{"m/create_table.sql": {Data: []byte("")}}
+
+		_, err := load(fsys, "m")
+		assert.Error(t, err)
+	})
+
+	t.Run("異常系: バージョンの重複", func(t *testing.T) {
+		fsys := fstest.MapFS{
+			"m/0001_a.sql": {Data: []byte("")},
+			"m/001_b.sql":  {Data: []byte("")},
+		}
+
+		_, err := load(fsys, "m")
+		assert.Error(t, err)
+	})
+}
+
+func TestSplitStatements(t *testing.T) {
+	t.Run("正常系: 文字列とコメント内のセミコロンでは区切らない", func(t *testing.T) {
+		sql := `
+-- setup; first
+CREATE TABLE t (a TEXT);
+INSERT INTO t (a) VALUES ('x;y'); -- trailing; comment
+INSERT INTO t (a) VALUES ('it''s')
+`
+		statements := splitStatements(sql)
+		assert.Equal(t, []string{
+			"CREATE TABLE t (a TEXT)",
+			"INSERT INTO t (a) VALUES ('x;y')",
+			"INSERT INTO t (a) VALUES ('it''s')",
+		}, statements)
+	})
+}
@@ -0,0 +1,25 @@
+package notification
+
+import (
+	"context"
+	"log"
+
+	"Aicon-assignment/internal/domain/entity"
+)
+
+// 送らずにログに出す（SMTPを設定していないローカル環境用）
+type LogNotifier struct{}
+
+func NewLogNotifier() *LogNotifier {
+	return &LogNotifier{}
+}
+
+func (n *LogNotifier) Notify(ctx context.Context, notification entity.Notification) error {
+	subject, body, err := Render(notification)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("notification (not sent, SMTP_HOST is not set): %s\n%s", subject, body)
+	return nil
+}
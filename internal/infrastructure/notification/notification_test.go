@@ -0,0 +1,126 @@
+package notification
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"mime"
+	"mime/quotedprintable"
+	"net"
+	"net/mail"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"Aicon-assignment/internal/domain/entity"
+)
+
+var overdue = entity.Notification{
+	Kind: entity.NotificationLoansOverdue,
+	Data: entity.OverdueLoansNotice{
+		Date: "2026-10-16",
+		Loans: []entity.OverdueLoan{
+			{LoanID: 1, ItemID: 3, ItemName: "ロレックス デイトナ", Borrower: "山田 太郎", DueDate: "2026-10-01"},
+			{LoanID: 2, ItemID: 9, Borrower: "佐藤 花子", DueDate: "2026-10-10"},
+		},
+	},
+}
+
+func TestRender(t *testing.T) {
+	t.Run("正常系: 返却期限を過ぎた貸出", func(t *testing.T) {
+		subject, body, err := Render(overdue)
+
+		require.NoError(t, err)
+		assert.Equal(t, "返却期限を過ぎた貸出が2件あります", subject)
+		assert.Equal(t, `2026-10-16 の時点で、次の貸出が返却期限を過ぎています。
+
+- ロレックス デイトナ（ID: 3）: 山田 太郎 さん、返却期限 2026-10-01
+- アイテム（ID: 9）: 佐藤 花子 さん、返却期限 2026-10-10
+
+返却されたものは POST /loans/{id}/return で記録してください。
+`, body)
+	})
+
+	t.Run("異常系: テンプレートのない種類", func(t *testing.T) {
+		_, _, err := Render(entity.Notification{Kind: "price_alert"})
+
+		assert.EqualError(t, err, `no template for notification "price_alert"`)
+	})
+}
+
+func TestSMTPNotifier_Notify(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer listener.Close()
+
+	received := make(chan string, 1)
+	go serveSMTP(t, listener, received)
+
+	addr := listener.Addr().(*net.TCPAddr)
+	notifier := NewSMTPNotifier("127.0.0.1", addr.Port, "", "", "items@example.com", []string{"owner@example.com"})
+
+	require.NoError(t, notifier.Notify(context.Background(), overdue))
+
+	message, err := mail.ReadMessage(strings.NewReader(<-received))
+	require.NoError(t, err)
+	subject, err := new(mime.WordDecoder).DecodeHeader(message.Header.Get("Subject"))
+	require.NoError(t, err)
+	body, err := io.ReadAll(quotedprintable.NewReader(message.Body))
+	require.NoError(t, err)
+
+	assert.Equal(t, "items@example.com", message.Header.Get("From"))
+	assert.Equal(t, "owner@example.com", message.Header.Get("To"))
+	assert.Equal(t, "返却期限を過ぎた貸出が2件あります", subject)
+	assert.Contains(t, string(body), "- ロレックス デイトナ（ID: 3）: 山田 太郎 さん、返却期限 2026-10-01\r\n")
+}
+
+// 1通だけ受け取り、DATAの内容を received に送るSMTPサーバー
+func serveSMTP(t *testing.T, listener net.Listener, received chan<- string) {
+	conn, err := listener.Accept()
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+	conn.SetDeadline(time.Now().Add(5 * time.Second))
+
+	r := bufio.NewReader(conn)
+	reply := func(line string) { io.WriteString(conn, line+"\r\n") }
+	reply("220 localhost ESMTP")
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return
+		}
+		command := strings.ToUpper(strings.Fields(line)[0])
+		switch command {
+		case "EHLO", "HELO":
+			reply("250 localhost")
+		case "MAIL", "RCPT":
+			reply("250 OK")
+		case "DATA":
+			reply("354 End data with <CR><LF>.<CR><LF>")
+			var data strings.Builder
+			for {
+				line, err := r.ReadString('\n')
+				if err != nil {
+					return
+				}
+				if line == ".\r\n" {
+					break
+				}
+				data.WriteString(line)
+			}
+			received <- data.String()
+			reply("250 OK")
+		case "QUIT":
+			reply("221 Bye")
+			return
+		default:
+			t.Errorf("unexpected SMTP command %q", line)
+			reply("502 Not implemented")
+		}
+	}
+}
@@ -0,0 +1,132 @@
+package notification
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"mime"
+	"mime/quotedprintable"
+	"net"
+	"net/smtp"
+	"strings"
+	"time"
+
+	"Aicon-assignment/internal/domain/entity"
+)
+
+const (
+	smtpDialTimeout = 10 * time.Second
+	// 接続してから送り終えるまでの上限
+	smtpSendTimeout = 30 * time.Second
+)
+
+// SMTPでメールを送る
+type SMTPNotifier struct {
+	addr     string
+	host     string
+	username string
+	password string
+	from     string
+	to       []string
+}
+
+// username が空の場合は認証しない
+func NewSMTPNotifier(host string, port int, username, password, from string, to []string) *SMTPNotifier {
+	return &SMTPNotifier{
+		addr:     net.JoinHostPort(host, fmt.Sprint(port)),
+		host:     host,
+		username: username,
+		password: password,
+		from:     from,
+		to:       to,
+	}
+}
+
+func (n *SMTPNotifier) Notify(ctx context.Context, notification entity.Notification) error {
+	subject, body, err := Render(notification)
+	if err != nil {
+		return err
+	}
+
+	message, err := buildMessage(n.from, n.to, subject, body, time.Now())
+	if err != nil {
+		return err
+	}
+
+	if err := n.send(ctx, message); err != nil {
+		return fmt.Errorf("failed to send %s notification: %w", notification.Kind, err)
+	}
+	return nil
+}
+
+func (n *SMTPNotifier) send(ctx context.Context, message []byte) error {
+	conn, err := (&net.Dialer{Timeout: smtpDialTimeout}).DialContext(ctx, "tcp", n.addr)
+	if err != nil {
+		return err
+	}
+	conn.SetDeadline(time.Now().Add(smtpSendTimeout))
+
+	client, err := smtp.NewClient(conn, n.host)
+	if err != nil {
+		conn.Close()
+		return err
+	}
+	defer client.Close()
+
+	// 対応しているサーバーには暗号化して送る（認証する場合は net/smtp が暗号化を求める）
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		if err := client.StartTLS(&tls.Config{ServerName: n.host}); err != nil {
+			return err
+		}
+	}
+	if n.username != "" {
+		if err := client.Auth(smtp.PlainAuth("", n.username, n.password, n.host)); err != nil {
+			return err
+		}
+	}
+
+	if err := client.Mail(n.from); err != nil {
+		return err
+	}
+	for _, to := range n.to {
+		if err := client.Rcpt(to); err != nil {
+			return err
+		}
+	}
+
+	w, err := client.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(message); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+
+	return client.Quit()
+}
+
+// 日本語の件名と本文を送れるよう、件名はMIMEエンコードし、本文はquoted-printableにする
+func buildMessage(from string, to []string, subject, body string, date time.Time) ([]byte, error) {
+	var message bytes.Buffer
+	fmt.Fprintf(&message, "From: %s\r\n", from)
+	fmt.Fprintf(&message, "To: %s\r\n", strings.Join(to, ", "))
+	fmt.Fprintf(&message, "Subject: %s\r\n", mime.QEncoding.Encode("UTF-8", subject))
+	fmt.Fprintf(&message, "Date: %s\r\n", date.Format(time.RFC1123Z))
+	message.WriteString("MIME-Version: 1.0\r\n")
+	message.WriteString("Content-Type: text/plain; charset=UTF-8\r\n")
+	message.WriteString("Content-Transfer-Encoding: quoted-printable\r\n\r\n")
+
+	w := quotedprintable.NewWriter(&message)
+	if _, err := w.Write([]byte(strings.ReplaceAll(body, "\n", "\r\n"))); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+
+	return message.Bytes(), nil
+}
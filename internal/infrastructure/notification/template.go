@@ -0,0 +1,50 @@
+package notification
+
+import (
+	"embed"
+	"fmt"
+	"io/fs"
+	"path"
+	"strings"
+	"text/template"
+
+	"Aicon-assignment/internal/domain/entity"
+)
+
+// 種類ごとのテンプレート（ファイル名が種類で、"subject" と "body" を定義する）
+//
+//go:embed templates/*.tmpl
+var templateFiles embed.FS
+
+var templates = parseTemplates()
+
+func parseTemplates() map[string]*template.Template {
+	files, err := fs.Glob(templateFiles, "templates/*.tmpl")
+	if err != nil {
+		panic(err)
+	}
+
+	parsed := make(map[string]*template.Template, len(files))
+	for _, file := range files {
+		kind := strings.TrimSuffix(path.Base(file), ".tmpl")
+		parsed[kind] = template.Must(template.ParseFS(templateFiles, file))
+	}
+	return parsed
+}
+
+// 通知の件名と本文を作る
+func Render(n entity.Notification) (subject, body string, err error) {
+	tmpl, ok := templates[n.Kind]
+	if !ok {
+		return "", "", fmt.Errorf("no template for notification %q", n.Kind)
+	}
+
+	var rendered [2]strings.Builder
+	for i, name := range []string{"subject", "body"} {
+		if err := tmpl.ExecuteTemplate(&rendered[i], name, n.Data); err != nil {
+			return "", "", fmt.Errorf("failed to render %s of notification %q: %w", name, n.Kind, err)
+		}
+	}
+
+	return strings.TrimSpace(rendered[0].String()), rendered[1].String(), nil
+}
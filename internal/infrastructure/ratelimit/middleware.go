@@ -0,0 +1,52 @@
+package ratelimit
+
+import (
+	"math"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+
+	"Aicon-assignment/internal/infrastructure/logging"
+	"Aicon-assignment/internal/interfaces/problem"
+)
+
+// 制限を超えたリクエストに429を返す（limiterがnilの場合は何もしない）
+// skipPaths に含まれるパス（ヘルスチェックなど）は数えない
+func Middleware(limiter *Limiter, skipPaths ...string) echo.MiddlewareFunc {
+	skip := make(map[string]bool, len(skipPaths))
+	for _, path := range skipPaths {
+		skip[path] = true
+	}
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		if limiter == nil {
+			return next
+		}
+
+		return func(c echo.Context) error {
+			if skip[c.Request().URL.Path] {
+				return next(c)
+			}
+
+			allowed, wait := limiter.Allow(clientKey(c))
+			if !allowed {
+				// Retry-After は秒単位のため切り上げる
+				retryAfter := int(math.Ceil(wait.Seconds()))
+				c.Response().Header().Set("Retry-After", strconv.Itoa(max(retryAfter, 1)))
+				return problem.Write(c, problem.New(http.StatusTooManyRequests, "rate_limit_exceeded"))
+			}
+
+			return next(c)
+		}
+	}
+}
+
+// 認証済みのリクエストはユーザーごと、それ以外は接続元のIPアドレスごとに数える
+// IPアドレスは Echo の IPExtractor で取り出す（X-Forwarded-For を使うのは信頼するプロキシから来た場合だけで、呼び出し側が付けた値では数えない）
+func clientKey(c echo.Context) string {
+	if user, ok := c.Get(logging.UserKey).(string); ok && user != "" {
+		return "user:" + user
+	}
+	return "ip:" + c.RealIP()
+}
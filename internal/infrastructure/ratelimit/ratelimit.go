@@ -0,0 +1,85 @@
+package ratelimit
+
+import (
+	"math"
+	"sync"
+	"time"
+)
+
+// クライアントごとのトークンバケット
+// 1分あたり perMinute 個のトークンが補充され、最大 burst 個まで貯まる。リクエストごとに1個使う
+type Limiter struct {
+	perMinute int
+	burst     int
+	interval  time.Duration // トークン1個が補充されるまでの時間
+	now       func() time.Time
+
+	mu        sync.Mutex
+	buckets   map[string]*bucket
+	lastSweep time.Time
+}
+
+type bucket struct {
+	tokens  float64
+	updated time.Time
+}
+
+// perMinute が0の場合は制限しない（nilを返す）
+func New(perMinute, burst int) *Limiter {
+	if perMinute <= 0 {
+		return nil
+	}
+	if burst <= 0 {
+		burst = 1
+	}
+
+	return &Limiter{
+		perMinute: perMinute,
+		burst:     burst,
+		interval:  time.Minute / time.Duration(perMinute),
+		now:       time.Now,
+		buckets:   map[string]*bucket{},
+	}
+}
+
+// トークンを1個使う。足りない場合は false と、次の1個が補充されるまでの時間を返す
+func (l *Limiter) Allow(key string) (bool, time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	l.sweep(now)
+
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &bucket{tokens: float64(l.burst), updated: now}
+		l.buckets[key] = b
+	}
+
+	elapsed := now.Sub(b.updated)
+	b.tokens = math.Min(float64(l.burst), b.tokens+float64(elapsed)/float64(l.interval))
+	b.updated = now
+
+	if b.tokens < 1 {
+		wait := time.Duration((1 - b.tokens) * float64(l.interval))
+		return false, wait
+	}
+
+	b.tokens--
+	return true, 0
+}
+
+// 満タンまで補充されたバケットは新しく作ったものと同じなので、定期的に捨ててメモリを増やし続けない
+func (l *Limiter) sweep(now time.Time) {
+	full := l.interval * time.Duration(l.burst)
+	if now.Sub(l.lastSweep) < full {
+		return
+	}
+	l.lastSweep = now
+
+	for key, b := range l.buckets {
+		if now.Sub(b.updated) >= full {
+			delete(l.buckets, key)
+		}
+	}
+}
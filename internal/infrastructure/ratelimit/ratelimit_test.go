@@ -0,0 +1,132 @@
+package ratelimit
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+
+	"Aicon-assignment/internal/infrastructure/logging"
+)
+
+// 時刻を進められるLimiterを返す
+func newTestLimiter(perMinute, burst int) (*Limiter, *time.Time) {
+	now := time.Date(2025, 1, 15, 10, 0, 0, 0, time.UTC)
+	limiter := New(perMinute, burst)
+	limiter.now = func() time.Time { return now }
+	return limiter, &now
+}
+
+func TestLimiter_Allow(t *testing.T) {
+	t.Run("正常系: バーストの分だけ続けて通し、補充されたら再び通す", func(t *testing.T) {
+		limiter, now := newTestLimiter(60, 3)
+
+		for i := 0; i < 3; i++ {
+			allowed, _ := limiter.Allow("client")
+			assert.True(t, allowed)
+		}
+
+		allowed, wait := limiter.Allow("client")
+		assert.False(t, allowed)
+		assert.Equal(t, time.Second, wait)
+
+		*now = now.Add(time.Second)
+		allowed, _ = limiter.Allow("client")
+		assert.True(t, allowed)
+	})
+
+	t.Run("正常系: クライアントごとに数える", func(t *testing.T) {
+		limiter, _ := newTestLimiter(60, 1)
+
+		allowed, _ := limiter.Allow("a")
+		assert.True(t, allowed)
+		allowed, _ = limiter.Allow("b")
+		assert.True(t, allowed)
+		allowed, _ = limiter.Allow("a")
+		assert.False(t, allowed)
+	})
+
+	t.Run("正常系: 満タンまで補充されたバケットは捨てる", func(t *testing.T) {
+		limiter, now := newTestLimiter(60, 2)
+
+		limiter.Allow("a")
+		*now = now.Add(2 * time.Second)
+		limiter.Allow("b")
+
+		assert.Len(t, limiter.buckets, 1)
+		assert.Contains(t, limiter.buckets, "b")
+	})
+
+	t.Run("正常系: 0の場合は制限しない", func(t *testing.T) {
+		assert.Nil(t, New(0, 10))
+	})
+}
+
+func TestMiddleware(t *testing.T) {
+	newServer := func(limiter *Limiter) *echo.Echo {
+		e := echo.New()
+		e.Use(Middleware(limiter, "/healthz"))
+		ok := func(c echo.Context) error { return c.NoContent(http.StatusOK) }
+		e.GET("/items", ok)
+		e.GET("/healthz", ok)
+		return e
+	}
+	request := func(e *echo.Echo, path, ip string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		req.RemoteAddr = ip + ":12345"
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		return rec
+	}
+
+	t.Run("異常系: 制限を超えたら429とRetry-Afterを返す", func(t *testing.T) {
+		limiter, _ := newTestLimiter(30, 1)
+		e := newServer(limiter)
+
+		assert.Equal(t, http.StatusOK, request(e, "/items", "192.0.2.1").Code)
+
+		rec := request(e, "/items", "192.0.2.1")
+		assert.Equal(t, http.StatusTooManyRequests, rec.Code)
+		assert.Equal(t, "2", rec.Header().Get("Retry-After"))
+		assert.Contains(t, rec.Body.String(), `"code":"rate_limit_exceeded"`)
+
+		// 別のIPアドレスは制限されない
+		assert.Equal(t, http.StatusOK, request(e, "/items", "192.0.2.2").Code)
+	})
+
+	t.Run("正常系: 除外したパスは数えない", func(t *testing.T) {
+		limiter, _ := newTestLimiter(60, 1)
+		e := newServer(limiter)
+
+		for i := 0; i < 3; i++ {
+			assert.Equal(t, http.StatusOK, request(e, "/healthz", "192.0.2.1").Code)
+		}
+		assert.Equal(t, http.StatusOK, request(e, "/items", "192.0.2.1").Code)
+	})
+
+	t.Run("正常系: 認証済みのリクエストはIPアドレスによらずユーザーごとに数える", func(t *testing.T) {
+		limiter, _ := newTestLimiter(60, 1)
+		e := echo.New()
+		e.GET("/admin", func(c echo.Context) error { return c.NoContent(http.StatusOK) },
+			func(next echo.HandlerFunc) echo.HandlerFunc {
+				return func(c echo.Context) error {
+					c.Set(logging.UserKey, "admin")
+					return next(c)
+				}
+			}, Middleware(limiter))
+
+		assert.Equal(t, http.StatusOK, request(e, "/admin", "192.0.2.1").Code)
+		assert.Equal(t, http.StatusTooManyRequests, request(e, "/admin", "192.0.2.2").Code)
+	})
+
+	t.Run("正常系: limiterがnilの場合は制限しない", func(t *testing.T) {
+		e := newServer(nil)
+
+		for i := 0; i < 5; i++ {
+			assert.Equal(t, http.StatusOK, request(e, "/items", "192.0.2.1").Code)
+		}
+	})
+}
@@ -0,0 +1,335 @@
+package search
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"Aicon-assignment/internal/domain/entity"
+)
+
+// ファセットで返すカテゴリー・ブランドの数の上限
+const facetSize = 50
+
+// インデックスの定義（ファセットと絞り込みには .keyword を使う）
+var indexMapping = map[string]any{
+	"mappings": map[string]any{
+		"properties": map[string]any{
+			"name":          map[string]any{"type": "text"},
+			"brand":         map[string]any{"type": "text", "fields": map[string]any{"keyword": map[string]any{"type": "keyword"}}},
+			"category":      map[string]any{"type": "text", "fields": map[string]any{"keyword": map[string]any{"type": "keyword"}}},
+			"serial_number": map[string]any{"type": "text"},
+			"archived":      map[string]any{"type": "boolean"},
+			"indexed_at":    map[string]any{"type": "date", "format": "epoch_millis"},
+		},
+	},
+}
+
+// 検索に使うフィールドと重み
+var searchFields = []string{"name^3", "brand^2", "category", "serial_number"}
+
+// インデックスに書き込むアイテムの内容
+type document struct {
+	Name         string `json:"name"`
+	Category     string `json:"category"`
+	Brand        string `json:"brand"`
+	SerialNumber string `json:"serial_number"`
+	Archived     bool   `json:"archived"`
+	IndexedAt    int64  `json:"indexed_at"` // 再構築で古い文書を見分ける（Unixミリ秒）
+}
+
+// OpenSearch（Elasticsearch互換のREST API）の検索インデックス
+// URLにユーザー名とパスワードを含めた場合はBasic認証で接続する
+type OpenSearchIndex struct {
+	baseURL  string
+	index    string
+	username string
+	password string
+	client   *http.Client
+}
+
+func NewOpenSearchIndex(rawURL, index string) (*OpenSearchIndex, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid search url: %w", err)
+	}
+
+	s := &OpenSearchIndex{
+		index:  index,
+		client: &http.Client{Timeout: 10 * time.Second},
+	}
+	if u.User != nil {
+		s.username = u.User.Username()
+		s.password, _ = u.User.Password()
+		u.User = nil
+	}
+	s.baseURL = strings.TrimRight(u.String(), "/")
+	return s, nil
+}
+
+// インデックスがなければ作成し、作成した場合は true を返す
+func (s *OpenSearchIndex) EnsureIndex(ctx context.Context) (bool, error) {
+	status, _, err := s.do(ctx, http.MethodHead, "", nil, "")
+	if err != nil {
+		return false, err
+	}
+	if status == http.StatusOK {
+		return false, nil
+	}
+
+	if err := s.request(ctx, http.MethodPut, "", indexMapping, nil); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (s *OpenSearchIndex) Search(ctx context.Context, query entity.ItemSearchQuery) (*entity.ItemSearchResult, error) {
+	// カテゴリー・ブランドの絞り込みは post_filter にし、ファセットは絞り込む前の件数にする
+	var selected []any
+	if query.Category != "" {
+		selected = append(selected, map[string]any{"term": map[string]any{"category.keyword": query.Category}})
+	}
+	if query.Brand != "" {
+		selected = append(selected, map[string]any{"term": map[string]any{"brand.keyword": query.Brand}})
+	}
+
+	body := map[string]any{
+		"from":             query.Offset,
+		"size":             query.Limit,
+		"track_total_hits": true,
+		"_source":          false,
+		"query": map[string]any{
+			"bool": map[string]any{
+				// 全ての語がいずれかのフィールドに含まれるもの
+				"must": map[string]any{
+					"multi_match": map[string]any{
+						"query":    strings.Join(query.Keywords(), " "),
+						"fields":   searchFields,
+						"type":     "cross_fields",
+						"operator": "and",
+					},
+				},
+				"filter": map[string]any{"term": map[string]any{"archived": false}},
+			},
+		},
+		"aggs": map[string]any{
+			"category": map[string]any{"terms": map[string]any{"field": "category.keyword", "size": facetSize}},
+			"brand":    map[string]any{"terms": map[string]any{"field": "brand.keyword", "size": facetSize}},
+		},
+		"highlight": map[string]any{
+			"encoder":   "html",
+			"pre_tags":  []string{"<em>"},
+			"post_tags": []string{"</em>"},
+			"fields": map[string]any{
+				"name":          map[string]any{},
+				"brand":         map[string]any{},
+				"serial_number": map[string]any{},
+			},
+		},
+	}
+
+	if len(selected) > 0 {
+		body["post_filter"] = map[string]any{"bool": map[string]any{"filter": selected}}
+	}
+
+	var response struct {
+		Hits struct {
+			Total struct {
+				Value int `json:"value"`
+			} `json:"total"`
+			Hits []struct {
+				ID        string              `json:"_id"`
+				Score     float64             `json:"_score"`
+				Highlight map[string][]string `json:"highlight"`
+			} `json:"hits"`
+		} `json:"hits"`
+		Aggregations map[string]struct {
+			Buckets []struct {
+				Key      string `json:"key"`
+				DocCount int    `json:"doc_count"`
+			} `json:"buckets"`
+		} `json:"aggregations"`
+	}
+	if err := s.request(ctx, http.MethodPost, "/_search", body, &response); err != nil {
+		return nil, err
+	}
+
+	result := &entity.ItemSearchResult{
+		Total: response.Hits.Total.Value,
+		Hits:  make([]*entity.ItemSearchHit, 0, len(response.Hits.Hits)),
+	}
+	for _, hit := range response.Hits.Hits {
+		id, err := strconv.ParseInt(hit.ID, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid document id %q: %w", hit.ID, err)
+		}
+		highlights := hit.Highlight
+		if highlights == nil {
+			highlights = map[string][]string{}
+		}
+		result.Hits = append(result.Hits, &entity.ItemSearchHit{ItemID: id, Score: hit.Score, Highlights: highlights})
+	}
+
+	facets := func(name string) []entity.FacetCount {
+		counts := make([]entity.FacetCount, 0, len(response.Aggregations[name].Buckets))
+		for _, bucket := range response.Aggregations[name].Buckets {
+			counts = append(counts, entity.FacetCount{Value: bucket.Key, Count: bucket.DocCount})
+		}
+		return counts
+	}
+	result.Facets = entity.ItemSearchFacets{
+		Categories: facets("category"),
+		Brands:     facets("brand"),
+	}
+	return result, nil
+}
+
+// Bulk APIでまとめて書き込む（既にある文書は置き換える）
+func (s *OpenSearchIndex) Index(ctx context.Context, items ...*entity.Item) error {
+	if len(items) == 0 {
+		return nil
+	}
+
+	indexedAt := time.Now().UnixMilli()
+	var body bytes.Buffer
+	encoder := json.NewEncoder(&body)
+	for _, item := range items {
+		action := map[string]any{"index": map[string]any{"_id": strconv.FormatInt(item.ID, 10)}}
+		if err := encoder.Encode(action); err != nil {
+			return err
+		}
+		if err := encoder.Encode(document{
+			Name:         item.Name,
+			Category:     item.Category,
+			Brand:        item.Brand,
+			SerialNumber: item.SerialNumber,
+			Archived:     item.IsArchived(),
+			IndexedAt:    indexedAt,
+		}); err != nil {
+			return err
+		}
+	}
+
+	var response struct {
+		Errors bool `json:"errors"`
+		Items  []map[string]struct {
+			ID    string          `json:"_id"`
+			Error json.RawMessage `json:"error"`
+		} `json:"items"`
+	}
+	status, data, err := s.do(ctx, http.MethodPost, "/_bulk", &body, "application/x-ndjson")
+	if err != nil {
+		return err
+	}
+	if status >= http.StatusBadRequest {
+		return statusError(http.MethodPost, "/_bulk", status, data)
+	}
+	if err := json.Unmarshal(data, &response); err != nil {
+		return fmt.Errorf("failed to decode bulk response: %w", err)
+	}
+
+	// 1件でも失敗していれば最初の理由を返す
+	if response.Errors {
+		for _, item := range response.Items {
+			for _, result := range item {
+				if len(result.Error) > 0 {
+					return fmt.Errorf("failed to index document %s: %s", result.ID, result.Error)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// 既にない場合も成功とする
+func (s *OpenSearchIndex) Remove(ctx context.Context, id int64) error {
+	path := "/_doc/" + strconv.FormatInt(id, 10)
+	status, data, err := s.do(ctx, http.MethodDelete, path, nil, "")
+	if err != nil {
+		return err
+	}
+	if status >= http.StatusBadRequest && status != http.StatusNotFound {
+		return statusError(http.MethodDelete, path, status, data)
+	}
+	return nil
+}
+
+// 書き込んだばかりの文書が検索で見えるよう反映してから、古い文書を消す
+func (s *OpenSearchIndex) RemoveStale(ctx context.Context, before time.Time) error {
+	if err := s.request(ctx, http.MethodPost, "/_refresh", nil, nil); err != nil {
+		return err
+	}
+
+	body := map[string]any{
+		"query": map[string]any{"range": map[string]any{"indexed_at": map[string]any{"lt": before.UnixMilli()}}},
+	}
+	// 消す間に書き込まれた文書は消さずに残す
+	return s.request(ctx, http.MethodPost, "/_delete_by_query?conflicts=proceed", body, nil)
+}
+
+// body をJSONで送り、2xx以外はエラーにする（out が nil でなければレスポンスを読み込む）
+func (s *OpenSearchIndex) request(ctx context.Context, method, path string, body, out any) error {
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reader = bytes.NewReader(data)
+	}
+
+	status, data, err := s.do(ctx, method, path, reader, "application/json")
+	if err != nil {
+		return err
+	}
+	if status >= http.StatusBadRequest {
+		return statusError(method, path, status, data)
+	}
+	if out != nil {
+		if err := json.Unmarshal(data, out); err != nil {
+			return fmt.Errorf("failed to decode response of %s %s: %w", method, path, err)
+		}
+	}
+	return nil
+}
+
+func (s *OpenSearchIndex) do(ctx context.Context, method, path string, body io.Reader, contentType string) (int, []byte, error) {
+	req, err := http.NewRequestWithContext(ctx, method, s.baseURL+"/"+url.PathEscape(s.index)+path, body)
+	if err != nil {
+		return 0, nil, err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", contentType)
+	}
+	if s.username != "" {
+		req.SetBasicAuth(s.username, s.password)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return 0, nil, err
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return 0, nil, err
+	}
+	return resp.StatusCode, data, nil
+}
+
+// エラーの本文は長くなりうるため先頭だけを含める
+func statusError(method, path string, status int, body []byte) error {
+	const maxBody = 500
+	if len(body) > maxBody {
+		body = body[:maxBody]
+	}
+	return fmt.Errorf("opensearch %s %s returned %d: %s", method, path, status, body)
+}
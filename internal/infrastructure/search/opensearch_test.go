@@ -0,0 +1,99 @@
+package search
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"Aicon-assignment/internal/domain/entity"
+)
+
+func TestOpenSearchIndex(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("正常系: 検索結果をヒット・ファセット・ハイライトにする", func(t *testing.T) {
+		var requested map[string]any
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/items/_search", r.URL.Path)
+			user, password, ok := r.BasicAuth()
+			assert.True(t, ok)
+			assert.Equal(t, "admin:secret", user+":"+password)
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&requested))
+
+			io.WriteString(w, `{
+				"hits": {"total": {"value": 1}, "hits": [{"_id": "2", "_score": 1.5, "highlight": {"name": ["ROLEX <em>デイトナ</em>"]}}]},
+				"aggregations": {
+					"category": {"buckets": [{"key": "時計", "doc_count": 2}]},
+					"brand": {"buckets": [{"key": "ROLEX", "doc_count": 1}, {"key": "OMEGA", "doc_count": 1}]}
+				}
+			}`)
+		}))
+		defer server.Close()
+
+		index, err := NewOpenSearchIndex(strings.Replace(server.URL, "http://", "http://admin:secret@", 1), "items")
+		require.NoError(t, err)
+
+		got, err := index.Search(ctx, entity.ItemSearchQuery{Query: "デイトナ", Brand: "ROLEX", Limit: 20})
+		require.NoError(t, err)
+
+		assert.Equal(t, 1, got.Total)
+		require.Len(t, got.Hits, 1)
+		assert.Equal(t, int64(2), got.Hits[0].ItemID)
+		assert.Equal(t, []string{"ROLEX <em>デイトナ</em>"}, got.Hits[0].Highlights["name"])
+		assert.Equal(t, []entity.FacetCount{{Value: "時計", Count: 2}}, got.Facets.Categories)
+		assert.Len(t, got.Facets.Brands, 2)
+
+		// ブランドの絞り込みはファセットに影響しない post_filter にする
+		assert.Contains(t, requested, "post_filter")
+		assert.NotContains(t, requested["query"].(map[string]any)["bool"], "post_filter")
+	})
+
+	t.Run("正常系: まとめて書き込み、存在しない文書の削除は成功とする", func(t *testing.T) {
+		var lines []string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch r.Method {
+			case http.MethodPost:
+				assert.Equal(t, "/items/_bulk", r.URL.Path)
+				assert.Equal(t, "application/x-ndjson", r.Header.Get("Content-Type"))
+				body, _ := io.ReadAll(r.Body)
+				lines = strings.Split(strings.TrimSpace(string(body)), "\n")
+				io.WriteString(w, `{"errors": false, "items": []}`)
+			case http.MethodDelete:
+				w.WriteHeader(http.StatusNotFound)
+				io.WriteString(w, `{"result": "not_found"}`)
+			}
+		}))
+		defer server.Close()
+
+		index, err := NewOpenSearchIndex(server.URL, "items")
+		require.NoError(t, err)
+
+		require.NoError(t, index.Index(ctx, &entity.Item{ID: 1, Name: "ロレックス デイトナ"}, &entity.Item{ID: 2, Name: "エルメス バーキン"}))
+		require.Len(t, lines, 4)
+		assert.JSONEq(t, `{"index": {"_id": "1"}}`, lines[0])
+		assert.Contains(t, lines[3], `"name":"エルメス バーキン"`)
+
+		assert.NoError(t, index.Remove(ctx, 9))
+	})
+
+	t.Run("異常系: 書き込みに失敗した文書があればエラーを返す", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			io.WriteString(w, `{"errors": true, "items": [{"index": {"_id": "1", "error": {"type": "mapper_parsing_exception"}}}]}`)
+		}))
+		defer server.Close()
+
+		index, err := NewOpenSearchIndex(server.URL, "items")
+		require.NoError(t, err)
+
+		err = index.Index(ctx, &entity.Item{ID: 1})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "mapper_parsing_exception")
+	})
+}
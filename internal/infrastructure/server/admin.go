@@ -0,0 +1,77 @@
+package server
+
+import (
+	"context"
+	"crypto/subtle"
+	"net/http"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"Aicon-assignment/internal/infrastructure/logging"
+	"Aicon-assignment/internal/interfaces/masking"
+	"Aicon-assignment/internal/interfaces/problem"
+)
+
+// 管理者用のエンドポイントの認証（Authorization: Bearer <ADMIN_TOKEN>）
+// 通ったリクエストはアクセスログに user=admin として出力し、記録された内容を伏せずに返す
+func requireAdminToken(token string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if !hasAdminToken(c, token) {
+				c.Response().Header().Set(echo.HeaderWWWAuthenticate, "Bearer")
+				return problem.Write(c, problem.New(http.StatusUnauthorized, "admin_token_required"))
+			}
+
+			authenticateAdmin(c)
+			return next(c)
+		}
+	}
+}
+
+// 誰でも呼べるエンドポイントでも、管理者のトークンが付いていれば管理者として扱う（付いていなくても断らない）
+func identifyAdmin(token string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if hasAdminToken(c, token) {
+				authenticateAdmin(c)
+			}
+			return next(c)
+		}
+	}
+}
+
+// gRPCでも requireAdminToken と同じトークンを authorization メタデータ（Bearer <ADMIN_TOKEN>）で求める
+func requireAdminTokenGRPC(token string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		md, _ := metadata.FromIncomingContext(ctx)
+		if values := md.Get("authorization"); len(values) == 0 || !isAdminToken(values[0], token) {
+			return nil, status.Error(codes.Unauthenticated, "admin token required")
+		}
+		return handler(ctx, req)
+	}
+}
+
+func hasAdminToken(c echo.Context, token string) bool {
+	return isAdminToken(c.Request().Header.Get(echo.HeaderAuthorization), token)
+}
+
+func isAdminToken(authorization, token string) bool {
+	given, ok := strings.CutPrefix(authorization, "Bearer ")
+	return ok && subtle.ConstantTimeCompare([]byte(given), []byte(token)) == 1
+}
+
+func authenticateAdmin(c echo.Context) {
+	c.Set(logging.UserKey, "admin")
+	c.SetRequest(c.Request().WithContext(masking.Reveal(c.Request().Context())))
+}
+
+// 認証したミドルウェアが設定した利用者（設定されていなければ空文字）
+func requestUser(c echo.Context) string {
+	user, _ := c.Get(logging.UserKey).(string)
+	return user
+}
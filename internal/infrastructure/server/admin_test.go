@@ -0,0 +1,126 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"Aicon-assignment/internal/infrastructure/logging"
+	"Aicon-assignment/internal/interfaces/masking"
+)
+
+func TestRequireAdminToken(t *testing.T) {
+	const token = "0123456789abcdef"
+
+	tests := []struct {
+		name          string
+		authorization string
+		wantStatus    int
+	}{
+		{name: "正常系: トークンが一致する", authorization: "Bearer " + token, wantStatus: http.StatusOK},
+		{name: "異常系: トークンがない", authorization: "", wantStatus: http.StatusUnauthorized},
+		{name: "異常系: トークンが異なる", authorization: "Bearer wrong-token-000000", wantStatus: http.StatusUnauthorized},
+		{name: "異常系: Bearer以外の形式", authorization: token, wantStatus: http.StatusUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			var user interface{}
+			e.POST("/admin/backup", func(c echo.Context) error {
+				user = c.Get(logging.UserKey)
+				return c.NoContent(http.StatusOK)
+			}, requireAdminToken(token))
+
+			req := httptest.NewRequest(http.MethodPost, "/admin/backup", nil)
+			if tt.authorization != "" {
+				req.Header.Set(echo.HeaderAuthorization, tt.authorization)
+			}
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.wantStatus, rec.Code)
+			if tt.wantStatus == http.StatusOK {
+				assert.Equal(t, "admin", user)
+			} else {
+				assert.Equal(t, "Bearer", rec.Header().Get(echo.HeaderWWWAuthenticate))
+			}
+		})
+	}
+}
+
+func TestRequireAdminTokenGRPC(t *testing.T) {
+	const token = "0123456789abcdef"
+
+	tests := []struct {
+		name          string
+		authorization string
+		wantCode      codes.Code
+	}{
+		{name: "正常系: トークンが一致する", authorization: "Bearer " + token, wantCode: codes.OK},
+		{name: "異常系: トークンがない", authorization: "", wantCode: codes.Unauthenticated},
+		{name: "異常系: トークンが異なる", authorization: "Bearer wrong-token-000000", wantCode: codes.Unauthenticated},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.authorization != "" {
+				ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", tt.authorization))
+			}
+			called := false
+			handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+				called = true
+				return "ok", nil
+			}
+
+			_, err := requireAdminTokenGRPC(token)(ctx, nil, &grpc.UnaryServerInfo{FullMethod: "/item.v1.ItemService/GetItemByID"}, handler)
+
+			assert.Equal(t, tt.wantCode, status.Code(err))
+			assert.Equal(t, tt.wantCode == codes.OK, called)
+		})
+	}
+}
+
+func TestIdentifyAdmin(t *testing.T) {
+	const token = "0123456789abcdef"
+
+	tests := []struct {
+		name          string
+		authorization string
+		wantRevealed  bool
+	}{
+		{name: "正常系: トークンが一致すれば伏せずに返す", authorization: "Bearer " + token, wantRevealed: true},
+		{name: "正常系: トークンがなくても断らない", authorization: ""},
+		{name: "正常系: トークンが異なれば伏せる", authorization: "Bearer wrong-token-000000"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			var revealed bool
+			e.GET("/webhooks/1/deliveries", func(c echo.Context) error {
+				revealed = masking.IsRevealed(c.Request().Context())
+				return c.NoContent(http.StatusOK)
+			}, identifyAdmin(token))
+
+			req := httptest.NewRequest(http.MethodGet, "/webhooks/1/deliveries", nil)
+			if tt.authorization != "" {
+				req.Header.Set(echo.HeaderAuthorization, tt.authorization)
+			}
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, req)
+
+			assert.Equal(t, http.StatusOK, rec.Code)
+			assert.Equal(t, tt.wantRevealed, revealed)
+		})
+	}
+}
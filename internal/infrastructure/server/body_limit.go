@@ -0,0 +1,54 @@
+package server
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"Aicon-assignment/internal/interfaces/problem"
+)
+
+// 本文が limit バイトを超えるリクエストに413を返す
+// 上限までは読み込んでから渡すため、ハンドラーやスキーマの検証は読み込み途中のエラーを扱わなくてよい
+// skipPaths に含まれるパスは、ルートごとに別の上限を設定するため対象にしない
+func limitRequestBody(limit int64, skipPaths ...string) echo.MiddlewareFunc {
+	skip := make(map[string]bool, len(skipPaths))
+	for _, path := range skipPaths {
+		skip[path] = true
+	}
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			req := c.Request()
+			if req.Body == nil || req.Body == http.NoBody || skip[req.URL.Path] {
+				return next(c)
+			}
+
+			if req.ContentLength > limit {
+				return requestBodyTooLarge(c, limit)
+			}
+
+			// Content-Lengthがない（chunked）場合や偽っている場合のため、上限を1バイト超えるまで読む
+			body, err := io.ReadAll(io.LimitReader(req.Body, limit+1))
+			if err != nil {
+				return problem.Write(c, problem.New(http.StatusBadRequest, "invalid_request_format"))
+			}
+			if int64(len(body)) > limit {
+				return requestBodyTooLarge(c, limit)
+			}
+			req.Body = io.NopCloser(bytes.NewReader(body))
+
+			return next(c)
+		}
+	}
+}
+
+func requestBodyTooLarge(c echo.Context, limit int64) error {
+	// 残りを読まずに返すため、接続を使い回さない
+	c.Response().Header().Set(echo.HeaderConnection, "close")
+	return problem.Write(c, problem.New(http.StatusRequestEntityTooLarge, "request_body_too_large").
+		WithDetail(fmt.Sprintf("request body must not exceed %d bytes", limit)))
+}
@@ -0,0 +1,105 @@
+package server
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLimitRequestBody(t *testing.T) {
+	newServer := func() *echo.Echo {
+		e := echo.New()
+		e.Use(limitRequestBody(10, "/admin/restore"))
+		echoBody := func(c echo.Context) error {
+			body, err := io.ReadAll(c.Request().Body)
+			if err != nil {
+				return err
+			}
+			return c.String(http.StatusOK, string(body))
+		}
+		e.POST("/items", echoBody)
+		e.POST("/admin/restore", echoBody, limitRequestBody(20))
+		return e
+	}
+
+	tests := []struct {
+		name          string
+		path          string
+		body          string
+		contentLength int64
+		wantStatus    int
+	}{
+		{name: "正常系: 上限ちょうど", path: "/items", body: strings.Repeat("a", 10), wantStatus: http.StatusOK},
+		{name: "異常系: Content-Lengthが上限を超える", path: "/items", body: strings.Repeat("a", 11), wantStatus: http.StatusRequestEntityTooLarge},
+		{name: "異常系: Content-Lengthがなく本文が上限を超える", path: "/items", body: strings.Repeat("a", 11), contentLength: -1, wantStatus: http.StatusRequestEntityTooLarge},
+		{name: "正常系: ルートごとの上限", path: "/admin/restore", body: strings.Repeat("a", 20), wantStatus: http.StatusOK},
+		{name: "異常系: ルートごとの上限を超える", path: "/admin/restore", body: strings.Repeat("a", 21), wantStatus: http.StatusRequestEntityTooLarge},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, tt.path, strings.NewReader(tt.body))
+			if tt.contentLength != 0 {
+				req.ContentLength = tt.contentLength
+			}
+			rec := httptest.NewRecorder()
+			newServer().ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.wantStatus, rec.Code)
+			if tt.wantStatus == http.StatusOK {
+				assert.Equal(t, tt.body, rec.Body.String())
+			} else {
+				assert.Contains(t, rec.Body.String(), `"code":"request_body_too_large"`)
+			}
+		})
+	}
+}
+
+func TestCompressResponses(t *testing.T) {
+	large := strings.Repeat("a", gzipMinLength)
+	e := echo.New()
+	e.Use(compressResponses())
+	// リソースのレスポンスと同じく Content-Length を付けて返す
+	items := func(c echo.Context) error {
+		c.Response().Header().Set(echo.HeaderContentLength, strconv.Itoa(len(large)))
+		return c.String(http.StatusOK, large)
+	}
+	e.GET("/items", items)
+	e.HEAD("/items", items)
+	e.GET("/items/1", func(c echo.Context) error { return c.String(http.StatusOK, "small") })
+	e.GET("/events", func(c echo.Context) error { return c.String(http.StatusOK, large) })
+
+	tests := []struct {
+		name              string
+		method            string
+		path              string
+		wantEncoding      string
+		wantContentLength string
+	}{
+		{name: "正常系: 大きなレスポンスを圧縮する", method: http.MethodGet, path: "/items", wantEncoding: "gzip", wantContentLength: ""},
+		{name: "正常系: 小さなレスポンスは圧縮しない", method: http.MethodGet, path: "/items/1", wantEncoding: ""},
+		{name: "正常系: SSEは圧縮しない", method: http.MethodGet, path: "/events", wantEncoding: ""},
+		{name: "正常系: HEADは圧縮せず本文の長さを返す", method: http.MethodHead, path: "/items", wantEncoding: "", wantContentLength: strconv.Itoa(gzipMinLength)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.path, nil)
+			req.Header.Set(echo.HeaderAcceptEncoding, "gzip")
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, req)
+
+			assert.Equal(t, http.StatusOK, rec.Code)
+			assert.Equal(t, tt.wantEncoding, rec.Header().Get(echo.HeaderContentEncoding))
+			if tt.path == "/items" {
+				assert.Equal(t, tt.wantContentLength, rec.Header().Get(echo.HeaderContentLength))
+			}
+		})
+	}
+}
@@ -0,0 +1,231 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"math/rand"
+	"os"
+	"time"
+
+	"Aicon-assignment/internal/infrastructure/cache"
+	"Aicon-assignment/internal/infrastructure/config"
+	"Aicon-assignment/internal/interfaces/cli"
+	"Aicon-assignment/internal/usecase"
+)
+
+const cliUsage = `Usage: aicon-assignment <command> [options]
+
+Commands:
+  serve                                  start the HTTP and gRPC servers (default)
+  migrate [up|status]                    apply or list database migrations
+  import-csv <file>                      create items from a CSV file
+  export [-format csv|json] [-o file]    write all items to a file
+  recalculate-summaries                  drop cached summaries and recompute them
+  seed [-count n]                        create sample items (not allowed when APP_ENV=production)
+  help                                   show this message
+`
+
+// 運用向けのサブコマンドを実行する（引数がなければサーバーを起動する）
+// データの操作はAPIと同じusecaseを通すため、検証やイベントの記録も同じように行われる
+func RunCLI(ctx context.Context, cfg *config.Config, args []string, stdout io.Writer) error {
+	if len(args) == 0 {
+		return NewServer(cfg).Run(ctx)
+	}
+
+	switch args[0] {
+	case "serve":
+		return NewServer(cfg).Run(ctx)
+	case "migrate":
+		return Migrate(ctx, cfg, args[1:])
+	case "import-csv":
+		return importCSV(ctx, cfg, args[1:], stdout)
+	case "export":
+		return exportItems(ctx, cfg, args[1:], stdout)
+	case "recalculate-summaries":
+		return recalculateSummaries(ctx, cfg, stdout)
+	case "seed":
+		return seed(ctx, cfg, args[1:], stdout)
+	case "help", "-h", "--help":
+		fmt.Fprint(stdout, cliUsage)
+		return nil
+	default:
+		return fmt.Errorf("unknown command %q\n\n%s", args[0], cliUsage)
+	}
+}
+
+func importCSV(ctx context.Context, cfg *config.Config, args []string, stdout io.Writer) error {
+	if len(args) != 1 {
+		return errors.New("usage: import-csv <file>")
+	}
+
+	file, err := os.Open(args[0])
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	items, _, closeItems, err := openItemUsecase(ctx, cfg)
+	if err != nil {
+		return err
+	}
+	defer closeItems()
+
+	imported, rowErrors, err := cli.ImportCSV(ctx, items, file)
+	if err != nil {
+		return err
+	}
+
+	for _, rowError := range rowErrors {
+		fmt.Fprintf(stdout, "❌ %v\n", rowError)
+	}
+	fmt.Fprintf(stdout, "✅ Imported %d items from %s\n", imported, args[0])
+
+	if len(rowErrors) > 0 {
+		return fmt.Errorf("%d rows failed to import", len(rowErrors))
+	}
+	return nil
+}
+
+func exportItems(ctx context.Context, cfg *config.Config, args []string, stdout io.Writer) error {
+	flags := flag.NewFlagSet("export", flag.ContinueOnError)
+	flags.SetOutput(stdout)
+	format := flags.String("format", "csv", "output format (csv or json)")
+	output := flags.String("o", "", "output file (default items.<format>)")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	// 接続時のメッセージが標準出力に出るため、ファイルに書き出す
+	export := cli.ExportCSV
+	switch *format {
+	case "csv":
+	case "json":
+		export = cli.ExportJSON
+	default:
+		return fmt.Errorf("unknown format %q (must be csv or json)", *format)
+	}
+	if *output == "" {
+		*output = "items." + *format
+	}
+
+	items, _, closeItems, err := openItemUsecase(ctx, cfg)
+	if err != nil {
+		return err
+	}
+	defer closeItems()
+
+	file, err := os.Create(*output)
+	if err != nil {
+		return err
+	}
+
+	count, err := export(ctx, items, file)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(stdout, "✅ Exported %d items to %s\n", count, *output)
+	return nil
+}
+
+func recalculateSummaries(ctx context.Context, cfg *config.Config, stdout io.Writer) error {
+	items, store, closeItems, err := openItemUsecase(ctx, cfg)
+	if err != nil {
+		return err
+	}
+	defer closeItems()
+
+	if store != nil {
+		if err := cache.InvalidateSummaries(ctx, store); err != nil {
+			return fmt.Errorf("failed to invalidate cached summaries: %w", err)
+		}
+	}
+
+	// キャッシュを削除した後に取得すると、保存先から集計し直してキャッシュに載せる
+	summary, err := items.GetCategorySummary(ctx)
+	if err != nil {
+		return err
+	}
+
+	encoded, err := json.MarshalIndent(summary, "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(stdout, "%s\n", encoded)
+	return nil
+}
+
+// 開発・デモ用のサンプルデータを登録する（本番環境では実行できない）
+func seed(ctx context.Context, cfg *config.Config, args []string, stdout io.Writer) error {
+	if cfg.Env == "production" {
+		return errors.New("seed is not allowed when APP_ENV=production")
+	}
+
+	flags := flag.NewFlagSet("seed", flag.ContinueOnError)
+	flags.SetOutput(stdout)
+	count := flags.Int("count", 50, "number of items to create")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if *count <= 0 {
+		return fmt.Errorf("count must be greater than 0, got %d", *count)
+	}
+
+	items, _, closeItems, err := openItemUsecase(ctx, cfg)
+	if err != nil {
+		return err
+	}
+	defer closeItems()
+
+	created, err := cli.Seed(ctx, items, *count, rand.New(rand.NewSource(time.Now().UnixNano())))
+	fmt.Fprintf(stdout, "✅ Created %d sample items\n", created)
+	return err
+}
+
+// サーバーと同じ保存先とキャッシュでアイテムのusecaseを組み立てる
+// キャッシュを使わない設定の場合、storeはnil
+func openItemUsecase(ctx context.Context, cfg *config.Config) (usecase.ItemUsecase, cache.Store, func(), error) {
+	repos, err := newRepositories(cfg, nil)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	if cfg.MigrateOnStartup && repos.sqlHandler != nil {
+		if err := migrate(ctx, repos.sqlHandler); err != nil {
+			repos.close()
+			return nil, nil, nil, err
+		}
+	}
+
+	itemRepo := repos.item
+	itemReader := repos.itemReader
+	transactor := repos.transactor
+	closeAll := repos.close
+
+	// 変更したアイテムと集計のキャッシュがサーバーに残らないよう、同じように削除する
+	var store cache.Store
+	if cfg.Cache.RedisURL != "" {
+		redisStore, err := cache.NewRedisStore(cfg.Cache.RedisURL)
+		if err != nil {
+			repos.close()
+			return nil, nil, nil, fmt.Errorf("failed to connect to Redis: %w", err)
+		}
+		store = redisStore
+		itemRepo = cache.NewItemRepository(itemRepo, store, cfg.Cache.ItemTTL, cfg.Cache.SummaryTTL)
+		itemReader = cache.NewItemRepository(itemReader, store, cfg.Cache.ItemTTL, cfg.Cache.SummaryTTL)
+		transactor = cache.NewTransactor(transactor, store, cfg.Cache.ItemTTL, cfg.Cache.SummaryTTL)
+		closeAll = func() {
+			redisStore.Close()
+			repos.close()
+		}
+	}
+
+	return usecase.NewItemUsecaseWithQuota(itemRepo, itemReader, transactor, usecase.Quota{MaxItems: cfg.Quota.MaxItems}), store, closeAll, nil
+}
@@ -0,0 +1,58 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"Aicon-assignment/internal/infrastructure/config"
+)
+
+func TestRunCLI(t *testing.T) {
+	cfg := config.Default()
+	cfg.Storage = "memory"
+
+	t.Run("正常系: 指定したファイルに書き出す", func(t *testing.T) {
+		output := filepath.Join(t.TempDir(), "items.json")
+		var stdout bytes.Buffer
+
+		err := RunCLI(context.Background(), cfg, []string{"export", "-format", "json", "-o", output}, &stdout)
+
+		require.NoError(t, err)
+		assert.Contains(t, stdout.String(), "Exported 0 items")
+		content, err := os.ReadFile(output)
+		require.NoError(t, err)
+		assert.JSONEq(t, "[]", string(content))
+	})
+
+	t.Run("異常系: 取り込めない行があれば失敗する", func(t *testing.T) {
+		input := filepath.Join(t.TempDir(), "items.csv")
+		require.NoError(t, os.WriteFile(input, []byte("name,category,brand,purchase_price,purchase_date\n,時計,ROLEX,1,2023-01-15\n"), 0o600))
+		var stdout bytes.Buffer
+
+		err := RunCLI(context.Background(), cfg, []string{"import-csv", input}, &stdout)
+
+		assert.EqualError(t, err, "1 rows failed to import")
+		assert.Contains(t, stdout.String(), "line 2:")
+	})
+
+	t.Run("異常系: 本番環境ではサンプルデータを登録しない", func(t *testing.T) {
+		production := *cfg
+		production.Env = "production"
+
+		err := RunCLI(context.Background(), &production, []string{"seed"}, &bytes.Buffer{})
+
+		assert.EqualError(t, err, "seed is not allowed when APP_ENV=production")
+	})
+
+	t.Run("異常系: 未知のコマンド", func(t *testing.T) {
+		err := RunCLI(context.Background(), cfg, []string{"unknown"}, &bytes.Buffer{})
+
+		assert.ErrorContains(t, err, `unknown command "unknown"`)
+	})
+}
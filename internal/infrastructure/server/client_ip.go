@@ -0,0 +1,44 @@
+package server
+
+import (
+	"net"
+
+	"github.com/labstack/echo/v4"
+)
+
+// クライアントのIPアドレスの取り出し方（リクエスト数の制限やアクセスログで使う）
+// X-Forwarded-For・X-Real-IP は呼び出し側が自由に付けられるため、信頼するプロキシがなければ接続元のアドレスを使う
+// 信頼するプロキシがあれば X-Forwarded-For を右から辿り、信頼するプロキシ以外で最初のアドレスを使う
+func ipExtractor(trustedProxies []string) echo.IPExtractor {
+	if len(trustedProxies) == 0 {
+		return echo.ExtractIPDirect()
+	}
+
+	// 既定ではループバックやプライベートアドレスも信頼するため、設定したアドレスだけにする
+	options := []echo.TrustOption{
+		echo.TrustLoopback(false),
+		echo.TrustLinkLocal(false),
+		echo.TrustPrivateNet(false),
+	}
+	for _, proxy := range trustedProxies {
+		if ipRange := parseIPRange(proxy); ipRange != nil {
+			options = append(options, echo.TrustIPRange(ipRange))
+		}
+	}
+	return echo.ExtractIPFromXFFHeader(options...)
+}
+
+// CIDRかIPアドレス（そのアドレスだけの範囲にする）を読む（どちらでもなければnil）
+func parseIPRange(s string) *net.IPNet {
+	if _, ipRange, err := net.ParseCIDR(s); err == nil {
+		return ipRange
+	}
+	ip := net.ParseIP(s)
+	if ip == nil {
+		return nil
+	}
+	if ip4 := ip.To4(); ip4 != nil {
+		return &net.IPNet{IP: ip4, Mask: net.CIDRMask(32, 32)}
+	}
+	return &net.IPNet{IP: ip, Mask: net.CIDRMask(128, 128)}
+}
@@ -0,0 +1,74 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+
+	"Aicon-assignment/internal/infrastructure/ratelimit"
+)
+
+func TestIPExtractor(t *testing.T) {
+	// 1分に1回まで（続けて送れるのも1回）
+	newServer := func(trustedProxies []string) *echo.Echo {
+		e := echo.New()
+		e.IPExtractor = ipExtractor(trustedProxies)
+		e.Use(ratelimit.Middleware(ratelimit.New(1, 1)))
+		e.GET("/items", func(c echo.Context) error { return c.String(http.StatusOK, c.RealIP()) })
+		return e
+	}
+	send := func(e *echo.Echo, remoteAddr, forwardedFor string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/items", nil)
+		req.RemoteAddr = remoteAddr
+		if forwardedFor != "" {
+			req.Header.Set(echo.HeaderXForwardedFor, forwardedFor)
+			req.Header.Set(echo.HeaderXRealIP, forwardedFor)
+		}
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		return rec
+	}
+
+	t.Run("異常系: 信頼するプロキシがなければ、X-Forwarded-For を変えても同じバケットで数える", func(t *testing.T) {
+		e := newServer(nil)
+
+		rec := send(e, "203.0.113.10:50000", "198.51.100.1")
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "203.0.113.10", rec.Body.String())
+
+		rec = send(e, "203.0.113.10:50000", "198.51.100.2")
+		assert.Equal(t, http.StatusTooManyRequests, rec.Code)
+	})
+
+	t.Run("正常系: 信頼するプロキシからは X-Forwarded-For のクライアントごとに数える", func(t *testing.T) {
+		e := newServer([]string{"10.0.0.0/8"})
+
+		rec := send(e, "10.0.0.5:50000", "198.51.100.1")
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "198.51.100.1", rec.Body.String())
+
+		rec = send(e, "10.0.0.5:50000", "198.51.100.2")
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("異常系: 信頼するプロキシ以外から来た X-Forwarded-For は使わない", func(t *testing.T) {
+		e := newServer([]string{"10.0.0.5"})
+
+		rec := send(e, "192.168.0.20:50000", "198.51.100.1")
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "192.168.0.20", rec.Body.String())
+
+		rec = send(e, "192.168.0.20:50000", "198.51.100.2")
+		assert.Equal(t, http.StatusTooManyRequests, rec.Code)
+	})
+
+	t.Run("異常系: クライアントが先頭に付け足したアドレスは使わない", func(t *testing.T) {
+		e := newServer([]string{"10.0.0.5"})
+
+		rec := send(e, "10.0.0.5:50000", "198.51.100.1, 203.0.113.10")
+		assert.Equal(t, "203.0.113.10", rec.Body.String())
+	})
+}
@@ -0,0 +1,29 @@
+package server
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/echo/v4/middleware"
+)
+
+// これより短いレスポンスは圧縮しても小さくならないため、そのまま返す
+// エラーのレスポンスも圧縮されず、リクエストIDを付け加えられる
+const gzipMinLength = 1024
+
+// Accept-Encoding: gzip のリクエストに、一覧やエクスポートなどの大きなレスポンスを圧縮して返す
+// 配信を続けるストリーム（SSE・WebSocket）と、圧縮済みの画像は対象にしない
+// HEADは本文を返さず Content-Length をそのまま返すため対象にしない
+func compressResponses() echo.MiddlewareFunc {
+	return middleware.GzipWithConfig(middleware.GzipConfig{
+		MinLength: gzipMinLength,
+		Skipper: func(c echo.Context) bool {
+			if c.Request().Method == http.MethodHead {
+				return true
+			}
+			path := c.Request().URL.Path
+			return path == "/events" || path == "/ws" || strings.HasSuffix(path, ".png")
+		},
+	})
+}
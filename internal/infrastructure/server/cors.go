@@ -0,0 +1,44 @@
+package server
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/echo/v4/middleware"
+
+	"Aicon-assignment/internal/infrastructure/config"
+	itemController "Aicon-assignment/internal/interfaces/controller/items"
+)
+
+// ブラウザから読めるようにするレスポンスのヘッダー
+var corsExposeHeaders = []string{
+	echo.HeaderXRequestID,
+	echo.HeaderContentDisposition,
+	echo.HeaderRetryAfter,
+	"Link",
+	"ETag",
+	headerDeprecation,
+	headerSunset,
+	itemController.HeaderTotalCount,
+}
+
+// 許可したオリジンのブラウザからAPIを呼べるようにする（オリジンが空の場合は何もしない）
+// プリフライト（OPTIONS）は検証やリクエスト数の制限より前に204で返す
+// プリフライトではないOPTIONSはルーターに任せ、ルートごとの Allow ヘッダーを返す
+func corsMiddleware(cfg config.CORSConfig) echo.MiddlewareFunc {
+	if len(cfg.AllowedOrigins) == 0 {
+		return func(next echo.HandlerFunc) echo.HandlerFunc { return next }
+	}
+
+	return middleware.CORSWithConfig(middleware.CORSConfig{
+		Skipper: func(c echo.Context) bool {
+			req := c.Request()
+			return req.Method == http.MethodOptions && req.Header.Get(echo.HeaderAccessControlRequestMethod) == ""
+		},
+		AllowOrigins:  cfg.AllowedOrigins,
+		AllowMethods:  cfg.AllowedMethods,
+		AllowHeaders:  cfg.AllowedHeaders,
+		ExposeHeaders: corsExposeHeaders,
+		MaxAge:        int(cfg.MaxAge.Seconds()),
+	})
+}
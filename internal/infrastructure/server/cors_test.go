@@ -0,0 +1,108 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+
+	"Aicon-assignment/internal/infrastructure/config"
+)
+
+func TestCORSMiddleware(t *testing.T) {
+	newServer := func(cfg config.CORSConfig) *echo.Echo {
+		e := echo.New()
+		e.Use(corsMiddleware(cfg))
+		e.PATCH("/items/:id", func(c echo.Context) error { return c.NoContent(http.StatusOK) })
+		e.DELETE("/items/:id", func(c echo.Context) error { return c.NoContent(http.StatusNoContent) })
+		return e
+	}
+	cfg := config.Default().CORS
+	cfg.AllowedOrigins = []string{"https://app.example.com"}
+
+	tests := []struct {
+		name        string
+		cfg         config.CORSConfig
+		method      string
+		origin      string
+		wantStatus  int
+		wantOrigin  string
+		wantMethods string
+	}{
+		{
+			name:        "正常系: PATCHのプリフライト",
+			cfg:         cfg,
+			method:      http.MethodPatch,
+			origin:      "https://app.example.com",
+			wantStatus:  http.StatusNoContent,
+			wantOrigin:  "https://app.example.com",
+			wantMethods: "GET,HEAD,POST,PUT,PATCH,DELETE",
+		},
+		{
+			name:        "正常系: DELETEのプリフライト",
+			cfg:         cfg,
+			method:      http.MethodDelete,
+			origin:      "https://app.example.com",
+			wantStatus:  http.StatusNoContent,
+			wantOrigin:  "https://app.example.com",
+			wantMethods: "GET,HEAD,POST,PUT,PATCH,DELETE",
+		},
+		{
+			name:       "異常系: 許可していないオリジン",
+			cfg:        cfg,
+			method:     http.MethodPatch,
+			origin:     "https://evil.example.com",
+			wantStatus: http.StatusNoContent,
+			wantOrigin: "",
+		},
+		{
+			name:       "異常系: オリジンを設定していない場合はCORSのヘッダーを返さない",
+			cfg:        config.Default().CORS,
+			method:     http.MethodPatch,
+			origin:     "https://app.example.com",
+			wantStatus: http.StatusNoContent,
+			wantOrigin: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodOptions, "/items/1", nil)
+			req.Header.Set(echo.HeaderOrigin, tt.origin)
+			req.Header.Set(echo.HeaderAccessControlRequestMethod, tt.method)
+			req.Header.Set(echo.HeaderAccessControlRequestHeaders, "Content-Type")
+			rec := httptest.NewRecorder()
+			newServer(tt.cfg).ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.wantStatus, rec.Code)
+			assert.Equal(t, tt.wantOrigin, rec.Header().Get(echo.HeaderAccessControlAllowOrigin))
+			if tt.wantMethods != "" {
+				assert.Equal(t, tt.wantMethods, rec.Header().Get(echo.HeaderAccessControlAllowMethods))
+				assert.Equal(t, "Content-Type,Authorization,X-Request-ID", rec.Header().Get(echo.HeaderAccessControlAllowHeaders))
+				assert.Equal(t, "600", rec.Header().Get(echo.HeaderAccessControlMaxAge))
+			}
+		})
+	}
+
+	t.Run("正常系: プリフライトではないOPTIONSにはルートで使えるメソッドを返す", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodOptions, "/items/1", nil)
+		rec := httptest.NewRecorder()
+		newServer(cfg).ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+		assert.Equal(t, "OPTIONS, DELETE, PATCH", rec.Header().Get(echo.HeaderAllow))
+	})
+
+	t.Run("正常系: 実際のリクエストにオリジンと公開するヘッダーを付ける", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodDelete, "/items/1", nil)
+		req.Header.Set(echo.HeaderOrigin, "https://app.example.com")
+		rec := httptest.NewRecorder()
+		newServer(cfg).ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+		assert.Equal(t, "https://app.example.com", rec.Header().Get(echo.HeaderAccessControlAllowOrigin))
+		assert.Equal(t, "X-Request-Id,Content-Disposition,Retry-After,Link,ETag,Deprecation,Sunset,X-Total-Count", rec.Header().Get(echo.HeaderAccessControlExposeHeaders))
+	})
+}
@@ -0,0 +1,24 @@
+package server
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"Aicon-assignment/internal/interfaces/problem"
+	"Aicon-assignment/internal/usecase"
+)
+
+// 機能が無効になっている間は503を返す（障害時などに管理者用のエンドポイントで止める）
+func requireFeature(features usecase.FeatureChecker, name string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if !features.IsEnabled(name) {
+				return problem.Write(c, problem.New(http.StatusServiceUnavailable, "feature_disabled").
+					WithDetail(fmt.Sprintf("%s is disabled", name)))
+			}
+			return next(c)
+		}
+	}
+}
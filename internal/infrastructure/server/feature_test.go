@@ -0,0 +1,48 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+
+	"Aicon-assignment/internal/domain/entity"
+)
+
+type staticFeatures map[string]bool
+
+func (f staticFeatures) IsEnabled(name string) bool {
+	return f[name]
+}
+
+func TestRequireFeature(t *testing.T) {
+	tests := []struct {
+		name       string
+		enabled    bool
+		wantStatus int
+	}{
+		{name: "正常系: 有効な機能", enabled: true, wantStatus: http.StatusOK},
+		{name: "異常系: 無効な機能は503", enabled: false, wantStatus: http.StatusServiceUnavailable},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			features := staticFeatures{entity.FeatureDuplicateDetection: tt.enabled}
+			e.GET("/items/duplicates", func(c echo.Context) error {
+				return c.NoContent(http.StatusOK)
+			}, requireFeature(features, entity.FeatureDuplicateDetection))
+
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/items/duplicates", nil))
+
+			assert.Equal(t, tt.wantStatus, rec.Code)
+			if tt.wantStatus == http.StatusServiceUnavailable {
+				assert.Contains(t, rec.Body.String(), `"code":"feature_disabled"`)
+				assert.Contains(t, rec.Body.String(), "duplicate_detection is disabled")
+			}
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"Aicon-assignment/internal/infrastructure/migration"
+	"Aicon-assignment/internal/interfaces/controller/system"
+	itemDatabase "Aicon-assignment/internal/interfaces/database"
+)
+
+// データベースに接続でき、全てのマイグレーションが適用済みか（memoryの場合は確認しない）
+func databaseChecks(dbHandler itemDatabase.SqlHandler) ([]system.Check, error) {
+	if dbHandler == nil {
+		return nil, nil
+	}
+
+	runner, err := migration.NewRunner(dbHandler)
+	if err != nil {
+		return nil, err
+	}
+
+	return []system.Check{
+		{
+			Name: "database",
+			Check: func(ctx context.Context) error {
+				var one int
+				return dbHandler.QueryRow(ctx, "SELECT 1").Scan(&one)
+			},
+		},
+		{
+			Name: "migrations",
+			Check: func(ctx context.Context) error {
+				statuses, err := runner.Status(ctx)
+				if err != nil {
+					return err
+				}
+
+				var pending []string
+				for _, status := range statuses {
+					if status.AppliedAt == nil {
+						pending = append(pending, fmt.Sprintf("%04d_%s", status.Version, status.Name))
+					}
+				}
+				if len(pending) > 0 {
+					return fmt.Errorf("pending migrations: %s", strings.Join(pending, ", "))
+				}
+				return nil
+			},
+		},
+	}, nil
+}
@@ -0,0 +1,74 @@
+package server
+
+import (
+	"context"
+	"fmt"
+
+	"Aicon-assignment/internal/infrastructure/config"
+	"Aicon-assignment/internal/infrastructure/migration"
+	itemDatabase "Aicon-assignment/internal/interfaces/database"
+)
+
+// migrate サブコマンド。args が "status" の場合は適用状況を表示するだけ
+func Migrate(ctx context.Context, cfg *config.Config, args []string) error {
+	repos, err := newRepositories(cfg, nil)
+	if err != nil {
+		return err
+	}
+	defer repos.close()
+
+	if repos.sqlHandler == nil {
+		return fmt.Errorf("STORAGE=%s does not use migrations", cfg.Storage)
+	}
+
+	if len(args) == 0 {
+		return migrate(ctx, repos.sqlHandler)
+	}
+
+	switch args[0] {
+	case "up":
+		return migrate(ctx, repos.sqlHandler)
+	case "status":
+		runner, err := migration.NewRunner(repos.sqlHandler)
+		if err != nil {
+			return err
+		}
+
+		statuses, err := runner.Status(ctx)
+		if err != nil {
+			return err
+		}
+
+		for _, status := range statuses {
+			applied := "pending"
+			if status.AppliedAt != nil {
+				applied = status.AppliedAt.Format("2006-01-02 15:04:05")
+			}
+			fmt.Printf("%04d_%s\t%s\n", status.Version, status.Name, applied)
+		}
+		return nil
+	default:
+		return fmt.Errorf("unknown migrate command %q (must be up or status)", args[0])
+	}
+}
+
+// 未適用のマイグレーションを適用する
+func migrate(ctx context.Context, dbHandler itemDatabase.SqlHandler) error {
+	runner, err := migration.NewRunner(dbHandler)
+	if err != nil {
+		return err
+	}
+
+	applied, err := runner.Up(ctx)
+	for _, m := range applied {
+		fmt.Printf("✅ Applied migration %04d_%s\n", m.Version, m.Name)
+	}
+	if err != nil {
+		return err
+	}
+
+	if len(applied) == 0 {
+		fmt.Println("✅ Database schema is up to date")
+	}
+	return nil
+}
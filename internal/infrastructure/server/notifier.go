@@ -0,0 +1,18 @@
+package server
+
+import (
+	"fmt"
+
+	"Aicon-assignment/internal/infrastructure/config"
+	"Aicon-assignment/internal/infrastructure/notification"
+	"Aicon-assignment/internal/usecase"
+)
+
+// SMTPを設定していない場合は送らずにログに出す
+func newNotifier(cfg config.NotificationConfig) usecase.Notifier {
+	if cfg.SMTPHost == "" {
+		fmt.Println("⚠️  SMTP_HOST が未設定のため通知はメールで送らずログに出します")
+		return notification.NewLogNotifier()
+	}
+	return notification.NewSMTPNotifier(cfg.SMTPHost, cfg.SMTPPort, cfg.SMTPUsername, cfg.SMTPPassword, cfg.From, cfg.To)
+}
@@ -0,0 +1,177 @@
+package server
+
+import (
+	"fmt"
+
+	"Aicon-assignment/internal/infrastructure/config"
+	databaseInfra "Aicon-assignment/internal/infrastructure/database"
+	"Aicon-assignment/internal/infrastructure/metrics"
+	"Aicon-assignment/internal/infrastructure/tracing"
+	itemDatabase "Aicon-assignment/internal/interfaces/database"
+	"Aicon-assignment/internal/interfaces/memory"
+	"Aicon-assignment/internal/usecase"
+)
+
+// 保存先ごとのリポジトリの組み合わせ
+type repositories struct {
+	item      usecase.ItemRepository
+	loan      usecase.LoanRepository
+	location  usecase.LocationRepository
+	template  usecase.ItemTemplateRepository
+	webhook   usecase.WebhookRepository
+	outbox    usecase.OutboxRepository
+	job       usecase.JobRepository
+	feature   usecase.FeatureFlagRepository
+	erasure   usecase.ErasureRepository
+	shareLink usecase.ShareLinkRepository
+	comment   usecase.ItemCommentRepository
+	relation  usecase.ItemRelationRepository
+	auditLog  usecase.AuditLogRepository
+	valuation usecase.ItemValuationRepository
+	sale      usecase.ItemSaleRepository
+	insurance usecase.ItemInsuranceRepository
+	view      usecase.ItemViewRepository
+	favorite  usecase.ItemFavoriteRepository
+	backup    usecase.BackupRepository
+
+	notificationPreference usecase.NotificationPreferenceRepository
+
+	// 読み取りだけの処理に使う（リードレプリカがない場合は item と同じ）
+	itemReader usecase.ItemRepository
+
+	transactor usecase.Transactor
+
+	// memoryの場合はnil（マイグレーションは不要）
+	sqlHandler itemDatabase.SqlHandler
+
+	// 接続などの後始末
+	close func()
+}
+
+// m が nil の場合は問い合わせを計測しない（サブコマンドはメトリクスを公開しないため）
+func newRepositories(cfg *config.Config, m *metrics.Metrics) (*repositories, error) {
+	db := cfg.Database
+
+	switch cfg.Storage {
+	case "mysql":
+		dbHandler := databaseInfra.NewSqlHandler(db.MySQLDSN(), db.Pool)
+		return newSQLRepositories(db, m, dbHandler, openReplicas(db, databaseInfra.NewSqlHandler)), nil
+	case "postgres":
+		dbHandler := databaseInfra.NewPostgresHandler(db.PostgresDSN(), db.Pool)
+		return newSQLRepositories(db, m, dbHandler, openReplicas(db, databaseInfra.NewPostgresHandler)), nil
+	case "sqlite":
+		dbHandler, err := databaseInfra.NewSQLiteHandler(cfg.SQLitePath)
+		if err != nil {
+			return nil, err
+		}
+		fmt.Printf("✅ Using SQLite database at %s\n", cfg.SQLitePath)
+		return newSQLRepositories(db, m, dbHandler, nil), nil
+	case "memory":
+		fmt.Println("⚠️  STORAGE=memory のためデータはメモリ上に保存され、停止すると消えます")
+		store := memory.NewStore()
+		item := &memory.ItemRepository{Store: store}
+		return &repositories{
+			item:      item,
+			loan:      &memory.LoanRepository{Store: store},
+			location:  &memory.LocationRepository{Store: store},
+			template:  &memory.ItemTemplateRepository{Store: store},
+			webhook:   &memory.WebhookRepository{Store: store},
+			outbox:    &memory.OutboxRepository{Store: store},
+			job:       &memory.JobRepository{Store: store},
+			feature:   &memory.FeatureFlagRepository{Store: store},
+			erasure:   &memory.ErasureRepository{Store: store},
+			shareLink: &memory.ShareLinkRepository{Store: store},
+			comment:   &memory.ItemCommentRepository{Store: store},
+			relation:  &memory.ItemRelationRepository{Store: store},
+			auditLog:  &memory.AuditLogRepository{Store: store},
+			valuation: &memory.ItemValuationRepository{Store: store},
+			sale:      &memory.ItemSaleRepository{Store: store},
+			insurance: &memory.ItemInsuranceRepository{Store: store},
+			view:      &memory.ItemViewRepository{Store: store},
+			favorite:  &memory.ItemFavoriteRepository{Store: store},
+			backup:    &memory.BackupRepository{Store: store},
+
+			notificationPreference: &memory.NotificationPreferenceRepository{Store: store},
+
+			itemReader: item,
+			transactor: &memory.Transactor{Store: store},
+			close:      func() {},
+		}, nil
+	default:
+		return nil, fmt.Errorf("unknown STORAGE %q (must be mysql, postgres, sqlite or memory)", cfg.Storage)
+	}
+}
+
+// MySQL・PostgreSQL・SQLiteは同じリポジトリをハンドラーの方言で切り替えて使う
+func newSQLRepositories(db config.DatabaseConfig, m *metrics.Metrics, dbHandler itemDatabase.SqlHandler, replicas []itemDatabase.SqlHandler) *repositories {
+	dbHandler = newResilientHandler(db, m, dbHandler)
+
+	// レプリカごとにブレーカーを持たせ、接続できないレプリカはプライマリで代わりに読む
+	for i, replica := range replicas {
+		replicas[i] = newResilientHandler(db, m, replica)
+	}
+	readHandler := databaseInfra.NewReplicaHandler(dbHandler, replicas)
+
+	return &repositories{
+		item:      &itemDatabase.ItemRepository{SqlHandler: dbHandler},
+		loan:      &itemDatabase.LoanRepository{SqlHandler: dbHandler},
+		location:  &itemDatabase.LocationRepository{SqlHandler: dbHandler},
+		template:  &itemDatabase.ItemTemplateRepository{SqlHandler: dbHandler},
+		webhook:   &itemDatabase.WebhookRepository{SqlHandler: dbHandler},
+		outbox:    &itemDatabase.OutboxRepository{SqlHandler: dbHandler},
+		job:       &itemDatabase.JobRepository{SqlHandler: dbHandler},
+		feature:   &itemDatabase.FeatureFlagRepository{SqlHandler: dbHandler},
+		erasure:   &itemDatabase.ErasureRepository{SqlHandler: dbHandler},
+		shareLink: &itemDatabase.ShareLinkRepository{SqlHandler: dbHandler},
+		comment:   &itemDatabase.ItemCommentRepository{SqlHandler: dbHandler},
+		relation:  &itemDatabase.ItemRelationRepository{SqlHandler: dbHandler},
+		auditLog:  &itemDatabase.AuditLogRepository{SqlHandler: dbHandler},
+		valuation: &itemDatabase.ItemValuationRepository{SqlHandler: dbHandler},
+		sale:      &itemDatabase.ItemSaleRepository{SqlHandler: dbHandler},
+		insurance: &itemDatabase.ItemInsuranceRepository{SqlHandler: dbHandler},
+		view:      &itemDatabase.ItemViewRepository{SqlHandler: dbHandler},
+		favorite:  &itemDatabase.ItemFavoriteRepository{SqlHandler: dbHandler},
+		backup:    &itemDatabase.BackupRepository{SqlHandler: dbHandler},
+
+		notificationPreference: &itemDatabase.NotificationPreferenceRepository{SqlHandler: dbHandler},
+
+		itemReader: &itemDatabase.ItemRepository{SqlHandler: readHandler},
+
+		transactor: &itemDatabase.Transactor{SqlHandler: dbHandler},
+		sqlHandler: dbHandler,
+		close: func() {
+			if readHandler != dbHandler {
+				readHandler.Close()
+			}
+			dbHandler.Close()
+		},
+	}
+}
+
+// 問い合わせごとの制限時間と、一時的なエラーの再試行、DBが落ちている間に待たずに失敗させるためのブレーカー
+// 制限時間とSQLのスパン、問い合わせの時間は再試行の1回ごとに数える
+func newResilientHandler(db config.DatabaseConfig, m *metrics.Metrics, dbHandler itemDatabase.SqlHandler) itemDatabase.SqlHandler {
+	if m != nil {
+		dbHandler = m.WrapSqlHandler(dbHandler, db.SlowQueryThreshold)
+	}
+	dbHandler = itemDatabase.WithQueryTimeout(tracing.WrapSqlHandler(dbHandler), db.QueryTimeout)
+	return databaseInfra.NewResilientHandler(dbHandler, databaseInfra.ResilienceConfig{
+		MaxRetries:       db.Retry.MaxRetries,
+		BaseDelay:        db.Retry.BaseDelay,
+		MaxDelay:         db.Retry.MaxDelay,
+		FailureThreshold: db.Retry.BreakerThreshold,
+		OpenDuration:     db.Retry.BreakerOpenDuration,
+	})
+}
+
+// DB_REPLICA_DSNS のリードレプリカに接続する
+func openReplicas(db config.DatabaseConfig, open func(dsn string, pool config.PoolConfig) itemDatabase.SqlHandler) []itemDatabase.SqlHandler {
+	var replicas []itemDatabase.SqlHandler
+	for _, dsn := range db.ReplicaDSNs {
+		replicas = append(replicas, open(dsn, db.Pool))
+	}
+	if len(replicas) > 0 {
+		fmt.Printf("✅ Reading from %d replica(s)\n", len(replicas))
+	}
+	return replicas
+}
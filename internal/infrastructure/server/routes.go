@@ -0,0 +1,273 @@
+package server
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"Aicon-assignment/internal/domain/entity"
+	activityController "Aicon-assignment/internal/interfaces/controller/activity"
+	auditController "Aicon-assignment/internal/interfaces/controller/audit"
+	backupController "Aicon-assignment/internal/interfaces/controller/backups"
+	categoryController "Aicon-assignment/internal/interfaces/controller/categories"
+	commentController "Aicon-assignment/internal/interfaces/controller/comments"
+	favoriteController "Aicon-assignment/internal/interfaces/controller/favorites"
+	featureController "Aicon-assignment/internal/interfaces/controller/features"
+	insuranceController "Aicon-assignment/internal/interfaces/controller/insurance"
+	itemController "Aicon-assignment/internal/interfaces/controller/items"
+	jobController "Aicon-assignment/internal/interfaces/controller/jobs"
+	listingController "Aicon-assignment/internal/interfaces/controller/listings"
+	loanController "Aicon-assignment/internal/interfaces/controller/loans"
+	locationController "Aicon-assignment/internal/interfaces/controller/locations"
+	notificationController "Aicon-assignment/internal/interfaces/controller/notifications"
+	privacyController "Aicon-assignment/internal/interfaces/controller/privacy"
+	relationController "Aicon-assignment/internal/interfaces/controller/relations"
+	reportController "Aicon-assignment/internal/interfaces/controller/reports"
+	saleController "Aicon-assignment/internal/interfaces/controller/sales"
+	searchController "Aicon-assignment/internal/interfaces/controller/search"
+	shareController "Aicon-assignment/internal/interfaces/controller/shares"
+	taskController "Aicon-assignment/internal/interfaces/controller/tasks"
+	templateController "Aicon-assignment/internal/interfaces/controller/templates"
+	valuationController "Aicon-assignment/internal/interfaces/controller/valuations"
+	viewController "Aicon-assignment/internal/interfaces/controller/views"
+	webhookController "Aicon-assignment/internal/interfaces/controller/webhooks"
+	"Aicon-assignment/internal/interfaces/resource"
+	"Aicon-assignment/internal/usecase"
+)
+
+// REST APIの現行のバージョン（レスポンスのリンクと同じプレフィックス）
+const apiV1Prefix = resource.BasePath
+
+const (
+	headerDeprecation = "Deprecation"
+	headerSunset      = "Sunset"
+)
+
+// バージョンのないパスは v1 と同じ内容で残し、廃止予定であることをヘッダーで知らせる
+var legacyAPIDeprecation = apiDeprecation{
+	since:     time.Date(2026, time.October, 16, 0, 0, 0, 0, time.UTC),
+	sunset:    time.Date(2027, time.April, 30, 0, 0, 0, 0, time.UTC),
+	successor: apiV1Prefix,
+}
+
+// 多くのデータを扱うため、リクエストの制限時間を長くするルート（r.expensive を付けるルートと揃える）
+var exportRoutes = []string{
+	"/items/duplicates",
+	"/items/:id/merge",
+	"/me/export",
+	"/reports/tax",
+	"/reports/insurance-schedule",
+	"/admin/backup",
+	"/admin/restore",
+	"/admin/search/reindex",
+}
+
+// REST APIのハンドラー
+// バージョンを加えるときは、変わったハンドラーだけを差し替えた登録関数を用意して別のプレフィックスに登録する
+type apiRoutes struct {
+	item      *itemController.ItemHandler
+	loan      *loanController.LoanHandler
+	location  *locationController.LocationHandler
+	template  *templateController.TemplateHandler
+	webhook   *webhookController.WebhookHandler
+	backup    *backupController.BackupHandler
+	feature   *featureController.FeatureHandler
+	privacy   *privacyController.PrivacyHandler
+	share     *shareController.ShareLinkHandler
+	comment   *commentController.ItemCommentHandler
+	relation  *relationController.ItemRelationHandler
+	category  *categoryController.CategoryHandler
+	auditLog  *auditController.AuditLogHandler
+	valuation *valuationController.ItemValuationHandler
+	sale      *saleController.ItemSaleHandler
+	insurance *insuranceController.ItemInsuranceHandler
+	report    *reportController.ReportHandler
+	listing   *listingController.ListingImportHandler
+	view      *viewController.ItemViewHandler
+	favorite  *favoriteController.ItemFavoriteHandler
+	search    *searchController.ItemSearchHandler
+	job       *jobController.JobHandler
+	task      *taskController.TaskHandler
+	activity  *activityController.ActivityHandler
+
+	// 利用者ごとのアカウントはないため、通知の設定は全体で1つ
+	notification *notificationController.NotificationHandler
+
+	// 検索インデックスを使わない場合は再構築のエンドポイントを公開しない
+	searchIndexEnabled bool
+
+	// 機能ごとの有効・無効
+	features usecase.FeatureChecker
+
+	// 重いエンドポイントのリクエスト数の制限
+	expensive echo.MiddlewareFunc
+	// 空の場合は管理者用のエンドポイントを公開しない
+	adminToken          string
+	maxRestoreBodyBytes int64
+}
+
+// v1 のエンドポイントを g に登録する（m は全てのルートに付ける）
+func (r *apiRoutes) registerV1(g *echo.Group, m ...echo.MiddlewareFunc) {
+	duplicateDetection := requireFeature(r.features, entity.FeatureDuplicateDetection)
+
+	// アイテムに関するエンドポイント
+	itemsGroup := g.Group("/items", m...)
+	{
+		itemsGroup.GET("", r.item.GetItems)                                                  // GET /items
+		itemsGroup.HEAD("", r.item.GetItems)                                                 // HEAD /items
+		itemsGroup.POST("", r.item.CreateItem)                                               // POST /items
+		itemsGroup.GET("/:id", r.item.GetItem, r.view.RecordView)                            // GET /items/{id}
+		itemsGroup.HEAD("/:id", r.item.GetItem)                                              // HEAD /items/{id}
+		itemsGroup.PATCH("/:id", r.item.PatchItem)                                           // PATCH /items/{id} - 追加しました。
+		itemsGroup.DELETE("/:id", r.item.DeleteItem)                                         // DELETE /items/{id}
+		itemsGroup.GET("/count", r.item.GetItemCount)                                        // GET /items/count
+		itemsGroup.GET("/summary", r.item.GetSummary)                                        // GET /items/summary (bonus)
+		itemsGroup.GET("/search", r.search.SearchItems)                                      // GET /items/search
+		itemsGroup.GET("/suggest", r.search.SuggestItems)                                    // GET /items/suggest
+		itemsGroup.GET("/recently-viewed", r.view.GetRecentlyViewed)                         // GET /items/recently-viewed
+		itemsGroup.GET("/duplicates", r.item.GetDuplicates, duplicateDetection, r.expensive) // GET /items/duplicates
+		itemsGroup.GET("/by-serial/:serial", r.item.GetItemBySerial)                         // GET /items/by-serial/{serial}
+		itemsGroup.POST("/bulk-revalue", r.valuation.BulkRevalue)                            // POST /items/bulk-revalue
+		itemsGroup.POST("/from-template/:templateID", r.template.CreateItemFromTemplate)     // POST /items/from-template/{templateID}
+		itemsGroup.POST("/from-url", r.listing.DraftFromURL, r.expensive)                    // POST /items/from-url
+		itemsGroup.GET("/:id/label.png", r.item.GetItemLabel)                                // GET /items/{id}/label.png
+		itemsGroup.POST("/:id/clone", r.item.CloneItem)                                      // POST /items/{id}/clone
+		itemsGroup.POST("/:id/merge", r.item.MergeItems, duplicateDetection, r.expensive)    // POST /items/{id}/merge
+		itemsGroup.POST("/:id/favorite", r.favorite.AddFavorite)                             // POST /items/{id}/favorite
+		itemsGroup.DELETE("/:id/favorite", r.favorite.RemoveFavorite)                        // DELETE /items/{id}/favorite
+		itemsGroup.POST("/:id/archive", r.item.ArchiveItem)                                  // POST /items/{id}/archive
+		itemsGroup.POST("/:id/unarchive", r.item.UnarchiveItem)                              // POST /items/{id}/unarchive
+		itemsGroup.POST("/:id/loans", r.loan.CreateLoan)                                     // POST /items/{id}/loans
+		itemsGroup.POST("/:id/move", r.location.MoveItem)                                    // POST /items/{id}/move
+		itemsGroup.GET("/:id/location-history", r.location.GetItemLocationHistory)           // GET /items/{id}/location-history
+		itemsGroup.POST("/:id/share", r.share.CreateShareLink)                               // POST /items/{id}/share
+		itemsGroup.GET("/:id/shares", r.share.GetShareLinks)                                 // GET /items/{id}/shares
+		itemsGroup.DELETE("/:id/shares/:shareID", r.share.RevokeShareLink)                   // DELETE /items/{id}/shares/{shareID}
+		itemsGroup.POST("/:id/comments", r.comment.CreateComment)                            // POST /items/{id}/comments
+		itemsGroup.GET("/:id/comments", r.comment.GetComments)                               // GET /items/{id}/comments
+		itemsGroup.DELETE("/:id/comments/:commentID", r.comment.DeleteComment)               // DELETE /items/{id}/comments/{commentID}
+		itemsGroup.POST("/:id/relations", r.relation.CreateRelation)                         // POST /items/{id}/relations
+		itemsGroup.GET("/:id/relations", r.relation.GetRelations)                            // GET /items/{id}/relations
+		itemsGroup.DELETE("/:id/relations/:relationID", r.relation.DeleteRelation)           // DELETE /items/{id}/relations/{relationID}
+		itemsGroup.GET("/:id/valuations", r.valuation.GetValuations)                         // GET /items/{id}/valuations
+		itemsGroup.POST("/:id/sale", r.sale.RecordSale)                                      // POST /items/{id}/sale
+		itemsGroup.DELETE("/:id/sale", r.sale.CancelSale)                                    // DELETE /items/{id}/sale
+		itemsGroup.GET("/:id/insurance", r.insurance.GetInsurance)                           // GET /items/{id}/insurance
+		itemsGroup.PUT("/:id/insurance", r.insurance.SetInsurance)                           // PUT /items/{id}/insurance
+		itemsGroup.DELETE("/:id/insurance", r.insurance.RemoveInsurance)                     // DELETE /items/{id}/insurance
+	}
+
+	// カテゴリーに関するエンドポイント
+	categoriesGroup := g.Group("/categories", m...)
+	{
+		categoriesGroup.POST("/:from/reassign", r.category.ReassignCategory) // POST /categories/{from}/reassign
+	}
+
+	// 保管場所に関するエンドポイント
+	locationsGroup := g.Group("/locations", m...)
+	{
+		locationsGroup.GET("", r.location.GetLocations)          // GET /locations
+		locationsGroup.POST("", r.location.CreateLocation)       // POST /locations
+		locationsGroup.GET("/:id", r.location.GetLocation)       // GET /locations/{id}
+		locationsGroup.PUT("/:id", r.location.UpdateLocation)    // PUT /locations/{id}
+		locationsGroup.DELETE("/:id", r.location.DeleteLocation) // DELETE /locations/{id}
+	}
+
+	// 貸出に関するエンドポイント
+	loansGroup := g.Group("/loans", m...)
+	{
+		loansGroup.GET("/overdue", r.loan.GetOverdueLoans) // GET /loans/overdue
+		loansGroup.POST("/:id/return", r.loan.ReturnLoan)  // POST /loans/{id}/return
+	}
+
+	// テンプレートに関するエンドポイント
+	templatesGroup := g.Group("/templates", m...)
+	{
+		templatesGroup.GET("", r.template.GetTemplates)          // GET /templates
+		templatesGroup.POST("", r.template.CreateTemplate)       // POST /templates
+		templatesGroup.GET("/:id", r.template.GetTemplate)       // GET /templates/{id}
+		templatesGroup.DELETE("/:id", r.template.DeleteTemplate) // DELETE /templates/{id}
+	}
+
+	// Webhookに関するエンドポイント
+	// 送信ログは管理者のトークンが付いている場合だけ伏せずに返す
+	webhookMiddleware := append(m, requireFeature(r.features, entity.FeatureWebhooks))
+	if r.adminToken != "" {
+		webhookMiddleware = append(webhookMiddleware, identifyAdmin(r.adminToken))
+	}
+	webhooksGroup := g.Group("/webhooks", webhookMiddleware...)
+	{
+		webhooksGroup.GET("", r.webhook.GetWebhooks)                  // GET /webhooks
+		webhooksGroup.POST("", r.webhook.CreateWebhook)               // POST /webhooks
+		webhooksGroup.DELETE("/:id", r.webhook.DeleteWebhook)         // DELETE /webhooks/{id}
+		webhooksGroup.GET("/:id/deliveries", r.webhook.GetDeliveries) // GET /webhooks/{id}/deliveries
+	}
+
+	// 帳票
+	reportsGroup := g.Group("/reports", m...)
+	{
+		reportsGroup.GET("/tax", r.report.GetTaxReport, r.expensive)                        // GET /reports/tax
+		reportsGroup.GET("/insurance-schedule", r.report.GetInsuranceSchedule, r.expensive) // GET /reports/insurance-schedule
+	}
+
+	// コレクションの変更の履歴
+	g.GET("/activity", r.activity.GetActivity, m...) // GET /activity
+
+	// 利用状況に関するエンドポイント
+	meGroup := g.Group("/me", m...)
+	{
+		meGroup.GET("/usage", r.item.GetUsage)                // GET /me/usage
+		meGroup.GET("/export", r.privacy.Export, r.expensive) // GET /me/export
+
+		meGroup.GET("/notification-preferences", r.notification.GetPreferences)    // GET /me/notification-preferences
+		meGroup.PUT("/notification-preferences", r.notification.UpdatePreferences) // PUT /me/notification-preferences
+
+		// 全データを削除するため、管理者のトークンを求める
+		if r.adminToken != "" {
+			admin := requireAdminToken(r.adminToken)
+			meGroup.DELETE("", r.privacy.RequestErasure, admin)             // DELETE /me
+			meGroup.GET("/erasure", r.privacy.GetErasure, admin)            // GET /me/erasure
+			meGroup.POST("/erasure/cancel", r.privacy.CancelErasure, admin) // POST /me/erasure/cancel
+		}
+	}
+
+	// 管理者用のエンドポイント（ADMIN_TOKEN を設定した場合のみ公開する）
+	if r.adminToken != "" {
+		adminGroup := g.Group("/admin", append(m, requireAdminToken(r.adminToken))...)
+		adminGroup.POST("/backup", r.backup.Backup, r.expensive)                                            // POST /admin/backup
+		adminGroup.POST("/restore", r.backup.Restore, r.expensive, limitRequestBody(r.maxRestoreBodyBytes)) // POST /admin/restore
+		adminGroup.GET("/features", r.feature.GetFeatures)                                                  // GET /admin/features
+		adminGroup.PUT("/features/:name", r.feature.SetFeature)                                             // PUT /admin/features/{name}
+		adminGroup.DELETE("/features/:name", r.feature.ResetFeature)                                        // DELETE /admin/features/{name}
+		adminGroup.GET("/audit-log", r.auditLog.GetAuditLog)                                                // GET /admin/audit-log
+		adminGroup.GET("/jobs", r.job.GetJobs)                                                              // GET /admin/jobs
+		adminGroup.POST("/jobs/:id/retry", r.job.RetryJob)                                                  // POST /admin/jobs/{id}/retry
+		adminGroup.GET("/tasks", r.task.GetTasks)                                                           // GET /admin/tasks
+		if r.searchIndexEnabled {
+			adminGroup.POST("/search/reindex", r.search.Reindex, r.expensive) // POST /admin/search/reindex
+		}
+	}
+}
+
+// 廃止予定のパスに付けるヘッダーの内容
+type apiDeprecation struct {
+	since     time.Time
+	sunset    time.Time
+	successor string // 後継のバージョンのプレフィックス
+}
+
+// 廃止予定であることを Deprecation（RFC 9745）と Sunset（RFC 8594）で知らせ、
+// 同じリソースの後継のパスを Link（rel="successor-version"）で示す
+func deprecated(d apiDeprecation) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			header := c.Response().Header()
+			header.Set(headerDeprecation, fmt.Sprintf("@%d", d.since.Unix()))
+			header.Set(headerSunset, d.sunset.Format(http.TimeFormat))
+			header.Add("Link", fmt.Sprintf(`<%s%s>; rel="successor-version"`, d.successor, c.Request().URL.RequestURI()))
+			return next(c)
+		}
+	}
+}
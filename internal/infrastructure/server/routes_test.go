@@ -0,0 +1,81 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+
+	itemController "Aicon-assignment/internal/interfaces/controller/items"
+	locationController "Aicon-assignment/internal/interfaces/controller/locations"
+	"Aicon-assignment/internal/interfaces/memory"
+	"Aicon-assignment/internal/usecase"
+)
+
+func TestAPIRoutes(t *testing.T) {
+	store := memory.NewStore()
+	itemRepo := &memory.ItemRepository{Store: store}
+	transactor := &memory.Transactor{Store: store}
+	api := &apiRoutes{
+		item:     itemController.NewItemHandler(usecase.NewItemUsecase(itemRepo, transactor), requestUser),
+		location: locationController.NewLocationHandler(usecase.NewLocationUsecase(itemRepo, &memory.LocationRepository{Store: store}, transactor)),
+		features: staticFeatures{},
+	}
+
+	e := echo.New()
+	api.registerV1(e.Group(apiV1Prefix))
+	api.registerV1(e.Group(""), deprecated(legacyAPIDeprecation))
+
+	tests := []struct {
+		name            string
+		path            string
+		wantStatus      int
+		wantDeprecation bool
+		wantLinks       []string
+	}{
+		{
+			name:       "正常系: バージョン付きのパス",
+			path:       "/api/v1/items?condition=A",
+			wantStatus: http.StatusOK,
+			wantLinks:  []string{`</api/v1/items?condition=A>; rel="self"`},
+		},
+		{
+			name:            "正常系: バージョンのないパスは廃止予定のヘッダーと後継のリンクを付ける",
+			path:            "/items?condition=A",
+			wantStatus:      http.StatusOK,
+			wantDeprecation: true,
+			wantLinks:       []string{`</api/v1/items?condition=A>; rel="successor-version"`, `</items?condition=A>; rel="self"`},
+		},
+		{
+			name:            "正常系: 保管場所もバージョンのないパスで使える",
+			path:            "/locations",
+			wantStatus:      http.StatusOK,
+			wantDeprecation: true,
+			wantLinks:       []string{`</api/v1/locations>; rel="successor-version"`},
+		},
+		{
+			name:       "異常系: 存在しないバージョン",
+			path:       "/api/v2/items",
+			wantStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, tt.path, nil))
+
+			assert.Equal(t, tt.wantStatus, rec.Code)
+			if tt.wantDeprecation {
+				assert.Equal(t, "@1792108800", rec.Header().Get(headerDeprecation))
+				assert.Equal(t, "Fri, 30 Apr 2027 00:00:00 GMT", rec.Header().Get(headerSunset))
+			} else {
+				assert.Empty(t, rec.Header().Get(headerDeprecation))
+				assert.Empty(t, rec.Header().Get(headerSunset))
+			}
+			assert.Equal(t, tt.wantLinks, rec.Header().Values("Link"))
+		})
+	}
+}
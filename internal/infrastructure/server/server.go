@@ -3,76 +3,436 @@ package server
 import (
 	"context"
 	"fmt"
+	"log"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
+	"syscall"
 	"time"
 
 	"github.com/labstack/echo/v4"
+	"google.golang.org/grpc"
 
-	databaseInfra "Aicon-assignment/internal/infrastructure/database"
+	"Aicon-assignment/internal/domain/entity"
+	"Aicon-assignment/internal/domain/event"
+	"Aicon-assignment/internal/infrastructure/broker"
+	"Aicon-assignment/internal/infrastructure/cache"
+	"Aicon-assignment/internal/infrastructure/config"
+	"Aicon-assignment/internal/infrastructure/eventbus"
+	"Aicon-assignment/internal/infrastructure/listing"
+	"Aicon-assignment/internal/infrastructure/logging"
+	"Aicon-assignment/internal/infrastructure/metrics"
+	"Aicon-assignment/internal/infrastructure/ratelimit"
+	"Aicon-assignment/internal/infrastructure/search"
+	"Aicon-assignment/internal/infrastructure/tracing"
+	webhookInfra "Aicon-assignment/internal/infrastructure/webhook"
+	activityController "Aicon-assignment/internal/interfaces/controller/activity"
+	auditController "Aicon-assignment/internal/interfaces/controller/audit"
+	backupController "Aicon-assignment/internal/interfaces/controller/backups"
+	catalogController "Aicon-assignment/internal/interfaces/controller/catalog"
+	categoryController "Aicon-assignment/internal/interfaces/controller/categories"
+	commentController "Aicon-assignment/internal/interfaces/controller/comments"
+	eventController "Aicon-assignment/internal/interfaces/controller/events"
+	favoriteController "Aicon-assignment/internal/interfaces/controller/favorites"
+	featureController "Aicon-assignment/internal/interfaces/controller/features"
+	graphqlController "Aicon-assignment/internal/interfaces/controller/graphql"
+	grpcController "Aicon-assignment/internal/interfaces/controller/grpc"
+	"Aicon-assignment/internal/interfaces/controller/grpc/itempb"
+	insuranceController "Aicon-assignment/internal/interfaces/controller/insurance"
 	itemController "Aicon-assignment/internal/interfaces/controller/items"
+	jobController "Aicon-assignment/internal/interfaces/controller/jobs"
+	listingController "Aicon-assignment/internal/interfaces/controller/listings"
+	loanController "Aicon-assignment/internal/interfaces/controller/loans"
+	locationController "Aicon-assignment/internal/interfaces/controller/locations"
+	notificationController "Aicon-assignment/internal/interfaces/controller/notifications"
+	privacyController "Aicon-assignment/internal/interfaces/controller/privacy"
+	relationController "Aicon-assignment/internal/interfaces/controller/relations"
+	reportController "Aicon-assignment/internal/interfaces/controller/reports"
+	saleController "Aicon-assignment/internal/interfaces/controller/sales"
+	searchController "Aicon-assignment/internal/interfaces/controller/search"
+	shareController "Aicon-assignment/internal/interfaces/controller/shares"
 	"Aicon-assignment/internal/interfaces/controller/system"
-	itemDatabase "Aicon-assignment/internal/interfaces/database"
+	taskController "Aicon-assignment/internal/interfaces/controller/tasks"
+	templateController "Aicon-assignment/internal/interfaces/controller/templates"
+	valuationController "Aicon-assignment/internal/interfaces/controller/valuations"
+	viewController "Aicon-assignment/internal/interfaces/controller/views"
+	webhookController "Aicon-assignment/internal/interfaces/controller/webhooks"
+	wsController "Aicon-assignment/internal/interfaces/controller/ws"
+	"Aicon-assignment/internal/interfaces/openapi"
+	"Aicon-assignment/internal/interfaces/problem"
 	"Aicon-assignment/internal/usecase"
 )
 
 // サーバー用の構造体
-type Server struct{}
+type Server struct {
+	cfg *config.Config
+}
 
-func NewServer() *Server {
-	return &Server{}
+func NewServer(cfg *config.Config) *Server {
+	return &Server{cfg: cfg}
 }
 
 // サーバー起動
 func (s *Server) Run(ctx context.Context) error {
+	cfg := s.cfg
 	e := echo.New()
 
+	spec, err := openapi.LoadSpec()
+	if err != nil {
+		return err
+	}
+
+	// アクセスログとリクエストID（エラーのレスポンスにも含めるため最初に登録する）
+	e.HideBanner = true
+	e.HTTPErrorHandler = problem.ErrorHandler
+	e.IPExtractor = ipExtractor(cfg.TrustedProxies)
+	e.Use(logging.Middleware())
+
+	// プリフライトはスキーマの検証やリクエスト数の制限の対象にしない
+	e.Use(corsMiddleware(cfg.CORS))
+
+	// 大きなレスポンスをgzipで圧縮する
+	e.Use(compressResponses())
+
+	// 設定されている場合はOTLPでトレースを送る
+	if cfg.TracingEnabled {
+		shutdownTracing, err := tracing.Setup(ctx)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			shutdownTracing(shutdownCtx)
+		}()
+	}
+	e.Use(tracing.Middleware())
+
+	// 検証で弾いたリクエストも数えるため先に登録する
+	m := metrics.New()
+	e.Use(m.Middleware())
+
+	// クライアントごとのリクエスト数の上限（ヘルスチェックとメトリクスの取得は数えない）
+	// 重いエンドポイントには別のバケットで、より厳しい上限を設ける
+	e.Use(ratelimit.Middleware(ratelimit.New(cfg.RateLimit.PerMinute, cfg.RateLimit.Burst), "/health", "/healthz", "/readyz", "/metrics"))
+	expensive := ratelimit.Middleware(ratelimit.New(cfg.RateLimit.ExpensivePerMinute, cfg.RateLimit.ExpensiveBurst))
+
+	// 応答しない問い合わせで接続を占有し続けないよう、リクエストごとに制限時間を設ける（過ぎた場合は504）
+	// 読み取りは短く、エクスポートなどの重いエンドポイントは長くする。変更の配信は接続を保ち続けるため制限しない
+	e.Use(requestTimeout(cfg.RequestTimeout, exportRoutes, "/events", "/ws"))
+
+	// 巨大なリクエストでメモリを使い切らないよう、本文の大きさを制限する（スキーマの検証で読み込む前に確認する）
+	// 復元はバックアップ全体を受け取るため、ルートに別の上限を設定する
+	e.Use(limitRequestBody(cfg.MaxBodyBytes, apiV1Prefix+"/admin/restore", "/admin/restore"))
+
+	// OpenAPIのスキーマに合わないリクエストはハンドラーに渡さない
+	e.Use(openapi.ValidateRequest(spec))
+
 	// 依存性注入
-	dbHandler := databaseInfra.NewSqlHandler()
-	defer dbHandler.Close()
+	repos, err := newRepositories(cfg, m)
+	if err != nil {
+		return err
+	}
+	defer repos.close()
+
+	if cfg.MigrateOnStartup && repos.sqlHandler != nil {
+		if err := migrate(ctx, repos.sqlHandler); err != nil {
+			return err
+		}
+	}
+
+	// キャッシュに当たった分は含めず、保存先への問い合わせを計測する
+	itemRepo := usecase.ItemRepository(metrics.NewItemRepository(repos.item, m))
+	loanRepo := usecase.LoanRepository(metrics.NewLoanRepository(repos.loan, m))
+	locationRepo := metrics.NewLocationRepository(repos.location, m)
+	templateRepo := metrics.NewItemTemplateRepository(repos.template, m)
+	webhookRepo := metrics.NewWebhookRepository(repos.webhook, m)
+	featureRepo := metrics.NewFeatureFlagRepository(repos.feature, m)
+	erasureRepo := metrics.NewErasureRepository(repos.erasure, m)
+	shareLinkRepo := metrics.NewShareLinkRepository(repos.shareLink, m)
+	commentRepo := metrics.NewItemCommentRepository(repos.comment, m)
+	relationRepo := metrics.NewItemRelationRepository(repos.relation, m)
+	auditLogRepo := metrics.NewAuditLogRepository(repos.auditLog, m)
+	valuationRepo := metrics.NewItemValuationRepository(repos.valuation, m)
+	saleRepo := metrics.NewItemSaleRepository(repos.sale, m)
+	insuranceRepo := metrics.NewItemInsuranceRepository(repos.insurance, m)
+	viewRepo := metrics.NewItemViewRepository(repos.view, m)
+	favoriteRepo := metrics.NewItemFavoriteRepository(repos.favorite, m)
+	outboxRepo := metrics.NewOutboxRepository(repos.outbox, m)
+	jobRepo := metrics.NewJobRepository(repos.job, m)
+	notificationPreferenceRepo := metrics.NewNotificationPreferenceRepository(repos.notificationPreference, m)
+	backupRepo := usecase.BackupRepository(metrics.NewBackupRepository(repos.backup, m))
+	itemReader := usecase.ItemRepository(metrics.NewItemRepository(repos.itemReader, m))
+
+	// レディネスチェックの確認項目（停止を始めたら失敗させる）
+	shutdown := newGracefulShutdown(ctx)
+	checks, err := databaseChecks(repos.sqlHandler)
+	if err != nil {
+		return err
+	}
+	checks = append(checks, shutdown.check())
+	transactor := usecase.Transactor(metrics.NewTransactor(repos.transactor, m))
+
+	// 設定されている場合はアイテムの取得と集計をRedisにキャッシュする
+	if cfg.Cache.RedisURL != "" {
+		store, err := cache.NewRedisStore(cfg.Cache.RedisURL)
+		if err != nil {
+			return fmt.Errorf("failed to connect to Redis: %w", err)
+		}
+		defer store.Close()
+		checks = append(checks, system.Check{Name: "cache", Check: store.Ping})
+		itemRepo = cache.NewItemRepository(itemRepo, store, cfg.Cache.ItemTTL, cfg.Cache.SummaryTTL)
+		itemReader = cache.NewItemRepository(itemReader, store, cfg.Cache.ItemTTL, cfg.Cache.SummaryTTL)
+		loanRepo = cache.NewLoanRepository(loanRepo, store)
+		backupRepo = cache.NewBackupRepository(backupRepo, store)
+		transactor = cache.NewTransactor(transactor, store, cfg.Cache.ItemTTL, cfg.Cache.SummaryTTL)
+	}
+
+	// 機能の有効・無効（管理者が切り替えた値を定期的に読み直す）
+	featureUsecase := usecase.NewFeatureFlagUsecase(featureRepo, cfg.Features.Flags, cfg.Features.RefreshInterval)
+	shutdown.goWorker(featureUsecase.Run)
+
+	// バックグラウンドで実行するジョブ（種類ごとの処理を登録してから動かす）
+	jobQueue := usecase.NewJobQueue(jobRepo)
+	webhookUsecase := usecase.NewWebhookUsecase(webhookRepo, webhookInfra.NewHTTPSender(cfg.Webhook.AllowPrivateNetworks), jobQueue)
+	jobQueue.Register(entity.JobWebhookDelivery, webhookUsecase.Deliver)
+	shutdown.goWorker(jobQueue.Run)
+
+	eventHandler := eventController.NewEventHandler()
+	wsHub := wsController.NewHub()
+
+	// ドメインイベントの購読者を登録
+	eventBus := eventbus.New()
+	// Webhookを止めている間のイベントは送らない（再開しても送り直さない）
+	eventBus.SubscribeAll(func(ctx context.Context, e event.Event) {
+		if featureUsecase.IsEnabled(entity.FeatureWebhooks) {
+			webhookUsecase.HandleEvent(ctx, e)
+		}
+	})
+	eventBus.SubscribeAll(eventHandler.HandleEvent)
+	eventBus.SubscribeAll(wsHub.HandleEvent)
+
+	// 設定されている場合はデータ基盤向けにNATSへも送る
+	if cfg.NATS.URL != "" {
+		natsPublisher, err := broker.NewNATSPublisher(cfg.NATS.URL, cfg.NATS.Subject)
+		if err != nil {
+			return fmt.Errorf("failed to connect to NATS: %w", err)
+		}
+		defer natsPublisher.Close()
+		eventBus.SubscribeAll(natsPublisher.HandleEvent)
+	}
 
-	itemRepo := &itemDatabase.ItemRepository{
-		SqlHandler: dbHandler,
+	// 設定されている場合はアイテムの変更を検索インデックスに反映する（ない場合はデータベースで検索する）
+	var searchIndex usecase.ItemSearchIndex
+	var searchIndexCreated bool
+	if cfg.Search.URL != "" {
+		openSearch, err := search.NewOpenSearchIndex(cfg.Search.URL, cfg.Search.Index)
+		if err != nil {
+			return err
+		}
+		if searchIndexCreated, err = openSearch.EnsureIndex(ctx); err != nil {
+			return fmt.Errorf("failed to prepare search index: %w", err)
+		}
+		searchIndex = openSearch
 	}
+	searchUsecase := usecase.NewItemSearchUsecase(itemReader, searchIndex)
+	if searchIndex != nil {
+		eventBus.SubscribeAll(searchUsecase.HandleEvent)
+		// 作ったばかりのインデックスには既存のアイテムを書き込む
+		if searchIndexCreated {
+			shutdown.goWorker(func(ctx context.Context) {
+				if _, err := searchUsecase.Reindex(ctx); err != nil {
+					log.Printf("failed to build search index: %v", err)
+				}
+			})
+		}
+	}
+
+	// アイテムの変更と同じトランザクションで記録されたイベントをイベントバスへ配信
+	// 停止時は最後に残りを配信し、それで登録されたWebhookの送信も含めて実行する時刻になったジョブを実行する
+	outboxRelay := usecase.NewOutboxRelay(outboxRepo, eventBus)
+	shutdown.goWorker(outboxRelay.Run)
+	shutdown.onShutdown(outboxRelay.Drain)
+	shutdown.onShutdown(jobQueue.RunDue)
+
+	itemUsecase := tracing.NewItemUsecase(usecase.NewItemUsecaseWithQuota(itemRepo, itemReader, transactor, usecase.Quota{MaxItems: cfg.Quota.MaxItems}))
+	loanUsecase := tracing.NewLoanUsecase(usecase.NewLoanUsecase(itemRepo, loanRepo, transactor))
+	locationUsecase := tracing.NewLocationUsecase(usecase.NewLocationUsecase(itemRepo, locationRepo, transactor))
+	templateUsecase := tracing.NewItemTemplateUsecase(usecase.NewItemTemplateUsecase(templateRepo, itemUsecase))
+	backupUsecase := usecase.NewBackupUsecase(backupRepo)
+	// 猶予期間が過ぎた削除の依頼を実行する
+	privacyUsecase := usecase.NewPrivacyUsecase(backupRepo, erasureRepo, cfg.Privacy.ErasureGracePeriod)
+	shutdown.goWorker(privacyUsecase.Run)
 
-	itemUsecase := usecase.NewItemUsecase(itemRepo)
+	// 通知は利用者の設定で無効にされていないものだけを送る
+	notifier := usecase.NewNotificationDispatcher(notificationPreferenceRepo, map[string]usecase.Notifier{
+		entity.NotificationChannelEmail: newNotifier(cfg.Notification),
+	})
+
+	// 定期的に実行する保守のタスク
+	maintenance := usecase.NewMaintenance(itemRepo, loanRepo, notifier, cfg.Scheduler.TrashRetention)
+	tasks := map[string]func(ctx context.Context) error{
+		entity.TaskPurgeTrash:   maintenance.PurgeTrash,
+		entity.TaskPurgeJobs:    jobQueue.PurgeSucceeded,
+		entity.TaskOverdueLoans: maintenance.CheckOverdueLoans,
+	}
+	scheduler := usecase.NewScheduler()
+	for _, name := range entity.ScheduledTasks {
+		schedule, err := entity.ParseSchedule(cfg.Scheduler.Schedule(name))
+		if err != nil {
+			return err
+		}
+		scheduler.Add(name, schedule, cfg.Scheduler.Enabled(name), tasks[name])
+	}
+	shutdown.goWorker(scheduler.Run)
 
-	systemHandler := system.NewSystemHandler()
-	itemHandler := itemController.NewItemHandler(itemUsecase)
+	systemHandler := system.NewSystemHandler(checks...)
+	itemHandler := itemController.NewItemHandler(itemUsecase, requestUser)
+	loanHandler := loanController.NewLoanHandler(loanUsecase)
+	locationHandler := locationController.NewLocationHandler(locationUsecase)
+	templateHandler := templateController.NewTemplateHandler(templateUsecase)
+	webhookHandler := webhookController.NewWebhookHandler(webhookUsecase)
+	jobHandler := jobController.NewJobHandler(jobQueue)
+	taskHandler := taskController.NewTaskHandler(scheduler)
+	backupHandler := backupController.NewBackupHandler(backupUsecase)
+	featureHandler := featureController.NewFeatureHandler(featureUsecase)
+	privacyHandler := privacyController.NewPrivacyHandler(privacyUsecase)
+	notificationHandler := notificationController.NewNotificationHandler(usecase.NewNotificationPreferenceUsecase(notificationPreferenceRepo))
+	shareLinkHandler := shareController.NewShareLinkHandler(usecase.NewShareLinkUsecase(itemRepo, shareLinkRepo))
+	commentHandler := commentController.NewItemCommentHandler(usecase.NewItemCommentUsecase(itemRepo, commentRepo))
+	relationHandler := relationController.NewItemRelationHandler(usecase.NewItemRelationUsecase(itemRepo, relationRepo))
+	categoryHandler := categoryController.NewCategoryHandler(usecase.NewCategoryUsecase(transactor))
+	auditLogHandler := auditController.NewAuditLogHandler(usecase.NewAuditLogUsecase(auditLogRepo))
+	activityHandler := activityController.NewActivityHandler(usecase.NewActivityUsecase(outboxRepo, auditLogRepo))
+	searchHandler := searchController.NewItemSearchHandler(searchUsecase)
+	valuationHandler := valuationController.NewItemValuationHandler(usecase.NewItemValuationUsecase(itemRepo, valuationRepo, transactor), requestUser)
+	saleHandler := saleController.NewItemSaleHandler(usecase.NewItemSaleUsecase(saleRepo, transactor))
+	insuranceHandler := insuranceController.NewItemInsuranceHandler(usecase.NewItemInsuranceUsecase(itemRepo, insuranceRepo))
+	reportHandler := reportController.NewReportHandler(usecase.NewReportUsecase(itemReader, saleRepo, insuranceRepo))
+	viewHandler := viewController.NewItemViewHandler(usecase.NewItemViewUsecase(itemRepo, viewRepo), requestUser)
+	favoriteHandler := favoriteController.NewItemFavoriteHandler(usecase.NewItemFavoriteUsecase(itemRepo, favoriteRepo), requestUser)
+	listingHandler := listingController.NewListingImportHandler(usecase.NewListingImportUsecase(itemReader, listing.NewChrono24Provider(), listing.NewYahooAuctionsProvider()))
+	wsHandler := wsController.NewWebSocketHandler(wsHub, itemUsecase)
+	graphqlHandler := graphqlController.NewGraphQLHandler(itemUsecase, locationUsecase)
+	openapiHandler := openapi.NewHandler()
 
 	// ヘルスチェック
 	e.GET("/health", func(c echo.Context) error {
 		systemHandler.Health(c)
 		return nil
 	})
+	e.GET("/healthz", systemHandler.Liveness) // GET /healthz
+	e.GET("/readyz", systemHandler.Readiness) // GET /readyz
+
+	// Prometheus形式のメトリクス
+	e.GET("/metrics", echo.WrapHandler(m.Handler())) // GET /metrics
 
-	// アイテムに関するエンドポイント
-	itemsGroup := e.Group("/items")
-	{
-		itemsGroup.GET("", itemHandler.GetItems)           // GET /items
-		itemsGroup.POST("", itemHandler.CreateItem)        // POST /items
-		itemsGroup.GET("/:id", itemHandler.GetItem)        // GET /items/{id}
-		itemsGroup.PATCH("/:id", itemHandler.PatchItem)    // PATCH /items/{id} - 追加しました。
-		itemsGroup.DELETE("/:id", itemHandler.DeleteItem)  // DELETE /items/{id}
-		itemsGroup.GET("/summary", itemHandler.GetSummary) // GET /items/summary (bonus)
+	// 公開カタログを有効にした場合は、カタログ以外のAPIと変更の配信に管理者のトークンを求める
+	// 読み取りも守るのは、APIのレスポンスに購入価格・シリアル番号・保管場所が含まれるため
+	var private []echo.MiddlewareFunc
+	if cfg.PublicCatalog.Enabled {
+		private = append(private, requireAdminToken(cfg.AdminToken))
+
+		catalogHandler := catalogController.NewCatalogHandler(usecase.NewCatalogUsecase(itemReader, usecase.Catalog{
+			Categories: cfg.PublicCatalog.Categories,
+			Fields:     cfg.PublicCatalog.Fields,
+		}))
+		publicGroup := e.Group(catalogController.PublicPath)
+		publicGroup.GET("/items", catalogHandler.GetCatalogItems)    // GET /public/items
+		publicGroup.GET("/items/:id", catalogHandler.GetCatalogItem) // GET /public/items/{id}
 	}
 
-	return s.startWithGracefulShutdown(ctx, e)
+	// アイテムの変更をServer-Sent Eventsで配信（停止時は接続を切ってシャットダウンを待たせない）
+	e.GET("/events", eventHandler.Stream, private...) // GET /events
+	e.Server.RegisterOnShutdown(eventHandler.Close)
+
+	// アイテムの変更と集計をWebSocketで配信
+	shutdown.goWorker(func(ctx context.Context) { wsHub.Run(ctx, itemUsecase) })
+	e.GET("/ws", wsHandler.Connect, private...) // GET /ws
+	e.Server.RegisterOnShutdown(wsHub.Close)
+
+	// 共有リンクの公開ページ（APIのアクセス権がない相手に渡すため、バージョンのないパスで公開する）
+	e.GET(shareController.SharedPath+"/:token", shareLinkHandler.GetSharedItem) // GET /shared/{token}
+
+	// API仕様
+	e.GET("/openapi.json", openapiHandler.GetSpec) // GET /openapi.json
+	e.GET("/docs", openapiHandler.GetDocs)         // GET /docs (Swagger UI)
+
+	// REST API（/api/v1 と、互換のために残すバージョンのないパス）
+	api := &apiRoutes{
+		item:                itemHandler,
+		loan:                loanHandler,
+		location:            locationHandler,
+		template:            templateHandler,
+		webhook:             webhookHandler,
+		backup:              backupHandler,
+		feature:             featureHandler,
+		privacy:             privacyHandler,
+		notification:        notificationHandler,
+		activity:            activityHandler,
+		share:               shareLinkHandler,
+		comment:             commentHandler,
+		relation:            relationHandler,
+		category:            categoryHandler,
+		auditLog:            auditLogHandler,
+		valuation:           valuationHandler,
+		sale:                saleHandler,
+		insurance:           insuranceHandler,
+		report:              reportHandler,
+		listing:             listingHandler,
+		view:                viewHandler,
+		favorite:            favoriteHandler,
+		search:              searchHandler,
+		job:                 jobHandler,
+		task:                taskHandler,
+		searchIndexEnabled:  searchIndex != nil,
+		features:            featureUsecase,
+		expensive:           expensive,
+		adminToken:          cfg.AdminToken,
+		maxRestoreBodyBytes: cfg.MaxRestoreBodyBytes,
+	}
+	api.registerV1(e.Group(apiV1Prefix), private...)
+	api.registerV1(e.Group(""), append([]echo.MiddlewareFunc{deprecated(legacyAPIDeprecation)}, private...)...)
+
+	// GraphQL（RESTと同じユースケースを利用）
+	e.POST("/graphql", graphqlHandler.Query, append(private, requireFeature(featureUsecase, entity.FeatureGraphQL))...) // POST /graphql
+
+	// 社内サービス向けのgRPC（公開カタログを有効にした場合はRESTと同じくトークンを求める）
+	var grpcOptions []grpc.ServerOption
+	if cfg.PublicCatalog.Enabled {
+		grpcOptions = append(grpcOptions, grpc.UnaryInterceptor(requireAdminTokenGRPC(cfg.AdminToken)))
+	}
+	grpcServer := grpc.NewServer(grpcOptions...)
+	itempb.RegisterItemServiceServer(grpcServer, grpcController.NewItemServiceServer(itemUsecase))
+
+	return s.startWithGracefulShutdown(ctx, e, grpcServer, shutdown)
 }
 
-func (s *Server) startWithGracefulShutdown(ctx context.Context, e *echo.Echo) error {
+func (s *Server) startWithGracefulShutdown(ctx context.Context, e *echo.Echo, grpcServer *grpc.Server, shutdown *gracefulShutdown) error {
 	go func() {
-		port := ":8080"
-		fmt.Printf("🚀 Server starting on port %s\n", port)
+		fmt.Printf("🚀 Server starting on port %s\n", s.cfg.Port)
 
-		if err := e.Start(port); err != nil && err != http.ErrServerClosed {
+		if err := e.Start(s.cfg.Port); err != nil && err != http.ErrServerClosed {
 			e.Logger.Fatal("Server startup failed:", err)
 		}
 	}()
 
+	go func() {
+		fmt.Printf("🚀 gRPC server starting on port %s\n", s.cfg.GRPCPort)
+
+		listener, err := net.Listen("tcp", s.cfg.GRPCPort)
+		if err != nil {
+			e.Logger.Fatal("gRPC server startup failed:", err)
+		}
+		if err := grpcServer.Serve(listener); err != nil && err != grpc.ErrServerStopped {
+			e.Logger.Fatal("gRPC server startup failed:", err)
+		}
+	}()
+
+	// Kubernetesなどは SIGTERM で停止を求める
 	quit := make(chan os.Signal, 1)
-	signal.Notify(quit, os.Interrupt)
+	signal.Notify(quit, os.Interrupt, syscall.SIGTERM)
 
 	select {
 	case <-quit:
@@ -81,10 +441,7 @@ func (s *Server) startWithGracefulShutdown(ctx context.Context, e *echo.Echo) er
 		fmt.Println("\n🛑 Context cancelled, shutting down server...")
 	}
 
-	shutdownCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
-	defer cancel()
-
-	if err := e.Shutdown(shutdownCtx); err != nil {
+	if err := shutdown.run(e, grpcServer, s.cfg.Shutdown.Delay, s.cfg.Shutdown.Timeout); err != nil {
 		return fmt.Errorf("server forced to shutdown: %w", err)
 	}
 
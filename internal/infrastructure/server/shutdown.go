@@ -0,0 +1,104 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"google.golang.org/grpc"
+
+	"Aicon-assignment/internal/interfaces/controller/system"
+)
+
+var errShuttingDown = errors.New("shutting down")
+
+// 停止時に待つ処理
+type gracefulShutdown struct {
+	// 停止を始めるとレディネスチェックを失敗させる
+	draining atomic.Bool
+
+	// アウトボックスの配信など、停止時にキャンセルして終わるのを待つゴルーチン
+	workersCtx    context.Context
+	cancelWorkers context.CancelFunc
+	workers       sync.WaitGroup
+
+	// ゴルーチンを止めた後に、残りを送り切る処理（アウトボックスの最後の配信、送信中のWebhookなど）
+	flushers []func(ctx context.Context) error
+}
+
+func newGracefulShutdown(ctx context.Context) *gracefulShutdown {
+	workersCtx, cancel := context.WithCancel(ctx)
+	return &gracefulShutdown{workersCtx: workersCtx, cancelWorkers: cancel}
+}
+
+func (s *gracefulShutdown) goWorker(fn func(ctx context.Context)) {
+	s.workers.Add(1)
+	go func() {
+		defer s.workers.Done()
+		fn(s.workersCtx)
+	}()
+}
+
+func (s *gracefulShutdown) onShutdown(flush func(ctx context.Context) error) {
+	s.flushers = append(s.flushers, flush)
+}
+
+// レディネスチェックの確認項目
+func (s *gracefulShutdown) check() system.Check {
+	return system.Check{Name: "shutdown", Check: func(ctx context.Context) error {
+		if s.draining.Load() {
+			return errShuttingDown
+		}
+		return nil
+	}}
+}
+
+// 新しい接続を断り、処理中のリクエストとバックグラウンド処理を timeout まで待つ
+// データベースなどの接続はこの後に Run の defer で閉じる
+func (s *gracefulShutdown) run(e *echo.Echo, grpcServer *grpc.Server, delay, timeout time.Duration) error {
+	s.draining.Store(true)
+	if delay > 0 {
+		fmt.Printf("⏳ Waiting %s before closing listeners...\n", delay)
+		time.Sleep(delay)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	var errs []error
+
+	// HTTPとgRPCは並行して待つ
+	grpcStopped := make(chan struct{})
+	go func() {
+		grpcServer.GracefulStop()
+		close(grpcStopped)
+	}()
+
+	if err := e.Shutdown(ctx); err != nil {
+		errs = append(errs, fmt.Errorf("failed to drain HTTP requests: %w", err))
+		e.Close()
+	}
+
+	select {
+	case <-grpcStopped:
+	case <-ctx.Done():
+		errs = append(errs, fmt.Errorf("failed to drain gRPC requests: %w", ctx.Err()))
+		grpcServer.Stop()
+	}
+
+	// リクエストが残っていない状態でゴルーチンを止め、最後に残りを送り切る
+	s.cancelWorkers()
+	s.workers.Wait()
+
+	for _, flush := range s.flushers {
+		if err := flush(ctx); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
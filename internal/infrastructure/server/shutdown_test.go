@@ -0,0 +1,78 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+func TestGracefulShutdown(t *testing.T) {
+	t.Run("正常系: 処理中のリクエストを待ってからゴルーチンを止め、残りを送り切る", func(t *testing.T) {
+		shutdown := newGracefulShutdown(context.Background())
+
+		started := make(chan struct{})
+		e := echo.New()
+		e.HideBanner = true
+		e.HidePort = true
+		e.GET("/slow", func(c echo.Context) error {
+			close(started)
+			time.Sleep(100 * time.Millisecond)
+			return c.String(http.StatusOK, "done")
+		})
+		go e.Start("127.0.0.1:0")
+		require.Eventually(t, func() bool { return e.ListenerAddr() != nil }, time.Second, 10*time.Millisecond)
+
+		var steps []string
+		workerStopped := make(chan struct{})
+		shutdown.goWorker(func(ctx context.Context) {
+			<-ctx.Done()
+			close(workerStopped)
+		})
+		shutdown.onShutdown(func(ctx context.Context) error {
+			select {
+			case <-workerStopped:
+				steps = append(steps, "flush after worker")
+			default:
+				steps = append(steps, "flush before worker")
+			}
+			return nil
+		})
+
+		responses := make(chan int)
+		go func() {
+			res, err := http.Get("http://" + e.ListenerAddr().String() + "/slow")
+			if err != nil {
+				responses <- 0
+				return
+			}
+			res.Body.Close()
+			responses <- res.StatusCode
+		}()
+		<-started
+
+		require.NoError(t, shutdown.check().Check(context.Background()))
+		require.NoError(t, shutdown.run(e, grpc.NewServer(), 0, time.Second))
+
+		assert.Equal(t, http.StatusOK, <-responses)
+		assert.Equal(t, []string{"flush after worker"}, steps)
+		assert.ErrorIs(t, shutdown.check().Check(context.Background()), errShuttingDown)
+	})
+
+	t.Run("異常系: 制限時間を過ぎたら待つのをやめてエラーを返す", func(t *testing.T) {
+		shutdown := newGracefulShutdown(context.Background())
+		shutdown.onShutdown(func(ctx context.Context) error {
+			<-ctx.Done()
+			return ctx.Err()
+		})
+
+		err := shutdown.run(echo.New(), grpc.NewServer(), 0, 20*time.Millisecond)
+
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+}
@@ -0,0 +1,46 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"Aicon-assignment/internal/infrastructure/config"
+)
+
+// ルートに応じた制限時間をリクエストのコンテキストに付ける
+// ユースケースとリポジトリには同じコンテキストを渡すため、応答しない問い合わせも制限時間で打ち切られ、
+// ハンドラーが返す500は problem.Write が504にする
+// exportRoutes（/api/v1 を除いたルートのパス）は cfg.Export、unlimitedRoutes は制限しない
+func requestTimeout(cfg config.RequestTimeoutConfig, exportRoutes []string, unlimitedRoutes ...string) echo.MiddlewareFunc {
+	timeouts := make(map[string]time.Duration, len(exportRoutes)+len(unlimitedRoutes))
+	for _, route := range exportRoutes {
+		timeouts[route] = cfg.Export
+	}
+	for _, route := range unlimitedRoutes {
+		timeouts[route] = 0
+	}
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			timeout, ok := timeouts[strings.TrimPrefix(c.Path(), apiV1Prefix)]
+			if !ok {
+				timeout = cfg.Write
+				if method := c.Request().Method; method == http.MethodGet || method == http.MethodHead {
+					timeout = cfg.Read
+				}
+			}
+			if timeout <= 0 {
+				return next(c)
+			}
+
+			ctx, cancel := context.WithTimeout(c.Request().Context(), timeout)
+			defer cancel()
+			c.SetRequest(c.Request().WithContext(ctx))
+			return next(c)
+		}
+	}
+}
@@ -0,0 +1,70 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+
+	"Aicon-assignment/internal/infrastructure/config"
+	"Aicon-assignment/internal/interfaces/problem"
+)
+
+func TestRequestTimeout(t *testing.T) {
+	e := echo.New()
+	e.Use(requestTimeout(config.RequestTimeoutConfig{Read: time.Second, Write: 2 * time.Second, Export: time.Minute}, []string{"/me/export"}, "/events"))
+
+	// 制限時間までの残りを返す
+	remaining := func(c echo.Context) error {
+		deadline, ok := c.Request().Context().Deadline()
+		if !ok {
+			return c.String(http.StatusOK, "none")
+		}
+		return c.String(http.StatusOK, time.Until(deadline).Round(time.Second).String())
+	}
+	e.GET("/items/:id", remaining)
+	e.PATCH("/items/:id", remaining)
+	e.GET(apiV1Prefix+"/me/export", remaining)
+	e.GET("/events", remaining)
+
+	tests := []struct {
+		name   string
+		method string
+		path   string
+		want   string
+	}{
+		{name: "正常系: 読み取り", method: http.MethodGet, path: "/items/1", want: "1s"},
+		{name: "正常系: 変更", method: http.MethodPatch, path: "/items/1", want: "2s"},
+		{name: "正常系: エクスポート", method: http.MethodGet, path: apiV1Prefix + "/me/export", want: "1m0s"},
+		{name: "正常系: 制限しないルート", method: http.MethodGet, path: "/events", want: "none"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, httptest.NewRequest(tt.method, tt.path, nil))
+
+			assert.Equal(t, http.StatusOK, rec.Code)
+			assert.Equal(t, tt.want, rec.Body.String())
+		})
+	}
+
+	t.Run("異常系: 制限時間を過ぎた問い合わせは504", func(t *testing.T) {
+		e := echo.New()
+		e.Use(requestTimeout(config.RequestTimeoutConfig{Read: 10 * time.Millisecond}, nil))
+		e.GET("/items", func(c echo.Context) error {
+			// 応答しない問い合わせの代わりに、打ち切られるまで待つ
+			<-c.Request().Context().Done()
+			return problem.Write(c, problem.New(http.StatusInternalServerError, "failed_to_retrieve_items"))
+		})
+
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/items", nil))
+
+		assert.Equal(t, http.StatusGatewayTimeout, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"code":"request_timeout"`)
+	})
+}
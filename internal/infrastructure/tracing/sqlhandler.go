@@ -0,0 +1,143 @@
+package tracing
+
+import (
+	"context"
+	"strings"
+
+	"go.opentelemetry.io/otel/attribute"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+
+	"Aicon-assignment/internal/interfaces/database"
+)
+
+// 実行したSQLごとにクライアントスパンを作るハンドラー
+// 引数には個人情報が含まれうるため、文だけを記録する
+type sqlHandler struct {
+	database.SqlHandler
+	system attribute.KeyValue
+}
+
+func WrapSqlHandler(handler database.SqlHandler) database.SqlHandler {
+	return &sqlHandler{SqlHandler: handler, system: dbSystem(handler.Dialect())}
+}
+
+func (h *sqlHandler) Execute(ctx context.Context, statement string, args ...interface{}) (database.Result, error) {
+	ctx, end := startStatement(ctx, h.system, statement)
+	result, err := h.SqlHandler.Execute(ctx, statement, args...)
+	end(err)
+	return result, err
+}
+
+func (h *sqlHandler) Query(ctx context.Context, statement string, args ...interface{}) (database.Rows, error) {
+	return query(ctx, h.SqlHandler, h.system, statement, args...)
+}
+
+func (h *sqlHandler) QueryRow(ctx context.Context, statement string, args ...interface{}) database.Row {
+	return queryRow(ctx, h.SqlHandler, h.system, statement, args...)
+}
+
+func (h *sqlHandler) Begin(ctx context.Context) (database.Tx, error) {
+	tx, err := h.SqlHandler.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &sqlTx{Tx: tx, system: h.system}, nil
+}
+
+type sqlTx struct {
+	database.Tx
+	system attribute.KeyValue
+}
+
+func (t *sqlTx) Execute(ctx context.Context, statement string, args ...interface{}) (database.Result, error) {
+	ctx, end := startStatement(ctx, t.system, statement)
+	result, err := t.Tx.Execute(ctx, statement, args...)
+	end(err)
+	return result, err
+}
+
+func (t *sqlTx) Query(ctx context.Context, statement string, args ...interface{}) (database.Rows, error) {
+	return query(ctx, t.Tx, t.system, statement, args...)
+}
+
+func (t *sqlTx) QueryRow(ctx context.Context, statement string, args ...interface{}) database.Row {
+	return queryRow(ctx, t.Tx, t.system, statement, args...)
+}
+
+type querier interface {
+	Query(ctx context.Context, statement string, args ...interface{}) (database.Rows, error)
+	QueryRow(ctx context.Context, statement string, args ...interface{}) database.Row
+}
+
+// 行を読み終えるまでを1つのスパンにする
+func query(ctx context.Context, q querier, system attribute.KeyValue, statement string, args ...interface{}) (database.Rows, error) {
+	ctx, end := startStatement(ctx, system, statement)
+	rows, err := q.Query(ctx, statement, args...)
+	if err != nil {
+		end(err)
+		return nil, err
+	}
+	return &sqlRows{Rows: rows, end: end}, nil
+}
+
+type sqlRows struct {
+	database.Rows
+	end func(err error)
+}
+
+func (r *sqlRows) Close() error {
+	err := r.Rows.Close()
+	if err == nil {
+		err = r.Rows.Err()
+	}
+	r.end(err)
+	return err
+}
+
+// エラーはScanまで分からないので、Scanの時点で実行する
+func queryRow(ctx context.Context, q querier, system attribute.KeyValue, statement string, args ...interface{}) database.Row {
+	return &sqlRow{q: q, ctx: ctx, system: system, statement: statement, args: args}
+}
+
+type sqlRow struct {
+	q         querier
+	ctx       context.Context
+	system    attribute.KeyValue
+	statement string
+	args      []interface{}
+}
+
+func (r *sqlRow) Scan(dest ...interface{}) error {
+	ctx, end := startStatement(r.ctx, r.system, r.statement)
+	err := r.q.QueryRow(ctx, r.statement, r.args...).Scan(dest...)
+	end(err)
+	return err
+}
+
+// スパン名は SELECT・INSERT などの操作にする
+func startStatement(ctx context.Context, system attribute.KeyValue, statement string) (context.Context, func(err error)) {
+	return start(ctx, operation(statement),
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(system, semconv.DBQueryText(statement)),
+	)
+}
+
+func operation(statement string) string {
+	fields := strings.Fields(statement)
+	if len(fields) == 0 {
+		return "SQL"
+	}
+	return strings.ToUpper(fields[0])
+}
+
+func dbSystem(dialect database.Dialect) attribute.KeyValue {
+	switch dialect {
+	case database.DialectPostgres:
+		return semconv.DBSystemPostgreSQL
+	case database.DialectSQLite:
+		return semconv.DBSystemSqlite
+	default:
+		return semconv.DBSystemMySQL
+	}
+}
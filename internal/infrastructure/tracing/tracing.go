@@ -0,0 +1,115 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	instrumentationName = "Aicon-assignment"
+
+	// OTEL_SERVICE_NAME が未設定の場合のサービス名
+	defaultServiceName = "aicon-assignment"
+)
+
+// OTLPでトレースを送るよう設定し、停止時に残りを送り切る関数を返す
+// 送信先やサンプリングは OTEL_EXPORTER_OTLP_ENDPOINT・OTEL_TRACES_SAMPLER などの標準の環境変数で設定する
+func Setup(ctx context.Context) (func(context.Context) error, error) {
+	exporter, err := otlptracehttp.New(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create OTLP exporter: %w", err)
+	}
+
+	res, err := resource.Merge(resource.Default(), resource.NewSchemaless(semconv.ServiceName(defaultServiceName)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create resource: %w", err)
+	}
+	// OTEL_SERVICE_NAME・OTEL_RESOURCE_ATTRIBUTES を優先する
+	res, err = resource.Merge(res, resource.Environment())
+	if err != nil {
+		return nil, fmt.Errorf("failed to create resource: %w", err)
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+	)
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+
+	return provider.Shutdown, nil
+}
+
+// Setupを呼ぶまでは何も記録しないトレーサーになる
+func tracer() trace.Tracer {
+	return otel.Tracer(instrumentationName)
+}
+
+// スパンを開始し、終了時にエラーを記録する関数を返す
+func start(ctx context.Context, name string, opts ...trace.SpanStartOption) (context.Context, func(err error)) {
+	ctx, span := tracer().Start(ctx, name, opts...)
+	return ctx, func(err error) {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}
+}
+
+// リクエストごとにサーバースパンを作り、traceparentヘッダーがあれば呼び出し元のトレースにつなげる
+// スパンはリクエストのコンテキストに入れるため、ユースケースとリポジトリのスパンはこの子になる
+func Middleware() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			req := c.Request()
+			ctx := otel.GetTextMapPropagator().Extract(req.Context(), propagation.HeaderCarrier(req.Header))
+
+			route := c.Path()
+			if route == "" {
+				route = "unmatched"
+			}
+
+			ctx, span := tracer().Start(ctx, req.Method+" "+route,
+				trace.WithSpanKind(trace.SpanKindServer),
+				trace.WithAttributes(
+					semconv.HTTPRequestMethodKey.String(req.Method),
+					semconv.HTTPRoute(route),
+					semconv.URLPath(req.URL.Path),
+				),
+			)
+			defer span.End()
+
+			c.SetRequest(req.WithContext(ctx))
+			err := next(c)
+
+			// エラーを返したハンドラーはこの後のエラーハンドラーでレスポンスが決まるため、ステータスを先に求める
+			status := c.Response().Status
+			if err != nil {
+				status = http.StatusInternalServerError
+				if httpErr, ok := err.(*echo.HTTPError); ok {
+					status = httpErr.Code
+				}
+				span.RecordError(err)
+			}
+
+			span.SetAttributes(semconv.HTTPResponseStatusCode(status))
+			if status >= http.StatusInternalServerError {
+				span.SetStatus(codes.Error, http.StatusText(status))
+			}
+
+			return err
+		}
+	}
+}
@@ -0,0 +1,151 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+
+	"Aicon-assignment/internal/domain/entity"
+	"Aicon-assignment/internal/interfaces/database"
+	"Aicon-assignment/internal/usecase"
+)
+
+// 記録したスパンを返すトレーサーに差し替える
+func record(t *testing.T) *tracetest.SpanRecorder {
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+
+	previous, previousPropagator := otel.GetTracerProvider(), otel.GetTextMapPropagator()
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	t.Cleanup(func() {
+		otel.SetTracerProvider(previous)
+		otel.SetTextMapPropagator(previousPropagator)
+	})
+	return recorder
+}
+
+func attributes(span sdktrace.ReadOnlySpan) map[attribute.Key]attribute.Value {
+	values := map[attribute.Key]attribute.Value{}
+	for _, kv := range span.Attributes() {
+		values[kv.Key] = kv.Value
+	}
+	return values
+}
+
+// GetItemByIDだけを実装したユースケース
+type stubItemUsecase struct {
+	usecase.ItemUsecase
+	err error
+}
+
+func (u *stubItemUsecase) GetItemByID(ctx context.Context, id int64) (*entity.Item, error) {
+	return &entity.Item{ID: id}, u.err
+}
+
+func TestMiddleware(t *testing.T) {
+	t.Run("正常系: 呼び出し元のトレースにつなげ、ユースケースのスパンを子にする", func(t *testing.T) {
+		recorder := record(t)
+		itemUsecase := NewItemUsecase(&stubItemUsecase{})
+
+		e := echo.New()
+		e.Use(Middleware())
+		e.GET("/items/:id", func(c echo.Context) error {
+			_, err := itemUsecase.GetItemByID(c.Request().Context(), 1)
+			require.NoError(t, err)
+			return c.NoContent(http.StatusOK)
+		})
+
+		req := httptest.NewRequest(http.MethodGet, "/items/1", nil)
+		req.Header.Set("traceparent", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+		e.ServeHTTP(httptest.NewRecorder(), req)
+
+		spans := recorder.Ended()
+		require.Len(t, spans, 2)
+		child, server := spans[0], spans[1]
+
+		assert.Equal(t, "GET /items/:id", server.Name())
+		assert.Equal(t, trace.SpanKindServer, server.SpanKind())
+		assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", server.SpanContext().TraceID().String())
+		assert.Equal(t, "00f067aa0ba902b7", server.Parent().SpanID().String())
+		assert.Equal(t, int64(http.StatusOK), attributes(server)["http.response.status_code"].AsInt64())
+
+		assert.Equal(t, "ItemUsecase.GetItemByID", child.Name())
+		assert.Equal(t, server.SpanContext().SpanID(), child.Parent().SpanID())
+	})
+
+	t.Run("異常系: 500系はエラーとして記録する", func(t *testing.T) {
+		recorder := record(t)
+
+		e := echo.New()
+		e.Use(Middleware())
+		e.GET("/items", func(c echo.Context) error {
+			return echo.NewHTTPError(http.StatusServiceUnavailable)
+		})
+		e.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/items", nil))
+
+		spans := recorder.Ended()
+		require.Len(t, spans, 1)
+		assert.Equal(t, int64(http.StatusServiceUnavailable), attributes(spans[0])["http.response.status_code"].AsInt64())
+		assert.Equal(t, codes.Error, spans[0].Status().Code)
+	})
+}
+
+// Executeだけを実装したハンドラー
+type stubSqlHandler struct {
+	database.SqlHandler
+	err error
+}
+
+func (h *stubSqlHandler) Execute(ctx context.Context, statement string, args ...interface{}) (database.Result, error) {
+	return nil, h.err
+}
+
+func (h *stubSqlHandler) Dialect() database.Dialect {
+	return database.DialectPostgres
+}
+
+func TestWrapSqlHandler(t *testing.T) {
+	t.Run("正常系: 文とDBの種類を記録し、引数は記録しない", func(t *testing.T) {
+		recorder := record(t)
+		handler := WrapSqlHandler(&stubSqlHandler{})
+
+		_, err := handler.Execute(context.Background(), "  delete FROM items WHERE id = ?", 1)
+		require.NoError(t, err)
+
+		spans := recorder.Ended()
+		require.Len(t, spans, 1)
+		assert.Equal(t, "DELETE", spans[0].Name())
+		assert.Equal(t, trace.SpanKindClient, spans[0].SpanKind())
+		assert.Equal(t, map[attribute.Key]attribute.Value{
+			"db.system":     attribute.StringValue("postgresql"),
+			"db.query.text": attribute.StringValue("  delete FROM items WHERE id = ?"),
+		}, attributes(spans[0]))
+	})
+
+	t.Run("異常系: 失敗した文はエラーとして記録する", func(t *testing.T) {
+		recorder := record(t)
+		handler := WrapSqlHandler(&stubSqlHandler{err: errors.New("connection refused")})
+
+		_, err := handler.Execute(context.Background(), "UPDATE items SET name = ?", "x")
+		require.Error(t, err)
+
+		spans := recorder.Ended()
+		require.Len(t, spans, 1)
+		assert.Equal(t, codes.Error, spans[0].Status().Code)
+		assert.Equal(t, "connection refused", spans[0].Status().Description)
+	})
+}
@@ -0,0 +1,185 @@
+package tracing
+
+import (
+	"context"
+
+	"Aicon-assignment/internal/domain/entity"
+	"Aicon-assignment/internal/usecase"
+)
+
+// 1つの値を返す呼び出しをスパンで囲む
+func traced[T any](ctx context.Context, name string, call func(ctx context.Context) (T, error)) (T, error) {
+	ctx, end := start(ctx, name)
+	value, err := call(ctx)
+	end(err)
+	return value, err
+}
+
+func tracedErr(ctx context.Context, name string, call func(ctx context.Context) error) error {
+	ctx, end := start(ctx, name)
+	err := call(ctx)
+	end(err)
+	return err
+}
+
+// ItemUsecase の呼び出しごとにスパンを作るデコレーター
+type ItemUsecase struct {
+	next usecase.ItemUsecase
+}
+
+func NewItemUsecase(u usecase.ItemUsecase) *ItemUsecase {
+	return &ItemUsecase{next: u}
+}
+
+func (u *ItemUsecase) GetAllItems(ctx context.Context, filter entity.ItemFilter) ([]*entity.Item, error) {
+	return traced(ctx, "ItemUsecase.GetAllItems", func(ctx context.Context) ([]*entity.Item, error) { return u.next.GetAllItems(ctx, filter) })
+}
+
+func (u *ItemUsecase) CountItems(ctx context.Context, filter entity.ItemFilter) (int, error) {
+	return traced(ctx, "ItemUsecase.CountItems", func(ctx context.Context) (int, error) { return u.next.CountItems(ctx, filter) })
+}
+
+func (u *ItemUsecase) GetItemByID(ctx context.Context, id int64) (*entity.Item, error) {
+	return traced(ctx, "ItemUsecase.GetItemByID", func(ctx context.Context) (*entity.Item, error) { return u.next.GetItemByID(ctx, id) })
+}
+
+func (u *ItemUsecase) GetItemBySerialNumber(ctx context.Context, serialNumber string) (*entity.Item, error) {
+	return traced(ctx, "ItemUsecase.GetItemBySerialNumber", func(ctx context.Context) (*entity.Item, error) {
+		return u.next.GetItemBySerialNumber(ctx, serialNumber)
+	})
+}
+
+func (u *ItemUsecase) CreateItem(ctx context.Context, input usecase.CreateItemInput) (*entity.Item, error) {
+	return traced(ctx, "ItemUsecase.CreateItem", func(ctx context.Context) (*entity.Item, error) { return u.next.CreateItem(ctx, input) })
+}
+
+func (u *ItemUsecase) ValidateItem(ctx context.Context, input usecase.CreateItemInput) (*entity.Item, error) {
+	return traced(ctx, "ItemUsecase.ValidateItem", func(ctx context.Context) (*entity.Item, error) { return u.next.ValidateItem(ctx, input) })
+}
+
+func (u *ItemUsecase) PartialUpdateItem(ctx context.Context, id int64, input usecase.UpdateItemInput) (*entity.Item, error) {
+	return traced(ctx, "ItemUsecase.PartialUpdateItem", func(ctx context.Context) (*entity.Item, error) { return u.next.PartialUpdateItem(ctx, id, input) })
+}
+
+func (u *ItemUsecase) DeleteItem(ctx context.Context, id int64) error {
+	return tracedErr(ctx, "ItemUsecase.DeleteItem", func(ctx context.Context) error { return u.next.DeleteItem(ctx, id) })
+}
+
+func (u *ItemUsecase) GetCategorySummary(ctx context.Context) (*usecase.CategorySummary, error) {
+	return traced(ctx, "ItemUsecase.GetCategorySummary", func(ctx context.Context) (*usecase.CategorySummary, error) { return u.next.GetCategorySummary(ctx) })
+}
+
+func (u *ItemUsecase) FindDuplicateItems(ctx context.Context) ([]*usecase.DuplicateGroup, error) {
+	return traced(ctx, "ItemUsecase.FindDuplicateItems", func(ctx context.Context) ([]*usecase.DuplicateGroup, error) { return u.next.FindDuplicateItems(ctx) })
+}
+
+func (u *ItemUsecase) MergeItems(ctx context.Context, survivorID int64, input usecase.MergeItemsInput) (*entity.Item, error) {
+	return traced(ctx, "ItemUsecase.MergeItems", func(ctx context.Context) (*entity.Item, error) { return u.next.MergeItems(ctx, survivorID, input) })
+}
+
+func (u *ItemUsecase) CloneItem(ctx context.Context, id int64, input usecase.CloneItemInput) (*entity.Item, error) {
+	return traced(ctx, "ItemUsecase.CloneItem", func(ctx context.Context) (*entity.Item, error) { return u.next.CloneItem(ctx, id, input) })
+}
+
+func (u *ItemUsecase) ArchiveItem(ctx context.Context, id int64) (*entity.Item, error) {
+	return traced(ctx, "ItemUsecase.ArchiveItem", func(ctx context.Context) (*entity.Item, error) { return u.next.ArchiveItem(ctx, id) })
+}
+
+func (u *ItemUsecase) UnarchiveItem(ctx context.Context, id int64) (*entity.Item, error) {
+	return traced(ctx, "ItemUsecase.UnarchiveItem", func(ctx context.Context) (*entity.Item, error) { return u.next.UnarchiveItem(ctx, id) })
+}
+
+func (u *ItemUsecase) GetUsage(ctx context.Context) (*usecase.Usage, error) {
+	return traced(ctx, "ItemUsecase.GetUsage", func(ctx context.Context) (*usecase.Usage, error) { return u.next.GetUsage(ctx) })
+}
+
+// LoanUsecase の呼び出しごとにスパンを作るデコレーター
+type LoanUsecase struct {
+	next usecase.LoanUsecase
+}
+
+func NewLoanUsecase(u usecase.LoanUsecase) *LoanUsecase {
+	return &LoanUsecase{next: u}
+}
+
+func (u *LoanUsecase) LendItem(ctx context.Context, itemID int64, input usecase.CreateLoanInput) (*entity.Loan, error) {
+	return traced(ctx, "LoanUsecase.LendItem", func(ctx context.Context) (*entity.Loan, error) { return u.next.LendItem(ctx, itemID, input) })
+}
+
+func (u *LoanUsecase) ReturnLoan(ctx context.Context, id int64) (*entity.Loan, error) {
+	return traced(ctx, "LoanUsecase.ReturnLoan", func(ctx context.Context) (*entity.Loan, error) { return u.next.ReturnLoan(ctx, id) })
+}
+
+func (u *LoanUsecase) GetOverdueLoans(ctx context.Context) ([]*entity.Loan, error) {
+	return traced(ctx, "LoanUsecase.GetOverdueLoans", func(ctx context.Context) ([]*entity.Loan, error) { return u.next.GetOverdueLoans(ctx) })
+}
+
+// LocationUsecase の呼び出しごとにスパンを作るデコレーター
+type LocationUsecase struct {
+	next usecase.LocationUsecase
+}
+
+func NewLocationUsecase(u usecase.LocationUsecase) *LocationUsecase {
+	return &LocationUsecase{next: u}
+}
+
+func (u *LocationUsecase) GetAllLocations(ctx context.Context) ([]*entity.Location, error) {
+	return traced(ctx, "LocationUsecase.GetAllLocations", func(ctx context.Context) ([]*entity.Location, error) { return u.next.GetAllLocations(ctx) })
+}
+
+func (u *LocationUsecase) GetLocationByID(ctx context.Context, id int64) (*entity.Location, error) {
+	return traced(ctx, "LocationUsecase.GetLocationByID", func(ctx context.Context) (*entity.Location, error) { return u.next.GetLocationByID(ctx, id) })
+}
+
+func (u *LocationUsecase) CreateLocation(ctx context.Context, input usecase.LocationInput) (*entity.Location, error) {
+	return traced(ctx, "LocationUsecase.CreateLocation", func(ctx context.Context) (*entity.Location, error) { return u.next.CreateLocation(ctx, input) })
+}
+
+func (u *LocationUsecase) UpdateLocation(ctx context.Context, id int64, input usecase.LocationInput) (*entity.Location, error) {
+	return traced(ctx, "LocationUsecase.UpdateLocation", func(ctx context.Context) (*entity.Location, error) { return u.next.UpdateLocation(ctx, id, input) })
+}
+
+func (u *LocationUsecase) DeleteLocation(ctx context.Context, id int64) error {
+	return tracedErr(ctx, "LocationUsecase.DeleteLocation", func(ctx context.Context) error { return u.next.DeleteLocation(ctx, id) })
+}
+
+func (u *LocationUsecase) MoveItem(ctx context.Context, itemID int64, input usecase.MoveItemInput) (*entity.Item, error) {
+	return traced(ctx, "LocationUsecase.MoveItem", func(ctx context.Context) (*entity.Item, error) { return u.next.MoveItem(ctx, itemID, input) })
+}
+
+func (u *LocationUsecase) GetItemLocationHistory(ctx context.Context, itemID int64) ([]*entity.LocationMove, error) {
+	return traced(ctx, "LocationUsecase.GetItemLocationHistory", func(ctx context.Context) ([]*entity.LocationMove, error) {
+		return u.next.GetItemLocationHistory(ctx, itemID)
+	})
+}
+
+// ItemTemplateUsecase の呼び出しごとにスパンを作るデコレーター
+type ItemTemplateUsecase struct {
+	next usecase.ItemTemplateUsecase
+}
+
+func NewItemTemplateUsecase(u usecase.ItemTemplateUsecase) *ItemTemplateUsecase {
+	return &ItemTemplateUsecase{next: u}
+}
+
+func (u *ItemTemplateUsecase) GetAllTemplates(ctx context.Context) ([]*entity.ItemTemplate, error) {
+	return traced(ctx, "ItemTemplateUsecase.GetAllTemplates", func(ctx context.Context) ([]*entity.ItemTemplate, error) { return u.next.GetAllTemplates(ctx) })
+}
+
+func (u *ItemTemplateUsecase) GetTemplateByID(ctx context.Context, id int64) (*entity.ItemTemplate, error) {
+	return traced(ctx, "ItemTemplateUsecase.GetTemplateByID", func(ctx context.Context) (*entity.ItemTemplate, error) { return u.next.GetTemplateByID(ctx, id) })
+}
+
+func (u *ItemTemplateUsecase) CreateTemplate(ctx context.Context, input usecase.CreateTemplateInput) (*entity.ItemTemplate, error) {
+	return traced(ctx, "ItemTemplateUsecase.CreateTemplate", func(ctx context.Context) (*entity.ItemTemplate, error) { return u.next.CreateTemplate(ctx, input) })
+}
+
+func (u *ItemTemplateUsecase) DeleteTemplate(ctx context.Context, id int64) error {
+	return tracedErr(ctx, "ItemTemplateUsecase.DeleteTemplate", func(ctx context.Context) error { return u.next.DeleteTemplate(ctx, id) })
+}
+
+func (u *ItemTemplateUsecase) CreateItemFromTemplate(ctx context.Context, templateID int64, input usecase.CreateItemFromTemplateInput) (*entity.Item, error) {
+	return traced(ctx, "ItemTemplateUsecase.CreateItemFromTemplate", func(ctx context.Context) (*entity.Item, error) {
+		return u.next.CreateItemFromTemplate(ctx, templateID, input)
+	})
+}
@@ -0,0 +1,134 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"syscall"
+	"time"
+
+	"Aicon-assignment/internal/domain/entity"
+)
+
+const (
+	HeaderEvent     = "X-Webhook-Event"
+	HeaderSignature = "X-Webhook-Signature"
+)
+
+// 送信先がサーバー自身や内部のネットワークを指している
+var ErrForbiddenAddress = errors.New("url must not point to a loopback, private or link-local address")
+
+// キャリアグレードNATの共有アドレス（netip.Addr.IsPrivate に含まれない）
+var sharedAddressSpace = netip.MustParsePrefix("100.64.0.0/10")
+
+// HTTPでWebhookを送信する
+type HTTPSender struct {
+	client   *http.Client
+	resolver *net.Resolver
+	// ループバック・プライベートなアドレスへの送信を許可する（手元で受信側を動かす開発用）
+	allowPrivateNetworks bool
+}
+
+func NewHTTPSender(allowPrivateNetworks bool) *HTTPSender {
+	s := &HTTPSender{
+		resolver:             net.DefaultResolver,
+		allowPrivateNetworks: allowPrivateNetworks,
+	}
+
+	dialer := &net.Dialer{Timeout: 5 * time.Second, Control: s.checkDial}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	// プロキシを経由すると実際の送信先のアドレスを確かめられないため使わない
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+	s.client = &http.Client{Timeout: 10 * time.Second, Transport: transport}
+
+	return s
+}
+
+func (s *HTTPSender) Send(ctx context.Context, webhook *entity.Webhook, eventType string, payload []byte) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.URL, bytes.NewReader(payload))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderEvent, eventType)
+	req.Header.Set(HeaderSignature, Sign(webhook.Secret, payload))
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	// コネクションを再利用できるようにボディを読み捨てる
+	io.Copy(io.Discard, resp.Body)
+
+	return resp.StatusCode, nil
+}
+
+// 登録時にURLのホストを名前解決し、1つでも許可しないアドレスがあれば失敗する
+func (s *HTTPSender) CheckURL(ctx context.Context, rawURL string) error {
+	parsed, err := url.Parse(rawURL)
+	if err != nil {
+		return err
+	}
+
+	host := parsed.Hostname()
+	if addr, err := netip.ParseAddr(host); err == nil {
+		return s.checkAddr(addr)
+	}
+
+	addrs, err := s.resolver.LookupNetIP(ctx, "ip", host)
+	if err != nil {
+		return fmt.Errorf("failed to resolve url host %s", host)
+	}
+	for _, addr := range addrs {
+		if err := s.checkAddr(addr); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// 名前解決の後、実際に接続する直前にアドレスを確かめる
+// 登録後にDNSの向き先を変えられた場合や、リダイレクトで内部のアドレスへ誘導された場合も送らない
+func (s *HTTPSender) checkDial(network, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	addr, err := netip.ParseAddr(host)
+	if err != nil {
+		return err
+	}
+	return s.checkAddr(addr)
+}
+
+func (s *HTTPSender) checkAddr(addr netip.Addr) error {
+	addr = addr.Unmap()
+	if s.allowPrivateNetworks || !isForbiddenAddr(addr) {
+		return nil
+	}
+	return fmt.Errorf("%w: %s", ErrForbiddenAddress, addr)
+}
+
+// ループバック・プライベート・リンクローカル（クラウドのメタデータを含む）・未指定・マルチキャストのアドレス
+func isForbiddenAddr(addr netip.Addr) bool {
+	return addr.IsLoopback() || addr.IsPrivate() || addr.IsLinkLocalUnicast() || addr.IsLinkLocalMulticast() ||
+		addr.IsInterfaceLocalMulticast() || addr.IsMulticast() || addr.IsUnspecified() || sharedAddressSpace.Contains(addr)
+}
+
+// 受信側で検証できるようにボディのHMAC-SHA256を "sha256=<hex>" 形式で返す
+func Sign(secret string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,66 @@
+package webhook
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"Aicon-assignment/internal/domain/entity"
+)
+
+func TestHTTPSender_CheckURL(t *testing.T) {
+	tests := []struct {
+		name    string
+		url     string
+		wantErr bool
+	}{
+		{name: "正常系: グローバルなアドレス", url: "https://93.184.216.34/hooks"},
+		{name: "異常系: ループバック", url: "http://127.0.0.1:8080/hooks", wantErr: true},
+		{name: "異常系: IPv6のループバック", url: "http://[::1]/hooks", wantErr: true},
+		{name: "異常系: プライベートなアドレス", url: "http://10.0.0.5/hooks", wantErr: true},
+		{name: "異常系: クラウドのメタデータ（リンクローカル）", url: "http://169.254.169.254/latest/meta-data/", wantErr: true},
+		{name: "異常系: IPv4射影アドレス", url: "http://[::ffff:192.168.0.1]/hooks", wantErr: true},
+		{name: "異常系: localhost", url: "http://localhost/hooks", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := NewHTTPSender(false).CheckURL(context.Background(), tt.url)
+
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrForbiddenAddress)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+
+	t.Run("正常系: 許可した場合はプライベートなアドレスも登録できる", func(t *testing.T) {
+		assert.NoError(t, NewHTTPSender(true).CheckURL(context.Background(), "http://127.0.0.1:8080/hooks"))
+	})
+}
+
+func TestHTTPSender_Send(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+	webhook := &entity.Webhook{URL: server.URL, Secret: "0123456789abcdef"}
+
+	t.Run("異常系: 接続先がループバックなら送らない", func(t *testing.T) {
+		_, err := NewHTTPSender(false).Send(context.Background(), webhook, entity.EventItemCreated, []byte(`{}`))
+
+		assert.ErrorIs(t, err, ErrForbiddenAddress)
+	})
+
+	t.Run("正常系: 許可した場合は送る", func(t *testing.T) {
+		status, err := NewHTTPSender(true).Send(context.Background(), webhook, entity.EventItemCreated, []byte(`{}`))
+
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusNoContent, status)
+	})
+}
@@ -0,0 +1,191 @@
+package cli
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"Aicon-assignment/internal/domain/entity"
+	"Aicon-assignment/internal/usecase"
+)
+
+// CSVの列（カスタム属性は "attr.<キー>" の列にする）
+var itemColumns = []string{"name", "category", "brand", "purchase_price", "purchase_date", "condition", "serial_number"}
+
+const attributeColumnPrefix = "attr."
+
+// 1行ごとの取り込みの失敗
+type RowError struct {
+	Line int
+	Err  error
+}
+
+func (e *RowError) Error() string {
+	return fmt.Sprintf("line %d: %v", e.Line, e.Err)
+}
+
+func (e *RowError) Unwrap() error {
+	return e.Err
+}
+
+// 1行目を見出しとしてCSVのアイテムを1件ずつ登録する
+// 失敗した行は飛ばして続け、登録した件数と失敗した行を返す
+func ImportCSV(ctx context.Context, itemUsecase usecase.ItemUsecase, r io.Reader) (int, []*RowError, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if err != nil {
+		return 0, nil, fmt.Errorf("failed to read CSV header: %w", err)
+	}
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		// Excelで保存したCSVの先頭に付くBOMは取り除く
+		columns[strings.TrimSpace(strings.TrimPrefix(name, "\ufeff"))] = i
+	}
+	for _, required := range []string{"name", "category", "brand", "purchase_price", "purchase_date"} {
+		if _, ok := columns[required]; !ok {
+			return 0, nil, fmt.Errorf("CSV header must contain %q", required)
+		}
+	}
+
+	imported := 0
+	var rowErrors []*RowError
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		line, _ := reader.FieldPos(0)
+		if err != nil {
+			rowErrors = append(rowErrors, &RowError{Line: line, Err: err})
+			continue
+		}
+
+		input, err := toCreateItemInput(columns, record)
+		if err == nil {
+			_, err = itemUsecase.CreateItem(ctx, input)
+		}
+		if err != nil {
+			rowErrors = append(rowErrors, &RowError{Line: line, Err: err})
+			continue
+		}
+		imported++
+	}
+
+	return imported, rowErrors, nil
+}
+
+func toCreateItemInput(columns map[string]int, record []string) (usecase.CreateItemInput, error) {
+	value := func(name string) string {
+		i, ok := columns[name]
+		if !ok || i >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[i])
+	}
+
+	input := usecase.CreateItemInput{
+		Name:         value("name"),
+		Category:     value("category"),
+		Brand:        value("brand"),
+		PurchaseDate: value("purchase_date"),
+		Condition:    value("condition"),
+		SerialNumber: value("serial_number"),
+	}
+
+	price, err := strconv.Atoi(value("purchase_price"))
+	if err != nil {
+		return input, fmt.Errorf("purchase_price must be an integer, got %q", value("purchase_price"))
+	}
+	input.PurchasePrice = price
+
+	for name := range columns {
+		key, ok := strings.CutPrefix(name, attributeColumnPrefix)
+		if !ok || value(name) == "" {
+			continue
+		}
+		if input.Attributes == nil {
+			input.Attributes = map[string]string{}
+		}
+		input.Attributes[key] = value(name)
+	}
+
+	return input, nil
+}
+
+// 全てのアイテムをCSVで書き出す（ImportCSVでそのまま取り込める形式）
+func ExportCSV(ctx context.Context, itemUsecase usecase.ItemUsecase, w io.Writer) (int, error) {
+	items, err := itemUsecase.GetAllItems(ctx, entity.ItemFilter{IncludeArchived: true})
+	if err != nil {
+		return 0, err
+	}
+
+	// 属性の列はいずれかのアイテムが持つキーを全て並べる
+	keySet := map[string]struct{}{}
+	for _, item := range items {
+		for key := range item.Attributes {
+			keySet[key] = struct{}{}
+		}
+	}
+	keys := make([]string, 0, len(keySet))
+	for key := range keySet {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	writer := csv.NewWriter(w)
+	header := append([]string{"id"}, itemColumns...)
+	for _, key := range keys {
+		header = append(header, attributeColumnPrefix+key)
+	}
+	if err := writer.Write(header); err != nil {
+		return 0, err
+	}
+
+	for _, item := range items {
+		record := []string{
+			strconv.FormatInt(item.ID, 10),
+			item.Name,
+			item.Category,
+			item.Brand,
+			strconv.Itoa(item.PurchasePrice),
+			item.PurchaseDate,
+			item.Condition,
+			item.SerialNumber,
+		}
+		for _, key := range keys {
+			record = append(record, item.Attributes[key])
+		}
+		if err := writer.Write(record); err != nil {
+			return 0, err
+		}
+	}
+
+	writer.Flush()
+	return len(items), writer.Error()
+}
+
+// 全てのアイテムをAPIと同じ形のJSONの配列で書き出す
+func ExportJSON(ctx context.Context, itemUsecase usecase.ItemUsecase, w io.Writer) (int, error) {
+	items, err := itemUsecase.GetAllItems(ctx, entity.ItemFilter{IncludeArchived: true})
+	if err != nil {
+		return 0, err
+	}
+	if items == nil {
+		items = []*entity.Item{}
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(items); err != nil {
+		return 0, err
+	}
+	return len(items), nil
+}
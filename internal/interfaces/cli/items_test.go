@@ -0,0 +1,143 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"Aicon-assignment/internal/domain/entity"
+	"Aicon-assignment/internal/interfaces/memory"
+	"Aicon-assignment/internal/usecase"
+)
+
+func newItemUsecase() usecase.ItemUsecase {
+	store := memory.NewStore()
+	return usecase.NewItemUsecase(&memory.ItemRepository{Store: store}, &memory.Transactor{Store: store})
+}
+
+func TestImportCSV(t *testing.T) {
+	t.Run("正常系: 見出しの列名でアイテムと属性を登録する", func(t *testing.T) {
+		items := newItemUsecase()
+		input := "\ufeffbrand,name,category,purchase_price,purchase_date,condition,serial_number,attr.dial_color\n" +
+			"ROLEX,ロレックス デイトナ,時計,1500000,2023-01-15,A,116500LN,black\n" +
+			"HERMES,エルメス バーキン,バッグ,2000000,2023-02-20,,,\n"
+
+		imported, rowErrors, err := ImportCSV(context.Background(), items, strings.NewReader(input))
+
+		require.NoError(t, err)
+		assert.Equal(t, 2, imported)
+		assert.Empty(t, rowErrors)
+
+		all, err := items.GetAllItems(context.Background(), entity.ItemFilter{})
+		require.NoError(t, err)
+		require.Len(t, all, 2)
+		byBrand := map[string]*entity.Item{}
+		for _, item := range all {
+			byBrand[item.Brand] = item
+		}
+		assert.Equal(t, "ロレックス デイトナ", byBrand["ROLEX"].Name)
+		assert.Equal(t, map[string]string{"dial_color": "black"}, byBrand["ROLEX"].Attributes)
+		assert.Empty(t, byBrand["HERMES"].Attributes)
+	})
+
+	t.Run("異常系: 不正な行は行番号とともに返し、残りの行は登録する", func(t *testing.T) {
+		items := newItemUsecase()
+		input := "name,category,brand,purchase_price,purchase_date\n" +
+			"ロレックス デイトナ,時計,ROLEX,abc,2023-01-15\n" +
+			"エルメス バーキン,未知のカテゴリー,HERMES,2000000,2023-02-20\n" +
+			"グッチ ローファー,靴,GUCCI,100000,2023-03-01\n"
+
+		imported, rowErrors, err := ImportCSV(context.Background(), items, strings.NewReader(input))
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, imported)
+		require.Len(t, rowErrors, 2)
+		assert.Equal(t, 2, rowErrors[0].Line)
+		assert.Contains(t, rowErrors[0].Error(), "purchase_price")
+		assert.Equal(t, 3, rowErrors[1].Line)
+	})
+
+	t.Run("異常系: 必須の列が見出しにない", func(t *testing.T) {
+		_, _, err := ImportCSV(context.Background(), newItemUsecase(), strings.NewReader("name,category\n"))
+
+		assert.ErrorContains(t, err, `"brand"`)
+	})
+}
+
+func TestExport(t *testing.T) {
+	input := "name,category,brand,purchase_price,purchase_date,serial_number,attr.dial_color\n" +
+		"ロレックス デイトナ,時計,ROLEX,1500000,2023-01-15,\"116500LN, 2023\",black\n"
+
+	t.Run("正常系: CSVで書き出した内容はそのまま取り込める", func(t *testing.T) {
+		items := newItemUsecase()
+		_, _, err := ImportCSV(context.Background(), items, strings.NewReader(input))
+		require.NoError(t, err)
+
+		var exported bytes.Buffer
+		count, err := ExportCSV(context.Background(), items, &exported)
+		require.NoError(t, err)
+		assert.Equal(t, 1, count)
+		assert.True(t, strings.HasPrefix(exported.String(),
+			"id,name,category,brand,purchase_price,purchase_date,condition,serial_number,attr.dial_color\n"))
+
+		restored := newItemUsecase()
+		imported, rowErrors, err := ImportCSV(context.Background(), restored, &exported)
+		require.NoError(t, err)
+		assert.Equal(t, 1, imported)
+		assert.Empty(t, rowErrors)
+
+		all, err := restored.GetAllItems(context.Background(), entity.ItemFilter{})
+		require.NoError(t, err)
+		require.Len(t, all, 1)
+		assert.Equal(t, "116500LN, 2023", all[0].SerialNumber)
+		assert.Equal(t, map[string]string{"dial_color": "black"}, all[0].Attributes)
+	})
+
+	t.Run("正常系: JSONの配列で書き出す", func(t *testing.T) {
+		items := newItemUsecase()
+		_, _, err := ImportCSV(context.Background(), items, strings.NewReader(input))
+		require.NoError(t, err)
+
+		var exported bytes.Buffer
+		count, err := ExportJSON(context.Background(), items, &exported)
+		require.NoError(t, err)
+		assert.Equal(t, 1, count)
+
+		var decoded []entity.Item
+		require.NoError(t, json.Unmarshal(exported.Bytes(), &decoded))
+		require.Len(t, decoded, 1)
+		assert.Equal(t, "ROLEX", decoded[0].Brand)
+	})
+}
+
+func TestSeed(t *testing.T) {
+	t.Run("正常系: 全てのカテゴリーとブランドのアイテムを登録する", func(t *testing.T) {
+		items := newItemUsecase()
+
+		created, err := Seed(context.Background(), items, len(sampleProducts)*2, rand.New(rand.NewSource(1)))
+
+		require.NoError(t, err)
+		assert.Equal(t, len(sampleProducts)*2, created)
+
+		all, err := items.GetAllItems(context.Background(), entity.ItemFilter{})
+		require.NoError(t, err)
+		assert.Len(t, all, len(sampleProducts)*2)
+
+		categories := map[string]bool{}
+		brands := map[string]bool{}
+		for _, item := range all {
+			categories[item.Category] = true
+			brands[item.Brand] = true
+		}
+		assert.Len(t, categories, len(entity.ValidCategories))
+		for _, product := range sampleProducts {
+			assert.True(t, brands[product.brand], product.brand)
+		}
+	})
+}
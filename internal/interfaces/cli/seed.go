@@ -0,0 +1,125 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"strings"
+	"time"
+
+	"Aicon-assignment/internal/domain/entity"
+	domainErrors "Aicon-assignment/internal/domain/errors"
+	"Aicon-assignment/internal/usecase"
+)
+
+// サンプルデータの元になる商品
+type sampleProduct struct {
+	category   string
+	brand      string
+	name       string
+	minPrice   int
+	maxPrice   int
+	attributes func(rng *rand.Rand) map[string]string
+}
+
+// 全てのカテゴリーとブランドを含む商品の一覧
+var sampleProducts = []sampleProduct{
+	{category: "時計", brand: "ROLEX", name: "ロレックス デイトナ", minPrice: 1500000, maxPrice: 4500000, attributes: watchAttributes("automatic", "40mm")},
+	{category: "時計", brand: "ROLEX", name: "ロレックス サブマリーナ", minPrice: 1200000, maxPrice: 2500000, attributes: watchAttributes("automatic", "41mm")},
+	{category: "時計", brand: "OMEGA", name: "オメガ スピードマスター", minPrice: 600000, maxPrice: 1200000, attributes: watchAttributes("manual", "42mm")},
+	{category: "時計", brand: "Cartier", name: "カルティエ タンク", minPrice: 400000, maxPrice: 900000, attributes: watchAttributes("quartz", "31mm")},
+	{category: "時計", brand: "Grand Seiko", name: "グランドセイコー 白樺", minPrice: 800000, maxPrice: 1100000, attributes: watchAttributes("automatic", "40mm")},
+	{category: "バッグ", brand: "HERMÈS", name: "エルメス バーキン", minPrice: 2000000, maxPrice: 5000000, attributes: bagAttributes("トゴ", "30")},
+	{category: "バッグ", brand: "HERMÈS", name: "エルメス ケリー", minPrice: 1800000, maxPrice: 4000000, attributes: bagAttributes("エプソン", "28")},
+	{category: "バッグ", brand: "CHANEL", name: "シャネル マトラッセ", minPrice: 800000, maxPrice: 1600000, attributes: bagAttributes("ラムスキン", "25")},
+	{category: "バッグ", brand: "LOUIS VUITTON", name: "ルイ・ヴィトン ネヴァーフル", minPrice: 200000, maxPrice: 350000, attributes: bagAttributes("モノグラム", "MM")},
+	{category: "ジュエリー", brand: "Tiffany & Co.", name: "ティファニー ネックレス", minPrice: 100000, maxPrice: 500000},
+	{category: "ジュエリー", brand: "Cartier", name: "カルティエ ラブブレスレット", minPrice: 800000, maxPrice: 1200000},
+	{category: "ジュエリー", brand: "Van Cleef & Arpels", name: "ヴァンクリーフ&アーペル アルハンブラ", minPrice: 400000, maxPrice: 900000},
+	{category: "ジュエリー", brand: "BVLGARI", name: "ブルガリ ビー・ゼロワン リング", minPrice: 200000, maxPrice: 450000},
+	{category: "靴", brand: "Christian Louboutin", name: "ルブタン パンプス", minPrice: 100000, maxPrice: 200000},
+	{category: "靴", brand: "JIMMY CHOO", name: "ジミーチュウ サンダル", minPrice: 80000, maxPrice: 150000},
+	{category: "靴", brand: "John Lobb", name: "ジョンロブ シティ2", minPrice: 200000, maxPrice: 300000},
+	{category: "靴", brand: "GUCCI", name: "グッチ ホースビット ローファー", minPrice: 90000, maxPrice: 140000},
+	{category: "その他", brand: "Apple", name: "アップルウォッチ", minPrice: 50000, maxPrice: 150000},
+	{category: "その他", brand: "Leica", name: "ライカ M11", minPrice: 1000000, maxPrice: 1300000},
+	{category: "その他", brand: "Montblanc", name: "モンブラン マイスターシュテュック", minPrice: 60000, maxPrice: 120000},
+}
+
+var sampleColors = []string{"ブラック", "ブラウン", "ホワイト", "ゴールド", "ネイビー"}
+
+func watchAttributes(movement, caseSize string) func(rng *rand.Rand) map[string]string {
+	return func(rng *rand.Rand) map[string]string {
+		return map[string]string{
+			"movement":   movement,
+			"case_size":  caseSize,
+			"dial_color": sampleColors[rng.Intn(len(sampleColors))],
+		}
+	}
+}
+
+func bagAttributes(material, size string) func(rng *rand.Rand) map[string]string {
+	return func(rng *rand.Rand) map[string]string {
+		return map[string]string{
+			"material": material,
+			"color":    sampleColors[rng.Intn(len(sampleColors))],
+			"size":     size,
+		}
+	}
+}
+
+// サンプルのアイテムを count 件登録する
+// 商品の一覧を順に使うため、件数が一覧以上なら全てのカテゴリーとブランドが含まれる
+func Seed(ctx context.Context, itemUsecase usecase.ItemUsecase, count int, rng *rand.Rand) (int, error) {
+	today := time.Now()
+
+	for i := 0; i < count; i++ {
+		product := sampleProducts[i%len(sampleProducts)]
+
+		input := usecase.CreateItemInput{
+			Name:          product.name,
+			Category:      product.category,
+			Brand:         product.brand,
+			PurchasePrice: roundPrice(product.minPrice + rng.Intn(product.maxPrice-product.minPrice+1)),
+			PurchaseDate:  today.AddDate(0, 0, -rng.Intn(5*365)).Format("2006-01-02"),
+		}
+		// 1割ほどは未評価のままにする
+		if rng.Intn(10) > 0 {
+			input.Condition = entity.ValidConditions[rng.Intn(len(entity.ValidConditions))]
+		}
+		// 時計はシリアル番号が必須のため常に付け、他のカテゴリーは半分ほどに付ける
+		if product.category == "時計" || rng.Intn(2) == 0 {
+			input.SerialNumber = sampleSerialNumber(product.brand, rng)
+		}
+		if product.attributes != nil {
+			input.Attributes = product.attributes(rng)
+		}
+
+		// 乱数のシリアル番号が登録済みのものと重なった場合は付け直す
+		_, err := itemUsecase.CreateItem(ctx, input)
+		for retry := 0; errors.Is(err, domainErrors.ErrDuplicateSerial) && retry < 3; retry++ {
+			input.SerialNumber = sampleSerialNumber(product.brand, rng)
+			_, err = itemUsecase.CreateItem(ctx, input)
+		}
+		if err != nil {
+			return i, fmt.Errorf("failed to create sample item %q: %w", product.name, err)
+		}
+	}
+
+	return count, nil
+}
+
+// 価格は千円単位にそろえる
+func roundPrice(price int) int {
+	return price / 1000 * 1000
+}
+
+// ブランドの頭文字と乱数のシリアル番号（例: RO-4821937）
+func sampleSerialNumber(brand string, rng *rand.Rand) string {
+	prefix := strings.ToUpper(strings.ReplaceAll(brand, " ", ""))
+	if len(prefix) > 2 {
+		prefix = prefix[:2]
+	}
+	return fmt.Sprintf("%s-%07d", prefix, rng.Intn(10000000))
+}
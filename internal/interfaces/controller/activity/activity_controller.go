@@ -0,0 +1,51 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"Aicon-assignment/internal/domain/entity"
+	"Aicon-assignment/internal/interfaces/problem"
+	"Aicon-assignment/internal/usecase"
+
+	"github.com/labstack/echo/v4"
+)
+
+type ActivityHandler struct {
+	activityUsecase usecase.ActivityUsecase
+}
+
+func NewActivityHandler(activityUsecase usecase.ActivityUsecase) *ActivityHandler {
+	return &ActivityHandler{
+		activityUsecase: activityUsecase,
+	}
+}
+
+// GetActivity GET /activity エンドポイント（type はカンマ区切りで複数指定できる）
+func (h *ActivityHandler) GetActivity(c echo.Context) error {
+	query := entity.ActivityQuery{Limit: entity.DefaultActivityLimit}
+	for _, value := range c.QueryParams()["type"] {
+		for _, activityType := range strings.Split(value, ",") {
+			if activityType = strings.TrimSpace(activityType); activityType != "" {
+				query.Types = append(query.Types, activityType)
+			}
+		}
+	}
+	for name, target := range map[string]*int{"limit": &query.Limit, "offset": &query.Offset} {
+		if value := c.QueryParam(name); value != "" {
+			n, err := strconv.Atoi(value)
+			if err != nil {
+				return problem.Write(c, problem.New(http.StatusBadRequest, "invalid_filter").WithDetails(name+" must be an integer"))
+			}
+			*target = n
+		}
+	}
+
+	page, err := h.activityUsecase.GetActivity(c.Request().Context(), query)
+	if err != nil {
+		return problem.Write(c, problem.FromError(err, "failed_to_retrieve_activity"))
+	}
+
+	return c.JSON(http.StatusOK, page)
+}
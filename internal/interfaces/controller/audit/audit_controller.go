@@ -0,0 +1,30 @@
+package controller
+
+import (
+	"net/http"
+
+	"Aicon-assignment/internal/interfaces/problem"
+	"Aicon-assignment/internal/usecase"
+
+	"github.com/labstack/echo/v4"
+)
+
+type AuditLogHandler struct {
+	auditLogUsecase usecase.AuditLogUsecase
+}
+
+func NewAuditLogHandler(auditLogUsecase usecase.AuditLogUsecase) *AuditLogHandler {
+	return &AuditLogHandler{
+		auditLogUsecase: auditLogUsecase,
+	}
+}
+
+// GetAuditLog GET /admin/audit-log エンドポイント
+func (h *AuditLogHandler) GetAuditLog(c echo.Context) error {
+	entries, err := h.auditLogUsecase.GetAuditLog(c.Request().Context())
+	if err != nil {
+		return problem.Write(c, problem.New(http.StatusInternalServerError, "failed_to_retrieve_audit_log"))
+	}
+
+	return c.JSON(http.StatusOK, entries)
+}
@@ -0,0 +1,61 @@
+package controller
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"Aicon-assignment/internal/domain/entity"
+	"Aicon-assignment/internal/interfaces/problem"
+	"Aicon-assignment/internal/usecase"
+
+	"github.com/labstack/echo/v4"
+)
+
+type BackupHandler struct {
+	backupUsecase usecase.BackupUsecase
+}
+
+func NewBackupHandler(backupUsecase usecase.BackupUsecase) *BackupHandler {
+	return &BackupHandler{
+		backupUsecase: backupUsecase,
+	}
+}
+
+// Backup POST /admin/backup エンドポイント
+// 保存しやすいよう、作成日時入りのファイル名で添付ファイルとして返す
+func (h *BackupHandler) Backup(c echo.Context) error {
+	backup, err := h.backupUsecase.Backup(c.Request().Context())
+	if err != nil {
+		return problem.Write(c, problem.New(http.StatusInternalServerError, "failed_to_create_backup"))
+	}
+
+	filename := fmt.Sprintf("backup-%s.json", backup.CreatedAt.Format("20060102-150405"))
+	c.Response().Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%q", filename))
+	return c.JSON(http.StatusOK, backup)
+}
+
+// Restore POST /admin/restore エンドポイント
+// データが残っている場合は ?force=true を指定したときだけ置き換える
+func (h *BackupHandler) Restore(c echo.Context) error {
+	force := false
+	if value := c.QueryParam("force"); value != "" {
+		parsed, err := strconv.ParseBool(value)
+		if err != nil {
+			return problem.Write(c, problem.New(http.StatusBadRequest, "invalid_force"))
+		}
+		force = parsed
+	}
+
+	var backup entity.Backup
+	if err := c.Bind(&backup); err != nil {
+		return problem.Write(c, problem.New(http.StatusBadRequest, "invalid_request_format"))
+	}
+
+	result, err := h.backupUsecase.Restore(c.Request().Context(), &backup, force)
+	if err != nil {
+		return problem.Write(c, problem.FromError(err, "failed_to_restore_backup"))
+	}
+
+	return c.JSON(http.StatusOK, result)
+}
@@ -0,0 +1,85 @@
+package controller
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	"Aicon-assignment/internal/domain/entity"
+	"Aicon-assignment/internal/interfaces/i18n"
+	"Aicon-assignment/internal/interfaces/problem"
+	"Aicon-assignment/internal/interfaces/resource"
+	"Aicon-assignment/internal/usecase"
+
+	"github.com/labstack/echo/v4"
+)
+
+// 公開カタログのパス（APIのバージョンとは関係なく同じURLで見られるようにする）
+const PublicPath = "/public"
+
+// 公開カタログはWebサイトから何度も読まれるため、短い間はキャッシュさせる
+const catalogCacheControl = "public, max-age=60"
+
+// 公開カタログのアイテム（カテゴリーを公開する場合は Accept-Language の言語の表示名を加える）
+type catalogItemResponse struct {
+	*entity.CatalogItem
+	CategoryLabel string `json:"category_label,omitempty"`
+}
+
+func newCatalogItemResponse(item *entity.CatalogItem, lang i18n.Lang) *catalogItemResponse {
+	response := &catalogItemResponse{CatalogItem: item}
+	if item.Category != "" {
+		response.CategoryLabel = i18n.CategoryName(lang, item.Category)
+	}
+	return response
+}
+
+type CatalogHandler struct {
+	catalogUsecase usecase.CatalogUsecase
+}
+
+func NewCatalogHandler(catalogUsecase usecase.CatalogUsecase) *CatalogHandler {
+	return &CatalogHandler{
+		catalogUsecase: catalogUsecase,
+	}
+}
+
+// GetCatalogItems GET /public/items エンドポイント
+func (h *CatalogHandler) GetCatalogItems(c echo.Context) error {
+	category := c.QueryParam("category")
+	if category != "" && !slices.Contains(entity.GetValidCategories(), category) {
+		detail := fmt.Sprintf("category must be one of: %s", strings.Join(entity.GetValidCategories(), ", "))
+		return problem.Write(c, problem.New(http.StatusBadRequest, "invalid_filter").WithDetails(detail))
+	}
+
+	items, err := h.catalogUsecase.GetCatalogItems(c.Request().Context(), category)
+	if err != nil {
+		return problem.Write(c, problem.FromError(err, "failed_to_retrieve_items"))
+	}
+
+	lang := resource.Lang(c)
+	response := make([]*catalogItemResponse, 0, len(items))
+	for _, item := range items {
+		response = append(response, newCatalogItemResponse(item, lang))
+	}
+	c.Response().Header().Set("Cache-Control", catalogCacheControl)
+	return c.JSON(http.StatusOK, response)
+}
+
+// GetCatalogItem GET /public/items/{id} エンドポイント
+func (h *CatalogHandler) GetCatalogItem(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return problem.Write(c, problem.New(http.StatusBadRequest, "invalid_item_id"))
+	}
+
+	item, err := h.catalogUsecase.GetCatalogItem(c.Request().Context(), id)
+	if err != nil {
+		return problem.Write(c, problem.FromError(err, "failed_to_retrieve_item"))
+	}
+
+	c.Response().Header().Set("Cache-Control", catalogCacheControl)
+	return c.JSON(http.StatusOK, newCatalogItemResponse(item, resource.Lang(c)))
+}
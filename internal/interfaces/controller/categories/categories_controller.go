@@ -0,0 +1,35 @@
+package controller
+
+import (
+	"net/http"
+
+	"Aicon-assignment/internal/interfaces/problem"
+	"Aicon-assignment/internal/usecase"
+
+	"github.com/labstack/echo/v4"
+)
+
+type CategoryHandler struct {
+	categoryUsecase usecase.CategoryUsecase
+}
+
+func NewCategoryHandler(categoryUsecase usecase.CategoryUsecase) *CategoryHandler {
+	return &CategoryHandler{
+		categoryUsecase: categoryUsecase,
+	}
+}
+
+// ReassignCategory POST /categories/{from}/reassign エンドポイント
+func (h *CategoryHandler) ReassignCategory(c echo.Context) error {
+	var input usecase.ReassignCategoryInput
+	if err := c.Bind(&input); err != nil {
+		return problem.Write(c, problem.New(http.StatusBadRequest, "invalid_request_format"))
+	}
+
+	reassignment, err := h.categoryUsecase.ReassignCategory(c.Request().Context(), c.Param("from"), input)
+	if err != nil {
+		return problem.Write(c, problem.FromError(err, "failed_to_reassign_category"))
+	}
+
+	return c.JSON(http.StatusOK, reassignment)
+}
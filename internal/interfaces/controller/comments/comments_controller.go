@@ -0,0 +1,74 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	"Aicon-assignment/internal/interfaces/problem"
+	"Aicon-assignment/internal/usecase"
+
+	"github.com/labstack/echo/v4"
+)
+
+type ItemCommentHandler struct {
+	commentUsecase usecase.ItemCommentUsecase
+}
+
+func NewItemCommentHandler(commentUsecase usecase.ItemCommentUsecase) *ItemCommentHandler {
+	return &ItemCommentHandler{
+		commentUsecase: commentUsecase,
+	}
+}
+
+// CreateComment POST /items/{id}/comments エンドポイント
+func (h *ItemCommentHandler) CreateComment(c echo.Context) error {
+	itemID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return problem.Write(c, problem.New(http.StatusBadRequest, "invalid_item_id"))
+	}
+
+	var input usecase.CreateItemCommentInput
+	if err := c.Bind(&input); err != nil {
+		return problem.Write(c, problem.New(http.StatusBadRequest, "invalid_request_format"))
+	}
+
+	comment, err := h.commentUsecase.CreateComment(c.Request().Context(), itemID, input)
+	if err != nil {
+		return problem.Write(c, problem.FromError(err, "failed_to_create_comment"))
+	}
+
+	return c.JSON(http.StatusCreated, comment)
+}
+
+// GetComments GET /items/{id}/comments エンドポイント
+func (h *ItemCommentHandler) GetComments(c echo.Context) error {
+	itemID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return problem.Write(c, problem.New(http.StatusBadRequest, "invalid_item_id"))
+	}
+
+	comments, err := h.commentUsecase.GetComments(c.Request().Context(), itemID)
+	if err != nil {
+		return problem.Write(c, problem.FromError(err, "failed_to_retrieve_comments"))
+	}
+
+	return c.JSON(http.StatusOK, comments)
+}
+
+// DeleteComment DELETE /items/{id}/comments/{commentID} エンドポイント
+func (h *ItemCommentHandler) DeleteComment(c echo.Context) error {
+	itemID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return problem.Write(c, problem.New(http.StatusBadRequest, "invalid_item_id"))
+	}
+	id, err := strconv.ParseInt(c.Param("commentID"), 10, 64)
+	if err != nil {
+		return problem.Write(c, problem.New(http.StatusBadRequest, "invalid_comment_id"))
+	}
+
+	if err := h.commentUsecase.DeleteComment(c.Request().Context(), itemID, id); err != nil {
+		return problem.Write(c, problem.FromError(err, "failed_to_delete_comment"))
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
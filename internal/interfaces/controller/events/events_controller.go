@@ -0,0 +1,130 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"sync"
+	"time"
+
+	"Aicon-assignment/internal/domain/event"
+
+	"github.com/labstack/echo/v4"
+)
+
+// 接続を維持するためのコメントを送る間隔
+const heartbeatInterval = 15 * time.Second
+
+// 遅いクライアントのために溜めておけるイベント数（溢れた分は捨てる）
+const subscriberBufferSize = 32
+
+// SSEで送る1件分のイベント
+type sseMessage struct {
+	id        uint64
+	eventType string
+	data      []byte
+}
+
+// アイテムの変更をServer-Sent Eventsで配信する
+type EventHandler struct {
+	mu          sync.Mutex
+	subscribers map[chan sseMessage]struct{}
+	nextID      uint64
+	closed      chan struct{}
+	closeOnce   sync.Once
+}
+
+func NewEventHandler() *EventHandler {
+	return &EventHandler{
+		subscribers: make(map[chan sseMessage]struct{}),
+		closed:      make(chan struct{}),
+	}
+}
+
+// アイテムのイベントを接続中の全クライアントに送る
+func (h *EventHandler) HandleEvent(_ context.Context, e event.Event) {
+	itemEvent, ok := e.(event.ItemEvent)
+	if !ok {
+		return
+	}
+
+	data, err := json.Marshal(itemEvent.Item())
+	if err != nil {
+		log.Printf("failed to encode event %s: %v", e.Name(), err)
+		return
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.nextID++
+	message := sseMessage{id: h.nextID, eventType: e.Name(), data: data}
+	for ch := range h.subscribers {
+		select {
+		case ch <- message:
+		default:
+			// 受信が追いつかないクライアントのためにアイテム操作を止めない
+		}
+	}
+}
+
+// Stream GET /events エンドポイント
+func (h *EventHandler) Stream(c echo.Context) error {
+	ch := h.subscribe()
+	defer h.unsubscribe(ch)
+
+	res := c.Response()
+	res.Header().Set(echo.HeaderContentType, "text/event-stream")
+	res.Header().Set(echo.HeaderCacheControl, "no-cache")
+	res.Header().Set(echo.HeaderConnection, "keep-alive")
+	res.Header().Set("X-Accel-Buffering", "no")
+	res.WriteHeader(http.StatusOK)
+	res.Flush()
+
+	heartbeat := time.NewTicker(heartbeatInterval)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-c.Request().Context().Done():
+			return nil
+		case <-h.closed:
+			return nil
+		case <-heartbeat.C:
+			if _, err := fmt.Fprint(res, ": keepalive\n\n"); err != nil {
+				return nil
+			}
+			res.Flush()
+		case message := <-ch:
+			if _, err := fmt.Fprintf(res, "id: %d\nevent: %s\ndata: %s\n\n", message.id, message.eventType, message.data); err != nil {
+				return nil
+			}
+			res.Flush()
+		}
+	}
+}
+
+// 接続中のストリームを全て終了する（サーバー停止時に呼ぶ）
+func (h *EventHandler) Close() {
+	h.closeOnce.Do(func() {
+		close(h.closed)
+	})
+}
+
+func (h *EventHandler) subscribe() chan sseMessage {
+	ch := make(chan sseMessage, subscriberBufferSize)
+
+	h.mu.Lock()
+	h.subscribers[ch] = struct{}{}
+	h.mu.Unlock()
+
+	return ch
+}
+
+func (h *EventHandler) unsubscribe(ch chan sseMessage) {
+	h.mu.Lock()
+	delete(h.subscribers, ch)
+	h.mu.Unlock()
+}
@@ -0,0 +1,76 @@
+package controller
+
+import (
+	"bufio"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"Aicon-assignment/internal/domain/entity"
+	"Aicon-assignment/internal/domain/event"
+)
+
+// 1件分のイベント（空行まで）を読み取る
+func readEvent(t *testing.T, reader *bufio.Reader) []string {
+	var lines []string
+	for {
+		line, err := reader.ReadString('\n')
+		require.NoError(t, err)
+		line = strings.TrimRight(line, "\n")
+		if line == "" {
+			return lines
+		}
+		lines = append(lines, line)
+	}
+}
+
+func TestEventHandler_Stream(t *testing.T) {
+	handler := NewEventHandler()
+	e := echo.New()
+	e.GET("/events", handler.Stream)
+	server := httptest.NewServer(e)
+	defer server.Close()
+	defer handler.Close()
+
+	resp, err := http.Get(server.URL + "/events")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "text/event-stream", resp.Header.Get(echo.HeaderContentType))
+
+	// 購読が登録されるまで待つ
+	require.Eventually(t, func() bool {
+		handler.mu.Lock()
+		defer handler.mu.Unlock()
+		return len(handler.subscribers) == 1
+	}, time.Second, 10*time.Millisecond)
+
+	handler.HandleEvent(context.Background(), event.NewItemCreated(&entity.Item{ID: 1, Name: "ロレックス デイトナ"}))
+	handler.HandleEvent(context.Background(), event.NewItemDeleted(&entity.Item{ID: 2, Name: "エルメス バーキン"}))
+
+	reader := bufio.NewReader(resp.Body)
+
+	created := readEvent(t, reader)
+	require.Len(t, created, 3)
+	assert.Equal(t, "id: 1", created[0])
+	assert.Equal(t, "event: item.created", created[1])
+	assert.Contains(t, created[2], `"name":"ロレックス デイトナ"`)
+
+	deleted := readEvent(t, reader)
+	require.Len(t, deleted, 3)
+	assert.Equal(t, "id: 2", deleted[0])
+	assert.Equal(t, "event: item.deleted", deleted[1])
+
+	// 停止時はストリームが終了する
+	handler.Close()
+	_, err = reader.ReadString('\n')
+	assert.Error(t, err)
+}
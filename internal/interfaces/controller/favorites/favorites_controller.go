@@ -0,0 +1,52 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	"Aicon-assignment/internal/interfaces/problem"
+	"Aicon-assignment/internal/usecase"
+
+	"github.com/labstack/echo/v4"
+)
+
+type ItemFavoriteHandler struct {
+	favoriteUsecase usecase.ItemFavoriteUsecase
+	// リクエストの閲覧者（認証で利用者が決まらない場合は空文字）
+	viewer func(c echo.Context) string
+}
+
+func NewItemFavoriteHandler(favoriteUsecase usecase.ItemFavoriteUsecase, viewer func(c echo.Context) string) *ItemFavoriteHandler {
+	return &ItemFavoriteHandler{
+		favoriteUsecase: favoriteUsecase,
+		viewer:          viewer,
+	}
+}
+
+// AddFavorite POST /items/{id}/favorite エンドポイント
+func (h *ItemFavoriteHandler) AddFavorite(c echo.Context) error {
+	itemID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return problem.Write(c, problem.New(http.StatusBadRequest, "invalid_item_id"))
+	}
+
+	if err := h.favoriteUsecase.AddFavorite(c.Request().Context(), h.viewer(c), itemID); err != nil {
+		return problem.Write(c, problem.FromError(err, "failed_to_update_favorite"))
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// RemoveFavorite DELETE /items/{id}/favorite エンドポイント
+func (h *ItemFavoriteHandler) RemoveFavorite(c echo.Context) error {
+	itemID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return problem.Write(c, problem.New(http.StatusBadRequest, "invalid_item_id"))
+	}
+
+	if err := h.favoriteUsecase.RemoveFavorite(c.Request().Context(), h.viewer(c), itemID); err != nil {
+		return problem.Write(c, problem.FromError(err, "failed_to_update_favorite"))
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
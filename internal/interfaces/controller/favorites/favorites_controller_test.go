@@ -0,0 +1,92 @@
+package controller
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	domainErrors "Aicon-assignment/internal/domain/errors"
+)
+
+type changedFavorite struct {
+	viewer   string
+	itemID   int64
+	favorite bool
+}
+
+type stubItemFavoriteUsecase struct {
+	changed []changedFavorite
+	err     error
+}
+
+func (s *stubItemFavoriteUsecase) AddFavorite(ctx context.Context, viewer string, itemID int64) error {
+	s.changed = append(s.changed, changedFavorite{viewer: viewer, itemID: itemID, favorite: true})
+	return s.err
+}
+
+func (s *stubItemFavoriteUsecase) RemoveFavorite(ctx context.Context, viewer string, itemID int64) error {
+	s.changed = append(s.changed, changedFavorite{viewer: viewer, itemID: itemID, favorite: false})
+	return s.err
+}
+
+func viewerOf(c echo.Context) string {
+	viewer, _ := c.Get("user").(string)
+	return viewer
+}
+
+func TestItemFavoriteHandler(t *testing.T) {
+	newContext := func(method, id, user string) (echo.Context, *httptest.ResponseRecorder) {
+		rec := httptest.NewRecorder()
+		c := echo.New().NewContext(httptest.NewRequest(method, "/items/"+id+"/favorite", nil), rec)
+		c.SetParamNames("id")
+		c.SetParamValues(id)
+		if user != "" {
+			c.Set("user", user)
+		}
+		return c, rec
+	}
+
+	t.Run("正常系: リクエストの閲覧者のお気に入りにする", func(t *testing.T) {
+		stub := &stubItemFavoriteUsecase{}
+		c, rec := newContext(http.MethodPost, "1", "admin")
+
+		require.NoError(t, NewItemFavoriteHandler(stub, viewerOf).AddFavorite(c))
+
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+		assert.Equal(t, []changedFavorite{{viewer: "admin", itemID: 1, favorite: true}}, stub.changed)
+	})
+
+	t.Run("正常系: 認証のないリクエストは空の閲覧者のお気に入りから外す", func(t *testing.T) {
+		stub := &stubItemFavoriteUsecase{}
+		c, rec := newContext(http.MethodDelete, "1", "")
+
+		require.NoError(t, NewItemFavoriteHandler(stub, viewerOf).RemoveFavorite(c))
+
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+		assert.Equal(t, []changedFavorite{{viewer: "", itemID: 1, favorite: false}}, stub.changed)
+	})
+
+	t.Run("異常系: アイテムが存在しない", func(t *testing.T) {
+		stub := &stubItemFavoriteUsecase{err: domainErrors.ErrItemNotFound}
+		c, rec := newContext(http.MethodPost, "9", "")
+
+		require.NoError(t, NewItemFavoriteHandler(stub, viewerOf).AddFavorite(c))
+
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+
+	t.Run("異常系: 不正なID", func(t *testing.T) {
+		stub := &stubItemFavoriteUsecase{}
+		c, rec := newContext(http.MethodDelete, "abc", "")
+
+		require.NoError(t, NewItemFavoriteHandler(stub, viewerOf).RemoveFavorite(c))
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.Empty(t, stub.changed)
+	})
+}
@@ -0,0 +1,62 @@
+package controller
+
+import (
+	"net/http"
+
+	"Aicon-assignment/internal/interfaces/problem"
+	"Aicon-assignment/internal/usecase"
+
+	"github.com/labstack/echo/v4"
+)
+
+type FeatureHandler struct {
+	featureUsecase usecase.FeatureFlagUsecase
+}
+
+func NewFeatureHandler(featureUsecase usecase.FeatureFlagUsecase) *FeatureHandler {
+	return &FeatureHandler{
+		featureUsecase: featureUsecase,
+	}
+}
+
+type SetFeatureInput struct {
+	Enabled *bool `json:"enabled"`
+}
+
+// GetFeatures GET /admin/features エンドポイント
+func (h *FeatureHandler) GetFeatures(c echo.Context) error {
+	features, err := h.featureUsecase.GetFeatures(c.Request().Context())
+	if err != nil {
+		return problem.Write(c, problem.New(http.StatusInternalServerError, "failed_to_retrieve_features"))
+	}
+
+	return c.JSON(http.StatusOK, features)
+}
+
+// SetFeature PUT /admin/features/{name} エンドポイント
+func (h *FeatureHandler) SetFeature(c echo.Context) error {
+	var input SetFeatureInput
+	if err := c.Bind(&input); err != nil {
+		return problem.Write(c, problem.New(http.StatusBadRequest, "invalid_request_format"))
+	}
+	if input.Enabled == nil {
+		return problem.Write(c, problem.New(http.StatusBadRequest, "validation_failed").WithDetails("enabled is required"))
+	}
+
+	feature, err := h.featureUsecase.SetFeature(c.Request().Context(), c.Param("name"), *input.Enabled)
+	if err != nil {
+		return problem.Write(c, problem.FromError(err, "failed_to_update_feature"))
+	}
+
+	return c.JSON(http.StatusOK, feature)
+}
+
+// ResetFeature DELETE /admin/features/{name} エンドポイント（設定の値に戻す）
+func (h *FeatureHandler) ResetFeature(c echo.Context) error {
+	feature, err := h.featureUsecase.ResetFeature(c.Request().Context(), c.Param("name"))
+	if err != nil {
+		return problem.Write(c, problem.FromError(err, "failed_to_update_feature"))
+	}
+
+	return c.JSON(http.StatusOK, feature)
+}
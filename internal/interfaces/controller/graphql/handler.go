@@ -0,0 +1,54 @@
+package controller
+
+import (
+	_ "embed"
+	"net/http"
+
+	"github.com/graph-gophers/graphql-go"
+	"github.com/labstack/echo/v4"
+
+	"Aicon-assignment/internal/interfaces/problem"
+	"Aicon-assignment/internal/usecase"
+)
+
+//go:embed schema.graphql
+var schemaString string
+
+type GraphQLHandler struct {
+	schema *graphql.Schema
+}
+
+func NewGraphQLHandler(itemUsecase usecase.ItemUsecase, locationUsecase usecase.LocationUsecase) *GraphQLHandler {
+	root := &resolver{
+		itemUsecase:     itemUsecase,
+		locationUsecase: locationUsecase,
+	}
+
+	return &GraphQLHandler{
+		schema: graphql.MustParseSchema(schemaString, root),
+	}
+}
+
+// GraphQLのリクエスト形式
+type graphQLRequest struct {
+	Query         string                 `json:"query"`
+	OperationName string                 `json:"operationName"`
+	Variables     map[string]interface{} `json:"variables"`
+}
+
+// Query POST /graphql エンドポイント
+func (h *GraphQLHandler) Query(c echo.Context) error {
+	var req graphQLRequest
+	if err := c.Bind(&req); err != nil {
+		return problem.Write(c, problem.New(http.StatusBadRequest, "invalid_request_format"))
+	}
+
+	if req.Query == "" {
+		return problem.Write(c, problem.New(http.StatusBadRequest, "query_required"))
+	}
+
+	// リゾルバーのエラーはGraphQLの仕様どおりerrorsに入れて200で返す
+	response := h.schema.Exec(c.Request().Context(), req.Query, req.OperationName, req.Variables)
+
+	return c.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,131 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"Aicon-assignment/internal/domain/entity"
+	domainErrors "Aicon-assignment/internal/domain/errors"
+	"Aicon-assignment/internal/testutil"
+	"Aicon-assignment/internal/usecase"
+)
+
+// MockLocationUsecase はテストで使うメソッドだけを実装したモック
+type MockLocationUsecase struct {
+	usecase.LocationUsecase
+	mock.Mock
+}
+
+func (m *MockLocationUsecase) GetLocationByID(ctx context.Context, id int64) (*entity.Location, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).(*entity.Location), args.Error(1)
+}
+
+type graphQLResponse struct {
+	Data   map[string]interface{} `json:"data"`
+	Errors []struct {
+		Message string `json:"message"`
+	} `json:"errors"`
+}
+
+func execGraphQL(t *testing.T, handler *GraphQLHandler, body string) (*httptest.ResponseRecorder, graphQLResponse) {
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodPost, "/graphql", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+
+	require.NoError(t, handler.Query(e.NewContext(req, rec)))
+
+	var response graphQLResponse
+	json.Unmarshal(rec.Body.Bytes(), &response)
+	return rec, response
+}
+
+func TestGraphQLHandler_Items(t *testing.T) {
+	locationID := int64(3)
+	itemUsecase := testutil.NewItemUsecase()
+	// 一覧は新しい順のため、最後に登録したアイテムが先頭になる
+	items := itemUsecase.Repo.Seed(t,
+		&entity.Item{Name: "グランドセイコー", Category: "時計", Brand: "Grand Seiko", Condition: "A", SerialNumber: "G123456"},
+		&entity.Item{Name: "オメガ スピードマスター", Category: "時計", Brand: "OMEGA", Condition: "A", SerialNumber: "O123456"},
+		&entity.Item{Name: "カルティエ タンク", Category: "時計", Brand: "Cartier", Condition: "B", SerialNumber: "C123456"},
+		&entity.Item{Name: "ロレックス デイトナ", Category: "時計", Brand: "ROLEX", Condition: "A", SerialNumber: "D123456", LocationID: &locationID, Attributes: map[string]string{"movement": "automatic"}},
+	)
+	locationUsecase := new(MockLocationUsecase)
+	locationUsecase.On("GetLocationByID", mock.Anything, int64(3)).Return(&entity.Location{ID: 3, Name: "自宅金庫"}, nil)
+
+	handler := NewGraphQLHandler(itemUsecase, locationUsecase)
+	rec, response := execGraphQL(t, handler, `{"query": "{ items(filter: {condition: \"A\"}, limit: 2) { totalCount items { id name attributes { key value } location { name } } } }"}`)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Empty(t, response.Errors)
+
+	page := response.Data["items"].(map[string]interface{})
+	assert.Equal(t, float64(3), page["totalCount"])
+
+	pageItems := page["items"].([]interface{})
+	require.Len(t, pageItems, 2)
+	first := pageItems[0].(map[string]interface{})
+	assert.Equal(t, strconv.FormatInt(items[3].ID, 10), first["id"])
+	assert.Equal(t, map[string]interface{}{"name": "自宅金庫"}, first["location"])
+	assert.Equal(t, []interface{}{map[string]interface{}{"key": "movement", "value": "automatic"}}, first["attributes"])
+	assert.Nil(t, pageItems[1].(map[string]interface{})["location"])
+
+	assert.Equal(t, 1, itemUsecase.Calls("GetAllItems"))
+	locationUsecase.AssertExpectations(t)
+}
+
+func TestGraphQLHandler_Item(t *testing.T) {
+	t.Run("正常系: IDで取得", func(t *testing.T) {
+		itemUsecase := testutil.NewItemUsecase()
+		item := itemUsecase.Repo.Seed(t, &entity.Item{Name: "ロレックス デイトナ", Category: "時計", Brand: "ROLEX", SerialNumber: "D123456"})[0]
+
+		handler := NewGraphQLHandler(itemUsecase, new(MockLocationUsecase))
+		_, response := execGraphQL(t, handler, `{"query": "query($id: ID!) { item(id: $id) { name } }", "variables": {"id": "`+strconv.FormatInt(item.ID, 10)+`"}}`)
+
+		assert.Empty(t, response.Errors)
+		assert.Equal(t, map[string]interface{}{"name": "ロレックス デイトナ"}, response.Data["item"])
+	})
+
+	t.Run("正常系: 存在しないIDはnull", func(t *testing.T) {
+		handler := NewGraphQLHandler(testutil.NewItemUsecase(), new(MockLocationUsecase))
+		_, response := execGraphQL(t, handler, `{"query": "{ item(id: \"999\") { name } }"}`)
+
+		assert.Empty(t, response.Errors)
+		assert.Nil(t, response.Data["item"])
+	})
+}
+
+func TestGraphQLHandler_CreateItem(t *testing.T) {
+	t.Run("異常系: バリデーションエラーはerrorsに入る", func(t *testing.T) {
+		itemUsecase := testutil.NewItemUsecase()
+
+		handler := NewGraphQLHandler(itemUsecase, new(MockLocationUsecase))
+		_, response := execGraphQL(t, handler, `{"query": "mutation { createItem(input: {name: \"\", category: \"時計\", brand: \"ROLEX\", purchasePrice: 1, purchaseDate: \"2023-01-15\"}) { id } }"}`)
+
+		require.Len(t, response.Errors, 1)
+		assert.Equal(t, "invalid input: name is required", response.Errors[0].Message)
+		assert.Equal(t, 0, itemUsecase.Repo.Calls("Create"))
+	})
+
+	t.Run("異常系: 内部エラーの詳細は返さない", func(t *testing.T) {
+		itemUsecase := testutil.NewItemUsecase()
+		itemUsecase.FailOn("CreateItem", domainErrors.ErrDatabaseError)
+
+		handler := NewGraphQLHandler(itemUsecase, new(MockLocationUsecase))
+		_, response := execGraphQL(t, handler, `{"query": "mutation { createItem(input: {name: \"時計\", category: \"時計\", brand: \"ROLEX\", purchasePrice: 1, purchaseDate: \"2023-01-15\"}) { id } }"}`)
+
+		require.Len(t, response.Errors, 1)
+		assert.Equal(t, "failed to create item", response.Errors[0].Message)
+	})
+}
@@ -0,0 +1,373 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/graph-gophers/graphql-go"
+
+	"Aicon-assignment/internal/domain/entity"
+	domainErrors "Aicon-assignment/internal/domain/errors"
+	"Aicon-assignment/internal/usecase"
+)
+
+// items クエリの取得件数（デフォルトと上限）
+const (
+	defaultItemsLimit = 20
+	maxItemsLimit     = 100
+)
+
+// ルートリゾルバー（ItemUsecase / LocationUsecase をそのまま利用する）
+type resolver struct {
+	itemUsecase     usecase.ItemUsecase
+	locationUsecase usecase.LocationUsecase
+}
+
+type attributeInput struct {
+	Key   string
+	Value string
+}
+
+type itemFilterInput struct {
+	LocationID *graphql.ID
+	Condition  *string
+	Attributes *[]attributeInput
+}
+
+type createItemInput struct {
+	Name          string
+	Category      string
+	Brand         string
+	PurchasePrice int32
+	PurchaseDate  string
+	Condition     *string
+	SerialNumber  *string
+	Attributes    *[]attributeInput
+}
+
+type updateItemInput struct {
+	Name          *string
+	Brand         *string
+	PurchasePrice *int32
+	Condition     *string
+	SerialNumber  *string
+	Attributes    *[]attributeInput
+	Version       *int32
+}
+
+func (r *resolver) Items(ctx context.Context, args struct {
+	Filter *itemFilterInput
+	Limit  *int32
+	Offset *int32
+}) (*itemPageResolver, error) {
+	var filter entity.ItemFilter
+	if args.Filter != nil {
+		if args.Filter.LocationID != nil {
+			locationID, err := parseID(*args.Filter.LocationID)
+			if err != nil {
+				return nil, err
+			}
+			filter.LocationID = &locationID
+		}
+		if args.Filter.Condition != nil {
+			filter.Condition = *args.Filter.Condition
+		}
+		if args.Filter.Attributes != nil {
+			filter.Attributes = toAttributeMap(*args.Filter.Attributes)
+		}
+	}
+
+	limit, offset := int32(defaultItemsLimit), int32(0)
+	if args.Limit != nil {
+		limit = *args.Limit
+	}
+	if args.Offset != nil {
+		offset = *args.Offset
+	}
+	if limit < 0 || limit > maxItemsLimit || offset < 0 {
+		return nil, errors.New("limit must be between 0 and 100 and offset must be 0 or greater")
+	}
+
+	items, err := r.itemUsecase.GetAllItems(ctx, filter)
+	if err != nil {
+		return nil, resolverError(err, "failed to retrieve items")
+	}
+
+	total := len(items)
+	start := min(int(offset), total)
+	end := min(start+int(limit), total)
+
+	return &itemPageResolver{
+		root:       r,
+		totalCount: int32(total),
+		items:      items[start:end],
+	}, nil
+}
+
+func (r *resolver) Item(ctx context.Context, args struct{ ID graphql.ID }) (*itemResolver, error) {
+	id, err := parseID(args.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	item, err := r.itemUsecase.GetItemByID(ctx, id)
+	if err != nil {
+		if domainErrors.IsNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, resolverError(err, "failed to retrieve item")
+	}
+
+	return &itemResolver{root: r, item: item}, nil
+}
+
+func (r *resolver) Summary(ctx context.Context) (*summaryResolver, error) {
+	summary, err := r.itemUsecase.GetCategorySummary(ctx)
+	if err != nil {
+		return nil, resolverError(err, "failed to retrieve summary")
+	}
+
+	return &summaryResolver{summary: summary}, nil
+}
+
+func (r *resolver) CreateItem(ctx context.Context, args struct{ Input createItemInput }) (*itemResolver, error) {
+	input := usecase.CreateItemInput{
+		Name:          args.Input.Name,
+		Category:      args.Input.Category,
+		Brand:         args.Input.Brand,
+		PurchasePrice: int(args.Input.PurchasePrice),
+		PurchaseDate:  args.Input.PurchaseDate,
+	}
+	if args.Input.Condition != nil {
+		input.Condition = *args.Input.Condition
+	}
+	if args.Input.SerialNumber != nil {
+		input.SerialNumber = *args.Input.SerialNumber
+	}
+	if args.Input.Attributes != nil {
+		input.Attributes = toAttributeMap(*args.Input.Attributes)
+	}
+
+	item, err := r.itemUsecase.CreateItem(ctx, input)
+	if err != nil {
+		return nil, resolverError(err, "failed to create item")
+	}
+
+	return &itemResolver{root: r, item: item}, nil
+}
+
+func (r *resolver) UpdateItem(ctx context.Context, args struct {
+	ID    graphql.ID
+	Input updateItemInput
+}) (*itemResolver, error) {
+	id, err := parseID(args.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	input := usecase.UpdateItemInput{
+		Name:         args.Input.Name,
+		Brand:        args.Input.Brand,
+		Condition:    args.Input.Condition,
+		SerialNumber: args.Input.SerialNumber,
+	}
+	if args.Input.PurchasePrice != nil {
+		purchasePrice := int(*args.Input.PurchasePrice)
+		input.PurchasePrice = &purchasePrice
+	}
+	if args.Input.Attributes != nil {
+		input.Attributes = toAttributeMap(*args.Input.Attributes)
+	}
+	if args.Input.Version != nil {
+		version := int(*args.Input.Version)
+		input.Version = &version
+	}
+
+	item, err := r.itemUsecase.PartialUpdateItem(ctx, id, input)
+	if err != nil {
+		return nil, resolverError(err, "failed to update item")
+	}
+
+	return &itemResolver{root: r, item: item}, nil
+}
+
+func (r *resolver) DeleteItem(ctx context.Context, args struct{ ID graphql.ID }) (bool, error) {
+	id, err := parseID(args.ID)
+	if err != nil {
+		return false, err
+	}
+
+	if err := r.itemUsecase.DeleteItem(ctx, id); err != nil {
+		return false, resolverError(err, "failed to delete item")
+	}
+
+	return true, nil
+}
+
+type itemPageResolver struct {
+	root       *resolver
+	totalCount int32
+	items      []*entity.Item
+}
+
+func (p *itemPageResolver) TotalCount() int32 {
+	return p.totalCount
+}
+
+func (p *itemPageResolver) Items() []*itemResolver {
+	resolvers := make([]*itemResolver, 0, len(p.items))
+	for _, item := range p.items {
+		resolvers = append(resolvers, &itemResolver{root: p.root, item: item})
+	}
+	return resolvers
+}
+
+type itemResolver struct {
+	root *resolver
+	item *entity.Item
+}
+
+func (i *itemResolver) ID() graphql.ID       { return formatID(i.item.ID) }
+func (i *itemResolver) Name() string         { return i.item.Name }
+func (i *itemResolver) Category() string     { return i.item.Category }
+func (i *itemResolver) Brand() string        { return i.item.Brand }
+func (i *itemResolver) PurchasePrice() int32 { return int32(i.item.PurchasePrice) }
+func (i *itemResolver) PurchaseDate() string { return i.item.PurchaseDate }
+func (i *itemResolver) Condition() string    { return i.item.Condition }
+func (i *itemResolver) SerialNumber() string { return i.item.SerialNumber }
+func (i *itemResolver) OnLoan() bool         { return i.item.OnLoan }
+func (i *itemResolver) CreatedAt() string    { return i.item.CreatedAt.Format(time.RFC3339) }
+func (i *itemResolver) UpdatedAt() string    { return i.item.UpdatedAt.Format(time.RFC3339) }
+func (i *itemResolver) Version() int32       { return int32(i.item.Version) }
+
+func (i *itemResolver) Attributes() []*attributeResolver {
+	keys := make([]string, 0, len(i.item.Attributes))
+	for key := range i.item.Attributes {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	attributes := make([]*attributeResolver, 0, len(keys))
+	for _, key := range keys {
+		attributes = append(attributes, &attributeResolver{key: key, value: i.item.Attributes[key]})
+	}
+	return attributes
+}
+
+// 未評価ならnull
+func (i *itemResolver) CurrentValue() *int32 {
+	if i.item.CurrentValue == nil {
+		return nil
+	}
+	currentValue := int32(*i.item.CurrentValue)
+	return &currentValue
+}
+
+// アーカイブしていなければnull
+func (i *itemResolver) ArchivedAt() *string {
+	if i.item.ArchivedAt == nil {
+		return nil
+	}
+	archivedAt := i.item.ArchivedAt.Format(time.RFC3339)
+	return &archivedAt
+}
+
+// 保管場所はネストして取得できるようにする（未設定ならnull）
+func (i *itemResolver) Location(ctx context.Context) (*locationResolver, error) {
+	if i.item.LocationID == nil {
+		return nil, nil
+	}
+
+	location, err := i.root.locationUsecase.GetLocationByID(ctx, *i.item.LocationID)
+	if err != nil {
+		if domainErrors.IsLocationNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, resolverError(err, "failed to retrieve location")
+	}
+
+	return &locationResolver{location: location}, nil
+}
+
+type attributeResolver struct {
+	key   string
+	value string
+}
+
+func (a *attributeResolver) Key() string   { return a.key }
+func (a *attributeResolver) Value() string { return a.value }
+
+type locationResolver struct {
+	location *entity.Location
+}
+
+func (l *locationResolver) ID() graphql.ID      { return formatID(l.location.ID) }
+func (l *locationResolver) Name() string        { return l.location.Name }
+func (l *locationResolver) Description() string { return l.location.Description }
+
+type summaryResolver struct {
+	summary *usecase.CategorySummary
+}
+
+func (s *summaryResolver) Total() int32 {
+	return int32(s.summary.Total)
+}
+
+func (s *summaryResolver) Categories() []*countEntryResolver {
+	return countEntries(entity.GetValidCategories(), s.summary.Categories)
+}
+
+func (s *summaryResolver) Conditions() []*countEntryResolver {
+	return countEntries(entity.GetValidConditions(), s.summary.Conditions)
+}
+
+type countEntryResolver struct {
+	key   string
+	count int
+}
+
+func (c *countEntryResolver) Key() string  { return c.key }
+func (c *countEntryResolver) Count() int32 { return int32(c.count) }
+
+// 定義順に件数を並べる
+func countEntries(keys []string, counts map[string]int) []*countEntryResolver {
+	entries := make([]*countEntryResolver, 0, len(keys))
+	for _, key := range keys {
+		entries = append(entries, &countEntryResolver{key: key, count: counts[key]})
+	}
+	return entries
+}
+
+func toAttributeMap(attributes []attributeInput) map[string]string {
+	m := make(map[string]string, len(attributes))
+	for _, attribute := range attributes {
+		m[attribute.Key] = attribute.Value
+	}
+	return m
+}
+
+func parseID(id graphql.ID) (int64, error) {
+	parsed, err := strconv.ParseInt(string(id), 10, 64)
+	if err != nil || parsed <= 0 {
+		return 0, errors.New("invalid ID")
+	}
+	return parsed, nil
+}
+
+func formatID(id int64) graphql.ID {
+	return graphql.ID(strconv.FormatInt(id, 10))
+}
+
+// 利用者に返してよいエラーはそのまま、それ以外は内部の詳細を隠す
+func resolverError(err error, message string) error {
+	if domainErrors.IsNotFoundError(err) ||
+		domainErrors.IsValidationError(err) ||
+		domainErrors.IsConflictError(err) ||
+		domainErrors.IsQuotaExceededError(err) {
+		return err
+	}
+	return errors.New(message)
+}
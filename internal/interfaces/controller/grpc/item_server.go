@@ -0,0 +1,174 @@
+package controller
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"Aicon-assignment/internal/domain/entity"
+	domainErrors "Aicon-assignment/internal/domain/errors"
+	"Aicon-assignment/internal/interfaces/controller/grpc/itempb"
+	"Aicon-assignment/internal/usecase"
+)
+
+// ItemServiceServer は ItemUsecase をgRPCで公開する
+type ItemServiceServer struct {
+	itempb.UnimplementedItemServiceServer
+	itemUsecase usecase.ItemUsecase
+}
+
+func NewItemServiceServer(itemUsecase usecase.ItemUsecase) *ItemServiceServer {
+	return &ItemServiceServer{
+		itemUsecase: itemUsecase,
+	}
+}
+
+func (s *ItemServiceServer) GetAllItems(ctx context.Context, req *itempb.GetAllItemsRequest) (*itempb.GetAllItemsResponse, error) {
+	filter := entity.ItemFilter{
+		LocationID: req.LocationId,
+		Condition:  req.GetCondition(),
+		Attributes: req.GetAttributes(),
+	}
+
+	items, err := s.itemUsecase.GetAllItems(ctx, filter)
+	if err != nil {
+		return nil, toStatusError(err, "failed to retrieve items")
+	}
+
+	response := &itempb.GetAllItemsResponse{
+		Items: make([]*itempb.Item, 0, len(items)),
+	}
+	for _, item := range items {
+		response.Items = append(response.Items, toItemMessage(item))
+	}
+
+	return response, nil
+}
+
+func (s *ItemServiceServer) GetItemByID(ctx context.Context, req *itempb.GetItemByIDRequest) (*itempb.Item, error) {
+	item, err := s.itemUsecase.GetItemByID(ctx, req.GetId())
+	if err != nil {
+		return nil, toStatusError(err, "failed to retrieve item")
+	}
+
+	return toItemMessage(item), nil
+}
+
+func (s *ItemServiceServer) CreateItem(ctx context.Context, req *itempb.CreateItemRequest) (*itempb.Item, error) {
+	item, err := s.itemUsecase.CreateItem(ctx, usecase.CreateItemInput{
+		Name:          req.GetName(),
+		Category:      req.GetCategory(),
+		Brand:         req.GetBrand(),
+		PurchasePrice: int(req.GetPurchasePrice()),
+		PurchaseDate:  req.GetPurchaseDate(),
+		Condition:     req.GetCondition(),
+		SerialNumber:  req.GetSerialNumber(),
+		Attributes:    req.GetAttributes(),
+	})
+	if err != nil {
+		return nil, toStatusError(err, "failed to create item")
+	}
+
+	return toItemMessage(item), nil
+}
+
+func (s *ItemServiceServer) PartialUpdateItem(ctx context.Context, req *itempb.PartialUpdateItemRequest) (*itempb.Item, error) {
+	input := usecase.UpdateItemInput{
+		Name:         req.Name,
+		Brand:        req.Brand,
+		Condition:    req.Condition,
+		SerialNumber: req.SerialNumber,
+	}
+	if req.PurchasePrice != nil {
+		purchasePrice := int(req.GetPurchasePrice())
+		input.PurchasePrice = &purchasePrice
+	}
+	if req.Attributes != nil {
+		input.Attributes = req.GetAttributes().GetValues()
+		if input.Attributes == nil {
+			input.Attributes = map[string]string{}
+		}
+	}
+
+	if input.Name == nil && input.Brand == nil && input.PurchasePrice == nil &&
+		input.Condition == nil && input.SerialNumber == nil && input.Attributes == nil {
+		return nil, status.Error(codes.InvalidArgument, "at least one field must be provided for update")
+	}
+
+	item, err := s.itemUsecase.PartialUpdateItem(ctx, req.GetId(), input)
+	if err != nil {
+		return nil, toStatusError(err, "failed to update item")
+	}
+
+	return toItemMessage(item), nil
+}
+
+func (s *ItemServiceServer) DeleteItem(ctx context.Context, req *itempb.DeleteItemRequest) (*itempb.DeleteItemResponse, error) {
+	if err := s.itemUsecase.DeleteItem(ctx, req.GetId()); err != nil {
+		return nil, toStatusError(err, "failed to delete item")
+	}
+
+	return &itempb.DeleteItemResponse{}, nil
+}
+
+func (s *ItemServiceServer) GetCategorySummary(ctx context.Context, _ *itempb.GetCategorySummaryRequest) (*itempb.CategorySummary, error) {
+	summary, err := s.itemUsecase.GetCategorySummary(ctx)
+	if err != nil {
+		return nil, toStatusError(err, "failed to retrieve summary")
+	}
+
+	return &itempb.CategorySummary{
+		Categories: toCountMap(summary.Categories),
+		Conditions: toCountMap(summary.Conditions),
+		Total:      int64(summary.Total),
+	}, nil
+}
+
+func toItemMessage(item *entity.Item) *itempb.Item {
+	return &itempb.Item{
+		Id:            item.ID,
+		Name:          item.Name,
+		Category:      item.Category,
+		Brand:         item.Brand,
+		PurchasePrice: int64(item.PurchasePrice),
+		PurchaseDate:  item.PurchaseDate,
+		Condition:     item.Condition,
+		SerialNumber:  item.SerialNumber,
+		Attributes:    item.Attributes,
+		LocationId:    item.LocationID,
+		OnLoan:        item.OnLoan,
+		CreatedAt:     timestamppb.New(item.CreatedAt),
+		UpdatedAt:     timestamppb.New(item.UpdatedAt),
+	}
+}
+
+func toCountMap(counts map[string]int) map[string]int64 {
+	m := make(map[string]int64, len(counts))
+	for key, count := range counts {
+		m[key] = int64(count)
+	}
+	return m
+}
+
+// ドメインエラーをgRPCのステータスに変換（内部エラーの詳細は返さない）
+func toStatusError(err error, message string) error {
+	switch {
+	case domainErrors.IsNotFoundError(err):
+		return status.Error(codes.NotFound, "item not found")
+	case domainErrors.IsValidationError(err):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domainErrors.ErrDuplicateSerial):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case domainErrors.IsConflictError(err):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case domainErrors.IsQuotaExceededError(err):
+		return status.Error(codes.ResourceExhausted, err.Error())
+	case domainErrors.IsDatabaseUnavailableError(err):
+		return status.Error(codes.Unavailable, "database is unavailable")
+	default:
+		return status.Error(codes.Internal, message)
+	}
+}
@@ -0,0 +1,108 @@
+package controller
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"Aicon-assignment/internal/domain/entity"
+	"Aicon-assignment/internal/interfaces/controller/grpc/itempb"
+	"Aicon-assignment/internal/testutil"
+	"Aicon-assignment/internal/usecase"
+)
+
+// bufconn上でサーバーを起動してクライアントを返す
+func newTestClient(t *testing.T, itemUsecase usecase.ItemUsecase) itempb.ItemServiceClient {
+	listener := bufconn.Listen(1024 * 1024)
+	server := grpc.NewServer()
+	itempb.RegisterItemServiceServer(server, NewItemServiceServer(itemUsecase))
+	go server.Serve(listener)
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	return itempb.NewItemServiceClient(conn)
+}
+
+func TestItemServiceServer_GetItemByID(t *testing.T) {
+	t.Run("正常系: アイテムを取得", func(t *testing.T) {
+		locationID := int64(2)
+		itemUsecase := testutil.NewItemUsecase()
+		seeded := itemUsecase.Repo.Seed(t, &entity.Item{
+			Name:         "ロレックス デイトナ",
+			Category:     "時計",
+			Brand:        "ROLEX",
+			SerialNumber: "D123456",
+			Attributes:   map[string]string{"movement": "automatic"},
+			LocationID:   &locationID,
+		})[0]
+
+		client := newTestClient(t, itemUsecase)
+		item, err := client.GetItemByID(context.Background(), &itempb.GetItemByIDRequest{Id: seeded.ID})
+
+		require.NoError(t, err)
+		assert.Equal(t, "ロレックス デイトナ", item.GetName())
+		assert.Equal(t, "automatic", item.GetAttributes()["movement"])
+		assert.Equal(t, int64(2), item.GetLocationId())
+	})
+
+	t.Run("異常系: 存在しないアイテムはNotFound", func(t *testing.T) {
+		client := newTestClient(t, testutil.NewItemUsecase())
+		_, err := client.GetItemByID(context.Background(), &itempb.GetItemByIDRequest{Id: 999})
+
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
+
+func TestItemServiceServer_PartialUpdateItem(t *testing.T) {
+	t.Run("正常系: 指定したフィールドのみ更新", func(t *testing.T) {
+		name := "ロレックス デイトナ 白文字盤"
+		itemUsecase := testutil.NewItemUsecase()
+		seeded := itemUsecase.Repo.Seed(t, &entity.Item{Name: "ロレックス デイトナ", Category: "時計", Brand: "ROLEX", PurchasePrice: 1500000, PurchaseDate: "2023-01-15", SerialNumber: "D123456"})[0]
+
+		client := newTestClient(t, itemUsecase)
+		item, err := client.PartialUpdateItem(context.Background(), &itempb.PartialUpdateItemRequest{Id: seeded.ID, Name: &name})
+
+		require.NoError(t, err)
+		assert.Equal(t, name, item.GetName())
+		assert.Equal(t, "ROLEX", item.GetBrand())
+	})
+
+	t.Run("異常系: 更新するフィールドが無い", func(t *testing.T) {
+		itemUsecase := testutil.NewItemUsecase()
+		client := newTestClient(t, itemUsecase)
+		_, err := client.PartialUpdateItem(context.Background(), &itempb.PartialUpdateItemRequest{Id: 1})
+
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Equal(t, 0, itemUsecase.Calls("PartialUpdateItem"))
+	})
+
+	t.Run("異常系: シリアル番号の重複はAlreadyExists", func(t *testing.T) {
+		serialNumber := "SN-0002"
+		itemUsecase := testutil.NewItemUsecase()
+		seeded := itemUsecase.Repo.Seed(t,
+			&entity.Item{Name: "ロレックス デイトナ", Category: "時計", Brand: "ROLEX", PurchasePrice: 1500000, PurchaseDate: "2023-01-15", SerialNumber: "SN-0001"},
+			&entity.Item{Name: "オメガ スピードマスター", Category: "時計", Brand: "OMEGA", PurchasePrice: 800000, PurchaseDate: "2023-02-01", SerialNumber: serialNumber},
+		)
+
+		client := newTestClient(t, itemUsecase)
+		_, err := client.PartialUpdateItem(context.Background(), &itempb.PartialUpdateItemRequest{Id: seeded[0].ID, SerialNumber: &serialNumber})
+
+		assert.Equal(t, codes.AlreadyExists, status.Code(err))
+	})
+}
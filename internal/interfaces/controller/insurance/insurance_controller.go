@@ -0,0 +1,70 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	"Aicon-assignment/internal/interfaces/problem"
+	"Aicon-assignment/internal/usecase"
+
+	"github.com/labstack/echo/v4"
+)
+
+type ItemInsuranceHandler struct {
+	insuranceUsecase usecase.ItemInsuranceUsecase
+}
+
+func NewItemInsuranceHandler(insuranceUsecase usecase.ItemInsuranceUsecase) *ItemInsuranceHandler {
+	return &ItemInsuranceHandler{
+		insuranceUsecase: insuranceUsecase,
+	}
+}
+
+// GetInsurance GET /items/{id}/insurance エンドポイント
+func (h *ItemInsuranceHandler) GetInsurance(c echo.Context) error {
+	itemID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return problem.Write(c, problem.New(http.StatusBadRequest, "invalid_item_id"))
+	}
+
+	insurance, err := h.insuranceUsecase.GetInsurance(c.Request().Context(), itemID)
+	if err != nil {
+		return problem.Write(c, problem.FromError(err, "failed_to_retrieve_insurance"))
+	}
+
+	return c.JSON(http.StatusOK, insurance)
+}
+
+// SetInsurance PUT /items/{id}/insurance エンドポイント
+func (h *ItemInsuranceHandler) SetInsurance(c echo.Context) error {
+	itemID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return problem.Write(c, problem.New(http.StatusBadRequest, "invalid_item_id"))
+	}
+
+	var input usecase.SetItemInsuranceInput
+	if err := c.Bind(&input); err != nil {
+		return problem.Write(c, problem.New(http.StatusBadRequest, "invalid_request_format"))
+	}
+
+	insurance, err := h.insuranceUsecase.SetInsurance(c.Request().Context(), itemID, input)
+	if err != nil {
+		return problem.Write(c, problem.FromError(err, "failed_to_set_insurance"))
+	}
+
+	return c.JSON(http.StatusOK, insurance)
+}
+
+// RemoveInsurance DELETE /items/{id}/insurance エンドポイント
+func (h *ItemInsuranceHandler) RemoveInsurance(c echo.Context) error {
+	itemID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return problem.Write(c, problem.New(http.StatusBadRequest, "invalid_item_id"))
+	}
+
+	if err := h.insuranceUsecase.RemoveInsurance(c.Request().Context(), itemID); err != nil {
+		return problem.Write(c, problem.FromError(err, "failed_to_remove_insurance"))
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
@@ -1,31 +1,37 @@
 package controller
 
 import (
+	"errors"
 	"net/http"
 	"strconv"
+	"strings"
 
+	"Aicon-assignment/internal/domain/entity"
 	domainErrors "Aicon-assignment/internal/domain/errors"
+	"Aicon-assignment/internal/interfaces/i18n"
+	"Aicon-assignment/internal/interfaces/problem"
+	"Aicon-assignment/internal/interfaces/resource"
 	"Aicon-assignment/internal/usecase"
 
 	"github.com/labstack/echo/v4"
 )
 
+// 絞り込み条件に合うアイテムの総数を返すヘッダー
+const HeaderTotalCount = "X-Total-Count"
+
 type ItemHandler struct {
 	itemUsecase usecase.ItemUsecase
+	// リクエストの閲覧者（?favorites=true で絞り込むお気に入りの持ち主。認証で利用者が決まらない場合は空文字）
+	viewer func(c echo.Context) string
 }
 
-func NewItemHandler(itemUsecase usecase.ItemUsecase) *ItemHandler {
+func NewItemHandler(itemUsecase usecase.ItemUsecase, viewer func(c echo.Context) string) *ItemHandler {
 	return &ItemHandler{
 		itemUsecase: itemUsecase,
+		viewer:      viewer,
 	}
 }
 
-// エラーレスポンスの形式
-type ErrorResponse struct {
-	Error   string   `json:"error"`
-	Details []string `json:"details,omitempty"`
-}
-
 // // 部分更新用の入力構造体
 // type UpdateItemInput struct {
 // 	Name          *string `json:"name,omitempty"`
@@ -34,91 +40,144 @@ type ErrorResponse struct {
 // }
 
 func (h *ItemHandler) GetItems(c echo.Context) error {
-	items, err := h.itemUsecase.GetAllItems(c.Request().Context())
+	filter, err := h.parseItemFilter(c)
+	if err != nil {
+		return problem.Write(c, problem.New(http.StatusBadRequest, "invalid_filter").WithDetails(err.Error()))
+	}
+
+	items, err := h.itemUsecase.GetAllItems(c.Request().Context(), filter)
+	if err != nil {
+		if domainErrors.IsValidationError(err) {
+			return problem.Write(c, problem.New(http.StatusBadRequest, "invalid_filter").WithDetails(err.Error()))
+		}
+		return problem.Write(c, problem.New(http.StatusInternalServerError, "failed_to_retrieve_items"))
+	}
+
+	// 一覧はページに分けていないため、件数は返す数と同じ
+	c.Response().Header().Set(HeaderTotalCount, strconv.Itoa(len(items)))
+	return resource.WriteItems(c, http.StatusOK, items)
+}
+
+// カテゴリー別の集計（categories のキーは保存している値のまま、表示名は Accept-Language の言語にして別に返す）
+type SummaryResponse struct {
+	*usecase.CategorySummary
+	CategoryLabels map[string]string `json:"category_labels"`
+}
+
+// 一覧と同じ絞り込み条件に合うアイテムの数
+type CountResponse struct {
+	Count int `json:"count"`
+}
+
+// GetItemCount GET /items/count エンドポイント
+func (h *ItemHandler) GetItemCount(c echo.Context) error {
+	filter, err := h.parseItemFilter(c)
+	if err != nil {
+		return problem.Write(c, problem.New(http.StatusBadRequest, "invalid_filter").WithDetails(err.Error()))
+	}
+
+	count, err := h.itemUsecase.CountItems(c.Request().Context(), filter)
+	if err != nil {
+		if domainErrors.IsValidationError(err) {
+			return problem.Write(c, problem.New(http.StatusBadRequest, "invalid_filter").WithDetails(err.Error()))
+		}
+		return problem.Write(c, problem.New(http.StatusInternalServerError, "failed_to_count_items"))
+	}
+
+	c.Response().Header().Set(HeaderTotalCount, strconv.Itoa(count))
+	return c.JSON(http.StatusOK, CountResponse{Count: count})
+}
+
+// GetUsage GET /me/usage エンドポイント
+func (h *ItemHandler) GetUsage(c echo.Context) error {
+	usage, err := h.itemUsecase.GetUsage(c.Request().Context())
 	if err != nil {
-		return c.JSON(http.StatusInternalServerError, ErrorResponse{
-			Error: "failed to retrieve items",
-		})
+		return problem.Write(c, problem.New(http.StatusInternalServerError, "failed_to_get_usage"))
 	}
 
-	return c.JSON(http.StatusOK, items)
+	return c.JSON(http.StatusOK, usage)
 }
 
 func (h *ItemHandler) GetItem(c echo.Context) error {
 	idStr := c.Param("id")
 	id, err := strconv.ParseInt(idStr, 10, 64)
 	if err != nil {
-		return c.JSON(http.StatusBadRequest, ErrorResponse{
-			Error: "invalid item ID",
-		})
+		return problem.Write(c, problem.New(http.StatusBadRequest, "invalid_item_id"))
 	}
 
 	item, err := h.itemUsecase.GetItemByID(c.Request().Context(), id)
+	if err != nil {
+		return problem.Write(c, problem.FromError(err, "failed_to_retrieve_item"))
+	}
+
+	return resource.WriteItem(c, http.StatusOK, item)
+}
+
+// GetItemBySerial GET /items/by-serial/{serial} エンドポイント
+func (h *ItemHandler) GetItemBySerial(c echo.Context) error {
+	item, err := h.itemUsecase.GetItemBySerialNumber(c.Request().Context(), c.Param("serial"))
 	if err != nil {
 		if domainErrors.IsNotFoundError(err) {
-			return c.JSON(http.StatusNotFound, ErrorResponse{
-				Error: "item not found",
-			})
+			return problem.Write(c, problem.New(http.StatusNotFound, "item_not_found"))
 		}
-		return c.JSON(http.StatusInternalServerError, ErrorResponse{
-			Error: "failed to retrieve item",
-		})
+		if domainErrors.IsValidationError(err) {
+			return problem.Write(c, problem.New(http.StatusBadRequest, "invalid_serial_number"))
+		}
+		return problem.Write(c, problem.New(http.StatusInternalServerError, "failed_to_retrieve_item"))
 	}
 
-	return c.JSON(http.StatusOK, item)
+	return resource.WriteItem(c, http.StatusOK, item)
 }
 
+// ?dry_run=true の場合は保存せず、登録される内容を200で返す（取り込み前の確認用）
 func (h *ItemHandler) CreateItem(c echo.Context) error {
+	dryRun := false
+	if value := c.QueryParam("dry_run"); value != "" {
+		parsed, err := strconv.ParseBool(value)
+		if err != nil {
+			return problem.Write(c, problem.New(http.StatusBadRequest, "invalid_dry_run"))
+		}
+		dryRun = parsed
+	}
+
 	var input usecase.CreateItemInput
 	if err := c.Bind(&input); err != nil {
-		return c.JSON(http.StatusBadRequest, ErrorResponse{
-			Error: "invalid request format",
-		})
+		return problem.Write(c, problem.New(http.StatusBadRequest, "invalid_request_format"))
 	}
 
 	// バリデーション
 	if validationErrors := validateCreateItemInput(input); len(validationErrors) > 0 {
-		return c.JSON(http.StatusBadRequest, ErrorResponse{
-			Error:   "validation failed",
-			Details: validationErrors,
-		})
+		return problem.Write(c, problem.New(http.StatusBadRequest, "validation_failed").
+			WithDetails(validationErrors.Messages()...).
+			WithErrors(validationErrors))
+	}
+
+	if dryRun {
+		item, err := h.itemUsecase.ValidateItem(c.Request().Context(), input)
+		if err != nil {
+			return problem.Write(c, problem.FromError(err, "failed_to_validate_item"))
+		}
+		return resource.WriteItem(c, http.StatusOK, item)
 	}
 
 	item, err := h.itemUsecase.CreateItem(c.Request().Context(), input)
 	if err != nil {
-		if domainErrors.IsValidationError(err) {
-			return c.JSON(http.StatusBadRequest, ErrorResponse{
-				Error:   "validation failed",
-				Details: []string{err.Error()},
-			})
-		}
-		return c.JSON(http.StatusInternalServerError, ErrorResponse{
-			Error: "failed to create item",
-		})
+		return problem.Write(c, problem.FromError(err, "failed_to_create_item"))
 	}
 
-	return c.JSON(http.StatusCreated, item)
+	return resource.WriteItem(c, http.StatusCreated, item)
 }
 
 func (h *ItemHandler) DeleteItem(c echo.Context) error {
 	idStr := c.Param("id")
 	id, err := strconv.ParseInt(idStr, 10, 64)
 	if err != nil {
-		return c.JSON(http.StatusBadRequest, ErrorResponse{
-			Error: "invalid item ID",
-		})
+		return problem.Write(c, problem.New(http.StatusBadRequest, "invalid_item_id"))
 	}
 
 	err = h.itemUsecase.DeleteItem(c.Request().Context(), id)
 	if err != nil {
-		if domainErrors.IsNotFoundError(err) {
-			return c.JSON(http.StatusNotFound, ErrorResponse{
-				Error: "item not found",
-			})
-		}
-		return c.JSON(http.StatusInternalServerError, ErrorResponse{
-			Error: "failed to delete item",
-		})
+		return problem.Write(c, problem.FromError(err, "failed_to_delete_item"))
 	}
 
 	return c.NoContent(http.StatusNoContent)
@@ -127,12 +186,100 @@ func (h *ItemHandler) DeleteItem(c echo.Context) error {
 func (h *ItemHandler) GetSummary(c echo.Context) error {
 	summary, err := h.itemUsecase.GetCategorySummary(c.Request().Context())
 	if err != nil {
-		return c.JSON(http.StatusInternalServerError, ErrorResponse{
-			Error: "failed to retrieve summary",
-		})
+		return problem.Write(c, problem.New(http.StatusInternalServerError, "failed_to_retrieve_summary"))
+	}
+
+	categories := make([]string, 0, len(summary.Categories))
+	for category := range summary.Categories {
+		categories = append(categories, category)
+	}
+	return c.JSON(http.StatusOK, SummaryResponse{
+		CategorySummary: summary,
+		CategoryLabels:  i18n.CategoryNames(resource.Lang(c), categories),
+	})
+}
+
+// GetDuplicates GET /items/duplicates エンドポイント
+func (h *ItemHandler) GetDuplicates(c echo.Context) error {
+	groups, err := h.itemUsecase.FindDuplicateItems(c.Request().Context())
+	if err != nil {
+		return problem.Write(c, problem.New(http.StatusInternalServerError, "failed_to_detect_duplicates"))
+	}
+
+	return c.JSON(http.StatusOK, groups)
+}
+
+// MergeItems POST /items/{id}/merge エンドポイント
+func (h *ItemHandler) MergeItems(c echo.Context) error {
+	idStr := c.Param("id")
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		return problem.Write(c, problem.New(http.StatusBadRequest, "invalid_item_id"))
+	}
+
+	var input usecase.MergeItemsInput
+	if err := c.Bind(&input); err != nil {
+		return problem.Write(c, problem.New(http.StatusBadRequest, "invalid_request_format"))
+	}
+
+	item, err := h.itemUsecase.MergeItems(c.Request().Context(), id, input)
+	if err != nil {
+		return problem.Write(c, problem.FromError(err, "failed_to_merge_items"))
+	}
+
+	return resource.WriteItem(c, http.StatusOK, item)
+}
+
+// CloneItem POST /items/{id}/clone エンドポイント
+func (h *ItemHandler) CloneItem(c echo.Context) error {
+	idStr := c.Param("id")
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		return problem.Write(c, problem.New(http.StatusBadRequest, "invalid_item_id"))
+	}
+
+	// ボディは任意（上書きするフィールドのみ指定）
+	var input usecase.CloneItemInput
+	if err := c.Bind(&input); err != nil {
+		return problem.Write(c, problem.New(http.StatusBadRequest, "invalid_request_format"))
+	}
+
+	item, err := h.itemUsecase.CloneItem(c.Request().Context(), id, input)
+	if err != nil {
+		return problem.Write(c, problem.FromError(err, "failed_to_clone_item"))
 	}
 
-	return c.JSON(http.StatusOK, summary)
+	return resource.WriteItem(c, http.StatusCreated, item)
+}
+
+// ArchiveItem POST /items/{id}/archive エンドポイント
+func (h *ItemHandler) ArchiveItem(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return problem.Write(c, problem.New(http.StatusBadRequest, "invalid_item_id"))
+	}
+
+	item, err := h.itemUsecase.ArchiveItem(c.Request().Context(), id)
+	if err != nil {
+		return problem.Write(c, problem.FromError(err, "failed_to_update_item"))
+	}
+
+	return resource.WriteItem(c, http.StatusOK, item)
+}
+
+// UnarchiveItem POST /items/{id}/unarchive エンドポイント
+func (h *ItemHandler) UnarchiveItem(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return problem.Write(c, problem.New(http.StatusBadRequest, "invalid_item_id"))
+	}
+
+	item, err := h.itemUsecase.UnarchiveItem(c.Request().Context(), id)
+	if err != nil {
+		return problem.Write(c, problem.FromError(err, "failed_to_update_item"))
+	}
+
+	return resource.WriteItem(c, http.StatusOK, item)
 }
 
 // PatchItem PATCH /items/{id} エンドポイント
@@ -140,66 +287,100 @@ func (h *ItemHandler) PatchItem(c echo.Context) error {
 	idStr := c.Param("id")
 	id, err := strconv.ParseInt(idStr, 10, 64)
 	if err != nil {
-		return c.JSON(http.StatusBadRequest, ErrorResponse{
-			Error: "invalid item ID",
-		})
+		return problem.Write(c, problem.New(http.StatusBadRequest, "invalid_item_id"))
 	}
 
 	// usecase.UpdateItemInputを使用
 	var input usecase.UpdateItemInput
 	if err := c.Bind(&input); err != nil {
-		return c.JSON(http.StatusBadRequest, ErrorResponse{
-			Error: "invalid request format",
-		})
+		return problem.Write(c, problem.New(http.StatusBadRequest, "invalid_request_format"))
 	}
 
 	// 少なくとも1つのフィールドが指定されているかチェック
-	if input.Name == nil && input.Brand == nil && input.PurchasePrice == nil {
-		return c.JSON(http.StatusBadRequest, ErrorResponse{
-			Error: "at least one field (name, brand, or purchase_price) must be provided for update",
-		})
+	if input.Name == nil && input.Brand == nil && input.PurchasePrice == nil && input.Condition == nil && input.SerialNumber == nil && input.Attributes == nil {
+		return problem.Write(c, problem.New(http.StatusBadRequest, "no_fields_to_update"))
 	}
 
 	// 部分更新の実行
 	item, err := h.itemUsecase.PartialUpdateItem(c.Request().Context(), id, input)
 	if err != nil {
-		if domainErrors.IsNotFoundError(err) {
-			return c.JSON(http.StatusNotFound, ErrorResponse{
-				Error: "item not found",
-			})
+		return problem.Write(c, problem.FromError(err, "failed_to_update_item"))
+	}
+
+	return resource.WriteItem(c, http.StatusOK, item)
+}
+
+// クエリパラメータから一覧の絞り込み条件を作成
+func (h *ItemHandler) parseItemFilter(c echo.Context) (entity.ItemFilter, error) {
+	var filter entity.ItemFilter
+
+	if locationStr := c.QueryParam("location"); locationStr != "" {
+		locationID, err := strconv.ParseInt(locationStr, 10, 64)
+		if err != nil || locationID <= 0 {
+			return filter, errors.New("invalid location ID")
 		}
-		if domainErrors.IsValidationError(err) {
-			return c.JSON(http.StatusBadRequest, ErrorResponse{
-				Error:   "validation failed",
-				Details: []string{err.Error()},
-			})
+		filter.LocationID = &locationID
+	}
+
+	filter.Category = strings.TrimSpace(c.QueryParam("category"))
+	filter.Brand = strings.TrimSpace(c.QueryParam("brand"))
+	filter.Condition = strings.ToUpper(strings.TrimSpace(c.QueryParam("condition")))
+
+	if favoritesStr := c.QueryParam("favorites"); favoritesStr != "" {
+		favorites, err := strconv.ParseBool(favoritesStr)
+		if err != nil {
+			return filter, errors.New("favorites must be true or false")
+		}
+		// お気に入りはリクエストの閲覧者のものに絞り込む
+		if favorites {
+			filter.Favorites = true
+			filter.Viewer = h.viewer(c)
+		}
+	}
+
+	// ?include=archived でアーカイブしたアイテムも含める
+	if include := c.QueryParam("include"); include != "" {
+		for _, value := range strings.Split(include, ",") {
+			if strings.TrimSpace(value) != "archived" {
+				return filter, errors.New("include must be archived")
+			}
+			filter.IncludeArchived = true
+		}
+	}
+
+	// ?attr.movement=automatic のようにカスタム属性で絞り込む
+	for key, values := range c.QueryParams() {
+		attrKey, ok := strings.CutPrefix(key, "attr.")
+		if !ok || len(values) == 0 {
+			continue
+		}
+		if filter.Attributes == nil {
+			filter.Attributes = make(map[string]string)
 		}
-		return c.JSON(http.StatusInternalServerError, ErrorResponse{
-			Error: "failed to update item",
-		})
+		filter.Attributes[attrKey] = strings.TrimSpace(values[0])
 	}
 
-	return c.JSON(http.StatusOK, item)
+	return filter, nil
 }
 
-func validateCreateItemInput(input usecase.CreateItemInput) []string {
-	var errs []string
+func validateCreateItemInput(input usecase.CreateItemInput) entity.FieldErrors {
+	var errs entity.FieldErrors
 
 	// Basic required field validation
 	if input.Name == "" {
-		errs = append(errs, "name is required")
+		errs = append(errs, entity.FieldError{Field: "name", Value: input.Name, Rule: "required", Message: "name is required"})
 	}
 	if input.Category == "" {
-		errs = append(errs, "category is required")
+		errs = append(errs, entity.FieldError{Field: "category", Value: input.Category, Rule: "required", Message: "category is required"})
 	}
 	if input.Brand == "" {
-		errs = append(errs, "brand is required")
+		errs = append(errs, entity.FieldError{Field: "brand", Value: input.Brand, Rule: "required", Message: "brand is required"})
 	}
 	if input.PurchaseDate == "" {
-		errs = append(errs, "purchase_date is required")
+		errs = append(errs, entity.FieldError{Field: "purchase_date", Value: input.PurchaseDate, Rule: "required", Message: "purchase_date is required"})
 	}
 	if input.PurchasePrice < 0 {
-		errs = append(errs, "purchase_price must be 0 or greater")
+		errs = append(errs, entity.FieldError{Field: "purchase_price", Value: input.PurchasePrice, Rule: "min", Message: "purchase_price must be 0 or greater"})
 	}
 
 	return errs
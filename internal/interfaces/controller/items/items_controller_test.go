@@ -4,125 +4,80 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"image/png"
 	"net/http"
 	"net/http/httptest"
 	"strconv"
 	"testing"
 
 	"Aicon-assignment/internal/domain/entity"
-	domainErrors "Aicon-assignment/internal/domain/errors"
+	"Aicon-assignment/internal/interfaces/masking"
+	"Aicon-assignment/internal/interfaces/problem"
+	"Aicon-assignment/internal/interfaces/resource"
+	"Aicon-assignment/internal/testutil"
 	"Aicon-assignment/internal/usecase"
 
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/assert"
-	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 )
 
-// MockItemUsecase is a mock implementation of ItemUsecase for testing
-type MockItemUsecase struct {
-	mock.Mock
-}
-
-func (m *MockItemUsecase) GetAllItems(ctx context.Context) ([]*entity.Item, error) {
-	args := m.Called(ctx)
-	return args.Get(0).([]*entity.Item), args.Error(1)
-}
-
-func (m *MockItemUsecase) GetItemByID(ctx context.Context, id int64) (*entity.Item, error) {
-	args := m.Called(ctx, id)
-	return args.Get(0).(*entity.Item), args.Error(1)
-}
-
-func (m *MockItemUsecase) CreateItem(ctx context.Context, input usecase.CreateItemInput) (*entity.Item, error) {
-	args := m.Called(ctx, input)
-	return args.Get(0).(*entity.Item), args.Error(1)
-}
-
-func (m *MockItemUsecase) PartialUpdateItem(ctx context.Context, id int64, input usecase.UpdateItemInput) (*entity.Item, error) {
-	args := m.Called(ctx, id, input)
-	return args.Get(0).(*entity.Item), args.Error(1)
-}
-
-func (m *MockItemUsecase) DeleteItem(ctx context.Context, id int64) error {
-	args := m.Called(ctx, id)
-	return args.Error(0)
-}
-
-func (m *MockItemUsecase) GetCategorySummary(ctx context.Context) (*usecase.CategorySummary, error) {
-	args := m.Called(ctx)
-	return args.Get(0).(*usecase.CategorySummary), args.Error(1)
+func viewerOf(c echo.Context) string {
+	viewer, _ := c.Get("user").(string)
+	return viewer
 }
 
 func TestItemHandler_PatchItem(t *testing.T) {
 	e := echo.New()
 
 	t.Run("Successfully update item name", func(t *testing.T) {
-		mockUsecase := new(MockItemUsecase)
-		handler := NewItemHandler(mockUsecase)
+		itemUsecase := testutil.NewItemUsecase()
+		handler := NewItemHandler(itemUsecase, viewerOf)
+		item := itemUsecase.Repo.Seed(t, daytona("D123456"))[0]
 
-		itemID := int64(1)
 		updateInput := usecase.UpdateItemInput{
 			Name: stringPtr("Updated Item Name"),
 		}
 
-		expectedItem := &entity.Item{
-			ID:       itemID,
-			Name:     "Updated Item Name",
-			Category: "時計",
-			Brand:    "ROLEX",
-		}
-
-		mockUsecase.On("PartialUpdateItem", mock.Anything, itemID, updateInput).Return(expectedItem, nil)
-
 		requestBody, _ := json.Marshal(updateInput)
-		req := httptest.NewRequest(http.MethodPatch, "/items/1", bytes.NewReader(requestBody))
+		req := httptest.NewRequest(http.MethodPatch, "/items/"+itemIDParam(item), bytes.NewReader(requestBody))
 		req.Header.Set("Content-Type", "application/json")
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
 		c.SetPath("/items/:id")
 		c.SetParamNames("id")
-		c.SetParamValues(strconv.FormatInt(itemID, 10))
+		c.SetParamValues(itemIDParam(item))
 
 		err := handler.PatchItem(c)
 
 		assert.NoError(t, err)
 		assert.Equal(t, http.StatusOK, rec.Code)
 
-		var response entity.Item
+		var response resource.Item
 		json.Unmarshal(rec.Body.Bytes(), &response)
 		assert.Equal(t, "Updated Item Name", response.Name)
-
-		mockUsecase.AssertExpectations(t)
+		assert.Equal(t, "ROLEX", response.Brand)
+		assert.Equal(t, "/api/v1/items/"+itemIDParam(item), response.Links["self"].Href)
 	})
 
 	t.Run("Successfully update item brand and price", func(t *testing.T) {
-		mockUsecase := new(MockItemUsecase)
-		handler := NewItemHandler(mockUsecase)
+		itemUsecase := testutil.NewItemUsecase()
+		handler := NewItemHandler(itemUsecase, viewerOf)
+		item := itemUsecase.Repo.Seed(t, daytona("D123456"))[0]
 
-		itemID := int64(2)
 		updateInput := usecase.UpdateItemInput{
 			Brand:         stringPtr("Updated Brand"),
 			PurchasePrice: intPtr(2000000),
 		}
 
-		expectedItem := &entity.Item{
-			ID:            itemID,
-			Name:          "ロレックス デイトナ",
-			Category:      "時計",
-			Brand:         "Updated Brand",
-			PurchasePrice: 2000000,
-		}
-
-		mockUsecase.On("PartialUpdateItem", mock.Anything, itemID, updateInput).Return(expectedItem, nil)
-
 		requestBody, _ := json.Marshal(updateInput)
-		req := httptest.NewRequest(http.MethodPatch, "/items/2", bytes.NewReader(requestBody))
+		req := httptest.NewRequest(http.MethodPatch, "/items/"+itemIDParam(item), bytes.NewReader(requestBody))
 		req.Header.Set("Content-Type", "application/json")
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
 		c.SetPath("/items/:id")
 		c.SetParamNames("id")
-		c.SetParamValues(strconv.FormatInt(itemID, 10))
+		c.SetParamValues(itemIDParam(item))
 
 		err := handler.PatchItem(c)
 
@@ -131,23 +86,19 @@ func TestItemHandler_PatchItem(t *testing.T) {
 
 		var response entity.Item
 		json.Unmarshal(rec.Body.Bytes(), &response)
+		assert.Equal(t, "ロレックス デイトナ", response.Name)
 		assert.Equal(t, "Updated Brand", response.Brand)
 		assert.Equal(t, 2000000, response.PurchasePrice)
-
-		mockUsecase.AssertExpectations(t)
 	})
 
 	t.Run("Item not found", func(t *testing.T) {
-		mockUsecase := new(MockItemUsecase)
-		handler := NewItemHandler(mockUsecase)
+		itemUsecase := testutil.NewItemUsecase()
+		handler := NewItemHandler(itemUsecase, viewerOf)
 
-		itemID := int64(999)
 		updateInput := usecase.UpdateItemInput{
 			Name: stringPtr("Non-existent Item"),
 		}
 
-		mockUsecase.On("PartialUpdateItem", mock.Anything, itemID, updateInput).Return((*entity.Item)(nil), domainErrors.ErrItemNotFound)
-
 		requestBody, _ := json.Marshal(updateInput)
 		req := httptest.NewRequest(http.MethodPatch, "/items/999", bytes.NewReader(requestBody))
 		req.Header.Set("Content-Type", "application/json")
@@ -155,23 +106,22 @@ func TestItemHandler_PatchItem(t *testing.T) {
 		c := e.NewContext(req, rec)
 		c.SetPath("/items/:id")
 		c.SetParamNames("id")
-		c.SetParamValues(strconv.FormatInt(itemID, 10))
+		c.SetParamValues("999")
 
 		err := handler.PatchItem(c)
 
 		assert.NoError(t, err)
 		assert.Equal(t, http.StatusNotFound, rec.Code)
 
-		var response ErrorResponse
+		var response problem.Problem
 		json.Unmarshal(rec.Body.Bytes(), &response)
-		assert.Equal(t, "item not found", response.Error)
-
-		mockUsecase.AssertExpectations(t)
+		assert.Equal(t, "item not found", response.Title)
+		assert.Equal(t, "item_not_found", response.Code)
 	})
 
 	t.Run("Invalid item ID", func(t *testing.T) {
-		mockUsecase := new(MockItemUsecase)
-		handler := NewItemHandler(mockUsecase)
+		itemUsecase := testutil.NewItemUsecase()
+		handler := NewItemHandler(itemUsecase, viewerOf)
 
 		req := httptest.NewRequest(http.MethodPatch, "/items/invalid", nil)
 		rec := httptest.NewRecorder()
@@ -185,14 +135,15 @@ func TestItemHandler_PatchItem(t *testing.T) {
 		assert.NoError(t, err)
 		assert.Equal(t, http.StatusBadRequest, rec.Code)
 
-		var response ErrorResponse
+		var response problem.Problem
 		json.Unmarshal(rec.Body.Bytes(), &response)
-		assert.Equal(t, "invalid item ID", response.Error)
+		assert.Equal(t, "invalid_item_id", response.Code)
+		assert.Equal(t, 0, itemUsecase.Calls("PartialUpdateItem"))
 	})
 
 	t.Run("No fields provided for update", func(t *testing.T) {
-		mockUsecase := new(MockItemUsecase)
-		handler := NewItemHandler(mockUsecase)
+		itemUsecase := testutil.NewItemUsecase()
+		handler := NewItemHandler(itemUsecase, viewerOf)
 
 		updateInput := usecase.UpdateItemInput{} // 空の入力
 
@@ -210,14 +161,15 @@ func TestItemHandler_PatchItem(t *testing.T) {
 		assert.NoError(t, err)
 		assert.Equal(t, http.StatusBadRequest, rec.Code)
 
-		var response ErrorResponse
+		var response problem.Problem
 		json.Unmarshal(rec.Body.Bytes(), &response)
-		assert.Contains(t, response.Error, "at least one field")
+		assert.Equal(t, "no_fields_to_update", response.Code)
+		assert.Equal(t, 0, itemUsecase.Calls("PartialUpdateItem"))
 	})
 
 	t.Run("Invalid JSON", func(t *testing.T) {
-		mockUsecase := new(MockItemUsecase)
-		handler := NewItemHandler(mockUsecase)
+		itemUsecase := testutil.NewItemUsecase()
+		handler := NewItemHandler(itemUsecase, viewerOf)
 
 		req := httptest.NewRequest(http.MethodPatch, "/items/1", bytes.NewReader([]byte("invalid json")))
 		req.Header.Set("Content-Type", "application/json")
@@ -232,13 +184,512 @@ func TestItemHandler_PatchItem(t *testing.T) {
 		assert.NoError(t, err)
 		assert.Equal(t, http.StatusBadRequest, rec.Code)
 
-		var response ErrorResponse
+		var response problem.Problem
+		json.Unmarshal(rec.Body.Bytes(), &response)
+		assert.Equal(t, "invalid_request_format", response.Code)
+		assert.Equal(t, 0, itemUsecase.Calls("PartialUpdateItem"))
+	})
+}
+
+func TestItemHandler_GetItem_JSONAPI(t *testing.T) {
+	e := echo.New()
+	itemUsecase := testutil.NewItemUsecase()
+	handler := NewItemHandler(itemUsecase, viewerOf)
+	item := itemUsecase.Repo.Seed(t, daytona("D123456"))[0]
+
+	req := httptest.NewRequest(http.MethodGet, "/items/"+itemIDParam(item), nil)
+	req.Header.Set("Accept", resource.JSONAPIContentType)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetPath("/items/:id")
+	c.SetParamNames("id")
+	c.SetParamValues(itemIDParam(item))
+
+	err := handler.GetItem(c)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, resource.JSONAPIContentType, rec.Header().Get("Content-Type"))
+
+	var response struct {
+		Data struct {
+			Type       string            `json:"type"`
+			ID         string            `json:"id"`
+			Attributes map[string]any    `json:"attributes"`
+			Links      map[string]string `json:"links"`
+		} `json:"data"`
+	}
+	json.Unmarshal(rec.Body.Bytes(), &response)
+	assert.Equal(t, "items", response.Data.Type)
+	assert.Equal(t, itemIDParam(item), response.Data.ID)
+	assert.Equal(t, "ロレックス デイトナ", response.Data.Attributes["name"])
+	assert.Equal(t, "/api/v1/items/"+itemIDParam(item), response.Data.Links["self"])
+}
+
+func TestItemHandler_GetItemCount(t *testing.T) {
+	e := echo.New()
+
+	t.Run("正常系: 絞り込み条件に合う数をヘッダーと本文で返す", func(t *testing.T) {
+		itemUsecase := testutil.NewItemUsecase()
+		handler := NewItemHandler(itemUsecase, viewerOf)
+		bag := birkin()
+		bag.Condition = "B"
+		itemUsecase.Repo.Seed(t, daytona("D123456"), daytona("D654321"), bag)
+
+		req := httptest.NewRequest(http.MethodGet, "/items/count?condition=a", nil)
+		rec := httptest.NewRecorder()
+
+		err := handler.GetItemCount(e.NewContext(req, rec))
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "2", rec.Header().Get(HeaderTotalCount))
+		assert.JSONEq(t, `{"count":2}`, rec.Body.String())
+	})
+
+	t.Run("正常系: アーカイブしたアイテムも含める", func(t *testing.T) {
+		itemUsecase := testutil.NewItemUsecase()
+		handler := NewItemHandler(itemUsecase, viewerOf)
+		items := itemUsecase.Repo.Seed(t, daytona("D123456"), birkin())
+		_, err := itemUsecase.ArchiveItem(context.Background(), items[1].ID)
+		require.NoError(t, err)
+
+		for query, want := range map[string]string{"": `{"count":1}`, "?include=archived": `{"count":2}`} {
+			req := httptest.NewRequest(http.MethodGet, "/items/count"+query, nil)
+			rec := httptest.NewRecorder()
+
+			err := handler.GetItemCount(e.NewContext(req, rec))
+
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusOK, rec.Code)
+			assert.JSONEq(t, want, rec.Body.String(), query)
+		}
+	})
+
+	t.Run("正常系: リクエストの閲覧者のお気に入りのみに絞り込む", func(t *testing.T) {
+		itemUsecase := testutil.NewItemUsecase()
+		handler := NewItemHandler(itemUsecase, viewerOf)
+		items := itemUsecase.Repo.Seed(t, daytona("D123456"), daytona("D654321"), birkin())
+		itemUsecase.Repo.SeedFavorite(t, "admin", items[0].ID)
+		itemUsecase.Repo.SeedFavorite(t, "admin", items[2].ID)
+		itemUsecase.Repo.SeedFavorite(t, "", items[1].ID)
+
+		req := httptest.NewRequest(http.MethodGet, "/items/count?favorites=true", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.Set("user", "admin")
+
+		err := handler.GetItemCount(c)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.JSONEq(t, `{"count":2}`, rec.Body.String())
+	})
+
+	t.Run("異常系: 不正な絞り込み条件", func(t *testing.T) {
+		itemUsecase := testutil.NewItemUsecase()
+		handler := NewItemHandler(itemUsecase, viewerOf)
+
+		for _, query := range []string{"location=abc", "favorites=yes", "include=deleted"} {
+			req := httptest.NewRequest(http.MethodGet, "/items/count?"+query, nil)
+			rec := httptest.NewRecorder()
+
+			err := handler.GetItemCount(e.NewContext(req, rec))
+
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusBadRequest, rec.Code, query)
+		}
+		assert.Equal(t, 0, itemUsecase.Calls("CountItems"))
+	})
+}
+
+func TestItemHandler_GetSummary(t *testing.T) {
+	seed := func(t *testing.T) *testutil.ItemUsecase {
+		itemUsecase := testutil.NewItemUsecase()
+		itemUsecase.Repo.Seed(t, daytona("D123456"), daytona("D234567"), daytona("D345678"), birkin())
+		return itemUsecase
+	}
+
+	t.Run("正常系: 集計のキーは保存している値のまま、表示名を言語ごとに返す", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/items/summary", nil)
+		req.Header.Set("Accept-Language", "en-US")
+		rec := httptest.NewRecorder()
+
+		assert.NoError(t, NewItemHandler(seed(t), viewerOf).GetSummary(echo.New().NewContext(req, rec)))
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.JSONEq(t, `{
+			"categories": {"時計": 3, "バッグ": 1, "ジュエリー": 0, "靴": 0, "その他": 0},
+			"conditions": {"S": 0, "A": 4, "B": 0, "C": 0, "N": 0},
+			"total": 4,
+			"category_labels": {"時計": "Watches", "バッグ": "Bags", "ジュエリー": "Jewelry", "靴": "Shoes", "その他": "Other"}
+		}`, rec.Body.String())
+		assert.Contains(t, rec.Header().Values(echo.HeaderVary), "Accept-Language")
+	})
+
+	t.Run("正常系: 日本語では保存している値と同じ", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/items/summary", nil)
+		req.Header.Set("Accept-Language", "ja")
+		rec := httptest.NewRecorder()
+
+		assert.NoError(t, NewItemHandler(seed(t), viewerOf).GetSummary(echo.New().NewContext(req, rec)))
+
+		assert.Contains(t, rec.Body.String(), `"category_labels":{"その他":"その他","ジュエリー":"ジュエリー","バッグ":"バッグ","時計":"時計","靴":"靴"}`)
+	})
+}
+
+func TestItemHandler_GetUsage(t *testing.T) {
+	e := echo.New()
+
+	t.Run("正常系: 利用量と上限を返す", func(t *testing.T) {
+		itemUsecase := testutil.NewItemUsecaseWithQuota(usecase.Quota{MaxItems: 100})
+		handler := NewItemHandler(itemUsecase, viewerOf)
+		itemUsecase.Repo.Seed(t, daytona("D123456"), birkin())
+
+		req := httptest.NewRequest(http.MethodGet, "/me/usage", nil)
+		rec := httptest.NewRecorder()
+
+		err := handler.GetUsage(e.NewContext(req, rec))
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.JSONEq(t, `{"items":{"used":2,"limit":100}}`, rec.Body.String())
+	})
+
+	t.Run("異常系: 登録が上限に達している", func(t *testing.T) {
+		itemUsecase := testutil.NewItemUsecaseWithQuota(usecase.Quota{MaxItems: 1})
+		handler := NewItemHandler(itemUsecase, viewerOf)
+		itemUsecase.Repo.Seed(t, birkin())
+
+		body := `{"name": "ロレックス デイトナ", "category": "時計", "brand": "ROLEX", "purchase_price": 1500000, "purchase_date": "2023-01-15", "serial_number": "D123456"}`
+		req := httptest.NewRequest(http.MethodPost, "/items", bytes.NewBufferString(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+
+		err := handler.CreateItem(e.NewContext(req, rec))
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"code":"quota_exceeded"`)
+		assert.Equal(t, 0, itemUsecase.Repo.Calls("Create"))
+	})
+}
+
+func TestItemHandler_GetItemBySerial(t *testing.T) {
+	e := echo.New()
+
+	t.Run("Successfully find item by serial number", func(t *testing.T) {
+		itemUsecase := testutil.NewItemUsecase()
+		handler := NewItemHandler(itemUsecase, viewerOf)
+		itemUsecase.Repo.Seed(t, daytona("SN-0001"), daytona("SN-0002"))
+
+		req := httptest.NewRequest(http.MethodGet, "/items/by-serial/SN-0001", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetPath("/items/by-serial/:serial")
+		c.SetParamNames("serial")
+		c.SetParamValues("SN-0001")
+
+		err := handler.GetItemBySerial(c)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var response entity.Item
 		json.Unmarshal(rec.Body.Bytes(), &response)
-		assert.Equal(t, "invalid request format", response.Error)
+		assert.Equal(t, "SN-0001", response.SerialNumber)
+	})
+
+	t.Run("Item not found", func(t *testing.T) {
+		itemUsecase := testutil.NewItemUsecase()
+		handler := NewItemHandler(itemUsecase, viewerOf)
+		itemUsecase.Repo.Seed(t, daytona("SN-0001"))
+
+		req := httptest.NewRequest(http.MethodGet, "/items/by-serial/UNKNOWN", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetPath("/items/by-serial/:serial")
+		c.SetParamNames("serial")
+		c.SetParamValues("UNKNOWN")
+
+		err := handler.GetItemBySerial(c)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusNotFound, rec.Code)
 	})
 }
 
+func TestItemHandler_PatchItem_DuplicateSerial(t *testing.T) {
+	e := echo.New()
+	itemUsecase := testutil.NewItemUsecase()
+	handler := NewItemHandler(itemUsecase, viewerOf)
+	items := itemUsecase.Repo.Seed(t, daytona("SN-0001"), daytona("SN-0002"))
+
+	updateInput := usecase.UpdateItemInput{
+		SerialNumber: stringPtr("SN-0002"),
+	}
+
+	requestBody, _ := json.Marshal(updateInput)
+	req := httptest.NewRequest(http.MethodPatch, "/items/"+itemIDParam(items[0]), bytes.NewReader(requestBody))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetPath("/items/:id")
+	c.SetParamNames("id")
+	c.SetParamValues(itemIDParam(items[0]))
+
+	err := handler.PatchItem(c)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusConflict, rec.Code)
+
+	var response problem.Problem
+	json.Unmarshal(rec.Body.Bytes(), &response)
+	assert.Equal(t, "duplicate_serial_number", response.Code)
+	assert.Equal(t, "serial number already exists", response.Detail)
+}
+
+func TestItemHandler_PatchItem_RuleViolation(t *testing.T) {
+	e := echo.New()
+	itemUsecase := testutil.NewItemUsecase()
+	handler := NewItemHandler(itemUsecase, viewerOf)
+	item := itemUsecase.Repo.Seed(t, daytona("SN-0001"))[0]
+
+	// 時計はシリアル番号を必須とするカテゴリーのため、空にできない
+	updateInput := usecase.UpdateItemInput{
+		SerialNumber: stringPtr(""),
+	}
+
+	requestBody, _ := json.Marshal(updateInput)
+	req := httptest.NewRequest(http.MethodPatch, "/items/"+itemIDParam(item), bytes.NewReader(requestBody))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetPath("/items/:id")
+	c.SetParamNames("id")
+	c.SetParamValues(itemIDParam(item))
+
+	err := handler.PatchItem(c)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+	var response problem.Problem
+	json.Unmarshal(rec.Body.Bytes(), &response)
+	assert.Equal(t, "validation_failed", response.Code)
+	require.Len(t, response.Violations, 1)
+	assert.Equal(t, "serial_number", response.Violations[0].Field)
+	assert.Equal(t, "required", response.Violations[0].Rule)
+}
+
+func TestItemHandler_CreateItem_FieldErrors(t *testing.T) {
+	e := echo.New()
+	itemUsecase := testutil.NewItemUsecase()
+	handler := NewItemHandler(itemUsecase, viewerOf)
+
+	requestBody := `{"name":"ロレックス デイトナ","category":"時計","brand":"","purchase_price":-1,"purchase_date":"2023-01-15"}`
+	req := httptest.NewRequest(http.MethodPost, "/items", bytes.NewReader([]byte(requestBody)))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	err := handler.CreateItem(c)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+	var response problem.Problem
+	json.Unmarshal(rec.Body.Bytes(), &response)
+	assert.Equal(t, "validation_failed", response.Code)
+	assert.Equal(t, []string{"brand is required", "purchase_price must be 0 or greater"}, response.Details)
+	assert.Equal(t, []entity.FieldError{
+		{Field: "brand", Value: "", Rule: "required", Message: "brand is required"},
+		// 購入価格は伏せる項目のため、受け付けなかった値を返さない
+		{Field: "purchase_price", Value: masking.Redacted, Rule: "min", Message: "purchase_price must be 0 or greater"},
+	}, response.Errors)
+
+	assert.Equal(t, 0, itemUsecase.Calls("CreateItem"))
+}
+
+func TestItemHandler_CreateItem_DryRun(t *testing.T) {
+	requestBody := `{"name":" ロレックス デイトナ ","category":"時計","brand":"ROLEX","purchase_price":1500000,"purchase_date":"2023-01-15","serial_number":"D123456"}`
+
+	tests := []struct {
+		name       string
+		query      string
+		seed       []*entity.Item
+		wantStatus int
+		wantCode   string
+	}{
+		{
+			name:       "正常系: 保存せずに登録される内容を返す",
+			query:      "?dry_run=true",
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "異常系: シリアル番号が登録済み",
+			query:      "?dry_run=1",
+			seed:       []*entity.Item{daytona("D123456")},
+			wantStatus: http.StatusConflict,
+			wantCode:   "duplicate_serial_number",
+		},
+		{
+			name:       "異常系: dry_runが真偽値でない",
+			query:      "?dry_run=maybe",
+			wantStatus: http.StatusBadRequest,
+			wantCode:   "invalid_dry_run",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			itemUsecase := testutil.NewItemUsecase()
+			itemUsecase.Repo.Seed(t, tt.seed...)
+			handler := NewItemHandler(itemUsecase, viewerOf)
+
+			req := httptest.NewRequest(http.MethodPost, "/items"+tt.query, bytes.NewReader([]byte(requestBody)))
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			err := handler.CreateItem(c)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantStatus, rec.Code)
+			if tt.wantCode != "" {
+				var response problem.Problem
+				json.Unmarshal(rec.Body.Bytes(), &response)
+				assert.Equal(t, tt.wantCode, response.Code)
+			} else {
+				assert.Contains(t, rec.Body.String(), `"name":"ロレックス デイトナ"`)
+			}
+			assert.Equal(t, 0, itemUsecase.Calls("CreateItem"))
+			assert.Equal(t, 0, itemUsecase.Repo.Calls("Create"))
+		})
+	}
+}
+
+func TestItemHandler_CloneItem(t *testing.T) {
+	// 複製ではシリアル番号を引き継がないため、時計はシリアル番号を指定しないと複製できない
+	tests := []struct {
+		name       string
+		body       string
+		wantStatus int
+	}{
+		{
+			name:       "正常系: 時計はシリアル番号を指定して複製",
+			body:       `{"serial_number":"D654321"}`,
+			wantStatus: http.StatusCreated,
+		},
+		{
+			name:       "異常系: 時計はシリアル番号なしでは複製できない",
+			body:       `{}`,
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			itemUsecase := testutil.NewItemUsecase()
+			handler := NewItemHandler(itemUsecase, viewerOf)
+			source := itemUsecase.Repo.Seed(t, daytona("D123456"))[0]
+
+			req := httptest.NewRequest(http.MethodPost, "/items/"+itemIDParam(source)+"/clone", bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/items/:id/clone")
+			c.SetParamNames("id")
+			c.SetParamValues(itemIDParam(source))
+
+			err := handler.CloneItem(c)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantStatus, rec.Code)
+			if tt.wantStatus != http.StatusCreated {
+				var response problem.Problem
+				json.Unmarshal(rec.Body.Bytes(), &response)
+				assert.Equal(t, "validation_failed", response.Code)
+				require.Len(t, response.Violations, 1)
+				assert.Equal(t, "serial_number", response.Violations[0].Field)
+				assert.Equal(t, 0, itemUsecase.Repo.Calls("Create"))
+				return
+			}
+
+			var response entity.Item
+			json.Unmarshal(rec.Body.Bytes(), &response)
+			assert.NotEqual(t, source.ID, response.ID)
+			assert.Equal(t, "ロレックス デイトナ", response.Name)
+			assert.Equal(t, "D654321", response.SerialNumber)
+		})
+	}
+}
+
+func TestItemHandler_GetItemLabel(t *testing.T) {
+	e := echo.New()
+
+	t.Run("Successfully render label", func(t *testing.T) {
+		itemUsecase := testutil.NewItemUsecase()
+		handler := NewItemHandler(itemUsecase, viewerOf)
+		item := itemUsecase.Repo.Seed(t, daytona("SN-0001"))[0]
+
+		req := httptest.NewRequest(http.MethodGet, "/items/"+itemIDParam(item)+"/label.png", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetPath("/items/:id/label.png")
+		c.SetParamNames("id")
+		c.SetParamValues(itemIDParam(item))
+
+		err := handler.GetItemLabel(c)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "image/png", rec.Header().Get("Content-Type"))
+
+		img, err := png.Decode(rec.Body)
+		assert.NoError(t, err)
+		assert.Equal(t, labelQRSize, img.Bounds().Dx())
+	})
+
+	t.Run("Item not found", func(t *testing.T) {
+		itemUsecase := testutil.NewItemUsecase()
+		handler := NewItemHandler(itemUsecase, viewerOf)
+
+		req := httptest.NewRequest(http.MethodGet, "/items/999/label.png", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetPath("/items/:id/label.png")
+		c.SetParamNames("id")
+		c.SetParamValues("999")
+
+		err := handler.GetItemLabel(c)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+}
+
+func TestPrintableLabelText(t *testing.T) {
+	assert.Equal(t, "#1 ROLEX", printableLabelText("#1 ROLEX", 240))
+	assert.Equal(t, "#1 ?????", printableLabelText("#1 ロレックス", 240))
+	assert.Equal(t, "abcd~", printableLabelText("abcdefgh", 35))
+}
+
 // ヘルパー関数
+func daytona(serialNumber string) *entity.Item {
+	return &entity.Item{Name: "ロレックス デイトナ", Category: "時計", Brand: "ROLEX", PurchasePrice: 1500000, PurchaseDate: "2023-01-15", Condition: "A", SerialNumber: serialNumber}
+}
+
+func birkin() *entity.Item {
+	return &entity.Item{Name: "バーキン 30", Category: "バッグ", Brand: "HERMÈS", PurchasePrice: 2000000, PurchaseDate: "2023-06-01", Condition: "A"}
+}
+
+func itemIDParam(item *entity.Item) string {
+	return strconv.FormatInt(item.ID, 10)
+}
+
 func stringPtr(s string) *string {
 	return &s
 }
@@ -0,0 +1,109 @@
+package controller
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/skip2/go-qrcode"
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/basicfont"
+	"golang.org/x/image/math/fixed"
+
+	"Aicon-assignment/internal/domain/entity"
+	"Aicon-assignment/internal/interfaces/problem"
+)
+
+// ラベル画像のレイアウト
+const (
+	labelQRSize     = 256
+	labelPadding    = 8
+	labelLineHeight = 16
+)
+
+// GetItemLabel GET /items/{id}/label.png エンドポイント
+func (h *ItemHandler) GetItemLabel(c echo.Context) error {
+	idStr := c.Param("id")
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		return problem.Write(c, problem.New(http.StatusBadRequest, "invalid_item_id"))
+	}
+
+	item, err := h.itemUsecase.GetItemByID(c.Request().Context(), id)
+	if err != nil {
+		return problem.Write(c, problem.FromError(err, "failed_to_retrieve_item"))
+	}
+
+	// スキャンしたらアイテム詳細に戻れるよう、リクエスト元のホストでURLを組み立てる
+	itemURL := fmt.Sprintf("%s://%s/items/%d", c.Scheme(), c.Request().Host, item.ID)
+
+	label, err := renderItemLabel(item, itemURL)
+	if err != nil {
+		return problem.Write(c, problem.New(http.StatusInternalServerError, "failed_to_render_label"))
+	}
+
+	return c.Blob(http.StatusOK, "image/png", label)
+}
+
+// QRコードの下にID・名前・シリアル番号を印字したPNGを生成する
+func renderItemLabel(item *entity.Item, itemURL string) ([]byte, error) {
+	qr, err := qrcode.New(itemURL, qrcode.Medium)
+	if err != nil {
+		return nil, err
+	}
+	qrImage := qr.Image(labelQRSize)
+
+	lines := []string{fmt.Sprintf("#%d %s", item.ID, item.Name)}
+	if item.SerialNumber != "" {
+		lines = append(lines, "S/N: "+item.SerialNumber)
+	}
+
+	width := labelQRSize
+	height := labelQRSize + labelPadding + len(lines)*labelLineHeight
+	canvas := image.NewRGBA(image.Rect(0, 0, width, height))
+	draw.Draw(canvas, canvas.Bounds(), image.White, image.Point{}, draw.Src)
+	draw.Draw(canvas, image.Rect(0, 0, labelQRSize, labelQRSize), qrImage, image.Point{}, draw.Src)
+
+	drawer := &font.Drawer{
+		Dst:  canvas,
+		Src:  image.NewUniform(color.Black),
+		Face: basicfont.Face7x13,
+	}
+	for i, line := range lines {
+		drawer.Dot = fixed.P(labelPadding, labelQRSize+(i+1)*labelLineHeight)
+		drawer.DrawString(printableLabelText(line, width-labelPadding*2))
+	}
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, canvas); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// 組み込みフォントに無い文字は?に置き換え、ラベル幅に収まるよう切り詰める
+func printableLabelText(s string, maxWidth int) string {
+	face := basicfont.Face7x13
+	maxRunes := maxWidth / face.Advance
+
+	runes := make([]rune, 0, len(s))
+	for _, r := range s {
+		if _, ok := face.GlyphAdvance(r); !ok {
+			r = '?'
+		}
+		runes = append(runes, r)
+	}
+
+	if len(runes) > maxRunes {
+		runes = append(runes[:maxRunes-1], '~')
+	}
+
+	return string(runes)
+}
@@ -0,0 +1,46 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	"Aicon-assignment/internal/interfaces/problem"
+	"Aicon-assignment/internal/usecase"
+
+	"github.com/labstack/echo/v4"
+)
+
+type JobHandler struct {
+	jobUsecase usecase.JobUsecase
+}
+
+func NewJobHandler(jobUsecase usecase.JobUsecase) *JobHandler {
+	return &JobHandler{
+		jobUsecase: jobUsecase,
+	}
+}
+
+// GetJobs GET /admin/jobs エンドポイント
+func (h *JobHandler) GetJobs(c echo.Context) error {
+	jobs, err := h.jobUsecase.GetJobs(c.Request().Context(), c.QueryParam("status"))
+	if err != nil {
+		return problem.Write(c, problem.FromError(err, "failed_to_retrieve_jobs"))
+	}
+
+	return c.JSON(http.StatusOK, jobs)
+}
+
+// RetryJob POST /admin/jobs/{id}/retry エンドポイント
+func (h *JobHandler) RetryJob(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return problem.Write(c, problem.New(http.StatusBadRequest, "invalid_job_id"))
+	}
+
+	job, err := h.jobUsecase.RetryJob(c.Request().Context(), id)
+	if err != nil {
+		return problem.Write(c, problem.FromError(err, "failed_to_retry_job"))
+	}
+
+	return c.JSON(http.StatusOK, job)
+}
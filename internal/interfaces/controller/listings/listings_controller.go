@@ -0,0 +1,36 @@
+package controller
+
+import (
+	"net/http"
+
+	"Aicon-assignment/internal/interfaces/problem"
+	"Aicon-assignment/internal/usecase"
+
+	"github.com/labstack/echo/v4"
+)
+
+type ListingImportHandler struct {
+	importUsecase usecase.ListingImportUsecase
+}
+
+func NewListingImportHandler(importUsecase usecase.ListingImportUsecase) *ListingImportHandler {
+	return &ListingImportHandler{
+		importUsecase: importUsecase,
+	}
+}
+
+// DraftFromURL POST /items/from-url エンドポイント
+// 登録はせず、出品ページから読み取った下書きを返す
+func (h *ListingImportHandler) DraftFromURL(c echo.Context) error {
+	var input usecase.ImportListingInput
+	if err := c.Bind(&input); err != nil {
+		return problem.Write(c, problem.New(http.StatusBadRequest, "invalid_request_format"))
+	}
+
+	draft, err := h.importUsecase.DraftFromURL(c.Request().Context(), input)
+	if err != nil {
+		return problem.Write(c, problem.FromError(err, "failed_to_import_listing"))
+	}
+
+	return c.JSON(http.StatusOK, draft)
+}
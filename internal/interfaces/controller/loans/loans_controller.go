@@ -0,0 +1,90 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	domainErrors "Aicon-assignment/internal/domain/errors"
+	"Aicon-assignment/internal/interfaces/problem"
+	"Aicon-assignment/internal/usecase"
+
+	"github.com/labstack/echo/v4"
+)
+
+type LoanHandler struct {
+	loanUsecase usecase.LoanUsecase
+}
+
+func NewLoanHandler(loanUsecase usecase.LoanUsecase) *LoanHandler {
+	return &LoanHandler{
+		loanUsecase: loanUsecase,
+	}
+}
+
+// CreateLoan POST /items/{id}/loans エンドポイント
+func (h *LoanHandler) CreateLoan(c echo.Context) error {
+	idStr := c.Param("id")
+	itemID, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		return problem.Write(c, problem.New(http.StatusBadRequest, "invalid_item_id"))
+	}
+
+	var input usecase.CreateLoanInput
+	if err := c.Bind(&input); err != nil {
+		return problem.Write(c, problem.New(http.StatusBadRequest, "invalid_request_format"))
+	}
+
+	// バリデーション
+	if validationErrors := validateCreateLoanInput(input); len(validationErrors) > 0 {
+		return problem.Write(c, problem.New(http.StatusBadRequest, "validation_failed").WithDetails(validationErrors...))
+	}
+
+	loan, err := h.loanUsecase.LendItem(c.Request().Context(), itemID, input)
+	if err != nil {
+		return problem.Write(c, problem.FromError(err, "failed_to_create_loan"))
+	}
+
+	return c.JSON(http.StatusCreated, loan)
+}
+
+// ReturnLoan POST /loans/{id}/return エンドポイント
+func (h *LoanHandler) ReturnLoan(c echo.Context) error {
+	idStr := c.Param("id")
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		return problem.Write(c, problem.New(http.StatusBadRequest, "invalid_loan_id"))
+	}
+
+	loan, err := h.loanUsecase.ReturnLoan(c.Request().Context(), id)
+	if err != nil {
+		if domainErrors.IsValidationError(err) {
+			return problem.Write(c, problem.New(http.StatusBadRequest, "invalid_loan_id"))
+		}
+		return problem.Write(c, problem.FromError(err, "failed_to_return_loan"))
+	}
+
+	return c.JSON(http.StatusOK, loan)
+}
+
+// GetOverdueLoans GET /loans/overdue エンドポイント
+func (h *LoanHandler) GetOverdueLoans(c echo.Context) error {
+	loans, err := h.loanUsecase.GetOverdueLoans(c.Request().Context())
+	if err != nil {
+		return problem.Write(c, problem.New(http.StatusInternalServerError, "failed_to_retrieve_overdue_loans"))
+	}
+
+	return c.JSON(http.StatusOK, loans)
+}
+
+func validateCreateLoanInput(input usecase.CreateLoanInput) []string {
+	var errs []string
+
+	if input.Borrower == "" {
+		errs = append(errs, "borrower is required")
+	}
+	if input.DueDate == "" {
+		errs = append(errs, "due_date is required")
+	}
+
+	return errs
+}
@@ -0,0 +1,126 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	"Aicon-assignment/internal/interfaces/problem"
+	"Aicon-assignment/internal/interfaces/resource"
+	"Aicon-assignment/internal/usecase"
+
+	"github.com/labstack/echo/v4"
+)
+
+type LocationHandler struct {
+	locationUsecase usecase.LocationUsecase
+}
+
+func NewLocationHandler(locationUsecase usecase.LocationUsecase) *LocationHandler {
+	return &LocationHandler{
+		locationUsecase: locationUsecase,
+	}
+}
+
+func (h *LocationHandler) GetLocations(c echo.Context) error {
+	locations, err := h.locationUsecase.GetAllLocations(c.Request().Context())
+	if err != nil {
+		return problem.Write(c, problem.New(http.StatusInternalServerError, "failed_to_retrieve_locations"))
+	}
+
+	return c.JSON(http.StatusOK, locations)
+}
+
+func (h *LocationHandler) GetLocation(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return problem.Write(c, problem.New(http.StatusBadRequest, "invalid_location_id"))
+	}
+
+	location, err := h.locationUsecase.GetLocationByID(c.Request().Context(), id)
+	if err != nil {
+		return problem.Write(c, problem.FromError(err, "failed_to_retrieve_location"))
+	}
+
+	return c.JSON(http.StatusOK, location)
+}
+
+func (h *LocationHandler) CreateLocation(c echo.Context) error {
+	var input usecase.LocationInput
+	if err := c.Bind(&input); err != nil {
+		return problem.Write(c, problem.New(http.StatusBadRequest, "invalid_request_format"))
+	}
+
+	location, err := h.locationUsecase.CreateLocation(c.Request().Context(), input)
+	if err != nil {
+		return problem.Write(c, problem.FromError(err, "failed_to_create_location"))
+	}
+
+	return c.JSON(http.StatusCreated, location)
+}
+
+func (h *LocationHandler) UpdateLocation(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return problem.Write(c, problem.New(http.StatusBadRequest, "invalid_location_id"))
+	}
+
+	var input usecase.LocationInput
+	if err := c.Bind(&input); err != nil {
+		return problem.Write(c, problem.New(http.StatusBadRequest, "invalid_request_format"))
+	}
+
+	location, err := h.locationUsecase.UpdateLocation(c.Request().Context(), id, input)
+	if err != nil {
+		return problem.Write(c, problem.FromError(err, "failed_to_update_location"))
+	}
+
+	return c.JSON(http.StatusOK, location)
+}
+
+func (h *LocationHandler) DeleteLocation(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return problem.Write(c, problem.New(http.StatusBadRequest, "invalid_location_id"))
+	}
+
+	if err := h.locationUsecase.DeleteLocation(c.Request().Context(), id); err != nil {
+		return problem.Write(c, problem.FromError(err, "failed_to_delete_location"))
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// MoveItem POST /items/{id}/move エンドポイント
+func (h *LocationHandler) MoveItem(c echo.Context) error {
+	itemID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return problem.Write(c, problem.New(http.StatusBadRequest, "invalid_item_id"))
+	}
+
+	var input usecase.MoveItemInput
+	if err := c.Bind(&input); err != nil {
+		return problem.Write(c, problem.New(http.StatusBadRequest, "invalid_request_format"))
+	}
+
+	item, err := h.locationUsecase.MoveItem(c.Request().Context(), itemID, input)
+	if err != nil {
+		return problem.Write(c, problem.FromError(err, "failed_to_move_item"))
+	}
+
+	return resource.WriteItem(c, http.StatusOK, item)
+}
+
+// GetItemLocationHistory GET /items/{id}/location-history エンドポイント
+func (h *LocationHandler) GetItemLocationHistory(c echo.Context) error {
+	itemID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return problem.Write(c, problem.New(http.StatusBadRequest, "invalid_item_id"))
+	}
+
+	moves, err := h.locationUsecase.GetItemLocationHistory(c.Request().Context(), itemID)
+	if err != nil {
+		return problem.Write(c, problem.FromError(err, "failed_to_retrieve_location_history"))
+	}
+
+	return c.JSON(http.StatusOK, moves)
+}
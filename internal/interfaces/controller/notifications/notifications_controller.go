@@ -0,0 +1,45 @@
+package controller
+
+import (
+	"net/http"
+
+	"Aicon-assignment/internal/interfaces/problem"
+	"Aicon-assignment/internal/usecase"
+
+	"github.com/labstack/echo/v4"
+)
+
+type NotificationHandler struct {
+	preferenceUsecase usecase.NotificationPreferenceUsecase
+}
+
+func NewNotificationHandler(preferenceUsecase usecase.NotificationPreferenceUsecase) *NotificationHandler {
+	return &NotificationHandler{
+		preferenceUsecase: preferenceUsecase,
+	}
+}
+
+// GetPreferences GET /me/notification-preferences エンドポイント
+func (h *NotificationHandler) GetPreferences(c echo.Context) error {
+	prefs, err := h.preferenceUsecase.GetPreferences(c.Request().Context())
+	if err != nil {
+		return problem.Write(c, problem.New(http.StatusInternalServerError, "failed_to_retrieve_preferences"))
+	}
+
+	return c.JSON(http.StatusOK, prefs)
+}
+
+// UpdatePreferences PUT /me/notification-preferences エンドポイント（指定した手段・種類だけを変更する）
+func (h *NotificationHandler) UpdatePreferences(c echo.Context) error {
+	var input usecase.UpdateNotificationPreferencesInput
+	if err := c.Bind(&input); err != nil {
+		return problem.Write(c, problem.New(http.StatusBadRequest, "invalid_request_format"))
+	}
+
+	prefs, err := h.preferenceUsecase.UpdatePreferences(c.Request().Context(), input)
+	if err != nil {
+		return problem.Write(c, problem.FromError(err, "failed_to_update_preferences"))
+	}
+
+	return c.JSON(http.StatusOK, prefs)
+}
@@ -0,0 +1,112 @@
+package controller
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"Aicon-assignment/internal/domain/entity"
+	"Aicon-assignment/internal/interfaces/problem"
+	"Aicon-assignment/internal/usecase"
+
+	"github.com/labstack/echo/v4"
+)
+
+type PrivacyHandler struct {
+	privacyUsecase usecase.PrivacyUsecase
+}
+
+func NewPrivacyHandler(privacyUsecase usecase.PrivacyUsecase) *PrivacyHandler {
+	return &PrivacyHandler{
+		privacyUsecase: privacyUsecase,
+	}
+}
+
+// Export GET /me/export エンドポイント
+// テーブルごとのJSONファイルをまとめたZIPを添付ファイルとして返す
+func (h *PrivacyHandler) Export(c echo.Context) error {
+	backup, err := h.privacyUsecase.Export(c.Request().Context())
+	if err != nil {
+		return problem.Write(c, problem.New(http.StatusInternalServerError, "failed_to_export_data"))
+	}
+
+	archive, err := exportArchive(backup)
+	if err != nil {
+		return problem.Write(c, problem.New(http.StatusInternalServerError, "failed_to_export_data"))
+	}
+
+	filename := fmt.Sprintf("export-%s.zip", backup.CreatedAt.Format("20060102-150405"))
+	c.Response().Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%q", filename))
+	return c.Blob(http.StatusOK, "application/zip", archive)
+}
+
+// GetErasure GET /me/erasure エンドポイント
+func (h *PrivacyHandler) GetErasure(c echo.Context) error {
+	erasure, err := h.privacyUsecase.GetErasure(c.Request().Context())
+	if err != nil {
+		return problem.Write(c, problem.FromError(err, "failed_to_retrieve_erasure"))
+	}
+
+	return c.JSON(http.StatusOK, erasure)
+}
+
+// RequestErasure DELETE /me エンドポイント
+// 削除は猶予期間の後に行うため202を返す
+func (h *PrivacyHandler) RequestErasure(c echo.Context) error {
+	erasure, err := h.privacyUsecase.RequestErasure(c.Request().Context())
+	if err != nil {
+		return problem.Write(c, problem.New(http.StatusInternalServerError, "failed_to_request_erasure"))
+	}
+
+	return c.JSON(http.StatusAccepted, erasure)
+}
+
+// CancelErasure POST /me/erasure/cancel エンドポイント
+func (h *PrivacyHandler) CancelErasure(c echo.Context) error {
+	erasure, err := h.privacyUsecase.CancelErasure(c.Request().Context())
+	if err != nil {
+		return problem.Write(c, problem.FromError(err, "failed_to_cancel_erasure"))
+	}
+
+	return c.JSON(http.StatusOK, erasure)
+}
+
+// テーブルごとのファイル（バックアップの復元には POST /admin/backup のJSONを使う）
+func exportArchive(backup *entity.Backup) ([]byte, error) {
+	files := []struct {
+		name string
+		data any
+	}{
+		{"items.json", backup.Items},
+		{"locations.json", backup.Locations},
+		{"loans.json", backup.Loans},
+		{"location_history.json", backup.LocationHistory},
+		{"templates.json", backup.Templates},
+		{"comments.json", backup.Comments},
+		{"relations.json", backup.Relations},
+		{"valuations.json", backup.Valuations},
+		{"sales.json", backup.Sales},
+		{"insurances.json", backup.Insurances},
+	}
+
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+	for _, file := range files {
+		f, err := w.CreateHeader(&zip.FileHeader{Name: file.name, Method: zip.Deflate, Modified: backup.CreatedAt})
+		if err != nil {
+			return nil, err
+		}
+		encoder := json.NewEncoder(f)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(file.data); err != nil {
+			return nil, err
+		}
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,62 @@
+package controller
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"Aicon-assignment/internal/domain/entity"
+	"Aicon-assignment/internal/usecase"
+)
+
+type stubPrivacyUsecase struct {
+	usecase.PrivacyUsecase
+	backup *entity.Backup
+}
+
+func (s *stubPrivacyUsecase) Export(ctx context.Context) (*entity.Backup, error) {
+	return s.backup, nil
+}
+
+func TestPrivacyHandler_Export(t *testing.T) {
+	backup := &entity.Backup{
+		Version:   entity.BackupVersion,
+		CreatedAt: time.Date(2026, 10, 16, 9, 30, 0, 0, time.UTC),
+		Items:     []*entity.Item{{ID: 1, Name: "ロレックス デイトナ"}},
+	}
+	handler := NewPrivacyHandler(&stubPrivacyUsecase{backup: backup})
+
+	rec := httptest.NewRecorder()
+	c := echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/me/export", nil), rec)
+
+	require.NoError(t, handler.Export(c))
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "application/zip", rec.Header().Get(echo.HeaderContentType))
+	assert.Equal(t, `attachment; filename="export-20261016-093000.zip"`, rec.Header().Get(echo.HeaderContentDisposition))
+
+	archive, err := zip.NewReader(bytes.NewReader(rec.Body.Bytes()), int64(rec.Body.Len()))
+	require.NoError(t, err)
+
+	var names []string
+	for _, f := range archive.File {
+		names = append(names, f.Name)
+	}
+	assert.Equal(t, []string{"items.json", "locations.json", "loans.json", "location_history.json", "templates.json", "comments.json", "relations.json", "valuations.json", "sales.json", "insurances.json"}, names)
+
+	items, err := archive.File[0].Open()
+	require.NoError(t, err)
+	defer items.Close()
+	body, err := io.ReadAll(items)
+	require.NoError(t, err)
+	assert.Contains(t, string(body), `"name": "ロレックス デイトナ"`)
+}
@@ -0,0 +1,92 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	"Aicon-assignment/internal/domain/entity"
+	"Aicon-assignment/internal/interfaces/i18n"
+	"Aicon-assignment/internal/interfaces/problem"
+	"Aicon-assignment/internal/interfaces/resource"
+	"Aicon-assignment/internal/usecase"
+
+	"github.com/labstack/echo/v4"
+)
+
+type ItemRelationHandler struct {
+	relationUsecase usecase.ItemRelationUsecase
+}
+
+func NewItemRelationHandler(relationUsecase usecase.ItemRelationUsecase) *ItemRelationHandler {
+	return &ItemRelationHandler{
+		relationUsecase: relationUsecase,
+	}
+}
+
+// 関連のレスポンス（相手のアイテムを _links 付きで埋め込む）
+type relationResponse struct {
+	*entity.ItemRelation
+	LinkedItem *resource.Item `json:"linked_item"`
+}
+
+func newRelationResponse(related *usecase.RelatedItem, lang i18n.Lang) *relationResponse {
+	return &relationResponse{ItemRelation: related.Relation, LinkedItem: resource.NewItem(related.Item, lang)}
+}
+
+// CreateRelation POST /items/{id}/relations エンドポイント
+func (h *ItemRelationHandler) CreateRelation(c echo.Context) error {
+	itemID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return problem.Write(c, problem.New(http.StatusBadRequest, "invalid_item_id"))
+	}
+
+	var input usecase.CreateItemRelationInput
+	if err := c.Bind(&input); err != nil {
+		return problem.Write(c, problem.New(http.StatusBadRequest, "invalid_request_format"))
+	}
+
+	related, err := h.relationUsecase.CreateRelation(c.Request().Context(), itemID, input)
+	if err != nil {
+		return problem.Write(c, problem.FromError(err, "failed_to_create_relation"))
+	}
+
+	return c.JSON(http.StatusCreated, newRelationResponse(related, resource.Lang(c)))
+}
+
+// GetRelations GET /items/{id}/relations エンドポイント
+func (h *ItemRelationHandler) GetRelations(c echo.Context) error {
+	itemID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return problem.Write(c, problem.New(http.StatusBadRequest, "invalid_item_id"))
+	}
+
+	relations, err := h.relationUsecase.GetRelations(c.Request().Context(), itemID)
+	if err != nil {
+		return problem.Write(c, problem.FromError(err, "failed_to_retrieve_relations"))
+	}
+
+	lang := resource.Lang(c)
+	response := make([]*relationResponse, 0, len(relations))
+	for _, related := range relations {
+		response = append(response, newRelationResponse(related, lang))
+	}
+	return c.JSON(http.StatusOK, response)
+}
+
+// DeleteRelation DELETE /items/{id}/relations/{relationID} エンドポイント
+func (h *ItemRelationHandler) DeleteRelation(c echo.Context) error {
+	itemID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return problem.Write(c, problem.New(http.StatusBadRequest, "invalid_item_id"))
+	}
+	id, err := strconv.ParseInt(c.Param("relationID"), 10, 64)
+	if err != nil {
+		return problem.Write(c, problem.New(http.StatusBadRequest, "invalid_relation_id"))
+	}
+
+	if err := h.relationUsecase.DeleteRelation(c.Request().Context(), itemID, id); err != nil {
+		return problem.Write(c, problem.FromError(err, "failed_to_delete_relation"))
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
@@ -0,0 +1,214 @@
+package controller
+
+import (
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"unicode/utf8"
+)
+
+// A4横のページのレイアウト（単位はポイント）
+const (
+	pdfPageWidth  = 842
+	pdfPageHeight = 595
+	pdfMargin     = 36
+	pdfFontSize   = 9
+	pdfLineHeight = 14
+	pdfCellMargin = 4
+)
+
+// 表の列（width はポイント、right は数値などの右寄せ）
+type pdfColumn struct {
+	title string
+	width float64
+	right bool
+}
+
+// 見出しと表だけの帳票を作るPDF
+// 日本語を表示できるよう、閲覧ソフトが持つ平成角ゴシック（Adobe-Japan1）を埋め込まずに使う
+type pdfDocument struct {
+	pages []*bytes.Buffer
+	y     float64 // 次に書く行のベースライン
+}
+
+func newPDFDocument() *pdfDocument {
+	d := &pdfDocument{}
+	d.newPage()
+	return d
+}
+
+func (d *pdfDocument) newPage() {
+	d.pages = append(d.pages, &bytes.Buffer{})
+	d.y = pdfPageHeight - pdfMargin - pdfFontSize
+}
+
+func (d *pdfDocument) page() *bytes.Buffer {
+	return d.pages[len(d.pages)-1]
+}
+
+// 残りが lines 行に満たない場合は改ページする
+func (d *pdfDocument) reserve(lines int) bool {
+	if d.y-float64(lines-1)*pdfLineHeight < pdfMargin {
+		d.newPage()
+		return true
+	}
+	return false
+}
+
+func (d *pdfDocument) heading(text string, size float64) {
+	d.reserve(2)
+	d.drawText(pdfMargin, d.y, size, text)
+	d.y -= size + pdfLineHeight/2
+}
+
+func (d *pdfDocument) text(text string) {
+	d.reserve(1)
+	d.drawText(pdfMargin, d.y, pdfFontSize, text)
+	d.y -= pdfLineHeight
+}
+
+func (d *pdfDocument) space() {
+	d.y -= pdfLineHeight / 2
+}
+
+// 改ページした場合は次のページにも列の見出しを書く
+func (d *pdfDocument) table(columns []pdfColumn, rows [][]string) {
+	d.reserve(2)
+	d.tableHeader(columns)
+	for _, row := range rows {
+		if d.reserve(1) {
+			d.tableHeader(columns)
+		}
+		d.tableRow(columns, row)
+	}
+}
+
+func (d *pdfDocument) tableHeader(columns []pdfColumn) {
+	titles := make([]string, len(columns))
+	width := 0.0
+	for i, column := range columns {
+		titles[i] = column.title
+		width += column.width
+	}
+	d.tableRow(columns, titles)
+
+	// 見出しの下に線を引く
+	lineY := d.y + pdfLineHeight - 3
+	fmt.Fprintf(d.page(), "0.5 w %.2f %.2f m %.2f %.2f l S\n", float64(pdfMargin), lineY, pdfMargin+width, lineY)
+}
+
+func (d *pdfDocument) tableRow(columns []pdfColumn, cells []string) {
+	x := float64(pdfMargin)
+	for i, column := range columns {
+		if i < len(cells) && cells[i] != "" {
+			text := truncatePDFText(cells[i], column.width-pdfCellMargin*2, pdfFontSize)
+			cellX := x + pdfCellMargin
+			if column.right {
+				cellX = x + column.width - pdfCellMargin - pdfTextWidth(text, pdfFontSize)
+			}
+			d.drawText(cellX, d.y, pdfFontSize, text)
+		}
+		x += column.width
+	}
+	d.y -= pdfLineHeight
+}
+
+func (d *pdfDocument) drawText(x, y, size float64, text string) {
+	fmt.Fprintf(d.page(), "BT /F1 %.1f Tf %.2f %.2f Td <%s> Tj ET\n", size, x, y, encodePDFText(text))
+}
+
+// UniJIS-UCS2-HW-H の文字コード（UTF-16BE）にする。基本多言語面にない文字は「?」にする
+func encodePDFText(text string) string {
+	var buf bytes.Buffer
+	for _, r := range text {
+		if r > 0xFFFF || r == utf8.RuneError {
+			r = '?'
+		}
+		fmt.Fprintf(&buf, "%04X", r)
+	}
+	return buf.String()
+}
+
+// 半角の英数字・カナは全角の半分の幅になる（フォントの /W と合わせる）
+func pdfRuneWidth(r rune) float64 {
+	if (r >= 0x20 && r <= 0x7E) || (r >= 0xFF61 && r <= 0xFF9F) {
+		return 0.5
+	}
+	return 1
+}
+
+func pdfTextWidth(text string, size float64) float64 {
+	width := 0.0
+	for _, r := range text {
+		width += pdfRuneWidth(r)
+	}
+	return width * size
+}
+
+// 幅に収まらない場合は末尾を「…」にする
+func truncatePDFText(text string, width, size float64) string {
+	if pdfTextWidth(text, size) <= width {
+		return text
+	}
+
+	runes := []rune(text)
+	used := pdfRuneWidth('…') * size
+	for i, r := range runes {
+		used += pdfRuneWidth(r) * size
+		if used > width {
+			return string(runes[:i]) + "…"
+		}
+	}
+	return text
+}
+
+// ページの内容からPDFのファイルを組み立てる
+func (d *pdfDocument) bytes() ([]byte, error) {
+	var out bytes.Buffer
+	var offsets []int
+	object := func(body string) {
+		offsets = append(offsets, out.Len())
+		fmt.Fprintf(&out, "%d 0 obj\n%s\nendobj\n", len(offsets), body)
+	}
+
+	out.WriteString("%PDF-1.4\n%\xE2\xE3\xCF\xD3\n")
+
+	// 1: カタログ、2: ページの一覧、3〜5: フォント、6以降: ページと内容の組
+	const firstPage = 6
+	kids := ""
+	for i := range d.pages {
+		kids += fmt.Sprintf("%d 0 R ", firstPage+i*2)
+	}
+	object("<< /Type /Catalog /Pages 2 0 R >>")
+	object(fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", kids, len(d.pages)))
+	object("<< /Type /Font /Subtype /Type0 /BaseFont /HeiseiKakuGo-W5 /Encoding /UniJIS-UCS2-HW-H /DescendantFonts [4 0 R] >>")
+	object("<< /Type /Font /Subtype /CIDFontType0 /BaseFont /HeiseiKakuGo-W5 " +
+		"/CIDSystemInfo << /Registry (Adobe) /Ordering (Japan1) /Supplement 2 >> " +
+		"/FontDescriptor 5 0 R /DW 1000 /W [1 95 500 231 325 500 327 389 500] >>")
+	object("<< /Type /FontDescriptor /FontName /HeiseiKakuGo-W5 /Flags 4 /FontBBox [-92 -250 1010 922] " +
+		"/ItalicAngle 0 /Ascent 752 /Descent -221 /CapHeight 737 /StemV 114 >>")
+
+	for i, page := range d.pages {
+		object(fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %d %d] /Resources << /Font << /F1 3 0 R >> >> /Contents %d 0 R >>",
+			pdfPageWidth, pdfPageHeight, firstPage+i*2+1))
+
+		var compressed bytes.Buffer
+		writer := zlib.NewWriter(&compressed)
+		if _, err := writer.Write(page.Bytes()); err != nil {
+			return nil, err
+		}
+		if err := writer.Close(); err != nil {
+			return nil, err
+		}
+		object(fmt.Sprintf("<< /Length %d /Filter /FlateDecode >>\nstream\n%s\nendstream", compressed.Len(), compressed.String()))
+	}
+
+	xref := out.Len()
+	fmt.Fprintf(&out, "xref\n0 %d\n0000000000 65535 f \n", len(offsets)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&out, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&out, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(offsets)+1, xref)
+
+	return out.Bytes(), nil
+}
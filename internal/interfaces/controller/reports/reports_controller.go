@@ -0,0 +1,364 @@
+package controller
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"Aicon-assignment/internal/domain/entity"
+	"Aicon-assignment/internal/interfaces/i18n"
+	"Aicon-assignment/internal/interfaces/problem"
+	"Aicon-assignment/internal/usecase"
+
+	"github.com/labstack/echo/v4"
+)
+
+// 帳票の形式
+const (
+	formatCSV = "csv"
+	formatPDF = "pdf"
+)
+
+// ExcelでUTF-8として開けるよう、CSVの先頭に付けるBOM
+const utf8BOM = "\ufeff"
+
+type ReportHandler struct {
+	reportUsecase usecase.ReportUsecase
+}
+
+func NewReportHandler(reportUsecase usecase.ReportUsecase) *ReportHandler {
+	return &ReportHandler{
+		reportUsecase: reportUsecase,
+	}
+}
+
+// GetTaxReport GET /reports/tax?year={year}&format={csv|pdf} エンドポイント
+// 見出しは Accept-Language の言語にする
+func (h *ReportHandler) GetTaxReport(c echo.Context) error {
+	year, err := strconv.Atoi(c.QueryParam("year"))
+	if err != nil {
+		return problem.Write(c, problem.New(http.StatusBadRequest, "invalid_year"))
+	}
+
+	format, ok := reportFormat(c)
+	if !ok {
+		return problem.Write(c, problem.New(http.StatusBadRequest, "invalid_report_format"))
+	}
+
+	report, err := h.reportUsecase.GetTaxReport(c.Request().Context(), year)
+	if err != nil {
+		return problem.Write(c, problem.FromError(err, "failed_to_create_tax_report"))
+	}
+
+	lang := i18n.Negotiate(c.Request().Header.Get(i18n.HeaderAcceptLanguage))
+	labels := taxReportLabels[lang]
+
+	var body []byte
+	switch format {
+	case formatPDF:
+		body, err = renderTaxReportPDF(report, labels)
+	default:
+		body, err = renderTaxReportCSV(report, labels)
+	}
+	if err != nil {
+		return problem.Write(c, problem.New(http.StatusInternalServerError, "failed_to_create_tax_report"))
+	}
+
+	return writeReport(c, lang, fmt.Sprintf("tax-report-%d", report.Year), format, body)
+}
+
+// GetInsuranceSchedule GET /reports/insurance-schedule?policy={証券番号}&format={csv|pdf} エンドポイント
+// 見出しは Accept-Language の言語にする
+func (h *ReportHandler) GetInsuranceSchedule(c echo.Context) error {
+	format, ok := reportFormat(c)
+	if !ok {
+		return problem.Write(c, problem.New(http.StatusBadRequest, "invalid_report_format"))
+	}
+
+	schedule, err := h.reportUsecase.GetInsuranceSchedule(c.Request().Context(), c.QueryParam("policy"))
+	if err != nil {
+		return problem.Write(c, problem.FromError(err, "failed_to_create_insurance_schedule"))
+	}
+
+	lang := i18n.Negotiate(c.Request().Header.Get(i18n.HeaderAcceptLanguage))
+	labels := insuranceScheduleLabels[lang]
+
+	var body []byte
+	switch format {
+	case formatPDF:
+		body, err = renderInsuranceSchedulePDF(schedule, labels)
+	default:
+		body, err = renderInsuranceScheduleCSV(schedule, labels)
+	}
+	if err != nil {
+		return problem.Write(c, problem.New(http.StatusInternalServerError, "failed_to_create_insurance_schedule"))
+	}
+
+	return writeReport(c, lang, "insurance-schedule-"+schedule.CreatedAt.Format("20060102"), format, body)
+}
+
+// 帳票を name.format の添付ファイルとして返す
+func writeReport(c echo.Context, lang i18n.Lang, name, format string, body []byte) error {
+	contentType := "text/csv; charset=utf-8"
+	if format == formatPDF {
+		contentType = "application/pdf"
+	}
+
+	header := c.Response().Header()
+	header.Add(echo.HeaderVary, i18n.HeaderAcceptLanguage)
+	header.Set(i18n.HeaderContentLanguage, string(lang))
+	header.Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%q", name+"."+format))
+	return c.Blob(http.StatusOK, contentType, body)
+}
+
+// format の指定（既定はCSV）
+func reportFormat(c echo.Context) (string, bool) {
+	switch format := c.QueryParam("format"); format {
+	case "", formatCSV:
+		return formatCSV, true
+	case formatPDF:
+		return formatPDF, true
+	default:
+		return "", false
+	}
+}
+
+// 税務申告用の明細の見出し
+type taxReportText struct {
+	title        string // %d に年が入る
+	acquisitions string
+	disposals    string
+	acquisition  string // 区分の値
+	disposal     string
+	total        string
+	columns      taxReportColumns
+}
+
+type taxReportColumns struct {
+	kind, itemID, name, category, brand, purchaseDate, cost, soldOn, salePrice, fees, gain string
+}
+
+var taxReportLabels = map[i18n.Lang]taxReportText{
+	i18n.English: {
+		title:        "Acquisitions and disposals in %d",
+		acquisitions: "Acquisitions",
+		disposals:    "Disposals",
+		acquisition:  "acquisition",
+		disposal:     "disposal",
+		total:        "total",
+		columns: taxReportColumns{
+			kind: "type", itemID: "item_id", name: "name", category: "category", brand: "brand", purchaseDate: "purchase_date",
+			cost: "cost", soldOn: "sold_on", salePrice: "sale_price", fees: "fees", gain: "gain_loss",
+		},
+	},
+	i18n.Japanese: {
+		title:        "%d年 取得・売却明細",
+		acquisitions: "取得",
+		disposals:    "売却",
+		acquisition:  "取得",
+		disposal:     "売却",
+		total:        "合計",
+		columns: taxReportColumns{
+			kind: "区分", itemID: "アイテムID", name: "名前", category: "カテゴリー", brand: "ブランド", purchaseDate: "購入日",
+			cost: "取得価額", soldOn: "売却日", salePrice: "売却価格", fees: "手数料", gain: "損益",
+		},
+	},
+}
+
+// 取得と売却を1つの表にし、それぞれの後に合計の行を付ける（金額は円）
+func renderTaxReportCSV(report *entity.TaxReport, labels taxReportText) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteString(utf8BOM)
+
+	col := labels.columns
+	writer := csv.NewWriter(&buf)
+	records := [][]string{{col.kind, col.itemID, col.name, col.category, col.brand, col.purchaseDate, col.cost, col.soldOn, col.salePrice, col.fees, col.gain}}
+	for _, a := range report.Acquisitions {
+		records = append(records, []string{labels.acquisition, formatID(a.ItemID), a.Name, a.Category, a.Brand, a.PurchaseDate, strconv.Itoa(a.PurchasePrice), "", "", "", ""})
+	}
+	records = append(records, []string{labels.acquisition + " " + labels.total, "", "", "", "", "", strconv.Itoa(report.Totals.Acquired), "", "", "", ""})
+	for _, d := range report.Disposals {
+		records = append(records, []string{labels.disposal, formatID(d.ItemID), d.Name, "", "", d.PurchaseDate, strconv.Itoa(d.PurchasePrice),
+			d.SoldOn, strconv.Itoa(d.SalePrice), strconv.Itoa(d.Fees), strconv.Itoa(d.Gain)})
+	}
+	records = append(records, []string{labels.disposal + " " + labels.total, "", "", "", "", "", strconv.Itoa(report.Totals.Cost),
+		"", strconv.Itoa(report.Totals.SalePrice), strconv.Itoa(report.Totals.Fees), strconv.Itoa(report.Totals.Gain)})
+
+	if err := writer.WriteAll(records); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// 取得と売却を別の表にする
+func renderTaxReportPDF(report *entity.TaxReport, labels taxReportText) ([]byte, error) {
+	col := labels.columns
+	doc := newPDFDocument()
+	doc.heading(fmt.Sprintf(labels.title, report.Year), 14)
+
+	doc.heading(labels.acquisitions, 11)
+	rows := [][]string{}
+	for _, a := range report.Acquisitions {
+		rows = append(rows, []string{formatID(a.ItemID), a.Name, a.Category, a.Brand, a.PurchaseDate, formatYen(a.PurchasePrice)})
+	}
+	rows = append(rows, []string{"", labels.total, "", "", "", formatYen(report.Totals.Acquired)})
+	doc.table([]pdfColumn{
+		{title: col.itemID, width: 60, right: true},
+		{title: col.name, width: 260},
+		{title: col.category, width: 80},
+		{title: col.brand, width: 140},
+		{title: col.purchaseDate, width: 80},
+		{title: col.cost, width: 90, right: true},
+	}, rows)
+	doc.space()
+
+	doc.heading(labels.disposals, 11)
+	rows = [][]string{}
+	for _, d := range report.Disposals {
+		rows = append(rows, []string{formatID(d.ItemID), d.Name, d.PurchaseDate, formatYen(d.PurchasePrice), d.SoldOn,
+			formatYen(d.SalePrice), formatYen(d.Fees), formatYen(d.Gain)})
+	}
+	rows = append(rows, []string{"", labels.total, "", formatYen(report.Totals.Cost), "",
+		formatYen(report.Totals.SalePrice), formatYen(report.Totals.Fees), formatYen(report.Totals.Gain)})
+	doc.table([]pdfColumn{
+		{title: col.itemID, width: 60, right: true},
+		{title: col.name, width: 200},
+		{title: col.purchaseDate, width: 70},
+		{title: col.cost, width: 80, right: true},
+		{title: col.soldOn, width: 70},
+		{title: col.salePrice, width: 80, right: true},
+		{title: col.fees, width: 70, right: true},
+		{title: col.gain, width: 80, right: true},
+	}, rows)
+
+	return doc.bytes()
+}
+
+// 保険の明細の見出し
+type insuranceScheduleText struct {
+	title    string
+	asOf     string // %s に作成日が入る
+	policy   string // %s に保険会社、%s に証券番号が入る
+	total    string
+	columns  insuranceScheduleColumns
+	noPolicy string
+}
+
+type insuranceScheduleColumns struct {
+	insurer, policyNumber, itemID, name, category, brand, serialNumber, appraisedValue, photos string
+}
+
+var insuranceScheduleLabels = map[i18n.Lang]insuranceScheduleText{
+	i18n.English: {
+		title:    "Schedule of insured items",
+		asOf:     "As of %s",
+		policy:   "%s  Policy No. %s",
+		total:    "total",
+		noPolicy: "No items are covered by insurance.",
+		columns: insuranceScheduleColumns{
+			insurer: "insurer", policyNumber: "policy_number", itemID: "item_id", name: "name", category: "category", brand: "brand",
+			serialNumber: "serial_number", appraisedValue: "appraised_value", photos: "photos",
+		},
+	},
+	i18n.Japanese: {
+		title:    "保険対象品明細",
+		asOf:     "作成日 %s",
+		policy:   "%s  証券番号 %s",
+		total:    "合計",
+		noPolicy: "保険を設定したアイテムはありません。",
+		columns: insuranceScheduleColumns{
+			insurer: "保険会社", policyNumber: "証券番号", itemID: "アイテムID", name: "名前", category: "カテゴリー", brand: "ブランド",
+			serialNumber: "シリアル番号", appraisedValue: "評価額", photos: "写真",
+		},
+	},
+}
+
+// 契約ごとにアイテムを並べ、契約の後に合計の行を付ける（写真の参照は1つのセルに改行でつなぐ）
+func renderInsuranceScheduleCSV(schedule *entity.InsuranceSchedule, labels insuranceScheduleText) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteString(utf8BOM)
+
+	col := labels.columns
+	writer := csv.NewWriter(&buf)
+	records := [][]string{{col.insurer, col.policyNumber, col.itemID, col.name, col.category, col.brand, col.serialNumber, col.appraisedValue, col.photos}}
+	for _, policy := range schedule.Policies {
+		for _, item := range policy.Items {
+			records = append(records, []string{policy.Insurer, policy.PolicyNumber, formatID(item.ItemID), item.Name, item.Category, item.Brand,
+				item.SerialNumber, strconv.Itoa(item.AppraisedValue), strings.Join(item.Photos, "\n")})
+		}
+		records = append(records, []string{policy.Insurer, policy.PolicyNumber, "", labels.total, "", "", "", strconv.Itoa(policy.Total), ""})
+	}
+
+	if err := writer.WriteAll(records); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// 契約ごとに表を分ける。写真の参照が複数ある場合は次の行に続ける
+func renderInsuranceSchedulePDF(schedule *entity.InsuranceSchedule, labels insuranceScheduleText) ([]byte, error) {
+	col := labels.columns
+	doc := newPDFDocument()
+	doc.heading(labels.title, 14)
+	doc.text(fmt.Sprintf(labels.asOf, schedule.CreatedAt.Format("2006-01-02")))
+	doc.space()
+
+	if len(schedule.Policies) == 0 {
+		doc.text(labels.noPolicy)
+	}
+
+	columns := []pdfColumn{
+		{title: col.itemID, width: 60, right: true},
+		{title: col.name, width: 200},
+		{title: col.category, width: 70},
+		{title: col.brand, width: 110},
+		{title: col.serialNumber, width: 100},
+		{title: col.appraisedValue, width: 80, right: true},
+		{title: col.photos, width: 150},
+	}
+	for _, policy := range schedule.Policies {
+		doc.heading(fmt.Sprintf(labels.policy, policy.Insurer, policy.PolicyNumber), 11)
+		rows := [][]string{}
+		for _, item := range policy.Items {
+			photo := ""
+			if len(item.Photos) > 0 {
+				photo = item.Photos[0]
+			}
+			rows = append(rows, []string{formatID(item.ItemID), item.Name, item.Category, item.Brand, item.SerialNumber, formatYen(item.AppraisedValue), photo})
+			for i := 1; i < len(item.Photos); i++ {
+				rows = append(rows, []string{"", "", "", "", "", "", item.Photos[i]})
+			}
+		}
+		rows = append(rows, []string{"", labels.total, "", "", "", formatYen(policy.Total), ""})
+		doc.table(columns, rows)
+		doc.space()
+	}
+
+	return doc.bytes()
+}
+
+func formatID(id int64) string {
+	return strconv.FormatInt(id, 10)
+}
+
+// 3桁ごとにカンマを入れる（損失は先頭に -）
+func formatYen(amount int) string {
+	sign := ""
+	if amount < 0 {
+		sign = "-"
+		amount = -amount
+	}
+
+	digits := strconv.Itoa(amount)
+	var buf bytes.Buffer
+	for i, d := range digits {
+		if i > 0 && (len(digits)-i)%3 == 0 {
+			buf.WriteByte(',')
+		}
+		buf.WriteRune(d)
+	}
+	return sign + buf.String()
+}
@@ -0,0 +1,158 @@
+package controller
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"Aicon-assignment/internal/domain/entity"
+	"Aicon-assignment/internal/usecase"
+)
+
+type stubReportUsecase struct {
+	usecase.ReportUsecase
+}
+
+func (s *stubReportUsecase) GetTaxReport(ctx context.Context, year int) (*entity.TaxReport, error) {
+	items := []*entity.Item{{ID: 2, Name: "バーキン", Category: "バッグ", Brand: "HERMÈS", PurchasePrice: 2000000, PurchaseDate: "2024-06-01"}}
+	sales := []*entity.ItemSale{{ItemID: 1, ItemName: "ロレックス デイトナ", PurchaseDate: "2023-01-15", PurchasePrice: 1500000, SoldOn: "2024-08-01", SalePrice: 1400000, Fees: 42000}}
+	return entity.NewTaxReport(year, items, sales), nil
+}
+
+func (s *stubReportUsecase) GetInsuranceSchedule(ctx context.Context, policyNumber string) (*entity.InsuranceSchedule, error) {
+	items := []*entity.Item{
+		{ID: 1, Name: "ロレックス デイトナ", Category: "時計", Brand: "ROLEX", PurchasePrice: 1500000, SerialNumber: "D123456"},
+		{ID: 3, Name: "カルティエ タンク", Category: "時計", Brand: "Cartier", PurchasePrice: 400000},
+	}
+	insurances := []*entity.ItemInsurance{
+		{ItemID: 1, Insurer: "東京海上日動", PolicyNumber: "B-200", Photos: []string{"daytona-front.jpg", "daytona-back.jpg"}},
+		{ItemID: 3, Insurer: "東京海上日動", PolicyNumber: "B-200"},
+	}
+	schedule := entity.NewInsuranceSchedule(items, insurances)
+	schedule.CreatedAt = time.Date(2026, 10, 16, 9, 30, 0, 0, time.UTC)
+	return schedule, nil
+}
+
+func getReport(t *testing.T, handle func(*ReportHandler, echo.Context) error, target, lang string) *httptest.ResponseRecorder {
+	t.Helper()
+	req := httptest.NewRequest(http.MethodGet, target, nil)
+	req.Header.Set("Accept-Language", lang)
+	rec := httptest.NewRecorder()
+	c := echo.New().NewContext(req, rec)
+
+	require.NoError(t, handle(NewReportHandler(&stubReportUsecase{}), c))
+	return rec
+}
+
+func getTaxReport(t *testing.T, query, lang string) *httptest.ResponseRecorder {
+	t.Helper()
+	return getReport(t, (*ReportHandler).GetTaxReport, "/reports/tax?"+query, lang)
+}
+
+func TestReportHandler_GetTaxReport(t *testing.T) {
+	t.Run("正常系: 日本語の見出しのCSV", func(t *testing.T) {
+		rec := getTaxReport(t, "year=2024", "ja")
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "text/csv; charset=utf-8", rec.Header().Get(echo.HeaderContentType))
+		assert.Equal(t, `attachment; filename="tax-report-2024.csv"`, rec.Header().Get(echo.HeaderContentDisposition))
+		assert.Equal(t, "ja", rec.Header().Get("Content-Language"))
+
+		body := rec.Body.String()
+		require.True(t, strings.HasPrefix(body, utf8BOM))
+		lines := strings.Split(strings.TrimSuffix(strings.TrimPrefix(body, utf8BOM), "\n"), "\n")
+		assert.Equal(t, []string{
+			"区分,アイテムID,名前,カテゴリー,ブランド,購入日,取得価額,売却日,売却価格,手数料,損益",
+			"取得,2,バーキン,バッグ,HERMÈS,2024-06-01,2000000,,,,",
+			"取得 合計,,,,,,2000000,,,,",
+			"売却,1,ロレックス デイトナ,,,2023-01-15,1500000,2024-08-01,1400000,42000,-142000",
+			"売却 合計,,,,,,1500000,,1400000,42000,-142000",
+		}, lines)
+	})
+
+	t.Run("正常系: 英語の見出しのCSV", func(t *testing.T) {
+		rec := getTaxReport(t, "year=2024&format=csv", "en")
+
+		assert.Contains(t, rec.Body.String(), "type,item_id,name,category,brand,purchase_date,cost,sold_on,sale_price,fees,gain_loss\n")
+	})
+
+	t.Run("正常系: PDF", func(t *testing.T) {
+		rec := getTaxReport(t, "year=2024&format=pdf", "ja")
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "application/pdf", rec.Header().Get(echo.HeaderContentType))
+		assert.Equal(t, `attachment; filename="tax-report-2024.pdf"`, rec.Header().Get(echo.HeaderContentDisposition))
+		assert.True(t, bytes.HasPrefix(rec.Body.Bytes(), []byte("%PDF-1.4\n")))
+		assert.True(t, bytes.HasSuffix(rec.Body.Bytes(), []byte("%%EOF\n")))
+	})
+
+	t.Run("異常系: 年が数値でない", func(t *testing.T) {
+		rec := getTaxReport(t, "year=last", "ja")
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.Contains(t, rec.Body.String(), "invalid_year")
+	})
+
+	t.Run("異常系: 対応していない形式", func(t *testing.T) {
+		rec := getTaxReport(t, "year=2024&format=xlsx", "ja")
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.Contains(t, rec.Body.String(), "invalid_report_format")
+	})
+}
+
+func TestReportHandler_GetInsuranceSchedule(t *testing.T) {
+	t.Run("正常系: 契約ごとに合計を付けたCSV", func(t *testing.T) {
+		rec := getReport(t, (*ReportHandler).GetInsuranceSchedule, "/reports/insurance-schedule", "ja")
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "text/csv; charset=utf-8", rec.Header().Get(echo.HeaderContentType))
+		assert.Equal(t, `attachment; filename="insurance-schedule-20261016.csv"`, rec.Header().Get(echo.HeaderContentDisposition))
+
+		records, err := csv.NewReader(strings.NewReader(strings.TrimPrefix(rec.Body.String(), utf8BOM))).ReadAll()
+		require.NoError(t, err)
+		assert.Equal(t, [][]string{
+			{"保険会社", "証券番号", "アイテムID", "名前", "カテゴリー", "ブランド", "シリアル番号", "評価額", "写真"},
+			{"東京海上日動", "B-200", "1", "ロレックス デイトナ", "時計", "ROLEX", "D123456", "1500000", "daytona-front.jpg\ndaytona-back.jpg"},
+			{"東京海上日動", "B-200", "3", "カルティエ タンク", "時計", "Cartier", "", "400000", ""},
+			{"東京海上日動", "B-200", "", "合計", "", "", "", "1900000", ""},
+		}, records)
+	})
+
+	t.Run("正常系: PDF", func(t *testing.T) {
+		rec := getReport(t, (*ReportHandler).GetInsuranceSchedule, "/reports/insurance-schedule?format=pdf", "en")
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "application/pdf", rec.Header().Get(echo.HeaderContentType))
+		assert.Equal(t, `attachment; filename="insurance-schedule-20261016.pdf"`, rec.Header().Get(echo.HeaderContentDisposition))
+		assert.True(t, bytes.HasPrefix(rec.Body.Bytes(), []byte("%PDF-1.4\n")))
+	})
+
+	t.Run("異常系: 対応していない形式", func(t *testing.T) {
+		rec := getReport(t, (*ReportHandler).GetInsuranceSchedule, "/reports/insurance-schedule?format=xlsx", "ja")
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
+
+func TestFormatYen(t *testing.T) {
+	assert.Equal(t, "0", formatYen(0))
+	assert.Equal(t, "999", formatYen(999))
+	assert.Equal(t, "1,500,000", formatYen(1500000))
+	assert.Equal(t, "-142,000", formatYen(-142000))
+}
+
+func TestTruncatePDFText(t *testing.T) {
+	assert.Equal(t, "Rolex", truncatePDFText("Rolex", 100, 10))
+	// 全角は10ポイント、半角は5ポイント。「…」の幅を残して切る
+	assert.Equal(t, "ロレックス…", truncatePDFText("ロレックス デイトナ", 60, 10))
+}
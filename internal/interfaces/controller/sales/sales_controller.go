@@ -0,0 +1,55 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	"Aicon-assignment/internal/interfaces/problem"
+	"Aicon-assignment/internal/usecase"
+
+	"github.com/labstack/echo/v4"
+)
+
+type ItemSaleHandler struct {
+	saleUsecase usecase.ItemSaleUsecase
+}
+
+func NewItemSaleHandler(saleUsecase usecase.ItemSaleUsecase) *ItemSaleHandler {
+	return &ItemSaleHandler{
+		saleUsecase: saleUsecase,
+	}
+}
+
+// RecordSale POST /items/{id}/sale エンドポイント
+func (h *ItemSaleHandler) RecordSale(c echo.Context) error {
+	itemID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return problem.Write(c, problem.New(http.StatusBadRequest, "invalid_item_id"))
+	}
+
+	var input usecase.RecordSaleInput
+	if err := c.Bind(&input); err != nil {
+		return problem.Write(c, problem.New(http.StatusBadRequest, "invalid_request_format"))
+	}
+
+	sale, err := h.saleUsecase.RecordSale(c.Request().Context(), itemID, input)
+	if err != nil {
+		return problem.Write(c, problem.FromError(err, "failed_to_record_sale"))
+	}
+
+	return c.JSON(http.StatusCreated, sale)
+}
+
+// CancelSale DELETE /items/{id}/sale エンドポイント
+func (h *ItemSaleHandler) CancelSale(c echo.Context) error {
+	itemID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return problem.Write(c, problem.New(http.StatusBadRequest, "invalid_item_id"))
+	}
+
+	if err := h.saleUsecase.CancelSale(c.Request().Context(), itemID); err != nil {
+		return problem.Write(c, problem.FromError(err, "failed_to_cancel_sale"))
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
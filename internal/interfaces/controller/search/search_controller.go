@@ -0,0 +1,107 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	"Aicon-assignment/internal/domain/entity"
+	"Aicon-assignment/internal/interfaces/i18n"
+	"Aicon-assignment/internal/interfaces/problem"
+	"Aicon-assignment/internal/interfaces/resource"
+	"Aicon-assignment/internal/usecase"
+
+	"github.com/labstack/echo/v4"
+)
+
+type ItemSearchHandler struct {
+	searchUsecase usecase.ItemSearchUsecase
+}
+
+func NewItemSearchHandler(searchUsecase usecase.ItemSearchUsecase) *ItemSearchHandler {
+	return &ItemSearchHandler{
+		searchUsecase: searchUsecase,
+	}
+}
+
+// 検索結果の1件（アイテムは一覧と同じく _links を付ける）
+type SearchHitResponse struct {
+	Score      float64             `json:"score"`
+	Highlights map[string][]string `json:"highlights"`
+	Item       *resource.Item      `json:"item"`
+}
+
+type SearchResponse struct {
+	Total  int                     `json:"total"`
+	Engine string                  `json:"engine"`
+	Hits   []SearchHitResponse     `json:"hits"`
+	Facets entity.ItemSearchFacets `json:"facets"`
+	// ファセットのカテゴリーの表示名（Accept-Language の言語）
+	CategoryLabels map[string]string `json:"category_labels"`
+}
+
+// 再構築で書き込んだアイテムの数
+type ReindexResponse struct {
+	Indexed int `json:"indexed"`
+}
+
+// SearchItems GET /items/search エンドポイント
+func (h *ItemSearchHandler) SearchItems(c echo.Context) error {
+	query := entity.ItemSearchQuery{
+		Query:    c.QueryParam("q"),
+		Category: c.QueryParam("category"),
+		Brand:    c.QueryParam("brand"),
+		Limit:    entity.DefaultSearchLimit,
+	}
+	for name, target := range map[string]*int{"limit": &query.Limit, "offset": &query.Offset} {
+		if value := c.QueryParam(name); value != "" {
+			n, err := strconv.Atoi(value)
+			if err != nil {
+				return problem.Write(c, problem.New(http.StatusBadRequest, "invalid_filter").WithDetails(name+" must be an integer"))
+			}
+			*target = n
+		}
+	}
+
+	result, err := h.searchUsecase.Search(c.Request().Context(), query)
+	if err != nil {
+		return problem.Write(c, problem.FromError(err, "failed_to_search_items"))
+	}
+
+	lang := resource.Lang(c)
+	categories := make([]string, 0, len(result.Facets.Categories))
+	for _, facet := range result.Facets.Categories {
+		categories = append(categories, facet.Value)
+	}
+	response := SearchResponse{
+		Total:          result.Total,
+		Engine:         result.Engine,
+		Hits:           make([]SearchHitResponse, 0, len(result.Hits)),
+		Facets:         result.Facets,
+		CategoryLabels: i18n.CategoryNames(lang, categories),
+	}
+	for _, hit := range result.Hits {
+		response.Hits = append(response.Hits, SearchHitResponse{Score: hit.Score, Highlights: hit.Highlights, Item: resource.NewItem(hit.Item, lang)})
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// SuggestItems GET /items/suggest エンドポイント
+func (h *ItemSearchHandler) SuggestItems(c echo.Context) error {
+	suggestions, err := h.searchUsecase.Suggest(c.Request().Context(), c.QueryParam("q"))
+	if err != nil {
+		return problem.Write(c, problem.FromError(err, "failed_to_suggest_items"))
+	}
+
+	return c.JSON(http.StatusOK, suggestions)
+}
+
+// Reindex POST /admin/search/reindex エンドポイント
+func (h *ItemSearchHandler) Reindex(c echo.Context) error {
+	indexed, err := h.searchUsecase.Reindex(c.Request().Context())
+	if err != nil {
+		return problem.Write(c, problem.New(http.StatusInternalServerError, "failed_to_reindex_items"))
+	}
+
+	return c.JSON(http.StatusOK, ReindexResponse{Indexed: indexed})
+}
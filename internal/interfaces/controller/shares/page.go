@@ -0,0 +1,64 @@
+package controller
+
+import (
+	"html/template"
+	"strconv"
+)
+
+// 共有リンクの公開ページ（選んだ項目だけを表示する）
+var sharedItemPage = template.Must(template.New("shared").Funcs(template.FuncMap{
+	"yen": formatYen,
+}).Parse(`<!DOCTYPE html>
+<html lang="ja">
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<meta name="robots" content="noindex">
+<title>{{if .Name}}{{.Name}}{{else}}共有されたアイテム{{end}}</title>
+<style>
+body { font-family: sans-serif; max-width: 40rem; margin: 2rem auto; padding: 0 1rem; color: #222; }
+dl { display: grid; grid-template-columns: max-content 1fr; gap: .5rem 1.5rem; }
+dt { color: #666; }
+dd { margin: 0; }
+footer { margin-top: 2rem; color: #888; font-size: .875rem; }
+</style>
+</head>
+<body>
+<h1>{{if .Name}}{{.Name}}{{else}}共有されたアイテム{{end}}</h1>
+<dl>
+{{- if .Category}}
+<dt>カテゴリー</dt><dd>{{.Category}}</dd>
+{{- end}}
+{{- if .Brand}}
+<dt>ブランド</dt><dd>{{.Brand}}</dd>
+{{- end}}
+{{- if .Condition}}
+<dt>コンディション</dt><dd>{{.Condition}}</dd>
+{{- end}}
+{{- if .PurchaseDate}}
+<dt>購入日</dt><dd>{{.PurchaseDate}}</dd>
+{{- end}}
+{{- if .PurchasePrice}}
+<dt>購入価格</dt><dd>{{yen .PurchasePrice}}</dd>
+{{- end}}
+{{- range $key, $value := .Attributes}}
+<dt>{{$key}}</dt><dd>{{$value}}</dd>
+{{- end}}
+</dl>
+<footer>このページは {{.ExpiresAt.Format "2006-01-02 15:04 MST"}} まで公開されています。</footer>
+</body>
+</html>
+`))
+
+// 3桁ごとに区切った円表記
+func formatYen(price *int) string {
+	digits := strconv.Itoa(*price)
+	sign := ""
+	if digits[0] == '-' {
+		sign, digits = "-", digits[1:]
+	}
+	for i := len(digits) - 3; i > 0; i -= 3 {
+		digits = digits[:i] + "," + digits[i:]
+	}
+	return "¥" + sign + digits
+}
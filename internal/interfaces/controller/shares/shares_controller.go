@@ -0,0 +1,124 @@
+package controller
+
+import (
+	"bytes"
+	"fmt"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"Aicon-assignment/internal/interfaces/problem"
+	"Aicon-assignment/internal/usecase"
+
+	"github.com/labstack/echo/v4"
+)
+
+// 公開ページのパス（APIのバージョンとは関係なく同じURLで見られるようにする）
+const SharedPath = "/shared"
+
+type ShareLinkHandler struct {
+	shareLinkUsecase usecase.ShareLinkUsecase
+}
+
+func NewShareLinkHandler(shareLinkUsecase usecase.ShareLinkUsecase) *ShareLinkHandler {
+	return &ShareLinkHandler{
+		shareLinkUsecase: shareLinkUsecase,
+	}
+}
+
+// 作成したリンクと、そのまま相手に渡せる公開ページのURL
+type createdShareLinkResponse struct {
+	*usecase.CreatedShareLink
+	URL string `json:"url"`
+}
+
+// CreateShareLink POST /items/{id}/share エンドポイント
+func (h *ShareLinkHandler) CreateShareLink(c echo.Context) error {
+	itemID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return problem.Write(c, problem.New(http.StatusBadRequest, "invalid_item_id"))
+	}
+
+	var input usecase.CreateShareLinkInput
+	if err := c.Bind(&input); err != nil {
+		return problem.Write(c, problem.New(http.StatusBadRequest, "invalid_request_format"))
+	}
+
+	link, err := h.shareLinkUsecase.CreateShareLink(c.Request().Context(), itemID, input)
+	if err != nil {
+		return problem.Write(c, problem.FromError(err, "failed_to_create_share_link"))
+	}
+
+	url := fmt.Sprintf("%s://%s%s/%s", c.Scheme(), c.Request().Host, SharedPath, link.Token)
+	return c.JSON(http.StatusCreated, createdShareLinkResponse{CreatedShareLink: link, URL: url})
+}
+
+// GetShareLinks GET /items/{id}/shares エンドポイント
+func (h *ShareLinkHandler) GetShareLinks(c echo.Context) error {
+	itemID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return problem.Write(c, problem.New(http.StatusBadRequest, "invalid_item_id"))
+	}
+
+	links, err := h.shareLinkUsecase.GetShareLinks(c.Request().Context(), itemID)
+	if err != nil {
+		return problem.Write(c, problem.FromError(err, "failed_to_retrieve_share_links"))
+	}
+
+	return c.JSON(http.StatusOK, links)
+}
+
+// RevokeShareLink DELETE /items/{id}/shares/{shareID} エンドポイント
+func (h *ShareLinkHandler) RevokeShareLink(c echo.Context) error {
+	itemID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return problem.Write(c, problem.New(http.StatusBadRequest, "invalid_item_id"))
+	}
+	id, err := strconv.ParseInt(c.Param("shareID"), 10, 64)
+	if err != nil {
+		return problem.Write(c, problem.New(http.StatusBadRequest, "invalid_share_link_id"))
+	}
+
+	if err := h.shareLinkUsecase.RevokeShareLink(c.Request().Context(), itemID, id); err != nil {
+		return problem.Write(c, problem.FromError(err, "failed_to_revoke_share_link"))
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// GetSharedItem GET /shared/{token} エンドポイント
+// ブラウザで開けるようHTMLで返し、Accept に application/json がある場合はJSONで返す
+func (h *ShareLinkHandler) GetSharedItem(c echo.Context) error {
+	header := c.Response().Header()
+	header.Add(echo.HeaderVary, echo.HeaderAccept)
+	// URLにトークンを含むため、キャッシュ・検索エンジン・リンク先への Referer に残さない
+	header.Set("Cache-Control", "no-store")
+	header.Set("X-Robots-Tag", "noindex")
+	header.Set("Referrer-Policy", "no-referrer")
+
+	item, err := h.shareLinkUsecase.GetSharedItem(c.Request().Context(), c.Param("token"))
+	if err != nil {
+		return problem.Write(c, problem.FromError(err, "failed_to_retrieve_shared_item"))
+	}
+
+	if acceptsJSON(c.Request().Header.Get(echo.HeaderAccept)) {
+		return c.JSON(http.StatusOK, item)
+	}
+
+	var buf bytes.Buffer
+	if err := sharedItemPage.Execute(&buf, item); err != nil {
+		return problem.Write(c, problem.New(http.StatusInternalServerError, "failed_to_retrieve_shared_item"))
+	}
+	return c.HTMLBlob(http.StatusOK, buf.Bytes())
+}
+
+func acceptsJSON(accept string) bool {
+	for _, value := range strings.Split(accept, ",") {
+		mediaType, _, err := mime.ParseMediaType(strings.TrimSpace(value))
+		if err == nil && mediaType == echo.MIMEApplicationJSON {
+			return true
+		}
+	}
+	return false
+}
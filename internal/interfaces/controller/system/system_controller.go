@@ -1,17 +1,68 @@
 package system
 
 import (
+	"context"
 	"net/http"
+	"time"
 
 	"github.com/labstack/echo/v4"
 )
 
-type SystemHandler struct{}
+// 1つの確認にかける時間の上限（ロードバランサーの確認が詰まらないようにする）
+const checkTimeout = 2 * time.Second
+
+// トラフィックを受ける準備ができているかの確認項目（データベースへの接続など）
+type Check struct {
+	Name  string
+	Check func(ctx context.Context) error
+}
+
+type CheckResult struct {
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
+
+type StatusResponse struct {
+	Status string                 `json:"status"`
+	Checks map[string]CheckResult `json:"checks,omitempty"`
+}
+
+type SystemHandler struct {
+	checks []Check
+}
 
 func (handler *SystemHandler) Health(ctx echo.Context) {
 	ctx.NoContent(http.StatusOK)
 }
 
-func NewSystemHandler() *SystemHandler {
-	return &SystemHandler{}
+// プロセスが動いているか（依存先は確認しない。失敗するとコンテナが再起動されるため）
+func (handler *SystemHandler) Liveness(c echo.Context) error {
+	return c.JSON(http.StatusOK, StatusResponse{Status: "ok"})
+}
+
+// 全ての確認項目が成功した場合のみ200、1つでも失敗すれば503を返す
+func (handler *SystemHandler) Readiness(c echo.Context) error {
+	response := StatusResponse{Status: "ok", Checks: make(map[string]CheckResult, len(handler.checks))}
+
+	for _, check := range handler.checks {
+		ctx, cancel := context.WithTimeout(c.Request().Context(), checkTimeout)
+		err := check.Check(ctx)
+		cancel()
+
+		if err != nil {
+			response.Status = "unavailable"
+			response.Checks[check.Name] = CheckResult{Status: "error", Error: err.Error()}
+			continue
+		}
+		response.Checks[check.Name] = CheckResult{Status: "ok"}
+	}
+
+	if response.Status != "ok" {
+		return c.JSON(http.StatusServiceUnavailable, response)
+	}
+	return c.JSON(http.StatusOK, response)
+}
+
+func NewSystemHandler(checks ...Check) *SystemHandler {
+	return &SystemHandler{checks: checks}
 }
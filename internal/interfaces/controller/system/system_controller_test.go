@@ -0,0 +1,80 @@
+package system
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func serve(t *testing.T, handler echo.HandlerFunc) (int, StatusResponse) {
+	e := echo.New()
+	rec := httptest.NewRecorder()
+	c := e.NewContext(httptest.NewRequest(http.MethodGet, "/", nil), rec)
+
+	require.NoError(t, handler(c))
+
+	var response StatusResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	return rec.Code, response
+}
+
+func TestSystemHandler_Liveness(t *testing.T) {
+	t.Run("正常系: 依存先に関係なく200を返す", func(t *testing.T) {
+		handler := NewSystemHandler(Check{Name: "database", Check: func(ctx context.Context) error {
+			return errors.New("connection refused")
+		}})
+
+		code, response := serve(t, handler.Liveness)
+
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, "ok", response.Status)
+	})
+}
+
+func TestSystemHandler_Readiness(t *testing.T) {
+	ok := func(ctx context.Context) error { return nil }
+
+	t.Run("正常系: 全て成功すれば200", func(t *testing.T) {
+		handler := NewSystemHandler(Check{Name: "database", Check: ok}, Check{Name: "migrations", Check: ok})
+
+		code, response := serve(t, handler.Readiness)
+
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, StatusResponse{
+			Status: "ok",
+			Checks: map[string]CheckResult{"database": {Status: "ok"}, "migrations": {Status: "ok"}},
+		}, response)
+	})
+
+	t.Run("異常系: 1つでも失敗すれば503と理由を返す", func(t *testing.T) {
+		handler := NewSystemHandler(
+			Check{Name: "database", Check: ok},
+			Check{Name: "cache", Check: func(ctx context.Context) error { return errors.New("connection refused") }},
+		)
+
+		code, response := serve(t, handler.Readiness)
+
+		assert.Equal(t, http.StatusServiceUnavailable, code)
+		assert.Equal(t, "unavailable", response.Status)
+		assert.Equal(t, CheckResult{Status: "error", Error: "connection refused"}, response.Checks["cache"])
+		assert.Equal(t, CheckResult{Status: "ok"}, response.Checks["database"])
+	})
+
+	t.Run("異常系: 確認には制限時間を付ける", func(t *testing.T) {
+		handler := NewSystemHandler(Check{Name: "database", Check: func(ctx context.Context) error {
+			<-ctx.Done()
+			return ctx.Err()
+		}})
+
+		code, _ := serve(t, handler.Readiness)
+
+		assert.Equal(t, http.StatusServiceUnavailable, code)
+	})
+}
@@ -0,0 +1,24 @@
+package controller
+
+import (
+	"net/http"
+
+	"Aicon-assignment/internal/usecase"
+
+	"github.com/labstack/echo/v4"
+)
+
+type TaskHandler struct {
+	schedulerUsecase usecase.SchedulerUsecase
+}
+
+func NewTaskHandler(schedulerUsecase usecase.SchedulerUsecase) *TaskHandler {
+	return &TaskHandler{
+		schedulerUsecase: schedulerUsecase,
+	}
+}
+
+// GetTasks GET /admin/tasks エンドポイント
+func (h *TaskHandler) GetTasks(c echo.Context) error {
+	return c.JSON(http.StatusOK, h.schedulerUsecase.GetTasks(c.Request().Context()))
+}
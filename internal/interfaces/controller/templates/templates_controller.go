@@ -0,0 +1,96 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	"Aicon-assignment/internal/interfaces/problem"
+	"Aicon-assignment/internal/interfaces/resource"
+	"Aicon-assignment/internal/usecase"
+
+	"github.com/labstack/echo/v4"
+)
+
+type TemplateHandler struct {
+	templateUsecase usecase.ItemTemplateUsecase
+}
+
+func NewTemplateHandler(templateUsecase usecase.ItemTemplateUsecase) *TemplateHandler {
+	return &TemplateHandler{
+		templateUsecase: templateUsecase,
+	}
+}
+
+func (h *TemplateHandler) GetTemplates(c echo.Context) error {
+	templates, err := h.templateUsecase.GetAllTemplates(c.Request().Context())
+	if err != nil {
+		return problem.Write(c, problem.New(http.StatusInternalServerError, "failed_to_retrieve_templates"))
+	}
+
+	return c.JSON(http.StatusOK, templates)
+}
+
+func (h *TemplateHandler) GetTemplate(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return problem.Write(c, problem.New(http.StatusBadRequest, "invalid_template_id"))
+	}
+
+	template, err := h.templateUsecase.GetTemplateByID(c.Request().Context(), id)
+	if err != nil {
+		return problem.Write(c, problem.FromError(err, "failed_to_retrieve_template"))
+	}
+
+	return c.JSON(http.StatusOK, template)
+}
+
+func (h *TemplateHandler) CreateTemplate(c echo.Context) error {
+	var input usecase.CreateTemplateInput
+	if err := c.Bind(&input); err != nil {
+		return problem.Write(c, problem.New(http.StatusBadRequest, "invalid_request_format"))
+	}
+
+	template, err := h.templateUsecase.CreateTemplate(c.Request().Context(), input)
+	if err != nil {
+		return problem.Write(c, problem.FromError(err, "failed_to_create_template"))
+	}
+
+	return c.JSON(http.StatusCreated, template)
+}
+
+func (h *TemplateHandler) DeleteTemplate(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return problem.Write(c, problem.New(http.StatusBadRequest, "invalid_template_id"))
+	}
+
+	if err := h.templateUsecase.DeleteTemplate(c.Request().Context(), id); err != nil {
+		return problem.Write(c, problem.FromError(err, "failed_to_delete_template"))
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// CreateItemFromTemplate POST /items/from-template/{templateID} エンドポイント
+func (h *TemplateHandler) CreateItemFromTemplate(c echo.Context) error {
+	templateID, err := strconv.ParseInt(c.Param("templateID"), 10, 64)
+	if err != nil {
+		return problem.Write(c, problem.New(http.StatusBadRequest, "invalid_template_id"))
+	}
+
+	var input usecase.CreateItemFromTemplateInput
+	if err := c.Bind(&input); err != nil {
+		return problem.Write(c, problem.New(http.StatusBadRequest, "invalid_request_format"))
+	}
+
+	if input.PurchaseDate == "" {
+		return problem.Write(c, problem.New(http.StatusBadRequest, "validation_failed").WithDetails("purchase_date is required"))
+	}
+
+	item, err := h.templateUsecase.CreateItemFromTemplate(c.Request().Context(), templateID, input)
+	if err != nil {
+		return problem.Write(c, problem.FromError(err, "failed_to_create_item"))
+	}
+
+	return resource.WriteItem(c, http.StatusCreated, item)
+}
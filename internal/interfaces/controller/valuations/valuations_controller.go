@@ -0,0 +1,55 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	"Aicon-assignment/internal/interfaces/problem"
+	"Aicon-assignment/internal/usecase"
+
+	"github.com/labstack/echo/v4"
+)
+
+type ItemValuationHandler struct {
+	valuationUsecase usecase.ItemValuationUsecase
+	// リクエストの閲覧者（filter.favorites で絞り込むお気に入りの持ち主）
+	viewer func(c echo.Context) string
+}
+
+func NewItemValuationHandler(valuationUsecase usecase.ItemValuationUsecase, viewer func(c echo.Context) string) *ItemValuationHandler {
+	return &ItemValuationHandler{
+		valuationUsecase: valuationUsecase,
+		viewer:           viewer,
+	}
+}
+
+// BulkRevalue POST /items/bulk-revalue エンドポイント
+func (h *ItemValuationHandler) BulkRevalue(c echo.Context) error {
+	var input usecase.BulkRevalueInput
+	if err := c.Bind(&input); err != nil {
+		return problem.Write(c, problem.New(http.StatusBadRequest, "invalid_request_format"))
+	}
+	input.Filter.Viewer = h.viewer(c)
+
+	result, err := h.valuationUsecase.BulkRevalue(c.Request().Context(), input)
+	if err != nil {
+		return problem.Write(c, problem.FromError(err, "failed_to_revalue_items"))
+	}
+
+	return c.JSON(http.StatusOK, result)
+}
+
+// GetValuations GET /items/{id}/valuations エンドポイント
+func (h *ItemValuationHandler) GetValuations(c echo.Context) error {
+	itemID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return problem.Write(c, problem.New(http.StatusBadRequest, "invalid_item_id"))
+	}
+
+	valuations, err := h.valuationUsecase.GetValuations(c.Request().Context(), itemID)
+	if err != nil {
+		return problem.Write(c, problem.FromError(err, "failed_to_retrieve_valuations"))
+	}
+
+	return c.JSON(http.StatusOK, valuations)
+}
@@ -0,0 +1,89 @@
+package controller
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"Aicon-assignment/internal/domain/entity"
+	"Aicon-assignment/internal/interfaces/i18n"
+	"Aicon-assignment/internal/interfaces/problem"
+	"Aicon-assignment/internal/interfaces/resource"
+	"Aicon-assignment/internal/usecase"
+
+	"github.com/labstack/echo/v4"
+)
+
+type ItemViewHandler struct {
+	viewUsecase usecase.ItemViewUsecase
+	// リクエストの閲覧者（認証で利用者が決まらない場合は空文字）
+	viewer func(c echo.Context) string
+}
+
+func NewItemViewHandler(viewUsecase usecase.ItemViewUsecase, viewer func(c echo.Context) string) *ItemViewHandler {
+	return &ItemViewHandler{
+		viewUsecase: viewUsecase,
+		viewer:      viewer,
+	}
+}
+
+// 最近見たアイテムのレスポンス（アイテムを _links 付きで埋め込む）
+type viewedItemResponse struct {
+	ViewedAt time.Time      `json:"viewed_at"`
+	Item     *resource.Item `json:"item"`
+}
+
+func newViewedItemResponse(viewed *usecase.ViewedItem, lang i18n.Lang) *viewedItemResponse {
+	return &viewedItemResponse{ViewedAt: viewed.ViewedAt, Item: resource.NewItem(viewed.Item, lang)}
+}
+
+// RecordView GET /items/{id} に付けるミドルウェア
+// 詳細を200で返した場合だけ閲覧を記録する（304はキャッシュの再検証で自動的に送られるため数えない）
+// 記録に失敗してもレスポンスには影響させない
+func (h *ItemViewHandler) RecordView(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		if err := next(c); err != nil {
+			return err
+		}
+
+		if c.Response().Status != http.StatusOK {
+			return nil
+		}
+		itemID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			return nil
+		}
+		// レスポンスを返し終えた後で接続が切れても記録する
+		ctx := context.WithoutCancel(c.Request().Context())
+		if err := h.viewUsecase.RecordView(ctx, h.viewer(c), itemID); err != nil {
+			log.Printf("failed to record view of item %d: %v", itemID, err)
+		}
+		return nil
+	}
+}
+
+// GetRecentlyViewed GET /items/recently-viewed エンドポイント
+func (h *ItemViewHandler) GetRecentlyViewed(c echo.Context) error {
+	limit := entity.DefaultRecentlyViewedLimit
+	if value := c.QueryParam("limit"); value != "" {
+		n, err := strconv.Atoi(value)
+		if err != nil {
+			return problem.Write(c, problem.New(http.StatusBadRequest, "invalid_filter").WithDetails("limit must be an integer"))
+		}
+		limit = n
+	}
+
+	viewed, err := h.viewUsecase.GetRecentlyViewed(c.Request().Context(), h.viewer(c), limit)
+	if err != nil {
+		return problem.Write(c, problem.FromError(err, "failed_to_retrieve_recently_viewed"))
+	}
+
+	lang := resource.Lang(c)
+	response := make([]*viewedItemResponse, 0, len(viewed))
+	for _, v := range viewed {
+		response = append(response, newViewedItemResponse(v, lang))
+	}
+	return c.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,101 @@
+package controller
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"Aicon-assignment/internal/domain/entity"
+	"Aicon-assignment/internal/usecase"
+)
+
+type recordedView struct {
+	viewer string
+	itemID int64
+}
+
+type stubItemViewUsecase struct {
+	recorded []recordedView
+	viewed   []*usecase.ViewedItem
+}
+
+func (s *stubItemViewUsecase) RecordView(ctx context.Context, viewer string, itemID int64) error {
+	s.recorded = append(s.recorded, recordedView{viewer: viewer, itemID: itemID})
+	return nil
+}
+
+func (s *stubItemViewUsecase) GetRecentlyViewed(ctx context.Context, viewer string, limit int) ([]*usecase.ViewedItem, error) {
+	return s.viewed, nil
+}
+
+func viewerOf(c echo.Context) string {
+	viewer, _ := c.Get("user").(string)
+	return viewer
+}
+
+func TestItemViewHandler_RecordView(t *testing.T) {
+	serve := func(handler *ItemViewHandler, status int) {
+		c := echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/items/1", nil), httptest.NewRecorder())
+		c.SetParamNames("id")
+		c.SetParamValues("1")
+		c.Set("user", "admin")
+
+		err := handler.RecordView(func(c echo.Context) error {
+			return c.NoContent(status)
+		})(c)
+		require.NoError(t, err)
+	}
+
+	t.Run("正常系: 詳細を200で返した場合は閲覧者とアイテムを記録する", func(t *testing.T) {
+		stub := &stubItemViewUsecase{}
+		handler := NewItemViewHandler(stub, viewerOf)
+
+		serve(handler, http.StatusOK)
+
+		assert.Equal(t, []recordedView{{viewer: "admin", itemID: 1}}, stub.recorded)
+	})
+
+	t.Run("正常系: 304や詳細を返せなかった場合は記録しない", func(t *testing.T) {
+		stub := &stubItemViewUsecase{}
+		handler := NewItemViewHandler(stub, viewerOf)
+
+		serve(handler, http.StatusNotModified)
+		serve(handler, http.StatusNotFound)
+
+		assert.Empty(t, stub.recorded)
+	})
+}
+
+func TestItemViewHandler_GetRecentlyViewed(t *testing.T) {
+	viewedAt := time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC)
+	stub := &stubItemViewUsecase{viewed: []*usecase.ViewedItem{
+		{Item: &entity.Item{ID: 1, Name: "ロレックス デイトナ", Category: "時計"}, ViewedAt: viewedAt},
+	}}
+	handler := NewItemViewHandler(stub, viewerOf)
+
+	t.Run("正常系: アイテムと最後に見た日時を返す", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/items/recently-viewed", nil)
+		req.Header.Set("Accept-Language", "en")
+		rec := httptest.NewRecorder()
+
+		require.NoError(t, handler.GetRecentlyViewed(echo.New().NewContext(req, rec)))
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"viewed_at":"2026-10-16T09:00:00Z"`)
+		assert.Contains(t, rec.Body.String(), `"category_label":"Watches"`)
+	})
+
+	t.Run("異常系: limit が整数ではない", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+
+		require.NoError(t, handler.GetRecentlyViewed(echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/items/recently-viewed?limit=ten", nil), rec)))
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
@@ -0,0 +1,85 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	"Aicon-assignment/internal/domain/entity"
+	"Aicon-assignment/internal/interfaces/masking"
+	"Aicon-assignment/internal/interfaces/problem"
+	"Aicon-assignment/internal/usecase"
+
+	"github.com/labstack/echo/v4"
+)
+
+type WebhookHandler struct {
+	webhookUsecase usecase.WebhookUsecase
+}
+
+func NewWebhookHandler(webhookUsecase usecase.WebhookUsecase) *WebhookHandler {
+	return &WebhookHandler{
+		webhookUsecase: webhookUsecase,
+	}
+}
+
+func (h *WebhookHandler) GetWebhooks(c echo.Context) error {
+	webhooks, err := h.webhookUsecase.GetAllWebhooks(c.Request().Context())
+	if err != nil {
+		return problem.Write(c, problem.New(http.StatusInternalServerError, "failed_to_retrieve_webhooks"))
+	}
+
+	return c.JSON(http.StatusOK, webhooks)
+}
+
+func (h *WebhookHandler) CreateWebhook(c echo.Context) error {
+	var input usecase.RegisterWebhookInput
+	if err := c.Bind(&input); err != nil {
+		return problem.Write(c, problem.New(http.StatusBadRequest, "invalid_request_format"))
+	}
+
+	webhook, err := h.webhookUsecase.RegisterWebhook(c.Request().Context(), input)
+	if err != nil {
+		return problem.Write(c, problem.FromError(err, "failed_to_register_webhook"))
+	}
+
+	return c.JSON(http.StatusCreated, webhook)
+}
+
+func (h *WebhookHandler) DeleteWebhook(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return problem.Write(c, problem.New(http.StatusBadRequest, "invalid_webhook_id"))
+	}
+
+	if err := h.webhookUsecase.DeleteWebhook(c.Request().Context(), id); err != nil {
+		return problem.Write(c, problem.FromError(err, "failed_to_delete_webhook"))
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// GetDeliveries GET /webhooks/{id}/deliveries エンドポイント
+func (h *WebhookHandler) GetDeliveries(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return problem.Write(c, problem.New(http.StatusBadRequest, "invalid_webhook_id"))
+	}
+
+	deliveries, err := h.webhookUsecase.GetDeliveries(c.Request().Context(), id)
+	if err != nil {
+		return problem.Write(c, problem.FromError(err, "failed_to_retrieve_deliveries"))
+	}
+
+	// 送信した内容にはアイテムの購入価格などが含まれるため、管理者以外には伏せて返す
+	if !masking.IsRevealed(c.Request().Context()) {
+		masked := make([]*entity.WebhookDelivery, len(deliveries))
+		for i, delivery := range deliveries {
+			copied := *delivery
+			copied.Payload = string(masking.JSON([]byte(delivery.Payload)))
+			masked[i] = &copied
+		}
+		deliveries = masked
+	}
+
+	return c.JSON(http.StatusOK, deliveries)
+}
@@ -0,0 +1,111 @@
+package controller
+
+import (
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+const (
+	writeWait      = 10 * time.Second
+	pongWait       = 60 * time.Second
+	pingPeriod     = pongWait * 9 / 10
+	maxMessageSize = 4096
+	sendBufferSize = 32
+)
+
+// 1本のWebSocket接続
+type client struct {
+	conn      *websocket.Conn
+	send      chan []byte
+	done      chan struct{}
+	closeOnce sync.Once
+
+	mu     sync.RWMutex
+	filter Filter
+}
+
+func newClient(conn *websocket.Conn, filter Filter) *client {
+	return &client{
+		conn:   conn,
+		send:   make(chan []byte, sendBufferSize),
+		done:   make(chan struct{}),
+		filter: filter,
+	}
+}
+
+func (c *client) matches(message Message) bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.filter.matches(message)
+}
+
+func (c *client) setFilter(filter Filter) {
+	c.mu.Lock()
+	c.filter = filter
+	c.mu.Unlock()
+}
+
+func (c *client) enqueue(data []byte) {
+	select {
+	case c.send <- data:
+	case <-c.done:
+	default:
+		// 受信が追いつかないクライアントは切断し、再接続時に最新の状態を取り直してもらう
+		c.close()
+	}
+}
+
+func (c *client) close() {
+	c.closeOnce.Do(func() {
+		close(c.done)
+	})
+}
+
+// 送信キューのメッセージと定期的なpingを書き込む
+func (c *client) writePump() {
+	ticker := time.NewTicker(pingPeriod)
+	defer func() {
+		ticker.Stop()
+		c.conn.Close()
+	}()
+
+	for {
+		select {
+		case <-c.done:
+			c.conn.SetWriteDeadline(time.Now().Add(writeWait))
+			c.conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseGoingAway, ""))
+			return
+		case data := <-c.send:
+			c.conn.SetWriteDeadline(time.Now().Add(writeWait))
+			if err := c.conn.WriteMessage(websocket.TextMessage, data); err != nil {
+				c.close()
+				return
+			}
+		case <-ticker.C:
+			c.conn.SetWriteDeadline(time.Now().Add(writeWait))
+			if err := c.conn.WriteMessage(websocket.PingMessage, nil); err != nil {
+				c.close()
+				return
+			}
+		}
+	}
+}
+
+// クライアントからの購読条件の変更を読み取る（接続が切れるまでブロックする）
+func (c *client) readPump(onRequest func(data []byte)) {
+	c.conn.SetReadLimit(maxMessageSize)
+	c.conn.SetReadDeadline(time.Now().Add(pongWait))
+	c.conn.SetPongHandler(func(string) error {
+		return c.conn.SetReadDeadline(time.Now().Add(pongWait))
+	})
+
+	for {
+		_, data, err := c.conn.ReadMessage()
+		if err != nil {
+			return
+		}
+		onRequest(data)
+	}
+}
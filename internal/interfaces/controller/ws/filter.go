@@ -0,0 +1,103 @@
+package controller
+
+import (
+	"errors"
+	"net/url"
+	"strings"
+
+	"Aicon-assignment/internal/domain/entity"
+)
+
+// 接続ごとの購読条件（空のリストは全件を意味する）
+type Filter struct {
+	Categories []string `json:"categories"`
+	Events     []string `json:"events"`
+	Summary    bool     `json:"summary"`
+}
+
+// クライアントから購読条件を変更するメッセージ
+type subscribeRequest struct {
+	Type       string   `json:"type"` // subscribe
+	Categories []string `json:"categories"`
+	Events     []string `json:"events"`
+	Summary    *bool    `json:"summary"`
+}
+
+func (f Filter) matches(message Message) bool {
+	if message.Type == messageTypeSummary {
+		return f.Summary
+	}
+	if message.Item == nil {
+		return true
+	}
+	return containsOrEmpty(f.Events, message.Type) && containsOrEmpty(f.Categories, message.Item.Category)
+}
+
+// クエリパラメーター（?category=時計&events=item.created,item.updated&summary=false）から購読条件を作る
+func parseFilter(query url.Values) (Filter, error) {
+	request := subscribeRequest{
+		Categories: splitValues(query["category"]),
+		Events:     splitValues(query["events"]),
+	}
+	if raw := query.Get("summary"); raw != "" {
+		summary := raw != "false" && raw != "0"
+		request.Summary = &summary
+	}
+	return request.toFilter()
+}
+
+func (r subscribeRequest) toFilter() (Filter, error) {
+	var errs []string
+
+	for _, category := range r.Categories {
+		if !contains(entity.GetValidCategories(), category) {
+			errs = append(errs, "category must be one of: "+strings.Join(entity.GetValidCategories(), ", "))
+			break
+		}
+	}
+	for _, eventType := range r.Events {
+		if !entity.IsValidItemEventType(eventType) {
+			errs = append(errs, "events must be one of: "+strings.Join([]string{entity.EventItemCreated, entity.EventItemUpdated, entity.EventItemDeleted, entity.EventItemSold}, ", "))
+			break
+		}
+	}
+	if len(errs) > 0 {
+		return Filter{}, errors.New(strings.Join(errs, ", "))
+	}
+
+	filter := Filter{
+		Categories: r.Categories,
+		Events:     r.Events,
+		Summary:    true,
+	}
+	if r.Summary != nil {
+		filter.Summary = *r.Summary
+	}
+	return filter, nil
+}
+
+// 繰り返し指定とカンマ区切りの両方を受け付ける
+func splitValues(values []string) []string {
+	var result []string
+	for _, value := range values {
+		for _, v := range strings.Split(value, ",") {
+			if v = strings.TrimSpace(v); v != "" {
+				result = append(result, v)
+			}
+		}
+	}
+	return result
+}
+
+func containsOrEmpty(values []string, target string) bool {
+	return len(values) == 0 || contains(values, target)
+}
+
+func contains(values []string, target string) bool {
+	for _, v := range values {
+		if v == target {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,147 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"sync"
+
+	"Aicon-assignment/internal/domain/entity"
+	"Aicon-assignment/internal/domain/event"
+	"Aicon-assignment/internal/usecase"
+)
+
+// 集計の再送で参照するユースケース
+type SummaryProvider interface {
+	GetCategorySummary(ctx context.Context) (*usecase.CategorySummary, error)
+}
+
+// クライアントに送るメッセージ
+type Message struct {
+	Type    string                   `json:"type"` // item.created / item.updated / item.deleted / summary / subscribed / error
+	Item    *entity.Item             `json:"item,omitempty"`
+	Summary *usecase.CategorySummary `json:"summary,omitempty"`
+	Filter  *Filter                  `json:"filter,omitempty"`
+	Error   string                   `json:"error,omitempty"`
+}
+
+const (
+	messageTypeSummary    = "summary"
+	messageTypeSubscribed = "subscribed"
+	messageTypeError      = "error"
+)
+
+// 未処理のイベントを溜めておける数（溢れた分は捨てる）
+const hubEventBufferSize = 256
+
+// 接続中のクライアントにアイテムの変更と集計を配信するpub/sub
+type Hub struct {
+	mu      sync.RWMutex
+	clients map[*client]struct{}
+	events  chan event.ItemEvent
+}
+
+func NewHub() *Hub {
+	return &Hub{
+		clients: make(map[*client]struct{}),
+		events:  make(chan event.ItemEvent, hubEventBufferSize),
+	}
+}
+
+// アイテムのイベントを受け付ける（配信はRunのゴルーチンで行う）
+func (h *Hub) HandleEvent(_ context.Context, e event.Event) {
+	itemEvent, ok := e.(event.ItemEvent)
+	if !ok {
+		return
+	}
+
+	select {
+	case h.events <- itemEvent:
+	default:
+		log.Printf("websocket hub is full, dropping %s event", e.Name())
+	}
+}
+
+// ctxが終わるまでイベントを配信する
+func (h *Hub) Run(ctx context.Context, summaryProvider SummaryProvider) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case e := <-h.events:
+			h.broadcast(Message{Type: e.Name(), Item: e.Item()})
+
+			// まとめて届いた変更は1回の集計で済ませる
+			for drained := false; !drained; {
+				select {
+				case e := <-h.events:
+					h.broadcast(Message{Type: e.Name(), Item: e.Item()})
+				default:
+					drained = true
+				}
+			}
+
+			if !h.wantsSummary() {
+				continue
+			}
+			summary, err := summaryProvider.GetCategorySummary(ctx)
+			if err != nil {
+				log.Printf("failed to refresh summary for websocket clients: %v", err)
+				continue
+			}
+			h.broadcast(Message{Type: messageTypeSummary, Summary: summary})
+		}
+	}
+}
+
+// 接続中の全クライアントを切断する（サーバー停止時に呼ぶ）
+func (h *Hub) Close() {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	for c := range h.clients {
+		c.close()
+	}
+}
+
+func (h *Hub) broadcast(message Message) {
+	data, err := json.Marshal(message)
+	if err != nil {
+		log.Printf("failed to encode websocket message %s: %v", message.Type, err)
+		return
+	}
+
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	for c := range h.clients {
+		if c.matches(message) {
+			c.enqueue(data)
+		}
+	}
+}
+
+// 集計を購読しているクライアントがいるか（いなければ集計のクエリを省く）
+func (h *Hub) wantsSummary() bool {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	for c := range h.clients {
+		if c.matches(Message{Type: messageTypeSummary}) {
+			return true
+		}
+	}
+	return false
+}
+
+func (h *Hub) register(c *client) {
+	h.mu.Lock()
+	h.clients[c] = struct{}{}
+	h.mu.Unlock()
+}
+
+func (h *Hub) unregister(c *client) {
+	h.mu.Lock()
+	delete(h.clients, c)
+	h.mu.Unlock()
+}
@@ -0,0 +1,87 @@
+package controller
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+
+	"github.com/gorilla/websocket"
+	"github.com/labstack/echo/v4"
+
+	"Aicon-assignment/internal/interfaces/problem"
+)
+
+type WebSocketHandler struct {
+	hub             *Hub
+	summaryProvider SummaryProvider
+	upgrader        websocket.Upgrader
+}
+
+func NewWebSocketHandler(hub *Hub, summaryProvider SummaryProvider) *WebSocketHandler {
+	return &WebSocketHandler{
+		hub:             hub,
+		summaryProvider: summaryProvider,
+	}
+}
+
+// Connect GET /ws エンドポイント
+func (h *WebSocketHandler) Connect(c echo.Context) error {
+	filter, err := parseFilter(c.QueryParams())
+	if err != nil {
+		return problem.Write(c, problem.New(http.StatusBadRequest, "validation_failed").WithDetails(err.Error()))
+	}
+
+	conn, err := h.upgrader.Upgrade(c.Response(), c.Request(), nil)
+	if err != nil {
+		// Upgraderがエラーレスポンスを書き込み済み
+		return nil
+	}
+
+	client := newClient(conn, filter)
+	h.hub.register(client)
+	defer func() {
+		h.hub.unregister(client)
+		client.close()
+	}()
+	go client.writePump()
+
+	h.send(client, Message{Type: messageTypeSubscribed, Filter: &filter})
+
+	// 接続直後に現在の集計を送って、以降は変更のたびに送る
+	if filter.Summary {
+		summary, err := h.summaryProvider.GetCategorySummary(c.Request().Context())
+		if err != nil {
+			log.Printf("failed to get summary for websocket client: %v", err)
+		} else {
+			h.send(client, Message{Type: messageTypeSummary, Summary: summary})
+		}
+	}
+
+	client.readPump(func(data []byte) {
+		var request subscribeRequest
+		if err := json.Unmarshal(data, &request); err != nil || request.Type != "subscribe" {
+			h.send(client, Message{Type: messageTypeError, Error: `message must be {"type": "subscribe", ...}`})
+			return
+		}
+
+		filter, err := request.toFilter()
+		if err != nil {
+			h.send(client, Message{Type: messageTypeError, Error: err.Error()})
+			return
+		}
+
+		client.setFilter(filter)
+		h.send(client, Message{Type: messageTypeSubscribed, Filter: &filter})
+	})
+
+	return nil
+}
+
+func (h *WebSocketHandler) send(client *client, message Message) {
+	data, err := json.Marshal(message)
+	if err != nil {
+		log.Printf("failed to encode websocket message %s: %v", message.Type, err)
+		return
+	}
+	client.enqueue(data)
+}
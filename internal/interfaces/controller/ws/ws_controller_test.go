@@ -0,0 +1,119 @@
+package controller
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"Aicon-assignment/internal/domain/entity"
+	"Aicon-assignment/internal/domain/event"
+	"Aicon-assignment/internal/usecase"
+)
+
+// MockSummaryProvider はtestify/mockを使用したモック
+type MockSummaryProvider struct {
+	mock.Mock
+}
+
+func (m *MockSummaryProvider) GetCategorySummary(ctx context.Context) (*usecase.CategorySummary, error) {
+	args := m.Called(ctx)
+	return args.Get(0).(*usecase.CategorySummary), args.Error(1)
+}
+
+// ハブを起動したテストサーバーに接続する
+func dial(t *testing.T, hub *Hub, summaryProvider SummaryProvider, query string) *websocket.Conn {
+	e := echo.New()
+	e.GET("/ws", NewWebSocketHandler(hub, summaryProvider).Connect)
+	server := httptest.NewServer(e)
+	t.Cleanup(server.Close)
+
+	conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(server.URL, "http")+"/ws"+query, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+	return conn
+}
+
+func readMessage(t *testing.T, conn *websocket.Conn) Message {
+	var message Message
+	conn.SetReadDeadline(time.Now().Add(time.Second))
+	require.NoError(t, conn.ReadJSON(&message))
+	return message
+}
+
+func TestWebSocketHandler_Connect(t *testing.T) {
+	summary := &usecase.CategorySummary{Categories: map[string]int{"時計": 1}, Total: 1}
+
+	t.Run("正常系: 購読したカテゴリーの変更と集計が届く", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		summaryProvider := new(MockSummaryProvider)
+		summaryProvider.On("GetCategorySummary", mock.Anything).Return(summary, nil)
+		hub := NewHub()
+		go hub.Run(ctx, summaryProvider)
+
+		conn := dial(t, hub, summaryProvider, "?category="+url.QueryEscape("時計"))
+
+		subscribed := readMessage(t, conn)
+		assert.Equal(t, "subscribed", subscribed.Type)
+		assert.Equal(t, []string{"時計"}, subscribed.Filter.Categories)
+		assert.Equal(t, "summary", readMessage(t, conn).Type)
+
+		hub.HandleEvent(ctx, event.NewItemCreated(&entity.Item{ID: 2, Category: "バッグ"}))
+		hub.HandleEvent(ctx, event.NewItemCreated(&entity.Item{ID: 1, Category: "時計"}))
+
+		// 購読していないバッグの変更は届かない
+		created := readMessage(t, conn)
+		assert.Equal(t, entity.EventItemCreated, created.Type)
+		assert.Equal(t, int64(1), created.Item.ID)
+
+		refreshed := readMessage(t, conn)
+		assert.Equal(t, "summary", refreshed.Type)
+		assert.Equal(t, 1, refreshed.Summary.Total)
+	})
+
+	t.Run("正常系: メッセージで購読条件を変更", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		hub := NewHub()
+		go hub.Run(ctx, new(MockSummaryProvider))
+
+		conn := dial(t, hub, new(MockSummaryProvider), "?summary=false")
+		assert.Equal(t, "subscribed", readMessage(t, conn).Type)
+
+		require.NoError(t, conn.WriteJSON(map[string]interface{}{"type": "subscribe", "events": []string{"item.deleted"}, "summary": false}))
+		subscribed := readMessage(t, conn)
+		assert.Equal(t, "subscribed", subscribed.Type)
+		assert.Equal(t, []string{"item.deleted"}, subscribed.Filter.Events)
+
+		hub.HandleEvent(ctx, event.NewItemUpdated(&entity.Item{ID: 1, Category: "時計"}))
+		hub.HandleEvent(ctx, event.NewItemDeleted(&entity.Item{ID: 1, Category: "時計"}))
+
+		assert.Equal(t, entity.EventItemDeleted, readMessage(t, conn).Type)
+
+		require.NoError(t, conn.WriteJSON(map[string]interface{}{"type": "subscribe", "categories": []string{"家電"}}))
+		failed := readMessage(t, conn)
+		assert.Equal(t, "error", failed.Type)
+		assert.Contains(t, failed.Error, "category must be one of")
+	})
+
+	t.Run("異常系: 不正なカテゴリーは接続前に400", func(t *testing.T) {
+		e := echo.New()
+		e.GET("/ws", NewWebSocketHandler(NewHub(), new(MockSummaryProvider)).Connect)
+		req := httptest.NewRequest(http.MethodGet, "/ws?category="+url.QueryEscape("家電"), nil)
+		rec := httptest.NewRecorder()
+
+		e.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
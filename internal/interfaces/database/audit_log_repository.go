@@ -0,0 +1,56 @@
+package database
+
+import (
+	"context"
+	"fmt"
+
+	"Aicon-assignment/internal/domain/entity"
+	domainErrors "Aicon-assignment/internal/domain/errors"
+)
+
+type AuditLogRepository struct {
+	SqlHandler
+}
+
+func (r *AuditLogRepository) FindRecent(ctx context.Context, limit int) ([]*entity.AuditEntry, error) {
+	query := `
+        SELECT id, action, detail, created_at
+        FROM audit_log
+        ORDER BY id DESC
+        LIMIT ?
+    `
+
+	rows, err := r.Query(ctx, query, limit)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+	}
+	defer rows.Close()
+
+	entries := []*entity.AuditEntry{}
+	for rows.Next() {
+		var entry entity.AuditEntry
+		var detail string
+		if err := rows.Scan(&entry.ID, &entry.Action, &detail, &entry.CreatedAt); err != nil {
+			return nil, fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+		}
+		entry.Detail = []byte(detail)
+		entries = append(entries, &entry)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+	}
+
+	return entries, nil
+}
+
+// 一括変更と同じトランザクションで監査ログを記録する
+func insertAuditEntry(ctx context.Context, tx Tx, entry *entity.AuditEntry) error {
+	query := `
+        INSERT INTO audit_log (action, detail, created_at)
+        VALUES (?, ?, ?)
+    `
+
+	_, err := tx.Execute(ctx, query, entry.Action, string(entry.Detail), entry.CreatedAt)
+	return err
+}
@@ -0,0 +1,431 @@
+package database
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"Aicon-assignment/internal/domain/entity"
+	domainErrors "Aicon-assignment/internal/domain/errors"
+)
+
+// 外部キーで参照する側のテーブルから順に並べる（この順に削除する）
+var backupTables = []string{"item_sales", "item_valuations", "item_relations", "item_comments", "item_location_history", "loans", "items", "locations", "item_templates"}
+
+// アイテムのIDを主キーにする（シーケンスを持たない）テーブル。backupTables より先に削除する
+var backupItemKeyedTables = []string{"item_insurances"}
+
+// バックアップには含めないが、アイテムの内容や操作の記録を持つテーブル。全データの削除で一緒に消す
+var erasedTables = []string{"item_views", "item_favorites", "share_links", "outbox", "webhook_deliveries", "jobs", "audit_log"}
+
+type BackupRepository struct {
+	SqlHandler
+}
+
+// 削除済みのアイテムと、その貸出・移動履歴・メモ・関連・評価額の履歴・売却の記録・保険は含めない
+func (r *BackupRepository) Dump(ctx context.Context) (*entity.Backup, error) {
+	backup := &entity.Backup{}
+
+	err := queryAll(ctx, r, `
+        SELECT id, name, description, created_at, updated_at
+        FROM locations
+        ORDER BY id
+    `, func(scanner rowScanner) error {
+		location, err := scanLocation(scanner)
+		backup.Locations = append(backup.Locations, location)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = queryAll(ctx, r, `
+        SELECT `+itemColumns+`
+        FROM items
+        WHERE deleted_at IS NULL
+        ORDER BY id
+    `, func(scanner rowScanner) error {
+		item, err := scanItem(scanner)
+		backup.Items = append(backup.Items, item)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = queryAll(ctx, r, `
+        SELECT loans.id, loans.item_id, loans.borrower, loans.due_date, loans.loaned_at, loans.returned_at
+        FROM loans
+        JOIN items ON items.id = loans.item_id
+        WHERE items.deleted_at IS NULL
+        ORDER BY loans.id
+    `, func(scanner rowScanner) error {
+		loan, err := scanLoan(scanner)
+		backup.Loans = append(backup.Loans, loan)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = queryAll(ctx, r, `
+        SELECT h.id, h.item_id, h.from_location_id, h.to_location_id, h.moved_at
+        FROM item_location_history h
+        JOIN items ON items.id = h.item_id
+        WHERE items.deleted_at IS NULL
+        ORDER BY h.id
+    `, func(scanner rowScanner) error {
+		move, err := scanLocationMove(scanner)
+		backup.LocationHistory = append(backup.LocationHistory, move)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = queryAll(ctx, r, `
+        SELECT c.id, c.item_id, c.author, c.body, c.created_at
+        FROM item_comments c
+        JOIN items ON items.id = c.item_id
+        WHERE items.deleted_at IS NULL
+        ORDER BY c.id
+    `, func(scanner rowScanner) error {
+		comment, err := scanItemComment(scanner)
+		backup.Comments = append(backup.Comments, comment)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = queryAll(ctx, r, `
+        SELECT r.id, r.item_id, r.related_item_id, r.relation_type, r.created_at
+        FROM item_relations r
+        JOIN items i ON i.id = r.item_id
+        JOIN items related ON related.id = r.related_item_id
+        WHERE i.deleted_at IS NULL AND related.deleted_at IS NULL
+        ORDER BY r.id
+    `, func(scanner rowScanner) error {
+		relation, err := scanItemRelation(scanner)
+		backup.Relations = append(backup.Relations, relation)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = queryAll(ctx, r, `
+        SELECT v.id, v.item_id, v.previous_value, v.value, v.created_at
+        FROM item_valuations v
+        JOIN items ON items.id = v.item_id
+        WHERE items.deleted_at IS NULL
+        ORDER BY v.id
+    `, func(scanner rowScanner) error {
+		valuation, err := scanItemValuation(scanner)
+		backup.Valuations = append(backup.Valuations, valuation)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = queryAll(ctx, r, `
+        SELECT s.id, s.item_id, s.item_name, s.purchase_date, s.purchase_price, s.sold_on, s.sale_price, s.fees, s.created_at
+        FROM item_sales s
+        JOIN items ON items.id = s.item_id
+        WHERE items.deleted_at IS NULL
+        ORDER BY s.id
+    `, func(scanner rowScanner) error {
+		sale, err := scanItemSale(scanner)
+		backup.Sales = append(backup.Sales, sale)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = queryAll(ctx, r, `
+        SELECT i.item_id, i.insurer, i.policy_number, i.photos, i.updated_at
+        FROM item_insurances i
+        JOIN items ON items.id = i.item_id
+        WHERE items.deleted_at IS NULL
+        ORDER BY i.item_id
+    `, func(scanner rowScanner) error {
+		insurance, err := scanItemInsurance(scanner)
+		backup.Insurances = append(backup.Insurances, insurance)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = queryAll(ctx, r, `
+        SELECT id, name, category, brand, purchase_price, item_condition, created_at, updated_at
+        FROM item_templates
+        ORDER BY id
+    `, func(scanner rowScanner) error {
+		template, err := scanItemTemplate(scanner)
+		backup.Templates = append(backup.Templates, template)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return backup, nil
+}
+
+func (r *BackupRepository) Restore(ctx context.Context, backup *entity.Backup, replace bool) error {
+	return retryTx(ctx, r.SqlHandler, func() error {
+		return r.restore(ctx, backup, replace)
+	})
+}
+
+func (r *BackupRepository) restore(ctx context.Context, backup *entity.Backup, replace bool) (err error) {
+	tx, err := r.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("%w: failed to begin transaction: %w", domainErrors.ErrDatabaseError, err)
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if !replace {
+		var count int
+		err = tx.QueryRow(ctx, `
+            SELECT (SELECT COUNT(*) FROM items) + (SELECT COUNT(*) FROM locations) + (SELECT COUNT(*) FROM item_templates)
+        `).Scan(&count)
+		if err != nil {
+			return fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+		}
+		if count > 0 {
+			return domainErrors.ErrRestoreNotEmpty
+		}
+	}
+
+	tables := append(append(append([]string{}, backupItemKeyedTables...), backupTables...), "item_summary")
+	for _, table := range tables {
+		if _, err = tx.Execute(ctx, "DELETE FROM "+table); err != nil {
+			return fmt.Errorf("%w: failed to clear %s: %w", domainErrors.ErrDatabaseError, table, err)
+		}
+	}
+	// 配信済みのイベントはアクティビティにだけ使うため、置き換えたアイテムの分は消す
+	if _, err = tx.Execute(ctx, "DELETE FROM outbox WHERE published_at IS NOT NULL"); err != nil {
+		return fmt.Errorf("%w: failed to clear outbox: %w", domainErrors.ErrDatabaseError, err)
+	}
+
+	if err = insertBackup(ctx, tx, backup); err != nil {
+		return fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+	}
+
+	if err = rebuildItemSummary(ctx, tx); err != nil {
+		return fmt.Errorf("%w: failed to rebuild summary: %w", domainErrors.ErrDatabaseError, err)
+	}
+
+	// IDを指定して登録しても、PostgreSQLのシーケンスは進まない
+	if r.Dialect() == DialectPostgres {
+		for _, table := range backupTables {
+			_, err = tx.Execute(ctx, `SELECT setval(pg_get_serial_sequence('`+table+`', 'id'), COALESCE(MAX(id), 1), MAX(id) IS NOT NULL) FROM `+table)
+			if err != nil {
+				return fmt.Errorf("%w: failed to reset sequence of %s: %w", domainErrors.ErrDatabaseError, table, err)
+			}
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("%w: failed to commit transaction: %w", domainErrors.ErrDatabaseError, err)
+	}
+
+	return nil
+}
+
+func (r *BackupRepository) Erase(ctx context.Context) error {
+	return retryTx(ctx, r.SqlHandler, func() error {
+		return r.erase(ctx)
+	})
+}
+
+func (r *BackupRepository) erase(ctx context.Context) (err error) {
+	tx, err := r.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("%w: failed to begin transaction: %w", domainErrors.ErrDatabaseError, err)
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	tables := append(append(append(append([]string{}, erasedTables...), backupItemKeyedTables...), backupTables...), "item_summary")
+	for _, table := range tables {
+		if _, err = tx.Execute(ctx, "DELETE FROM "+table); err != nil {
+			return fmt.Errorf("%w: failed to clear %s: %w", domainErrors.ErrDatabaseError, table, err)
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("%w: failed to commit transaction: %w", domainErrors.ErrDatabaseError, err)
+	}
+
+	return nil
+}
+
+func insertBackup(ctx context.Context, tx Tx, backup *entity.Backup) error {
+	for _, location := range backup.Locations {
+		_, err := tx.Execute(ctx, `
+            INSERT INTO locations (id, name, description, created_at, updated_at)
+            VALUES (?, ?, ?, ?, ?)
+        `, location.ID, location.Name, location.Description, location.CreatedAt, location.UpdatedAt)
+		if err != nil {
+			return fmt.Errorf("failed to restore location %d: %w", location.ID, err)
+		}
+	}
+
+	for _, item := range backup.Items {
+		attributes, err := marshalAttributes(item.Attributes)
+		if err != nil {
+			return err
+		}
+
+		// 版を含まない古いバックアップのアイテムは1から始める
+		_, err = tx.Execute(ctx, `
+            INSERT INTO items (id, name, category, brand, purchase_price, current_value, purchase_date, item_condition, serial_number, attributes, location_id, archived_at, created_at, updated_at, version)
+            VALUES (?, ?, ?, ?, ?, ?, ?, ?, NULLIF(?, ''), ?, ?, ?, ?, ?, ?)
+        `, item.ID, item.Name, item.Category, item.Brand, item.PurchasePrice, item.CurrentValue, item.PurchaseDate, item.Condition,
+			item.SerialNumber, attributes, item.LocationID, item.ArchivedAt, item.CreatedAt, item.UpdatedAt, max(item.Version, 1))
+		if err != nil {
+			return fmt.Errorf("failed to restore item %d: %w", item.ID, err)
+		}
+	}
+
+	for _, loan := range backup.Loans {
+		_, err := tx.Execute(ctx, `
+            INSERT INTO loans (id, item_id, borrower, due_date, loaned_at, returned_at)
+            VALUES (?, ?, ?, ?, ?, ?)
+        `, loan.ID, loan.ItemID, loan.Borrower, loan.DueDate, loan.LoanedAt, loan.ReturnedAt)
+		if err != nil {
+			return fmt.Errorf("failed to restore loan %d: %w", loan.ID, err)
+		}
+	}
+
+	for _, move := range backup.LocationHistory {
+		_, err := tx.Execute(ctx, `
+            INSERT INTO item_location_history (id, item_id, from_location_id, to_location_id, moved_at)
+            VALUES (?, ?, ?, ?, ?)
+        `, move.ID, move.ItemID, move.FromLocationID, move.ToLocationID, move.MovedAt)
+		if err != nil {
+			return fmt.Errorf("failed to restore location move %d: %w", move.ID, err)
+		}
+	}
+
+	for _, comment := range backup.Comments {
+		_, err := tx.Execute(ctx, `
+            INSERT INTO item_comments (id, item_id, author, body, created_at)
+            VALUES (?, ?, ?, ?, ?)
+        `, comment.ID, comment.ItemID, comment.Author, comment.Body, comment.CreatedAt)
+		if err != nil {
+			return fmt.Errorf("failed to restore comment %d: %w", comment.ID, err)
+		}
+	}
+
+	for _, relation := range backup.Relations {
+		_, err := tx.Execute(ctx, `
+            INSERT INTO item_relations (id, item_id, related_item_id, relation_type, created_at)
+            VALUES (?, ?, ?, ?, ?)
+        `, relation.ID, relation.ItemID, relation.RelatedItemID, relation.Type, relation.CreatedAt)
+		if err != nil {
+			return fmt.Errorf("failed to restore relation %d: %w", relation.ID, err)
+		}
+	}
+
+	for _, valuation := range backup.Valuations {
+		_, err := tx.Execute(ctx, `
+            INSERT INTO item_valuations (id, item_id, previous_value, value, created_at)
+            VALUES (?, ?, ?, ?, ?)
+        `, valuation.ID, valuation.ItemID, valuation.PreviousValue, valuation.Value, valuation.CreatedAt)
+		if err != nil {
+			return fmt.Errorf("failed to restore valuation %d: %w", valuation.ID, err)
+		}
+	}
+
+	for _, sale := range backup.Sales {
+		_, err := tx.Execute(ctx, `
+            INSERT INTO item_sales (id, item_id, item_name, purchase_date, purchase_price, sold_on, sale_price, fees, created_at)
+            VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
+        `, sale.ID, sale.ItemID, sale.ItemName, sale.PurchaseDate, sale.PurchasePrice, sale.SoldOn, sale.SalePrice, sale.Fees, sale.CreatedAt)
+		if err != nil {
+			return fmt.Errorf("failed to restore sale %d: %w", sale.ID, err)
+		}
+	}
+
+	for _, insurance := range backup.Insurances {
+		photos, err := json.Marshal(insurance.Photos)
+		if err != nil {
+			return fmt.Errorf("failed to restore insurance of item %d: %w", insurance.ItemID, err)
+		}
+		_, err = tx.Execute(ctx, `
+            INSERT INTO item_insurances (item_id, insurer, policy_number, photos, updated_at)
+            VALUES (?, ?, ?, ?, ?)
+        `, insurance.ItemID, insurance.Insurer, insurance.PolicyNumber, string(photos), insurance.UpdatedAt)
+		if err != nil {
+			return fmt.Errorf("failed to restore insurance of item %d: %w", insurance.ItemID, err)
+		}
+	}
+
+	for _, template := range backup.Templates {
+		_, err := tx.Execute(ctx, `
+            INSERT INTO item_templates (id, name, category, brand, purchase_price, item_condition, created_at, updated_at)
+            VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+        `, template.ID, template.Name, template.Category, template.Brand, template.PurchasePrice, template.Condition,
+			template.CreatedAt, template.UpdatedAt)
+		if err != nil {
+			return fmt.Errorf("failed to restore template %d: %w", template.ID, err)
+		}
+	}
+
+	return nil
+}
+
+// 集計テーブルを登録したアイテムから作り直す（マイグレーションの初期データと同じ集計）
+func rebuildItemSummary(ctx context.Context, tx Tx) error {
+	_, err := tx.Execute(ctx, `
+        INSERT INTO item_summary (dimension, dimension_value, item_count)
+        SELECT '`+summaryDimensionCategory+`', category, COUNT(*) FROM items WHERE deleted_at IS NULL AND archived_at IS NULL GROUP BY category
+    `)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Execute(ctx, `
+        INSERT INTO item_summary (dimension, dimension_value, item_count)
+        SELECT '`+summaryDimensionCondition+`', item_condition, COUNT(*) FROM items WHERE item_condition <> '' AND deleted_at IS NULL AND archived_at IS NULL GROUP BY item_condition
+    `)
+	return err
+}
+
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// 全ての行を読み、1行ずつ scan に渡す
+func queryAll(ctx context.Context, q SqlHandler, query string, scan func(scanner rowScanner) error) error {
+	rows, err := q.Query(ctx, query)
+	if err != nil {
+		return fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		if err := scan(rows); err != nil {
+			return fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+	}
+
+	return nil
+}
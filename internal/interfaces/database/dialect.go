@@ -0,0 +1,76 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+)
+
+// SQLの方言。プレースホルダーはどの方言でも ? で書き、ハンドラーが変換する
+type Dialect string
+
+const (
+	DialectMySQL    Dialect = "mysql"
+	DialectPostgres Dialect = "postgres"
+	DialectSQLite   Dialect = "sqlite"
+)
+
+// ドライバーに依存せず制約違反を判定するためのエラー（ハンドラーがドライバーのエラーから変換する）
+var (
+	ErrUniqueViolation     = errors.New("unique constraint violation")
+	ErrForeignKeyViolation = errors.New("foreign key constraint violation")
+
+	// デッドロックやロック待ちのタイムアウトなど、同じ文を再実行すれば成功しうるエラー
+	ErrTransient = errors.New("transient database error")
+)
+
+type execQuerier interface {
+	Execute(ctx context.Context, statement string, args ...interface{}) (Result, error)
+	QueryRow(ctx context.Context, statement string, args ...interface{}) Row
+}
+
+// INSERTして採番されたIDを返す（PostgreSQLはLastInsertIdに対応していないためRETURNINGを使う）
+func insertReturningID(ctx context.Context, q execQuerier, dialect Dialect, statement string, args ...interface{}) (int64, error) {
+	if dialect == DialectPostgres {
+		var id int64
+		err := q.QueryRow(ctx, strings.TrimSpace(statement)+" RETURNING id", args...).Scan(&id)
+		return id, err
+	}
+
+	result, err := q.Execute(ctx, statement, args...)
+	if err != nil {
+		return 0, err
+	}
+	return result.LastInsertId()
+}
+
+// カスタム属性のキーと値が一致する条件
+func attributeCondition(dialect Dialect, key, value string) (string, []interface{}) {
+	switch dialect {
+	case DialectPostgres:
+		return "attributes ->> ? = ?", []interface{}{key, value}
+	case DialectSQLite:
+		return "json_extract(attributes, ?) = ?", []interface{}{`$."` + key + `"`, value}
+	}
+	return "JSON_UNQUOTE(JSON_EXTRACT(attributes, ?)) = ?", []interface{}{`$."` + key + `"`, value}
+}
+
+// 行ロックの句（SQLiteは書き込みがデータベース単位で直列化されるため不要）
+func forUpdateClause(dialect Dialect) string {
+	if dialect == DialectSQLite {
+		return ""
+	}
+	return "FOR UPDATE"
+}
+
+// DATE型のカラムを YYYY-MM-DD にそろえる（ドライバーによっては時刻付きの文字列で返る）
+func formatDateColumn(value string) string {
+	if parsed, err := time.Parse("2006-01-02", value); err == nil {
+		return parsed.Format("2006-01-02")
+	}
+	if parsed, err := time.Parse(time.RFC3339Nano, value); err == nil {
+		return parsed.Format("2006-01-02")
+	}
+	return value
+}
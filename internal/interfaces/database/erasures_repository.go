@@ -0,0 +1,115 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"Aicon-assignment/internal/domain/entity"
+	domainErrors "Aicon-assignment/internal/domain/errors"
+)
+
+type ErasureRepository struct {
+	SqlHandler
+}
+
+func (r *ErasureRepository) FindPending(ctx context.Context) (*entity.Erasure, error) {
+	query := `
+        SELECT id, status, requested_at, scheduled_at, finished_at
+        FROM erasures
+        WHERE status = ?
+        ORDER BY id ASC
+        LIMIT 1
+    `
+
+	erasure, err := scanErasure(r.QueryRow(ctx, query, entity.ErasurePending))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, domainErrors.ErrErasureNotFound
+		}
+		return nil, fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+	}
+
+	return erasure, nil
+}
+
+func (r *ErasureRepository) Create(ctx context.Context, erasure *entity.Erasure) (*entity.Erasure, error) {
+	query := `
+        INSERT INTO erasures (status, requested_at, scheduled_at)
+        VALUES (?, ?, ?)
+    `
+
+	id, err := insertReturningID(ctx, r, r.Dialect(), query,
+		entity.ErasurePending,
+		erasure.RequestedAt,
+		erasure.ScheduledAt,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+	}
+
+	return r.findByID(ctx, id)
+}
+
+func (r *ErasureRepository) Finish(ctx context.Context, id int64, status string) (*entity.Erasure, error) {
+	query := `
+        UPDATE erasures
+        SET status = ?, finished_at = ?
+        WHERE id = ? AND status = ?
+    `
+
+	result, err := r.Execute(ctx, query, status, time.Now(), id, entity.ErasurePending)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+	}
+	if rowsAffected == 0 {
+		return nil, domainErrors.ErrErasureNotFound
+	}
+
+	return r.findByID(ctx, id)
+}
+
+func (r *ErasureRepository) findByID(ctx context.Context, id int64) (*entity.Erasure, error) {
+	query := `
+        SELECT id, status, requested_at, scheduled_at, finished_at
+        FROM erasures
+        WHERE id = ?
+    `
+
+	erasure, err := scanErasure(r.QueryRow(ctx, query, id))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+	}
+
+	return erasure, nil
+}
+
+func scanErasure(scanner interface {
+	Scan(dest ...interface{}) error
+}) (*entity.Erasure, error) {
+	var erasure entity.Erasure
+	var finishedAt sql.NullTime
+
+	err := scanner.Scan(
+		&erasure.ID,
+		&erasure.Status,
+		&erasure.RequestedAt,
+		&erasure.ScheduledAt,
+		&finishedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if finishedAt.Valid {
+		erasure.FinishedAt = &finishedAt.Time
+	}
+
+	return &erasure, nil
+}
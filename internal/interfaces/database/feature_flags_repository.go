@@ -0,0 +1,77 @@
+package database
+
+import (
+	"context"
+	"fmt"
+
+	"Aicon-assignment/internal/domain/entity"
+	domainErrors "Aicon-assignment/internal/domain/errors"
+)
+
+type FeatureFlagRepository struct {
+	SqlHandler
+}
+
+func (r *FeatureFlagRepository) FindAll(ctx context.Context) ([]*entity.FeatureFlag, error) {
+	query := `
+        SELECT name, enabled, updated_at
+        FROM feature_flags
+        ORDER BY name ASC
+    `
+
+	rows, err := r.Query(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+	}
+	defer rows.Close()
+
+	var flags []*entity.FeatureFlag
+	for rows.Next() {
+		var flag entity.FeatureFlag
+		if err := rows.Scan(&flag.Name, &flag.Enabled, &flag.UpdatedAt); err != nil {
+			return nil, fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+		}
+		flags = append(flags, &flag)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+	}
+
+	return flags, nil
+}
+
+func (r *FeatureFlagRepository) Save(ctx context.Context, flag *entity.FeatureFlag) (*entity.FeatureFlag, error) {
+	query := `
+        INSERT INTO feature_flags (name, enabled)
+        VALUES (?, ?)
+        ON CONFLICT (name) DO UPDATE SET enabled = excluded.enabled, updated_at = CURRENT_TIMESTAMP
+    `
+	if r.Dialect() == DialectMySQL {
+		query = `
+        INSERT INTO feature_flags (name, enabled)
+        VALUES (?, ?)
+        ON DUPLICATE KEY UPDATE enabled = VALUES(enabled), updated_at = CURRENT_TIMESTAMP
+    `
+	}
+
+	if _, err := r.Execute(ctx, query, flag.Name, flag.Enabled); err != nil {
+		return nil, fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+	}
+
+	saved := entity.FeatureFlag{Name: flag.Name}
+	err := r.QueryRow(ctx, `SELECT enabled, updated_at FROM feature_flags WHERE name = ?`, flag.Name).
+		Scan(&saved.Enabled, &saved.UpdatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+	}
+
+	return &saved, nil
+}
+
+func (r *FeatureFlagRepository) Delete(ctx context.Context, name string) error {
+	if _, err := r.Execute(ctx, `DELETE FROM feature_flags WHERE name = ?`, name); err != nil {
+		return fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+	}
+	return nil
+}
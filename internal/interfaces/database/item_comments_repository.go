@@ -0,0 +1,117 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"Aicon-assignment/internal/domain/entity"
+	domainErrors "Aicon-assignment/internal/domain/errors"
+)
+
+const itemCommentColumns = `id, item_id, author, body, created_at`
+
+type ItemCommentRepository struct {
+	SqlHandler
+}
+
+func (r *ItemCommentRepository) FindByItemID(ctx context.Context, itemID int64) ([]*entity.ItemComment, error) {
+	query := `
+        SELECT ` + itemCommentColumns + `
+        FROM item_comments
+        WHERE item_id = ?
+        ORDER BY id
+    `
+
+	rows, err := r.Query(ctx, query, itemID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+	}
+	defer rows.Close()
+
+	comments := []*entity.ItemComment{}
+	for rows.Next() {
+		comment, err := scanItemComment(rows)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+		}
+		comments = append(comments, comment)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+	}
+
+	return comments, nil
+}
+
+func (r *ItemCommentRepository) Create(ctx context.Context, comment *entity.ItemComment) (*entity.ItemComment, error) {
+	query := `
+        INSERT INTO item_comments (item_id, author, body, created_at)
+        VALUES (?, ?, ?, ?)
+    `
+
+	id, err := insertReturningID(ctx, r, r.Dialect(), query, comment.ItemID, comment.Author, comment.Body, comment.CreatedAt)
+	if err != nil {
+		// 確認後にアイテムが削除された場合
+		if errors.Is(err, ErrForeignKeyViolation) {
+			return nil, domainErrors.ErrItemNotFound
+		}
+		return nil, fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+	}
+
+	query = `
+        SELECT ` + itemCommentColumns + `
+        FROM item_comments
+        WHERE id = ?
+    `
+
+	created, err := scanItemComment(r.QueryRow(ctx, query, id))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+	}
+
+	return created, nil
+}
+
+func (r *ItemCommentRepository) Delete(ctx context.Context, itemID, id int64) error {
+	query := `
+        DELETE FROM item_comments
+        WHERE id = ? AND item_id = ?
+    `
+
+	result, err := r.Execute(ctx, query, id, itemID)
+	if err != nil {
+		return fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%w: failed to get rows affected: %w", domainErrors.ErrDatabaseError, err)
+	}
+
+	if rowsAffected == 0 {
+		return domainErrors.ErrCommentNotFound
+	}
+
+	return nil
+}
+
+func scanItemComment(scanner interface {
+	Scan(dest ...interface{}) error
+}) (*entity.ItemComment, error) {
+	var comment entity.ItemComment
+
+	err := scanner.Scan(
+		&comment.ID,
+		&comment.ItemID,
+		&comment.Author,
+		&comment.Body,
+		&comment.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &comment, nil
+}
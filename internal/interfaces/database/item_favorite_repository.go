@@ -0,0 +1,46 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"Aicon-assignment/internal/domain/entity"
+	domainErrors "Aicon-assignment/internal/domain/errors"
+)
+
+type ItemFavoriteRepository struct {
+	SqlHandler
+}
+
+// 既にお気に入りの場合は最初に加えた日時のまま何もしない
+func (r *ItemFavoriteRepository) Add(ctx context.Context, favorite *entity.ItemFavorite) error {
+	query := `
+        INSERT INTO item_favorites (viewer, item_id, created_at)
+        VALUES (?, ?, ?)
+        ON CONFLICT (viewer, item_id) DO NOTHING
+    `
+	if r.Dialect() == DialectMySQL {
+		query = `
+        INSERT IGNORE INTO item_favorites (viewer, item_id, created_at)
+        VALUES (?, ?, ?)
+    `
+	}
+
+	if _, err := r.Execute(ctx, query, favorite.Viewer, favorite.ItemID, favorite.CreatedAt); err != nil {
+		if errors.Is(err, ErrForeignKeyViolation) {
+			return domainErrors.ErrItemNotFound
+		}
+		return fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+	}
+
+	return nil
+}
+
+func (r *ItemFavoriteRepository) Remove(ctx context.Context, viewer string, itemID int64) error {
+	if _, err := r.Execute(ctx, `DELETE FROM item_favorites WHERE viewer = ? AND item_id = ?`, viewer, itemID); err != nil {
+		return fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+	}
+
+	return nil
+}
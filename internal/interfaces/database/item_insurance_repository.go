@@ -0,0 +1,141 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"Aicon-assignment/internal/domain/entity"
+	domainErrors "Aicon-assignment/internal/domain/errors"
+)
+
+const itemInsuranceColumns = `item_id, insurer, policy_number, photos, updated_at`
+
+type ItemInsuranceRepository struct {
+	SqlHandler
+}
+
+func (r *ItemInsuranceRepository) FindByItemID(ctx context.Context, itemID int64) (*entity.ItemInsurance, error) {
+	query := `
+        SELECT ` + itemInsuranceColumns + `
+        FROM item_insurances
+        WHERE item_id = ?
+    `
+
+	insurance, err := scanItemInsurance(r.QueryRow(ctx, query, itemID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domainErrors.ErrInsuranceNotFound
+		}
+		return nil, fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+	}
+
+	return insurance, nil
+}
+
+func (r *ItemInsuranceRepository) FindAll(ctx context.Context) ([]*entity.ItemInsurance, error) {
+	query := `
+        SELECT ` + itemInsuranceColumns + `
+        FROM item_insurances
+        ORDER BY item_id
+    `
+
+	rows, err := r.Query(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+	}
+	defer rows.Close()
+
+	insurances := []*entity.ItemInsurance{}
+	for rows.Next() {
+		insurance, err := scanItemInsurance(rows)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+		}
+		insurances = append(insurances, insurance)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+	}
+
+	return insurances, nil
+}
+
+func (r *ItemInsuranceRepository) Save(ctx context.Context, insurance *entity.ItemInsurance) (*entity.ItemInsurance, error) {
+	query := `
+        INSERT INTO item_insurances (item_id, insurer, policy_number, photos, updated_at)
+        VALUES (?, ?, ?, ?, ?)
+        ON CONFLICT (item_id) DO UPDATE SET insurer = excluded.insurer, policy_number = excluded.policy_number, photos = excluded.photos, updated_at = excluded.updated_at
+    `
+	if r.Dialect() == DialectMySQL {
+		query = `
+        INSERT INTO item_insurances (item_id, insurer, policy_number, photos, updated_at)
+        VALUES (?, ?, ?, ?, ?)
+        ON DUPLICATE KEY UPDATE insurer = VALUES(insurer), policy_number = VALUES(policy_number), photos = VALUES(photos), updated_at = VALUES(updated_at)
+    `
+	}
+
+	photos, err := json.Marshal(insurance.Photos)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+	}
+
+	_, err = r.Execute(ctx, query, insurance.ItemID, insurance.Insurer, insurance.PolicyNumber, string(photos), insurance.UpdatedAt)
+	if err != nil {
+		if errors.Is(err, ErrForeignKeyViolation) {
+			return nil, domainErrors.ErrItemNotFound
+		}
+		return nil, fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+	}
+
+	return r.FindByItemID(ctx, insurance.ItemID)
+}
+
+func (r *ItemInsuranceRepository) DeleteByItemID(ctx context.Context, itemID int64) error {
+	result, err := r.Execute(ctx, `DELETE FROM item_insurances WHERE item_id = ?`, itemID)
+	if err != nil {
+		return fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+	}
+	if rowsAffected == 0 {
+		return domainErrors.ErrInsuranceNotFound
+	}
+
+	return nil
+}
+
+func scanItemInsurance(scanner interface {
+	Scan(dest ...interface{}) error
+}) (*entity.ItemInsurance, error) {
+	var insurance entity.ItemInsurance
+	var photos string
+
+	err := scanner.Scan(
+		&insurance.ItemID,
+		&insurance.Insurer,
+		&insurance.PolicyNumber,
+		&photos,
+		&insurance.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if photos != "" {
+		if err := json.Unmarshal([]byte(photos), &insurance.Photos); err != nil {
+			return nil, err
+		}
+	}
+	if insurance.Photos == nil {
+		insurance.Photos = []string{}
+	}
+
+	return &insurance, nil
+}
@@ -0,0 +1,117 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"Aicon-assignment/internal/domain/entity"
+	domainErrors "Aicon-assignment/internal/domain/errors"
+)
+
+const itemRelationColumns = `id, item_id, related_item_id, relation_type, created_at`
+
+type ItemRelationRepository struct {
+	SqlHandler
+}
+
+func (r *ItemRelationRepository) FindByItemID(ctx context.Context, itemID int64) ([]*entity.ItemRelation, error) {
+	query := `
+        SELECT ` + itemRelationColumns + `
+        FROM item_relations
+        WHERE item_id = ? OR related_item_id = ?
+        ORDER BY id
+    `
+
+	rows, err := r.Query(ctx, query, itemID, itemID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+	}
+	defer rows.Close()
+
+	relations := []*entity.ItemRelation{}
+	for rows.Next() {
+		relation, err := scanItemRelation(rows)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+		}
+		relations = append(relations, relation)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+	}
+
+	return relations, nil
+}
+
+func (r *ItemRelationRepository) Create(ctx context.Context, relation *entity.ItemRelation) (*entity.ItemRelation, error) {
+	query := `
+        INSERT INTO item_relations (item_id, related_item_id, relation_type, created_at)
+        VALUES (?, ?, ?, ?)
+    `
+
+	id, err := insertReturningID(ctx, r, r.Dialect(), query, relation.ItemID, relation.RelatedItemID, relation.Type, relation.CreatedAt)
+	if err != nil {
+		// 確認後にどちらかのアイテムが削除された場合
+		if errors.Is(err, ErrForeignKeyViolation) {
+			return nil, domainErrors.ErrItemNotFound
+		}
+		return nil, fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+	}
+
+	query = `
+        SELECT ` + itemRelationColumns + `
+        FROM item_relations
+        WHERE id = ?
+    `
+
+	created, err := scanItemRelation(r.QueryRow(ctx, query, id))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+	}
+
+	return created, nil
+}
+
+func (r *ItemRelationRepository) Delete(ctx context.Context, itemID, id int64) error {
+	query := `
+        DELETE FROM item_relations
+        WHERE id = ? AND (item_id = ? OR related_item_id = ?)
+    `
+
+	result, err := r.Execute(ctx, query, id, itemID, itemID)
+	if err != nil {
+		return fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%w: failed to get rows affected: %w", domainErrors.ErrDatabaseError, err)
+	}
+
+	if rowsAffected == 0 {
+		return domainErrors.ErrRelationNotFound
+	}
+
+	return nil
+}
+
+func scanItemRelation(scanner interface {
+	Scan(dest ...interface{}) error
+}) (*entity.ItemRelation, error) {
+	var relation entity.ItemRelation
+
+	err := scanner.Scan(
+		&relation.ID,
+		&relation.ItemID,
+		&relation.RelatedItemID,
+		&relation.Type,
+		&relation.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &relation, nil
+}
@@ -0,0 +1,166 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"Aicon-assignment/internal/domain/entity"
+	domainErrors "Aicon-assignment/internal/domain/errors"
+)
+
+const itemSaleColumns = `id, item_id, item_name, purchase_date, purchase_price, sold_on, sale_price, fees, created_at`
+
+type ItemSaleRepository struct {
+	SqlHandler
+}
+
+func (r *ItemSaleRepository) FindByItemID(ctx context.Context, itemID int64) ([]*entity.ItemSale, error) {
+	query := `
+        SELECT ` + itemSaleColumns + `
+        FROM item_sales
+        WHERE item_id = ?
+        ORDER BY id
+    `
+
+	return r.findAll(ctx, query, itemID)
+}
+
+func (r *ItemSaleRepository) FindSoldBetween(ctx context.Context, from, to string) ([]*entity.ItemSale, error) {
+	query := `
+        SELECT ` + itemSaleColumns + `
+        FROM item_sales
+        WHERE sold_on >= ? AND sold_on <= ?
+        ORDER BY sold_on, id
+    `
+
+	return r.findAll(ctx, query, from, to)
+}
+
+func (r *ItemSaleRepository) findAll(ctx context.Context, query string, args ...interface{}) ([]*entity.ItemSale, error) {
+	rows, err := r.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+	}
+	defer rows.Close()
+
+	sales := []*entity.ItemSale{}
+	for rows.Next() {
+		sale, err := scanItemSale(rows)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+		}
+		sales = append(sales, sale)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+	}
+
+	return sales, nil
+}
+
+// 売却の記録とitem.soldイベントのアウトボックスへの記録を1つのトランザクションで行う
+func (r *ItemSaleRepository) Create(ctx context.Context, sale *entity.ItemSale) (*entity.ItemSale, error) {
+	var created *entity.ItemSale
+	err := retryTx(ctx, r.SqlHandler, func() (err error) {
+		created, err = r.create(ctx, sale)
+		return err
+	})
+	return created, err
+}
+
+func (r *ItemSaleRepository) create(ctx context.Context, sale *entity.ItemSale) (created *entity.ItemSale, err error) {
+	query := `
+        INSERT INTO item_sales (item_id, item_name, purchase_date, purchase_price, sold_on, sale_price, fees, created_at)
+        VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+    `
+
+	tx, err := r.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to begin transaction: %w", domainErrors.ErrDatabaseError, err)
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	id, err := insertReturningID(ctx, tx, r.Dialect(), query,
+		sale.ItemID, sale.ItemName, sale.PurchaseDate, sale.PurchasePrice, sale.SoldOn, sale.SalePrice, sale.Fees, sale.CreatedAt)
+	if err != nil {
+		if errors.Is(err, ErrForeignKeyViolation) {
+			return nil, domainErrors.ErrItemNotFound
+		}
+		return nil, fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+	}
+
+	query = `
+        SELECT ` + itemSaleColumns + `
+        FROM item_sales
+        WHERE id = ?
+    `
+
+	created, err = scanItemSale(tx.QueryRow(ctx, query, id))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+	}
+
+	item, err := findItemByID(ctx, tx, sale.ItemID)
+	if err != nil {
+		return nil, err
+	}
+	if err = insertOutboxMessage(ctx, tx, entity.EventItemSold, item); err != nil {
+		return nil, fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf("%w: failed to commit transaction: %w", domainErrors.ErrDatabaseError, err)
+	}
+
+	return created, nil
+}
+
+func (r *ItemSaleRepository) DeleteByItemID(ctx context.Context, itemID int64) error {
+	result, err := r.Execute(ctx, `DELETE FROM item_sales WHERE item_id = ?`, itemID)
+	if err != nil {
+		return fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+	}
+	if rowsAffected == 0 {
+		return domainErrors.ErrSaleNotFound
+	}
+
+	return nil
+}
+
+func scanItemSale(scanner interface {
+	Scan(dest ...interface{}) error
+}) (*entity.ItemSale, error) {
+	var sale entity.ItemSale
+	var purchaseDate, soldOn string
+
+	err := scanner.Scan(
+		&sale.ID,
+		&sale.ItemID,
+		&sale.ItemName,
+		&purchaseDate,
+		&sale.PurchasePrice,
+		&soldOn,
+		&sale.SalePrice,
+		&sale.Fees,
+		&sale.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	sale.PurchaseDate = formatDateColumn(purchaseDate)
+	sale.SoldOn = formatDateColumn(soldOn)
+
+	return &sale, nil
+}
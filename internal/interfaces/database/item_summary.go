@@ -0,0 +1,49 @@
+package database
+
+import (
+	"context"
+
+	"Aicon-assignment/internal/domain/entity"
+)
+
+// item_summary テーブルの集計軸
+const (
+	summaryDimensionCategory  = "category"
+	summaryDimensionCondition = "condition"
+)
+
+// アイテムの増減（delta は 1 か -1）をアイテムの変更と同じトランザクションで集計テーブルに反映する
+// アーカイブしたアイテムは集計に含めないため何もしない
+func adjustItemSummary(ctx context.Context, tx Tx, dialect Dialect, item *entity.Item, delta int) error {
+	if item.IsArchived() {
+		return nil
+	}
+
+	if err := adjustSummaryCount(ctx, tx, dialect, summaryDimensionCategory, item.Category, delta); err != nil {
+		return err
+	}
+
+	// 未評価のコンディションは集計しない
+	if item.Condition == "" {
+		return nil
+	}
+	return adjustSummaryCount(ctx, tx, dialect, summaryDimensionCondition, item.Condition, delta)
+}
+
+func adjustSummaryCount(ctx context.Context, tx Tx, dialect Dialect, dimension, value string, delta int) error {
+	query := `
+        INSERT INTO item_summary (dimension, dimension_value, item_count)
+        VALUES (?, ?, ?)
+        ON DUPLICATE KEY UPDATE item_count = item_count + VALUES(item_count)
+    `
+	if dialect == DialectPostgres || dialect == DialectSQLite {
+		query = `
+        INSERT INTO item_summary (dimension, dimension_value, item_count)
+        VALUES (?, ?, ?)
+        ON CONFLICT (dimension, dimension_value) DO UPDATE SET item_count = item_summary.item_count + EXCLUDED.item_count
+    `
+	}
+
+	_, err := tx.Execute(ctx, query, dimension, value, delta)
+	return err
+}
@@ -0,0 +1,125 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"Aicon-assignment/internal/domain/entity"
+	domainErrors "Aicon-assignment/internal/domain/errors"
+)
+
+type ItemTemplateRepository struct {
+	SqlHandler
+}
+
+func (r *ItemTemplateRepository) FindAll(ctx context.Context) ([]*entity.ItemTemplate, error) {
+	query := `
+        SELECT id, name, category, brand, purchase_price, item_condition, created_at, updated_at
+        FROM item_templates
+        ORDER BY name ASC
+    `
+
+	rows, err := r.Query(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+	}
+	defer rows.Close()
+
+	var templates []*entity.ItemTemplate
+	for rows.Next() {
+		template, err := scanItemTemplate(rows)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+		}
+		templates = append(templates, template)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+	}
+
+	return templates, nil
+}
+
+func (r *ItemTemplateRepository) FindByID(ctx context.Context, id int64) (*entity.ItemTemplate, error) {
+	query := `
+        SELECT id, name, category, brand, purchase_price, item_condition, created_at, updated_at
+        FROM item_templates
+        WHERE id = ?
+    `
+
+	row := r.QueryRow(ctx, query, id)
+
+	template, err := scanItemTemplate(row)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, domainErrors.ErrTemplateNotFound
+		}
+		return nil, fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+	}
+
+	return template, nil
+}
+
+func (r *ItemTemplateRepository) Create(ctx context.Context, template *entity.ItemTemplate) (*entity.ItemTemplate, error) {
+	query := `
+        INSERT INTO item_templates (name, category, brand, purchase_price, item_condition)
+        VALUES (?, ?, ?, ?, ?)
+    `
+
+	id, err := insertReturningID(ctx, r, r.Dialect(), query,
+		template.Name,
+		template.Category,
+		template.Brand,
+		template.PurchasePrice,
+		template.Condition,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+	}
+
+	return r.FindByID(ctx, id)
+}
+
+func (r *ItemTemplateRepository) Delete(ctx context.Context, id int64) error {
+	query := `DELETE FROM item_templates WHERE id = ?`
+
+	result, err := r.Execute(ctx, query, id)
+	if err != nil {
+		return fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%w: failed to get rows affected: %w", domainErrors.ErrDatabaseError, err)
+	}
+
+	if rowsAffected == 0 {
+		return domainErrors.ErrTemplateNotFound
+	}
+
+	return nil
+}
+
+func scanItemTemplate(scanner interface {
+	Scan(dest ...interface{}) error
+}) (*entity.ItemTemplate, error) {
+	var template entity.ItemTemplate
+
+	err := scanner.Scan(
+		&template.ID,
+		&template.Name,
+		&template.Category,
+		&template.Brand,
+		&template.PurchasePrice,
+		&template.Condition,
+		&template.CreatedAt,
+		&template.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &template, nil
+}
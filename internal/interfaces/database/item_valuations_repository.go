@@ -0,0 +1,93 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"Aicon-assignment/internal/domain/entity"
+	domainErrors "Aicon-assignment/internal/domain/errors"
+)
+
+const itemValuationColumns = `id, item_id, previous_value, value, created_at`
+
+type ItemValuationRepository struct {
+	SqlHandler
+}
+
+func (r *ItemValuationRepository) FindByItemID(ctx context.Context, itemID int64) ([]*entity.ItemValuation, error) {
+	query := `
+        SELECT ` + itemValuationColumns + `
+        FROM item_valuations
+        WHERE item_id = ?
+        ORDER BY id
+    `
+
+	rows, err := r.Query(ctx, query, itemID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+	}
+	defer rows.Close()
+
+	valuations := []*entity.ItemValuation{}
+	for rows.Next() {
+		valuation, err := scanItemValuation(rows)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+		}
+		valuations = append(valuations, valuation)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+	}
+
+	return valuations, nil
+}
+
+func (r *ItemValuationRepository) Create(ctx context.Context, valuation *entity.ItemValuation) (*entity.ItemValuation, error) {
+	query := `
+        INSERT INTO item_valuations (item_id, previous_value, value, created_at)
+        VALUES (?, ?, ?, ?)
+    `
+
+	id, err := insertReturningID(ctx, r, r.Dialect(), query, valuation.ItemID, valuation.PreviousValue, valuation.Value, valuation.CreatedAt)
+	if err != nil {
+		if errors.Is(err, ErrForeignKeyViolation) {
+			return nil, domainErrors.ErrItemNotFound
+		}
+		return nil, fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+	}
+
+	query = `
+        SELECT ` + itemValuationColumns + `
+        FROM item_valuations
+        WHERE id = ?
+    `
+
+	created, err := scanItemValuation(r.QueryRow(ctx, query, id))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+	}
+
+	return created, nil
+}
+
+func scanItemValuation(scanner interface {
+	Scan(dest ...interface{}) error
+}) (*entity.ItemValuation, error) {
+	var valuation entity.ItemValuation
+
+	err := scanner.Scan(
+		&valuation.ID,
+		&valuation.ItemID,
+		&valuation.PreviousValue,
+		&valuation.Value,
+		&valuation.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &valuation, nil
+}
@@ -0,0 +1,86 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"Aicon-assignment/internal/domain/entity"
+	domainErrors "Aicon-assignment/internal/domain/errors"
+)
+
+type ItemViewRepository struct {
+	SqlHandler
+}
+
+func (r *ItemViewRepository) Save(ctx context.Context, view *entity.ItemView, keep int) error {
+	query := `
+        INSERT INTO item_views (viewer, item_id, viewed_at)
+        VALUES (?, ?, ?)
+        ON CONFLICT (viewer, item_id) DO UPDATE SET viewed_at = excluded.viewed_at
+    `
+	if r.Dialect() == DialectMySQL {
+		query = `
+        INSERT INTO item_views (viewer, item_id, viewed_at)
+        VALUES (?, ?, ?)
+        ON DUPLICATE KEY UPDATE viewed_at = VALUES(viewed_at)
+    `
+	}
+
+	if _, err := r.Execute(ctx, query, view.Viewer, view.ItemID, view.ViewedAt); err != nil {
+		if errors.Is(err, ErrForeignKeyViolation) {
+			return domainErrors.ErrItemNotFound
+		}
+		return fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+	}
+
+	// 新しい方から keep 件を残す（MySQLは IN の中の LIMIT を受け付けないため、派生テーブルを挟む）
+	trim := `
+        DELETE FROM item_views
+        WHERE viewer = ? AND item_id NOT IN (
+            SELECT item_id FROM (
+                SELECT item_id
+                FROM item_views
+                WHERE viewer = ?
+                ORDER BY viewed_at DESC, item_id DESC
+                LIMIT ?
+            ) recent
+        )
+    `
+	if _, err := r.Execute(ctx, trim, view.Viewer, view.Viewer, keep); err != nil {
+		return fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+	}
+
+	return nil
+}
+
+func (r *ItemViewRepository) FindRecentByViewer(ctx context.Context, viewer string, limit int) ([]*entity.ItemView, error) {
+	query := `
+        SELECT viewer, item_id, viewed_at
+        FROM item_views
+        WHERE viewer = ?
+        ORDER BY viewed_at DESC, item_id DESC
+        LIMIT ?
+    `
+
+	rows, err := r.Query(ctx, query, viewer, limit)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+	}
+	defer rows.Close()
+
+	views := []*entity.ItemView{}
+	for rows.Next() {
+		var view entity.ItemView
+		if err := rows.Scan(&view.Viewer, &view.ItemID, &view.ViewedAt); err != nil {
+			return nil, fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+		}
+		views = append(views, &view)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+	}
+
+	return views, nil
+}
@@ -3,27 +3,38 @@ package database
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"sort"
+	"strings"
 	"time"
 
 	"Aicon-assignment/internal/domain/entity"
 	domainErrors "Aicon-assignment/internal/domain/errors"
 )
 
+// アイテム取得時のSELECT句（scanItemの順序と一致させる）
+const itemColumns = `id, name, category, brand, purchase_price, current_value, purchase_date, item_condition, serial_number, attributes, location_id, archived_at, created_at, updated_at, version,
+               EXISTS(SELECT 1 FROM loans WHERE loans.item_id = items.id AND loans.returned_at IS NULL) AS on_loan`
+
 type ItemRepository struct {
 	SqlHandler
 }
 
-func (r *ItemRepository) FindAll(ctx context.Context) ([]*entity.Item, error) {
+func (r *ItemRepository) FindAll(ctx context.Context, filter entity.ItemFilter) ([]*entity.Item, error) {
+	where, args := itemFilterCondition(r.Dialect(), filter)
+
 	query := `
-        SELECT id, name, category, brand, purchase_price, purchase_date, created_at, updated_at
+        SELECT ` + itemColumns + `
         FROM items
+        WHERE ` + where + `
         ORDER BY created_at DESC
     `
 
-	rows, err := r.Query(ctx, query)
+	rows, err := r.Query(ctx, query, args...)
 	if err != nil {
-		return nil, fmt.Errorf("%w: %s", domainErrors.ErrDatabaseError, err.Error())
+		return nil, fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
 	}
 	defer rows.Close()
 
@@ -31,150 +42,681 @@ func (r *ItemRepository) FindAll(ctx context.Context) ([]*entity.Item, error) {
 	for rows.Next() {
 		item, err := scanItem(rows)
 		if err != nil {
-			return nil, fmt.Errorf("%w: %s", domainErrors.ErrDatabaseError, err.Error())
+			return nil, fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
 		}
 		items = append(items, item)
 	}
 
 	if err = rows.Err(); err != nil {
-		return nil, fmt.Errorf("%w: %s", domainErrors.ErrDatabaseError, err.Error())
+		return nil, fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
 	}
 
 	return items, nil
 }
 
+func (r *ItemRepository) Count(ctx context.Context, filter entity.ItemFilter) (int, error) {
+	where, args := itemFilterCondition(r.Dialect(), filter)
+
+	var count int
+	if err := r.QueryRow(ctx, `SELECT COUNT(*) FROM items WHERE `+where, args...).Scan(&count); err != nil {
+		return 0, fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+	}
+
+	return count, nil
+}
+
+// 利用量の上限の確認から登録までの間に他の登録が割り込まないよう、ロック用の行を取ってから数える
+// SQLiteはトランザクションの開始時に書き込みのロックを取るため、行のロックはない
+func (r *ItemRepository) CountLocked(ctx context.Context) (int, error) {
+	var name string
+	if err := r.QueryRow(ctx, `SELECT name FROM quota_locks WHERE name = 'items' `+forUpdateClause(r.Dialect())).Scan(&name); err != nil {
+		return 0, fmt.Errorf("%w: failed to lock item quota: %w", domainErrors.ErrDatabaseError, err)
+	}
+
+	return r.Count(ctx, entity.ItemFilter{IncludeArchived: true})
+}
+
+// 一覧の絞り込み条件をWHERE句にする（削除済みのアイテムは常に除く）
+func itemFilterCondition(dialect Dialect, filter entity.ItemFilter) (string, []interface{}) {
+	conditions := []string{"deleted_at IS NULL"}
+	var args []interface{}
+
+	if !filter.IncludeArchived {
+		conditions = append(conditions, "archived_at IS NULL")
+	}
+
+	if filter.LocationID != nil {
+		conditions = append(conditions, "location_id = ?")
+		args = append(args, *filter.LocationID)
+	}
+	if filter.Category != "" {
+		conditions = append(conditions, "category = ?")
+		args = append(args, filter.Category)
+	}
+	if filter.Brand != "" {
+		conditions = append(conditions, "brand = ?")
+		args = append(args, filter.Brand)
+	}
+	if filter.Condition != "" {
+		conditions = append(conditions, "item_condition = ?")
+		args = append(args, filter.Condition)
+	}
+	// お気に入りは閲覧者ごとに別のテーブルに持つ
+	if filter.Favorites {
+		conditions = append(conditions, "EXISTS(SELECT 1 FROM item_favorites WHERE item_favorites.item_id = items.id AND item_favorites.viewer = ?)")
+		args = append(args, filter.Viewer)
+	}
+	for _, keyword := range filter.Keywords {
+		conditions = append(conditions, "(LOWER(name) LIKE ? ESCAPE '!' OR LOWER(brand) LIKE ? ESCAPE '!' OR LOWER(category) LIKE ? ESCAPE '!' OR LOWER(serial_number) LIKE ? ESCAPE '!')")
+		pattern := "%" + escapeLike(strings.ToLower(keyword)) + "%"
+		args = append(args, pattern, pattern, pattern, pattern)
+	}
+
+	attributeKeys := make([]string, 0, len(filter.Attributes))
+	for key := range filter.Attributes {
+		attributeKeys = append(attributeKeys, key)
+	}
+	sort.Strings(attributeKeys)
+	for _, key := range attributeKeys {
+		condition, conditionArgs := attributeCondition(dialect, key, filter.Attributes[key])
+		conditions = append(conditions, condition)
+		args = append(args, conditionArgs...)
+	}
+
+	return strings.Join(conditions, " AND "), args
+}
+
+// LIKEのワイルドカードを文字として扱う（MySQLの文字列リテラルでバックスラッシュが特別な意味を持つため ! でエスケープする）
+var likeEscaper = strings.NewReplacer("!", "!!", "%", "!%", "_", "!_")
+
+func escapeLike(s string) string {
+	return likeEscaper.Replace(s)
+}
+
 func (r *ItemRepository) FindByID(ctx context.Context, id int64) (*entity.Item, error) {
+	return findItemByID(ctx, r, id)
+}
+
+func (r *ItemRepository) FindBySerialNumber(ctx context.Context, serialNumber string) (*entity.Item, error) {
 	query := `
-        SELECT id, name, category, brand, purchase_price, purchase_date, created_at, updated_at
+        SELECT ` + itemColumns + `
         FROM items
-        WHERE id = ?
+        WHERE serial_number = ? AND deleted_at IS NULL
     `
 
-	row := r.QueryRow(ctx, query, id)
+	row := r.QueryRow(ctx, query, serialNumber)
 
 	item, err := scanItem(row)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, domainErrors.ErrItemNotFound
 		}
-		return nil, fmt.Errorf("%w: %s", domainErrors.ErrDatabaseError, err.Error())
+		return nil, fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
 	}
 
 	return item, nil
 }
 
+// アイテムの登録とitem.createdイベントのアウトボックスへの記録を1つのトランザクションで行う
 func (r *ItemRepository) Create(ctx context.Context, item *entity.Item) (*entity.Item, error) {
+	var created *entity.Item
+	err := retryTx(ctx, r.SqlHandler, func() (err error) {
+		created, err = r.create(ctx, item)
+		return err
+	})
+	return created, err
+}
+
+func (r *ItemRepository) create(ctx context.Context, item *entity.Item) (created *entity.Item, err error) {
 	query := `
-        INSERT INTO items (name, category, brand, purchase_price, purchase_date)
-        VALUES (?, ?, ?, ?, ?)
+        INSERT INTO items (name, category, brand, purchase_price, purchase_date, item_condition, serial_number, attributes)
+        VALUES (?, ?, ?, ?, ?, ?, NULLIF(?, ''), ?)
     `
 
-	result, err := r.Execute(ctx, query,
+	attributes, err := marshalAttributes(item.Attributes)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+	}
+
+	tx, err := r.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to begin transaction: %w", domainErrors.ErrDatabaseError, err)
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	id, err := insertReturningID(ctx, tx, r.Dialect(), query,
 		item.Name,
 		item.Category,
 		item.Brand,
 		item.PurchasePrice,
 		item.PurchaseDate,
+		item.Condition,
+		item.SerialNumber,
+		attributes,
 	)
 	if err != nil {
-		return nil, fmt.Errorf("%w: %s", domainErrors.ErrDatabaseError, err.Error())
+		// 使用済みの確認と登録の間に同じシリアル番号が登録された場合
+		if errors.Is(err, ErrUniqueViolation) {
+			return nil, domainErrors.ErrDuplicateSerial
+		}
+		return nil, fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
 	}
 
-	id, err := result.LastInsertId()
+	created, err = findItemByID(ctx, tx, id)
 	if err != nil {
-		return nil, fmt.Errorf("%w: failed to get last insert id: %s", domainErrors.ErrDatabaseError, err.Error())
+		return nil, err
+	}
+
+	if err = adjustItemSummary(ctx, tx, r.Dialect(), created, 1); err != nil {
+		return nil, fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
 	}
 
-	return r.FindByID(ctx, id)
+	if err = insertOutboxMessage(ctx, tx, entity.EventItemCreated, created); err != nil {
+		return nil, fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf("%w: failed to commit transaction: %w", domainErrors.ErrDatabaseError, err)
+	}
+
+	return created, nil
 }
 
+// アイテムの更新とitem.updatedイベントのアウトボックスへの記録を1つのトランザクションで行う
+// 読んだ後に他のリクエストが更新していた場合は上書きせずに ErrVersionConflict を返す
 func (r *ItemRepository) Update(ctx context.Context, item *entity.Item) error {
+	return retryTx(ctx, r.SqlHandler, func() error {
+		return r.update(ctx, item)
+	})
+}
+
+func (r *ItemRepository) update(ctx context.Context, item *entity.Item) (err error) {
 	query := `
         UPDATE items 
-        SET name = ?, category = ?, brand = ?, purchase_price = ?, purchase_date = ?, updated_at = ?
-        WHERE id = ?
+        SET name = ?, category = ?, brand = ?, purchase_price = ?, current_value = ?, purchase_date = ?, item_condition = ?, serial_number = NULLIF(?, ''), attributes = ?, location_id = ?, archived_at = ?, updated_at = ?, version = version + 1
+        WHERE id = ? AND version = ? AND deleted_at IS NULL
     `
 
-	result, err := r.Execute(ctx, query,
+	attributes, err := marshalAttributes(item.Attributes)
+	if err != nil {
+		return fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+	}
+
+	tx, err := r.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("%w: failed to begin transaction: %w", domainErrors.ErrDatabaseError, err)
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	// 集計の付け替えのために更新前の状態をロックして読む
+	previous, err := lockItemByID(ctx, tx, r.Dialect(), item.ID)
+	if err != nil {
+		return err
+	}
+
+	result, err := tx.Execute(ctx, query,
 		item.Name,
 		item.Category,
 		item.Brand,
 		item.PurchasePrice,
+		item.CurrentValue,
 		item.PurchaseDate,
+		item.Condition,
+		item.SerialNumber,
+		attributes,
+		item.LocationID,
+		item.ArchivedAt,
 		item.UpdatedAt,
 		item.ID,
+		item.Version,
 	)
 	if err != nil {
-		return fmt.Errorf("%w: %s", domainErrors.ErrDatabaseError, err.Error())
+		if errors.Is(err, ErrUniqueViolation) {
+			return domainErrors.ErrDuplicateSerial
+		}
+		return fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
 	}
 
 	rowsAffected, err := result.RowsAffected()
 	if err != nil {
-		return fmt.Errorf("%w: failed to get rows affected: %s", domainErrors.ErrDatabaseError, err.Error())
+		return fmt.Errorf("%w: failed to get rows affected: %w", domainErrors.ErrDatabaseError, err)
 	}
 
+	// 行はロックして読んだため、更新できないのは版が違う場合だけ
 	if rowsAffected == 0 {
-		return domainErrors.ErrItemNotFound
+		err = domainErrors.ErrVersionConflict
+		return err
+	}
+
+	updated, err := findItemByID(ctx, tx, item.ID)
+	if err != nil {
+		return err
+	}
+
+	if previous.Category != updated.Category || previous.Condition != updated.Condition || previous.IsArchived() != updated.IsArchived() {
+		if err = adjustItemSummary(ctx, tx, r.Dialect(), previous, -1); err != nil {
+			return fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+		}
+		if err = adjustItemSummary(ctx, tx, r.Dialect(), updated, 1); err != nil {
+			return fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+		}
+	}
+
+	if err = insertOutboxMessage(ctx, tx, entity.EventItemUpdated, updated); err != nil {
+		return fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("%w: failed to commit transaction: %w", domainErrors.ErrDatabaseError, err)
 	}
 
+	item.Version = updated.Version
 	return nil
 }
 
+// アイテムの削除とitem.deletedイベント（削除前のアイテム）のアウトボックスへの記録を1つのトランザクションで行う
 func (r *ItemRepository) Delete(ctx context.Context, id int64) error {
-	query := `DELETE FROM items WHERE id = ?`
+	return retryTx(ctx, r.SqlHandler, func() error {
+		return r.delete(ctx, id)
+	})
+}
+
+func (r *ItemRepository) delete(ctx context.Context, id int64) (err error) {
+	tx, err := r.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("%w: failed to begin transaction: %w", domainErrors.ErrDatabaseError, err)
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	deleted, err := lockItemByID(ctx, tx, r.Dialect(), id)
+	if err != nil {
+		return err
+	}
+
+	if _, err = tx.Execute(ctx, `DELETE FROM items WHERE id = ?`, id); err != nil {
+		return fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+	}
+
+	if err = adjustItemSummary(ctx, tx, r.Dialect(), deleted, -1); err != nil {
+		return fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+	}
+
+	if err = insertOutboxMessage(ctx, tx, entity.EventItemDeleted, deleted); err != nil {
+		return fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("%w: failed to commit transaction: %w", domainErrors.ErrDatabaseError, err)
+	}
+
+	return nil
+}
+
+// 重複アイテムの履歴を残すアイテムへ付け替え、重複アイテムを論理削除する
+func (r *ItemRepository) Merge(ctx context.Context, survivorID int64, duplicateIDs []int64) error {
+	return retryTx(ctx, r.SqlHandler, func() error {
+		return r.merge(ctx, survivorID, duplicateIDs)
+	})
+}
+
+func (r *ItemRepository) merge(ctx context.Context, survivorID int64, duplicateIDs []int64) (err error) {
+	tx, err := r.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("%w: failed to begin transaction: %w", domainErrors.ErrDatabaseError, err)
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	// 削除前の重複アイテムを集計とイベント用に取っておく
+	duplicates := make([]*entity.Item, 0, len(duplicateIDs))
+	for _, id := range duplicateIDs {
+		duplicate, err := lockItemByID(ctx, tx, r.Dialect(), id)
+		if err != nil {
+			return err
+		}
+		duplicates = append(duplicates, duplicate)
+	}
+
+	placeholders := strings.TrimSuffix(strings.Repeat("?, ", len(duplicateIDs)), ", ")
+	args := []interface{}{survivorID}
+	for _, id := range duplicateIDs {
+		args = append(args, id)
+	}
+
+	statements := []string{
+		`UPDATE loans SET item_id = ? WHERE item_id IN (` + placeholders + `)`,
+		`UPDATE item_location_history SET item_id = ? WHERE item_id IN (` + placeholders + `)`,
+		`UPDATE item_comments SET item_id = ? WHERE item_id IN (` + placeholders + `)`,
+		`UPDATE item_valuations SET item_id = ? WHERE item_id IN (` + placeholders + `)`,
+		`UPDATE item_sales SET item_id = ? WHERE item_id IN (` + placeholders + `)`,
+		`UPDATE item_relations SET item_id = ? WHERE item_id IN (` + placeholders + `)`,
+		`UPDATE item_relations SET related_item_id = ? WHERE related_item_id IN (` + placeholders + `)`,
+	}
+	for _, statement := range statements {
+		if _, err = tx.Execute(ctx, statement, args...); err != nil {
+			return fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+		}
+	}
+
+	// 保険は1つのアイテムに1件のため、残すアイテムの設定だけを残す
+	if _, err = tx.Execute(ctx, `DELETE FROM item_insurances WHERE item_id IN (`+placeholders+`)`, args[1:]...); err != nil {
+		return fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+	}
+
+	// 統合したアイテム同士の関連は、付け替えると自分自身との関連になるため消す
+	if _, err = tx.Execute(ctx, `DELETE FROM item_relations WHERE item_id = related_item_id`); err != nil {
+		return fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+	}
+
+	// シリアル番号の一意制約に引っかからないよう、論理削除するアイテムのシリアル番号は解放する
+	deleteQuery := `
+        UPDATE items
+        SET deleted_at = ?, serial_number = NULL
+        WHERE id IN (` + placeholders + `) AND deleted_at IS NULL
+    `
+	deleteArgs := append([]interface{}{time.Now()}, args[1:]...)
 
-	result, err := r.Execute(ctx, query, id)
+	result, err := tx.Execute(ctx, deleteQuery, deleteArgs...)
 	if err != nil {
-		return fmt.Errorf("%w: %s", domainErrors.ErrDatabaseError, err.Error())
+		return fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
 	}
 
 	rowsAffected, err := result.RowsAffected()
 	if err != nil {
-		return fmt.Errorf("%w: failed to get rows affected: %s", domainErrors.ErrDatabaseError, err.Error())
+		return fmt.Errorf("%w: failed to get rows affected: %w", domainErrors.ErrDatabaseError, err)
 	}
 
-	if rowsAffected == 0 {
-		return domainErrors.ErrItemNotFound
+	if rowsAffected != int64(len(duplicateIDs)) {
+		err = domainErrors.ErrItemNotFound
+		return err
+	}
+
+	for _, duplicate := range duplicates {
+		if err = adjustItemSummary(ctx, tx, r.Dialect(), duplicate, -1); err != nil {
+			return fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+		}
+		if err = insertOutboxMessage(ctx, tx, entity.EventItemDeleted, duplicate); err != nil {
+			return fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+		}
+	}
+
+	survivor, err := findItemByID(ctx, tx, survivorID)
+	if err != nil {
+		return err
+	}
+	if err = insertOutboxMessage(ctx, tx, entity.EventItemUpdated, survivor); err != nil {
+		return fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("%w: failed to commit transaction: %w", domainErrors.ErrDatabaseError, err)
 	}
 
 	return nil
 }
 
+// 統合で論理削除したアイテムを完全に削除する（履歴は統合先に付け替え済み）
+// 削除済みのアイテムは集計とイベントに含まれないため、どちらも更新しない
+func (r *ItemRepository) PurgeDeleted(ctx context.Context, before time.Time) (int, error) {
+	result, err := r.Execute(ctx, `DELETE FROM items WHERE deleted_at IS NOT NULL AND deleted_at < ?`, before)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+	}
+
+	return int(rowsAffected), nil
+}
+
+// カテゴリーが from のアイテムを全て to に付け替え、監査ログを1件だけ記録する
+// 件数が多くなりうるため、アイテムごとのitem.updatedイベントはアウトボックスに記録しない
+func (r *ItemRepository) ReassignCategory(ctx context.Context, from, to string) ([]int64, error) {
+	var ids []int64
+	err := retryTx(ctx, r.SqlHandler, func() (err error) {
+		ids, err = r.reassignCategory(ctx, from, to)
+		return err
+	})
+	return ids, err
+}
+
+func (r *ItemRepository) reassignCategory(ctx context.Context, from, to string) (ids []int64, err error) {
+	tx, err := r.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to begin transaction: %w", domainErrors.ErrDatabaseError, err)
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	// 付け替えるアイテムをロックして読み、集計から外すアーカイブ済みのアイテムを数えておく
+	rows, err := tx.Query(ctx, `
+        SELECT id, archived_at IS NOT NULL
+        FROM items
+        WHERE category = ? AND deleted_at IS NULL
+        ORDER BY id
+        `+forUpdateClause(r.Dialect()), from)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+	}
+	archived := 0
+	for rows.Next() {
+		var id int64
+		var isArchived bool
+		if err = rows.Scan(&id, &isArchived); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+		}
+		ids = append(ids, id)
+		if isArchived {
+			archived++
+		}
+	}
+	err = rows.Err()
+	rows.Close()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+	}
+
+	// 付け替えるアイテムがなければ監査ログも残さない
+	if len(ids) == 0 {
+		tx.Rollback()
+		return nil, nil
+	}
+
+	_, err = tx.Execute(ctx, `
+        UPDATE items
+        SET category = ?, updated_at = ?, version = version + 1
+        WHERE category = ? AND deleted_at IS NULL
+    `, to, time.Now(), from)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+	}
+
+	if moved := len(ids) - archived; moved > 0 {
+		if err = adjustSummaryCount(ctx, tx, r.Dialect(), summaryDimensionCategory, from, -moved); err != nil {
+			return nil, fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+		}
+		if err = adjustSummaryCount(ctx, tx, r.Dialect(), summaryDimensionCategory, to, moved); err != nil {
+			return nil, fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+		}
+	}
+
+	audit := entity.NewCategoryReassignedAudit(entity.CategoryReassignment{From: from, To: to, Affected: len(ids)})
+	if err = insertAuditEntry(ctx, tx, audit); err != nil {
+		return nil, fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf("%w: failed to commit transaction: %w", domainErrors.ErrDatabaseError, err)
+	}
+
+	return ids, nil
+}
+
+// 集計テーブルから読むため件数に関わらず一定の時間で返る
 func (r *ItemRepository) GetSummaryByCategory(ctx context.Context) (map[string]int, error) {
+	return r.getSummary(ctx, summaryDimensionCategory)
+}
+
+func (r *ItemRepository) FindBrands(ctx context.Context) ([]string, error) {
+	rows, err := r.Query(ctx, `SELECT DISTINCT brand FROM items WHERE deleted_at IS NULL ORDER BY brand`)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+	}
+	defer rows.Close()
+
+	var brands []string
+	for rows.Next() {
+		var brand string
+		if err := rows.Scan(&brand); err != nil {
+			return nil, fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+		}
+		brands = append(brands, brand)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+	}
+
+	return brands, nil
+}
+
+func (r *ItemRepository) Suggest(ctx context.Context, prefix string, limit int) ([]entity.ItemSuggestion, error) {
+	// 先頭か、半角・全角の空白の後に一致するもの
+	escaped := escapeLike(strings.ToLower(prefix))
+	patterns := []interface{}{escaped + "%", "% " + escaped + "%", "%　" + escaped + "%"}
+
+	var suggestions []entity.ItemSuggestion
+	for _, field := range []string{entity.SuggestionFieldBrand, entity.SuggestionFieldName} {
+		query := `
+            SELECT DISTINCT ` + field + `
+            FROM items
+            WHERE deleted_at IS NULL AND archived_at IS NULL
+              AND (LOWER(` + field + `) LIKE ? ESCAPE '!' OR LOWER(` + field + `) LIKE ? ESCAPE '!' OR LOWER(` + field + `) LIKE ? ESCAPE '!')
+            ORDER BY ` + field + `
+            LIMIT ?
+        `
+
+		rows, err := r.Query(ctx, query, append(patterns, limit)...)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+		}
+		for rows.Next() {
+			var value string
+			if err := rows.Scan(&value); err != nil {
+				rows.Close()
+				return nil, fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+			}
+			suggestions = append(suggestions, entity.ItemSuggestion{Value: value, Field: field})
+		}
+		err = rows.Err()
+		rows.Close()
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+		}
+	}
+
+	return suggestions, nil
+}
+
+func (r *ItemRepository) GetSummaryByCondition(ctx context.Context) (map[string]int, error) {
+	return r.getSummary(ctx, summaryDimensionCondition)
+}
+
+func (r *ItemRepository) getSummary(ctx context.Context, dimension string) (map[string]int, error) {
 	query := `
-        SELECT category, COUNT(*) as count
-        FROM items
-        GROUP BY category
+        SELECT dimension_value, item_count
+        FROM item_summary
+        WHERE dimension = ? AND item_count > 0
     `
 
-	rows, err := r.Query(ctx, query)
+	rows, err := r.Query(ctx, query, dimension)
 	if err != nil {
-		return nil, fmt.Errorf("%w: %s", domainErrors.ErrDatabaseError, err.Error())
+		return nil, fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
 	}
 	defer rows.Close()
 
 	summary := make(map[string]int)
 	for rows.Next() {
-		var category string
+		var value string
 		var count int
-		if err := rows.Scan(&category, &count); err != nil {
-			return nil, fmt.Errorf("%w: %s", domainErrors.ErrDatabaseError, err.Error())
+		if err := rows.Scan(&value, &count); err != nil {
+			return nil, fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
 		}
-		summary[category] = count
+		summary[value] = count
 	}
 
 	if err = rows.Err(); err != nil {
-		return nil, fmt.Errorf("%w: %s", domainErrors.ErrDatabaseError, err.Error())
+		return nil, fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
 	}
 
 	return summary, nil
 }
 
+// トランザクションの内外どちらからでも使えるようにQueryRowだけを要求する
+type rowQuerier interface {
+	QueryRow(ctx context.Context, statement string, args ...interface{}) Row
+}
+
+func findItemByID(ctx context.Context, q rowQuerier, id int64) (*entity.Item, error) {
+	return queryItemByID(ctx, q, id, "")
+}
+
+// 他のトランザクションが同じアイテムを変更しないよう行ロックを取って読む
+func lockItemByID(ctx context.Context, tx Tx, dialect Dialect, id int64) (*entity.Item, error) {
+	return queryItemByID(ctx, tx, id, forUpdateClause(dialect))
+}
+
+func queryItemByID(ctx context.Context, q rowQuerier, id int64, lock string) (*entity.Item, error) {
+	query := `
+        SELECT ` + itemColumns + `
+        FROM items
+        WHERE id = ? AND deleted_at IS NULL
+        ` + lock
+
+	row := q.QueryRow(ctx, query, id)
+
+	item, err := scanItem(row)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, domainErrors.ErrItemNotFound
+		}
+		return nil, fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+	}
+
+	return item, nil
+}
+
 func scanItem(scanner interface {
 	Scan(dest ...interface{}) error
 }) (*entity.Item, error) {
 	var item entity.Item
 	var purchaseDate string
+	var currentValue sql.NullInt64
+	var serialNumber sql.NullString
+	var attributes sql.NullString
+	var locationID sql.NullInt64
+	var archivedAt sql.NullTime
 	var createdAt, updatedAt time.Time
 
 	err := scanner.Scan(
@@ -183,24 +725,64 @@ func scanItem(scanner interface {
 		&item.Category,
 		&item.Brand,
 		&item.PurchasePrice,
+		&currentValue,
 		&purchaseDate,
+		&item.Condition,
+		&serialNumber,
+		&attributes,
+		&locationID,
+		&archivedAt,
 		&createdAt,
 		&updatedAt,
+		&item.Version,
+		&item.OnLoan,
 	)
 	if err != nil {
 		return nil, err
 	}
 
 	if purchaseDate != "" {
-		if parsedDate, err := time.Parse("2006-01-02", purchaseDate); err == nil {
-			item.PurchaseDate = parsedDate.Format("2006-01-02")
-		} else {
-			item.PurchaseDate = purchaseDate
+		item.PurchaseDate = formatDateColumn(purchaseDate)
+	}
+
+	if currentValue.Valid {
+		value := int(currentValue.Int64)
+		item.CurrentValue = &value
+	}
+
+	item.SerialNumber = serialNumber.String
+
+	item.Attributes = make(map[string]string)
+	if attributes.Valid && attributes.String != "" {
+		if err := json.Unmarshal([]byte(attributes.String), &item.Attributes); err != nil {
+			return nil, err
 		}
 	}
 
+	if locationID.Valid {
+		item.LocationID = &locationID.Int64
+	}
+
+	if archivedAt.Valid {
+		item.ArchivedAt = &archivedAt.Time
+	}
+
 	item.CreatedAt = createdAt
 	item.UpdatedAt = updatedAt
 
 	return &item, nil
 }
+
+// カスタム属性をJSONカラム用の文字列に変換する（属性が無ければNULL）
+func marshalAttributes(attributes map[string]string) (interface{}, error) {
+	if len(attributes) == 0 {
+		return nil, nil
+	}
+
+	b, err := json.Marshal(attributes)
+	if err != nil {
+		return nil, err
+	}
+
+	return string(b), nil
+}
@@ -0,0 +1,241 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"Aicon-assignment/internal/domain/entity"
+	domainErrors "Aicon-assignment/internal/domain/errors"
+)
+
+type JobRepository struct {
+	SqlHandler
+}
+
+const jobColumns = `id, type, payload, status, attempts, max_attempts, last_error, run_at, created_at, finished_at`
+
+func (r *JobRepository) Create(ctx context.Context, job *entity.Job) (*entity.Job, error) {
+	query := `
+        INSERT INTO jobs (type, payload, status, max_attempts, run_at)
+        VALUES (?, ?, ?, ?, ?)
+    `
+
+	id, err := insertReturningID(ctx, r, r.Dialect(), query,
+		job.Type,
+		job.Payload,
+		entity.JobPending,
+		job.MaxAttempts,
+		jobTime(job.RunAt),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+	}
+
+	return r.FindByID(ctx, id)
+}
+
+func (r *JobRepository) FindByID(ctx context.Context, id int64) (*entity.Job, error) {
+	query := `SELECT ` + jobColumns + ` FROM jobs WHERE id = ?`
+
+	job, err := scanJob(r.QueryRow(ctx, query, id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, domainErrors.ErrJobNotFound
+		}
+		return nil, fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+	}
+
+	return job, nil
+}
+
+func (r *JobRepository) FindByStatus(ctx context.Context, statuses []string, limit int) ([]*entity.Job, error) {
+	if len(statuses) == 0 {
+		return []*entity.Job{}, nil
+	}
+
+	placeholders := strings.TrimSuffix(strings.Repeat("?, ", len(statuses)), ", ")
+	query := `SELECT ` + jobColumns + ` FROM jobs WHERE status IN (` + placeholders + `) ORDER BY id DESC LIMIT ?`
+
+	args := make([]interface{}, 0, len(statuses)+1)
+	for _, status := range statuses {
+		args = append(args, status)
+	}
+	args = append(args, limit)
+
+	return r.findJobs(ctx, query, args...)
+}
+
+// 候補を読んでから1件ずつ状態を条件にして更新し、他のワーカーが先に取ったものは除く
+// 行ロックの待ちを作らないため、どの方言でも同じ文で動く
+func (r *JobRepository) Claim(ctx context.Context, now, lockedUntil time.Time, limit int) ([]*entity.Job, error) {
+	const due = `(status = ? AND run_at <= ?) OR (status = ? AND locked_until < ?)`
+	now = jobTime(now)
+
+	candidates, err := r.findJobs(ctx,
+		`SELECT `+jobColumns+` FROM jobs WHERE `+due+` ORDER BY run_at ASC, id ASC LIMIT ?`,
+		entity.JobPending, now, entity.JobRunning, now, limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	claimed := make([]*entity.Job, 0, len(candidates))
+	for _, job := range candidates {
+		result, err := r.Execute(ctx,
+			`UPDATE jobs SET status = ?, attempts = attempts + 1, locked_until = ? WHERE id = ? AND (`+due+`)`,
+			entity.JobRunning, jobTime(lockedUntil), job.ID, entity.JobPending, now, entity.JobRunning, now,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+		}
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+		}
+		if rowsAffected == 0 {
+			continue
+		}
+
+		job.Status = entity.JobRunning
+		job.Attempts++
+		claimed = append(claimed, job)
+	}
+
+	return claimed, nil
+}
+
+func (r *JobRepository) Complete(ctx context.Context, id int64) error {
+	query := `
+        UPDATE jobs
+        SET status = ?, locked_until = NULL, finished_at = ?
+        WHERE id = ?
+    `
+
+	if _, err := r.Execute(ctx, query, entity.JobSucceeded, jobTime(time.Now()), id); err != nil {
+		return fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+	}
+
+	return nil
+}
+
+func (r *JobRepository) Fail(ctx context.Context, id int64, reason string, retryAt *time.Time) error {
+	var err error
+	if retryAt != nil {
+		_, err = r.Execute(ctx,
+			`UPDATE jobs SET status = ?, last_error = ?, run_at = ?, locked_until = NULL WHERE id = ?`,
+			entity.JobPending, reason, jobTime(*retryAt), id,
+		)
+	} else {
+		_, err = r.Execute(ctx,
+			`UPDATE jobs SET status = ?, last_error = ?, locked_until = NULL, finished_at = ? WHERE id = ?`,
+			entity.JobFailed, reason, jobTime(time.Now()), id,
+		)
+	}
+	if err != nil {
+		return fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+	}
+
+	return nil
+}
+
+func (r *JobRepository) Retry(ctx context.Context, id int64) (*entity.Job, error) {
+	query := `
+        UPDATE jobs
+        SET status = ?, attempts = 0, run_at = ?, finished_at = NULL
+        WHERE id = ? AND status = ?
+    `
+
+	result, err := r.Execute(ctx, query, entity.JobPending, jobTime(time.Now()), id, entity.JobFailed)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+	}
+	if rowsAffected == 0 {
+		if _, err := r.FindByID(ctx, id); err != nil {
+			return nil, err
+		}
+		return nil, domainErrors.ErrJobNotFailed
+	}
+
+	return r.FindByID(ctx, id)
+}
+
+func (r *JobRepository) DeleteSucceeded(ctx context.Context, before time.Time) (int, error) {
+	query := `DELETE FROM jobs WHERE status = ? AND finished_at < ?`
+
+	result, err := r.Execute(ctx, query, entity.JobSucceeded, jobTime(before))
+	if err != nil {
+		return 0, fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+	}
+
+	return int(rowsAffected), nil
+}
+
+func (r *JobRepository) findJobs(ctx context.Context, query string, args ...interface{}) ([]*entity.Job, error) {
+	rows, err := r.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+	}
+	defer rows.Close()
+
+	jobs := []*entity.Job{}
+	for rows.Next() {
+		job, err := scanJob(rows)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+		}
+		jobs = append(jobs, job)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+	}
+
+	return jobs, nil
+}
+
+// SQLiteは時刻を文字列で比べるため、書き込む時刻の形式（UTC・秒単位）をそろえる
+func jobTime(t time.Time) time.Time {
+	return t.UTC().Truncate(time.Second)
+}
+
+func scanJob(scanner interface {
+	Scan(dest ...interface{}) error
+}) (*entity.Job, error) {
+	var job entity.Job
+	var finishedAt sql.NullTime
+
+	err := scanner.Scan(
+		&job.ID,
+		&job.Type,
+		&job.Payload,
+		&job.Status,
+		&job.Attempts,
+		&job.MaxAttempts,
+		&job.LastError,
+		&job.RunAt,
+		&job.CreatedAt,
+		&finishedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if finishedAt.Valid {
+		job.FinishedAt = &finishedAt.Time
+	}
+
+	return &job, nil
+}
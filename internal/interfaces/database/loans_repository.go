@@ -0,0 +1,180 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"Aicon-assignment/internal/domain/entity"
+	domainErrors "Aicon-assignment/internal/domain/errors"
+)
+
+type LoanRepository struct {
+	SqlHandler
+}
+
+func (r *LoanRepository) FindByID(ctx context.Context, id int64) (*entity.Loan, error) {
+	query := `
+        SELECT id, item_id, borrower, due_date, loaned_at, returned_at
+        FROM loans
+        WHERE id = ?
+    `
+
+	row := r.QueryRow(ctx, query, id)
+
+	loan, err := scanLoan(row)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, domainErrors.ErrLoanNotFound
+		}
+		return nil, fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+	}
+
+	return loan, nil
+}
+
+func (r *LoanRepository) FindActiveByItemID(ctx context.Context, itemID int64) (*entity.Loan, error) {
+	query := `
+        SELECT id, item_id, borrower, due_date, loaned_at, returned_at
+        FROM loans
+        WHERE item_id = ? AND returned_at IS NULL
+        ORDER BY loaned_at DESC
+        LIMIT 1
+    `
+
+	row := r.QueryRow(ctx, query, itemID)
+
+	loan, err := scanLoan(row)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, domainErrors.ErrLoanNotFound
+		}
+		return nil, fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+	}
+
+	return loan, nil
+}
+
+func (r *LoanRepository) FindOverdue(ctx context.Context, today string) ([]*entity.Loan, error) {
+	query := `
+        SELECT id, item_id, borrower, due_date, loaned_at, returned_at
+        FROM loans
+        WHERE returned_at IS NULL AND due_date < ?
+        ORDER BY due_date ASC
+    `
+
+	rows, err := r.Query(ctx, query, today)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+	}
+	defer rows.Close()
+
+	var loans []*entity.Loan
+	for rows.Next() {
+		loan, err := scanLoan(rows)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+		}
+		loans = append(loans, loan)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+	}
+
+	return loans, nil
+}
+
+func (r *LoanRepository) Create(ctx context.Context, loan *entity.Loan) (*entity.Loan, error) {
+	query := `
+        INSERT INTO loans (item_id, borrower, due_date, loaned_at)
+        VALUES (?, ?, ?, ?)
+    `
+
+	id, err := insertReturningID(ctx, r, r.Dialect(), query,
+		loan.ItemID,
+		loan.Borrower,
+		loan.DueDate,
+		loan.LoanedAt,
+	)
+	if err != nil {
+		// 確認後にアイテムが削除された場合
+		if errors.Is(err, ErrForeignKeyViolation) {
+			return nil, domainErrors.ErrItemNotFound
+		}
+		return nil, fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+	}
+
+	if err := touchItem(ctx, r, loan.ItemID, loan.LoanedAt); err != nil {
+		return nil, err
+	}
+
+	return r.FindByID(ctx, id)
+}
+
+func (r *LoanRepository) Update(ctx context.Context, loan *entity.Loan) error {
+	query := `
+        UPDATE loans
+        SET borrower = ?, due_date = ?, returned_at = ?
+        WHERE id = ?
+    `
+
+	result, err := r.Execute(ctx, query,
+		loan.Borrower,
+		loan.DueDate,
+		loan.ReturnedAt,
+		loan.ID,
+	)
+	if err != nil {
+		return fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%w: failed to get rows affected: %w", domainErrors.ErrDatabaseError, err)
+	}
+
+	if rowsAffected == 0 {
+		return domainErrors.ErrLoanNotFound
+	}
+
+	return touchItem(ctx, r, loan.ItemID, time.Now())
+}
+
+// 貸出状況（on_loan）が変わったアイテムの updated_at を進める（Last-Modified に反映するため）
+func touchItem(ctx context.Context, q execQuerier, itemID int64, at time.Time) error {
+	if _, err := q.Execute(ctx, `UPDATE items SET updated_at = ? WHERE id = ?`, at, itemID); err != nil {
+		return fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+	}
+	return nil
+}
+
+func scanLoan(scanner interface {
+	Scan(dest ...interface{}) error
+}) (*entity.Loan, error) {
+	var loan entity.Loan
+	var dueDate string
+	var returnedAt sql.NullTime
+
+	err := scanner.Scan(
+		&loan.ID,
+		&loan.ItemID,
+		&loan.Borrower,
+		&dueDate,
+		&loan.LoanedAt,
+		&returnedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	loan.DueDate = formatDateColumn(dueDate)
+
+	if returnedAt.Valid {
+		loan.ReturnedAt = &returnedAt.Time
+	}
+
+	return &loan, nil
+}
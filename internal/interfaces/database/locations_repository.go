@@ -0,0 +1,219 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"Aicon-assignment/internal/domain/entity"
+	domainErrors "Aicon-assignment/internal/domain/errors"
+)
+
+type LocationRepository struct {
+	SqlHandler
+}
+
+func (r *LocationRepository) FindAll(ctx context.Context) ([]*entity.Location, error) {
+	query := `
+        SELECT id, name, description, created_at, updated_at
+        FROM locations
+        ORDER BY name ASC
+    `
+
+	rows, err := r.Query(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+	}
+	defer rows.Close()
+
+	var locations []*entity.Location
+	for rows.Next() {
+		location, err := scanLocation(rows)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+		}
+		locations = append(locations, location)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+	}
+
+	return locations, nil
+}
+
+func (r *LocationRepository) FindByID(ctx context.Context, id int64) (*entity.Location, error) {
+	query := `
+        SELECT id, name, description, created_at, updated_at
+        FROM locations
+        WHERE id = ?
+    `
+
+	row := r.QueryRow(ctx, query, id)
+
+	location, err := scanLocation(row)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, domainErrors.ErrLocationNotFound
+		}
+		return nil, fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+	}
+
+	return location, nil
+}
+
+func (r *LocationRepository) Create(ctx context.Context, location *entity.Location) (*entity.Location, error) {
+	query := `
+        INSERT INTO locations (name, description)
+        VALUES (?, ?)
+    `
+
+	id, err := insertReturningID(ctx, r, r.Dialect(), query,
+		location.Name,
+		location.Description,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+	}
+
+	return r.FindByID(ctx, id)
+}
+
+func (r *LocationRepository) Update(ctx context.Context, location *entity.Location) error {
+	query := `
+        UPDATE locations
+        SET name = ?, description = ?, updated_at = ?
+        WHERE id = ?
+    `
+
+	result, err := r.Execute(ctx, query,
+		location.Name,
+		location.Description,
+		location.UpdatedAt,
+		location.ID,
+	)
+	if err != nil {
+		return fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%w: failed to get rows affected: %w", domainErrors.ErrDatabaseError, err)
+	}
+
+	if rowsAffected == 0 {
+		return domainErrors.ErrLocationNotFound
+	}
+
+	return nil
+}
+
+func (r *LocationRepository) Delete(ctx context.Context, id int64) error {
+	query := `DELETE FROM locations WHERE id = ?`
+
+	result, err := r.Execute(ctx, query, id)
+	if err != nil {
+		return fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%w: failed to get rows affected: %w", domainErrors.ErrDatabaseError, err)
+	}
+
+	if rowsAffected == 0 {
+		return domainErrors.ErrLocationNotFound
+	}
+
+	return nil
+}
+
+func (r *LocationRepository) CreateMove(ctx context.Context, move *entity.LocationMove) (*entity.LocationMove, error) {
+	query := `
+        INSERT INTO item_location_history (item_id, from_location_id, to_location_id, moved_at)
+        VALUES (?, ?, ?, ?)
+    `
+
+	id, err := insertReturningID(ctx, r, r.Dialect(), query,
+		move.ItemID,
+		move.FromLocationID,
+		move.ToLocationID,
+		move.MovedAt,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+	}
+
+	move.ID = id
+
+	return move, nil
+}
+
+func (r *LocationRepository) FindMovesByItemID(ctx context.Context, itemID int64) ([]*entity.LocationMove, error) {
+	query := `
+        SELECT id, item_id, from_location_id, to_location_id, moved_at
+        FROM item_location_history
+        WHERE item_id = ?
+        ORDER BY moved_at DESC, id DESC
+    `
+
+	rows, err := r.Query(ctx, query, itemID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+	}
+	defer rows.Close()
+
+	var moves []*entity.LocationMove
+	for rows.Next() {
+		move, err := scanLocationMove(rows)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+		}
+		moves = append(moves, move)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+	}
+
+	return moves, nil
+}
+
+func scanLocation(scanner interface {
+	Scan(dest ...interface{}) error
+}) (*entity.Location, error) {
+	var location entity.Location
+
+	err := scanner.Scan(
+		&location.ID,
+		&location.Name,
+		&location.Description,
+		&location.CreatedAt,
+		&location.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &location, nil
+}
+
+func scanLocationMove(scanner interface {
+	Scan(dest ...interface{}) error
+}) (*entity.LocationMove, error) {
+	var move entity.LocationMove
+	var fromLocationID, toLocationID sql.NullInt64
+
+	if err := scanner.Scan(&move.ID, &move.ItemID, &fromLocationID, &toLocationID, &move.MovedAt); err != nil {
+		return nil, err
+	}
+
+	if fromLocationID.Valid {
+		move.FromLocationID = &fromLocationID.Int64
+	}
+	if toLocationID.Valid {
+		move.ToLocationID = &toLocationID.Int64
+	}
+
+	return &move, nil
+}
@@ -0,0 +1,62 @@
+package database
+
+import (
+	"context"
+	"fmt"
+
+	"Aicon-assignment/internal/domain/entity"
+	domainErrors "Aicon-assignment/internal/domain/errors"
+)
+
+type NotificationPreferenceRepository struct {
+	SqlHandler
+}
+
+func (r *NotificationPreferenceRepository) FindAll(ctx context.Context) ([]*entity.NotificationPreference, error) {
+	query := `
+        SELECT type, name, enabled, updated_at
+        FROM notification_preferences
+        ORDER BY type ASC, name ASC
+    `
+
+	rows, err := r.Query(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+	}
+	defer rows.Close()
+
+	var prefs []*entity.NotificationPreference
+	for rows.Next() {
+		var pref entity.NotificationPreference
+		if err := rows.Scan(&pref.Type, &pref.Name, &pref.Enabled, &pref.UpdatedAt); err != nil {
+			return nil, fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+		}
+		prefs = append(prefs, &pref)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+	}
+
+	return prefs, nil
+}
+
+func (r *NotificationPreferenceRepository) Save(ctx context.Context, pref *entity.NotificationPreference) error {
+	query := `
+        INSERT INTO notification_preferences (type, name, enabled)
+        VALUES (?, ?, ?)
+        ON CONFLICT (type, name) DO UPDATE SET enabled = excluded.enabled, updated_at = CURRENT_TIMESTAMP
+    `
+	if r.Dialect() == DialectMySQL {
+		query = `
+        INSERT INTO notification_preferences (type, name, enabled)
+        VALUES (?, ?, ?)
+        ON DUPLICATE KEY UPDATE enabled = VALUES(enabled), updated_at = CURRENT_TIMESTAMP
+    `
+	}
+
+	if _, err := r.Execute(ctx, query, pref.Type, pref.Name, pref.Enabled); err != nil {
+		return fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+	}
+	return nil
+}
@@ -0,0 +1,132 @@
+package database
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"Aicon-assignment/internal/domain/entity"
+	domainErrors "Aicon-assignment/internal/domain/errors"
+)
+
+// 配信に失敗し続けるメッセージはこの回数で諦める（outboxテーブルに残るので手動で調査する）
+const maxOutboxAttempts = 5
+
+type OutboxRepository struct {
+	SqlHandler
+}
+
+const outboxColumns = `id, event_type, aggregate_id, payload, attempts, created_at`
+
+func (r *OutboxRepository) FindPending(ctx context.Context, limit int) ([]*entity.OutboxMessage, error) {
+	query := `
+        SELECT ` + outboxColumns + `
+        FROM outbox
+        WHERE published_at IS NULL AND attempts < ?
+        ORDER BY id ASC
+        LIMIT ?
+    `
+
+	return r.findMessages(ctx, query, maxOutboxAttempts, limit)
+}
+
+func (r *OutboxRepository) FindRecent(ctx context.Context, eventTypes []string, limit int) ([]*entity.OutboxMessage, error) {
+	if len(eventTypes) == 0 {
+		return []*entity.OutboxMessage{}, nil
+	}
+
+	placeholders := strings.TrimSuffix(strings.Repeat("?, ", len(eventTypes)), ", ")
+	query := `SELECT ` + outboxColumns + ` FROM outbox WHERE event_type IN (` + placeholders + `) ORDER BY id DESC LIMIT ?`
+
+	args := make([]interface{}, 0, len(eventTypes)+1)
+	for _, eventType := range eventTypes {
+		args = append(args, eventType)
+	}
+	args = append(args, limit)
+
+	return r.findMessages(ctx, query, args...)
+}
+
+func (r *OutboxRepository) FindPrevious(ctx context.Context, aggregateID, beforeID int64) (*entity.OutboxMessage, error) {
+	query := `
+        SELECT ` + outboxColumns + `
+        FROM outbox
+        WHERE aggregate_id = ? AND id < ?
+        ORDER BY id DESC
+        LIMIT 1
+    `
+
+	messages, err := r.findMessages(ctx, query, aggregateID, beforeID)
+	if err != nil || len(messages) == 0 {
+		return nil, err
+	}
+	return messages[0], nil
+}
+
+func (r *OutboxRepository) findMessages(ctx context.Context, query string, args ...interface{}) ([]*entity.OutboxMessage, error) {
+	rows, err := r.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+	}
+	defer rows.Close()
+
+	messages := []*entity.OutboxMessage{}
+	for rows.Next() {
+		var message entity.OutboxMessage
+		err := rows.Scan(
+			&message.ID,
+			&message.EventType,
+			&message.AggregateID,
+			&message.Payload,
+			&message.Attempts,
+			&message.CreatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+		}
+		messages = append(messages, &message)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+	}
+
+	return messages, nil
+}
+
+func (r *OutboxRepository) MarkPublished(ctx context.Context, id int64) error {
+	query := `UPDATE outbox SET published_at = CURRENT_TIMESTAMP, attempts = attempts + 1 WHERE id = ?`
+
+	if _, err := r.Execute(ctx, query, id); err != nil {
+		return fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+	}
+
+	return nil
+}
+
+func (r *OutboxRepository) MarkFailed(ctx context.Context, id int64, reason string) error {
+	query := `UPDATE outbox SET attempts = attempts + 1, last_error = ? WHERE id = ?`
+
+	if _, err := r.Execute(ctx, query, reason, id); err != nil {
+		return fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+	}
+
+	return nil
+}
+
+// アイテムの変更と同じトランザクションでイベントを記録する
+func insertOutboxMessage(ctx context.Context, tx Tx, eventType string, item *entity.Item) error {
+	payload, err := json.Marshal(item)
+	if err != nil {
+		return err
+	}
+
+	query := `
+        INSERT INTO outbox (event_type, aggregate_id, payload)
+        VALUES (?, ?, ?)
+    `
+
+	_, err = tx.Execute(ctx, query, eventType, item.ID, string(payload))
+	return err
+}
@@ -0,0 +1,139 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"Aicon-assignment/internal/domain/entity"
+	domainErrors "Aicon-assignment/internal/domain/errors"
+)
+
+const shareLinkColumns = `id, item_id, token_hash, fields, expires_at, revoked_at, created_at`
+
+type ShareLinkRepository struct {
+	SqlHandler
+}
+
+func (r *ShareLinkRepository) FindByItemID(ctx context.Context, itemID int64) ([]*entity.ShareLink, error) {
+	query := `
+        SELECT ` + shareLinkColumns + `
+        FROM share_links
+        WHERE item_id = ?
+        ORDER BY id DESC
+    `
+
+	rows, err := r.Query(ctx, query, itemID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+	}
+	defer rows.Close()
+
+	links := []*entity.ShareLink{}
+	for rows.Next() {
+		link, err := scanShareLink(rows)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+		}
+		links = append(links, link)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+	}
+
+	return links, nil
+}
+
+func (r *ShareLinkRepository) FindByTokenHash(ctx context.Context, tokenHash string) (*entity.ShareLink, error) {
+	query := `
+        SELECT ` + shareLinkColumns + `
+        FROM share_links
+        WHERE token_hash = ?
+    `
+
+	link, err := scanShareLink(r.QueryRow(ctx, query, tokenHash))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, domainErrors.ErrShareLinkNotFound
+		}
+		return nil, fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+	}
+
+	return link, nil
+}
+
+func (r *ShareLinkRepository) Create(ctx context.Context, link *entity.ShareLink) (*entity.ShareLink, error) {
+	fields, err := json.Marshal(link.Fields)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+	}
+
+	query := `
+        INSERT INTO share_links (item_id, token_hash, fields, expires_at)
+        VALUES (?, ?, ?, ?)
+    `
+
+	_, err = insertReturningID(ctx, r, r.Dialect(), query, link.ItemID, link.TokenHash, string(fields), link.ExpiresAt)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+	}
+
+	return r.FindByTokenHash(ctx, link.TokenHash)
+}
+
+func (r *ShareLinkRepository) Revoke(ctx context.Context, itemID, id int64) error {
+	query := `
+        UPDATE share_links
+        SET revoked_at = ?
+        WHERE id = ? AND item_id = ? AND revoked_at IS NULL
+    `
+
+	result, err := r.Execute(ctx, query, time.Now(), id, itemID)
+	if err != nil {
+		return fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%w: failed to get rows affected: %w", domainErrors.ErrDatabaseError, err)
+	}
+
+	if rowsAffected == 0 {
+		return domainErrors.ErrShareLinkNotFound
+	}
+
+	return nil
+}
+
+func scanShareLink(scanner interface {
+	Scan(dest ...interface{}) error
+}) (*entity.ShareLink, error) {
+	var link entity.ShareLink
+	var fields string
+	var revokedAt sql.NullTime
+
+	err := scanner.Scan(
+		&link.ID,
+		&link.ItemID,
+		&link.TokenHash,
+		&fields,
+		&link.ExpiresAt,
+		&revokedAt,
+		&link.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal([]byte(fields), &link.Fields); err != nil {
+		return nil, err
+	}
+	if revokedAt.Valid {
+		link.RevokedAt = &revokedAt.Time
+	}
+
+	return &link, nil
+}
@@ -6,7 +6,18 @@ type SqlHandler interface {
 	Execute(ctx context.Context, statement string, args ...interface{}) (Result, error)
 	Query(ctx context.Context, statement string, args ...interface{}) (Rows, error)
 	QueryRow(ctx context.Context, statement string, args ...interface{}) Row
+	Begin(ctx context.Context) (Tx, error)
 	Close() error
+	Dialect() Dialect
+}
+
+// 複数の更新を1つのトランザクションで実行するためのハンドラ
+type Tx interface {
+	Execute(ctx context.Context, statement string, args ...interface{}) (Result, error)
+	Query(ctx context.Context, statement string, args ...interface{}) (Rows, error)
+	QueryRow(ctx context.Context, statement string, args ...interface{}) Row
+	Commit() error
+	Rollback() error
 }
 
 type Result interface {
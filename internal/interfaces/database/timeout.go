@@ -0,0 +1,103 @@
+package database
+
+import (
+	"context"
+	"time"
+)
+
+// 1つの問い合わせごとに制限時間を設けるハンドラー
+// トランザクションは開始時のコンテキストで終わりまで続くため、開始には制限時間を付けず、中の文ごとに付ける
+type timeoutHandler struct {
+	SqlHandler
+	timeout time.Duration
+}
+
+// timeout が0以下の場合は handler をそのまま返す
+func WithQueryTimeout(handler SqlHandler, timeout time.Duration) SqlHandler {
+	if timeout <= 0 {
+		return handler
+	}
+	return &timeoutHandler{SqlHandler: handler, timeout: timeout}
+}
+
+func (h *timeoutHandler) Execute(ctx context.Context, statement string, args ...interface{}) (Result, error) {
+	ctx, cancel := context.WithTimeout(ctx, h.timeout)
+	defer cancel()
+	return h.SqlHandler.Execute(ctx, statement, args...)
+}
+
+func (h *timeoutHandler) Query(ctx context.Context, statement string, args ...interface{}) (Rows, error) {
+	return queryWithTimeout(ctx, h.timeout, h.SqlHandler, statement, args...)
+}
+
+func (h *timeoutHandler) QueryRow(ctx context.Context, statement string, args ...interface{}) Row {
+	return &timeoutRow{q: h.SqlHandler, ctx: ctx, timeout: h.timeout, statement: statement, args: args}
+}
+
+func (h *timeoutHandler) Begin(ctx context.Context) (Tx, error) {
+	tx, err := h.SqlHandler.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &timeoutTx{Tx: tx, timeout: h.timeout}, nil
+}
+
+type timeoutTx struct {
+	Tx
+	timeout time.Duration
+}
+
+func (t *timeoutTx) Execute(ctx context.Context, statement string, args ...interface{}) (Result, error) {
+	ctx, cancel := context.WithTimeout(ctx, t.timeout)
+	defer cancel()
+	return t.Tx.Execute(ctx, statement, args...)
+}
+
+func (t *timeoutTx) Query(ctx context.Context, statement string, args ...interface{}) (Rows, error) {
+	return queryWithTimeout(ctx, t.timeout, t.Tx, statement, args...)
+}
+
+func (t *timeoutTx) QueryRow(ctx context.Context, statement string, args ...interface{}) Row {
+	return &timeoutRow{q: t.Tx, ctx: ctx, timeout: t.timeout, statement: statement, args: args}
+}
+
+type querier interface {
+	Query(ctx context.Context, statement string, args ...interface{}) (Rows, error)
+	QueryRow(ctx context.Context, statement string, args ...interface{}) Row
+}
+
+// 行を読み終えるまでコンテキストを有効にしておき、Closeで解放する
+func queryWithTimeout(ctx context.Context, timeout time.Duration, q querier, statement string, args ...interface{}) (Rows, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	rows, err := q.Query(ctx, statement, args...)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	return &timeoutRows{Rows: rows, cancel: cancel}, nil
+}
+
+type timeoutRows struct {
+	Rows
+	cancel context.CancelFunc
+}
+
+func (r *timeoutRows) Close() error {
+	defer r.cancel()
+	return r.Rows.Close()
+}
+
+// エラーはScanまで分からないので、Scanの時点で制限時間付きで実行する
+type timeoutRow struct {
+	q         querier
+	ctx       context.Context
+	timeout   time.Duration
+	statement string
+	args      []interface{}
+}
+
+func (r *timeoutRow) Scan(dest ...interface{}) error {
+	ctx, cancel := context.WithTimeout(r.ctx, r.timeout)
+	defer cancel()
+	return r.q.QueryRow(ctx, r.statement, r.args...).Scan(dest...)
+}
@@ -0,0 +1,100 @@
+package database
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// deadlineHandler は受け取ったコンテキストを記録するハンドラー
+type deadlineHandler struct {
+	SqlHandler
+	contexts []context.Context
+}
+
+func (h *deadlineHandler) Execute(ctx context.Context, statement string, args ...interface{}) (Result, error) {
+	h.contexts = append(h.contexts, ctx)
+	return nil, nil
+}
+
+func (h *deadlineHandler) Query(ctx context.Context, statement string, args ...interface{}) (Rows, error) {
+	h.contexts = append(h.contexts, ctx)
+	return &emptyRows{}, nil
+}
+
+func (h *deadlineHandler) QueryRow(ctx context.Context, statement string, args ...interface{}) Row {
+	h.contexts = append(h.contexts, ctx)
+	return emptyRow{}
+}
+
+func (h *deadlineHandler) Begin(ctx context.Context) (Tx, error) {
+	h.contexts = append(h.contexts, ctx)
+	return &deadlineTx{handler: h}, nil
+}
+
+type deadlineTx struct {
+	Tx
+	handler *deadlineHandler
+}
+
+func (t *deadlineTx) Execute(ctx context.Context, statement string, args ...interface{}) (Result, error) {
+	return t.handler.Execute(ctx, statement, args...)
+}
+
+type emptyRows struct {
+	Rows
+}
+
+func (r *emptyRows) Close() error {
+	return nil
+}
+
+type emptyRow struct{}
+
+func (emptyRow) Scan(dest ...interface{}) error {
+	return nil
+}
+
+func TestWithQueryTimeout(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("正常系: 文ごとに制限時間を付け、トランザクションの開始には付けない", func(t *testing.T) {
+		inner := &deadlineHandler{}
+		handler := WithQueryTimeout(inner, time.Second)
+
+		_, err := handler.Execute(ctx, "DELETE FROM items WHERE id = ?", 1)
+		require.NoError(t, err)
+		require.NoError(t, handler.QueryRow(ctx, "SELECT COUNT(*) FROM items").Scan())
+		tx, err := handler.Begin(ctx)
+		require.NoError(t, err)
+		_, err = tx.Execute(ctx, "DELETE FROM items WHERE id = ?", 1)
+		require.NoError(t, err)
+
+		require.Len(t, inner.contexts, 4)
+		for i, want := range []bool{true, true, false, true} {
+			_, hasDeadline := inner.contexts[i].Deadline()
+			assert.Equal(t, want, hasDeadline, "call %d", i)
+		}
+	})
+
+	t.Run("正常系: 行を閉じるまでコンテキストを有効にしておく", func(t *testing.T) {
+		inner := &deadlineHandler{}
+		handler := WithQueryTimeout(inner, time.Second)
+
+		rows, err := handler.Query(ctx, "SELECT id FROM items")
+		require.NoError(t, err)
+		assert.NoError(t, inner.contexts[0].Err())
+
+		require.NoError(t, rows.Close())
+		assert.ErrorIs(t, inner.contexts[0].Err(), context.Canceled)
+	})
+
+	t.Run("正常系: 0の場合はそのまま返す", func(t *testing.T) {
+		inner := &deadlineHandler{}
+
+		assert.Same(t, inner, WithQueryTimeout(inner, 0))
+	})
+}
@@ -0,0 +1,114 @@
+package database
+
+import (
+	"context"
+	"fmt"
+
+	domainErrors "Aicon-assignment/internal/domain/errors"
+	"Aicon-assignment/internal/usecase"
+)
+
+// 複数のリポジトリの呼び出しを1つのトランザクションで実行する
+type Transactor struct {
+	SqlHandler
+}
+
+// デッドロックなどで取り消された場合は、fn を含めてトランザクションを最初からやり直す
+func (t *Transactor) WithTx(ctx context.Context, fn func(repos usecase.Repositories) error) error {
+	return retryTx(ctx, t.SqlHandler, func() error {
+		return t.withTx(ctx, fn)
+	})
+}
+
+func (t *Transactor) withTx(ctx context.Context, fn func(repos usecase.Repositories) error) (err error) {
+	tx, err := t.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+	}
+	defer func() {
+		if p := recover(); p != nil {
+			tx.Rollback()
+			panic(p)
+		}
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	handler := &txHandler{tx: tx, dialect: t.Dialect()}
+	if err = fn(usecase.Repositories{
+		Items:      &ItemRepository{SqlHandler: handler},
+		Loans:      &LoanRepository{SqlHandler: handler},
+		Locations:  &LocationRepository{SqlHandler: handler},
+		Valuations: &ItemValuationRepository{SqlHandler: handler},
+		Sales:      &ItemSaleRepository{SqlHandler: handler},
+	}); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+	}
+
+	return nil
+}
+
+// トランザクションを最初からやり直せるハンドラー
+// 文の途中からは再実行できないため、一時的なエラー（ErrTransient）で失敗したトランザクションは Begin からやり直す
+type TxRetrier interface {
+	RetryTx(ctx context.Context, fn func() error) error
+}
+
+// fn（Begin からコミットまで）を実行し、ハンドラーが対応していれば一時的なエラーの間はやり直す
+// 外側のトランザクションに合流している場合（txHandler）は、外側のトランザクションごとやり直すためここではやり直さない
+func retryTx(ctx context.Context, h SqlHandler, fn func() error) error {
+	if retrier, ok := h.(TxRetrier); ok {
+		return retrier.RetryTx(ctx, fn)
+	}
+	return fn()
+}
+
+// 開始済みのトランザクション上で動くハンドラー
+// リポジトリが自分で開始するトランザクションは外側のトランザクションに合流させ、コミットとロールバックは外側に任せる
+type txHandler struct {
+	tx      Tx
+	dialect Dialect
+}
+
+func (h *txHandler) Execute(ctx context.Context, statement string, args ...interface{}) (Result, error) {
+	return h.tx.Execute(ctx, statement, args...)
+}
+
+func (h *txHandler) Query(ctx context.Context, statement string, args ...interface{}) (Rows, error) {
+	return h.tx.Query(ctx, statement, args...)
+}
+
+func (h *txHandler) QueryRow(ctx context.Context, statement string, args ...interface{}) Row {
+	return h.tx.QueryRow(ctx, statement, args...)
+}
+
+func (h *txHandler) Begin(ctx context.Context) (Tx, error) {
+	return &joinedTx{Tx: h.tx}, nil
+}
+
+// 接続は外側のハンドラーが持っているので閉じない
+func (h *txHandler) Close() error {
+	return nil
+}
+
+func (h *txHandler) Dialect() Dialect {
+	return h.dialect
+}
+
+// 外側のトランザクションに合流したトランザクション（失敗はエラーとして外側に返り、外側でロールバックされる）
+type joinedTx struct {
+	Tx
+}
+
+func (t *joinedTx) Commit() error {
+	return nil
+}
+
+func (t *joinedTx) Rollback() error {
+	return nil
+}
@@ -0,0 +1,194 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	"Aicon-assignment/internal/domain/entity"
+	domainErrors "Aicon-assignment/internal/domain/errors"
+)
+
+type WebhookRepository struct {
+	SqlHandler
+}
+
+func (r *WebhookRepository) FindAll(ctx context.Context) ([]*entity.Webhook, error) {
+	query := `
+        SELECT id, url, secret, event_types, created_at
+        FROM webhooks
+        ORDER BY id ASC
+    `
+
+	rows, err := r.Query(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+	}
+	defer rows.Close()
+
+	var webhooks []*entity.Webhook
+	for rows.Next() {
+		webhook, err := scanWebhook(rows)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+		}
+		webhooks = append(webhooks, webhook)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+	}
+
+	return webhooks, nil
+}
+
+func (r *WebhookRepository) FindByID(ctx context.Context, id int64) (*entity.Webhook, error) {
+	query := `
+        SELECT id, url, secret, event_types, created_at
+        FROM webhooks
+        WHERE id = ?
+    `
+
+	row := r.QueryRow(ctx, query, id)
+
+	webhook, err := scanWebhook(row)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, domainErrors.ErrWebhookNotFound
+		}
+		return nil, fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+	}
+
+	return webhook, nil
+}
+
+func (r *WebhookRepository) Create(ctx context.Context, webhook *entity.Webhook) (*entity.Webhook, error) {
+	eventTypes, err := json.Marshal(webhook.EventTypes)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+	}
+
+	query := `
+        INSERT INTO webhooks (url, secret, event_types)
+        VALUES (?, ?, ?)
+    `
+
+	id, err := insertReturningID(ctx, r, r.Dialect(), query, webhook.URL, webhook.Secret, string(eventTypes))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+	}
+
+	return r.FindByID(ctx, id)
+}
+
+func (r *WebhookRepository) Delete(ctx context.Context, id int64) error {
+	// 送信ログは外部キーのON DELETE CASCADEで一緒に消える
+	query := `DELETE FROM webhooks WHERE id = ?`
+
+	result, err := r.Execute(ctx, query, id)
+	if err != nil {
+		return fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%w: failed to get rows affected: %w", domainErrors.ErrDatabaseError, err)
+	}
+
+	if rowsAffected == 0 {
+		return domainErrors.ErrWebhookNotFound
+	}
+
+	return nil
+}
+
+func (r *WebhookRepository) CreateDelivery(ctx context.Context, delivery *entity.WebhookDelivery) (*entity.WebhookDelivery, error) {
+	query := `
+        INSERT INTO webhook_deliveries (webhook_id, event_type, payload, attempt, status_code, success, error_message, delivered_at)
+        VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+    `
+
+	id, err := insertReturningID(ctx, r, r.Dialect(), query,
+		delivery.WebhookID,
+		delivery.EventType,
+		delivery.Payload,
+		delivery.Attempt,
+		delivery.StatusCode,
+		delivery.Success,
+		delivery.ErrorMessage,
+		delivery.DeliveredAt,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+	}
+
+	delivery.ID = id
+	return delivery, nil
+}
+
+func (r *WebhookRepository) FindDeliveriesByWebhookID(ctx context.Context, webhookID int64) ([]*entity.WebhookDelivery, error) {
+	query := `
+        SELECT id, webhook_id, event_type, payload, attempt, status_code, success, error_message, delivered_at
+        FROM webhook_deliveries
+        WHERE webhook_id = ?
+        ORDER BY delivered_at DESC, id DESC
+        LIMIT 100
+    `
+
+	rows, err := r.Query(ctx, query, webhookID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+	}
+	defer rows.Close()
+
+	deliveries := []*entity.WebhookDelivery{}
+	for rows.Next() {
+		var delivery entity.WebhookDelivery
+		err := rows.Scan(
+			&delivery.ID,
+			&delivery.WebhookID,
+			&delivery.EventType,
+			&delivery.Payload,
+			&delivery.Attempt,
+			&delivery.StatusCode,
+			&delivery.Success,
+			&delivery.ErrorMessage,
+			&delivery.DeliveredAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+		}
+		deliveries = append(deliveries, &delivery)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, err)
+	}
+
+	return deliveries, nil
+}
+
+func scanWebhook(scanner interface {
+	Scan(dest ...interface{}) error
+}) (*entity.Webhook, error) {
+	var webhook entity.Webhook
+	var eventTypes string
+
+	err := scanner.Scan(
+		&webhook.ID,
+		&webhook.URL,
+		&webhook.Secret,
+		&eventTypes,
+		&webhook.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal([]byte(eventTypes), &webhook.EventTypes); err != nil {
+		return nil, err
+	}
+
+	return &webhook, nil
+}
@@ -0,0 +1,27 @@
+package i18n
+
+// カテゴリーの表示名（キーは保存している値）
+var categoryNames = map[string]map[Lang]string{
+	"時計":    {English: "Watches", Japanese: "時計"},
+	"バッグ":   {English: "Bags", Japanese: "バッグ"},
+	"ジュエリー": {English: "Jewelry", Japanese: "ジュエリー"},
+	"靴":     {English: "Shoes", Japanese: "靴"},
+	"その他":   {English: "Other", Japanese: "その他"},
+}
+
+// カテゴリーの表示名を返す（表示名のないカテゴリーは保存している値のまま）
+func CategoryName(lang Lang, category string) string {
+	if name, ok := categoryNames[category][lang]; ok {
+		return name
+	}
+	return category
+}
+
+// categories の表示名（保存している値ごと）
+func CategoryNames(lang Lang, categories []string) map[string]string {
+	names := make(map[string]string, len(categories))
+	for _, category := range categories {
+		names[category] = CategoryName(lang, category)
+	}
+	return names
+}
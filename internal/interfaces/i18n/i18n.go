@@ -0,0 +1,70 @@
+package i18n
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// レスポンスのメッセージの言語
+type Lang string
+
+const (
+	English  Lang = "en"
+	Japanese Lang = "ja"
+)
+
+const (
+	HeaderAcceptLanguage  = "Accept-Language"
+	HeaderContentLanguage = "Content-Language"
+)
+
+// Accept-Language がない、または対応していない言語だけの場合の言語（これまでのレスポンスと変えない）
+const DefaultLang = English
+
+// エラーコードのメッセージを返す（知らないコードの場合は false）
+func Message(lang Lang, code string) (string, bool) {
+	bundle, ok := messages[code]
+	if !ok {
+		return "", false
+	}
+	return bundle[lang], true
+}
+
+// Accept-Language（"ja,en-US;q=0.8" の形式）から、qの値が最も大きい対応言語を選ぶ
+func Negotiate(acceptLanguage string) Lang {
+	type candidate struct {
+		lang Lang
+		q    float64
+	}
+
+	var candidates []candidate
+	for _, part := range strings.Split(acceptLanguage, ",") {
+		tag, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		q := 1.0
+		if value, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			parsed, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				continue
+			}
+			q = parsed
+		}
+		if q <= 0 {
+			continue
+		}
+
+		// "ja-JP" のような地域付きの指定も言語だけで比べる
+		primary, _, _ := strings.Cut(strings.ToLower(tag), "-")
+		switch Lang(primary) {
+		case English, Japanese:
+			candidates = append(candidates, candidate{lang: Lang(primary), q: q})
+		}
+	}
+
+	if len(candidates) == 0 {
+		return DefaultLang
+	}
+	// 同じqの場合は先に書かれたものを優先する
+	sort.SliceStable(candidates, func(i, j int) bool { return candidates[i].q > candidates[j].q })
+	return candidates[0].lang
+}
@@ -0,0 +1,59 @@
+package i18n
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"Aicon-assignment/internal/domain/entity"
+)
+
+func TestNegotiate(t *testing.T) {
+	tests := []struct {
+		name           string
+		acceptLanguage string
+		want           Lang
+	}{
+		{name: "正常系: 指定がなければ英語", acceptLanguage: "", want: English},
+		{name: "正常系: 日本語", acceptLanguage: "ja", want: Japanese},
+		{name: "正常系: 地域付きの指定", acceptLanguage: "ja-JP", want: Japanese},
+		{name: "正常系: qの値が大きい方を選ぶ", acceptLanguage: "en;q=0.5, ja;q=0.9", want: Japanese},
+		{name: "正常系: 同じqなら先に書かれた方", acceptLanguage: "en-US,ja", want: English},
+		{name: "正常系: 対応していない言語は飛ばす", acceptLanguage: "fr-FR, ja;q=0.7, en;q=0.3", want: Japanese},
+		{name: "正常系: q=0は除外する", acceptLanguage: "ja;q=0, en", want: English},
+		{name: "異常系: 対応する言語がなければ英語", acceptLanguage: "fr, de;q=0.5", want: English},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Negotiate(tt.acceptLanguage))
+		})
+	}
+}
+
+// 全てのエラーコードに両方の言語のメッセージがある
+func TestMessages(t *testing.T) {
+	for code, bundle := range messages {
+		assert.NotEmpty(t, bundle[English], code)
+		assert.NotEmpty(t, bundle[Japanese], code)
+	}
+
+	message, ok := Message(Japanese, "item_not_found")
+	assert.True(t, ok)
+	assert.Equal(t, "アイテムが見つかりません", message)
+
+	_, ok = Message(English, "unknown_code")
+	assert.False(t, ok)
+}
+
+func TestCategoryName(t *testing.T) {
+	for _, category := range entity.ValidCategories {
+		assert.NotEmpty(t, categoryNames[category][English], category)
+		assert.Equal(t, category, CategoryName(Japanese, category))
+	}
+
+	assert.Equal(t, "Watches", CategoryName(English, "時計"))
+	// 表示名のないカテゴリーは保存している値のまま
+	assert.Equal(t, "腕時計", CategoryName(English, "腕時計"))
+	assert.Equal(t, map[string]string{"バッグ": "Bags", "靴": "Shoes"}, CategoryNames(English, []string{"バッグ", "靴"}))
+}
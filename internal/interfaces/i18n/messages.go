@@ -0,0 +1,137 @@
+package i18n
+
+// エラーコードごとのメッセージ（エラーレスポンスの title）
+var messages = map[string]map[Lang]string{
+	"invalid_request_format":              {English: "invalid request format", Japanese: "リクエストの形式が正しくありません"},
+	"validation_failed":                   {English: "validation failed", Japanese: "入力内容に誤りがあります"},
+	"request_does_not_match_schema":       {English: "request does not match schema", Japanese: "リクエストがAPI仕様に合っていません"},
+	"request_body_required":               {English: "request body is required", Japanese: "リクエストの本文が必要です"},
+	"request_body_too_large":              {English: "request body is too large", Japanese: "リクエストの本文が大きすぎます"},
+	"request_timeout":                     {English: "request timed out", Japanese: "制限時間内に処理を終えられませんでした。しばらく待ってから再度お試しください"},
+	"unsupported_content_type":            {English: "content type must be application/json", Japanese: "Content-Typeはapplication/jsonにしてください"},
+	"no_fields_to_update":                 {English: "at least one field (name, brand, purchase_price, condition, serial_number, or attributes) must be provided for update", Japanese: "更新する項目（name、brand、purchase_price、condition、serial_number、attributes）を1つ以上指定してください"},
+	"query_required":                      {English: "query is required", Japanese: "queryを指定してください"},
+	"invalid_filter":                      {English: "invalid filter", Japanese: "絞り込み条件が正しくありません"},
+	"invalid_item_id":                     {English: "invalid item ID", Japanese: "アイテムIDが正しくありません"},
+	"invalid_location_id":                 {English: "invalid location ID", Japanese: "保管場所IDが正しくありません"},
+	"invalid_loan_id":                     {English: "invalid loan ID", Japanese: "貸出IDが正しくありません"},
+	"invalid_template_id":                 {English: "invalid template ID", Japanese: "テンプレートIDが正しくありません"},
+	"invalid_webhook_id":                  {English: "invalid webhook ID", Japanese: "WebhookのIDが正しくありません"},
+	"invalid_job_id":                      {English: "invalid job ID", Japanese: "ジョブのIDが正しくありません"},
+	"invalid_serial_number":               {English: "invalid serial number", Japanese: "シリアル番号が正しくありません"},
+	"invalid_share_link_id":               {English: "invalid share link ID", Japanese: "共有リンクのIDが正しくありません"},
+	"invalid_comment_id":                  {English: "invalid comment ID", Japanese: "メモのIDが正しくありません"},
+	"invalid_relation_id":                 {English: "invalid relation ID", Japanese: "関連のIDが正しくありません"},
+	"invalid_dry_run":                     {English: "dry_run must be true or false", Japanese: "dry_runはtrueかfalseを指定してください"},
+	"invalid_force":                       {English: "force must be true or false", Japanese: "forceはtrueかfalseを指定してください"},
+	"admin_token_required":                {English: "admin token is required", Japanese: "管理者用のトークンが必要です"},
+	"feature_disabled":                    {English: "feature is disabled", Japanese: "この機能は現在停止しています"},
+	"rate_limit_exceeded":                 {English: "rate limit exceeded", Japanese: "リクエストが多すぎます。しばらく待ってから再度お試しください"},
+	"invalid_input":                       {English: "invalid input", Japanese: "入力内容が正しくありません"},
+	"database_error":                      {English: "database error", Japanese: "データベースでエラーが発生しました"},
+	"database_unavailable":                {English: "database is temporarily unavailable", Japanese: "データベースに接続できません。しばらく待ってから再度お試しください"},
+	"duplicate_entry":                     {English: "duplicate entry", Japanese: "既に登録されています"},
+	"bad_request":                         {English: "bad request", Japanese: "リクエストが正しくありません"},
+	"not_found":                           {English: "not found", Japanese: "見つかりません"},
+	"method_not_allowed":                  {English: "method not allowed", Japanese: "このメソッドは使えません"},
+	"internal_error":                      {English: "internal server error", Japanese: "サーバーでエラーが発生しました"},
+	"item_not_found":                      {English: "item not found", Japanese: "アイテムが見つかりません"},
+	"location_not_found":                  {English: "location not found", Japanese: "保管場所が見つかりません"},
+	"loan_not_found":                      {English: "loan not found", Japanese: "貸出が見つかりません"},
+	"template_not_found":                  {English: "template not found", Japanese: "テンプレートが見つかりません"},
+	"webhook_not_found":                   {English: "webhook not found", Japanese: "Webhookが見つかりません"},
+	"erasure_not_found":                   {English: "erasure not found", Japanese: "削除の依頼が見つかりません"},
+	"job_not_found":                       {English: "job not found", Japanese: "ジョブが見つかりません"},
+	"failed_to_export_data":               {English: "failed to export data", Japanese: "データを書き出せませんでした"},
+	"failed_to_retrieve_erasure":          {English: "failed to retrieve erasure", Japanese: "削除の依頼を取得できませんでした"},
+	"failed_to_request_erasure":           {English: "failed to request erasure", Japanese: "削除を依頼できませんでした"},
+	"failed_to_cancel_erasure":            {English: "failed to cancel erasure", Japanese: "削除の依頼を取り消せませんでした"},
+	"share_link_not_found":                {English: "share link not found", Japanese: "共有リンクが見つからないか、期限が切れています"},
+	"failed_to_create_share_link":         {English: "failed to create share link", Japanese: "共有リンクを作成できませんでした"},
+	"failed_to_retrieve_share_links":      {English: "failed to retrieve share links", Japanese: "共有リンクを取得できませんでした"},
+	"failed_to_revoke_share_link":         {English: "failed to revoke share link", Japanese: "共有リンクを取り消せませんでした"},
+	"comment_not_found":                   {English: "comment not found", Japanese: "メモが見つかりません"},
+	"failed_to_create_comment":            {English: "failed to create comment", Japanese: "メモを追加できませんでした"},
+	"failed_to_retrieve_comments":         {English: "failed to retrieve comments", Japanese: "メモを取得できませんでした"},
+	"failed_to_delete_comment":            {English: "failed to delete comment", Japanese: "メモを削除できませんでした"},
+	"relation_not_found":                  {English: "relation not found", Japanese: "関連が見つかりません"},
+	"duplicate_relation":                  {English: "relation already exists", Japanese: "同じ関連が既にあります"},
+	"similar_brand":                       {English: "brand is similar to an existing brand", Japanese: "既存のブランドと表記が似ています"},
+	"job_not_failed":                      {English: "job has not failed", Japanese: "失敗していないジョブは再実行できません"},
+	"version_conflict":                    {English: "item was modified by another request", Japanese: "アイテムは他のリクエストで変更されています。取得し直してから変更してください"},
+	"failed_to_create_relation":           {English: "failed to create relation", Japanese: "関連を作成できませんでした"},
+	"failed_to_retrieve_relations":        {English: "failed to retrieve relations", Japanese: "関連を取得できませんでした"},
+	"failed_to_delete_relation":           {English: "failed to delete relation", Japanese: "関連を削除できませんでした"},
+	"failed_to_reassign_category":         {English: "failed to reassign category", Japanese: "カテゴリーを付け替えられませんでした"},
+	"failed_to_retrieve_audit_log":        {English: "failed to retrieve audit log", Japanese: "監査ログを取得できませんでした"},
+	"failed_to_retrieve_activity":         {English: "failed to retrieve activity", Japanese: "アクティビティを取得できませんでした"},
+	"failed_to_retrieve_jobs":             {English: "failed to retrieve jobs", Japanese: "ジョブを取得できませんでした"},
+	"failed_to_retry_job":                 {English: "failed to retry job", Japanese: "ジョブを再実行できませんでした"},
+	"failed_to_retrieve_preferences":      {English: "failed to retrieve notification preferences", Japanese: "通知の設定を取得できませんでした"},
+	"failed_to_update_preferences":        {English: "failed to update notification preferences", Japanese: "通知の設定を変更できませんでした"},
+	"item_already_sold":                   {English: "item is already sold", Japanese: "アイテムは既に売却済みです"},
+	"sale_not_found":                      {English: "sale not found", Japanese: "売却の記録が見つかりません"},
+	"failed_to_record_sale":               {English: "failed to record sale", Japanese: "売却を記録できませんでした"},
+	"failed_to_cancel_sale":               {English: "failed to cancel sale", Japanese: "売却の記録を取り消せませんでした"},
+	"invalid_year":                        {English: "year must be between 1900 and 9999", Japanese: "yearは1900から9999の年を指定してください"},
+	"invalid_report_format":               {English: "format must be csv or pdf", Japanese: "formatはcsvかpdfを指定してください"},
+	"failed_to_create_tax_report":         {English: "failed to create tax report", Japanese: "税務申告用の明細を作成できませんでした"},
+	"insurance_not_found":                 {English: "insurance not found", Japanese: "保険の設定が見つかりません"},
+	"failed_to_retrieve_insurance":        {English: "failed to retrieve insurance", Japanese: "保険の設定を取得できませんでした"},
+	"failed_to_set_insurance":             {English: "failed to set insurance", Japanese: "保険を設定できませんでした"},
+	"failed_to_remove_insurance":          {English: "failed to remove insurance", Japanese: "保険の設定を削除できませんでした"},
+	"failed_to_create_insurance_schedule": {English: "failed to create insurance schedule", Japanese: "保険の明細を作成できませんでした"},
+	"unsupported_listing_site":            {English: "listing site is not supported", Japanese: "対応していないサイトのURLです"},
+	"listing_unavailable":                 {English: "failed to fetch listing", Japanese: "出品ページを読み取れませんでした"},
+	"failed_to_import_listing":            {English: "failed to import listing", Japanese: "出品ページから下書きを作成できませんでした"},
+	"failed_to_retrieve_recently_viewed":  {English: "failed to retrieve recently viewed items", Japanese: "最近見たアイテムを取得できませんでした"},
+	"failed_to_update_favorite":           {English: "failed to update favorite", Japanese: "お気に入りを変更できませんでした"},
+	"failed_to_revalue_items":             {English: "failed to revalue items", Japanese: "評価額を変更できませんでした"},
+	"failed_to_retrieve_valuations":       {English: "failed to retrieve valuations", Japanese: "評価額の履歴を取得できませんでした"},
+	"failed_to_search_items":              {English: "failed to search items", Japanese: "アイテムを検索できませんでした"},
+	"failed_to_suggest_items":             {English: "failed to retrieve suggestions", Japanese: "入力候補を取得できませんでした"},
+	"failed_to_reindex_items":             {English: "failed to rebuild the search index", Japanese: "検索インデックスを再構築できませんでした"},
+	"failed_to_retrieve_shared_item":      {English: "failed to retrieve shared item", Japanese: "共有されたアイテムを取得できませんでした"},
+	"feature_not_found":                   {English: "feature not found", Japanese: "機能が見つかりません"},
+	"item_already_on_loan":                {English: "item is already on loan", Japanese: "アイテムは既に貸出中です"},
+	"loan_already_returned":               {English: "loan is already returned", Japanese: "貸出は既に返却済みです"},
+	"duplicate_serial_number":             {English: "serial number already exists", Japanese: "シリアル番号は既に登録されています"},
+	"multiple_active_loans":               {English: "more than one of the items is on loan", Japanese: "貸出中のアイテムが2つ以上あります"},
+	"restore_not_empty":                   {English: "database already has data (use force to replace it)", Japanese: "データベースにデータが残っています（置き換える場合はforceを指定してください）"},
+	"quota_exceeded":                      {English: "quota exceeded", Japanese: "利用量の上限に達しています"},
+	"failed_to_retrieve_item":             {English: "failed to retrieve item", Japanese: "アイテムを取得できませんでした"},
+	"failed_to_retrieve_items":            {English: "failed to retrieve items", Japanese: "アイテムの一覧を取得できませんでした"},
+	"failed_to_get_usage":                 {English: "failed to get usage", Japanese: "利用量を取得できませんでした"},
+	"failed_to_count_items":               {English: "failed to count items", Japanese: "アイテムの数を取得できませんでした"},
+	"failed_to_create_item":               {English: "failed to create item", Japanese: "アイテムを登録できませんでした"},
+	"failed_to_validate_item":             {English: "failed to validate item", Japanese: "アイテムを確認できませんでした"},
+	"failed_to_update_item":               {English: "failed to update item", Japanese: "アイテムを更新できませんでした"},
+	"failed_to_delete_item":               {English: "failed to delete item", Japanese: "アイテムを削除できませんでした"},
+	"failed_to_clone_item":                {English: "failed to clone item", Japanese: "アイテムを複製できませんでした"},
+	"failed_to_merge_items":               {English: "failed to merge items", Japanese: "アイテムを統合できませんでした"},
+	"failed_to_move_item":                 {English: "failed to move item", Japanese: "アイテムを移動できませんでした"},
+	"failed_to_render_label":              {English: "failed to render label", Japanese: "ラベルを作成できませんでした"},
+	"failed_to_retrieve_summary":          {English: "failed to retrieve summary", Japanese: "集計を取得できませんでした"},
+	"failed_to_detect_duplicates":         {English: "failed to detect duplicates", Japanese: "重複を検出できませんでした"},
+	"failed_to_retrieve_location":         {English: "failed to retrieve location", Japanese: "保管場所を取得できませんでした"},
+	"failed_to_retrieve_locations":        {English: "failed to retrieve locations", Japanese: "保管場所の一覧を取得できませんでした"},
+	"failed_to_create_location":           {English: "failed to create location", Japanese: "保管場所を登録できませんでした"},
+	"failed_to_update_location":           {English: "failed to update location", Japanese: "保管場所を更新できませんでした"},
+	"failed_to_delete_location":           {English: "failed to delete location", Japanese: "保管場所を削除できませんでした"},
+	"failed_to_retrieve_location_history": {English: "failed to retrieve location history", Japanese: "移動履歴を取得できませんでした"},
+	"failed_to_create_loan":               {English: "failed to create loan", Japanese: "貸出を登録できませんでした"},
+	"failed_to_return_loan":               {English: "failed to return loan", Japanese: "返却を登録できませんでした"},
+	"failed_to_retrieve_overdue_loans":    {English: "failed to retrieve overdue loans", Japanese: "返却期限を過ぎた貸出を取得できませんでした"},
+	"failed_to_retrieve_template":         {English: "failed to retrieve template", Japanese: "テンプレートを取得できませんでした"},
+	"failed_to_retrieve_templates":        {English: "failed to retrieve templates", Japanese: "テンプレートの一覧を取得できませんでした"},
+	"failed_to_create_template":           {English: "failed to create template", Japanese: "テンプレートを登録できませんでした"},
+	"failed_to_delete_template":           {English: "failed to delete template", Japanese: "テンプレートを削除できませんでした"},
+	"failed_to_register_webhook":          {English: "failed to register webhook", Japanese: "Webhookを登録できませんでした"},
+	"failed_to_retrieve_webhooks":         {English: "failed to retrieve webhooks", Japanese: "Webhookの一覧を取得できませんでした"},
+	"failed_to_delete_webhook":            {English: "failed to delete webhook", Japanese: "Webhookを削除できませんでした"},
+	"failed_to_retrieve_deliveries":       {English: "failed to retrieve deliveries", Japanese: "Webhookの配信履歴を取得できませんでした"},
+	"failed_to_retrieve_features":         {English: "failed to retrieve features", Japanese: "機能の一覧を取得できませんでした"},
+	"failed_to_update_feature":            {English: "failed to update feature", Japanese: "機能を切り替えられませんでした"},
+	"failed_to_create_backup":             {English: "failed to create backup", Japanese: "バックアップを作成できませんでした"},
+	"failed_to_restore_backup":            {English: "failed to restore backup", Japanese: "バックアップから復元できませんでした"},
+}
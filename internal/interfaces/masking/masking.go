@@ -0,0 +1,130 @@
+package masking
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// 伏せた値の代わりに出力する文字列
+const Redacted = "[REDACTED]"
+
+// 設定しない場合に伏せる項目（JSONのキーとログの属性名）
+var DefaultFields = []string{"purchase_price", "current_value", "serial_number"}
+
+// URLのパスに値が入るルートのパラメーターと、その値が表す項目
+// 項目が空のものは秘密の値のため、設定によらず常に伏せる
+var pathParams = map[string]string{
+	"serial": "serial_number",
+	"token":  "",
+}
+
+var (
+	mu     sync.RWMutex
+	fields = slices.Clone(DefaultFields)
+)
+
+// 伏せる項目を差し替える（起動時に設定から呼ぶ）
+func Configure(names []string) {
+	mu.Lock()
+	defer mu.Unlock()
+	fields = slices.Clone(names)
+}
+
+// 伏せる項目か
+func IsSensitive(field string) bool {
+	mu.RLock()
+	defer mu.RUnlock()
+	return slices.Contains(fields, field)
+}
+
+// 伏せる項目の値は Redacted に置き換える
+func Value(field string, value any) any {
+	if IsSensitive(field) {
+		return Redacted
+	}
+	return value
+}
+
+// JSONのオブジェクトのうち、伏せる項目のキーの値を入れ子も含めて置き換える
+// 伏せる項目がない場合やJSONとして読めない場合はそのまま返す
+func JSON(data []byte) []byte {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var value any
+	if err := decoder.Decode(&value); err != nil || !mask(value) {
+		return data
+	}
+
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(value); err != nil {
+		return data
+	}
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n"))
+}
+
+// 置き換えた値があれば true を返す
+func mask(value any) bool {
+	masked := false
+	switch v := value.(type) {
+	case map[string]any:
+		for key, child := range v {
+			if IsSensitive(key) {
+				v[key] = Redacted
+				masked = true
+				continue
+			}
+			masked = mask(child) || masked
+		}
+	case []any:
+		for _, child := range v {
+			masked = mask(child) || masked
+		}
+	}
+	return masked
+}
+
+// ルート（"/items/by-serial/:serial" の形式）にパラメーターの値を入れてパスに戻し、伏せるパラメーターは Redacted にする
+// 伏せるパラメーターがないルートは path をそのまま返す
+func Path(path, route string, names, values []string) string {
+	if !slices.ContainsFunc(names, isSensitiveParam) {
+		return path
+	}
+
+	segments := strings.Split(route, "/")
+	for i, segment := range segments {
+		name, ok := strings.CutPrefix(segment, ":")
+		if !ok {
+			continue
+		}
+		index := slices.Index(names, name)
+		if index < 0 || index >= len(values) || isSensitiveParam(name) {
+			segments[i] = Redacted
+			continue
+		}
+		segments[i] = values[index]
+	}
+	return strings.Join(segments, "/")
+}
+
+func isSensitiveParam(name string) bool {
+	field, ok := pathParams[name]
+	return ok && (field == "" || IsSensitive(field))
+}
+
+type revealedKey struct{}
+
+// 管理者として認証したリクエストでは、記録された内容を伏せずに返す
+func Reveal(ctx context.Context) context.Context {
+	return context.WithValue(ctx, revealedKey{}, true)
+}
+
+func IsRevealed(ctx context.Context) bool {
+	revealed, _ := ctx.Value(revealedKey{}).(bool)
+	return revealed
+}
@@ -0,0 +1,102 @@
+package masking
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJSON(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		want string
+	}{
+		{
+			name: "正常系: 入れ子の項目も伏せる",
+			data: `{"type":"item.created","data":{"id":1,"name":"<デイトナ>","purchase_price":1500000,"serial_number":"116500LN"}}`,
+			want: `{"data":{"id":1,"name":"<デイトナ>","purchase_price":"[REDACTED]","serial_number":"[REDACTED]"},"type":"item.created"}`,
+		},
+		{
+			name: "正常系: 配列の中のオブジェクトも伏せる",
+			data: `[{"id":1,"purchase_price":100},{"id":2}]`,
+			want: `[{"id":1,"purchase_price":"[REDACTED]"},{"id":2}]`,
+		},
+		{
+			name: "正常系: 伏せる項目がなければそのまま",
+			data: `{"b":1, "a":"<x>"}`,
+			want: `{"b":1, "a":"<x>"}`,
+		},
+		{
+			name: "異常系: JSONでなければそのまま",
+			data: `purchase_price=100`,
+			want: `purchase_price=100`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, string(JSON([]byte(tt.data))))
+		})
+	}
+}
+
+func TestPath(t *testing.T) {
+	tests := []struct {
+		name   string
+		path   string
+		route  string
+		names  []string
+		values []string
+		want   string
+	}{
+		{
+			name:   "正常系: シリアル番号を伏せる",
+			path:   "/api/v1/items/by-serial/116500LN",
+			route:  "/api/v1/items/by-serial/:serial",
+			names:  []string{"serial"},
+			values: []string{"116500LN"},
+			want:   "/api/v1/items/by-serial/[REDACTED]",
+		},
+		{
+			name:   "正常系: 共有リンクのトークンを伏せる",
+			path:   "/shared/q3Jabc",
+			route:  "/shared/:token",
+			names:  []string{"token"},
+			values: []string{"q3Jabc"},
+			want:   "/shared/[REDACTED]",
+		},
+		{
+			name:   "正常系: 伏せないパラメーターはそのまま",
+			path:   "/api/v1/items/1/shares/2",
+			route:  "/api/v1/items/:id/shares/:shareID",
+			names:  []string{"id", "shareID"},
+			values: []string{"1", "2"},
+			want:   "/api/v1/items/1/shares/2",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Path(tt.path, tt.route, tt.names, tt.values))
+		})
+	}
+}
+
+func TestConfigure(t *testing.T) {
+	t.Cleanup(func() { Configure(DefaultFields) })
+
+	Configure([]string{"brand"})
+
+	assert.Equal(t, Redacted, Value("brand", "ROLEX"))
+	assert.Equal(t, 100, Value("purchase_price", 100))
+	// シリアル番号を伏せない設定ではパスも伏せないが、トークンは常に伏せる
+	assert.Equal(t, "/items/by-serial/A1", Path("/items/by-serial/A1", "/items/by-serial/:serial", []string{"serial"}, []string{"A1"}))
+	assert.Equal(t, "/shared/[REDACTED]", Path("/shared/t", "/shared/:token", []string{"token"}, []string{"t"}))
+}
+
+func TestReveal(t *testing.T) {
+	assert.False(t, IsRevealed(context.Background()))
+	assert.True(t, IsRevealed(Reveal(context.Background())))
+}
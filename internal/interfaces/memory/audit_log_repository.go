@@ -0,0 +1,42 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"Aicon-assignment/internal/domain/entity"
+)
+
+type AuditLogRepository struct {
+	*Store
+}
+
+func (r *AuditLogRepository) FindRecent(ctx context.Context, limit int) ([]*entity.AuditEntry, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	entries := []*entity.AuditEntry{}
+	for _, entry := range r.auditLog {
+		copied := *entry
+		entries = append(entries, &copied)
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].ID > entries[j].ID
+	})
+
+	if len(entries) > limit {
+		entries = entries[:limit]
+	}
+
+	return entries, nil
+}
+
+// 一括変更と同じロックの中で監査ログを記録する（呼び出し側でロックを取っていること）
+func (s *Store) recordAuditEntry(entry *entity.AuditEntry) {
+	stored := *entry
+	stored.ID = s.nextID("audit_log")
+	stored.CreatedAt = entry.CreatedAt.Truncate(time.Second)
+	s.auditLog[stored.ID] = &stored
+}
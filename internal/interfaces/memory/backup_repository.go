@@ -0,0 +1,163 @@
+package memory
+
+import (
+	"context"
+	"sort"
+
+	"Aicon-assignment/internal/domain/entity"
+	domainErrors "Aicon-assignment/internal/domain/errors"
+)
+
+type BackupRepository struct {
+	*Store
+}
+
+func (r *BackupRepository) Dump(ctx context.Context) (*entity.Backup, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	items := &ItemRepository{Store: r.Store}
+	backup := &entity.Backup{
+		Locations:       sortedRows(r.locations, func(l *entity.Location) int64 { return l.ID }),
+		Loans:           sortedRows(r.loans, func(l *entity.Loan) int64 { return l.ID }),
+		LocationHistory: sortedRows(r.moves, func(m *entity.LocationMove) int64 { return m.ID }),
+		Templates:       sortedRows(r.templates, func(t *entity.ItemTemplate) int64 { return t.ID }),
+		Comments:        sortedRows(r.comments, func(c *entity.ItemComment) int64 { return c.ID }),
+		Relations:       sortedRows(r.relations, func(rel *entity.ItemRelation) int64 { return rel.ID }),
+		Valuations:      sortedRows(r.valuations, func(v *entity.ItemValuation) int64 { return v.ID }),
+		Sales:           sortedRows(r.sales, func(s *entity.ItemSale) int64 { return s.ID }),
+		Insurances:      sortedRows(r.insurances, func(i *entity.ItemInsurance) int64 { return i.ItemID }),
+	}
+	for _, item := range sortedRows(r.items, func(i *entity.Item) int64 { return i.ID }) {
+		backup.Items = append(backup.Items, items.copyItem(item))
+	}
+
+	return backup, nil
+}
+
+// MySQL版と同じく、全て置き換えるか全く変えないかのどちらかにする
+func (r *BackupRepository) Restore(ctx context.Context, backup *entity.Backup, replace bool) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if !replace && (len(r.items) > 0 || len(r.locations) > 0 || len(r.templates) > 0) {
+		return domainErrors.ErrRestoreNotEmpty
+	}
+
+	r.items = make(map[int64]*entity.Item, len(backup.Items))
+	r.loans = make(map[int64]*entity.Loan, len(backup.Loans))
+	r.locations = make(map[int64]*entity.Location, len(backup.Locations))
+	r.moves = make(map[int64]*entity.LocationMove, len(backup.LocationHistory))
+	r.templates = make(map[int64]*entity.ItemTemplate, len(backup.Templates))
+	r.comments = make(map[int64]*entity.ItemComment, len(backup.Comments))
+	r.relations = make(map[int64]*entity.ItemRelation, len(backup.Relations))
+	r.valuations = make(map[int64]*entity.ItemValuation, len(backup.Valuations))
+	r.sales = make(map[int64]*entity.ItemSale, len(backup.Sales))
+	r.insurances = make(map[int64]*entity.ItemInsurance, len(backup.Insurances))
+	// 共有リンクはバックアップに含めない（DBでは外部キーで一緒に消える）
+	r.shareLinks = make(map[int64]*entity.ShareLink)
+	// 閲覧の記録とお気に入りも含めない（同じIDで別のアイテムが復元されるため消す）
+	r.itemViews = make(map[itemViewKey]*entity.ItemView)
+	r.favorites = make(map[itemViewKey]*entity.ItemFavorite)
+	for id, record := range r.outbox {
+		if record.published {
+			delete(r.outbox, id)
+		}
+	}
+
+	for _, location := range backup.Locations {
+		stored := *location
+		r.locations[stored.ID] = &stored
+		r.advanceID("locations", stored.ID)
+	}
+	for _, item := range backup.Items {
+		stored := cloneItem(item)
+		stored.OnLoan = false
+		stored.Version = max(stored.Version, 1)
+		r.items[stored.ID] = stored
+		r.advanceID("items", stored.ID)
+	}
+	for _, loan := range backup.Loans {
+		stored := *loan
+		r.loans[stored.ID] = &stored
+		r.advanceID("loans", stored.ID)
+	}
+	for _, move := range backup.LocationHistory {
+		stored := *move
+		r.moves[stored.ID] = &stored
+		r.advanceID("item_location_history", stored.ID)
+	}
+	for _, comment := range backup.Comments {
+		stored := *comment
+		r.comments[stored.ID] = &stored
+		r.advanceID("item_comments", stored.ID)
+	}
+	for _, relation := range backup.Relations {
+		stored := *relation
+		r.relations[stored.ID] = &stored
+		r.advanceID("item_relations", stored.ID)
+	}
+	for _, valuation := range backup.Valuations {
+		stored := *valuation
+		r.valuations[stored.ID] = &stored
+		r.advanceID("item_valuations", stored.ID)
+	}
+	for _, sale := range backup.Sales {
+		stored := *sale
+		r.sales[stored.ID] = &stored
+		r.advanceID("item_sales", stored.ID)
+	}
+	for _, insurance := range backup.Insurances {
+		r.insurances[insurance.ItemID] = copyInsurance(insurance)
+	}
+	for _, template := range backup.Templates {
+		stored := *template
+		r.templates[stored.ID] = &stored
+		r.advanceID("item_templates", stored.ID)
+	}
+
+	return nil
+}
+
+// IDを指定して登録した後も、採番が登録済みのIDと重ならないようにする（呼び出し側でロックを取っていること）
+func (s *Store) advanceID(table string, id int64) {
+	if id > s.lastIDs[table] {
+		s.lastIDs[table] = id
+	}
+}
+
+// IDの順に複製して返す
+func sortedRows[T any](table map[int64]*T, id func(*T) int64) []*T {
+	rows := make([]*T, 0, len(table))
+	for _, row := range table {
+		copied := *row
+		rows = append(rows, &copied)
+	}
+	sort.Slice(rows, func(i, j int) bool { return id(rows[i]) < id(rows[j]) })
+	return rows
+}
+
+func (r *BackupRepository) Erase(ctx context.Context) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.items = make(map[int64]*entity.Item)
+	r.loans = make(map[int64]*entity.Loan)
+	r.locations = make(map[int64]*entity.Location)
+	r.moves = make(map[int64]*entity.LocationMove)
+	r.templates = make(map[int64]*entity.ItemTemplate)
+	r.comments = make(map[int64]*entity.ItemComment)
+	r.relations = make(map[int64]*entity.ItemRelation)
+	r.valuations = make(map[int64]*entity.ItemValuation)
+	r.sales = make(map[int64]*entity.ItemSale)
+	r.insurances = make(map[int64]*entity.ItemInsurance)
+	r.shareLinks = make(map[int64]*entity.ShareLink)
+	r.itemViews = make(map[itemViewKey]*entity.ItemView)
+	r.favorites = make(map[itemViewKey]*entity.ItemFavorite)
+	r.outbox = make(map[int64]*outboxRecord)
+	r.deliveries = make(map[int64]*entity.WebhookDelivery)
+	r.jobs = make(map[int64]*jobRecord)
+	r.auditLog = make(map[int64]*entity.AuditEntry)
+
+	return nil
+}
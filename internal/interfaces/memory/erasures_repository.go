@@ -0,0 +1,70 @@
+package memory
+
+import (
+	"context"
+	"time"
+
+	"Aicon-assignment/internal/domain/entity"
+	domainErrors "Aicon-assignment/internal/domain/errors"
+)
+
+type ErasureRepository struct {
+	*Store
+}
+
+func (r *ErasureRepository) FindPending(ctx context.Context) (*entity.Erasure, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var pending *entity.Erasure
+	for _, erasure := range r.erasures {
+		if erasure.Status == entity.ErasurePending && (pending == nil || erasure.ID < pending.ID) {
+			pending = erasure
+		}
+	}
+	if pending == nil {
+		return nil, domainErrors.ErrErasureNotFound
+	}
+
+	return cloneErasure(pending), nil
+}
+
+func (r *ErasureRepository) Create(ctx context.Context, erasure *entity.Erasure) (*entity.Erasure, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored := &entity.Erasure{
+		ID:          r.nextID("erasures"),
+		Status:      entity.ErasurePending,
+		RequestedAt: erasure.RequestedAt.Truncate(time.Second),
+		ScheduledAt: erasure.ScheduledAt.Truncate(time.Second),
+	}
+	r.erasures[stored.ID] = stored
+
+	return cloneErasure(stored), nil
+}
+
+func (r *ErasureRepository) Finish(ctx context.Context, id int64, status string) (*entity.Erasure, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored, exists := r.erasures[id]
+	if !exists || stored.Status != entity.ErasurePending {
+		return nil, domainErrors.ErrErasureNotFound
+	}
+
+	finishedAt := now()
+	stored.Status = status
+	stored.FinishedAt = &finishedAt
+
+	return cloneErasure(stored), nil
+}
+
+func cloneErasure(erasure *entity.Erasure) *entity.Erasure {
+	copied := *erasure
+	if erasure.FinishedAt != nil {
+		finishedAt := *erasure.FinishedAt
+		copied.FinishedAt = &finishedAt
+	}
+	return &copied
+}
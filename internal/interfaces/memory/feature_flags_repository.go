@@ -0,0 +1,48 @@
+package memory
+
+import (
+	"context"
+	"sort"
+
+	"Aicon-assignment/internal/domain/entity"
+)
+
+type FeatureFlagRepository struct {
+	*Store
+}
+
+func (r *FeatureFlagRepository) FindAll(ctx context.Context) ([]*entity.FeatureFlag, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var flags []*entity.FeatureFlag
+	for _, flag := range r.featureFlags {
+		copied := *flag
+		flags = append(flags, &copied)
+	}
+
+	sort.Slice(flags, func(i, j int) bool {
+		return flags[i].Name < flags[j].Name
+	})
+
+	return flags, nil
+}
+
+func (r *FeatureFlagRepository) Save(ctx context.Context, flag *entity.FeatureFlag) (*entity.FeatureFlag, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored := &entity.FeatureFlag{Name: flag.Name, Enabled: flag.Enabled, UpdatedAt: now()}
+	r.featureFlags[flag.Name] = stored
+
+	copied := *stored
+	return &copied, nil
+}
+
+func (r *FeatureFlagRepository) Delete(ctx context.Context, name string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.featureFlags, name)
+	return nil
+}
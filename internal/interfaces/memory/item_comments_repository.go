@@ -0,0 +1,63 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"Aicon-assignment/internal/domain/entity"
+	domainErrors "Aicon-assignment/internal/domain/errors"
+)
+
+type ItemCommentRepository struct {
+	*Store
+}
+
+func (r *ItemCommentRepository) FindByItemID(ctx context.Context, itemID int64) ([]*entity.ItemComment, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	comments := []*entity.ItemComment{}
+	for _, comment := range r.comments {
+		if comment.ItemID == itemID {
+			copied := *comment
+			comments = append(comments, &copied)
+		}
+	}
+
+	sort.Slice(comments, func(i, j int) bool {
+		return comments[i].ID < comments[j].ID
+	})
+
+	return comments, nil
+}
+
+func (r *ItemCommentRepository) Create(ctx context.Context, comment *entity.ItemComment) (*entity.ItemComment, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.items[comment.ItemID]; !exists {
+		return nil, domainErrors.ErrItemNotFound
+	}
+
+	stored := *comment
+	stored.ID = r.nextID("item_comments")
+	stored.CreatedAt = comment.CreatedAt.Truncate(time.Second)
+	r.comments[stored.ID] = &stored
+
+	created := stored
+	return &created, nil
+}
+
+func (r *ItemCommentRepository) Delete(ctx context.Context, itemID, id int64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored, exists := r.comments[id]
+	if !exists || stored.ItemID != itemID {
+		return domainErrors.ErrCommentNotFound
+	}
+
+	delete(r.comments, id)
+	return nil
+}
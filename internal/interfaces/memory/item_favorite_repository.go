@@ -0,0 +1,41 @@
+package memory
+
+import (
+	"context"
+
+	"Aicon-assignment/internal/domain/entity"
+	domainErrors "Aicon-assignment/internal/domain/errors"
+)
+
+type ItemFavoriteRepository struct {
+	*Store
+}
+
+func (r *ItemFavoriteRepository) Add(ctx context.Context, favorite *entity.ItemFavorite) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.items[favorite.ItemID]; !exists {
+		return domainErrors.ErrItemNotFound
+	}
+
+	key := itemViewKey{viewer: favorite.Viewer, itemID: favorite.ItemID}
+	if _, exists := r.favorites[key]; exists {
+		return nil
+	}
+
+	stored := *favorite
+	stored.CreatedAt = now()
+	r.favorites[key] = &stored
+
+	return nil
+}
+
+func (r *ItemFavoriteRepository) Remove(ctx context.Context, viewer string, itemID int64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.favorites, itemViewKey{viewer: viewer, itemID: itemID})
+
+	return nil
+}
@@ -0,0 +1,75 @@
+package memory
+
+import (
+	"context"
+	"sort"
+
+	"Aicon-assignment/internal/domain/entity"
+	domainErrors "Aicon-assignment/internal/domain/errors"
+)
+
+type ItemInsuranceRepository struct {
+	*Store
+}
+
+func (r *ItemInsuranceRepository) FindByItemID(ctx context.Context, itemID int64) (*entity.ItemInsurance, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	insurance, exists := r.insurances[itemID]
+	if !exists {
+		return nil, domainErrors.ErrInsuranceNotFound
+	}
+
+	return copyInsurance(insurance), nil
+}
+
+func (r *ItemInsuranceRepository) FindAll(ctx context.Context) ([]*entity.ItemInsurance, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	insurances := make([]*entity.ItemInsurance, 0, len(r.insurances))
+	for _, insurance := range r.insurances {
+		insurances = append(insurances, copyInsurance(insurance))
+	}
+
+	sort.Slice(insurances, func(i, j int) bool {
+		return insurances[i].ItemID < insurances[j].ItemID
+	})
+
+	return insurances, nil
+}
+
+func (r *ItemInsuranceRepository) Save(ctx context.Context, insurance *entity.ItemInsurance) (*entity.ItemInsurance, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.items[insurance.ItemID]; !exists {
+		return nil, domainErrors.ErrItemNotFound
+	}
+
+	stored := copyInsurance(insurance)
+	stored.UpdatedAt = now()
+	r.insurances[stored.ItemID] = stored
+
+	return copyInsurance(stored), nil
+}
+
+func (r *ItemInsuranceRepository) DeleteByItemID(ctx context.Context, itemID int64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.insurances[itemID]; !exists {
+		return domainErrors.ErrInsuranceNotFound
+	}
+	delete(r.insurances, itemID)
+
+	return nil
+}
+
+// 写真の参照のスライスも複製する
+func copyInsurance(insurance *entity.ItemInsurance) *entity.ItemInsurance {
+	copied := *insurance
+	copied.Photos = append([]string{}, insurance.Photos...)
+	return &copied
+}
@@ -0,0 +1,66 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"Aicon-assignment/internal/domain/entity"
+	domainErrors "Aicon-assignment/internal/domain/errors"
+)
+
+type ItemRelationRepository struct {
+	*Store
+}
+
+func (r *ItemRelationRepository) FindByItemID(ctx context.Context, itemID int64) ([]*entity.ItemRelation, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	relations := []*entity.ItemRelation{}
+	for _, relation := range r.relations {
+		if relation.ItemID == itemID || relation.RelatedItemID == itemID {
+			copied := *relation
+			relations = append(relations, &copied)
+		}
+	}
+
+	sort.Slice(relations, func(i, j int) bool {
+		return relations[i].ID < relations[j].ID
+	})
+
+	return relations, nil
+}
+
+func (r *ItemRelationRepository) Create(ctx context.Context, relation *entity.ItemRelation) (*entity.ItemRelation, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.items[relation.ItemID]; !exists {
+		return nil, domainErrors.ErrItemNotFound
+	}
+	if _, exists := r.items[relation.RelatedItemID]; !exists {
+		return nil, domainErrors.ErrItemNotFound
+	}
+
+	stored := *relation
+	stored.ID = r.nextID("item_relations")
+	stored.CreatedAt = relation.CreatedAt.Truncate(time.Second)
+	r.relations[stored.ID] = &stored
+
+	created := stored
+	return &created, nil
+}
+
+func (r *ItemRelationRepository) Delete(ctx context.Context, itemID, id int64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored, exists := r.relations[id]
+	if !exists || (stored.ItemID != itemID && stored.RelatedItemID != itemID) {
+		return domainErrors.ErrRelationNotFound
+	}
+
+	delete(r.relations, id)
+	return nil
+}
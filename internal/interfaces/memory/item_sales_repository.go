@@ -0,0 +1,84 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"Aicon-assignment/internal/domain/entity"
+	domainErrors "Aicon-assignment/internal/domain/errors"
+)
+
+type ItemSaleRepository struct {
+	*Store
+}
+
+func (r *ItemSaleRepository) FindByItemID(ctx context.Context, itemID int64) ([]*entity.ItemSale, error) {
+	return r.findAll(func(sale *entity.ItemSale) bool { return sale.ItemID == itemID }), nil
+}
+
+func (r *ItemSaleRepository) FindSoldBetween(ctx context.Context, from, to string) ([]*entity.ItemSale, error) {
+	sales := r.findAll(func(sale *entity.ItemSale) bool { return sale.SoldOn >= from && sale.SoldOn <= to })
+
+	sort.SliceStable(sales, func(i, j int) bool {
+		return sales[i].SoldOn < sales[j].SoldOn
+	})
+
+	return sales, nil
+}
+
+// match に合う売却をIDの順に返す
+func (r *ItemSaleRepository) findAll(match func(sale *entity.ItemSale) bool) []*entity.ItemSale {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	sales := []*entity.ItemSale{}
+	for _, sale := range r.sales {
+		if match(sale) {
+			copied := *sale
+			sales = append(sales, &copied)
+		}
+	}
+
+	sort.Slice(sales, func(i, j int) bool {
+		return sales[i].ID < sales[j].ID
+	})
+
+	return sales
+}
+
+func (r *ItemSaleRepository) Create(ctx context.Context, sale *entity.ItemSale) (*entity.ItemSale, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.items[sale.ItemID]; !exists {
+		return nil, domainErrors.ErrItemNotFound
+	}
+
+	stored := *sale
+	stored.ID = r.nextID("item_sales")
+	stored.CreatedAt = sale.CreatedAt.Truncate(time.Second)
+	r.sales[stored.ID] = &stored
+	(&ItemRepository{Store: r.Store}).recordItemEvent(entity.EventItemSold, r.items[sale.ItemID])
+
+	created := stored
+	return &created, nil
+}
+
+func (r *ItemSaleRepository) DeleteByItemID(ctx context.Context, itemID int64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	deleted := false
+	for id, sale := range r.sales {
+		if sale.ItemID == itemID {
+			delete(r.sales, id)
+			deleted = true
+		}
+	}
+	if !deleted {
+		return domainErrors.ErrSaleNotFound
+	}
+
+	return nil
+}
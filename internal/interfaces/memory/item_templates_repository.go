@@ -0,0 +1,69 @@
+package memory
+
+import (
+	"context"
+	"sort"
+
+	"Aicon-assignment/internal/domain/entity"
+	domainErrors "Aicon-assignment/internal/domain/errors"
+)
+
+type ItemTemplateRepository struct {
+	*Store
+}
+
+func (r *ItemTemplateRepository) FindAll(ctx context.Context) ([]*entity.ItemTemplate, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var templates []*entity.ItemTemplate
+	for _, template := range r.templates {
+		copied := *template
+		templates = append(templates, &copied)
+	}
+
+	sort.Slice(templates, func(i, j int) bool {
+		return templates[i].Name < templates[j].Name
+	})
+
+	return templates, nil
+}
+
+func (r *ItemTemplateRepository) FindByID(ctx context.Context, id int64) (*entity.ItemTemplate, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	template, exists := r.templates[id]
+	if !exists {
+		return nil, domainErrors.ErrTemplateNotFound
+	}
+
+	copied := *template
+	return &copied, nil
+}
+
+func (r *ItemTemplateRepository) Create(ctx context.Context, template *entity.ItemTemplate) (*entity.ItemTemplate, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored := *template
+	stored.ID = r.nextID("item_templates")
+	stored.CreatedAt = now()
+	stored.UpdatedAt = stored.CreatedAt
+	r.templates[stored.ID] = &stored
+
+	copied := stored
+	return &copied, nil
+}
+
+func (r *ItemTemplateRepository) Delete(ctx context.Context, id int64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.templates[id]; !exists {
+		return domainErrors.ErrTemplateNotFound
+	}
+	delete(r.templates, id)
+
+	return nil
+}
@@ -0,0 +1,50 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"Aicon-assignment/internal/domain/entity"
+	domainErrors "Aicon-assignment/internal/domain/errors"
+)
+
+type ItemValuationRepository struct {
+	*Store
+}
+
+func (r *ItemValuationRepository) FindByItemID(ctx context.Context, itemID int64) ([]*entity.ItemValuation, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	valuations := []*entity.ItemValuation{}
+	for _, valuation := range r.valuations {
+		if valuation.ItemID == itemID {
+			copied := *valuation
+			valuations = append(valuations, &copied)
+		}
+	}
+
+	sort.Slice(valuations, func(i, j int) bool {
+		return valuations[i].ID < valuations[j].ID
+	})
+
+	return valuations, nil
+}
+
+func (r *ItemValuationRepository) Create(ctx context.Context, valuation *entity.ItemValuation) (*entity.ItemValuation, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.items[valuation.ItemID]; !exists {
+		return nil, domainErrors.ErrItemNotFound
+	}
+
+	stored := *valuation
+	stored.ID = r.nextID("item_valuations")
+	stored.CreatedAt = valuation.CreatedAt.Truncate(time.Second)
+	r.valuations[stored.ID] = &stored
+
+	created := stored
+	return &created, nil
+}
@@ -0,0 +1,71 @@
+package memory
+
+import (
+	"context"
+	"sort"
+
+	"Aicon-assignment/internal/domain/entity"
+	domainErrors "Aicon-assignment/internal/domain/errors"
+)
+
+type ItemViewRepository struct {
+	*Store
+}
+
+func (r *ItemViewRepository) Save(ctx context.Context, view *entity.ItemView, keep int) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.items[view.ItemID]; !exists {
+		return domainErrors.ErrItemNotFound
+	}
+
+	stored := *view
+	stored.ViewedAt = now()
+	r.itemViews[itemViewKey{viewer: view.Viewer, itemID: view.ItemID}] = &stored
+
+	// 新しい方から keep 件を残す
+	views := r.viewsOf(view.Viewer)
+	for _, old := range views[min(keep, len(views)):] {
+		delete(r.itemViews, itemViewKey{viewer: old.Viewer, itemID: old.ItemID})
+	}
+
+	return nil
+}
+
+func (r *ItemViewRepository) FindRecentByViewer(ctx context.Context, viewer string, limit int) ([]*entity.ItemView, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	views := r.viewsOf(viewer)
+	if len(views) > limit {
+		views = views[:limit]
+	}
+
+	result := make([]*entity.ItemView, 0, len(views))
+	for _, view := range views {
+		copied := *view
+		result = append(result, &copied)
+	}
+
+	return result, nil
+}
+
+// 閲覧者の記録を新しい順に並べる（同じ秒の記録はアイテムのIDの大きい順。呼び出し側でロックを取っていること）
+func (r *ItemViewRepository) viewsOf(viewer string) []*entity.ItemView {
+	views := []*entity.ItemView{}
+	for key, view := range r.itemViews {
+		if key.viewer == viewer {
+			views = append(views, view)
+		}
+	}
+
+	sort.Slice(views, func(i, j int) bool {
+		if !views[i].ViewedAt.Equal(views[j].ViewedAt) {
+			return views[i].ViewedAt.After(views[j].ViewedAt)
+		}
+		return views[i].ItemID > views[j].ItemID
+	})
+
+	return views
+}
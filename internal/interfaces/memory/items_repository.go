@@ -0,0 +1,446 @@
+package memory
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"Aicon-assignment/internal/domain/entity"
+	domainErrors "Aicon-assignment/internal/domain/errors"
+)
+
+type ItemRepository struct {
+	*Store
+}
+
+func (r *ItemRepository) FindAll(ctx context.Context, filter entity.ItemFilter) ([]*entity.Item, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var items []*entity.Item
+	for _, item := range r.items {
+		if r.matchesFilter(item, filter) {
+			items = append(items, r.copyItem(item))
+		}
+	}
+
+	// MySQL版と同じく新しい順（同時刻はIDの降順）
+	sort.Slice(items, func(i, j int) bool {
+		if !items[i].CreatedAt.Equal(items[j].CreatedAt) {
+			return items[i].CreatedAt.After(items[j].CreatedAt)
+		}
+		return items[i].ID > items[j].ID
+	})
+
+	return items, nil
+}
+
+func (r *ItemRepository) Count(ctx context.Context, filter entity.ItemFilter) (int, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	count := 0
+	for _, item := range r.items {
+		if r.matchesFilter(item, filter) {
+			count++
+		}
+	}
+
+	return count, nil
+}
+
+// Transactor.WithTx は1つずつ実行するため、ロックを取らずに数えればよい
+func (r *ItemRepository) CountLocked(ctx context.Context) (int, error) {
+	return r.Count(ctx, entity.ItemFilter{IncludeArchived: true})
+}
+
+func (r *ItemRepository) FindByID(ctx context.Context, id int64) (*entity.Item, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	item, exists := r.items[id]
+	if !exists {
+		return nil, domainErrors.ErrItemNotFound
+	}
+
+	return r.copyItem(item), nil
+}
+
+func (r *ItemRepository) FindBySerialNumber(ctx context.Context, serialNumber string) (*entity.Item, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, item := range r.items {
+		if item.SerialNumber != "" && item.SerialNumber == serialNumber {
+			return r.copyItem(item), nil
+		}
+	}
+
+	return nil, domainErrors.ErrItemNotFound
+}
+
+func (r *ItemRepository) Create(ctx context.Context, item *entity.Item) (*entity.Item, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored := cloneItem(item)
+	stored.ID = r.nextID("items")
+	stored.CreatedAt = now()
+	stored.UpdatedAt = stored.CreatedAt
+	stored.Version = 1
+	r.items[stored.ID] = stored
+
+	created := r.copyItem(stored)
+	r.recordItemEvent(entity.EventItemCreated, created)
+
+	return created, nil
+}
+
+func (r *ItemRepository) Update(ctx context.Context, item *entity.Item) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	current, exists := r.items[item.ID]
+	if !exists {
+		return domainErrors.ErrItemNotFound
+	}
+	if current.Version != item.Version {
+		return domainErrors.ErrVersionConflict
+	}
+
+	stored := cloneItem(item)
+	stored.CreatedAt = current.CreatedAt
+	stored.Version = current.Version + 1
+	r.items[stored.ID] = stored
+
+	r.recordItemEvent(entity.EventItemUpdated, r.copyItem(stored))
+
+	item.Version = stored.Version
+	return nil
+}
+
+func (r *ItemRepository) Delete(ctx context.Context, id int64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	item, exists := r.items[id]
+	if !exists {
+		return domainErrors.ErrItemNotFound
+	}
+
+	deleted := r.copyItem(item)
+	delete(r.items, id)
+
+	// ON DELETE CASCADE と同じく履歴も消す
+	for loanID, loan := range r.loans {
+		if loan.ItemID == id {
+			delete(r.loans, loanID)
+		}
+	}
+	for moveID, move := range r.moves {
+		if move.ItemID == id {
+			delete(r.moves, moveID)
+		}
+	}
+	for commentID, comment := range r.comments {
+		if comment.ItemID == id {
+			delete(r.comments, commentID)
+		}
+	}
+	for relationID, relation := range r.relations {
+		if relation.ItemID == id || relation.RelatedItemID == id {
+			delete(r.relations, relationID)
+		}
+	}
+	for valuationID, valuation := range r.valuations {
+		if valuation.ItemID == id {
+			delete(r.valuations, valuationID)
+		}
+	}
+	for saleID, sale := range r.sales {
+		if sale.ItemID == id {
+			delete(r.sales, saleID)
+		}
+	}
+	delete(r.insurances, id)
+	for key := range r.itemViews {
+		if key.itemID == id {
+			delete(r.itemViews, key)
+		}
+	}
+	for key := range r.favorites {
+		if key.itemID == id {
+			delete(r.favorites, key)
+		}
+	}
+
+	r.recordItemEvent(entity.EventItemDeleted, deleted)
+
+	return nil
+}
+
+func (r *ItemRepository) Merge(ctx context.Context, survivorID int64, duplicateIDs []int64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// 途中で失敗して中途半端に反映されないよう、先に全て存在することを確かめる
+	if _, exists := r.items[survivorID]; !exists {
+		return domainErrors.ErrItemNotFound
+	}
+	duplicates := make(map[int64]bool, len(duplicateIDs))
+	for _, id := range duplicateIDs {
+		if _, exists := r.items[id]; !exists {
+			return domainErrors.ErrItemNotFound
+		}
+		duplicates[id] = true
+	}
+
+	for _, loan := range r.loans {
+		if duplicates[loan.ItemID] {
+			loan.ItemID = survivorID
+		}
+	}
+	for _, move := range r.moves {
+		if duplicates[move.ItemID] {
+			move.ItemID = survivorID
+		}
+	}
+	for _, comment := range r.comments {
+		if duplicates[comment.ItemID] {
+			comment.ItemID = survivorID
+		}
+	}
+	for _, valuation := range r.valuations {
+		if duplicates[valuation.ItemID] {
+			valuation.ItemID = survivorID
+		}
+	}
+	for _, sale := range r.sales {
+		if duplicates[sale.ItemID] {
+			sale.ItemID = survivorID
+		}
+	}
+	// 保険は1つのアイテムに1件のため、残すアイテムの設定だけを残す
+	for _, id := range duplicateIDs {
+		delete(r.insurances, id)
+	}
+	for relationID, relation := range r.relations {
+		if duplicates[relation.ItemID] {
+			relation.ItemID = survivorID
+		}
+		if duplicates[relation.RelatedItemID] {
+			relation.RelatedItemID = survivorID
+		}
+		// 統合したアイテム同士の関連は自分自身との関連になるため消す
+		if relation.ItemID == relation.RelatedItemID {
+			delete(r.relations, relationID)
+		}
+	}
+
+	for _, id := range duplicateIDs {
+		deleted := r.copyItem(r.items[id])
+		delete(r.items, id)
+		r.recordItemEvent(entity.EventItemDeleted, deleted)
+	}
+	r.recordItemEvent(entity.EventItemUpdated, r.copyItem(r.items[survivorID]))
+
+	return nil
+}
+
+// メモリ上では統合したアイテムをすぐに削除するため、論理削除したアイテムは残らない
+func (r *ItemRepository) PurgeDeleted(ctx context.Context, before time.Time) (int, error) {
+	return 0, nil
+}
+
+// MySQL版と同じく監査ログを1件だけ記録し、アイテムごとのイベントは記録しない
+func (r *ItemRepository) ReassignCategory(ctx context.Context, from, to string) ([]int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var ids []int64
+	updatedAt := now()
+	for id, item := range r.items {
+		if item.Category == from {
+			item.Category = to
+			item.UpdatedAt = updatedAt
+			item.Version++
+			ids = append(ids, id)
+		}
+	}
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		return ids[i] < ids[j]
+	})
+
+	r.recordAuditEntry(entity.NewCategoryReassignedAudit(entity.CategoryReassignment{From: from, To: to, Affected: len(ids)}))
+
+	return ids, nil
+}
+
+func (r *ItemRepository) GetSummaryByCategory(ctx context.Context) (map[string]int, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	// MySQL版の集計テーブルと同じくアーカイブしたアイテムは数えない
+	summary := make(map[string]int)
+	for _, item := range r.items {
+		if !item.IsArchived() {
+			summary[item.Category]++
+		}
+	}
+
+	return summary, nil
+}
+
+func (r *ItemRepository) FindBrands(ctx context.Context) ([]string, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	seen := make(map[string]bool)
+	var brands []string
+	for _, item := range r.items {
+		if !seen[item.Brand] {
+			seen[item.Brand] = true
+			brands = append(brands, item.Brand)
+		}
+	}
+	sort.Strings(brands)
+	return brands, nil
+}
+
+func (r *ItemRepository) Suggest(ctx context.Context, prefix string, limit int) ([]entity.ItemSuggestion, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var suggestions []entity.ItemSuggestion
+	for _, field := range []string{entity.SuggestionFieldBrand, entity.SuggestionFieldName} {
+		seen := make(map[string]bool)
+		var values []string
+		for _, item := range r.items {
+			if item.IsArchived() {
+				continue
+			}
+			value := item.Brand
+			if field == entity.SuggestionFieldName {
+				value = item.Name
+			}
+			if !seen[value] && entity.MatchesSuggestionPrefix(value, prefix) {
+				seen[value] = true
+				values = append(values, value)
+			}
+		}
+		sort.Strings(values)
+		for _, value := range values[:min(limit, len(values))] {
+			suggestions = append(suggestions, entity.ItemSuggestion{Value: value, Field: field})
+		}
+	}
+	return suggestions, nil
+}
+
+func (r *ItemRepository) GetSummaryByCondition(ctx context.Context) (map[string]int, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	summary := make(map[string]int)
+	for _, item := range r.items {
+		if item.Condition != "" && !item.IsArchived() {
+			summary[item.Condition]++
+		}
+	}
+
+	return summary, nil
+}
+
+// 呼び出し側でロックを取っていること
+func (r *ItemRepository) matchesFilter(item *entity.Item, filter entity.ItemFilter) bool {
+	if filter.LocationID != nil && (item.LocationID == nil || *item.LocationID != *filter.LocationID) {
+		return false
+	}
+	if filter.Category != "" && item.Category != filter.Category {
+		return false
+	}
+	if filter.Brand != "" && item.Brand != filter.Brand {
+		return false
+	}
+	if filter.Condition != "" && item.Condition != filter.Condition {
+		return false
+	}
+	if filter.Favorites {
+		if _, favorite := r.favorites[itemViewKey{viewer: filter.Viewer, itemID: item.ID}]; !favorite {
+			return false
+		}
+	}
+	if !filter.IncludeArchived && item.IsArchived() {
+		return false
+	}
+	for key, value := range filter.Attributes {
+		if actual, exists := item.Attributes[key]; !exists || actual != value {
+			return false
+		}
+	}
+	for _, keyword := range filter.Keywords {
+		if !slices.ContainsFunc([]string{item.Name, item.Brand, item.Category, item.SerialNumber}, func(field string) bool {
+			return strings.Contains(strings.ToLower(field), strings.ToLower(keyword))
+		}) {
+			return false
+		}
+	}
+	return true
+}
+
+// 呼び出し側が変更しても保存している内容に影響しないよう複製を返す。貸出中かどうかは貸出から求める
+func (r *ItemRepository) copyItem(item *entity.Item) *entity.Item {
+	copied := cloneItem(item)
+	copied.OnLoan = false
+	for _, loan := range r.loans {
+		if loan.ItemID == item.ID && loan.ReturnedAt == nil {
+			copied.OnLoan = true
+			break
+		}
+	}
+	return copied
+}
+
+func cloneItem(item *entity.Item) *entity.Item {
+	copied := *item
+	if item.Attributes != nil {
+		copied.Attributes = make(map[string]string, len(item.Attributes))
+		for key, value := range item.Attributes {
+			copied.Attributes[key] = value
+		}
+	}
+	if item.LocationID != nil {
+		locationID := *item.LocationID
+		copied.LocationID = &locationID
+	}
+	if item.ArchivedAt != nil {
+		archivedAt := *item.ArchivedAt
+		copied.ArchivedAt = &archivedAt
+	}
+	if item.CurrentValue != nil {
+		currentValue := *item.CurrentValue
+		copied.CurrentValue = &currentValue
+	}
+	return &copied
+}
+
+// MySQL版と同じくアイテムの変更と同時にアウトボックスへ記録する
+func (r *ItemRepository) recordItemEvent(eventType string, item *entity.Item) {
+	payload, _ := json.Marshal(item)
+
+	id := r.nextID("outbox")
+	r.outbox[id] = &outboxRecord{
+		message: entity.OutboxMessage{
+			ID:          id,
+			EventType:   eventType,
+			AggregateID: item.ID,
+			Payload:     string(payload),
+			CreatedAt:   time.Now(),
+		},
+	}
+}
@@ -0,0 +1,410 @@
+package memory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"Aicon-assignment/internal/domain/entity"
+	domainErrors "Aicon-assignment/internal/domain/errors"
+)
+
+func newItem(t *testing.T, name, category string) *entity.Item {
+	item, err := entity.NewItem(name, category, "ROLEX", 1500000, "2023-01-15")
+	require.NoError(t, err)
+	return item
+}
+
+func TestItemRepository(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("正常系: 登録・取得・絞り込み・集計", func(t *testing.T) {
+		repo := &ItemRepository{Store: NewStore()}
+
+		watch := newItem(t, "ロレックス デイトナ", "時計")
+		watch.Condition = "A"
+		watch.Attributes = map[string]string{"movement": "automatic"}
+		created, err := repo.Create(ctx, watch)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), created.ID)
+
+		_, err = repo.Create(ctx, newItem(t, "エルメス バーキン", "バッグ"))
+		require.NoError(t, err)
+
+		// 返した値を変更しても保存内容は変わらない
+		created.Attributes["movement"] = "quartz"
+		found, err := repo.FindByID(ctx, 1)
+		require.NoError(t, err)
+		assert.Equal(t, "automatic", found.Attributes["movement"])
+
+		items, err := repo.FindAll(ctx, entity.ItemFilter{Attributes: map[string]string{"movement": "automatic"}})
+		require.NoError(t, err)
+		require.Len(t, items, 1)
+		assert.Equal(t, "ロレックス デイトナ", items[0].Name)
+
+		// 検索語はどのフィールドに含まれてもよく、大文字・小文字を区別しない
+		items, err = repo.FindAll(ctx, entity.ItemFilter{Keywords: []string{"rolex", "デイトナ"}})
+		require.NoError(t, err)
+		require.Len(t, items, 1)
+		assert.Equal(t, int64(1), items[0].ID)
+
+		count, err := repo.Count(ctx, entity.ItemFilter{Condition: "A"})
+		require.NoError(t, err)
+		assert.Equal(t, 1, count)
+
+		// お気に入りは閲覧者ごとに絞り込み、アイテムの版は変えない
+		favorites := &ItemFavoriteRepository{Store: repo.Store}
+		require.NoError(t, favorites.Add(ctx, entity.NewItemFavorite("admin", 1)))
+		items, err = repo.FindAll(ctx, entity.ItemFilter{Favorites: true, Viewer: "admin"})
+		require.NoError(t, err)
+		require.Len(t, items, 1)
+		assert.Equal(t, int64(1), items[0].ID)
+		assert.Equal(t, found.Version, items[0].Version)
+		items, err = repo.FindAll(ctx, entity.ItemFilter{Favorites: true})
+		require.NoError(t, err)
+		assert.Empty(t, items)
+		count, err = repo.Count(ctx, entity.ItemFilter{})
+		require.NoError(t, err)
+		assert.Equal(t, 2, count)
+
+		categories, err := repo.GetSummaryByCategory(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]int{"時計": 1, "バッグ": 1}, categories)
+
+		conditions, err := repo.GetSummaryByCondition(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]int{"A": 1}, conditions)
+
+		// アーカイブしたアイテムは一覧と集計から外れる
+		found.Archive()
+		require.NoError(t, repo.Update(ctx, found))
+		count, err = repo.Count(ctx, entity.ItemFilter{})
+		require.NoError(t, err)
+		assert.Equal(t, 1, count)
+		count, err = repo.Count(ctx, entity.ItemFilter{IncludeArchived: true})
+		require.NoError(t, err)
+		assert.Equal(t, 2, count)
+		categories, err = repo.GetSummaryByCategory(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]int{"バッグ": 1}, categories)
+	})
+
+	t.Run("正常系: 貸出中かどうかを貸出から求める", func(t *testing.T) {
+		store := NewStore()
+		repo := &ItemRepository{Store: store}
+		loanRepo := &LoanRepository{Store: store}
+		created, err := repo.Create(ctx, newItem(t, "ロレックス デイトナ", "時計"))
+		require.NoError(t, err)
+
+		loanedAt := created.UpdatedAt.Add(time.Minute)
+		loan, err := loanRepo.Create(ctx, &entity.Loan{ItemID: created.ID, Borrower: "山田", DueDate: "2099-01-01", LoanedAt: loanedAt})
+		require.NoError(t, err)
+
+		// 貸出状況が変わったため updated_at も進む
+		found, err := repo.FindByID(ctx, created.ID)
+		require.NoError(t, err)
+		assert.True(t, found.OnLoan)
+		assert.Equal(t, loanedAt, found.UpdatedAt)
+
+		returnedAt := time.Now()
+		loan.ReturnedAt = &returnedAt
+		require.NoError(t, loanRepo.Update(ctx, loan))
+
+		found, err = repo.FindByID(ctx, created.ID)
+		require.NoError(t, err)
+		assert.False(t, found.OnLoan)
+		assert.NotEqual(t, loanedAt, found.UpdatedAt)
+	})
+
+	t.Run("正常系: 統合で履歴を付け替えて重複を削除する", func(t *testing.T) {
+		store := NewStore()
+		repo := &ItemRepository{Store: store}
+		loanRepo := &LoanRepository{Store: store}
+		survivor, err := repo.Create(ctx, newItem(t, "ロレックス デイトナ", "時計"))
+		require.NoError(t, err)
+		duplicate, err := repo.Create(ctx, newItem(t, "ROLEX デイトナ", "時計"))
+		require.NoError(t, err)
+		loan, err := loanRepo.Create(ctx, &entity.Loan{ItemID: duplicate.ID, Borrower: "山田", DueDate: "2099-01-01", LoanedAt: time.Now()})
+		require.NoError(t, err)
+
+		require.NoError(t, repo.Merge(ctx, survivor.ID, []int64{duplicate.ID}))
+
+		_, err = repo.FindByID(ctx, duplicate.ID)
+		assert.ErrorIs(t, err, domainErrors.ErrItemNotFound)
+		moved, err := loanRepo.FindByID(ctx, loan.ID)
+		require.NoError(t, err)
+		assert.Equal(t, survivor.ID, moved.ItemID)
+	})
+
+	t.Run("正常系: 変更をアウトボックスに記録する", func(t *testing.T) {
+		store := NewStore()
+		repo := &ItemRepository{Store: store}
+		outboxRepo := &OutboxRepository{Store: store}
+		created, err := repo.Create(ctx, newItem(t, "ロレックス デイトナ", "時計"))
+		require.NoError(t, err)
+		require.NoError(t, repo.Delete(ctx, created.ID))
+
+		messages, err := outboxRepo.FindPending(ctx, 10)
+		require.NoError(t, err)
+		require.Len(t, messages, 2)
+		assert.Equal(t, entity.EventItemCreated, messages[0].EventType)
+		assert.Equal(t, entity.EventItemDeleted, messages[1].EventType)
+
+		require.NoError(t, outboxRepo.MarkPublished(ctx, messages[0].ID))
+		messages, err = outboxRepo.FindPending(ctx, 10)
+		require.NoError(t, err)
+		assert.Len(t, messages, 1)
+	})
+
+	t.Run("正常系: 売却をアウトボックスに記録する", func(t *testing.T) {
+		store := NewStore()
+		repo := &ItemRepository{Store: store}
+		saleRepo := &ItemSaleRepository{Store: store}
+		outboxRepo := &OutboxRepository{Store: store}
+		created, err := repo.Create(ctx, newItem(t, "ロレックス デイトナ", "時計"))
+		require.NoError(t, err)
+		sale, err := entity.NewItemSale(created, "2026-10-16", 2100000, 63000)
+		require.NoError(t, err)
+
+		_, err = saleRepo.Create(ctx, sale)
+		require.NoError(t, err)
+
+		messages, err := outboxRepo.FindPending(ctx, 10)
+		require.NoError(t, err)
+		require.Len(t, messages, 2)
+		assert.Equal(t, entity.EventItemSold, messages[1].EventType)
+		assert.Equal(t, created.ID, messages[1].AggregateID)
+	})
+
+	t.Run("正常系: カテゴリーを付け替えて監査ログを1件だけ記録する", func(t *testing.T) {
+		store := NewStore()
+		repo := &ItemRepository{Store: store}
+		outboxRepo := &OutboxRepository{Store: store}
+		auditRepo := &AuditLogRepository{Store: store}
+		first, err := repo.Create(ctx, newItem(t, "ロレックス デイトナ", "時計"))
+		require.NoError(t, err)
+		second, err := repo.Create(ctx, newItem(t, "オメガ スピードマスター", "時計"))
+		require.NoError(t, err)
+		_, err = repo.Create(ctx, newItem(t, "エルメス バーキン", "バッグ"))
+		require.NoError(t, err)
+		pending, err := outboxRepo.FindPending(ctx, 10)
+		require.NoError(t, err)
+
+		ids, err := repo.ReassignCategory(ctx, "時計", "ジュエリー")
+		require.NoError(t, err)
+		assert.Equal(t, []int64{first.ID, second.ID}, ids)
+
+		summary, err := repo.GetSummaryByCategory(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]int{"ジュエリー": 2, "バッグ": 1}, summary)
+
+		// アイテムごとのイベントは記録しない
+		messages, err := outboxRepo.FindPending(ctx, 10)
+		require.NoError(t, err)
+		assert.Len(t, messages, len(pending))
+
+		entries, err := auditRepo.FindRecent(ctx, 10)
+		require.NoError(t, err)
+		require.Len(t, entries, 1)
+		assert.Equal(t, entity.AuditCategoryReassigned, entries[0].Action)
+		assert.JSONEq(t, `{"from":"時計","to":"ジュエリー","affected":2}`, string(entries[0].Detail))
+
+		// 該当するアイテムがなければ何も記録しない
+		ids, err = repo.ReassignCategory(ctx, "時計", "靴")
+		require.NoError(t, err)
+		assert.Empty(t, ids)
+		entries, err = auditRepo.FindRecent(ctx, 10)
+		require.NoError(t, err)
+		assert.Len(t, entries, 1)
+	})
+
+	t.Run("正常系: 評価額の変更と履歴をブランドで絞り込んで記録し、削除で履歴も消す", func(t *testing.T) {
+		store := NewStore()
+		repo := &ItemRepository{Store: store}
+		valuationRepo := &ItemValuationRepository{Store: store}
+		rolex, err := repo.Create(ctx, newItem(t, "ロレックス デイトナ", "時計"))
+		require.NoError(t, err)
+		omega := newItem(t, "オメガ スピードマスター", "時計")
+		omega.Brand = "OMEGA"
+		_, err = repo.Create(ctx, omega)
+		require.NoError(t, err)
+
+		items, err := repo.FindAll(ctx, entity.ItemFilter{Brand: "ROLEX"})
+		require.NoError(t, err)
+		require.Len(t, items, 1)
+
+		valuation := items[0].Revalue(1620000)
+		require.NoError(t, repo.Update(ctx, items[0]))
+		_, err = valuationRepo.Create(ctx, valuation)
+		require.NoError(t, err)
+
+		found, err := repo.FindByID(ctx, rolex.ID)
+		require.NoError(t, err)
+		require.NotNil(t, found.CurrentValue)
+		assert.Equal(t, 1620000, *found.CurrentValue)
+		valuations, err := valuationRepo.FindByItemID(ctx, rolex.ID)
+		require.NoError(t, err)
+		require.Len(t, valuations, 1)
+		assert.Equal(t, 1500000, valuations[0].PreviousValue)
+
+		require.NoError(t, repo.Delete(ctx, rolex.ID))
+		valuations, err = valuationRepo.FindByItemID(ctx, rolex.ID)
+		require.NoError(t, err)
+		assert.Empty(t, valuations)
+	})
+
+	t.Run("正常系: 売却日で売却の記録を絞り込み、削除で記録も消す", func(t *testing.T) {
+		store := NewStore()
+		repo := &ItemRepository{Store: store}
+		saleRepo := &ItemSaleRepository{Store: store}
+		rolex, err := repo.Create(ctx, newItem(t, "ロレックス デイトナ", "時計"))
+		require.NoError(t, err)
+		omega, err := repo.Create(ctx, newItem(t, "オメガ スピードマスター", "時計"))
+		require.NoError(t, err)
+
+		for _, s := range []struct {
+			item   *entity.Item
+			soldOn string
+		}{{rolex, "2024-12-31"}, {omega, "2025-01-01"}} {
+			sale, err := entity.NewItemSale(s.item, s.soldOn, 2100000, 63000)
+			require.NoError(t, err)
+			_, err = saleRepo.Create(ctx, sale)
+			require.NoError(t, err)
+		}
+
+		sales, err := saleRepo.FindSoldBetween(ctx, "2024-01-01", "2024-12-31")
+		require.NoError(t, err)
+		require.Len(t, sales, 1)
+		assert.Equal(t, rolex.ID, sales[0].ItemID)
+		assert.Equal(t, "ロレックス デイトナ", sales[0].ItemName)
+
+		require.NoError(t, saleRepo.DeleteByItemID(ctx, rolex.ID))
+		assert.ErrorIs(t, saleRepo.DeleteByItemID(ctx, rolex.ID), domainErrors.ErrSaleNotFound)
+
+		require.NoError(t, repo.Delete(ctx, omega.ID))
+		sales, err = saleRepo.FindByItemID(ctx, omega.ID)
+		require.NoError(t, err)
+		assert.Empty(t, sales)
+	})
+
+	t.Run("正常系: 保険の設定を置き換え、統合では残すアイテムの設定だけを残す", func(t *testing.T) {
+		store := NewStore()
+		repo := &ItemRepository{Store: store}
+		insuranceRepo := &ItemInsuranceRepository{Store: store}
+		survivor, err := repo.Create(ctx, newItem(t, "ロレックス デイトナ", "時計"))
+		require.NoError(t, err)
+		duplicate, err := repo.Create(ctx, newItem(t, "ロレックス デイトナ", "時計"))
+		require.NoError(t, err)
+
+		_, err = insuranceRepo.Save(ctx, &entity.ItemInsurance{ItemID: survivor.ID, Insurer: "東京海上日動", PolicyNumber: "A-1", Photos: []string{}})
+		require.NoError(t, err)
+		saved, err := insuranceRepo.Save(ctx, &entity.ItemInsurance{ItemID: survivor.ID, Insurer: "東京海上日動", PolicyNumber: "B-200", Photos: []string{"daytona.jpg"}})
+		require.NoError(t, err)
+		assert.Equal(t, "B-200", saved.PolicyNumber)
+		_, err = insuranceRepo.Save(ctx, &entity.ItemInsurance{ItemID: duplicate.ID, Insurer: "AIG損保", PolicyNumber: "C-3", Photos: []string{}})
+		require.NoError(t, err)
+		_, err = insuranceRepo.Save(ctx, &entity.ItemInsurance{ItemID: 999, Insurer: "AIG損保", PolicyNumber: "C-3"})
+		assert.ErrorIs(t, err, domainErrors.ErrItemNotFound)
+
+		require.NoError(t, repo.Merge(ctx, survivor.ID, []int64{duplicate.ID}))
+		insurances, err := insuranceRepo.FindAll(ctx)
+		require.NoError(t, err)
+		require.Len(t, insurances, 1)
+		assert.Equal(t, survivor.ID, insurances[0].ItemID)
+		assert.Equal(t, []string{"daytona.jpg"}, insurances[0].Photos)
+	})
+
+	t.Run("正常系: 閲覧の記録は閲覧者とアイテムごとに1件にし、上限を超えた古いものとアイテムの削除で消す", func(t *testing.T) {
+		store := NewStore()
+		repo := &ItemRepository{Store: store}
+		viewRepo := &ItemViewRepository{Store: store}
+		var ids []int64
+		for _, name := range []string{"ロレックス デイトナ", "オメガ スピードマスター", "カルティエ タンク"} {
+			item, err := repo.Create(ctx, newItem(t, name, "時計"))
+			require.NoError(t, err)
+			ids = append(ids, item.ID)
+		}
+
+		for _, id := range []int64{ids[0], ids[1], ids[0]} {
+			require.NoError(t, viewRepo.Save(ctx, entity.NewItemView("admin", id), 2))
+		}
+		require.NoError(t, viewRepo.Save(ctx, entity.NewItemView("", ids[2]), 2))
+		assert.ErrorIs(t, viewRepo.Save(ctx, entity.NewItemView("admin", 999), 2), domainErrors.ErrItemNotFound)
+
+		views, err := viewRepo.FindRecentByViewer(ctx, "admin", 10)
+		require.NoError(t, err)
+		require.Len(t, views, 2)
+		assert.ElementsMatch(t, []int64{ids[0], ids[1]}, []int64{views[0].ItemID, views[1].ItemID})
+
+		// 上限を超えると古いものから消す（同じ秒の記録はIDの小さいアイテムを古いとみなす）
+		require.NoError(t, viewRepo.Save(ctx, entity.NewItemView("admin", ids[2]), 2))
+		views, err = viewRepo.FindRecentByViewer(ctx, "admin", 10)
+		require.NoError(t, err)
+		assert.Len(t, views, 2)
+
+		require.NoError(t, repo.Delete(ctx, ids[2]))
+		views, err = viewRepo.FindRecentByViewer(ctx, "admin", 10)
+		require.NoError(t, err)
+		assert.Len(t, views, 1)
+		views, err = viewRepo.FindRecentByViewer(ctx, "", 10)
+		require.NoError(t, err)
+		assert.Empty(t, views)
+	})
+
+	t.Run("正常系: 語の先頭に一致する名前とブランドを入力補完の候補にする", func(t *testing.T) {
+		repo := &ItemRepository{Store: NewStore()}
+		_, err := repo.Create(ctx, newItem(t, "ロレックス Daytona", "時計"))
+		require.NoError(t, err)
+		_, err = repo.Create(ctx, newItem(t, "ロレックス Daytona", "時計"))
+		require.NoError(t, err)
+		archived := newItem(t, "Day-Date", "時計")
+		archived.Archive()
+		_, err = repo.Create(ctx, archived)
+		require.NoError(t, err)
+
+		suggestions, err := repo.Suggest(ctx, "DAY", 10)
+		require.NoError(t, err)
+		assert.Equal(t, []entity.ItemSuggestion{{Value: "ロレックス Daytona", Field: entity.SuggestionFieldName}}, suggestions)
+
+		suggestions, err = repo.Suggest(ctx, "ro", 10)
+		require.NoError(t, err)
+		assert.Equal(t, []entity.ItemSuggestion{{Value: "ROLEX", Field: entity.SuggestionFieldBrand}}, suggestions)
+	})
+
+	t.Run("異常系: 読んだ後に他で更新された", func(t *testing.T) {
+		repo := &ItemRepository{Store: NewStore()}
+		created, err := repo.Create(ctx, newItem(t, "ロレックス デイトナ", "時計"))
+		require.NoError(t, err)
+		assert.Equal(t, 1, created.Version)
+
+		first, err := repo.FindByID(ctx, created.ID)
+		require.NoError(t, err)
+		second, err := repo.FindByID(ctx, created.ID)
+		require.NoError(t, err)
+
+		first.PurchasePrice = 1600000
+		require.NoError(t, repo.Update(ctx, first))
+		assert.Equal(t, 2, first.Version)
+
+		second.PurchasePrice = 1700000
+		assert.ErrorIs(t, repo.Update(ctx, second), domainErrors.ErrVersionConflict)
+
+		stored, err := repo.FindByID(ctx, created.ID)
+		require.NoError(t, err)
+		assert.Equal(t, 1600000, stored.PurchasePrice)
+		assert.Equal(t, 2, stored.Version)
+	})
+
+	t.Run("異常系: 存在しないアイテム", func(t *testing.T) {
+		repo := &ItemRepository{Store: NewStore()}
+
+		_, err := repo.FindByID(ctx, 999)
+		assert.ErrorIs(t, err, domainErrors.ErrItemNotFound)
+		assert.ErrorIs(t, repo.Update(ctx, &entity.Item{ID: 999}), domainErrors.ErrItemNotFound)
+		assert.ErrorIs(t, repo.Delete(ctx, 999), domainErrors.ErrItemNotFound)
+		assert.ErrorIs(t, repo.Merge(ctx, 999, []int64{998}), domainErrors.ErrItemNotFound)
+	})
+}
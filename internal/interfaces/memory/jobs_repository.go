@@ -0,0 +1,179 @@
+package memory
+
+import (
+	"context"
+	"slices"
+	"sort"
+	"time"
+
+	"Aicon-assignment/internal/domain/entity"
+	domainErrors "Aicon-assignment/internal/domain/errors"
+)
+
+type JobRepository struct {
+	*Store
+}
+
+func (r *JobRepository) Create(ctx context.Context, job *entity.Job) (*entity.Job, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored := &jobRecord{job: entity.Job{
+		ID:          r.nextID("jobs"),
+		Type:        job.Type,
+		Payload:     job.Payload,
+		Status:      entity.JobPending,
+		MaxAttempts: job.MaxAttempts,
+		RunAt:       job.RunAt.Truncate(time.Second),
+		CreatedAt:   now(),
+	}}
+	r.jobs[stored.job.ID] = stored
+
+	return cloneJob(&stored.job), nil
+}
+
+func (r *JobRepository) FindByID(ctx context.Context, id int64) (*entity.Job, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	stored, exists := r.jobs[id]
+	if !exists {
+		return nil, domainErrors.ErrJobNotFound
+	}
+
+	return cloneJob(&stored.job), nil
+}
+
+func (r *JobRepository) FindByStatus(ctx context.Context, statuses []string, limit int) ([]*entity.Job, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	jobs := []*entity.Job{}
+	for _, stored := range r.jobs {
+		if slices.Contains(statuses, stored.job.Status) {
+			jobs = append(jobs, cloneJob(&stored.job))
+		}
+	}
+
+	sort.Slice(jobs, func(i, j int) bool { return jobs[i].ID > jobs[j].ID })
+	if len(jobs) > limit {
+		jobs = jobs[:limit]
+	}
+
+	return jobs, nil
+}
+
+func (r *JobRepository) Claim(ctx context.Context, now, lockedUntil time.Time, limit int) ([]*entity.Job, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var due []*jobRecord
+	for _, stored := range r.jobs {
+		pending := stored.job.Status == entity.JobPending && !stored.job.RunAt.After(now)
+		abandoned := stored.job.Status == entity.JobRunning && stored.lockedUntil.Before(now)
+		if pending || abandoned {
+			due = append(due, stored)
+		}
+	}
+
+	sort.Slice(due, func(i, j int) bool {
+		if !due[i].job.RunAt.Equal(due[j].job.RunAt) {
+			return due[i].job.RunAt.Before(due[j].job.RunAt)
+		}
+		return due[i].job.ID < due[j].job.ID
+	})
+	if len(due) > limit {
+		due = due[:limit]
+	}
+
+	claimed := make([]*entity.Job, 0, len(due))
+	for _, stored := range due {
+		stored.job.Status = entity.JobRunning
+		stored.job.Attempts++
+		stored.lockedUntil = lockedUntil
+		claimed = append(claimed, cloneJob(&stored.job))
+	}
+
+	return claimed, nil
+}
+
+func (r *JobRepository) Complete(ctx context.Context, id int64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if stored, exists := r.jobs[id]; exists {
+		finishedAt := now()
+		stored.job.Status = entity.JobSucceeded
+		stored.job.FinishedAt = &finishedAt
+		stored.lockedUntil = time.Time{}
+	}
+
+	return nil
+}
+
+func (r *JobRepository) Fail(ctx context.Context, id int64, reason string, retryAt *time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored, exists := r.jobs[id]
+	if !exists {
+		return nil
+	}
+
+	stored.job.LastError = reason
+	stored.lockedUntil = time.Time{}
+	if retryAt != nil {
+		stored.job.Status = entity.JobPending
+		stored.job.RunAt = retryAt.Truncate(time.Second)
+	} else {
+		finishedAt := now()
+		stored.job.Status = entity.JobFailed
+		stored.job.FinishedAt = &finishedAt
+	}
+
+	return nil
+}
+
+func (r *JobRepository) Retry(ctx context.Context, id int64) (*entity.Job, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored, exists := r.jobs[id]
+	if !exists {
+		return nil, domainErrors.ErrJobNotFound
+	}
+	if stored.job.Status != entity.JobFailed {
+		return nil, domainErrors.ErrJobNotFailed
+	}
+
+	stored.job.Status = entity.JobPending
+	stored.job.Attempts = 0
+	stored.job.RunAt = now()
+	stored.job.FinishedAt = nil
+
+	return cloneJob(&stored.job), nil
+}
+
+func (r *JobRepository) DeleteSucceeded(ctx context.Context, before time.Time) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	deleted := 0
+	for id, stored := range r.jobs {
+		if stored.job.Status == entity.JobSucceeded && stored.job.FinishedAt.Before(before) {
+			delete(r.jobs, id)
+			deleted++
+		}
+	}
+
+	return deleted, nil
+}
+
+func cloneJob(job *entity.Job) *entity.Job {
+	copied := *job
+	if job.FinishedAt != nil {
+		finishedAt := *job.FinishedAt
+		copied.FinishedAt = &finishedAt
+	}
+	return &copied
+}
@@ -0,0 +1,119 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"Aicon-assignment/internal/domain/entity"
+	domainErrors "Aicon-assignment/internal/domain/errors"
+)
+
+type LoanRepository struct {
+	*Store
+}
+
+func (r *LoanRepository) FindByID(ctx context.Context, id int64) (*entity.Loan, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	loan, exists := r.loans[id]
+	if !exists {
+		return nil, domainErrors.ErrLoanNotFound
+	}
+
+	return cloneLoan(loan), nil
+}
+
+func (r *LoanRepository) FindActiveByItemID(ctx context.Context, itemID int64) (*entity.Loan, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var active *entity.Loan
+	for _, loan := range r.loans {
+		if loan.ItemID != itemID || loan.ReturnedAt != nil {
+			continue
+		}
+		if active == nil || loan.LoanedAt.After(active.LoanedAt) {
+			active = loan
+		}
+	}
+
+	if active == nil {
+		return nil, domainErrors.ErrLoanNotFound
+	}
+
+	return cloneLoan(active), nil
+}
+
+func (r *LoanRepository) FindOverdue(ctx context.Context, today string) ([]*entity.Loan, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var loans []*entity.Loan
+	for _, loan := range r.loans {
+		// YYYY-MM-DD 形式なので文字列の比較で日付順になる
+		if loan.ReturnedAt == nil && loan.DueDate < today {
+			loans = append(loans, cloneLoan(loan))
+		}
+	}
+
+	sort.Slice(loans, func(i, j int) bool {
+		if loans[i].DueDate != loans[j].DueDate {
+			return loans[i].DueDate < loans[j].DueDate
+		}
+		return loans[i].ID < loans[j].ID
+	})
+
+	return loans, nil
+}
+
+func (r *LoanRepository) Create(ctx context.Context, loan *entity.Loan) (*entity.Loan, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// 外部キー制約の代わり
+	if _, exists := r.items[loan.ItemID]; !exists {
+		return nil, domainErrors.ErrItemNotFound
+	}
+
+	stored := cloneLoan(loan)
+	stored.ID = r.nextID("loans")
+	r.loans[stored.ID] = stored
+	r.items[loan.ItemID].UpdatedAt = loan.LoanedAt
+
+	return cloneLoan(stored), nil
+}
+
+func (r *LoanRepository) Update(ctx context.Context, loan *entity.Loan) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored, exists := r.loans[loan.ID]
+	if !exists {
+		return domainErrors.ErrLoanNotFound
+	}
+
+	stored.Borrower = loan.Borrower
+	stored.DueDate = loan.DueDate
+	stored.ReturnedAt = nil
+	if loan.ReturnedAt != nil {
+		returnedAt := *loan.ReturnedAt
+		stored.ReturnedAt = &returnedAt
+	}
+	// 貸出状況（on_loan）が変わったため、アイテムの updated_at を進める
+	if item, exists := r.items[stored.ItemID]; exists {
+		item.UpdatedAt = time.Now()
+	}
+
+	return nil
+}
+
+func cloneLoan(loan *entity.Loan) *entity.Loan {
+	copied := *loan
+	if loan.ReturnedAt != nil {
+		returnedAt := *loan.ReturnedAt
+		copied.ReturnedAt = &returnedAt
+	}
+	return &copied
+}
@@ -0,0 +1,143 @@
+package memory
+
+import (
+	"context"
+	"sort"
+
+	"Aicon-assignment/internal/domain/entity"
+	domainErrors "Aicon-assignment/internal/domain/errors"
+)
+
+type LocationRepository struct {
+	*Store
+}
+
+func (r *LocationRepository) FindAll(ctx context.Context) ([]*entity.Location, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var locations []*entity.Location
+	for _, location := range r.locations {
+		copied := *location
+		locations = append(locations, &copied)
+	}
+
+	sort.Slice(locations, func(i, j int) bool {
+		return locations[i].Name < locations[j].Name
+	})
+
+	return locations, nil
+}
+
+func (r *LocationRepository) FindByID(ctx context.Context, id int64) (*entity.Location, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	location, exists := r.locations[id]
+	if !exists {
+		return nil, domainErrors.ErrLocationNotFound
+	}
+
+	copied := *location
+	return &copied, nil
+}
+
+func (r *LocationRepository) Create(ctx context.Context, location *entity.Location) (*entity.Location, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored := &entity.Location{
+		ID:          r.nextID("locations"),
+		Name:        location.Name,
+		Description: location.Description,
+		CreatedAt:   now(),
+	}
+	stored.UpdatedAt = stored.CreatedAt
+	r.locations[stored.ID] = stored
+
+	copied := *stored
+	return &copied, nil
+}
+
+func (r *LocationRepository) Update(ctx context.Context, location *entity.Location) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored, exists := r.locations[location.ID]
+	if !exists {
+		return domainErrors.ErrLocationNotFound
+	}
+
+	stored.Name = location.Name
+	stored.Description = location.Description
+	stored.UpdatedAt = location.UpdatedAt
+
+	return nil
+}
+
+func (r *LocationRepository) Delete(ctx context.Context, id int64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.locations[id]; !exists {
+		return domainErrors.ErrLocationNotFound
+	}
+	delete(r.locations, id)
+
+	// ON DELETE SET NULL と同じく保管場所の設定を解除する
+	for _, item := range r.items {
+		if item.LocationID != nil && *item.LocationID == id {
+			item.LocationID = nil
+		}
+	}
+
+	return nil
+}
+
+func (r *LocationRepository) CreateMove(ctx context.Context, move *entity.LocationMove) (*entity.LocationMove, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.items[move.ItemID]; !exists {
+		return nil, domainErrors.ErrItemNotFound
+	}
+
+	move.ID = r.nextID("item_location_history")
+	r.moves[move.ID] = cloneMove(move)
+
+	return move, nil
+}
+
+func (r *LocationRepository) FindMovesByItemID(ctx context.Context, itemID int64) ([]*entity.LocationMove, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var moves []*entity.LocationMove
+	for _, move := range r.moves {
+		if move.ItemID == itemID {
+			moves = append(moves, cloneMove(move))
+		}
+	}
+
+	sort.Slice(moves, func(i, j int) bool {
+		if !moves[i].MovedAt.Equal(moves[j].MovedAt) {
+			return moves[i].MovedAt.After(moves[j].MovedAt)
+		}
+		return moves[i].ID > moves[j].ID
+	})
+
+	return moves, nil
+}
+
+func cloneMove(move *entity.LocationMove) *entity.LocationMove {
+	copied := *move
+	if move.FromLocationID != nil {
+		fromLocationID := *move.FromLocationID
+		copied.FromLocationID = &fromLocationID
+	}
+	if move.ToLocationID != nil {
+		toLocationID := *move.ToLocationID
+		copied.ToLocationID = &toLocationID
+	}
+	return &copied
+}
@@ -0,0 +1,45 @@
+package memory
+
+import (
+	"context"
+	"sort"
+
+	"Aicon-assignment/internal/domain/entity"
+)
+
+type NotificationPreferenceRepository struct {
+	*Store
+}
+
+func (r *NotificationPreferenceRepository) FindAll(ctx context.Context) ([]*entity.NotificationPreference, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var prefs []*entity.NotificationPreference
+	for _, pref := range r.notificationPreferences {
+		copied := *pref
+		prefs = append(prefs, &copied)
+	}
+
+	sort.Slice(prefs, func(i, j int) bool {
+		if prefs[i].Type != prefs[j].Type {
+			return prefs[i].Type < prefs[j].Type
+		}
+		return prefs[i].Name < prefs[j].Name
+	})
+
+	return prefs, nil
+}
+
+func (r *NotificationPreferenceRepository) Save(ctx context.Context, pref *entity.NotificationPreference) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.notificationPreferences[pref.Type+"/"+pref.Name] = &entity.NotificationPreference{
+		Type:      pref.Type,
+		Name:      pref.Name,
+		Enabled:   pref.Enabled,
+		UpdatedAt: now(),
+	}
+	return nil
+}
@@ -0,0 +1,102 @@
+package memory
+
+import (
+	"context"
+	"slices"
+	"sort"
+
+	"Aicon-assignment/internal/domain/entity"
+)
+
+// MySQL版と同じく配信に失敗し続けるメッセージはこの回数で諦める
+const maxOutboxAttempts = 5
+
+type OutboxRepository struct {
+	*Store
+}
+
+func (r *OutboxRepository) FindPending(ctx context.Context, limit int) ([]*entity.OutboxMessage, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var messages []*entity.OutboxMessage
+	for _, record := range r.outbox {
+		if !record.published && record.message.Attempts < maxOutboxAttempts {
+			message := record.message
+			messages = append(messages, &message)
+		}
+	}
+
+	sort.Slice(messages, func(i, j int) bool {
+		return messages[i].ID < messages[j].ID
+	})
+
+	if len(messages) > limit {
+		messages = messages[:limit]
+	}
+
+	return messages, nil
+}
+
+// 配信済みのメッセージもアクティビティとして読むため残しておく
+func (r *OutboxRepository) MarkPublished(ctx context.Context, id int64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if record, exists := r.outbox[id]; exists {
+		record.message.Attempts++
+		record.published = true
+	}
+
+	return nil
+}
+
+func (r *OutboxRepository) MarkFailed(ctx context.Context, id int64, reason string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if record, exists := r.outbox[id]; exists {
+		record.message.Attempts++
+		record.lastError = reason
+	}
+
+	return nil
+}
+
+func (r *OutboxRepository) FindRecent(ctx context.Context, eventTypes []string, limit int) ([]*entity.OutboxMessage, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	messages := []*entity.OutboxMessage{}
+	for _, record := range r.outbox {
+		if slices.Contains(eventTypes, record.message.EventType) {
+			message := record.message
+			messages = append(messages, &message)
+		}
+	}
+
+	sort.Slice(messages, func(i, j int) bool {
+		return messages[i].ID > messages[j].ID
+	})
+
+	if len(messages) > limit {
+		messages = messages[:limit]
+	}
+
+	return messages, nil
+}
+
+func (r *OutboxRepository) FindPrevious(ctx context.Context, aggregateID, beforeID int64) (*entity.OutboxMessage, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var previous *entity.OutboxMessage
+	for _, record := range r.outbox {
+		message := record.message
+		if message.AggregateID == aggregateID && message.ID < beforeID && (previous == nil || message.ID > previous.ID) {
+			previous = &message
+		}
+	}
+
+	return previous, nil
+}
@@ -0,0 +1,87 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"Aicon-assignment/internal/domain/entity"
+	domainErrors "Aicon-assignment/internal/domain/errors"
+)
+
+type ShareLinkRepository struct {
+	*Store
+}
+
+func (r *ShareLinkRepository) FindByItemID(ctx context.Context, itemID int64) ([]*entity.ShareLink, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	links := []*entity.ShareLink{}
+	for _, link := range r.shareLinks {
+		if link.ItemID == itemID {
+			links = append(links, cloneShareLink(link))
+		}
+	}
+
+	sort.Slice(links, func(i, j int) bool {
+		return links[i].ID > links[j].ID
+	})
+
+	return links, nil
+}
+
+func (r *ShareLinkRepository) FindByTokenHash(ctx context.Context, tokenHash string) (*entity.ShareLink, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, link := range r.shareLinks {
+		if link.TokenHash == tokenHash {
+			return cloneShareLink(link), nil
+		}
+	}
+
+	return nil, domainErrors.ErrShareLinkNotFound
+}
+
+func (r *ShareLinkRepository) Create(ctx context.Context, link *entity.ShareLink) (*entity.ShareLink, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.items[link.ItemID]; !exists {
+		return nil, domainErrors.ErrItemNotFound
+	}
+
+	stored := cloneShareLink(link)
+	stored.ID = r.nextID("share_links")
+	stored.ExpiresAt = link.ExpiresAt.Truncate(time.Second)
+	stored.RevokedAt = nil
+	stored.CreatedAt = now()
+	r.shareLinks[stored.ID] = stored
+
+	return cloneShareLink(stored), nil
+}
+
+func (r *ShareLinkRepository) Revoke(ctx context.Context, itemID, id int64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored, exists := r.shareLinks[id]
+	if !exists || stored.ItemID != itemID || stored.RevokedAt != nil {
+		return domainErrors.ErrShareLinkNotFound
+	}
+
+	revokedAt := now()
+	stored.RevokedAt = &revokedAt
+	return nil
+}
+
+func cloneShareLink(link *entity.ShareLink) *entity.ShareLink {
+	copied := *link
+	copied.Fields = append([]string(nil), link.Fields...)
+	if link.RevokedAt != nil {
+		revokedAt := *link.RevokedAt
+		copied.RevokedAt = &revokedAt
+	}
+	return &copied
+}
@@ -0,0 +1,174 @@
+package memory
+
+import (
+	"sync"
+	"time"
+
+	"Aicon-assignment/internal/domain/entity"
+)
+
+// メモリ上のデータ置き場。同じStoreを共有するリポジトリ間で、DBの外部キーと同じように削除や統合が連動する
+// データベースなしでデモやテストを動かすためのもので、プロセスが終わると内容は消える
+type Store struct {
+	mu sync.RWMutex
+
+	// Transactor.WithTx を1つずつ実行するためのロック
+	txMu sync.Mutex
+
+	lastIDs map[string]int64
+
+	items      map[int64]*entity.Item
+	loans      map[int64]*entity.Loan
+	locations  map[int64]*entity.Location
+	moves      map[int64]*entity.LocationMove
+	comments   map[int64]*entity.ItemComment
+	relations  map[int64]*entity.ItemRelation
+	valuations map[int64]*entity.ItemValuation
+	sales      map[int64]*entity.ItemSale
+	insurances map[int64]*entity.ItemInsurance
+	templates  map[int64]*entity.ItemTemplate
+	webhooks   map[int64]*entity.Webhook
+	deliveries map[int64]*entity.WebhookDelivery
+	outbox     map[int64]*outboxRecord
+	auditLog   map[int64]*entity.AuditEntry
+
+	// トランザクションの中では変更しないため、ロールバック用の複製には含めない
+	featureFlags map[string]*entity.FeatureFlag
+	erasures     map[int64]*entity.Erasure
+	shareLinks   map[int64]*entity.ShareLink
+	jobs         map[int64]*jobRecord
+	itemViews    map[itemViewKey]*entity.ItemView
+	favorites    map[itemViewKey]*entity.ItemFavorite
+
+	// 種類と名前を "/" でつないだキー
+	notificationPreferences map[string]*entity.NotificationPreference
+}
+
+type outboxRecord struct {
+	message   entity.OutboxMessage
+	lastError string
+	published bool
+}
+
+// 閲覧の記録とお気に入りは閲覧者とアイテムの組ごとに1件
+type itemViewKey struct {
+	viewer string
+	itemID int64
+}
+
+type jobRecord struct {
+	job         entity.Job
+	lockedUntil time.Time
+}
+
+func NewStore() *Store {
+	return &Store{
+		lastIDs:    make(map[string]int64),
+		items:      make(map[int64]*entity.Item),
+		loans:      make(map[int64]*entity.Loan),
+		locations:  make(map[int64]*entity.Location),
+		moves:      make(map[int64]*entity.LocationMove),
+		comments:   make(map[int64]*entity.ItemComment),
+		relations:  make(map[int64]*entity.ItemRelation),
+		valuations: make(map[int64]*entity.ItemValuation),
+		sales:      make(map[int64]*entity.ItemSale),
+		insurances: make(map[int64]*entity.ItemInsurance),
+		templates:  make(map[int64]*entity.ItemTemplate),
+		webhooks:   make(map[int64]*entity.Webhook),
+		deliveries: make(map[int64]*entity.WebhookDelivery),
+		outbox:     make(map[int64]*outboxRecord),
+		auditLog:   make(map[int64]*entity.AuditEntry),
+
+		featureFlags: make(map[string]*entity.FeatureFlag),
+		erasures:     make(map[int64]*entity.Erasure),
+		shareLinks:   make(map[int64]*entity.ShareLink),
+		jobs:         make(map[int64]*jobRecord),
+		itemViews:    make(map[itemViewKey]*entity.ItemView),
+		favorites:    make(map[itemViewKey]*entity.ItemFavorite),
+
+		notificationPreferences: make(map[string]*entity.NotificationPreference),
+	}
+}
+
+// AUTO_INCREMENTの代わり（呼び出し側でロックを取っていること）
+func (s *Store) nextID(table string) int64 {
+	s.lastIDs[table]++
+	return s.lastIDs[table]
+}
+
+// DBのTIMESTAMPと同じく秒未満を切り捨てる
+func now() time.Time {
+	return time.Now().Truncate(time.Second)
+}
+
+// 全テーブルの複製（Transactorのロールバック用。呼び出し側でロックを取っていること）
+type snapshot struct {
+	lastIDs    map[string]int64
+	items      map[int64]*entity.Item
+	loans      map[int64]*entity.Loan
+	locations  map[int64]*entity.Location
+	moves      map[int64]*entity.LocationMove
+	comments   map[int64]*entity.ItemComment
+	relations  map[int64]*entity.ItemRelation
+	valuations map[int64]*entity.ItemValuation
+	sales      map[int64]*entity.ItemSale
+	insurances map[int64]*entity.ItemInsurance
+	templates  map[int64]*entity.ItemTemplate
+	webhooks   map[int64]*entity.Webhook
+	deliveries map[int64]*entity.WebhookDelivery
+	outbox     map[int64]*outboxRecord
+	auditLog   map[int64]*entity.AuditEntry
+}
+
+func (s *Store) snapshot() *snapshot {
+	lastIDs := make(map[string]int64, len(s.lastIDs))
+	for table, id := range s.lastIDs {
+		lastIDs[table] = id
+	}
+
+	return &snapshot{
+		lastIDs:    lastIDs,
+		items:      copyTable(s.items),
+		loans:      copyTable(s.loans),
+		locations:  copyTable(s.locations),
+		moves:      copyTable(s.moves),
+		comments:   copyTable(s.comments),
+		relations:  copyTable(s.relations),
+		valuations: copyTable(s.valuations),
+		sales:      copyTable(s.sales),
+		insurances: copyTable(s.insurances),
+		templates:  copyTable(s.templates),
+		webhooks:   copyTable(s.webhooks),
+		deliveries: copyTable(s.deliveries),
+		outbox:     copyTable(s.outbox),
+		auditLog:   copyTable(s.auditLog),
+	}
+}
+
+func (s *Store) restore(snap *snapshot) {
+	s.lastIDs = snap.lastIDs
+	s.items = snap.items
+	s.loans = snap.loans
+	s.locations = snap.locations
+	s.moves = snap.moves
+	s.comments = snap.comments
+	s.relations = snap.relations
+	s.valuations = snap.valuations
+	s.sales = snap.sales
+	s.insurances = snap.insurances
+	s.templates = snap.templates
+	s.webhooks = snap.webhooks
+	s.deliveries = snap.deliveries
+	s.outbox = snap.outbox
+	s.auditLog = snap.auditLog
+}
+
+// 行をその場で書き換える処理（統合時の付け替えなど）があるので、行ごとに複製する
+func copyTable[T any](table map[int64]*T) map[int64]*T {
+	copied := make(map[int64]*T, len(table))
+	for id, row := range table {
+		c := *row
+		copied[id] = &c
+	}
+	return copied
+}
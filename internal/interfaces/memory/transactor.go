@@ -0,0 +1,47 @@
+package memory
+
+import (
+	"context"
+
+	"Aicon-assignment/internal/usecase"
+)
+
+// トランザクションの代わりに、fn が失敗した場合は開始時点の内容に戻す
+// WithTx は1つずつ実行するが、実行中に他のリポジトリから書き込まれた内容も一緒に戻るため、デモやテスト用
+type Transactor struct {
+	*Store
+}
+
+func (t *Transactor) WithTx(ctx context.Context, fn func(repos usecase.Repositories) error) (err error) {
+	t.txMu.Lock()
+	defer t.txMu.Unlock()
+
+	t.mu.RLock()
+	snap := t.snapshot()
+	t.mu.RUnlock()
+
+	defer func() {
+		if p := recover(); p != nil {
+			t.rollback(snap)
+			panic(p)
+		}
+		if err != nil {
+			t.rollback(snap)
+		}
+	}()
+
+	return fn(usecase.Repositories{
+		Items:      &ItemRepository{Store: t.Store},
+		Loans:      &LoanRepository{Store: t.Store},
+		Locations:  &LocationRepository{Store: t.Store},
+		Valuations: &ItemValuationRepository{Store: t.Store},
+		Sales:      &ItemSaleRepository{Store: t.Store},
+	})
+}
+
+func (t *Transactor) rollback(snap *snapshot) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.restore(snap)
+}
@@ -0,0 +1,69 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"Aicon-assignment/internal/domain/entity"
+	"Aicon-assignment/internal/usecase"
+)
+
+func TestTransactor_WithTx(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("正常系: 成功した変更は残る", func(t *testing.T) {
+		store := NewStore()
+		transactor := &Transactor{Store: store}
+
+		err := transactor.WithTx(ctx, func(repos usecase.Repositories) error {
+			_, err := repos.Items.Create(ctx, newItem(t, "ロレックス デイトナ", "時計"))
+			return err
+		})
+		require.NoError(t, err)
+
+		_, err = (&ItemRepository{Store: store}).FindByID(ctx, 1)
+		assert.NoError(t, err)
+	})
+
+	t.Run("異常系: 失敗すると統合で付け替えた貸出や採番も含めて元に戻る", func(t *testing.T) {
+		store := NewStore()
+		itemRepo := &ItemRepository{Store: store}
+		loanRepo := &LoanRepository{Store: store}
+		transactor := &Transactor{Store: store}
+
+		survivor, err := itemRepo.Create(ctx, newItem(t, "ロレックス デイトナ", "時計"))
+		require.NoError(t, err)
+		duplicate, err := itemRepo.Create(ctx, newItem(t, "ロレックス デイトナ", "時計"))
+		require.NoError(t, err)
+		loan, err := loanRepo.Create(ctx, &entity.Loan{ItemID: duplicate.ID, Borrower: "山田", DueDate: "2099-01-01", LoanedAt: time.Now()})
+		require.NoError(t, err)
+
+		failure := errors.New("failure")
+		err = transactor.WithTx(ctx, func(repos usecase.Repositories) error {
+			if err := repos.Items.Merge(ctx, survivor.ID, []int64{duplicate.ID}); err != nil {
+				return err
+			}
+			if _, err := repos.Items.Create(ctx, newItem(t, "エルメス バーキン", "バッグ")); err != nil {
+				return err
+			}
+			return failure
+		})
+		assert.ErrorIs(t, err, failure)
+
+		_, err = itemRepo.FindByID(ctx, duplicate.ID)
+		assert.NoError(t, err)
+
+		restored, err := loanRepo.FindByID(ctx, loan.ID)
+		require.NoError(t, err)
+		assert.Equal(t, duplicate.ID, restored.ItemID)
+
+		created, err := itemRepo.Create(ctx, newItem(t, "エルメス バーキン", "バッグ"))
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), created.ID)
+	})
+}
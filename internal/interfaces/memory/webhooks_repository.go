@@ -0,0 +1,123 @@
+package memory
+
+import (
+	"context"
+	"sort"
+
+	"Aicon-assignment/internal/domain/entity"
+	domainErrors "Aicon-assignment/internal/domain/errors"
+)
+
+// MySQL版と同じく送信ログは新しい順に最大100件返す
+const maxDeliveries = 100
+
+type WebhookRepository struct {
+	*Store
+}
+
+func (r *WebhookRepository) FindAll(ctx context.Context) ([]*entity.Webhook, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var webhooks []*entity.Webhook
+	for _, webhook := range r.webhooks {
+		webhooks = append(webhooks, cloneWebhook(webhook))
+	}
+
+	sort.Slice(webhooks, func(i, j int) bool {
+		return webhooks[i].ID < webhooks[j].ID
+	})
+
+	return webhooks, nil
+}
+
+func (r *WebhookRepository) FindByID(ctx context.Context, id int64) (*entity.Webhook, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	webhook, exists := r.webhooks[id]
+	if !exists {
+		return nil, domainErrors.ErrWebhookNotFound
+	}
+
+	return cloneWebhook(webhook), nil
+}
+
+func (r *WebhookRepository) Create(ctx context.Context, webhook *entity.Webhook) (*entity.Webhook, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored := cloneWebhook(webhook)
+	stored.ID = r.nextID("webhooks")
+	stored.CreatedAt = now()
+	r.webhooks[stored.ID] = stored
+
+	return cloneWebhook(stored), nil
+}
+
+func (r *WebhookRepository) Delete(ctx context.Context, id int64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.webhooks[id]; !exists {
+		return domainErrors.ErrWebhookNotFound
+	}
+	delete(r.webhooks, id)
+
+	// ON DELETE CASCADE と同じく送信ログも消す
+	for deliveryID, delivery := range r.deliveries {
+		if delivery.WebhookID == id {
+			delete(r.deliveries, deliveryID)
+		}
+	}
+
+	return nil
+}
+
+func (r *WebhookRepository) CreateDelivery(ctx context.Context, delivery *entity.WebhookDelivery) (*entity.WebhookDelivery, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// 送信中にWebhookが削除された場合
+	if _, exists := r.webhooks[delivery.WebhookID]; !exists {
+		return nil, domainErrors.ErrWebhookNotFound
+	}
+
+	delivery.ID = r.nextID("webhook_deliveries")
+	stored := *delivery
+	r.deliveries[stored.ID] = &stored
+
+	return delivery, nil
+}
+
+func (r *WebhookRepository) FindDeliveriesByWebhookID(ctx context.Context, webhookID int64) ([]*entity.WebhookDelivery, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var deliveries []*entity.WebhookDelivery
+	for _, delivery := range r.deliveries {
+		if delivery.WebhookID == webhookID {
+			copied := *delivery
+			deliveries = append(deliveries, &copied)
+		}
+	}
+
+	sort.Slice(deliveries, func(i, j int) bool {
+		if !deliveries[i].DeliveredAt.Equal(deliveries[j].DeliveredAt) {
+			return deliveries[i].DeliveredAt.After(deliveries[j].DeliveredAt)
+		}
+		return deliveries[i].ID > deliveries[j].ID
+	})
+
+	if len(deliveries) > maxDeliveries {
+		deliveries = deliveries[:maxDeliveries]
+	}
+
+	return deliveries, nil
+}
+
+func cloneWebhook(webhook *entity.Webhook) *entity.Webhook {
+	copied := *webhook
+	copied.EventTypes = append([]string(nil), webhook.EventTypes...)
+	return &copied
+}
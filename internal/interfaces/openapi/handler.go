@@ -0,0 +1,43 @@
+package openapi
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+// Swagger UI（アセットはCDNから読み込む）
+const swaggerUIHTML = `<!DOCTYPE html>
+<html lang="ja">
+<head>
+  <meta charset="utf-8">
+  <title>所持品管理API - Swagger UI</title>
+  <link rel="stylesheet" href="https://unpkg.com/swagger-ui-dist@5/swagger-ui.css">
+</head>
+<body>
+  <div id="swagger-ui"></div>
+  <script src="https://unpkg.com/swagger-ui-dist@5/swagger-ui-bundle.js"></script>
+  <script>
+    window.onload = function () {
+      SwaggerUIBundle({ url: "/openapi.json", dom_id: "#swagger-ui" });
+    };
+  </script>
+</body>
+</html>
+`
+
+type Handler struct{}
+
+func NewHandler() *Handler {
+	return &Handler{}
+}
+
+// GetSpec GET /openapi.json エンドポイント
+func (h *Handler) GetSpec(c echo.Context) error {
+	return c.Blob(http.StatusOK, echo.MIMEApplicationJSONCharsetUTF8, specJSON)
+}
+
+// GetDocs GET /docs エンドポイント
+func (h *Handler) GetDocs(c echo.Context) error {
+	return c.HTML(http.StatusOK, swaggerUIHTML)
+}
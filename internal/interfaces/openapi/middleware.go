@@ -0,0 +1,68 @@
+package openapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+
+	"Aicon-assignment/internal/interfaces/problem"
+)
+
+var echoParamPattern = regexp.MustCompile(`:([A-Za-z0-9_]+)`)
+
+// リクエストボディがOpenAPIのスキーマに合わない場合、ハンドラーに渡す前に400を返す
+// 互換のために残しているバージョンのないパスも、同じ定義で検証する
+func ValidateRequest(spec *Spec) echo.MiddlewareFunc {
+	basePath := spec.basePath()
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			req := c.Request()
+
+			requestBody, err := spec.requestBody(req.Method, toOpenAPIPath(strings.TrimPrefix(c.Path(), basePath)))
+			if err != nil || requestBody == nil {
+				return next(c)
+			}
+
+			body, err := io.ReadAll(req.Body)
+			if err != nil {
+				return problem.Write(c, problem.New(http.StatusBadRequest, "invalid_request_format"))
+			}
+			req.Body = io.NopCloser(bytes.NewReader(body))
+
+			if len(bytes.TrimSpace(body)) == 0 {
+				if requestBody.Required {
+					return problem.Write(c, problem.New(http.StatusBadRequest, "request_body_required"))
+				}
+				return next(c)
+			}
+
+			mediaType, ok := requestBody.Content[echo.MIMEApplicationJSON]
+			if !ok || !strings.HasPrefix(req.Header.Get(echo.HeaderContentType), echo.MIMEApplicationJSON) {
+				return problem.Write(c, problem.New(http.StatusUnsupportedMediaType, "unsupported_content_type"))
+			}
+
+			var value interface{}
+			decoder := json.NewDecoder(bytes.NewReader(body))
+			decoder.UseNumber()
+			if err := decoder.Decode(&value); err != nil {
+				return problem.Write(c, problem.New(http.StatusBadRequest, "invalid_request_format"))
+			}
+
+			if errs := spec.validate(value, mediaType.Schema, ""); len(errs) > 0 {
+				return problem.Write(c, problem.New(http.StatusBadRequest, "request_does_not_match_schema").WithDetails(errs...))
+			}
+
+			return next(c)
+		}
+	}
+}
+
+// Echoのルート（/items/:id）をOpenAPIのパス（/items/{id}）に変換する
+func toOpenAPIPath(path string) string {
+	return echoParamPattern.ReplaceAllString(path, "{$1}")
+}
@@ -0,0 +1,162 @@
+package openapi
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"Aicon-assignment/internal/interfaces/problem"
+)
+
+func TestLoadSpec(t *testing.T) {
+	spec, err := LoadSpec()
+	require.NoError(t, err)
+
+	// 全ての $ref が解決できること
+	var walk func(schema *Schema, name string)
+	walk = func(schema *Schema, name string) {
+		if schema == nil {
+			return
+		}
+		if schema.Ref != "" {
+			require.NotNil(t, spec.resolve(schema), "unresolved $ref %s in %s", schema.Ref, name)
+		}
+		for _, property := range schema.Properties {
+			walk(property, name)
+		}
+		walk(schema.Items, name)
+		walk(schema.AdditionalProperties.Schema, name)
+	}
+	for name, schema := range spec.Components.Schemas {
+		walk(schema, name)
+	}
+	for path, pathItem := range spec.Paths {
+		for method := range pathItem {
+			if method == "parameters" {
+				continue
+			}
+			requestBody, err := spec.requestBody(method, path)
+			require.NoError(t, err, "%s %s", method, path)
+			if requestBody != nil {
+				walk(requestBody.Content[echo.MIMEApplicationJSON].Schema, path)
+			}
+		}
+	}
+}
+
+func TestValidateRequest(t *testing.T) {
+	spec, err := LoadSpec()
+	require.NoError(t, err)
+
+	e := echo.New()
+	e.Use(ValidateRequest(spec))
+	handler := func(c echo.Context) error { return c.NoContent(http.StatusNoContent) }
+	e.POST("/items", handler)
+	e.PATCH("/items/:id", handler)
+	e.POST("/items/:id/clone", handler)
+	e.POST("/api/v1/items", handler)
+
+	tests := []struct {
+		name           string
+		method         string
+		path           string
+		contentType    string
+		body           string
+		expectedStatus int
+		expectedErrors []string
+	}{
+		{
+			name:           "正常系: スキーマに合うアイテム登録",
+			method:         http.MethodPost,
+			path:           "/items",
+			body:           `{"name": "ロレックス デイトナ", "category": "時計", "brand": "ROLEX", "purchase_price": 1500000, "purchase_date": "2023-01-15", "attributes": {"movement": "automatic"}}`,
+			expectedStatus: http.StatusNoContent,
+		},
+		{
+			name:           "異常系: 必須項目の欠落と型の不一致",
+			method:         http.MethodPost,
+			path:           "/items",
+			body:           `{"name": "ロレックス デイトナ", "category": "時計", "purchase_price": "1500000", "purchase_date": "2023/01/15"}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedErrors: []string{
+				"brand is required",
+				"purchase_date must be in YYYY-MM-DD format",
+				"purchase_price must be an integer",
+			},
+		},
+		{
+			name:           "異常系: バージョン付きのパスも同じ定義で検証する",
+			method:         http.MethodPost,
+			path:           "/api/v1/items",
+			body:           `{"name": "ロレックス デイトナ", "category": "時計", "purchase_price": 1500000, "purchase_date": "2023-01-15"}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedErrors: []string{"brand is required"},
+		},
+		{
+			name:           "異常系: 定義されていないフィールド",
+			method:         http.MethodPatch,
+			path:           "/items/1",
+			body:           `{"name": "新しい名前", "category": "バッグ"}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedErrors: []string{"category is not allowed"},
+		},
+		{
+			name:           "異常系: 小数の価格",
+			method:         http.MethodPatch,
+			path:           "/items/1",
+			body:           `{"purchase_price": 100.5}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedErrors: []string{"purchase_price must be an integer"},
+		},
+		{
+			name:           "異常系: 必須のボディが空",
+			method:         http.MethodPost,
+			path:           "/items",
+			body:           "",
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "正常系: 任意のボディは省略できる",
+			method:         http.MethodPost,
+			path:           "/items/1/clone",
+			body:           "",
+			expectedStatus: http.StatusNoContent,
+		},
+		{
+			name:           "異常系: JSON以外のContent-Type",
+			method:         http.MethodPost,
+			path:           "/items",
+			contentType:    echo.MIMETextPlain,
+			body:           "name=test",
+			expectedStatus: http.StatusUnsupportedMediaType,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.path, strings.NewReader(tt.body))
+			contentType := tt.contentType
+			if contentType == "" {
+				contentType = echo.MIMEApplicationJSON
+			}
+			req.Header.Set(echo.HeaderContentType, contentType)
+			rec := httptest.NewRecorder()
+
+			e.ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			if tt.expectedErrors != nil {
+				var response problem.Problem
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+				assert.Equal(t, "request_does_not_match_schema", response.Code)
+				assert.Equal(t, tt.expectedErrors, response.Details)
+			}
+		})
+	}
+}
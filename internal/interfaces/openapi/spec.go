@@ -0,0 +1,115 @@
+package openapi
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+//go:embed openapi.json
+var specJSON []byte
+
+// OpenAPIドキュメントのうち、リクエストの検証に使う部分
+type Spec struct {
+	Servers []struct {
+		URL string `json:"url"`
+	} `json:"servers"`
+	Paths      map[string]map[string]json.RawMessage `json:"paths"`
+	Components struct {
+		Schemas map[string]*Schema `json:"schemas"`
+	} `json:"components"`
+}
+
+type Operation struct {
+	RequestBody *RequestBody `json:"requestBody"`
+}
+
+type RequestBody struct {
+	Required bool                 `json:"required"`
+	Content  map[string]MediaType `json:"content"`
+}
+
+type MediaType struct {
+	Schema *Schema `json:"schema"`
+}
+
+// JSON Schemaのうち、このAPIで使っているキーワードのみ扱う
+type Schema struct {
+	Ref                  string               `json:"$ref"`
+	Type                 string               `json:"type"`
+	Format               string               `json:"format"`
+	Nullable             bool                 `json:"nullable"`
+	Required             []string             `json:"required"`
+	Properties           map[string]*Schema   `json:"properties"`
+	AdditionalProperties additionalProperties `json:"additionalProperties"`
+	Items                *Schema              `json:"items"`
+	Enum                 []interface{}        `json:"enum"`
+	Minimum              *float64             `json:"minimum"`
+	MaxLength            *int                 `json:"maxLength"`
+	MinItems             *int                 `json:"minItems"`
+}
+
+// additionalProperties は true/false かスキーマのどちらか（省略時は許可）
+type additionalProperties struct {
+	Disallowed bool
+	Schema     *Schema
+}
+
+func (a *additionalProperties) UnmarshalJSON(data []byte) error {
+	var allowed bool
+	if err := json.Unmarshal(data, &allowed); err == nil {
+		a.Disallowed = !allowed
+		return nil
+	}
+	return json.Unmarshal(data, &a.Schema)
+}
+
+// 埋め込んだOpenAPIドキュメントを読み込む
+func LoadSpec() (*Spec, error) {
+	var spec Spec
+	if err := json.Unmarshal(specJSON, &spec); err != nil {
+		return nil, fmt.Errorf("failed to parse openapi.json: %w", err)
+	}
+	return &spec, nil
+}
+
+// パスの前に付くバージョンのプレフィックス（servers の URL のパス）
+func (s *Spec) basePath() string {
+	if len(s.Servers) == 0 {
+		return ""
+	}
+	u, err := url.Parse(s.Servers[0].URL)
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSuffix(u.Path, "/")
+}
+
+// メソッドとパス（/items/{id} 形式）に対応するリクエストボディの定義
+func (s *Spec) requestBody(method, path string) (*RequestBody, error) {
+	pathItem, ok := s.Paths[path]
+	if !ok {
+		return nil, nil
+	}
+	raw, ok := pathItem[strings.ToLower(method)]
+	if !ok {
+		return nil, nil
+	}
+
+	var operation Operation
+	if err := json.Unmarshal(raw, &operation); err != nil {
+		return nil, err
+	}
+
+	return operation.RequestBody, nil
+}
+
+// $ref を解決する
+func (s *Spec) resolve(schema *Schema) *Schema {
+	for schema != nil && schema.Ref != "" {
+		schema = s.Components.Schemas[strings.TrimPrefix(schema.Ref, "#/components/schemas/")]
+	}
+	return schema
+}
@@ -0,0 +1,166 @@
+package openapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+var dateFormatPattern = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}$`)
+
+// 値をスキーマに照らして検証し、違反内容を返す
+func (s *Spec) validate(value interface{}, schema *Schema, field string) []string {
+	schema = s.resolve(schema)
+	if schema == nil {
+		return nil
+	}
+
+	if value == nil {
+		if schema.Nullable {
+			return nil
+		}
+		return []string{fmt.Sprintf("%s must not be null", fieldName(field))}
+	}
+
+	switch schema.Type {
+	case "object":
+		obj, ok := value.(map[string]interface{})
+		if !ok {
+			return []string{fmt.Sprintf("%s must be an object", fieldName(field))}
+		}
+		return s.validateObject(obj, schema, field)
+	case "array":
+		arr, ok := value.([]interface{})
+		if !ok {
+			return []string{fmt.Sprintf("%s must be an array", fieldName(field))}
+		}
+		return s.validateArray(arr, schema, field)
+	case "string":
+		str, ok := value.(string)
+		if !ok {
+			return []string{fmt.Sprintf("%s must be a string", fieldName(field))}
+		}
+		return validateString(str, schema, field)
+	case "integer", "number":
+		num, ok := value.(json.Number)
+		if !ok {
+			return []string{fmt.Sprintf("%s must be a%s %s", fieldName(field), article(schema.Type), schema.Type)}
+		}
+		return validateNumber(num, schema, field)
+	case "boolean":
+		if _, ok := value.(bool); !ok {
+			return []string{fmt.Sprintf("%s must be a boolean", fieldName(field))}
+		}
+	}
+
+	return nil
+}
+
+func (s *Spec) validateObject(obj map[string]interface{}, schema *Schema, field string) []string {
+	var errs []string
+
+	for _, name := range schema.Required {
+		if _, ok := obj[name]; !ok {
+			errs = append(errs, fmt.Sprintf("%s is required", joinField(field, name)))
+		}
+	}
+
+	keys := make([]string, 0, len(obj))
+	for key := range obj {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		if property, ok := schema.Properties[key]; ok {
+			errs = append(errs, s.validate(obj[key], property, joinField(field, key))...)
+			continue
+		}
+		if schema.AdditionalProperties.Disallowed {
+			errs = append(errs, fmt.Sprintf("%s is not allowed", joinField(field, key)))
+			continue
+		}
+		if schema.AdditionalProperties.Schema != nil {
+			errs = append(errs, s.validate(obj[key], schema.AdditionalProperties.Schema, joinField(field, key))...)
+		}
+	}
+
+	return errs
+}
+
+func (s *Spec) validateArray(arr []interface{}, schema *Schema, field string) []string {
+	var errs []string
+
+	if schema.MinItems != nil && len(arr) < *schema.MinItems {
+		errs = append(errs, fmt.Sprintf("%s must contain at least %d items", fieldName(field), *schema.MinItems))
+	}
+
+	if schema.Items != nil {
+		for i, item := range arr {
+			errs = append(errs, s.validate(item, schema.Items, fmt.Sprintf("%s[%d]", field, i))...)
+		}
+	}
+
+	return errs
+}
+
+func validateString(str string, schema *Schema, field string) []string {
+	if schema.MaxLength != nil && utf8.RuneCountInString(str) > *schema.MaxLength {
+		return []string{fmt.Sprintf("%s must be %d characters or less", fieldName(field), *schema.MaxLength)}
+	}
+	if schema.Format == "date" && !dateFormatPattern.MatchString(str) {
+		return []string{fmt.Sprintf("%s must be in YYYY-MM-DD format", fieldName(field))}
+	}
+	if len(schema.Enum) > 0 {
+		values := make([]string, 0, len(schema.Enum))
+		for _, v := range schema.Enum {
+			if v == str {
+				return nil
+			}
+			values = append(values, fmt.Sprint(v))
+		}
+		return []string{fmt.Sprintf("%s must be one of: %s", fieldName(field), strings.Join(values, ", "))}
+	}
+	return nil
+}
+
+func validateNumber(num json.Number, schema *Schema, field string) []string {
+	if schema.Type == "integer" {
+		if _, err := num.Int64(); err != nil {
+			return []string{fmt.Sprintf("%s must be an integer", fieldName(field))}
+		}
+	}
+
+	value, err := num.Float64()
+	if err != nil {
+		return []string{fmt.Sprintf("%s must be a number", fieldName(field))}
+	}
+	if schema.Minimum != nil && value < *schema.Minimum {
+		return []string{fmt.Sprintf("%s must be %v or greater", fieldName(field), *schema.Minimum)}
+	}
+	return nil
+}
+
+func joinField(parent, name string) string {
+	if parent == "" {
+		return name
+	}
+	return parent + "." + name
+}
+
+func fieldName(field string) string {
+	if field == "" {
+		return "request body"
+	}
+	return field
+}
+
+func article(typeName string) string {
+	if typeName == "integer" {
+		return "n"
+	}
+	return ""
+}
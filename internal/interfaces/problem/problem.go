@@ -0,0 +1,190 @@
+package problem
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"Aicon-assignment/internal/domain/entity"
+	domainErrors "Aicon-assignment/internal/domain/errors"
+	"Aicon-assignment/internal/interfaces/i18n"
+	"Aicon-assignment/internal/interfaces/masking"
+)
+
+// RFC 7807 のエラーレスポンスのContent-Type
+const ContentType = "application/problem+json"
+
+// 種類を表すURI（コードを付けて type にする）
+const typePrefix = "urn:aicon-assignment:problem:"
+
+// RFC 7807 のエラーレスポンス
+// code はクライアントがエラーを判別するためのもので、title の言語や文言が変わっても変えない
+type Problem struct {
+	Type   string `json:"type"`
+	Title  string `json:"title"`
+	Status int    `json:"status"`
+	Detail string `json:"detail,omitempty"`
+	Code   string `json:"code"`
+
+	// 入力値ごとの誤り
+	Details    []string               `json:"details,omitempty"`
+	Errors     []entity.FieldError    `json:"errors,omitempty"`     // フィールドごとの誤り（受け付けなかった値と満たさなかったルール）
+	Violations []entity.RuleViolation `json:"violations,omitempty"` // カテゴリー別ルールの違反内容
+
+	// 既存のブランドに似ている場合の候補（確認して選び直すか、confirm_brand を指定して送り直す）
+	BrandSuggestion *entity.BrandSuggestion `json:"brand_suggestion,omitempty"`
+}
+
+func New(status int, code string) *Problem {
+	return &Problem{
+		Type:   typePrefix + code,
+		Status: status,
+		Code:   code,
+	}
+}
+
+func (p *Problem) WithDetail(detail string) *Problem {
+	p.Detail = detail
+	return p
+}
+
+func (p *Problem) WithDetails(details ...string) *Problem {
+	p.Details = details
+	return p
+}
+
+func (p *Problem) WithErrors(errs entity.FieldErrors) *Problem {
+	p.Errors = errs
+	return p
+}
+
+// 入力値の誤り（フィールドごとの誤りやカテゴリー別ルールの違反があれば構造化して含める）
+func Validation(err error) *Problem {
+	p := New(http.StatusBadRequest, "validation_failed").WithDetails(err.Error())
+
+	var fieldErrors entity.FieldErrors
+	if errors.As(err, &fieldErrors) {
+		p.Errors = fieldErrors
+	}
+	var violations entity.RuleViolations
+	if errors.As(err, &violations) {
+		p.Violations = violations
+	}
+	return p
+}
+
+// ユースケースのエラーを変換する（見つからない・矛盾する・入力の誤り・DBに接続できないなど以外は fallback のコードで500にする）
+func FromError(err error, fallback string) *Problem {
+	switch {
+	case domainErrors.IsValidationError(err):
+		return Validation(err)
+	case domainErrors.IsNotFoundError(err),
+		domainErrors.IsLoanNotFoundError(err),
+		domainErrors.IsLocationNotFoundError(err),
+		domainErrors.IsTemplateNotFoundError(err),
+		domainErrors.IsWebhookNotFoundError(err),
+		domainErrors.IsFeatureNotFoundError(err),
+		domainErrors.IsErasureNotFoundError(err),
+		domainErrors.IsShareLinkNotFoundError(err),
+		domainErrors.IsCommentNotFoundError(err),
+		domainErrors.IsRelationNotFoundError(err),
+		domainErrors.IsJobNotFoundError(err),
+		domainErrors.IsSaleNotFoundError(err),
+		domainErrors.IsInsuranceNotFoundError(err):
+		return New(http.StatusNotFound, domainErrors.Code(err))
+	case domainErrors.IsConflictError(err):
+		p := New(http.StatusConflict, domainErrors.Code(err)).WithDetail(err.Error())
+		var suggestion *entity.BrandSuggestion
+		if errors.As(err, &suggestion) {
+			p.BrandSuggestion = suggestion
+		}
+		return p
+	case domainErrors.IsQuotaExceededError(err):
+		return New(http.StatusForbidden, domainErrors.Code(err)).WithDetail(err.Error())
+	case domainErrors.IsUnsupportedListingError(err):
+		return New(http.StatusBadRequest, domainErrors.Code(err)).WithDetail(err.Error())
+	case domainErrors.IsListingUnavailableError(err):
+		return New(http.StatusBadGateway, domainErrors.Code(err)).WithDetail(err.Error())
+	case domainErrors.IsDatabaseUnavailableError(err):
+		return New(http.StatusServiceUnavailable, domainErrors.Code(err))
+	default:
+		return New(http.StatusInternalServerError, fallback)
+	}
+}
+
+// title を Accept-Language の言語にして書き出す
+func Write(c echo.Context, p *Problem) error {
+	// リクエストの制限時間を過ぎて失敗した場合は504にする
+	// ドライバーによってはキャンセルを独自のエラーで返すため、エラーではなくコンテキストで判断する
+	if p.Status == http.StatusInternalServerError && errors.Is(c.Request().Context().Err(), context.DeadlineExceeded) {
+		p = New(http.StatusGatewayTimeout, "request_timeout")
+	}
+
+	lang := i18n.Negotiate(c.Request().Header.Get(i18n.HeaderAcceptLanguage))
+	title, ok := i18n.Message(lang, p.Code)
+	if !ok {
+		title = http.StatusText(p.Status)
+	}
+	response := *p
+	response.Title = title
+	response.Errors = maskFieldErrors(p.Errors)
+
+	body, err := json.Marshal(response)
+	if err != nil {
+		return err
+	}
+
+	header := c.Response().Header()
+	header.Add(echo.HeaderVary, i18n.HeaderAcceptLanguage)
+	header.Set(i18n.HeaderContentLanguage, string(lang))
+	return c.Blob(p.Status, ContentType, body)
+}
+
+// 受け付けなかった値のうち、購入価格などの伏せる項目は返さない
+func maskFieldErrors(errs entity.FieldErrors) entity.FieldErrors {
+	if len(errs) == 0 {
+		return errs
+	}
+	masked := make(entity.FieldErrors, len(errs))
+	for i, fieldError := range errs {
+		fieldError.Value = masking.Value(fieldError.Field, fieldError.Value)
+		masked[i] = fieldError
+	}
+	return masked
+}
+
+// Echoが返すエラー（存在しないルートなど）も同じ形式にする
+func ErrorHandler(err error, c echo.Context) {
+	if c.Response().Committed {
+		return
+	}
+
+	status := http.StatusInternalServerError
+	var httpErr *echo.HTTPError
+	if errors.As(err, &httpErr) {
+		status = httpErr.Code
+	}
+
+	var code string
+	switch {
+	case status == http.StatusNotFound:
+		code = "not_found"
+	case status == http.StatusMethodNotAllowed:
+		code = "method_not_allowed"
+	case status == http.StatusRequestEntityTooLarge:
+		code = "request_body_too_large"
+	case status >= http.StatusInternalServerError:
+		code = "internal_error"
+	default:
+		code = "bad_request"
+	}
+
+	if c.Request().Method == http.MethodHead {
+		c.NoContent(status)
+		return
+	}
+	Write(c, New(status, code))
+}
@@ -0,0 +1,172 @@
+package problem
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"Aicon-assignment/internal/domain/entity"
+	domainErrors "Aicon-assignment/internal/domain/errors"
+	"Aicon-assignment/internal/interfaces/i18n"
+)
+
+func TestWrite(t *testing.T) {
+	tests := []struct {
+		name           string
+		acceptLanguage string
+		problem        *Problem
+		wantBody       string
+		wantLanguage   string
+	}{
+		{
+			name:         "正常系: 指定がなければ英語のタイトル",
+			problem:      New(http.StatusNotFound, "item_not_found"),
+			wantBody:     `{"type":"urn:aicon-assignment:problem:item_not_found","title":"item not found","status":404,"code":"item_not_found"}`,
+			wantLanguage: "en",
+		},
+		{
+			name:           "正常系: 日本語のタイトルと詳細",
+			acceptLanguage: "ja",
+			problem:        New(http.StatusBadRequest, "validation_failed").WithDetails("name is required"),
+			wantBody:       `{"type":"urn:aicon-assignment:problem:validation_failed","title":"入力内容に誤りがあります","status":400,"code":"validation_failed","details":["name is required"]}`,
+			wantLanguage:   "ja",
+		},
+		{
+			name:         "正常系: 知らないコードはステータスの説明をタイトルにする",
+			problem:      New(http.StatusTeapot, "unknown_code").WithDetail("short and stout"),
+			wantBody:     `{"type":"urn:aicon-assignment:problem:unknown_code","title":"I'm a teapot","status":418,"detail":"short and stout","code":"unknown_code"}`,
+			wantLanguage: "en",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/items/1", nil)
+			if tt.acceptLanguage != "" {
+				req.Header.Set(i18n.HeaderAcceptLanguage, tt.acceptLanguage)
+			}
+			rec := httptest.NewRecorder()
+			c := echo.New().NewContext(req, rec)
+
+			require.NoError(t, Write(c, tt.problem))
+
+			assert.Equal(t, tt.problem.Status, rec.Code)
+			assert.Equal(t, ContentType, rec.Header().Get(echo.HeaderContentType))
+			assert.Equal(t, tt.wantLanguage, rec.Header().Get(i18n.HeaderContentLanguage))
+			assert.JSONEq(t, tt.wantBody, rec.Body.String())
+		})
+	}
+}
+
+func TestWrite_Timeout(t *testing.T) {
+	ctx, cancel := context.WithDeadline(context.Background(), time.Now().Add(-time.Second))
+	defer cancel()
+
+	t.Run("正常系: 制限時間を過ぎて失敗したら504にする", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		c := echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/items", nil).WithContext(ctx), rec)
+
+		require.NoError(t, Write(c, New(http.StatusInternalServerError, "failed_to_retrieve_items")))
+
+		assert.Equal(t, http.StatusGatewayTimeout, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"code":"request_timeout"`)
+	})
+
+	t.Run("正常系: 500以外はそのまま返す", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		c := echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/items/1", nil).WithContext(ctx), rec)
+
+		require.NoError(t, Write(c, New(http.StatusNotFound, "item_not_found")))
+
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+}
+
+func TestFromError(t *testing.T) {
+	violations := entity.RuleViolations{{Field: "serial_number", Rule: "required", Message: "serial_number is required"}}
+
+	tests := []struct {
+		name           string
+		err            error
+		wantStatus     int
+		wantCode       string
+		wantDetail     string
+		wantViolations bool
+		wantErrors     int
+	}{
+		{name: "正常系: 見つからない", err: domainErrors.ErrLocationNotFound, wantStatus: http.StatusNotFound, wantCode: "location_not_found"},
+		{name: "正常系: 矛盾する操作は詳細を含める", err: domainErrors.ErrItemAlreadyOnLoan, wantStatus: http.StatusConflict, wantCode: "item_already_on_loan", wantDetail: "item is already on loan"},
+		{name: "正常系: 入力の誤りはルール違反を含める", err: fmt.Errorf("%w: %w", domainErrors.ErrInvalidInput, violations), wantStatus: http.StatusBadRequest, wantCode: "validation_failed", wantViolations: true},
+		{name: "正常系: フィールドごとの誤りを含める", err: fmt.Errorf("%w: %w", domainErrors.ErrInvalidInput, entity.FieldErrors{{Field: "brand", Value: "", Rule: "required", Message: "brand is required"}}), wantStatus: http.StatusBadRequest, wantCode: "validation_failed", wantErrors: 1},
+		{name: "正常系: 存在しない機能は404", err: domainErrors.ErrFeatureNotFound, wantStatus: http.StatusNotFound, wantCode: "feature_not_found"},
+		{name: "正常系: 存在しない共有リンクは404", err: domainErrors.ErrShareLinkNotFound, wantStatus: http.StatusNotFound, wantCode: "share_link_not_found"},
+		{name: "正常系: 存在しないメモは404", err: domainErrors.ErrCommentNotFound, wantStatus: http.StatusNotFound, wantCode: "comment_not_found"},
+		{name: "正常系: 存在しない関連は404", err: domainErrors.ErrRelationNotFound, wantStatus: http.StatusNotFound, wantCode: "relation_not_found"},
+		{name: "正常系: 利用量の上限は403", err: fmt.Errorf("%w: items 3/3", domainErrors.ErrQuotaExceeded), wantStatus: http.StatusForbidden, wantCode: "quota_exceeded", wantDetail: "quota exceeded: items 3/3"},
+		{name: "正常系: 出品ページを取得できない場合は502", err: fmt.Errorf("%w: status 503", domainErrors.ErrListingUnavailable), wantStatus: http.StatusBadGateway, wantCode: "listing_unavailable", wantDetail: "failed to fetch listing: status 503"},
+		{name: "正常系: DBに接続できない場合は503", err: fmt.Errorf("%w: %w", domainErrors.ErrDatabaseError, fmt.Errorf("%w (circuit breaker is open)", domainErrors.ErrDatabaseUnavailable)), wantStatus: http.StatusServiceUnavailable, wantCode: "database_unavailable"},
+		{name: "異常系: それ以外は500", err: errors.New("boom"), wantStatus: http.StatusInternalServerError, wantCode: "failed_to_create_item"},
+		{name: "異常系: データベースのエラーは500", err: fmt.Errorf("%w: timeout", domainErrors.ErrDatabaseError), wantStatus: http.StatusInternalServerError, wantCode: "failed_to_create_item"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := FromError(tt.err, "failed_to_create_item")
+
+			assert.Equal(t, tt.wantStatus, p.Status)
+			assert.Equal(t, tt.wantCode, p.Code)
+			assert.Equal(t, tt.wantDetail, p.Detail)
+			assert.Equal(t, tt.wantViolations, len(p.Violations) > 0)
+			assert.Len(t, p.Errors, tt.wantErrors)
+		})
+	}
+}
+
+func TestErrorHandler(t *testing.T) {
+	e := echo.New()
+	e.HTTPErrorHandler = ErrorHandler
+	e.GET("/items", func(c echo.Context) error { return errors.New("boom") })
+
+	tests := []struct {
+		name       string
+		method     string
+		path       string
+		wantStatus int
+		wantCode   string
+	}{
+		{name: "異常系: 存在しないルート", method: http.MethodGet, path: "/unknown", wantStatus: http.StatusNotFound, wantCode: "not_found"},
+		{name: "異常系: 使えないメソッド", method: http.MethodDelete, path: "/items", wantStatus: http.StatusMethodNotAllowed, wantCode: "method_not_allowed"},
+		{name: "異常系: ハンドラーが返したエラー", method: http.MethodGet, path: "/items", wantStatus: http.StatusInternalServerError, wantCode: "internal_error"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, httptest.NewRequest(tt.method, tt.path, nil))
+
+			assert.Equal(t, tt.wantStatus, rec.Code)
+			var response Problem
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+			assert.Equal(t, tt.wantCode, response.Code)
+		})
+	}
+}
+
+// 全てのドメインエラーのコードに、レスポンスのタイトルがある
+func TestDomainErrorCodesHaveMessages(t *testing.T) {
+	for _, code := range domainErrors.Codes() {
+		for _, lang := range []i18n.Lang{i18n.English, i18n.Japanese} {
+			_, ok := i18n.Message(lang, code)
+			assert.True(t, ok, "%s has no %s message", code, lang)
+		}
+	}
+}
@@ -0,0 +1,59 @@
+package resource
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	headerETag        = "ETag"
+	headerIfNoneMatch = "If-None-Match"
+)
+
+// 本文からETagを作る（gzipで圧縮されても使えるよう弱いETagにする）
+func etag(body []byte) string {
+	sum := sha256.Sum256(body)
+	return `W/"` + hex.EncodeToString(sum[:16]) + `"`
+}
+
+// ETag と Last-Modified（ゼロ値なら付けない）を付け、GET・HEADで変わっていなければ304を返す
+// 返した場合は true
+func notModified(c echo.Context, status int, body []byte, lastModified time.Time) bool {
+	header := c.Response().Header()
+	tag := etag(body)
+	header.Set(headerETag, tag)
+	if !lastModified.IsZero() {
+		header.Set(echo.HeaderLastModified, lastModified.UTC().Format(http.TimeFormat))
+	}
+
+	req := c.Request()
+	if status != http.StatusOK || (req.Method != http.MethodGet && req.Method != http.MethodHead) {
+		return false
+	}
+
+	// If-None-Match がある場合は If-Modified-Since より優先する（RFC 9110）
+	if ifNoneMatch := req.Header.Get(headerIfNoneMatch); ifNoneMatch != "" {
+		return matchesETag(ifNoneMatch, tag)
+	}
+	if ifModifiedSince := req.Header.Get(echo.HeaderIfModifiedSince); ifModifiedSince != "" && !lastModified.IsZero() {
+		since, err := http.ParseTime(ifModifiedSince)
+		return err == nil && !lastModified.Truncate(time.Second).After(since)
+	}
+	return false
+}
+
+// If-None-Match のいずれかと一致するか（弱い比較）
+func matchesETag(ifNoneMatch, tag string) bool {
+	for _, value := range strings.Split(ifNoneMatch, ",") {
+		value = strings.TrimSpace(value)
+		if value == "*" || strings.TrimPrefix(value, "W/") == strings.TrimPrefix(tag, "W/") {
+			return true
+		}
+	}
+	return false
+}
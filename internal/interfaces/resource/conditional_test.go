@@ -0,0 +1,81 @@
+package resource
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"Aicon-assignment/internal/domain/entity"
+)
+
+func TestWriteItem_Conditional(t *testing.T) {
+	updatedAt := time.Date(2025, 1, 15, 10, 0, 0, 500, time.UTC)
+	item := &entity.Item{ID: 1, Name: "ロレックス デイトナ", UpdatedAt: updatedAt}
+
+	// 条件なしで取得したときのETag
+	first := httptest.NewRecorder()
+	require.NoError(t, WriteItem(echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/items/1", nil), first), http.StatusOK, item))
+	tag := first.Header().Get(headerETag)
+	require.NotEmpty(t, tag)
+	assert.Equal(t, "Wed, 15 Jan 2025 10:00:00 GMT", first.Header().Get(echo.HeaderLastModified))
+
+	tests := []struct {
+		name       string
+		method     string
+		header     map[string]string
+		status     int
+		wantStatus int
+	}{
+		{name: "正常系: ETagが一致すれば304", method: http.MethodGet, header: map[string]string{headerIfNoneMatch: tag}, status: http.StatusOK, wantStatus: http.StatusNotModified},
+		{name: "正常系: 複数のETagのいずれかと一致すれば304", method: http.MethodGet, header: map[string]string{headerIfNoneMatch: `"other", ` + tag}, status: http.StatusOK, wantStatus: http.StatusNotModified},
+		{name: "正常系: ETagが異なれば200", method: http.MethodGet, header: map[string]string{headerIfNoneMatch: `W/"other"`}, status: http.StatusOK, wantStatus: http.StatusOK},
+		{name: "正常系: 更新されていなければ304", method: http.MethodGet, header: map[string]string{echo.HeaderIfModifiedSince: "Wed, 15 Jan 2025 10:00:00 GMT"}, status: http.StatusOK, wantStatus: http.StatusNotModified},
+		{name: "正常系: 更新されていれば200", method: http.MethodGet, header: map[string]string{echo.HeaderIfModifiedSince: "Wed, 15 Jan 2025 09:59:59 GMT"}, status: http.StatusOK, wantStatus: http.StatusOK},
+		{name: "正常系: If-None-Matchを優先する", method: http.MethodGet, header: map[string]string{headerIfNoneMatch: `W/"other"`, echo.HeaderIfModifiedSince: "Wed, 15 Jan 2025 10:00:00 GMT"}, status: http.StatusOK, wantStatus: http.StatusOK},
+		{name: "正常系: GET以外は比べない", method: http.MethodPatch, header: map[string]string{headerIfNoneMatch: tag}, status: http.StatusOK, wantStatus: http.StatusOK},
+		{name: "異常系: 日時が不正なら200", method: http.MethodGet, header: map[string]string{echo.HeaderIfModifiedSince: "yesterday"}, status: http.StatusOK, wantStatus: http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, "/items/1", nil)
+			for key, value := range tt.header {
+				req.Header.Set(key, value)
+			}
+			rec := httptest.NewRecorder()
+
+			require.NoError(t, WriteItem(echo.New().NewContext(req, rec), tt.status, item))
+
+			assert.Equal(t, tt.wantStatus, rec.Code)
+			assert.Equal(t, tag, rec.Header().Get(headerETag))
+			if tt.wantStatus == http.StatusNotModified {
+				assert.Empty(t, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestWriteItems_Conditional(t *testing.T) {
+	items := []*entity.Item{{ID: 1, UpdatedAt: time.Date(2025, 1, 15, 10, 0, 0, 0, time.UTC)}}
+	request := func(header, value string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/items", nil)
+		if header != "" {
+			req.Header.Set(header, value)
+		}
+		rec := httptest.NewRecorder()
+		require.NoError(t, WriteItems(echo.New().NewContext(req, rec), http.StatusOK, items))
+		return rec
+	}
+
+	first := request("", "")
+	assert.Empty(t, first.Header().Get(echo.HeaderLastModified))
+
+	assert.Equal(t, http.StatusNotModified, request(headerIfNoneMatch, first.Header().Get(headerETag)).Code)
+	// 一覧は削除を日時で判断できないため、If-Modified-Since では304を返さない
+	assert.Equal(t, http.StatusOK, request(echo.HeaderIfModifiedSince, "Wed, 15 Jan 2025 10:00:00 GMT").Code)
+}
@@ -0,0 +1,84 @@
+package resource
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"Aicon-assignment/internal/domain/entity"
+	"Aicon-assignment/internal/interfaces/i18n"
+)
+
+// リンクは現行のバージョンのパスを指す（バージョンのないパスは廃止予定のため）
+const (
+	BasePath      = "/api/v1"
+	ItemsPath     = BasePath + "/items"
+	LocationsPath = BasePath + "/locations"
+)
+
+// リンク先（HALの形式）
+type Link struct {
+	Href string `json:"href"`
+}
+
+// 関係の名前ごとのリンク
+type Links map[string]Link
+
+// Linkヘッダー（RFC 8288）の値にする
+// 一覧は配列のまま返すため、一覧自体のリンクはこのヘッダーで返す
+func (l Links) Header() string {
+	rels := make([]string, 0, len(l))
+	for rel := range l {
+		rels = append(rels, rel)
+	}
+	sort.Strings(rels)
+
+	values := make([]string, 0, len(rels))
+	for _, rel := range rels {
+		values = append(values, fmt.Sprintf(`<%s>; rel="%s"`, l[rel].Href, rel))
+	}
+	return strings.Join(values, ", ")
+}
+
+// _links を付けたアイテムのレスポンス
+// クライアントがURLを組み立てずに関連するエンドポイントへ辿れるようにする
+type Item struct {
+	*entity.Item
+	// category は保存している値のまま返し、表示名は lang の言語にして別に返す
+	CategoryLabel string `json:"category_label"`
+	Links         Links  `json:"_links"`
+}
+
+func NewItem(item *entity.Item, lang i18n.Lang) *Item {
+	return &Item{Item: item, CategoryLabel: i18n.CategoryName(lang, item.Category), Links: ItemLinks(item)}
+}
+
+func NewItems(items []*entity.Item, lang i18n.Lang) []*Item {
+	resources := make([]*Item, 0, len(items))
+	for _, item := range items {
+		resources = append(resources, NewItem(item, lang))
+	}
+	return resources
+}
+
+// アイテムから辿れるエンドポイント（保管場所は設定されている場合のみ）
+func ItemLinks(item *entity.Item) Links {
+	self := fmt.Sprintf("%s/%d", ItemsPath, item.ID)
+	links := Links{
+		"self":             {Href: self},
+		"collection":       {Href: ItemsPath},
+		"label":            {Href: self + "/label.png"},
+		"location_history": {Href: self + "/location-history"},
+		"relations":        {Href: self + "/relations"},
+		"valuations":       {Href: self + "/valuations"},
+	}
+	if item.LocationID != nil {
+		links["location"] = Link{Href: fmt.Sprintf("%s/%d", LocationsPath, *item.LocationID)}
+	}
+	return links
+}
+
+// アイテムの一覧のリンク（self は絞り込み条件を含めたリクエストのURI）
+func ItemCollectionLinks(requestURI string) Links {
+	return Links{"self": {Href: requestURI}}
+}
@@ -0,0 +1,77 @@
+package resource
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"Aicon-assignment/internal/domain/entity"
+	"Aicon-assignment/internal/interfaces/i18n"
+)
+
+func TestItemLinks(t *testing.T) {
+	locationID := int64(3)
+
+	tests := []struct {
+		name string
+		item *entity.Item
+		want Links
+	}{
+		{
+			name: "正常系: 保管場所がない",
+			item: &entity.Item{ID: 1},
+			want: Links{
+				"self":             {Href: "/api/v1/items/1"},
+				"collection":       {Href: "/api/v1/items"},
+				"label":            {Href: "/api/v1/items/1/label.png"},
+				"location_history": {Href: "/api/v1/items/1/location-history"},
+				"relations":        {Href: "/api/v1/items/1/relations"},
+				"valuations":       {Href: "/api/v1/items/1/valuations"},
+			},
+		},
+		{
+			name: "正常系: 保管場所へのリンクを含める",
+			item: &entity.Item{ID: 2, LocationID: &locationID},
+			want: Links{
+				"self":             {Href: "/api/v1/items/2"},
+				"collection":       {Href: "/api/v1/items"},
+				"label":            {Href: "/api/v1/items/2/label.png"},
+				"location_history": {Href: "/api/v1/items/2/location-history"},
+				"relations":        {Href: "/api/v1/items/2/relations"},
+				"valuations":       {Href: "/api/v1/items/2/valuations"},
+				"location":         {Href: "/api/v1/locations/3"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, ItemLinks(tt.item))
+		})
+	}
+}
+
+// アイテムのフィールドと同じ階層に _links が並ぶ
+func TestNewItem_JSON(t *testing.T) {
+	body, err := json.Marshal(NewItem(&entity.Item{ID: 1, Name: "ロレックス デイトナ", Category: "時計"}, i18n.English))
+	require.NoError(t, err)
+
+	var response map[string]any
+	require.NoError(t, json.Unmarshal(body, &response))
+	assert.Equal(t, "ロレックス デイトナ", response["name"])
+	// 保存している値はそのまま、表示名を別に返す
+	assert.Equal(t, "時計", response["category"])
+	assert.Equal(t, "Watches", response["category_label"])
+	assert.Equal(t, map[string]any{"href": "/api/v1/items/1"}, response["_links"].(map[string]any)["self"])
+}
+
+func TestLinks_Header(t *testing.T) {
+	links := Links{
+		"self": {Href: "/items?condition=A"},
+		"next": {Href: "/items?condition=A&page=2"},
+	}
+
+	assert.Equal(t, `</items?condition=A&page=2>; rel="next", </items?condition=A>; rel="self"`, links.Header())
+}
@@ -0,0 +1,116 @@
+package resource
+
+import (
+	"strconv"
+	"time"
+
+	"Aicon-assignment/internal/domain/entity"
+	"Aicon-assignment/internal/interfaces/i18n"
+)
+
+// JSON:API（https://jsonapi.org/）のメディアタイプ
+const JSONAPIContentType = "application/vnd.api+json"
+
+type jsonAPIDocument struct {
+	Data  any               `json:"data"`
+	Links map[string]string `json:"links,omitempty"`
+}
+
+type jsonAPIResource struct {
+	Type          string                         `json:"type"`
+	ID            string                         `json:"id"`
+	Attributes    jsonAPIItemAttributes          `json:"attributes"`
+	Relationships map[string]jsonAPIRelationship `json:"relationships"`
+	Links         map[string]string              `json:"links"`
+}
+
+// id と関連（location_id）以外のフィールド
+type jsonAPIItemAttributes struct {
+	Name          string            `json:"name"`
+	Category      string            `json:"category"`
+	CategoryLabel string            `json:"category_label"`
+	Brand         string            `json:"brand"`
+	PurchasePrice int               `json:"purchase_price"`
+	CurrentValue  *int              `json:"current_value"`
+	PurchaseDate  string            `json:"purchase_date"`
+	Condition     string            `json:"condition"`
+	SerialNumber  string            `json:"serial_number"`
+	Attributes    map[string]string `json:"attributes"`
+	OnLoan        bool              `json:"on_loan"`
+	ArchivedAt    *time.Time        `json:"archived_at"`
+	CreatedAt     time.Time         `json:"created_at"`
+	UpdatedAt     time.Time         `json:"updated_at"`
+	Version       int               `json:"version"`
+}
+
+type jsonAPIRelationship struct {
+	Data  *jsonAPIResourceIdentifier `json:"data"` // 関連がなければnull
+	Links map[string]string          `json:"links,omitempty"`
+}
+
+type jsonAPIResourceIdentifier struct {
+	Type string `json:"type"`
+	ID   string `json:"id"`
+}
+
+type jsonAPISerializer struct{}
+
+func (jsonAPISerializer) ContentType() string {
+	return JSONAPIContentType
+}
+
+func (jsonAPISerializer) Item(item *entity.Item, lang i18n.Lang) any {
+	return jsonAPIDocument{Data: newJSONAPIItem(item, lang)}
+}
+
+func (jsonAPISerializer) Items(items []*entity.Item, links Links, lang i18n.Lang) any {
+	data := make([]jsonAPIResource, 0, len(items))
+	for _, item := range items {
+		data = append(data, newJSONAPIItem(item, lang))
+	}
+	return jsonAPIDocument{Data: data, Links: hrefs(links)}
+}
+
+func newJSONAPIItem(item *entity.Item, lang i18n.Lang) jsonAPIResource {
+	links := ItemLinks(item)
+
+	location := jsonAPIRelationship{}
+	if item.LocationID != nil {
+		location.Data = &jsonAPIResourceIdentifier{Type: "locations", ID: strconv.FormatInt(*item.LocationID, 10)}
+		location.Links = map[string]string{"related": links["location"].Href}
+	}
+	delete(links, "location")
+
+	return jsonAPIResource{
+		Type: "items",
+		ID:   strconv.FormatInt(item.ID, 10),
+		Attributes: jsonAPIItemAttributes{
+			Name:          item.Name,
+			Category:      item.Category,
+			CategoryLabel: i18n.CategoryName(lang, item.Category),
+			Brand:         item.Brand,
+			PurchasePrice: item.PurchasePrice,
+			CurrentValue:  item.CurrentValue,
+			PurchaseDate:  item.PurchaseDate,
+			Condition:     item.Condition,
+			SerialNumber:  item.SerialNumber,
+			Attributes:    item.Attributes,
+			OnLoan:        item.OnLoan,
+			ArchivedAt:    item.ArchivedAt,
+			CreatedAt:     item.CreatedAt,
+			UpdatedAt:     item.UpdatedAt,
+			Version:       item.Version,
+		},
+		Relationships: map[string]jsonAPIRelationship{"location": location},
+		Links:         hrefs(links),
+	}
+}
+
+// JSON:API のリンクは文字列で表す
+func hrefs(links Links) map[string]string {
+	values := make(map[string]string, len(links))
+	for rel, link := range links {
+		values[rel] = link.Href
+	}
+	return values
+}
@@ -0,0 +1,99 @@
+package resource
+
+import (
+	"encoding/json"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"Aicon-assignment/internal/domain/entity"
+	"Aicon-assignment/internal/interfaces/i18n"
+)
+
+// アイテムのレスポンスの形式
+// Acceptヘッダーで選び、対応していない形式が指定された場合は既定の形式（_links付きのJSON）で返す
+type Serializer interface {
+	ContentType() string
+	// lang はカテゴリーの表示名の言語
+	Item(item *entity.Item, lang i18n.Lang) any
+	Items(items []*entity.Item, links Links, lang i18n.Lang) any
+}
+
+// 既定の形式以外に選べる形式（メディアタイプごと）
+var serializers = map[string]Serializer{
+	JSONAPIContentType: jsonAPISerializer{},
+}
+
+// Acceptヘッダーから形式を選ぶ（書かれた順に最初に対応しているもの）
+func Negotiate(accept string) Serializer {
+	for _, value := range strings.Split(accept, ",") {
+		mediaType, _, err := mime.ParseMediaType(strings.TrimSpace(value))
+		if err != nil {
+			continue
+		}
+		if serializer, ok := serializers[mediaType]; ok {
+			return serializer
+		}
+	}
+	return halSerializer{}
+}
+
+// Acceptヘッダーで選んだ形式でアイテムを書き出す
+// Last-Modified はアイテムの updated_at にする
+func WriteItem(c echo.Context, status int, item *entity.Item) error {
+	serializer := negotiate(c)
+	return write(c, status, serializer.ContentType(), serializer.Item(item, Lang(c)), item.UpdatedAt)
+}
+
+// Acceptヘッダーで選んだ形式でアイテムの一覧を書き出す
+// 削除されたアイテムは updated_at に現れないため、一覧には Last-Modified を付けずETagだけで比べる
+func WriteItems(c echo.Context, status int, items []*entity.Item) error {
+	serializer := negotiate(c)
+	links := ItemCollectionLinks(c.Request().URL.RequestURI())
+	// 廃止予定のパスでは後継のリンクが先に付いているため、上書きせずに加える
+	c.Response().Header().Add("Link", links.Header())
+	return write(c, status, serializer.ContentType(), serializer.Items(items, links, Lang(c)), time.Time{})
+}
+
+func negotiate(c echo.Context) Serializer {
+	c.Response().Header().Add(echo.HeaderVary, echo.HeaderAccept)
+	return Negotiate(c.Request().Header.Get(echo.HeaderAccept))
+}
+
+// Accept-Language からカテゴリーの表示名の言語を選ぶ
+func Lang(c echo.Context) i18n.Lang {
+	c.Response().Header().Add(echo.HeaderVary, i18n.HeaderAcceptLanguage)
+	return i18n.Negotiate(c.Request().Header.Get(i18n.HeaderAcceptLanguage))
+}
+
+func write(c echo.Context, status int, contentType string, body any, lastModified time.Time) error {
+	b, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+	if notModified(c, status, b, lastModified) {
+		return c.NoContent(http.StatusNotModified)
+	}
+	// HEADでも本文の長さを返せるよう明示する（サーバーは本文を捨てる）
+	c.Response().Header().Set(echo.HeaderContentLength, strconv.Itoa(len(b)))
+	return c.Blob(status, contentType, b)
+}
+
+// 既定の形式（アイテムのフィールドに _links を加える）
+type halSerializer struct{}
+
+func (halSerializer) ContentType() string {
+	return echo.MIMEApplicationJSON
+}
+
+func (halSerializer) Item(item *entity.Item, lang i18n.Lang) any {
+	return NewItem(item, lang)
+}
+
+func (halSerializer) Items(items []*entity.Item, _ Links, lang i18n.Lang) any {
+	return NewItems(items, lang)
+}
@@ -0,0 +1,146 @@
+package resource
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"Aicon-assignment/internal/domain/entity"
+	"Aicon-assignment/internal/interfaces/i18n"
+)
+
+func TestNegotiate(t *testing.T) {
+	tests := []struct {
+		name   string
+		accept string
+		want   string
+	}{
+		{name: "正常系: 指定がなければJSON", accept: "", want: "application/json"},
+		{name: "正常系: JSON:API", accept: "application/vnd.api+json", want: JSONAPIContentType},
+		{name: "正常系: 先に書かれた対応している形式", accept: "text/html, application/vnd.api+json;q=0.9, application/json", want: JSONAPIContentType},
+		{name: "正常系: 対応していない形式はJSON", accept: "text/csv", want: "application/json"},
+		{name: "異常系: 不正な値はJSON", accept: ";;;", want: "application/json"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Negotiate(tt.accept).ContentType())
+		})
+	}
+}
+
+func TestWriteItem_JSONAPI(t *testing.T) {
+	locationID := int64(3)
+	createdAt := time.Date(2023, 1, 15, 10, 0, 0, 0, time.UTC)
+	item := &entity.Item{
+		ID:            1,
+		Name:          "ロレックス デイトナ",
+		Category:      "時計",
+		Brand:         "ROLEX",
+		PurchasePrice: 1500000,
+		PurchaseDate:  "2023-01-15",
+		LocationID:    &locationID,
+		CreatedAt:     createdAt,
+		UpdatedAt:     createdAt,
+		Version:       2,
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/items/1", nil)
+	req.Header.Set(echo.HeaderAccept, JSONAPIContentType)
+	rec := httptest.NewRecorder()
+	c := echo.New().NewContext(req, rec)
+
+	require.NoError(t, WriteItem(c, http.StatusOK, item))
+
+	assert.Equal(t, JSONAPIContentType, rec.Header().Get(echo.HeaderContentType))
+	assert.Equal(t, echo.HeaderAccept, rec.Header().Get(echo.HeaderVary))
+	assert.JSONEq(t, `{
+		"data": {
+			"type": "items",
+			"id": "1",
+			"attributes": {
+				"name": "ロレックス デイトナ",
+				"category": "時計",
+				"category_label": "Watches",
+				"brand": "ROLEX",
+				"purchase_price": 1500000,
+				"current_value": null,
+				"purchase_date": "2023-01-15",
+				"condition": "",
+				"serial_number": "",
+				"attributes": null,
+				"on_loan": false,
+				"archived_at": null,
+				"created_at": "2023-01-15T10:00:00Z",
+				"updated_at": "2023-01-15T10:00:00Z",
+				"version": 2
+			},
+			"relationships": {
+				"location": {"data": {"type": "locations", "id": "3"}, "links": {"related": "/api/v1/locations/3"}}
+			},
+			"links": {
+				"self": "/api/v1/items/1",
+				"collection": "/api/v1/items",
+				"label": "/api/v1/items/1/label.png",
+				"location_history": "/api/v1/items/1/location-history",
+				"relations": "/api/v1/items/1/relations",
+				"valuations": "/api/v1/items/1/valuations"
+			}
+		}
+	}`, rec.Body.String())
+}
+
+func TestWriteItems(t *testing.T) {
+	items := []*entity.Item{{ID: 1}, {ID: 2}}
+
+	t.Run("正常系: JSON:APIでは一覧のリンクを本文にも含める", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/items?condition=A", nil)
+		req.Header.Set(echo.HeaderAccept, JSONAPIContentType)
+		rec := httptest.NewRecorder()
+
+		require.NoError(t, WriteItems(echo.New().NewContext(req, rec), http.StatusOK, items))
+
+		assert.Equal(t, `</items?condition=A>; rel="self"`, rec.Header().Get("Link"))
+		assert.Contains(t, rec.Body.String(), `"links":{"self":"/items?condition=A"}`)
+		assert.Contains(t, rec.Body.String(), `"relationships":{"location":{"data":null}}`)
+	})
+
+	t.Run("正常系: 既定の形式は配列のまま返す", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/items", nil)
+		rec := httptest.NewRecorder()
+
+		require.NoError(t, WriteItems(echo.New().NewContext(req, rec), http.StatusOK, items))
+
+		assert.Equal(t, echo.MIMEApplicationJSON, rec.Header().Get(echo.HeaderContentType))
+		assert.Equal(t, byte('['), rec.Body.Bytes()[0])
+	})
+
+	t.Run("正常系: HEADでも本文の長さを返す", func(t *testing.T) {
+		get := httptest.NewRecorder()
+		require.NoError(t, WriteItems(echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/items", nil), get), http.StatusOK, items))
+		head := httptest.NewRecorder()
+		require.NoError(t, WriteItems(echo.New().NewContext(httptest.NewRequest(http.MethodHead, "/items", nil), head), http.StatusOK, items))
+
+		assert.Equal(t, strconv.Itoa(get.Body.Len()), head.Header().Get(echo.HeaderContentLength))
+		assert.Equal(t, get.Header().Get("ETag"), head.Header().Get("ETag"))
+	})
+
+	t.Run("正常系: カテゴリーの表示名は Accept-Language の言語にする", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/items", nil)
+		req.Header.Set(i18n.HeaderAcceptLanguage, "ja,en;q=0.8")
+		rec := httptest.NewRecorder()
+
+		require.NoError(t, WriteItems(echo.New().NewContext(req, rec), http.StatusOK, []*entity.Item{{ID: 1, Category: "時計"}}))
+
+		assert.Contains(t, rec.Body.String(), `"category":"時計"`)
+		assert.Contains(t, rec.Body.String(), `"category_label":"時計"`)
+		// 言語ごとに本文が変わるため、キャッシュが言語を区別できるようにする
+		assert.Contains(t, rec.Header().Values(echo.HeaderVary), i18n.HeaderAcceptLanguage)
+	})
+}
@@ -0,0 +1,80 @@
+// Package testutil は、ハンドラーや連携先のテストで使うユースケース・リポジトリのフェイクを提供する
+// 中身は memory のリポジトリで、メソッドごとに返すエラーを仕込める
+// internal 以下にあり、扱う型（usecase・entity など）も internal のため、このモジュールの中からだけ使える
+package testutil
+
+import "sync"
+
+// メソッド名（"GetItemByID" など）ごとに返すエラーと呼び出し回数
+type Errors struct {
+	mu    sync.Mutex
+	errs  map[string]error
+	once  map[string]bool
+	calls map[string]int
+}
+
+// 以降の method の呼び出しで err を返す（nil で元に戻す）
+func (e *Errors) FailOn(method string, err error) {
+	e.set(method, err, false)
+}
+
+// 次の method の呼び出しだけ err を返す
+func (e *Errors) FailOnce(method string, err error) {
+	e.set(method, err, true)
+}
+
+func (e *Errors) set(method string, err error, once bool) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.errs == nil {
+		e.errs = make(map[string]error)
+		e.once = make(map[string]bool)
+	}
+	if err == nil {
+		delete(e.errs, method)
+		delete(e.once, method)
+		return
+	}
+	e.errs[method] = err
+	e.once[method] = once
+}
+
+// 仕込んだエラーと呼び出し回数を消す
+func (e *Errors) Reset() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.errs = nil
+	e.once = nil
+	e.calls = nil
+}
+
+// method が呼ばれた回数（エラーを返した呼び出しも含む）
+func (e *Errors) Calls(method string) int {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	return e.calls[method]
+}
+
+// 呼び出しを数え、仕込んだエラーがあれば返す
+func (e *Errors) check(method string) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.calls == nil {
+		e.calls = make(map[string]int)
+	}
+	e.calls[method]++
+
+	err, ok := e.errs[method]
+	if !ok {
+		return nil
+	}
+	if e.once[method] {
+		delete(e.errs, method)
+		delete(e.once, method)
+	}
+	return err
+}
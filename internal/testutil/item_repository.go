@@ -0,0 +1,175 @@
+package testutil
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"Aicon-assignment/internal/domain/entity"
+	"Aicon-assignment/internal/interfaces/memory"
+	"Aicon-assignment/internal/usecase"
+)
+
+// メモリ上の ItemRepository に、仕込んだエラーを返す機能を付けたもの
+type ItemRepository struct {
+	Errors
+
+	items *memory.ItemRepository
+	store *memory.Store
+}
+
+var _ usecase.ItemRepository = (*ItemRepository)(nil)
+
+func NewItemRepository() *ItemRepository {
+	store := memory.NewStore()
+	return &ItemRepository{items: &memory.ItemRepository{Store: store}, store: store}
+}
+
+// 同じデータを使う Transactor。トランザクションの中のアイテムの操作にも仕込んだエラーを返す
+func (r *ItemRepository) Transactor() usecase.Transactor {
+	return &transactor{Transactor: &memory.Transactor{Store: r.store}, items: r}
+}
+
+// items を登録し、IDなどを付けた内容を返す（仕込んだエラーは返さず、呼び出し回数にも含めない）
+func (r *ItemRepository) Seed(t testing.TB, items ...*entity.Item) []*entity.Item {
+	t.Helper()
+
+	created := make([]*entity.Item, 0, len(items))
+	for _, item := range items {
+		c, err := r.items.Create(context.Background(), item)
+		if err != nil {
+			t.Fatalf("seed item %q: %v", item.Name, err)
+		}
+		created = append(created, c)
+	}
+	return created
+}
+
+// itemID のアイテムを viewer のお気に入りにする（仕込んだエラーは返さない）
+func (r *ItemRepository) SeedFavorite(t testing.TB, viewer string, itemID int64) {
+	t.Helper()
+
+	favorites := &memory.ItemFavoriteRepository{Store: r.store}
+	if err := favorites.Add(context.Background(), entity.NewItemFavorite(viewer, itemID)); err != nil {
+		t.Fatalf("seed favorite %d: %v", itemID, err)
+	}
+}
+
+func (r *ItemRepository) FindAll(ctx context.Context, filter entity.ItemFilter) ([]*entity.Item, error) {
+	if err := r.check("FindAll"); err != nil {
+		return nil, err
+	}
+	return r.items.FindAll(ctx, filter)
+}
+
+func (r *ItemRepository) Count(ctx context.Context, filter entity.ItemFilter) (int, error) {
+	if err := r.check("Count"); err != nil {
+		return 0, err
+	}
+	return r.items.Count(ctx, filter)
+}
+
+func (r *ItemRepository) CountLocked(ctx context.Context) (int, error) {
+	if err := r.check("CountLocked"); err != nil {
+		return 0, err
+	}
+	return r.items.CountLocked(ctx)
+}
+
+func (r *ItemRepository) FindByID(ctx context.Context, id int64) (*entity.Item, error) {
+	if err := r.check("FindByID"); err != nil {
+		return nil, err
+	}
+	return r.items.FindByID(ctx, id)
+}
+
+func (r *ItemRepository) FindBySerialNumber(ctx context.Context, serialNumber string) (*entity.Item, error) {
+	if err := r.check("FindBySerialNumber"); err != nil {
+		return nil, err
+	}
+	return r.items.FindBySerialNumber(ctx, serialNumber)
+}
+
+func (r *ItemRepository) Create(ctx context.Context, item *entity.Item) (*entity.Item, error) {
+	if err := r.check("Create"); err != nil {
+		return nil, err
+	}
+	return r.items.Create(ctx, item)
+}
+
+func (r *ItemRepository) Update(ctx context.Context, item *entity.Item) error {
+	if err := r.check("Update"); err != nil {
+		return err
+	}
+	return r.items.Update(ctx, item)
+}
+
+func (r *ItemRepository) Delete(ctx context.Context, id int64) error {
+	if err := r.check("Delete"); err != nil {
+		return err
+	}
+	return r.items.Delete(ctx, id)
+}
+
+func (r *ItemRepository) Merge(ctx context.Context, survivorID int64, duplicateIDs []int64) error {
+	if err := r.check("Merge"); err != nil {
+		return err
+	}
+	return r.items.Merge(ctx, survivorID, duplicateIDs)
+}
+
+func (r *ItemRepository) PurgeDeleted(ctx context.Context, before time.Time) (int, error) {
+	if err := r.check("PurgeDeleted"); err != nil {
+		return 0, err
+	}
+	return r.items.PurgeDeleted(ctx, before)
+}
+
+func (r *ItemRepository) ReassignCategory(ctx context.Context, from, to string) ([]int64, error) {
+	if err := r.check("ReassignCategory"); err != nil {
+		return nil, err
+	}
+	return r.items.ReassignCategory(ctx, from, to)
+}
+
+func (r *ItemRepository) FindBrands(ctx context.Context) ([]string, error) {
+	if err := r.check("FindBrands"); err != nil {
+		return nil, err
+	}
+	return r.items.FindBrands(ctx)
+}
+
+func (r *ItemRepository) Suggest(ctx context.Context, prefix string, limit int) ([]entity.ItemSuggestion, error) {
+	if err := r.check("Suggest"); err != nil {
+		return nil, err
+	}
+	return r.items.Suggest(ctx, prefix, limit)
+}
+
+func (r *ItemRepository) GetSummaryByCategory(ctx context.Context) (map[string]int, error) {
+	if err := r.check("GetSummaryByCategory"); err != nil {
+		return nil, err
+	}
+	return r.items.GetSummaryByCategory(ctx)
+}
+
+func (r *ItemRepository) GetSummaryByCondition(ctx context.Context) (map[string]int, error) {
+	if err := r.check("GetSummaryByCondition"); err != nil {
+		return nil, err
+	}
+	return r.items.GetSummaryByCondition(ctx)
+}
+
+// トランザクションの中でもアイテムは仕込んだエラーを返すリポジトリで扱う
+// 他のリポジトリは memory のもので、同じデータを使う
+type transactor struct {
+	*memory.Transactor
+	items *ItemRepository
+}
+
+func (t *transactor) WithTx(ctx context.Context, fn func(repos usecase.Repositories) error) error {
+	return t.Transactor.WithTx(ctx, func(repos usecase.Repositories) error {
+		repos.Items = t.items
+		return fn(repos)
+	})
+}
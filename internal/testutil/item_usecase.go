@@ -0,0 +1,137 @@
+package testutil
+
+import (
+	"context"
+
+	"Aicon-assignment/internal/domain/entity"
+	"Aicon-assignment/internal/usecase"
+)
+
+// 本物の ItemUsecase をメモリ上のリポジトリで動かし、仕込んだエラーを返す機能を付けたもの
+// バリデーションやエラーの種類は本番と同じになるため、ハンドラーのテストで戻り値を1つずつ用意しなくてよい
+type ItemUsecase struct {
+	Errors
+
+	// 使っているリポジトリ。データの準備（Seed）やリポジトリのエラーを仕込むのに使う
+	Repo *ItemRepository
+
+	usecase usecase.ItemUsecase
+}
+
+var _ usecase.ItemUsecase = (*ItemUsecase)(nil)
+
+func NewItemUsecase() *ItemUsecase {
+	repo := NewItemRepository()
+	return &ItemUsecase{Repo: repo, usecase: usecase.NewItemUsecase(repo, repo.Transactor())}
+}
+
+// 登録できるアイテムの数に quota の上限を設けたもの
+func NewItemUsecaseWithQuota(quota usecase.Quota) *ItemUsecase {
+	repo := NewItemRepository()
+	return &ItemUsecase{Repo: repo, usecase: usecase.NewItemUsecaseWithQuota(repo, repo, repo.Transactor(), quota)}
+}
+
+func (u *ItemUsecase) GetAllItems(ctx context.Context, filter entity.ItemFilter) ([]*entity.Item, error) {
+	if err := u.check("GetAllItems"); err != nil {
+		return nil, err
+	}
+	return u.usecase.GetAllItems(ctx, filter)
+}
+
+func (u *ItemUsecase) CountItems(ctx context.Context, filter entity.ItemFilter) (int, error) {
+	if err := u.check("CountItems"); err != nil {
+		return 0, err
+	}
+	return u.usecase.CountItems(ctx, filter)
+}
+
+func (u *ItemUsecase) GetItemByID(ctx context.Context, id int64) (*entity.Item, error) {
+	if err := u.check("GetItemByID"); err != nil {
+		return nil, err
+	}
+	return u.usecase.GetItemByID(ctx, id)
+}
+
+func (u *ItemUsecase) GetItemBySerialNumber(ctx context.Context, serialNumber string) (*entity.Item, error) {
+	if err := u.check("GetItemBySerialNumber"); err != nil {
+		return nil, err
+	}
+	return u.usecase.GetItemBySerialNumber(ctx, serialNumber)
+}
+
+func (u *ItemUsecase) CreateItem(ctx context.Context, input usecase.CreateItemInput) (*entity.Item, error) {
+	if err := u.check("CreateItem"); err != nil {
+		return nil, err
+	}
+	return u.usecase.CreateItem(ctx, input)
+}
+
+func (u *ItemUsecase) ValidateItem(ctx context.Context, input usecase.CreateItemInput) (*entity.Item, error) {
+	if err := u.check("ValidateItem"); err != nil {
+		return nil, err
+	}
+	return u.usecase.ValidateItem(ctx, input)
+}
+
+func (u *ItemUsecase) PartialUpdateItem(ctx context.Context, id int64, input usecase.UpdateItemInput) (*entity.Item, error) {
+	if err := u.check("PartialUpdateItem"); err != nil {
+		return nil, err
+	}
+	return u.usecase.PartialUpdateItem(ctx, id, input)
+}
+
+func (u *ItemUsecase) DeleteItem(ctx context.Context, id int64) error {
+	if err := u.check("DeleteItem"); err != nil {
+		return err
+	}
+	return u.usecase.DeleteItem(ctx, id)
+}
+
+func (u *ItemUsecase) GetCategorySummary(ctx context.Context) (*usecase.CategorySummary, error) {
+	if err := u.check("GetCategorySummary"); err != nil {
+		return nil, err
+	}
+	return u.usecase.GetCategorySummary(ctx)
+}
+
+func (u *ItemUsecase) FindDuplicateItems(ctx context.Context) ([]*usecase.DuplicateGroup, error) {
+	if err := u.check("FindDuplicateItems"); err != nil {
+		return nil, err
+	}
+	return u.usecase.FindDuplicateItems(ctx)
+}
+
+func (u *ItemUsecase) MergeItems(ctx context.Context, survivorID int64, input usecase.MergeItemsInput) (*entity.Item, error) {
+	if err := u.check("MergeItems"); err != nil {
+		return nil, err
+	}
+	return u.usecase.MergeItems(ctx, survivorID, input)
+}
+
+func (u *ItemUsecase) CloneItem(ctx context.Context, id int64, input usecase.CloneItemInput) (*entity.Item, error) {
+	if err := u.check("CloneItem"); err != nil {
+		return nil, err
+	}
+	return u.usecase.CloneItem(ctx, id, input)
+}
+
+func (u *ItemUsecase) ArchiveItem(ctx context.Context, id int64) (*entity.Item, error) {
+	if err := u.check("ArchiveItem"); err != nil {
+		return nil, err
+	}
+	return u.usecase.ArchiveItem(ctx, id)
+}
+
+func (u *ItemUsecase) UnarchiveItem(ctx context.Context, id int64) (*entity.Item, error) {
+	if err := u.check("UnarchiveItem"); err != nil {
+		return nil, err
+	}
+	return u.usecase.UnarchiveItem(ctx, id)
+}
+
+func (u *ItemUsecase) GetUsage(ctx context.Context) (*usecase.Usage, error) {
+	if err := u.check("GetUsage"); err != nil {
+		return nil, err
+	}
+	return u.usecase.GetUsage(ctx)
+}
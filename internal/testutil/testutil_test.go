@@ -0,0 +1,82 @@
+package testutil
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"Aicon-assignment/internal/domain/entity"
+	domainErrors "Aicon-assignment/internal/domain/errors"
+	"Aicon-assignment/internal/usecase"
+)
+
+func TestItemUsecase(t *testing.T) {
+	ctx := context.Background()
+	boom := errors.New("boom")
+
+	t.Run("正常系: 本物のユースケースと同じように登録・取得できる", func(t *testing.T) {
+		u := NewItemUsecase()
+
+		created, err := u.CreateItem(ctx, usecase.CreateItemInput{Name: "バーキン", Category: "バッグ", Brand: "HERMÈS", PurchasePrice: 2000000, PurchaseDate: "2023-06-01"})
+		require.NoError(t, err)
+
+		got, err := u.GetItemByID(ctx, created.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "バーキン", got.Name)
+
+		_, err = u.GetItemByID(ctx, 999)
+		assert.ErrorIs(t, err, domainErrors.ErrItemNotFound)
+		assert.Equal(t, 2, u.Calls("GetItemByID"))
+	})
+
+	t.Run("正常系: 準備したデータを読める", func(t *testing.T) {
+		u := NewItemUsecase()
+		u.Repo.Seed(t, &entity.Item{Name: "デイトナ", Category: "時計", Brand: "ROLEX", PurchasePrice: 1500000, PurchaseDate: "2023-01-15"})
+
+		items, err := u.GetAllItems(ctx, entity.ItemFilter{})
+		require.NoError(t, err)
+		assert.Len(t, items, 1)
+		assert.Equal(t, 0, u.Repo.Calls("Create"))
+	})
+
+	t.Run("異常系: 仕込んだエラーを返す", func(t *testing.T) {
+		u := NewItemUsecase()
+		u.FailOn("GetAllItems", boom)
+
+		for i := 0; i < 2; i++ {
+			_, err := u.GetAllItems(ctx, entity.ItemFilter{})
+			assert.ErrorIs(t, err, boom)
+		}
+
+		u.FailOn("GetAllItems", nil)
+		_, err := u.GetAllItems(ctx, entity.ItemFilter{})
+		assert.NoError(t, err)
+	})
+
+	t.Run("異常系: 1回だけエラーを返す", func(t *testing.T) {
+		u := NewItemUsecase()
+		u.FailOnce("CountItems", boom)
+
+		_, err := u.CountItems(ctx, entity.ItemFilter{})
+		assert.ErrorIs(t, err, boom)
+		_, err = u.CountItems(ctx, entity.ItemFilter{})
+		assert.NoError(t, err)
+	})
+
+	t.Run("異常系: リポジトリのエラーはユースケースを通して返る", func(t *testing.T) {
+		u := NewItemUsecase()
+		item := u.Repo.Seed(t, &entity.Item{Name: "バーキン", Category: "バッグ", Brand: "HERMÈS", PurchasePrice: 2000000, PurchaseDate: "2023-06-01"})[0]
+		u.Repo.FailOn("Update", domainErrors.ErrDatabaseError)
+
+		name := "バーキン 30"
+		_, err := u.PartialUpdateItem(ctx, item.ID, usecase.UpdateItemInput{Name: &name})
+		assert.ErrorIs(t, err, domainErrors.ErrDatabaseError)
+
+		got, err := u.GetItemByID(ctx, item.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "バーキン", got.Name)
+	})
+}
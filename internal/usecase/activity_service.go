@@ -0,0 +1,116 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+
+	"Aicon-assignment/internal/domain/entity"
+	domainErrors "Aicon-assignment/internal/domain/errors"
+)
+
+type ActivityUsecase interface {
+	// アイテムのイベントと監査ログを合わせて新しい順に返す
+	GetActivity(ctx context.Context, query entity.ActivityQuery) (*entity.ActivityPage, error)
+}
+
+type activityUsecase struct {
+	outboxRepo   OutboxRepository
+	auditLogRepo AuditLogRepository
+}
+
+func NewActivityUsecase(outboxRepo OutboxRepository, auditLogRepo AuditLogRepository) ActivityUsecase {
+	return &activityUsecase{
+		outboxRepo:   outboxRepo,
+		auditLogRepo: auditLogRepo,
+	}
+}
+
+func (u *activityUsecase) GetActivity(ctx context.Context, query entity.ActivityQuery) (*entity.ActivityPage, error) {
+	if err := query.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %w", domainErrors.ErrInvalidInput, err)
+	}
+
+	types := query.Types
+	if len(types) == 0 {
+		types = entity.ActivityTypes
+	}
+	var eventTypes []string
+	for _, activityType := range types {
+		if activityType != entity.AuditCategoryReassigned && !slices.Contains(eventTypes, activityType) {
+			eventTypes = append(eventTypes, activityType)
+		}
+	}
+
+	// 2つの記録を合わせて数えるため、それぞれ先頭からページの終わりまで読む（続きがあるかを知るため1件多く読む）
+	end := query.Offset + query.Limit
+	messages, err := u.outboxRepo.FindRecent(ctx, eventTypes, end+1)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve events: %w", err)
+	}
+	var entries []*entity.AuditEntry
+	if slices.Contains(types, entity.AuditCategoryReassigned) {
+		entries, err = u.auditLogRepo.FindRecent(ctx, end+1)
+		if err != nil {
+			return nil, fmt.Errorf("failed to retrieve audit log: %w", err)
+		}
+	}
+
+	type source struct {
+		activity *entity.Activity
+		message  *entity.OutboxMessage
+	}
+	sources := make([]source, 0, len(messages)+len(entries))
+	for _, message := range messages {
+		sources = append(sources, source{activity: &entity.Activity{Type: message.EventType, ItemID: message.AggregateID, CreatedAt: message.CreatedAt}, message: message})
+	}
+	for _, entry := range entries {
+		sources = append(sources, source{activity: &entity.Activity{Type: entry.Action, Detail: entry.Detail, CreatedAt: entry.CreatedAt}})
+	}
+	// それぞれ新しい順に読んでいるため、同じ日時の場合は読んだ順のままにする
+	sort.SliceStable(sources, func(i, j int) bool {
+		return sources[i].activity.CreatedAt.After(sources[j].activity.CreatedAt)
+	})
+
+	page := &entity.ActivityPage{Activities: []*entity.Activity{}, HasMore: len(sources) > end}
+	for _, src := range sources[min(query.Offset, len(sources)):min(end, len(sources))] {
+		if src.message != nil {
+			if err := u.describeItemEvent(ctx, src.activity, src.message); err != nil {
+				return nil, err
+			}
+		}
+		page.Activities = append(page.Activities, src.activity)
+	}
+
+	return page, nil
+}
+
+// 記録した時点のアイテムの名前と、更新の場合は前の記録から変わった項目を加える
+func (u *activityUsecase) describeItemEvent(ctx context.Context, activity *entity.Activity, message *entity.OutboxMessage) error {
+	var item struct {
+		Name string `json:"name"`
+	}
+	if err := json.Unmarshal([]byte(message.Payload), &item); err != nil {
+		return fmt.Errorf("failed to decode event %d: %w", message.ID, err)
+	}
+	activity.ItemName = item.Name
+
+	if message.EventType != entity.EventItemUpdated {
+		return nil
+	}
+	previous, err := u.outboxRepo.FindPrevious(ctx, message.AggregateID, message.ID)
+	if err != nil {
+		return fmt.Errorf("failed to retrieve previous event: %w", err)
+	}
+	if previous == nil {
+		return nil
+	}
+	changes, err := entity.ChangedItemFields(previous.Payload, message.Payload)
+	if err != nil {
+		return fmt.Errorf("failed to compare event %d: %w", message.ID, err)
+	}
+	activity.Changes = changes
+	return nil
+}
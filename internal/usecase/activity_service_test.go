@@ -0,0 +1,111 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"Aicon-assignment/internal/domain/entity"
+	domainErrors "Aicon-assignment/internal/domain/errors"
+)
+
+// MockAuditLogRepository はtestify/mockを使用したモックリポジトリ
+type MockAuditLogRepository struct {
+	mock.Mock
+}
+
+func (m *MockAuditLogRepository) FindRecent(ctx context.Context, limit int) ([]*entity.AuditEntry, error) {
+	args := m.Called(ctx, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.AuditEntry), args.Error(1)
+}
+
+func TestActivityUsecase_GetActivity(t *testing.T) {
+	at := func(hour int) time.Time { return time.Date(2026, 10, 16, hour, 0, 0, 0, time.UTC) }
+	itemEvents := []string{entity.EventItemCreated, entity.EventItemUpdated, entity.EventItemDeleted, entity.EventItemSold}
+	reassigned := json.RawMessage(`{"from":"時計","to":"腕時計","affected":2}`)
+
+	t.Run("正常系: イベントと監査ログを新しい順に合わせる", func(t *testing.T) {
+		outboxRepo := new(MockOutboxRepository)
+		auditLogRepo := new(MockAuditLogRepository)
+		outboxRepo.On("FindRecent", mock.Anything, itemEvents, 21).Return([]*entity.OutboxMessage{
+			{ID: 3, EventType: entity.EventItemUpdated, AggregateID: 1, Payload: `{"id":1,"name":"ロレックス デイトナ","purchase_price":1600000}`, CreatedAt: at(12)},
+			{ID: 2, EventType: entity.EventItemCreated, AggregateID: 1, Payload: `{"id":1,"name":"ロレックス デイトナ","purchase_price":1500000}`, CreatedAt: at(10)},
+		}, nil)
+		outboxRepo.On("FindPrevious", mock.Anything, int64(1), int64(3)).Return(&entity.OutboxMessage{
+			ID: 2, Payload: `{"id":1,"name":"ロレックス デイトナ","purchase_price":1500000}`,
+		}, nil)
+		auditLogRepo.On("FindRecent", mock.Anything, 21).Return([]*entity.AuditEntry{
+			{ID: 1, Action: entity.AuditCategoryReassigned, Detail: reassigned, CreatedAt: at(11)},
+		}, nil)
+
+		page, err := NewActivityUsecase(outboxRepo, auditLogRepo).GetActivity(context.Background(), entity.ActivityQuery{Limit: 20})
+
+		require.NoError(t, err)
+		assert.False(t, page.HasMore)
+		assert.Equal(t, []*entity.Activity{
+			{Type: entity.EventItemUpdated, ItemID: 1, ItemName: "ロレックス デイトナ", Changes: []string{"purchase_price"}, CreatedAt: at(12)},
+			{Type: entity.AuditCategoryReassigned, Detail: reassigned, CreatedAt: at(11)},
+			{Type: entity.EventItemCreated, ItemID: 1, ItemName: "ロレックス デイトナ", CreatedAt: at(10)},
+		}, page.Activities)
+	})
+
+	t.Run("正常系: 種類で絞り込み、ページを切り出す", func(t *testing.T) {
+		outboxRepo := new(MockOutboxRepository)
+		auditLogRepo := new(MockAuditLogRepository)
+		outboxRepo.On("FindRecent", mock.Anything, []string{entity.EventItemDeleted}, 3).Return([]*entity.OutboxMessage{
+			{ID: 9, EventType: entity.EventItemDeleted, AggregateID: 5, Payload: `{"id":5,"name":"エルメス バーキン"}`, CreatedAt: at(12)},
+			{ID: 8, EventType: entity.EventItemDeleted, AggregateID: 4, Payload: `{"id":4,"name":"カルティエ タンク"}`, CreatedAt: at(11)},
+			{ID: 7, EventType: entity.EventItemDeleted, AggregateID: 3, Payload: `{"id":3,"name":"シャネル マトラッセ"}`, CreatedAt: at(10)},
+		}, nil)
+
+		page, err := NewActivityUsecase(outboxRepo, auditLogRepo).GetActivity(context.Background(), entity.ActivityQuery{
+			Types: []string{entity.EventItemDeleted}, Limit: 1, Offset: 1,
+		})
+
+		require.NoError(t, err)
+		assert.True(t, page.HasMore)
+		require.Len(t, page.Activities, 1)
+		assert.Equal(t, "カルティエ タンク", page.Activities[0].ItemName)
+		auditLogRepo.AssertNotCalled(t, "FindRecent", mock.Anything, mock.Anything)
+	})
+
+	t.Run("正常系: 監査ログだけを指定", func(t *testing.T) {
+		outboxRepo := new(MockOutboxRepository)
+		auditLogRepo := new(MockAuditLogRepository)
+		outboxRepo.On("FindRecent", mock.Anything, []string(nil), 21).Return([]*entity.OutboxMessage{}, nil)
+		auditLogRepo.On("FindRecent", mock.Anything, 21).Return([]*entity.AuditEntry{}, nil)
+
+		page, err := NewActivityUsecase(outboxRepo, auditLogRepo).GetActivity(context.Background(), entity.ActivityQuery{
+			Types: []string{entity.AuditCategoryReassigned}, Limit: 20,
+		})
+
+		require.NoError(t, err)
+		assert.Empty(t, page.Activities)
+		assert.False(t, page.HasMore)
+	})
+
+	t.Run("異常系: 知らない種類", func(t *testing.T) {
+		_, err := NewActivityUsecase(new(MockOutboxRepository), new(MockAuditLogRepository)).GetActivity(context.Background(), entity.ActivityQuery{
+			Types: []string{"item.viewed"}, Limit: 20,
+		})
+
+		assert.ErrorIs(t, err, domainErrors.ErrInvalidInput)
+	})
+
+	t.Run("異常系: イベントの取得に失敗", func(t *testing.T) {
+		outboxRepo := new(MockOutboxRepository)
+		outboxRepo.On("FindRecent", mock.Anything, itemEvents, 21).Return(nil, domainErrors.ErrDatabaseError)
+
+		_, err := NewActivityUsecase(outboxRepo, new(MockAuditLogRepository)).GetActivity(context.Background(), entity.ActivityQuery{Limit: 20})
+
+		assert.ErrorIs(t, err, domainErrors.ErrDatabaseError)
+	})
+}
@@ -0,0 +1,29 @@
+package usecase
+
+import (
+	"context"
+
+	"Aicon-assignment/internal/domain/entity"
+)
+
+// アーカイブしたアイテムは一覧と集計から外れるが、IDでの取得や ?include=archived の一覧では返す
+// 既にアーカイブしている（していない）場合は何も変えずに返す
+func (u *itemUsecase) ArchiveItem(ctx context.Context, id int64) (*entity.Item, error) {
+	return u.changeItem(ctx, id, func(item *entity.Item) bool {
+		if item.IsArchived() {
+			return false
+		}
+		item.Archive()
+		return true
+	})
+}
+
+func (u *itemUsecase) UnarchiveItem(ctx context.Context, id int64) (*entity.Item, error) {
+	return u.changeItem(ctx, id, func(item *entity.Item) bool {
+		if !item.IsArchived() {
+			return false
+		}
+		item.Unarchive()
+		return true
+	})
+}
@@ -0,0 +1,72 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"Aicon-assignment/internal/domain/entity"
+	domainErrors "Aicon-assignment/internal/domain/errors"
+)
+
+func TestItemUsecase_ArchiveItem(t *testing.T) {
+	t.Run("正常系: アーカイブする", func(t *testing.T) {
+		mockRepo := new(MockItemRepository)
+		mockRepo.On("FindByID", mock.Anything, int64(1)).Return(&entity.Item{ID: 1}, nil)
+		mockRepo.On("Update", mock.Anything, mock.MatchedBy(func(item *entity.Item) bool {
+			return item.ID == 1 && item.IsArchived()
+		})).Return(nil)
+
+		usecase := NewItemUsecase(mockRepo, &MockTransactor{repos: Repositories{Items: mockRepo}})
+		item, err := usecase.ArchiveItem(context.Background(), 1)
+
+		require.NoError(t, err)
+		assert.NotNil(t, item.ArchivedAt)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("正常系: 既にアーカイブしていれば日時を変えない", func(t *testing.T) {
+		archivedAt := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+		mockRepo := new(MockItemRepository)
+		mockRepo.On("FindByID", mock.Anything, int64(1)).Return(&entity.Item{ID: 1, ArchivedAt: &archivedAt}, nil)
+
+		usecase := NewItemUsecase(mockRepo, &MockTransactor{repos: Repositories{Items: mockRepo}})
+		item, err := usecase.ArchiveItem(context.Background(), 1)
+
+		require.NoError(t, err)
+		assert.Equal(t, archivedAt, *item.ArchivedAt)
+		mockRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+
+	t.Run("異常系: アイテムが存在しない", func(t *testing.T) {
+		mockRepo := new(MockItemRepository)
+		mockRepo.On("FindByID", mock.Anything, int64(9)).Return(nil, domainErrors.ErrItemNotFound)
+
+		usecase := NewItemUsecase(mockRepo, &MockTransactor{repos: Repositories{Items: mockRepo}})
+		_, err := usecase.ArchiveItem(context.Background(), 9)
+
+		assert.ErrorIs(t, err, domainErrors.ErrItemNotFound)
+	})
+}
+
+func TestItemUsecase_UnarchiveItem(t *testing.T) {
+	t.Run("正常系: アーカイブを解除する", func(t *testing.T) {
+		archivedAt := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+		mockRepo := new(MockItemRepository)
+		mockRepo.On("FindByID", mock.Anything, int64(1)).Return(&entity.Item{ID: 1, ArchivedAt: &archivedAt}, nil)
+		mockRepo.On("Update", mock.Anything, mock.MatchedBy(func(item *entity.Item) bool {
+			return item.ID == 1 && !item.IsArchived()
+		})).Return(nil)
+
+		usecase := NewItemUsecase(mockRepo, &MockTransactor{repos: Repositories{Items: mockRepo}})
+		item, err := usecase.UnarchiveItem(context.Background(), 1)
+
+		require.NoError(t, err)
+		assert.Nil(t, item.ArchivedAt)
+		mockRepo.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,35 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"Aicon-assignment/internal/domain/entity"
+)
+
+// 監査ログを一度に返す件数
+const auditLogLimit = 100
+
+type AuditLogUsecase interface {
+	// 新しい順に返す
+	GetAuditLog(ctx context.Context) ([]*entity.AuditEntry, error)
+}
+
+type auditLogUsecase struct {
+	auditLogRepo AuditLogRepository
+}
+
+func NewAuditLogUsecase(auditLogRepo AuditLogRepository) AuditLogUsecase {
+	return &auditLogUsecase{
+		auditLogRepo: auditLogRepo,
+	}
+}
+
+func (u *auditLogUsecase) GetAuditLog(ctx context.Context) ([]*entity.AuditEntry, error) {
+	entries, err := u.auditLogRepo.FindRecent(ctx, auditLogLimit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve audit log: %w", err)
+	}
+
+	return entries, nil
+}
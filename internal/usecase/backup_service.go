@@ -0,0 +1,65 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"Aicon-assignment/internal/domain/entity"
+	domainErrors "Aicon-assignment/internal/domain/errors"
+)
+
+type BackupUsecase interface {
+	Backup(ctx context.Context) (*entity.Backup, error)
+	Restore(ctx context.Context, backup *entity.Backup, force bool) (*RestoreResult, error)
+}
+
+// 復元した件数
+type RestoreResult struct {
+	Locations       int `json:"locations"`
+	Items           int `json:"items"`
+	Loans           int `json:"loans"`
+	LocationHistory int `json:"location_history"`
+	Templates       int `json:"templates"`
+}
+
+type backupUsecase struct {
+	backupRepo BackupRepository
+}
+
+func NewBackupUsecase(backupRepo BackupRepository) BackupUsecase {
+	return &backupUsecase{
+		backupRepo: backupRepo,
+	}
+}
+
+func (u *backupUsecase) Backup(ctx context.Context) (*entity.Backup, error) {
+	backup, err := u.backupRepo.Dump(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to dump data: %w", err)
+	}
+
+	backup.Version = entity.BackupVersion
+	backup.CreatedAt = time.Now().UTC()
+	return backup, nil
+}
+
+// 内容を全て検証してから読み込む。force でなければ空のデータベースにのみ復元する
+// 復元したアイテムの変更イベントは配信しない
+func (u *backupUsecase) Restore(ctx context.Context, backup *entity.Backup, force bool) (*RestoreResult, error) {
+	if err := backup.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %s", domainErrors.ErrInvalidInput, err.Error())
+	}
+
+	if err := u.backupRepo.Restore(ctx, backup, force); err != nil {
+		return nil, err
+	}
+
+	return &RestoreResult{
+		Locations:       len(backup.Locations),
+		Items:           len(backup.Items),
+		Loans:           len(backup.Loans),
+		LocationHistory: len(backup.LocationHistory),
+		Templates:       len(backup.Templates),
+	}, nil
+}
@@ -0,0 +1,97 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"Aicon-assignment/internal/domain/entity"
+	domainErrors "Aicon-assignment/internal/domain/errors"
+)
+
+// MockBackupRepository はtestify/mockを使用したモックリポジトリ
+type MockBackupRepository struct {
+	mock.Mock
+}
+
+func (m *MockBackupRepository) Dump(ctx context.Context) (*entity.Backup, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.Backup), args.Error(1)
+}
+
+func (m *MockBackupRepository) Restore(ctx context.Context, backup *entity.Backup, replace bool) error {
+	args := m.Called(ctx, backup, replace)
+	return args.Error(0)
+}
+
+func (m *MockBackupRepository) Erase(ctx context.Context) error {
+	args := m.Called(ctx)
+	return args.Error(0)
+}
+
+func TestBackupUsecase_Backup(t *testing.T) {
+	t.Run("正常系: 形式のバージョンと作成日時を付ける", func(t *testing.T) {
+		repo := new(MockBackupRepository)
+		repo.On("Dump", mock.Anything).Return(&entity.Backup{Items: []*entity.Item{{ID: 1}}}, nil)
+
+		backup, err := NewBackupUsecase(repo).Backup(context.Background())
+
+		require.NoError(t, err)
+		assert.Equal(t, entity.BackupVersion, backup.Version)
+		assert.False(t, backup.CreatedAt.IsZero())
+		assert.Len(t, backup.Items, 1)
+	})
+}
+
+func TestBackupUsecase_Restore(t *testing.T) {
+	newBackup := func() *entity.Backup {
+		locationID := int64(1)
+		return &entity.Backup{
+			Version:   entity.BackupVersion,
+			Locations: []*entity.Location{{ID: 1, Name: "金庫"}},
+			Items: []*entity.Item{{
+				ID: 1, Name: "ロレックス デイトナ", Category: "時計", Brand: "ROLEX",
+				PurchasePrice: 1500000, PurchaseDate: "2023-01-15", SerialNumber: "D123456", LocationID: &locationID,
+			}},
+			Loans: []*entity.Loan{{ID: 1, ItemID: 1, Borrower: "山田", DueDate: "2023-02-01"}},
+		}
+	}
+
+	t.Run("正常系: 件数を返す", func(t *testing.T) {
+		repo := new(MockBackupRepository)
+		repo.On("Restore", mock.Anything, mock.Anything, true).Return(nil)
+
+		result, err := NewBackupUsecase(repo).Restore(context.Background(), newBackup(), true)
+
+		require.NoError(t, err)
+		assert.Equal(t, &RestoreResult{Locations: 1, Items: 1, Loans: 1}, result)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("異常系: 参照先がないバックアップは読み込まない", func(t *testing.T) {
+		repo := new(MockBackupRepository)
+		backup := newBackup()
+		backup.Locations = nil
+
+		_, err := NewBackupUsecase(repo).Restore(context.Background(), backup, false)
+
+		assert.True(t, domainErrors.IsValidationError(err))
+		assert.Contains(t, err.Error(), "items[0]: location 1 is not in the backup")
+		repo.AssertNotCalled(t, "Restore", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("異常系: データが残っている", func(t *testing.T) {
+		repo := new(MockBackupRepository)
+		repo.On("Restore", mock.Anything, mock.Anything, false).Return(domainErrors.ErrRestoreNotEmpty)
+
+		_, err := NewBackupUsecase(repo).Restore(context.Background(), newBackup(), false)
+
+		assert.True(t, domainErrors.IsConflictError(err))
+	})
+}
@@ -0,0 +1,75 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"Aicon-assignment/internal/domain/entity"
+	domainErrors "Aicon-assignment/internal/domain/errors"
+)
+
+// 認証なしで見られる公開カタログ
+type CatalogUsecase interface {
+	// category が空の場合は公開する全てのカテゴリー
+	GetCatalogItems(ctx context.Context, category string) ([]*entity.CatalogItem, error)
+	// 公開しないカテゴリーのアイテムは見つからない扱いにする
+	GetCatalogItem(ctx context.Context, id int64) (*entity.CatalogItem, error)
+}
+
+// 公開する範囲
+type Catalog struct {
+	Categories []string // 空の場合は全てのカテゴリー
+	Fields     []string
+}
+
+func (c Catalog) includes(category string) bool {
+	return len(c.Categories) == 0 || slices.Contains(c.Categories, category)
+}
+
+type catalogUsecase struct {
+	itemRepo ItemRepository
+	catalog  Catalog
+}
+
+func NewCatalogUsecase(itemRepo ItemRepository, catalog Catalog) CatalogUsecase {
+	return &catalogUsecase{
+		itemRepo: itemRepo,
+		catalog:  catalog,
+	}
+}
+
+func (u *catalogUsecase) GetCatalogItems(ctx context.Context, category string) ([]*entity.CatalogItem, error) {
+	if category != "" && !u.catalog.includes(category) {
+		return []*entity.CatalogItem{}, nil
+	}
+
+	items, err := u.itemRepo.FindAll(ctx, entity.ItemFilter{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve items: %w", err)
+	}
+
+	catalogItems := make([]*entity.CatalogItem, 0, len(items))
+	for _, item := range items {
+		if !u.catalog.includes(item.Category) || (category != "" && item.Category != category) {
+			continue
+		}
+		catalogItems = append(catalogItems, entity.NewCatalogItem(item, u.catalog.Fields))
+	}
+	return catalogItems, nil
+}
+
+func (u *catalogUsecase) GetCatalogItem(ctx context.Context, id int64) (*entity.CatalogItem, error) {
+	item, err := u.itemRepo.FindByID(ctx, id)
+	if err != nil {
+		if domainErrors.IsNotFoundError(err) {
+			return nil, domainErrors.ErrItemNotFound
+		}
+		return nil, fmt.Errorf("failed to retrieve item: %w", err)
+	}
+	// 一覧と同じく、対象外のカテゴリーとアーカイブしたアイテムは存在しないものとして扱う
+	if !u.catalog.includes(item.Category) || item.IsArchived() {
+		return nil, domainErrors.ErrItemNotFound
+	}
+	return entity.NewCatalogItem(item, u.catalog.Fields), nil
+}
@@ -0,0 +1,85 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"Aicon-assignment/internal/domain/entity"
+	domainErrors "Aicon-assignment/internal/domain/errors"
+)
+
+func TestCatalogUsecase_GetCatalogItems(t *testing.T) {
+	items := []*entity.Item{
+		{ID: 1, Name: "ロレックス デイトナ", Category: "時計", Brand: "ROLEX", PurchasePrice: 1500000, SerialNumber: "116500LN"},
+		{ID: 2, Name: "ティファニー ネックレス", Category: "ジュエリー", Brand: "Tiffany", PurchasePrice: 50000},
+		{ID: 3, Name: "エルメス バーキン", Category: "バッグ", Brand: "HERMES", PurchasePrice: 2000000},
+	}
+	catalog := Catalog{Categories: []string{"時計", "バッグ"}, Fields: []string{"name", "brand"}}
+
+	tests := []struct {
+		name     string
+		category string
+		wantIDs  []int64
+	}{
+		{name: "正常系: 公開するカテゴリーだけを返す", wantIDs: []int64{1, 3}},
+		{name: "正常系: カテゴリーで絞り込む", category: "バッグ", wantIDs: []int64{3}},
+		{name: "正常系: 公開しないカテゴリーは空", category: "ジュエリー", wantIDs: []int64{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			itemRepo := new(MockItemRepository)
+			itemRepo.On("FindAll", mock.Anything, entity.ItemFilter{}).Return(items, nil)
+			usecase := NewCatalogUsecase(itemRepo, catalog)
+
+			got, err := usecase.GetCatalogItems(context.Background(), tt.category)
+
+			require.NoError(t, err)
+			ids := make([]int64, 0, len(got))
+			for _, item := range got {
+				ids = append(ids, item.ID)
+				assert.Empty(t, item.Category, "公開しない項目は含めない")
+			}
+			assert.Equal(t, tt.wantIDs, ids)
+		})
+	}
+}
+
+func TestCatalogUsecase_GetCatalogItem(t *testing.T) {
+	catalog := Catalog{Categories: []string{"時計"}, Fields: entity.DefaultCatalogFields}
+
+	t.Run("正常系: 選んだ項目だけを返す", func(t *testing.T) {
+		itemRepo := new(MockItemRepository)
+		itemRepo.On("FindByID", mock.Anything, int64(1)).Return(&entity.Item{ID: 1, Name: "ロレックス デイトナ", Category: "時計", Brand: "ROLEX", Condition: "S", PurchasePrice: 1500000}, nil)
+		usecase := NewCatalogUsecase(itemRepo, catalog)
+
+		got, err := usecase.GetCatalogItem(context.Background(), 1)
+
+		require.NoError(t, err)
+		assert.Equal(t, &entity.CatalogItem{ID: 1, Name: "ロレックス デイトナ", Category: "時計", Brand: "ROLEX", Condition: "S"}, got)
+	})
+
+	t.Run("異常系: 公開しないカテゴリーは見つからない扱い", func(t *testing.T) {
+		itemRepo := new(MockItemRepository)
+		itemRepo.On("FindByID", mock.Anything, int64(2)).Return(&entity.Item{ID: 2, Category: "ジュエリー"}, nil)
+		usecase := NewCatalogUsecase(itemRepo, catalog)
+
+		_, err := usecase.GetCatalogItem(context.Background(), 2)
+
+		assert.ErrorIs(t, err, domainErrors.ErrItemNotFound)
+	})
+
+	t.Run("異常系: アイテムが存在しない", func(t *testing.T) {
+		itemRepo := new(MockItemRepository)
+		itemRepo.On("FindByID", mock.Anything, int64(999)).Return(nil, domainErrors.ErrItemNotFound)
+		usecase := NewCatalogUsecase(itemRepo, catalog)
+
+		_, err := usecase.GetCatalogItem(context.Background(), 999)
+
+		assert.ErrorIs(t, err, domainErrors.ErrItemNotFound)
+	})
+}
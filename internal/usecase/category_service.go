@@ -0,0 +1,79 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"Aicon-assignment/internal/domain/entity"
+	domainErrors "Aicon-assignment/internal/domain/errors"
+)
+
+type CategoryUsecase interface {
+	// カテゴリーが from のアイテムを全て input.To に付け替え、付け替えた数を返す
+	ReassignCategory(ctx context.Context, from string, input ReassignCategoryInput) (*entity.CategoryReassignment, error)
+}
+
+type ReassignCategoryInput struct {
+	To string `json:"to"`
+}
+
+type categoryUsecase struct {
+	transactor Transactor
+}
+
+func NewCategoryUsecase(transactor Transactor) CategoryUsecase {
+	return &categoryUsecase{
+		transactor: transactor,
+	}
+}
+
+// アーカイブしたアイテムも付け替える。変更の記録はアイテムごとのイベントではなく監査ログの1件にまとめる
+// 付け替え先のカテゴリーの属性スキーマかルールを満たさないアイテムが1つでもあれば、何も変えずに該当するアイテムの一覧を返す
+func (u *categoryUsecase) ReassignCategory(ctx context.Context, from string, input ReassignCategoryInput) (*entity.CategoryReassignment, error) {
+	reassignment, err := entity.NewCategoryReassignment(from, input.To)
+	if err != nil {
+		var fieldErrors entity.FieldErrors
+		if errors.As(err, &fieldErrors) {
+			return nil, fmt.Errorf("%w: %w", domainErrors.ErrInvalidInput, err)
+		}
+		return nil, err
+	}
+
+	err = u.transactor.WithTx(ctx, func(repos Repositories) error {
+		ids, err := repos.Items.ReassignCategory(ctx, reassignment.From, reassignment.To)
+		if err != nil {
+			return fmt.Errorf("failed to reassign category: %w", err)
+		}
+		if len(ids) == 0 {
+			return nil
+		}
+
+		// 付け替えたアイテムはトランザクションが終わるまでロックしているため、読み直して確かめる
+		items, err := repos.Items.FindAll(ctx, entity.ItemFilter{Category: reassignment.To, IncludeArchived: true})
+		if err != nil {
+			return fmt.Errorf("failed to retrieve items: %w", err)
+		}
+		reassigned := make(map[int64]bool, len(ids))
+		for _, id := range ids {
+			reassigned[id] = true
+		}
+		var moved []*entity.Item
+		for _, item := range items {
+			if reassigned[item.ID] {
+				moved = append(moved, item)
+			}
+		}
+		if errs := reassignment.CheckItems(moved); len(errs) > 0 {
+			return fmt.Errorf("%w: %w", domainErrors.ErrInvalidInput, errs)
+		}
+
+		reassignment.Affected = len(ids)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return reassignment, nil
+}
@@ -0,0 +1,102 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"Aicon-assignment/internal/domain/entity"
+	domainErrors "Aicon-assignment/internal/domain/errors"
+)
+
+func TestCategoryUsecase_ReassignCategory(t *testing.T) {
+	t.Run("正常系: 付け替えた数を返す", func(t *testing.T) {
+		mockRepo := new(MockItemRepository)
+		mockRepo.On("ReassignCategory", mock.Anything, "時計", "ジュエリー").Return([]int64{1, 2, 3}, nil)
+		mockRepo.On("FindAll", mock.Anything, entity.ItemFilter{Category: "ジュエリー", IncludeArchived: true}).Return([]*entity.Item{
+			{ID: 1, Category: "ジュエリー", SerialNumber: "A1"},
+			{ID: 2, Category: "ジュエリー"},
+			{ID: 3, Category: "ジュエリー", Attributes: map[string]string{"movement": "automatic"}},
+		}, nil)
+
+		usecase := NewCategoryUsecase(&MockTransactor{repos: Repositories{Items: mockRepo}})
+		reassignment, err := usecase.ReassignCategory(context.Background(), " 時計 ", ReassignCategoryInput{To: "ジュエリー"})
+
+		require.NoError(t, err)
+		assert.Equal(t, "時計", reassignment.From)
+		assert.Equal(t, "ジュエリー", reassignment.To)
+		assert.Equal(t, 3, reassignment.Affected)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("正常系: 該当するアイテムがない", func(t *testing.T) {
+		mockRepo := new(MockItemRepository)
+		mockRepo.On("ReassignCategory", mock.Anything, "腕時計", "時計").Return(nil, nil)
+
+		usecase := NewCategoryUsecase(&MockTransactor{repos: Repositories{Items: mockRepo}})
+		reassignment, err := usecase.ReassignCategory(context.Background(), "腕時計", ReassignCategoryInput{To: "時計"})
+
+		require.NoError(t, err)
+		assert.Equal(t, 0, reassignment.Affected)
+		mockRepo.AssertNotCalled(t, "FindAll", mock.Anything, mock.Anything)
+	})
+
+	t.Run("異常系: 付け替え先のルールと属性スキーマを満たさないアイテムを返す", func(t *testing.T) {
+		mockRepo := new(MockItemRepository)
+		mockRepo.On("ReassignCategory", mock.Anything, "バッグ", "時計").Return([]int64{2, 3}, nil)
+		mockRepo.On("FindAll", mock.Anything, entity.ItemFilter{Category: "時計", IncludeArchived: true}).Return([]*entity.Item{
+			{ID: 1, Category: "時計", SerialNumber: "A1", Attributes: map[string]string{"color": "black"}},
+			{ID: 2, Category: "時計", SerialNumber: "B2", Attributes: map[string]string{"movement": "automatic"}},
+			{ID: 3, Category: "時計", Attributes: map[string]string{"color": "black"}},
+		}, nil)
+
+		usecase := NewCategoryUsecase(&MockTransactor{repos: Repositories{Items: mockRepo}})
+		_, err := usecase.ReassignCategory(context.Background(), "バッグ", ReassignCategoryInput{To: "時計"})
+
+		assert.ErrorIs(t, err, domainErrors.ErrInvalidInput)
+		var fieldErrors entity.FieldErrors
+		require.True(t, errors.As(err, &fieldErrors))
+		// 元から時計だったアイテム1は確かめない
+		var fields []string
+		for _, e := range fieldErrors {
+			fields = append(fields, e.Field)
+		}
+		assert.Equal(t, []string{"items[3].attributes.color", "items[3].serial_number"}, fields)
+	})
+
+	tests := []struct {
+		name string
+		from string
+		to   string
+	}{
+		{name: "異常系: 付け替え先がない", from: "時計", to: ""},
+		{name: "異常系: 付け替え先が定義されていないカテゴリー", from: "時計", to: "家具"},
+		{name: "異常系: 付け替え元と付け替え先が同じ", from: "時計", to: "時計"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(MockItemRepository)
+
+			usecase := NewCategoryUsecase(&MockTransactor{repos: Repositories{Items: mockRepo}})
+			_, err := usecase.ReassignCategory(context.Background(), tt.from, ReassignCategoryInput{To: tt.to})
+
+			assert.ErrorIs(t, err, domainErrors.ErrInvalidInput)
+			mockRepo.AssertNotCalled(t, "ReassignCategory", mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+
+	t.Run("異常系: リポジトリのエラー", func(t *testing.T) {
+		mockRepo := new(MockItemRepository)
+		mockRepo.On("ReassignCategory", mock.Anything, "時計", "靴").Return(nil, errors.New("database error"))
+
+		usecase := NewCategoryUsecase(&MockTransactor{repos: Repositories{Items: mockRepo}})
+		_, err := usecase.ReassignCategory(context.Background(), "時計", ReassignCategoryInput{To: "靴"})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to reassign category")
+	})
+}
@@ -0,0 +1,97 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"Aicon-assignment/internal/domain/entity"
+	domainErrors "Aicon-assignment/internal/domain/errors"
+)
+
+// 複製時に上書きするフィールド（未指定のフィールドは複製元の値を引き継ぐ）
+type CloneItemInput struct {
+	Name          *string           `json:"name,omitempty"`
+	Category      *string           `json:"category,omitempty"`
+	Brand         *string           `json:"brand,omitempty"`
+	PurchasePrice *int              `json:"purchase_price,omitempty"`
+	PurchaseDate  *string           `json:"purchase_date,omitempty"`
+	Condition     *string           `json:"condition,omitempty"`
+	SerialNumber  *string           `json:"serial_number,omitempty"`
+	Attributes    map[string]string `json:"attributes,omitempty"`
+
+	// 既存のブランドに似ていても入力のまま登録する（ErrSimilarBrand で候補を確認した後に指定する）
+	ConfirmBrand bool `json:"confirm_brand"`
+}
+
+func (u *itemUsecase) CloneItem(ctx context.Context, id int64, input CloneItemInput) (*entity.Item, error) {
+	if id <= 0 {
+		return nil, domainErrors.ErrInvalidInput
+	}
+
+	source, err := u.itemRepo.FindByID(ctx, id)
+	if err != nil {
+		if domainErrors.IsNotFoundError(err) {
+			return nil, domainErrors.ErrItemNotFound
+		}
+		return nil, fmt.Errorf("failed to retrieve item: %w", err)
+	}
+
+	name, category, brand := source.Name, source.Category, source.Brand
+	purchasePrice, purchaseDate := source.PurchasePrice, source.PurchaseDate
+	condition := source.Condition
+	attributes := source.Attributes
+
+	if input.Name != nil {
+		name = *input.Name
+	}
+	if input.Category != nil {
+		category = *input.Category
+	}
+	if input.Brand != nil {
+		brand = *input.Brand
+	}
+	if input.PurchasePrice != nil {
+		purchasePrice = *input.PurchasePrice
+	}
+	if input.PurchaseDate != nil {
+		purchaseDate = *input.PurchaseDate
+	}
+	if input.Condition != nil {
+		condition = *input.Condition
+	}
+	if input.Attributes != nil {
+		attributes = input.Attributes
+	}
+
+	item, err := entity.NewItem(name, category, brand, purchasePrice, purchaseDate)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domainErrors.ErrInvalidInput, err)
+	}
+
+	if err := item.SetCondition(condition); err != nil {
+		return nil, fmt.Errorf("%w: %w", domainErrors.ErrInvalidInput, err)
+	}
+
+	if err := item.SetAttributes(attributes); err != nil {
+		return nil, fmt.Errorf("%w: %w", domainErrors.ErrInvalidInput, err)
+	}
+
+	// シリアル番号は個体ごとに異なるので、指定された場合のみ設定する
+	if input.SerialNumber != nil {
+		if err := item.SetSerialNumber(*input.SerialNumber); err != nil {
+			return nil, fmt.Errorf("%w: %w", domainErrors.ErrInvalidInput, err)
+		}
+	}
+
+	// カテゴリー別のルールを評価
+	if err := item.EvaluateRules(); err != nil {
+		return nil, fmt.Errorf("%w: %w", domainErrors.ErrInvalidInput, err)
+	}
+
+	// 登録と同じく既存のブランドと照合し、上書きしたブランドの表記の揺れを防ぐ
+	if err := resolveBrand(ctx, u.itemRepo, item, input.ConfirmBrand); err != nil {
+		return nil, err
+	}
+
+	return u.createItem(ctx, item)
+}
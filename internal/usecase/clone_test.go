@@ -0,0 +1,127 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"Aicon-assignment/internal/domain/entity"
+	domainErrors "Aicon-assignment/internal/domain/errors"
+)
+
+func TestItemUsecase_CloneItem(t *testing.T) {
+	newSource := func() *entity.Item {
+		source, _ := entity.NewItem("エルメス バーキン30 ブラック", "バッグ", "HERMES", 1500000, "2023-01-15")
+		source.ID = 1
+		source.Condition = "A"
+		source.SerialNumber = "SN-0001"
+		return source
+	}
+
+	t.Run("正常系: 上書きなしで複製", func(t *testing.T) {
+		mockRepo := new(MockItemRepository)
+		mockRepo.On("FindByID", mock.Anything, int64(1)).Return(newSource(), nil)
+		mockRepo.On("FindBrands", mock.Anything).Return([]string{"HERMES"}, nil)
+		mockRepo.On("Create", mock.Anything, mock.MatchedBy(func(item *entity.Item) bool {
+			return item.ID == 0 &&
+				item.Name == "エルメス バーキン30 ブラック" &&
+				item.Condition == "A" &&
+				item.SerialNumber == ""
+		})).Return(&entity.Item{ID: 2}, nil)
+
+		usecase := NewItemUsecase(mockRepo, &MockTransactor{repos: Repositories{Items: mockRepo}})
+		item, err := usecase.CloneItem(context.Background(), 1, CloneItemInput{})
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), item.ID)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("正常系: 名前とシリアル番号を上書きして複製", func(t *testing.T) {
+		mockRepo := new(MockItemRepository)
+		mockRepo.On("FindByID", mock.Anything, int64(1)).Return(newSource(), nil)
+		mockRepo.On("FindBrands", mock.Anything).Return([]string{"HERMES"}, nil)
+		mockRepo.On("FindBySerialNumber", mock.Anything, "SN-0002").Return(nil, domainErrors.ErrItemNotFound)
+		mockRepo.On("Create", mock.Anything, mock.MatchedBy(func(item *entity.Item) bool {
+			return item.Name == "エルメス バーキン30 ゴールド" && item.SerialNumber == "SN-0002"
+		})).Return(&entity.Item{ID: 3}, nil)
+
+		usecase := NewItemUsecase(mockRepo, &MockTransactor{repos: Repositories{Items: mockRepo}})
+		_, err := usecase.CloneItem(context.Background(), 1, CloneItemInput{
+			Name:         stringPtr("エルメス バーキン30 ゴールド"),
+			SerialNumber: stringPtr("SN-0002"),
+		})
+
+		require.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("異常系: 既存のブランドに似たブランドは確認を求める", func(t *testing.T) {
+		mockRepo := new(MockItemRepository)
+		mockRepo.On("FindByID", mock.Anything, int64(1)).Return(newSource(), nil)
+		mockRepo.On("FindBrands", mock.Anything).Return([]string{"HERMES"}, nil)
+
+		usecase := NewItemUsecase(mockRepo, &MockTransactor{repos: Repositories{Items: mockRepo}})
+		_, err := usecase.CloneItem(context.Background(), 1, CloneItemInput{Brand: stringPtr("HERMS")})
+
+		assert.ErrorIs(t, err, domainErrors.ErrSimilarBrand)
+		var suggestion *entity.BrandSuggestion
+		require.ErrorAs(t, err, &suggestion)
+		assert.Equal(t, []string{"HERMES"}, suggestion.Suggestions)
+		mockRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("正常系: 確認済みなら似たブランドのまま複製", func(t *testing.T) {
+		mockRepo := new(MockItemRepository)
+		mockRepo.On("FindByID", mock.Anything, int64(1)).Return(newSource(), nil)
+		mockRepo.On("FindBrands", mock.Anything).Return([]string{"HERMES"}, nil)
+		mockRepo.On("Create", mock.Anything, mock.MatchedBy(func(item *entity.Item) bool {
+			return item.Brand == "HERMS"
+		})).Return(&entity.Item{ID: 4}, nil)
+
+		usecase := NewItemUsecase(mockRepo, &MockTransactor{repos: Repositories{Items: mockRepo}})
+		_, err := usecase.CloneItem(context.Background(), 1, CloneItemInput{Brand: stringPtr("HERMS"), ConfirmBrand: true})
+
+		require.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("異常系: 上書き値が無効", func(t *testing.T) {
+		mockRepo := new(MockItemRepository)
+		mockRepo.On("FindByID", mock.Anything, int64(1)).Return(newSource(), nil)
+
+		usecase := NewItemUsecase(mockRepo, &MockTransactor{repos: Repositories{Items: mockRepo}})
+		_, err := usecase.CloneItem(context.Background(), 1, CloneItemInput{Category: stringPtr("無効なカテゴリー")})
+
+		assert.ErrorIs(t, err, domainErrors.ErrInvalidInput)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("異常系: 時計はシリアル番号を指定しないと複製できない", func(t *testing.T) {
+		mockRepo := new(MockItemRepository)
+		mockRepo.On("FindByID", mock.Anything, int64(1)).Return(newSource(), nil)
+
+		usecase := NewItemUsecase(mockRepo, &MockTransactor{repos: Repositories{Items: mockRepo}})
+		_, err := usecase.CloneItem(context.Background(), 1, CloneItemInput{Category: stringPtr("時計")})
+
+		assert.ErrorIs(t, err, domainErrors.ErrInvalidInput)
+		var violations entity.RuleViolations
+		require.ErrorAs(t, err, &violations)
+		assert.Equal(t, "serial_number", violations[0].Field)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("異常系: 複製元が存在しない", func(t *testing.T) {
+		mockRepo := new(MockItemRepository)
+		mockRepo.On("FindByID", mock.Anything, int64(999)).Return(nil, domainErrors.ErrItemNotFound)
+
+		usecase := NewItemUsecase(mockRepo, &MockTransactor{repos: Repositories{Items: mockRepo}})
+		_, err := usecase.CloneItem(context.Background(), 999, CloneItemInput{})
+
+		assert.ErrorIs(t, err, domainErrors.ErrItemNotFound)
+		mockRepo.AssertExpectations(t)
+	})
+}
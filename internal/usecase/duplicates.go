@@ -0,0 +1,177 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+
+	"Aicon-assignment/internal/domain/entity"
+)
+
+// 名前の類似度がこの値以上なら重複候補とみなす
+const duplicateNameSimilarityThreshold = 0.8
+
+// 重複と判定した理由
+const (
+	DuplicateReasonSerialNumber = "serial_number"
+	DuplicateReasonSimilarName  = "similar_name"
+)
+
+// 重複候補のグループ
+type DuplicateGroup struct {
+	Reasons []string       `json:"reasons"`
+	Items   []*entity.Item `json:"items"`
+}
+
+// アーカイブしたアイテムも統合の対象になるため含める
+func (u *itemUsecase) FindDuplicateItems(ctx context.Context) ([]*DuplicateGroup, error) {
+	items, err := u.readRepo.FindAll(ctx, entity.ItemFilter{IncludeArchived: true})
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve items: %w", err)
+	}
+
+	return groupDuplicates(items), nil
+}
+
+// 重複候補同士をUnion-Findでまとめてグループ化する
+func groupDuplicates(items []*entity.Item) []*DuplicateGroup {
+	parent := make([]int, len(items))
+	for i := range parent {
+		parent[i] = i
+	}
+
+	var find func(int) int
+	find = func(i int) int {
+		if parent[i] != i {
+			parent[i] = find(parent[i])
+		}
+		return parent[i]
+	}
+
+	reasons := make(map[int]map[string]bool)
+	addReason := func(i, j int, reason string) {
+		rootI, rootJ := find(i), find(j)
+		if rootI != rootJ {
+			parent[rootJ] = rootI
+			if reasons[rootI] == nil {
+				reasons[rootI] = make(map[string]bool)
+			}
+			for r := range reasons[rootJ] {
+				reasons[rootI][r] = true
+			}
+			delete(reasons, rootJ)
+		}
+		if reasons[rootI] == nil {
+			reasons[rootI] = make(map[string]bool)
+		}
+		reasons[rootI][reason] = true
+	}
+
+	for i := 0; i < len(items); i++ {
+		for j := i + 1; j < len(items); j++ {
+			if reason, ok := duplicateReason(items[i], items[j]); ok {
+				addReason(i, j, reason)
+			}
+		}
+	}
+
+	members := make(map[int][]*entity.Item)
+	for i, item := range items {
+		root := find(i)
+		members[root] = append(members[root], item)
+	}
+
+	var groups []*DuplicateGroup
+	for root, groupItems := range members {
+		if len(groupItems) < 2 {
+			continue
+		}
+
+		sort.Slice(groupItems, func(a, b int) bool { return groupItems[a].ID < groupItems[b].ID })
+
+		var groupReasons []string
+		for reason := range reasons[root] {
+			groupReasons = append(groupReasons, reason)
+		}
+		sort.Strings(groupReasons)
+
+		groups = append(groups, &DuplicateGroup{
+			Reasons: groupReasons,
+			Items:   groupItems,
+		})
+	}
+
+	sort.Slice(groups, func(a, b int) bool { return groups[a].Items[0].ID < groups[b].Items[0].ID })
+
+	return groups
+}
+
+// 2つのアイテムが重複候補かどうか判定する
+func duplicateReason(a, b *entity.Item) (string, bool) {
+	serialA := normalizeForComparison(a.SerialNumber)
+	if serialA != "" && serialA == normalizeForComparison(b.SerialNumber) {
+		return DuplicateReasonSerialNumber, true
+	}
+
+	if normalizeForComparison(a.Brand) != normalizeForComparison(b.Brand) {
+		return "", false
+	}
+
+	if nameSimilarity(a.Name, b.Name) >= duplicateNameSimilarityThreshold {
+		return DuplicateReasonSimilarName, true
+	}
+
+	return "", false
+}
+
+// 比較用に大文字小文字・空白・記号の違いを吸収する
+func normalizeForComparison(s string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(s) {
+		if unicode.IsSpace(r) || unicode.IsPunct(r) || unicode.IsSymbol(r) {
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// レーベンシュタイン距離に基づく名前の類似度（0〜1）
+func nameSimilarity(a, b string) float64 {
+	ra := []rune(normalizeForComparison(a))
+	rb := []rune(normalizeForComparison(b))
+
+	maxLen := len(ra)
+	if len(rb) > maxLen {
+		maxLen = len(rb)
+	}
+	if maxLen == 0 {
+		return 0
+	}
+
+	return 1 - float64(levenshtein(ra, rb))/float64(maxLen)
+}
+
+func levenshtein(a, b []rune) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+
+	return prev[len(b)]
+}
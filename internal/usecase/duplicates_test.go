@@ -0,0 +1,64 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"Aicon-assignment/internal/domain/entity"
+)
+
+func TestItemUsecase_FindDuplicateItems(t *testing.T) {
+	items := []*entity.Item{
+		{ID: 1, Name: "ロレックス デイトナ 116500LN", Brand: "ROLEX"},
+		{ID: 2, Name: "ロレックス デイトナ 116500", Brand: "Rolex"},
+		{ID: 3, Name: "ロレックス サブマリーナ", Brand: "ROLEX"},
+		{ID: 4, Name: "エルメス バーキン", Brand: "HERMÈS", SerialNumber: "AB-123"},
+		{ID: 5, Name: "バーキン30", Brand: "エルメス", SerialNumber: "ab123"},
+		{ID: 6, Name: "ロレックス デイトナ 116500LN", Brand: "OMEGA"},
+	}
+
+	mockRepo := new(MockItemRepository)
+	mockRepo.On("FindAll", mock.Anything, entity.ItemFilter{IncludeArchived: true}).Return(items, nil)
+
+	usecase := NewItemUsecase(mockRepo, &MockTransactor{repos: Repositories{Items: mockRepo}})
+	groups, err := usecase.FindDuplicateItems(context.Background())
+
+	require.NoError(t, err)
+	require.Len(t, groups, 2)
+
+	// 同じブランドで名前が似ているもの
+	assert.Equal(t, []string{DuplicateReasonSimilarName}, groups[0].Reasons)
+	assert.Equal(t, int64(1), groups[0].Items[0].ID)
+	assert.Equal(t, int64(2), groups[0].Items[1].ID)
+
+	// シリアル番号が一致するもの
+	assert.Equal(t, []string{DuplicateReasonSerialNumber}, groups[1].Reasons)
+	assert.Equal(t, int64(4), groups[1].Items[0].ID)
+	assert.Equal(t, int64(5), groups[1].Items[1].ID)
+
+	mockRepo.AssertExpectations(t)
+}
+
+func TestNameSimilarity(t *testing.T) {
+	tests := []struct {
+		name string
+		a    string
+		b    string
+		want float64
+	}{
+		{"完全一致", "ロレックス デイトナ", "ロレックス デイトナ", 1},
+		{"空白と大文字小文字の違いは無視", "Apple Watch", "apple-watch", 1},
+		{"完全に異なる", "abc", "xyz", 0},
+		{"空文字", "", "", 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.InDelta(t, tt.want, nameSimilarity(tt.a, tt.b), 0.001)
+		})
+	}
+}
@@ -0,0 +1,13 @@
+package usecase
+
+import (
+	"context"
+
+	"Aicon-assignment/internal/domain/event"
+)
+
+// EventBus delivers domain events to the subscribers (webhooks, live feeds, etc.)
+type EventBus interface {
+	// Publish notifies the subscribers of the event; it must not fail the operation that raised it
+	Publish(ctx context.Context, e event.Event)
+}
@@ -0,0 +1,158 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"Aicon-assignment/internal/domain/entity"
+	domainErrors "Aicon-assignment/internal/domain/errors"
+)
+
+// 機能が有効か（リクエストごとに呼ばれるため、保存先には問い合わせず手元の値で答える）
+type FeatureChecker interface {
+	IsEnabled(name string) bool
+}
+
+type FeatureFlagUsecase interface {
+	FeatureChecker
+	GetFeatures(ctx context.Context) ([]*FeatureState, error)
+	SetFeature(ctx context.Context, name string, enabled bool) (*FeatureState, error)
+	// 保存した値を消し、設定の値に戻す
+	ResetFeature(ctx context.Context, name string) (*FeatureState, error)
+	// 保存された値を定期的に読み直す（他のインスタンスで切り替えた値を反映する）
+	Run(ctx context.Context)
+}
+
+// 機能の現在の状態
+type FeatureState struct {
+	Name    string `json:"name"`
+	Enabled bool   `json:"enabled"`
+	// 管理者が切り替えた日時（設定の値のままの場合はnull）
+	UpdatedAt *time.Time `json:"updated_at"`
+}
+
+type featureFlagUsecase struct {
+	flagRepo        FeatureFlagRepository
+	defaults        map[string]bool
+	refreshInterval time.Duration
+
+	mu    sync.RWMutex
+	saved map[string]*entity.FeatureFlag
+}
+
+// defaults は設定ファイル・環境変数の値（含まれない機能は有効）
+// 保存された値を読めるまでは defaults で答える
+func NewFeatureFlagUsecase(flagRepo FeatureFlagRepository, defaults map[string]bool, refreshInterval time.Duration) FeatureFlagUsecase {
+	return &featureFlagUsecase{
+		flagRepo:        flagRepo,
+		defaults:        defaults,
+		refreshInterval: refreshInterval,
+		saved:           map[string]*entity.FeatureFlag{},
+	}
+}
+
+func (u *featureFlagUsecase) IsEnabled(name string) bool {
+	u.mu.RLock()
+	defer u.mu.RUnlock()
+
+	return u.state(name).Enabled
+}
+
+func (u *featureFlagUsecase) GetFeatures(ctx context.Context) ([]*FeatureState, error) {
+	if err := u.refresh(ctx); err != nil {
+		return nil, err
+	}
+
+	u.mu.RLock()
+	defer u.mu.RUnlock()
+
+	states := make([]*FeatureState, 0, len(entity.Features))
+	for _, name := range entity.Features {
+		states = append(states, u.state(name))
+	}
+	return states, nil
+}
+
+func (u *featureFlagUsecase) SetFeature(ctx context.Context, name string, enabled bool) (*FeatureState, error) {
+	if !entity.IsKnownFeature(name) {
+		return nil, domainErrors.ErrFeatureNotFound
+	}
+
+	flag, err := u.flagRepo.Save(ctx, &entity.FeatureFlag{Name: name, Enabled: enabled})
+	if err != nil {
+		return nil, fmt.Errorf("failed to save feature flag: %w", err)
+	}
+
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	u.saved[name] = flag
+	return u.state(name), nil
+}
+
+func (u *featureFlagUsecase) ResetFeature(ctx context.Context, name string) (*FeatureState, error) {
+	if !entity.IsKnownFeature(name) {
+		return nil, domainErrors.ErrFeatureNotFound
+	}
+
+	if err := u.flagRepo.Delete(ctx, name); err != nil {
+		return nil, fmt.Errorf("failed to delete feature flag: %w", err)
+	}
+
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	delete(u.saved, name)
+	return u.state(name), nil
+}
+
+func (u *featureFlagUsecase) Run(ctx context.Context) {
+	if err := u.refresh(ctx); err != nil {
+		log.Printf("failed to load feature flags: %v", err)
+	}
+
+	ticker := time.NewTicker(u.refreshInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			// 読めない間は前回の値のまま答える
+			if err := u.refresh(ctx); err != nil {
+				log.Printf("failed to refresh feature flags: %v", err)
+			}
+		}
+	}
+}
+
+func (u *featureFlagUsecase) refresh(ctx context.Context) error {
+	flags, err := u.flagRepo.FindAll(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to retrieve feature flags: %w", err)
+	}
+
+	saved := make(map[string]*entity.FeatureFlag, len(flags))
+	for _, flag := range flags {
+		saved[flag.Name] = flag
+	}
+
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	u.saved = saved
+	return nil
+}
+
+// 保存された値 → 設定の値 → 有効 の順に決める（呼び出し側でロックを取っていること）
+func (u *featureFlagUsecase) state(name string) *FeatureState {
+	if flag, ok := u.saved[name]; ok {
+		updatedAt := flag.UpdatedAt
+		return &FeatureState{Name: name, Enabled: flag.Enabled, UpdatedAt: &updatedAt}
+	}
+	if enabled, ok := u.defaults[name]; ok {
+		return &FeatureState{Name: name, Enabled: enabled}
+	}
+	return &FeatureState{Name: name, Enabled: true}
+}
@@ -0,0 +1,133 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"Aicon-assignment/internal/domain/entity"
+	domainErrors "Aicon-assignment/internal/domain/errors"
+)
+
+// MockFeatureFlagRepository はtestify/mockを使用したモックリポジトリ
+type MockFeatureFlagRepository struct {
+	mock.Mock
+}
+
+func (m *MockFeatureFlagRepository) FindAll(ctx context.Context) ([]*entity.FeatureFlag, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.FeatureFlag), args.Error(1)
+}
+
+func (m *MockFeatureFlagRepository) Save(ctx context.Context, flag *entity.FeatureFlag) (*entity.FeatureFlag, error) {
+	args := m.Called(ctx, flag)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.FeatureFlag), args.Error(1)
+}
+
+func (m *MockFeatureFlagRepository) Delete(ctx context.Context, name string) error {
+	args := m.Called(ctx, name)
+	return args.Error(0)
+}
+
+func TestFeatureFlagUsecase_GetFeatures(t *testing.T) {
+	updatedAt := time.Date(2026, 10, 1, 9, 0, 0, 0, time.UTC)
+
+	t.Run("正常系: 保存された値 → 設定の値 → 有効 の順に決める", func(t *testing.T) {
+		mockRepo := new(MockFeatureFlagRepository)
+		mockRepo.On("FindAll", mock.Anything).Return([]*entity.FeatureFlag{
+			{Name: entity.FeatureWebhooks, Enabled: true, UpdatedAt: updatedAt},
+		}, nil)
+		usecase := NewFeatureFlagUsecase(mockRepo, map[string]bool{
+			entity.FeatureWebhooks: false,
+			entity.FeatureGraphQL:  false,
+		}, time.Minute)
+
+		features, err := usecase.GetFeatures(context.Background())
+
+		require.NoError(t, err)
+		assert.Equal(t, []*FeatureState{
+			{Name: entity.FeatureWebhooks, Enabled: true, UpdatedAt: &updatedAt},
+			{Name: entity.FeatureGraphQL, Enabled: false},
+			{Name: entity.FeatureDuplicateDetection, Enabled: true},
+		}, features)
+		assert.True(t, usecase.IsEnabled(entity.FeatureWebhooks))
+		assert.False(t, usecase.IsEnabled(entity.FeatureGraphQL))
+	})
+
+	t.Run("異常系: 保存先から読めない", func(t *testing.T) {
+		mockRepo := new(MockFeatureFlagRepository)
+		mockRepo.On("FindAll", mock.Anything).Return(nil, errors.New("connection refused"))
+		usecase := NewFeatureFlagUsecase(mockRepo, map[string]bool{entity.FeatureGraphQL: false}, time.Minute)
+
+		_, err := usecase.GetFeatures(context.Background())
+
+		assert.Error(t, err)
+		assert.False(t, usecase.IsEnabled(entity.FeatureGraphQL))
+	})
+}
+
+func TestFeatureFlagUsecase_SetFeature(t *testing.T) {
+	t.Run("正常系: 切り替えた値をすぐに反映する", func(t *testing.T) {
+		updatedAt := time.Date(2026, 10, 1, 9, 0, 0, 0, time.UTC)
+		mockRepo := new(MockFeatureFlagRepository)
+		mockRepo.On("Save", mock.Anything, &entity.FeatureFlag{Name: entity.FeatureWebhooks, Enabled: false}).
+			Return(&entity.FeatureFlag{Name: entity.FeatureWebhooks, Enabled: false, UpdatedAt: updatedAt}, nil)
+		usecase := NewFeatureFlagUsecase(mockRepo, nil, time.Minute)
+
+		state, err := usecase.SetFeature(context.Background(), entity.FeatureWebhooks, false)
+
+		require.NoError(t, err)
+		assert.Equal(t, &FeatureState{Name: entity.FeatureWebhooks, Enabled: false, UpdatedAt: &updatedAt}, state)
+		assert.False(t, usecase.IsEnabled(entity.FeatureWebhooks))
+	})
+
+	t.Run("異常系: 存在しない機能", func(t *testing.T) {
+		mockRepo := new(MockFeatureFlagRepository)
+		usecase := NewFeatureFlagUsecase(mockRepo, nil, time.Minute)
+
+		_, err := usecase.SetFeature(context.Background(), "market_price", false)
+
+		assert.ErrorIs(t, err, domainErrors.ErrFeatureNotFound)
+		mockRepo.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
+	})
+}
+
+func TestFeatureFlagUsecase_ResetFeature(t *testing.T) {
+	t.Run("正常系: 設定の値に戻す", func(t *testing.T) {
+		mockRepo := new(MockFeatureFlagRepository)
+		mockRepo.On("Save", mock.Anything, mock.AnythingOfType("*entity.FeatureFlag")).
+			Return(&entity.FeatureFlag{Name: entity.FeatureGraphQL, Enabled: true, UpdatedAt: time.Now()}, nil)
+		mockRepo.On("Delete", mock.Anything, entity.FeatureGraphQL).Return(nil)
+		usecase := NewFeatureFlagUsecase(mockRepo, map[string]bool{entity.FeatureGraphQL: false}, time.Minute)
+
+		_, err := usecase.SetFeature(context.Background(), entity.FeatureGraphQL, true)
+		require.NoError(t, err)
+		assert.True(t, usecase.IsEnabled(entity.FeatureGraphQL))
+
+		state, err := usecase.ResetFeature(context.Background(), entity.FeatureGraphQL)
+
+		require.NoError(t, err)
+		assert.Equal(t, &FeatureState{Name: entity.FeatureGraphQL, Enabled: false}, state)
+		assert.False(t, usecase.IsEnabled(entity.FeatureGraphQL))
+	})
+
+	t.Run("異常系: 存在しない機能", func(t *testing.T) {
+		mockRepo := new(MockFeatureFlagRepository)
+		usecase := NewFeatureFlagUsecase(mockRepo, nil, time.Minute)
+
+		_, err := usecase.ResetFeature(context.Background(), "market_price")
+
+		assert.ErrorIs(t, err, domainErrors.ErrFeatureNotFound)
+	})
+}
@@ -0,0 +1,105 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"Aicon-assignment/internal/domain/entity"
+	domainErrors "Aicon-assignment/internal/domain/errors"
+)
+
+type ItemInsuranceUsecase interface {
+	GetInsurance(ctx context.Context, itemID int64) (*entity.ItemInsurance, error)
+	// 既に設定がある場合は置き換える
+	SetInsurance(ctx context.Context, itemID int64, input SetItemInsuranceInput) (*entity.ItemInsurance, error)
+	RemoveInsurance(ctx context.Context, itemID int64) error
+}
+
+type SetItemInsuranceInput struct {
+	Insurer      string   `json:"insurer"`
+	PolicyNumber string   `json:"policy_number"`
+	Photos       []string `json:"photos"`
+}
+
+type itemInsuranceUsecase struct {
+	itemRepo      ItemRepository
+	insuranceRepo ItemInsuranceRepository
+}
+
+func NewItemInsuranceUsecase(itemRepo ItemRepository, insuranceRepo ItemInsuranceRepository) ItemInsuranceUsecase {
+	return &itemInsuranceUsecase{
+		itemRepo:      itemRepo,
+		insuranceRepo: insuranceRepo,
+	}
+}
+
+func (u *itemInsuranceUsecase) GetInsurance(ctx context.Context, itemID int64) (*entity.ItemInsurance, error) {
+	if err := u.ensureItemExists(ctx, itemID); err != nil {
+		return nil, err
+	}
+
+	insurance, err := u.insuranceRepo.FindByItemID(ctx, itemID)
+	if err != nil {
+		if domainErrors.IsInsuranceNotFoundError(err) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to retrieve insurance: %w", err)
+	}
+
+	return insurance, nil
+}
+
+func (u *itemInsuranceUsecase) SetInsurance(ctx context.Context, itemID int64, input SetItemInsuranceInput) (*entity.ItemInsurance, error) {
+	if err := u.ensureItemExists(ctx, itemID); err != nil {
+		return nil, err
+	}
+
+	insurance, err := entity.NewItemInsurance(itemID, input.Insurer, input.PolicyNumber, input.Photos)
+	if err != nil {
+		var fieldErrors entity.FieldErrors
+		if errors.As(err, &fieldErrors) {
+			return nil, fmt.Errorf("%w: %w", domainErrors.ErrInvalidInput, err)
+		}
+		return nil, err
+	}
+
+	saved, err := u.insuranceRepo.Save(ctx, insurance)
+	if err != nil {
+		if domainErrors.IsNotFoundError(err) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to set insurance: %w", err)
+	}
+
+	return saved, nil
+}
+
+func (u *itemInsuranceUsecase) RemoveInsurance(ctx context.Context, itemID int64) error {
+	if err := u.ensureItemExists(ctx, itemID); err != nil {
+		return err
+	}
+
+	if err := u.insuranceRepo.DeleteByItemID(ctx, itemID); err != nil {
+		if domainErrors.IsInsuranceNotFoundError(err) {
+			return err
+		}
+		return fmt.Errorf("failed to remove insurance: %w", err)
+	}
+
+	return nil
+}
+
+func (u *itemInsuranceUsecase) ensureItemExists(ctx context.Context, itemID int64) error {
+	if itemID <= 0 {
+		return domainErrors.ErrInvalidInput
+	}
+
+	if _, err := u.itemRepo.FindByID(ctx, itemID); err != nil {
+		if domainErrors.IsNotFoundError(err) {
+			return domainErrors.ErrItemNotFound
+		}
+		return fmt.Errorf("failed to retrieve item: %w", err)
+	}
+	return nil
+}
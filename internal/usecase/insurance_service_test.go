@@ -0,0 +1,167 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"Aicon-assignment/internal/domain/entity"
+	domainErrors "Aicon-assignment/internal/domain/errors"
+)
+
+// MockItemInsuranceRepository はtestify/mockを使用したモックリポジトリ
+type MockItemInsuranceRepository struct {
+	mock.Mock
+}
+
+func (m *MockItemInsuranceRepository) FindByItemID(ctx context.Context, itemID int64) (*entity.ItemInsurance, error) {
+	args := m.Called(ctx, itemID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.ItemInsurance), args.Error(1)
+}
+
+func (m *MockItemInsuranceRepository) FindAll(ctx context.Context) ([]*entity.ItemInsurance, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.ItemInsurance), args.Error(1)
+}
+
+func (m *MockItemInsuranceRepository) Save(ctx context.Context, insurance *entity.ItemInsurance) (*entity.ItemInsurance, error) {
+	args := m.Called(ctx, insurance)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.ItemInsurance), args.Error(1)
+}
+
+func (m *MockItemInsuranceRepository) DeleteByItemID(ctx context.Context, itemID int64) error {
+	args := m.Called(ctx, itemID)
+	return args.Error(0)
+}
+
+func TestItemInsuranceUsecase_SetInsurance(t *testing.T) {
+	t.Run("正常系: 前後の空白と空の写真の参照を取り除いて保存する", func(t *testing.T) {
+		itemRepo := new(MockItemRepository)
+		itemRepo.On("FindByID", mock.Anything, int64(1)).Return(&entity.Item{ID: 1}, nil)
+		insuranceRepo := new(MockItemInsuranceRepository)
+		var saved *entity.ItemInsurance
+		insuranceRepo.On("Save", mock.Anything, mock.AnythingOfType("*entity.ItemInsurance")).
+			Run(func(args mock.Arguments) {
+				saved = args.Get(1).(*entity.ItemInsurance)
+			}).
+			Return(&entity.ItemInsurance{ItemID: 1, Insurer: "東京海上日動", PolicyNumber: "A-123"}, nil)
+
+		usecase := NewItemInsuranceUsecase(itemRepo, insuranceRepo)
+		got, err := usecase.SetInsurance(context.Background(), 1, SetItemInsuranceInput{
+			Insurer:      " 東京海上日動 ",
+			PolicyNumber: "A-123",
+			Photos:       []string{"https://photos.example.com/daytona-front.jpg", " ", "daytona-back.jpg"},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, "A-123", got.PolicyNumber)
+		require.NotNil(t, saved)
+		assert.Equal(t, "東京海上日動", saved.Insurer)
+		assert.Equal(t, []string{"https://photos.example.com/daytona-front.jpg", "daytona-back.jpg"}, saved.Photos)
+	})
+
+	t.Run("異常系: 証券番号が空", func(t *testing.T) {
+		itemRepo := new(MockItemRepository)
+		itemRepo.On("FindByID", mock.Anything, int64(1)).Return(&entity.Item{ID: 1}, nil)
+		insuranceRepo := new(MockItemInsuranceRepository)
+
+		usecase := NewItemInsuranceUsecase(itemRepo, insuranceRepo)
+		_, err := usecase.SetInsurance(context.Background(), 1, SetItemInsuranceInput{Insurer: "東京海上日動"})
+
+		require.Error(t, err)
+		assert.True(t, domainErrors.IsValidationError(err))
+		var fieldErrors entity.FieldErrors
+		require.True(t, errors.As(err, &fieldErrors))
+		assert.Equal(t, "policy_number", fieldErrors[0].Field)
+		insuranceRepo.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
+	})
+
+	t.Run("異常系: 存在しないアイテム", func(t *testing.T) {
+		itemRepo := new(MockItemRepository)
+		itemRepo.On("FindByID", mock.Anything, int64(999)).Return(nil, domainErrors.ErrItemNotFound)
+
+		usecase := NewItemInsuranceUsecase(itemRepo, new(MockItemInsuranceRepository))
+		_, err := usecase.SetInsurance(context.Background(), 999, SetItemInsuranceInput{Insurer: "東京海上日動", PolicyNumber: "A-123"})
+
+		assert.ErrorIs(t, err, domainErrors.ErrItemNotFound)
+	})
+}
+
+func TestItemInsuranceUsecase_RemoveInsurance(t *testing.T) {
+	t.Run("異常系: 保険を設定していない", func(t *testing.T) {
+		itemRepo := new(MockItemRepository)
+		itemRepo.On("FindByID", mock.Anything, int64(1)).Return(&entity.Item{ID: 1}, nil)
+		insuranceRepo := new(MockItemInsuranceRepository)
+		insuranceRepo.On("DeleteByItemID", mock.Anything, int64(1)).Return(domainErrors.ErrInsuranceNotFound)
+
+		usecase := NewItemInsuranceUsecase(itemRepo, insuranceRepo)
+
+		assert.ErrorIs(t, usecase.RemoveInsurance(context.Background(), 1), domainErrors.ErrInsuranceNotFound)
+	})
+}
+
+func TestReportUsecase_GetInsuranceSchedule(t *testing.T) {
+	currentValue := 1800000
+	items := []*entity.Item{
+		{ID: 3, Name: "カルティエ タンク", Category: "時計", Brand: "Cartier", PurchasePrice: 400000, SerialNumber: "CT-1"},
+		{ID: 2, Name: "バーキン", Category: "バッグ", Brand: "HERMÈS", PurchasePrice: 2000000},
+		{ID: 1, Name: "ロレックス デイトナ", Category: "時計", Brand: "ROLEX", PurchasePrice: 1500000, CurrentValue: &currentValue, SerialNumber: "D123456"},
+		{ID: 4, Name: "オメガ スピードマスター", Category: "時計", Brand: "OMEGA", PurchasePrice: 700000},
+	}
+	insurances := []*entity.ItemInsurance{
+		{ItemID: 1, Insurer: "東京海上日動", PolicyNumber: "B-200", Photos: []string{"daytona.jpg"}},
+		{ItemID: 2, Insurer: "AIG損保", PolicyNumber: "A-100"},
+		{ItemID: 3, Insurer: "東京海上日動", PolicyNumber: "B-200"},
+		// アーカイブしたアイテムは一覧に含まれないため明細にも出ない
+		{ItemID: 5, Insurer: "東京海上日動", PolicyNumber: "B-200"},
+	}
+
+	newUsecase := func() ReportUsecase {
+		itemRepo := new(MockItemRepository)
+		itemRepo.On("FindAll", mock.Anything, entity.ItemFilter{}).Return(items, nil)
+		insuranceRepo := new(MockItemInsuranceRepository)
+		insuranceRepo.On("FindAll", mock.Anything).Return(insurances, nil)
+		return NewReportUsecase(itemRepo, new(MockItemSaleRepository), insuranceRepo)
+	}
+
+	t.Run("正常系: 契約ごとにまとめ、評価額は再評価していなければ購入価格にする", func(t *testing.T) {
+		schedule, err := newUsecase().GetInsuranceSchedule(context.Background(), "")
+
+		require.NoError(t, err)
+		require.Len(t, schedule.Policies, 2)
+		assert.Equal(t, "A-100", schedule.Policies[0].PolicyNumber)
+		assert.Equal(t, 2000000, schedule.Policies[0].Total)
+
+		policy := schedule.Policies[1]
+		assert.Equal(t, "東京海上日動", policy.Insurer)
+		require.Len(t, policy.Items, 2)
+		assert.Equal(t, int64(1), policy.Items[0].ItemID)
+		assert.Equal(t, 1800000, policy.Items[0].AppraisedValue)
+		assert.Equal(t, "D123456", policy.Items[0].SerialNumber)
+		assert.Equal(t, []string{"daytona.jpg"}, policy.Items[0].Photos)
+		assert.Equal(t, 400000, policy.Items[1].AppraisedValue)
+		assert.Equal(t, 2200000, policy.Total)
+		assert.Equal(t, 4200000, schedule.Total)
+	})
+
+	t.Run("正常系: 証券番号で絞り込む", func(t *testing.T) {
+		schedule, err := newUsecase().GetInsuranceSchedule(context.Background(), "A-100")
+
+		require.NoError(t, err)
+		require.Len(t, schedule.Policies, 1)
+		assert.Equal(t, "AIG損保", schedule.Policies[0].Insurer)
+	})
+}
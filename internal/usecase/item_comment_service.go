@@ -0,0 +1,101 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"Aicon-assignment/internal/domain/entity"
+	domainErrors "Aicon-assignment/internal/domain/errors"
+)
+
+type ItemCommentUsecase interface {
+	CreateComment(ctx context.Context, itemID int64, input CreateItemCommentInput) (*entity.ItemComment, error)
+	// 古い順に返す
+	GetComments(ctx context.Context, itemID int64) ([]*entity.ItemComment, error)
+	DeleteComment(ctx context.Context, itemID, id int64) error
+}
+
+type CreateItemCommentInput struct {
+	Author string `json:"author"`
+	Body   string `json:"body"`
+}
+
+type itemCommentUsecase struct {
+	itemRepo    ItemRepository
+	commentRepo ItemCommentRepository
+}
+
+func NewItemCommentUsecase(itemRepo ItemRepository, commentRepo ItemCommentRepository) ItemCommentUsecase {
+	return &itemCommentUsecase{
+		itemRepo:    itemRepo,
+		commentRepo: commentRepo,
+	}
+}
+
+func (u *itemCommentUsecase) CreateComment(ctx context.Context, itemID int64, input CreateItemCommentInput) (*entity.ItemComment, error) {
+	if err := u.ensureItemExists(ctx, itemID); err != nil {
+		return nil, err
+	}
+
+	comment, err := entity.NewItemComment(itemID, input.Author, input.Body)
+	if err != nil {
+		var fieldErrors entity.FieldErrors
+		if errors.As(err, &fieldErrors) {
+			return nil, fmt.Errorf("%w: %w", domainErrors.ErrInvalidInput, err)
+		}
+		return nil, err
+	}
+
+	created, err := u.commentRepo.Create(ctx, comment)
+	if err != nil {
+		if domainErrors.IsNotFoundError(err) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to create comment: %w", err)
+	}
+
+	return created, nil
+}
+
+func (u *itemCommentUsecase) GetComments(ctx context.Context, itemID int64) ([]*entity.ItemComment, error) {
+	if err := u.ensureItemExists(ctx, itemID); err != nil {
+		return nil, err
+	}
+
+	comments, err := u.commentRepo.FindByItemID(ctx, itemID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve comments: %w", err)
+	}
+
+	return comments, nil
+}
+
+func (u *itemCommentUsecase) DeleteComment(ctx context.Context, itemID, id int64) error {
+	if err := u.ensureItemExists(ctx, itemID); err != nil {
+		return err
+	}
+
+	if err := u.commentRepo.Delete(ctx, itemID, id); err != nil {
+		if domainErrors.IsCommentNotFoundError(err) {
+			return err
+		}
+		return fmt.Errorf("failed to delete comment: %w", err)
+	}
+
+	return nil
+}
+
+func (u *itemCommentUsecase) ensureItemExists(ctx context.Context, itemID int64) error {
+	if itemID <= 0 {
+		return domainErrors.ErrInvalidInput
+	}
+
+	if _, err := u.itemRepo.FindByID(ctx, itemID); err != nil {
+		if domainErrors.IsNotFoundError(err) {
+			return domainErrors.ErrItemNotFound
+		}
+		return fmt.Errorf("failed to retrieve item: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,156 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"Aicon-assignment/internal/domain/entity"
+	domainErrors "Aicon-assignment/internal/domain/errors"
+)
+
+// MockItemCommentRepository はtestify/mockを使用したモックリポジトリ
+type MockItemCommentRepository struct {
+	mock.Mock
+}
+
+func (m *MockItemCommentRepository) FindByItemID(ctx context.Context, itemID int64) ([]*entity.ItemComment, error) {
+	args := m.Called(ctx, itemID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.ItemComment), args.Error(1)
+}
+
+func (m *MockItemCommentRepository) Create(ctx context.Context, comment *entity.ItemComment) (*entity.ItemComment, error) {
+	args := m.Called(ctx, comment)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.ItemComment), args.Error(1)
+}
+
+func (m *MockItemCommentRepository) Delete(ctx context.Context, itemID, id int64) error {
+	args := m.Called(ctx, itemID, id)
+	return args.Error(0)
+}
+
+func TestItemCommentUsecase_CreateComment(t *testing.T) {
+	t.Run("正常系: 前後の空白を取り除いて保存する", func(t *testing.T) {
+		itemRepo := new(MockItemRepository)
+		itemRepo.On("FindByID", mock.Anything, int64(1)).Return(&entity.Item{ID: 1}, nil)
+		commentRepo := new(MockItemCommentRepository)
+		var saved *entity.ItemComment
+		commentRepo.On("Create", mock.Anything, mock.AnythingOfType("*entity.ItemComment")).
+			Run(func(args mock.Arguments) {
+				saved = args.Get(1).(*entity.ItemComment)
+			}).
+			Return(&entity.ItemComment{ID: 3, ItemID: 1, Author: "山田", Body: "オーバーホール済み"}, nil)
+
+		usecase := NewItemCommentUsecase(itemRepo, commentRepo)
+		got, err := usecase.CreateComment(context.Background(), 1, CreateItemCommentInput{Author: " 山田 ", Body: "オーバーホール済み\n"})
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), got.ID)
+		require.NotNil(t, saved)
+		assert.Equal(t, int64(1), saved.ItemID)
+		assert.Equal(t, "山田", saved.Author)
+		assert.Equal(t, "オーバーホール済み", saved.Body)
+	})
+
+	t.Run("異常系: 本文が空", func(t *testing.T) {
+		itemRepo := new(MockItemRepository)
+		itemRepo.On("FindByID", mock.Anything, int64(1)).Return(&entity.Item{ID: 1}, nil)
+		commentRepo := new(MockItemCommentRepository)
+
+		usecase := NewItemCommentUsecase(itemRepo, commentRepo)
+		_, err := usecase.CreateComment(context.Background(), 1, CreateItemCommentInput{Author: "山田", Body: "  "})
+
+		require.Error(t, err)
+		assert.True(t, domainErrors.IsValidationError(err))
+		var fieldErrors entity.FieldErrors
+		require.True(t, errors.As(err, &fieldErrors))
+		assert.Equal(t, "body", fieldErrors[0].Field)
+		commentRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("異常系: 本文が長すぎる", func(t *testing.T) {
+		itemRepo := new(MockItemRepository)
+		itemRepo.On("FindByID", mock.Anything, int64(1)).Return(&entity.Item{ID: 1}, nil)
+		commentRepo := new(MockItemCommentRepository)
+
+		usecase := NewItemCommentUsecase(itemRepo, commentRepo)
+		_, err := usecase.CreateComment(context.Background(), 1, CreateItemCommentInput{
+			Author: "山田",
+			Body:   strings.Repeat("あ", entity.MaxCommentBodyLength+1),
+		})
+
+		assert.True(t, domainErrors.IsValidationError(err))
+	})
+
+	t.Run("異常系: アイテムが存在しない", func(t *testing.T) {
+		itemRepo := new(MockItemRepository)
+		itemRepo.On("FindByID", mock.Anything, int64(9)).Return(nil, domainErrors.ErrItemNotFound)
+		commentRepo := new(MockItemCommentRepository)
+
+		usecase := NewItemCommentUsecase(itemRepo, commentRepo)
+		_, err := usecase.CreateComment(context.Background(), 9, CreateItemCommentInput{Author: "山田", Body: "メモ"})
+
+		assert.ErrorIs(t, err, domainErrors.ErrItemNotFound)
+	})
+}
+
+func TestItemCommentUsecase_GetComments(t *testing.T) {
+	t.Run("正常系: リポジトリの順序のまま返す", func(t *testing.T) {
+		itemRepo := new(MockItemRepository)
+		itemRepo.On("FindByID", mock.Anything, int64(1)).Return(&entity.Item{ID: 1}, nil)
+		commentRepo := new(MockItemCommentRepository)
+		comments := []*entity.ItemComment{{ID: 1, ItemID: 1}, {ID: 2, ItemID: 1}}
+		commentRepo.On("FindByItemID", mock.Anything, int64(1)).Return(comments, nil)
+
+		usecase := NewItemCommentUsecase(itemRepo, commentRepo)
+		got, err := usecase.GetComments(context.Background(), 1)
+
+		require.NoError(t, err)
+		assert.Equal(t, comments, got)
+	})
+
+	t.Run("異常系: 不正なID", func(t *testing.T) {
+		usecase := NewItemCommentUsecase(new(MockItemRepository), new(MockItemCommentRepository))
+		_, err := usecase.GetComments(context.Background(), 0)
+
+		assert.ErrorIs(t, err, domainErrors.ErrInvalidInput)
+	})
+}
+
+func TestItemCommentUsecase_DeleteComment(t *testing.T) {
+	t.Run("正常系: 削除する", func(t *testing.T) {
+		itemRepo := new(MockItemRepository)
+		itemRepo.On("FindByID", mock.Anything, int64(1)).Return(&entity.Item{ID: 1}, nil)
+		commentRepo := new(MockItemCommentRepository)
+		commentRepo.On("Delete", mock.Anything, int64(1), int64(2)).Return(nil)
+
+		usecase := NewItemCommentUsecase(itemRepo, commentRepo)
+		err := usecase.DeleteComment(context.Background(), 1, 2)
+
+		require.NoError(t, err)
+		commentRepo.AssertExpectations(t)
+	})
+
+	t.Run("異常系: メモが存在しない", func(t *testing.T) {
+		itemRepo := new(MockItemRepository)
+		itemRepo.On("FindByID", mock.Anything, int64(1)).Return(&entity.Item{ID: 1}, nil)
+		commentRepo := new(MockItemCommentRepository)
+		commentRepo.On("Delete", mock.Anything, int64(1), int64(2)).Return(domainErrors.ErrCommentNotFound)
+
+		usecase := NewItemCommentUsecase(itemRepo, commentRepo)
+		err := usecase.DeleteComment(context.Background(), 1, 2)
+
+		assert.True(t, domainErrors.IsCommentNotFoundError(err))
+	})
+}
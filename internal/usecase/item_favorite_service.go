@@ -0,0 +1,72 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"Aicon-assignment/internal/domain/entity"
+	domainErrors "Aicon-assignment/internal/domain/errors"
+)
+
+// お気に入りは閲覧者ごとに持ち、アイテムとは別に保存する（設定・解除でアイテムの版は変わらず、item.updated も送らない）
+type ItemFavoriteUsecase interface {
+	// アイテムを閲覧者のお気に入りにする（既にお気に入りなら何もしない）
+	AddFavorite(ctx context.Context, viewer string, itemID int64) error
+	// 閲覧者のお気に入りから外す（お気に入りでなければ何もしない）
+	RemoveFavorite(ctx context.Context, viewer string, itemID int64) error
+}
+
+type itemFavoriteUsecase struct {
+	itemRepo     ItemRepository
+	favoriteRepo ItemFavoriteRepository
+}
+
+func NewItemFavoriteUsecase(itemRepo ItemRepository, favoriteRepo ItemFavoriteRepository) ItemFavoriteUsecase {
+	return &itemFavoriteUsecase{
+		itemRepo:     itemRepo,
+		favoriteRepo: favoriteRepo,
+	}
+}
+
+func (u *itemFavoriteUsecase) AddFavorite(ctx context.Context, viewer string, itemID int64) error {
+	if err := u.ensureItemExists(ctx, itemID); err != nil {
+		return err
+	}
+
+	if err := u.favoriteRepo.Add(ctx, entity.NewItemFavorite(viewer, itemID)); err != nil {
+		if domainErrors.IsNotFoundError(err) {
+			return err
+		}
+		return fmt.Errorf("failed to add favorite: %w", err)
+	}
+
+	return nil
+}
+
+func (u *itemFavoriteUsecase) RemoveFavorite(ctx context.Context, viewer string, itemID int64) error {
+	if err := u.ensureItemExists(ctx, itemID); err != nil {
+		return err
+	}
+
+	if err := u.favoriteRepo.Remove(ctx, viewer, itemID); err != nil {
+		return fmt.Errorf("failed to remove favorite: %w", err)
+	}
+
+	return nil
+}
+
+// 存在しないアイテムは設定・解除とも ErrItemNotFound にする
+func (u *itemFavoriteUsecase) ensureItemExists(ctx context.Context, itemID int64) error {
+	if itemID <= 0 {
+		return domainErrors.ErrInvalidInput
+	}
+
+	if _, err := u.itemRepo.FindByID(ctx, itemID); err != nil {
+		if domainErrors.IsNotFoundError(err) {
+			return err
+		}
+		return fmt.Errorf("failed to retrieve item: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,76 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"Aicon-assignment/internal/domain/entity"
+	domainErrors "Aicon-assignment/internal/domain/errors"
+)
+
+// MockItemFavoriteRepository はtestify/mockを使用したモックリポジトリ
+type MockItemFavoriteRepository struct {
+	mock.Mock
+}
+
+func (m *MockItemFavoriteRepository) Add(ctx context.Context, favorite *entity.ItemFavorite) error {
+	args := m.Called(ctx, favorite)
+	return args.Error(0)
+}
+
+func (m *MockItemFavoriteRepository) Remove(ctx context.Context, viewer string, itemID int64) error {
+	args := m.Called(ctx, viewer, itemID)
+	return args.Error(0)
+}
+
+func TestItemFavoriteUsecase_AddFavorite(t *testing.T) {
+	t.Run("正常系: 閲覧者のお気に入りに加え、アイテムは更新しない", func(t *testing.T) {
+		mockRepo := new(MockItemRepository)
+		mockRepo.On("FindByID", mock.Anything, int64(1)).Return(&entity.Item{ID: 1, Version: 3}, nil)
+		favoriteRepo := new(MockItemFavoriteRepository)
+		favoriteRepo.On("Add", mock.Anything, mock.MatchedBy(func(favorite *entity.ItemFavorite) bool {
+			return favorite.Viewer == "admin" && favorite.ItemID == 1 && !favorite.CreatedAt.IsZero()
+		})).Return(nil)
+
+		err := NewItemFavoriteUsecase(mockRepo, favoriteRepo).AddFavorite(context.Background(), "admin", 1)
+
+		assert.NoError(t, err)
+		favoriteRepo.AssertExpectations(t)
+		mockRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+
+	t.Run("異常系: アイテムが存在しない", func(t *testing.T) {
+		mockRepo := new(MockItemRepository)
+		mockRepo.On("FindByID", mock.Anything, int64(9)).Return(nil, domainErrors.ErrItemNotFound)
+		favoriteRepo := new(MockItemFavoriteRepository)
+
+		err := NewItemFavoriteUsecase(mockRepo, favoriteRepo).AddFavorite(context.Background(), "", 9)
+
+		assert.ErrorIs(t, err, domainErrors.ErrItemNotFound)
+		favoriteRepo.AssertNotCalled(t, "Add", mock.Anything, mock.Anything)
+	})
+}
+
+func TestItemFavoriteUsecase_RemoveFavorite(t *testing.T) {
+	t.Run("正常系: 閲覧者のお気に入りから外す", func(t *testing.T) {
+		mockRepo := new(MockItemRepository)
+		mockRepo.On("FindByID", mock.Anything, int64(1)).Return(&entity.Item{ID: 1}, nil)
+		favoriteRepo := new(MockItemFavoriteRepository)
+		favoriteRepo.On("Remove", mock.Anything, "", int64(1)).Return(nil)
+
+		err := NewItemFavoriteUsecase(mockRepo, favoriteRepo).RemoveFavorite(context.Background(), "", 1)
+
+		assert.NoError(t, err)
+		favoriteRepo.AssertExpectations(t)
+		mockRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+
+	t.Run("異常系: 不正なID", func(t *testing.T) {
+		err := NewItemFavoriteUsecase(new(MockItemRepository), new(MockItemFavoriteRepository)).RemoveFavorite(context.Background(), "", 0)
+
+		assert.ErrorIs(t, err, domainErrors.ErrInvalidInput)
+	})
+}
@@ -0,0 +1,136 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"Aicon-assignment/internal/domain/entity"
+	domainErrors "Aicon-assignment/internal/domain/errors"
+)
+
+type ItemRelationUsecase interface {
+	CreateRelation(ctx context.Context, itemID int64, input CreateItemRelationInput) (*RelatedItem, error)
+	// アイテムがどちら側の関連も古い順に返す
+	GetRelations(ctx context.Context, itemID int64) ([]*RelatedItem, error)
+	DeleteRelation(ctx context.Context, itemID, id int64) error
+}
+
+type CreateItemRelationInput struct {
+	RelatedItemID int64  `json:"related_item_id"`
+	Type          string `json:"type"`
+}
+
+// 関連と、その相手のアイテム
+type RelatedItem struct {
+	Relation *entity.ItemRelation
+	Item     *entity.Item
+}
+
+type itemRelationUsecase struct {
+	itemRepo     ItemRepository
+	relationRepo ItemRelationRepository
+}
+
+func NewItemRelationUsecase(itemRepo ItemRepository, relationRepo ItemRelationRepository) ItemRelationUsecase {
+	return &itemRelationUsecase{
+		itemRepo:     itemRepo,
+		relationRepo: relationRepo,
+	}
+}
+
+func (u *itemRelationUsecase) CreateRelation(ctx context.Context, itemID int64, input CreateItemRelationInput) (*RelatedItem, error) {
+	if _, err := u.findItem(ctx, itemID); err != nil {
+		return nil, err
+	}
+
+	relation, err := entity.NewItemRelation(itemID, input.RelatedItemID, input.Type)
+	if err != nil {
+		var fieldErrors entity.FieldErrors
+		if errors.As(err, &fieldErrors) {
+			return nil, fmt.Errorf("%w: %w", domainErrors.ErrInvalidInput, err)
+		}
+		return nil, err
+	}
+
+	related, err := u.findItem(ctx, relation.RelatedItemID)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := u.relationRepo.FindByItemID(ctx, itemID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve relations: %w", err)
+	}
+	for _, e := range existing {
+		if e.SameAs(relation) {
+			return nil, fmt.Errorf("%w: relation %d", domainErrors.ErrDuplicateRelation, e.ID)
+		}
+	}
+
+	created, err := u.relationRepo.Create(ctx, relation)
+	if err != nil {
+		if domainErrors.IsNotFoundError(err) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to create relation: %w", err)
+	}
+
+	return &RelatedItem{Relation: created, Item: related}, nil
+}
+
+func (u *itemRelationUsecase) GetRelations(ctx context.Context, itemID int64) ([]*RelatedItem, error) {
+	if _, err := u.findItem(ctx, itemID); err != nil {
+		return nil, err
+	}
+
+	relations, err := u.relationRepo.FindByItemID(ctx, itemID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve relations: %w", err)
+	}
+
+	result := make([]*RelatedItem, 0, len(relations))
+	for _, relation := range relations {
+		item, err := u.findItem(ctx, relation.OtherItemID(itemID))
+		if err != nil {
+			// 取得する間に相手のアイテムが削除された場合は関連も消えている
+			if domainErrors.IsNotFoundError(err) {
+				continue
+			}
+			return nil, err
+		}
+		result = append(result, &RelatedItem{Relation: relation, Item: item})
+	}
+
+	return result, nil
+}
+
+func (u *itemRelationUsecase) DeleteRelation(ctx context.Context, itemID, id int64) error {
+	if _, err := u.findItem(ctx, itemID); err != nil {
+		return err
+	}
+
+	if err := u.relationRepo.Delete(ctx, itemID, id); err != nil {
+		if domainErrors.IsRelationNotFoundError(err) {
+			return err
+		}
+		return fmt.Errorf("failed to delete relation: %w", err)
+	}
+
+	return nil
+}
+
+func (u *itemRelationUsecase) findItem(ctx context.Context, itemID int64) (*entity.Item, error) {
+	if itemID <= 0 {
+		return nil, domainErrors.ErrInvalidInput
+	}
+
+	item, err := u.itemRepo.FindByID(ctx, itemID)
+	if err != nil {
+		if domainErrors.IsNotFoundError(err) {
+			return nil, domainErrors.ErrItemNotFound
+		}
+		return nil, fmt.Errorf("failed to retrieve item: %w", err)
+	}
+	return item, nil
+}
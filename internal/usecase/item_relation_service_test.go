@@ -0,0 +1,169 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"Aicon-assignment/internal/domain/entity"
+	domainErrors "Aicon-assignment/internal/domain/errors"
+)
+
+// MockItemRelationRepository はtestify/mockを使用したモックリポジトリ
+type MockItemRelationRepository struct {
+	mock.Mock
+}
+
+func (m *MockItemRelationRepository) FindByItemID(ctx context.Context, itemID int64) ([]*entity.ItemRelation, error) {
+	args := m.Called(ctx, itemID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.ItemRelation), args.Error(1)
+}
+
+func (m *MockItemRelationRepository) Create(ctx context.Context, relation *entity.ItemRelation) (*entity.ItemRelation, error) {
+	args := m.Called(ctx, relation)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.ItemRelation), args.Error(1)
+}
+
+func (m *MockItemRelationRepository) Delete(ctx context.Context, itemID, id int64) error {
+	args := m.Called(ctx, itemID, id)
+	return args.Error(0)
+}
+
+func TestItemRelationUsecase_CreateRelation(t *testing.T) {
+	t.Run("正常系: 相手のアイテムと一緒に返す", func(t *testing.T) {
+		itemRepo := new(MockItemRepository)
+		itemRepo.On("FindByID", mock.Anything, int64(2)).Return(&entity.Item{ID: 2, Name: "箱"}, nil)
+		itemRepo.On("FindByID", mock.Anything, int64(1)).Return(&entity.Item{ID: 1, Name: "時計"}, nil)
+		relationRepo := new(MockItemRelationRepository)
+		relationRepo.On("FindByItemID", mock.Anything, int64(2)).Return([]*entity.ItemRelation{}, nil)
+		var saved *entity.ItemRelation
+		relationRepo.On("Create", mock.Anything, mock.AnythingOfType("*entity.ItemRelation")).
+			Run(func(args mock.Arguments) {
+				saved = args.Get(1).(*entity.ItemRelation)
+			}).
+			Return(&entity.ItemRelation{ID: 5, ItemID: 2, RelatedItemID: 1, Type: entity.RelationBelongsTo}, nil)
+
+		usecase := NewItemRelationUsecase(itemRepo, relationRepo)
+		got, err := usecase.CreateRelation(context.Background(), 2, CreateItemRelationInput{RelatedItemID: 1, Type: " belongs_to "})
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(5), got.Relation.ID)
+		assert.Equal(t, "時計", got.Item.Name)
+		require.NotNil(t, saved)
+		assert.Equal(t, entity.RelationBelongsTo, saved.Type)
+	})
+
+	t.Run("異常系: 自分自身との関連", func(t *testing.T) {
+		itemRepo := new(MockItemRepository)
+		itemRepo.On("FindByID", mock.Anything, int64(1)).Return(&entity.Item{ID: 1}, nil)
+		relationRepo := new(MockItemRelationRepository)
+
+		usecase := NewItemRelationUsecase(itemRepo, relationRepo)
+		_, err := usecase.CreateRelation(context.Background(), 1, CreateItemRelationInput{RelatedItemID: 1, Type: entity.RelationSet})
+
+		require.Error(t, err)
+		assert.True(t, domainErrors.IsValidationError(err))
+		var fieldErrors entity.FieldErrors
+		require.True(t, errors.As(err, &fieldErrors))
+		assert.Equal(t, "not_self", fieldErrors[0].Rule)
+		relationRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("異常系: 不明な種類", func(t *testing.T) {
+		itemRepo := new(MockItemRepository)
+		itemRepo.On("FindByID", mock.Anything, int64(1)).Return(&entity.Item{ID: 1}, nil)
+
+		usecase := NewItemRelationUsecase(itemRepo, new(MockItemRelationRepository))
+		_, err := usecase.CreateRelation(context.Background(), 1, CreateItemRelationInput{RelatedItemID: 2, Type: "parent"})
+
+		assert.True(t, domainErrors.IsValidationError(err))
+	})
+
+	t.Run("異常系: 相手のアイテムが存在しない", func(t *testing.T) {
+		itemRepo := new(MockItemRepository)
+		itemRepo.On("FindByID", mock.Anything, int64(1)).Return(&entity.Item{ID: 1}, nil)
+		itemRepo.On("FindByID", mock.Anything, int64(9)).Return(nil, domainErrors.ErrItemNotFound)
+
+		usecase := NewItemRelationUsecase(itemRepo, new(MockItemRelationRepository))
+		_, err := usecase.CreateRelation(context.Background(), 1, CreateItemRelationInput{RelatedItemID: 9, Type: entity.RelationSet})
+
+		assert.ErrorIs(t, err, domainErrors.ErrItemNotFound)
+	})
+
+	t.Run("異常系: 逆向きのセットは重複", func(t *testing.T) {
+		itemRepo := new(MockItemRepository)
+		itemRepo.On("FindByID", mock.Anything, int64(1)).Return(&entity.Item{ID: 1}, nil)
+		itemRepo.On("FindByID", mock.Anything, int64(2)).Return(&entity.Item{ID: 2}, nil)
+		relationRepo := new(MockItemRelationRepository)
+		relationRepo.On("FindByItemID", mock.Anything, int64(1)).
+			Return([]*entity.ItemRelation{{ID: 3, ItemID: 2, RelatedItemID: 1, Type: entity.RelationSet}}, nil)
+
+		usecase := NewItemRelationUsecase(itemRepo, relationRepo)
+		_, err := usecase.CreateRelation(context.Background(), 1, CreateItemRelationInput{RelatedItemID: 2, Type: entity.RelationSet})
+
+		assert.ErrorIs(t, err, domainErrors.ErrDuplicateRelation)
+		assert.True(t, domainErrors.IsConflictError(err))
+		relationRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+}
+
+func TestItemRelationUsecase_GetRelations(t *testing.T) {
+	t.Run("正常系: どちら側の関連も相手のアイテムを返す", func(t *testing.T) {
+		itemRepo := new(MockItemRepository)
+		itemRepo.On("FindByID", mock.Anything, int64(1)).Return(&entity.Item{ID: 1}, nil)
+		itemRepo.On("FindByID", mock.Anything, int64(2)).Return(&entity.Item{ID: 2}, nil)
+		itemRepo.On("FindByID", mock.Anything, int64(3)).Return(&entity.Item{ID: 3}, nil)
+		relationRepo := new(MockItemRelationRepository)
+		relationRepo.On("FindByItemID", mock.Anything, int64(1)).Return([]*entity.ItemRelation{
+			{ID: 1, ItemID: 2, RelatedItemID: 1, Type: entity.RelationBelongsTo},
+			{ID: 2, ItemID: 1, RelatedItemID: 3, Type: entity.RelationSet},
+		}, nil)
+
+		usecase := NewItemRelationUsecase(itemRepo, relationRepo)
+		got, err := usecase.GetRelations(context.Background(), 1)
+
+		require.NoError(t, err)
+		require.Len(t, got, 2)
+		assert.Equal(t, int64(2), got[0].Item.ID)
+		assert.Equal(t, int64(3), got[1].Item.ID)
+	})
+
+	t.Run("正常系: 削除された相手のアイテムは飛ばす", func(t *testing.T) {
+		itemRepo := new(MockItemRepository)
+		itemRepo.On("FindByID", mock.Anything, int64(1)).Return(&entity.Item{ID: 1}, nil)
+		itemRepo.On("FindByID", mock.Anything, int64(2)).Return(nil, domainErrors.ErrItemNotFound)
+		relationRepo := new(MockItemRelationRepository)
+		relationRepo.On("FindByItemID", mock.Anything, int64(1)).
+			Return([]*entity.ItemRelation{{ID: 1, ItemID: 2, RelatedItemID: 1, Type: entity.RelationBelongsTo}}, nil)
+
+		usecase := NewItemRelationUsecase(itemRepo, relationRepo)
+		got, err := usecase.GetRelations(context.Background(), 1)
+
+		require.NoError(t, err)
+		assert.Empty(t, got)
+	})
+}
+
+func TestItemRelationUsecase_DeleteRelation(t *testing.T) {
+	t.Run("異常系: 関連が存在しない", func(t *testing.T) {
+		itemRepo := new(MockItemRepository)
+		itemRepo.On("FindByID", mock.Anything, int64(1)).Return(&entity.Item{ID: 1}, nil)
+		relationRepo := new(MockItemRelationRepository)
+		relationRepo.On("Delete", mock.Anything, int64(1), int64(2)).Return(domainErrors.ErrRelationNotFound)
+
+		usecase := NewItemRelationUsecase(itemRepo, relationRepo)
+		err := usecase.DeleteRelation(context.Background(), 1, 2)
+
+		assert.True(t, domainErrors.IsRelationNotFoundError(err))
+	})
+}
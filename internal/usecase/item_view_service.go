@@ -0,0 +1,80 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"Aicon-assignment/internal/domain/entity"
+	domainErrors "Aicon-assignment/internal/domain/errors"
+)
+
+type ItemViewUsecase interface {
+	// 閲覧者がアイテムの詳細を見たことを記録する（閲覧者ごとに新しい方から MaxRecentlyViewedLimit 件を残す）
+	RecordView(ctx context.Context, viewer string, itemID int64) error
+	// 閲覧者が最近見たアイテムを新しい順に返す（同じアイテムは最後に見た1件にまとめ、削除されたものは除く）
+	GetRecentlyViewed(ctx context.Context, viewer string, limit int) ([]*ViewedItem, error)
+}
+
+// 最近見たアイテムと、最後に見た日時
+type ViewedItem struct {
+	Item     *entity.Item
+	ViewedAt time.Time
+}
+
+type itemViewUsecase struct {
+	itemRepo ItemRepository
+	viewRepo ItemViewRepository
+}
+
+func NewItemViewUsecase(itemRepo ItemRepository, viewRepo ItemViewRepository) ItemViewUsecase {
+	return &itemViewUsecase{
+		itemRepo: itemRepo,
+		viewRepo: viewRepo,
+	}
+}
+
+func (u *itemViewUsecase) RecordView(ctx context.Context, viewer string, itemID int64) error {
+	if itemID <= 0 {
+		return domainErrors.ErrInvalidInput
+	}
+
+	if err := u.viewRepo.Save(ctx, entity.NewItemView(viewer, itemID), entity.MaxRecentlyViewedLimit); err != nil {
+		if domainErrors.IsNotFoundError(err) {
+			return err
+		}
+		return fmt.Errorf("failed to record view: %w", err)
+	}
+
+	return nil
+}
+
+func (u *itemViewUsecase) GetRecentlyViewed(ctx context.Context, viewer string, limit int) ([]*ViewedItem, error) {
+	if limit < 1 || limit > entity.MaxRecentlyViewedLimit {
+		err := entity.FieldErrors{{Field: "limit", Value: limit, Rule: "range", Message: fmt.Sprintf("limit must be between 1 and %d", entity.MaxRecentlyViewedLimit)}}
+		return nil, fmt.Errorf("%w: %w", domainErrors.ErrInvalidInput, err)
+	}
+
+	// 統合で論理削除したアイテムの記録は残るため、残している分をすべて読んでから limit 件にする
+	views, err := u.viewRepo.FindRecentByViewer(ctx, viewer, entity.MaxRecentlyViewedLimit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve views: %w", err)
+	}
+
+	result := make([]*ViewedItem, 0, min(limit, len(views)))
+	for _, view := range views {
+		item, err := u.itemRepo.FindByID(ctx, view.ItemID)
+		if err != nil {
+			if domainErrors.IsNotFoundError(err) {
+				continue
+			}
+			return nil, fmt.Errorf("failed to retrieve item: %w", err)
+		}
+		result = append(result, &ViewedItem{Item: item, ViewedAt: view.ViewedAt})
+		if len(result) == limit {
+			break
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,96 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"Aicon-assignment/internal/domain/entity"
+	domainErrors "Aicon-assignment/internal/domain/errors"
+)
+
+// MockItemViewRepository はtestify/mockを使用したモックリポジトリ
+type MockItemViewRepository struct {
+	mock.Mock
+}
+
+func (m *MockItemViewRepository) Save(ctx context.Context, view *entity.ItemView, keep int) error {
+	args := m.Called(ctx, view, keep)
+	return args.Error(0)
+}
+
+func (m *MockItemViewRepository) FindRecentByViewer(ctx context.Context, viewer string, limit int) ([]*entity.ItemView, error) {
+	args := m.Called(ctx, viewer, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.ItemView), args.Error(1)
+}
+
+func TestItemViewUsecase_RecordView(t *testing.T) {
+	t.Run("正常系: 閲覧者ごとに上限の件数まで残すよう記録する", func(t *testing.T) {
+		viewRepo := new(MockItemViewRepository)
+		viewRepo.On("Save", mock.Anything, mock.MatchedBy(func(view *entity.ItemView) bool {
+			return view.Viewer == "admin" && view.ItemID == 1 && !view.ViewedAt.IsZero()
+		}), entity.MaxRecentlyViewedLimit).Return(nil)
+
+		err := NewItemViewUsecase(new(MockItemRepository), viewRepo).RecordView(context.Background(), "admin", 1)
+
+		assert.NoError(t, err)
+		viewRepo.AssertExpectations(t)
+	})
+
+	t.Run("異常系: アイテムが存在しない", func(t *testing.T) {
+		viewRepo := new(MockItemViewRepository)
+		viewRepo.On("Save", mock.Anything, mock.Anything, mock.Anything).Return(domainErrors.ErrItemNotFound)
+
+		err := NewItemViewUsecase(new(MockItemRepository), viewRepo).RecordView(context.Background(), "", 999)
+
+		assert.ErrorIs(t, err, domainErrors.ErrItemNotFound)
+	})
+}
+
+func TestItemViewUsecase_GetRecentlyViewed(t *testing.T) {
+	viewedAt := time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC)
+
+	t.Run("正常系: 削除されたアイテムを除いて limit 件を返す", func(t *testing.T) {
+		itemRepo := new(MockItemRepository)
+		itemRepo.On("FindByID", mock.Anything, int64(3)).Return(&entity.Item{ID: 3, Name: "エルメス バーキン"}, nil)
+		itemRepo.On("FindByID", mock.Anything, int64(2)).Return(nil, domainErrors.ErrItemNotFound)
+		itemRepo.On("FindByID", mock.Anything, int64(1)).Return(&entity.Item{ID: 1, Name: "ロレックス デイトナ"}, nil)
+		viewRepo := new(MockItemViewRepository)
+		viewRepo.On("FindRecentByViewer", mock.Anything, "admin", entity.MaxRecentlyViewedLimit).Return([]*entity.ItemView{
+			{Viewer: "admin", ItemID: 3, ViewedAt: viewedAt},
+			{Viewer: "admin", ItemID: 2, ViewedAt: viewedAt.Add(-time.Minute)},
+			{Viewer: "admin", ItemID: 1, ViewedAt: viewedAt.Add(-time.Hour)},
+			{Viewer: "admin", ItemID: 4, ViewedAt: viewedAt.Add(-2 * time.Hour)},
+		}, nil)
+
+		viewed, err := NewItemViewUsecase(itemRepo, viewRepo).GetRecentlyViewed(context.Background(), "admin", 2)
+
+		require.NoError(t, err)
+		require.Len(t, viewed, 2)
+		assert.Equal(t, int64(3), viewed[0].Item.ID)
+		assert.Equal(t, viewedAt, viewed[0].ViewedAt)
+		assert.Equal(t, int64(1), viewed[1].Item.ID)
+		// limit 件そろった後のアイテムは読まない
+		itemRepo.AssertNotCalled(t, "FindByID", mock.Anything, int64(4))
+	})
+
+	t.Run("異常系: limit が範囲外", func(t *testing.T) {
+		viewRepo := new(MockItemViewRepository)
+
+		_, err := NewItemViewUsecase(new(MockItemRepository), viewRepo).GetRecentlyViewed(context.Background(), "admin", entity.MaxRecentlyViewedLimit+1)
+
+		assert.True(t, domainErrors.IsValidationError(err))
+		var fieldErrors entity.FieldErrors
+		require.True(t, errors.As(err, &fieldErrors))
+		assert.Equal(t, "limit", fieldErrors[0].Field)
+		viewRepo.AssertNotCalled(t, "FindRecentByViewer", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
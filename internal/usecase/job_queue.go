@@ -0,0 +1,229 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"Aicon-assignment/internal/domain/entity"
+	domainErrors "Aicon-assignment/internal/domain/errors"
+)
+
+const (
+	defaultJobPollInterval = 1 * time.Second
+	defaultJobBatchSize    = 10
+	defaultJobMaxAttempts  = 5
+	// 実行中のまま止まったジョブを他のワーカーが引き継ぐまでの時間（1回の実行より十分長くする）
+	defaultJobLockTimeout = 5 * time.Minute
+	// 成功したジョブを消すまでの期間
+	defaultJobRetention = 7 * 24 * time.Hour
+
+	// 一覧で返すジョブの数
+	maxListedJobs = 100
+	// 記録するエラーの長さ（jobs.last_error に収まるようにする）
+	maxJobErrorChars = 1000
+)
+
+// 失敗した場合の再試行までの間隔（回数が要素数を超えたら最後の間隔を使う）
+var defaultJobRetryDelays = []time.Duration{5 * time.Second, 30 * time.Second, 2 * time.Minute, 10 * time.Minute}
+
+// 種類ごとのジョブの処理（エラーを返すと再試行する）
+// 同じジョブが2回実行されることがあるため、何度実行しても結果が変わらないようにする
+type JobFunc func(ctx context.Context, job *entity.Job) error
+
+// ジョブを登録する側が使う
+type JobEnqueuer interface {
+	// payload をJSONにして、すぐに実行するジョブとして登録する
+	Enqueue(ctx context.Context, jobType string, payload any) (*entity.Job, error)
+}
+
+type JobUsecase interface {
+	// 状態が status のジョブを新しい順に返す（空の場合は成功したもの以外）
+	GetJobs(ctx context.Context, status string) ([]*entity.Job, error)
+	// 失敗したジョブを試行回数を戻して実行し直す
+	RetryJob(ctx context.Context, id int64) (*entity.Job, error)
+}
+
+// 永続化したキューからジョブを取り出して実行する
+// ジョブは登録したインスタンスに限らず、キューを共有するどのインスタンスでも実行される
+type JobQueue struct {
+	jobRepo      JobRepository
+	handlers     map[string]JobFunc
+	pollInterval time.Duration
+	batchSize    int
+	maxAttempts  int
+	lockTimeout  time.Duration
+	retention    time.Duration
+	retryDelays  []time.Duration
+}
+
+func NewJobQueue(jobRepo JobRepository) *JobQueue {
+	return &JobQueue{
+		jobRepo:      jobRepo,
+		handlers:     make(map[string]JobFunc),
+		pollInterval: defaultJobPollInterval,
+		batchSize:    defaultJobBatchSize,
+		maxAttempts:  defaultJobMaxAttempts,
+		lockTimeout:  defaultJobLockTimeout,
+		retention:    defaultJobRetention,
+		retryDelays:  defaultJobRetryDelays,
+	}
+}
+
+// ジョブの種類に処理を割り当てる（Run の前に登録する）
+func (q *JobQueue) Register(jobType string, fn JobFunc) {
+	q.handlers[jobType] = fn
+}
+
+func (q *JobQueue) Enqueue(ctx context.Context, jobType string, payload any) (*entity.Job, error) {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode %s job: %w", jobType, err)
+	}
+
+	job, err := q.jobRepo.Create(ctx, &entity.Job{
+		Type:        jobType,
+		Payload:     string(body),
+		MaxAttempts: q.maxAttempts,
+		RunAt:       time.Now(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to enqueue %s job: %w", jobType, err)
+	}
+
+	return job, nil
+}
+
+func (q *JobQueue) GetJobs(ctx context.Context, status string) ([]*entity.Job, error) {
+	statuses := []string{entity.JobPending, entity.JobRunning, entity.JobFailed}
+	if status != "" {
+		if !entity.IsValidJobStatus(status) {
+			return nil, fmt.Errorf("%w: %w", domainErrors.ErrInvalidInput, entity.FieldErrors{
+				{Field: "status", Value: status, Rule: "oneof", Message: "status must be one of pending, running, succeeded, failed"},
+			})
+		}
+		statuses = []string{status}
+	}
+
+	jobs, err := q.jobRepo.FindByStatus(ctx, statuses, maxListedJobs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve jobs: %w", err)
+	}
+
+	return jobs, nil
+}
+
+func (q *JobQueue) RetryJob(ctx context.Context, id int64) (*entity.Job, error) {
+	if id <= 0 {
+		return nil, domainErrors.ErrInvalidInput
+	}
+
+	job, err := q.jobRepo.Retry(ctx, id)
+	if err != nil {
+		if domainErrors.IsJobNotFoundError(err) || domainErrors.IsConflictError(err) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to retry job: %w", err)
+	}
+
+	return job, nil
+}
+
+// ctxが終わるまで実行する時刻になったジョブを定期的に実行する
+func (q *JobQueue) Run(ctx context.Context) {
+	ticker := time.NewTicker(q.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := q.RunDue(ctx); err != nil {
+			log.Printf("failed to run jobs: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// 実行する時刻になったジョブがなくなるまで、1バッチずつ並行して実行する（停止時の最後の実行にも使う）
+func (q *JobQueue) RunDue(ctx context.Context) error {
+	for ctx.Err() == nil {
+		now := time.Now()
+		jobs, err := q.jobRepo.Claim(ctx, now, now.Add(q.lockTimeout), q.batchSize)
+		if err != nil {
+			return fmt.Errorf("failed to claim jobs: %w", err)
+		}
+
+		// 停止の途中でも、取り出したジョブは最後まで実行して結果を記録する
+		var batch sync.WaitGroup
+		for _, job := range jobs {
+			batch.Add(1)
+			go func(job *entity.Job) {
+				defer batch.Done()
+				q.execute(context.WithoutCancel(ctx), job)
+			}(job)
+		}
+		batch.Wait()
+
+		if len(jobs) < q.batchSize {
+			return nil
+		}
+	}
+	return nil
+}
+
+// 成功してから保存期間が過ぎたジョブを消す（定期的なタスクとして実行する）
+func (q *JobQueue) PurgeSucceeded(ctx context.Context) error {
+	deleted, err := q.jobRepo.DeleteSucceeded(ctx, time.Now().Add(-q.retention))
+	if err != nil {
+		return fmt.Errorf("failed to delete succeeded jobs: %w", err)
+	}
+	if deleted > 0 {
+		log.Printf("deleted %d succeeded job(s)", deleted)
+	}
+	return nil
+}
+
+// 1回実行して結果を記録する
+func (q *JobQueue) execute(ctx context.Context, job *entity.Job) {
+	fn, ok := q.handlers[job.Type]
+	if !ok {
+		// 処理が割り当てられていない種類は再試行しても成功しない
+		q.fail(ctx, job, fmt.Errorf("no handler for job type %q", job.Type), false)
+		return
+	}
+
+	if err := fn(ctx, job); err != nil {
+		q.fail(ctx, job, err, job.Attempts < job.MaxAttempts)
+		return
+	}
+
+	if err := q.jobRepo.Complete(ctx, job.ID); err != nil {
+		log.Printf("failed to complete job %d: %v", job.ID, err)
+	}
+}
+
+func (q *JobQueue) fail(ctx context.Context, job *entity.Job, cause error, retry bool) {
+	reason := cause.Error()
+	if utf8.RuneCountInString(reason) > maxJobErrorChars {
+		reason = string([]rune(reason)[:maxJobErrorChars])
+	}
+
+	var retryAt *time.Time
+	if retry {
+		at := time.Now().Add(q.retryDelays[min(job.Attempts, len(q.retryDelays))-1])
+		retryAt = &at
+	} else {
+		log.Printf("job %d (%s) failed after %d attempt(s): %v", job.ID, job.Type, job.Attempts, cause)
+	}
+
+	if err := q.jobRepo.Fail(ctx, job.ID, reason, retryAt); err != nil {
+		log.Printf("failed to record failure of job %d: %v", job.ID, err)
+	}
+}
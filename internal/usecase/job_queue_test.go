@@ -0,0 +1,223 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"Aicon-assignment/internal/domain/entity"
+	domainErrors "Aicon-assignment/internal/domain/errors"
+)
+
+// MockJobRepository はtestify/mockを使用したモックリポジトリ
+type MockJobRepository struct {
+	mock.Mock
+}
+
+func (m *MockJobRepository) Create(ctx context.Context, job *entity.Job) (*entity.Job, error) {
+	args := m.Called(ctx, job)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.Job), args.Error(1)
+}
+
+func (m *MockJobRepository) FindByID(ctx context.Context, id int64) (*entity.Job, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.Job), args.Error(1)
+}
+
+func (m *MockJobRepository) FindByStatus(ctx context.Context, statuses []string, limit int) ([]*entity.Job, error) {
+	args := m.Called(ctx, statuses, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.Job), args.Error(1)
+}
+
+func (m *MockJobRepository) Claim(ctx context.Context, now, lockedUntil time.Time, limit int) ([]*entity.Job, error) {
+	args := m.Called(ctx, now, lockedUntil, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.Job), args.Error(1)
+}
+
+func (m *MockJobRepository) Complete(ctx context.Context, id int64) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockJobRepository) Fail(ctx context.Context, id int64, reason string, retryAt *time.Time) error {
+	args := m.Called(ctx, id, reason, retryAt)
+	return args.Error(0)
+}
+
+func (m *MockJobRepository) Retry(ctx context.Context, id int64) (*entity.Job, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.Job), args.Error(1)
+}
+
+func (m *MockJobRepository) DeleteSucceeded(ctx context.Context, before time.Time) (int, error) {
+	args := m.Called(ctx, before)
+	return args.Int(0), args.Error(1)
+}
+
+func TestJobQueue_Enqueue(t *testing.T) {
+	jobRepo := new(MockJobRepository)
+	jobRepo.On("Create", mock.Anything, mock.MatchedBy(func(job *entity.Job) bool {
+		return job.Type == entity.JobWebhookDelivery && job.Payload == `{"webhook_id":1}` &&
+			job.MaxAttempts == defaultJobMaxAttempts && !job.RunAt.After(time.Now())
+	})).Return(&entity.Job{ID: 1, Status: entity.JobPending}, nil)
+
+	job, err := NewJobQueue(jobRepo).Enqueue(context.Background(), entity.JobWebhookDelivery, map[string]int{"webhook_id": 1})
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), job.ID)
+	jobRepo.AssertExpectations(t)
+}
+
+func TestJobQueue_RunDue(t *testing.T) {
+	// 1件だけ取り出す（バッチの件数に満たないので1回で終わる）
+	claim := func(jobRepo *MockJobRepository, job *entity.Job) {
+		jobRepo.On("Claim", mock.Anything, mock.Anything, mock.Anything, defaultJobBatchSize).Return([]*entity.Job{job}, nil)
+	}
+
+	t.Run("正常系: 成功したジョブを完了にする", func(t *testing.T) {
+		jobRepo := new(MockJobRepository)
+		claim(jobRepo, &entity.Job{ID: 1, Type: "test", Attempts: 1, MaxAttempts: 5})
+		jobRepo.On("Complete", mock.Anything, int64(1)).Return(nil).Once()
+
+		queue := NewJobQueue(jobRepo)
+		var ran int64
+		queue.Register("test", func(ctx context.Context, job *entity.Job) error {
+			ran = job.ID
+			return nil
+		})
+
+		require.NoError(t, queue.RunDue(context.Background()))
+		assert.Equal(t, int64(1), ran)
+		jobRepo.AssertExpectations(t)
+	})
+
+	t.Run("異常系: 失敗したジョブは間隔をあけて再試行する", func(t *testing.T) {
+		jobRepo := new(MockJobRepository)
+		claim(jobRepo, &entity.Job{ID: 1, Type: "test", Attempts: 2, MaxAttempts: 5})
+		start := time.Now()
+		jobRepo.On("Fail", mock.Anything, int64(1), "boom", mock.MatchedBy(func(retryAt *time.Time) bool {
+			return retryAt != nil && !retryAt.Before(start.Add(defaultJobRetryDelays[1]))
+		})).Return(nil).Once()
+
+		queue := NewJobQueue(jobRepo)
+		queue.Register("test", func(ctx context.Context, job *entity.Job) error {
+			return errors.New("boom")
+		})
+
+		require.NoError(t, queue.RunDue(context.Background()))
+		jobRepo.AssertExpectations(t)
+	})
+
+	t.Run("異常系: 試行の上限に達したジョブは失敗にする", func(t *testing.T) {
+		jobRepo := new(MockJobRepository)
+		claim(jobRepo, &entity.Job{ID: 1, Type: "test", Attempts: 5, MaxAttempts: 5})
+		jobRepo.On("Fail", mock.Anything, int64(1), "boom", (*time.Time)(nil)).Return(nil).Once()
+
+		queue := NewJobQueue(jobRepo)
+		queue.Register("test", func(ctx context.Context, job *entity.Job) error {
+			return errors.New("boom")
+		})
+
+		require.NoError(t, queue.RunDue(context.Background()))
+		jobRepo.AssertExpectations(t)
+	})
+
+	t.Run("異常系: 処理が登録されていない種類は再試行せず失敗にする", func(t *testing.T) {
+		jobRepo := new(MockJobRepository)
+		claim(jobRepo, &entity.Job{ID: 1, Type: "unknown", Attempts: 1, MaxAttempts: 5})
+		jobRepo.On("Fail", mock.Anything, int64(1), `no handler for job type "unknown"`, (*time.Time)(nil)).Return(nil).Once()
+
+		require.NoError(t, NewJobQueue(jobRepo).RunDue(context.Background()))
+		jobRepo.AssertExpectations(t)
+	})
+
+	t.Run("異常系: 取り出しに失敗した場合はエラー", func(t *testing.T) {
+		jobRepo := new(MockJobRepository)
+		jobRepo.On("Claim", mock.Anything, mock.Anything, mock.Anything, defaultJobBatchSize).Return(nil, domainErrors.ErrDatabaseError)
+
+		err := NewJobQueue(jobRepo).RunDue(context.Background())
+
+		assert.ErrorIs(t, err, domainErrors.ErrDatabaseError)
+	})
+}
+
+func TestJobQueue_GetJobs(t *testing.T) {
+	t.Run("正常系: 指定がなければ成功したもの以外を返す", func(t *testing.T) {
+		jobRepo := new(MockJobRepository)
+		jobRepo.On("FindByStatus", mock.Anything, []string{entity.JobPending, entity.JobRunning, entity.JobFailed}, maxListedJobs).
+			Return([]*entity.Job{{ID: 1}}, nil)
+
+		jobs, err := NewJobQueue(jobRepo).GetJobs(context.Background(), "")
+
+		require.NoError(t, err)
+		assert.Len(t, jobs, 1)
+	})
+
+	t.Run("正常系: 状態で絞り込む", func(t *testing.T) {
+		jobRepo := new(MockJobRepository)
+		jobRepo.On("FindByStatus", mock.Anything, []string{entity.JobFailed}, maxListedJobs).Return([]*entity.Job{}, nil)
+
+		_, err := NewJobQueue(jobRepo).GetJobs(context.Background(), entity.JobFailed)
+
+		require.NoError(t, err)
+		jobRepo.AssertExpectations(t)
+	})
+
+	t.Run("異常系: 不正な状態", func(t *testing.T) {
+		_, err := NewJobQueue(new(MockJobRepository)).GetJobs(context.Background(), "done")
+
+		assert.ErrorIs(t, err, domainErrors.ErrInvalidInput)
+	})
+}
+
+func TestJobQueue_RetryJob(t *testing.T) {
+	tests := []struct {
+		name    string
+		repoErr error
+		wantErr error
+	}{
+		{"正常系: 失敗したジョブを戻す", nil, nil},
+		{"異常系: 存在しない", domainErrors.ErrJobNotFound, domainErrors.ErrJobNotFound},
+		{"異常系: 失敗していない", domainErrors.ErrJobNotFailed, domainErrors.ErrJobNotFailed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			jobRepo := new(MockJobRepository)
+			if tt.repoErr != nil {
+				jobRepo.On("Retry", mock.Anything, int64(1)).Return(nil, tt.repoErr)
+			} else {
+				jobRepo.On("Retry", mock.Anything, int64(1)).Return(&entity.Job{ID: 1, Status: entity.JobPending}, nil)
+			}
+
+			job, err := NewJobQueue(jobRepo).RetryJob(context.Background(), 1)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, entity.JobPending, job.Status)
+		})
+	}
+}
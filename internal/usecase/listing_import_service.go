@@ -0,0 +1,158 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"Aicon-assignment/internal/domain/entity"
+	domainErrors "Aicon-assignment/internal/domain/errors"
+)
+
+// 下書きの名前の上限（アイテムの名前の上限に合わせる）
+const maxDraftNameLength = 100
+
+type ListingImportUsecase interface {
+	// 出品ページの内容から登録の下書きを作る（アイテムは登録しない）
+	DraftFromURL(ctx context.Context, input ImportListingInput) (*ItemDraft, error)
+}
+
+type ImportListingInput struct {
+	URL string `json:"url"`
+}
+
+// 出品ページを読み取るサイトごとの実装（Chrono24、ヤフオク!など）
+type ListingProvider interface {
+	// 読み取れるサイトのURLかどうか
+	Supports(u *url.URL) bool
+	// 出品ページを取得して読み取る（取得・解析できない場合は ErrListingUnavailable を包んで返す）
+	Fetch(ctx context.Context, u *url.URL) (*entity.Listing, error)
+}
+
+// アイテムの登録の下書き
+// 確認・修正した Item を POST /items に送って登録する
+type ItemDraft struct {
+	Item    CreateItemInput `json:"item"`
+	Listing *entity.Listing `json:"listing"`
+	// 出品ページから読み取れず、登録の前に入力が必要なフィールド
+	MissingFields []string `json:"missing_fields"`
+}
+
+type listingImportUsecase struct {
+	itemRepo  ItemRepository
+	providers []ListingProvider // 先に書いたものを優先する
+}
+
+func NewListingImportUsecase(itemRepo ItemRepository, providers ...ListingProvider) ListingImportUsecase {
+	return &listingImportUsecase{
+		itemRepo:  itemRepo,
+		providers: providers,
+	}
+}
+
+func (u *listingImportUsecase) DraftFromURL(ctx context.Context, input ImportListingInput) (*ItemDraft, error) {
+	rawURL := strings.TrimSpace(input.URL)
+	listingURL, err := url.Parse(rawURL)
+	if rawURL == "" || err != nil || (listingURL.Scheme != "http" && listingURL.Scheme != "https") || listingURL.Host == "" {
+		return nil, fmt.Errorf("%w: %w", domainErrors.ErrInvalidInput, entity.FieldErrors{
+			{Field: "url", Value: input.URL, Rule: "url", Message: "url must be an http or https URL"},
+		})
+	}
+
+	var provider ListingProvider
+	for _, p := range u.providers {
+		if p.Supports(listingURL) {
+			provider = p
+			break
+		}
+	}
+	if provider == nil {
+		return nil, fmt.Errorf("%w: %s", domainErrors.ErrUnsupportedListing, listingURL.Hostname())
+	}
+
+	listing, err := provider.Fetch(ctx, listingURL)
+	if err != nil {
+		return nil, err
+	}
+	if listing.URL == "" {
+		listing.URL = listingURL.String()
+	}
+
+	brands, err := u.itemRepo.FindBrands(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve brands: %w", err)
+	}
+
+	draft := &ItemDraft{
+		Item: CreateItemInput{
+			Name:       truncateDraftName(strings.TrimSpace(listing.Title)),
+			Category:   listing.Category,
+			Brand:      draftBrand(listing, brands),
+			Attributes: map[string]string{},
+		},
+		Listing:       listing,
+		MissingFields: []string{},
+	}
+	// 外貨の価格は購入価格（円）に換算しない
+	if listing.Currency == "" || listing.Currency == entity.CurrencyJPY {
+		draft.Item.PurchasePrice = listing.Price
+	}
+
+	item := &entity.Item{
+		Name:          draft.Item.Name,
+		Category:      draft.Item.Category,
+		Brand:         draft.Item.Brand,
+		PurchasePrice: draft.Item.PurchasePrice,
+	}
+	if err := item.Validate(); err != nil {
+		var fieldErrors entity.FieldErrors
+		if errors.As(err, &fieldErrors) {
+			for _, fieldError := range fieldErrors {
+				draft.MissingFields = append(draft.MissingFields, fieldError.Field)
+			}
+		}
+	}
+	return draft, nil
+}
+
+// 既存のブランドと表記が違うだけなら既存の表記に揃え、読み取れなかった場合はタイトルに含まれる既存のブランドを使う
+func draftBrand(listing *entity.Listing, brands []string) string {
+	brand := strings.TrimSpace(listing.Brand)
+	if brand != "" {
+		if match := entity.MatchBrand(brand, brands); match.Exact != "" {
+			return match.Exact
+		}
+		return brand
+	}
+
+	words := " " + strings.Join(strings.FieldsFunc(strings.ToLower(listing.Title), isTitleSeparator), " ") + " "
+	found := ""
+	for _, b := range brands {
+		normalized := strings.Join(strings.FieldsFunc(strings.ToLower(b), isTitleSeparator), " ")
+		// 長いブランドを優先する（"LOUIS VUITTON" と "LOUIS" なら前者）
+		if normalized != "" && strings.Contains(words, " "+normalized+" ") && len(b) > len(found) {
+			found = b
+		}
+	}
+	return found
+}
+
+func isTitleSeparator(r rune) bool {
+	return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+}
+
+// 文字の途中で切らないように上限のバイト数に収める
+func truncateDraftName(name string) string {
+	if len(name) <= maxDraftNameLength {
+		return name
+	}
+	name = name[:maxDraftNameLength]
+	for !utf8.ValidString(name) {
+		name = name[:len(name)-1]
+	}
+	return strings.TrimSpace(name)
+}
@@ -0,0 +1,122 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"Aicon-assignment/internal/domain/entity"
+	domainErrors "Aicon-assignment/internal/domain/errors"
+)
+
+// 1つのホストの出品ページを決まった内容で返す
+type stubListingProvider struct {
+	host    string
+	listing *entity.Listing
+	err     error
+	fetched bool
+}
+
+func (p *stubListingProvider) Supports(u *url.URL) bool {
+	return u.Hostname() == p.host
+}
+
+func (p *stubListingProvider) Fetch(ctx context.Context, u *url.URL) (*entity.Listing, error) {
+	p.fetched = true
+	if p.err != nil {
+		return nil, p.err
+	}
+	return p.listing, nil
+}
+
+func TestListingImportUsecase_DraftFromURL(t *testing.T) {
+	ctx := context.Background()
+	brands := []string{"HERMÈS", "LOUIS", "LOUIS VUITTON", "ROLEX"}
+
+	t.Run("正常系: ブランドの表記を既存のものに揃える", func(t *testing.T) {
+		itemRepo := new(MockItemRepository)
+		itemRepo.On("FindBrands", mock.Anything).Return(brands, nil)
+		provider := &stubListingProvider{host: "www.chrono24.jp", listing: &entity.Listing{
+			Site: entity.ListingSiteChrono24, Title: "Rolex Daytona 116500LN", Brand: "Rolex", Category: "時計",
+			Price: 3480000, Currency: entity.CurrencyJPY, Photos: []string{"https://img.chrono24.com/1.jpg"},
+		}}
+
+		draft, err := NewListingImportUsecase(itemRepo, provider).DraftFromURL(ctx, ImportListingInput{URL: " https://www.chrono24.jp/rolex/daytona--id1.htm "})
+
+		require.NoError(t, err)
+		assert.Equal(t, CreateItemInput{Name: "Rolex Daytona 116500LN", Category: "時計", Brand: "ROLEX", PurchasePrice: 3480000, Attributes: map[string]string{}}, draft.Item)
+		assert.Equal(t, "https://www.chrono24.jp/rolex/daytona--id1.htm", draft.Listing.URL)
+		assert.Equal(t, []string{"purchase_date"}, draft.MissingFields)
+	})
+
+	t.Run("正常系: ブランドがなければタイトルに含まれる既存のブランドを使う", func(t *testing.T) {
+		itemRepo := new(MockItemRepository)
+		itemRepo.On("FindBrands", mock.Anything).Return(brands, nil)
+		provider := &stubListingProvider{host: "page.auctions.yahoo.co.jp", listing: &entity.Listing{
+			Site: entity.ListingSiteYahooAuctions, Title: "【美品】Louis Vuitton ネヴァーフルMM", Price: 150000, Currency: entity.CurrencyJPY,
+		}}
+
+		draft, err := NewListingImportUsecase(itemRepo, provider).DraftFromURL(ctx, ImportListingInput{URL: "https://page.auctions.yahoo.co.jp/jp/auction/x1"})
+
+		require.NoError(t, err)
+		assert.Equal(t, "LOUIS VUITTON", draft.Item.Brand)
+		assert.Equal(t, []string{"category", "purchase_date"}, draft.MissingFields)
+	})
+
+	t.Run("正常系: 外貨の価格は購入価格にしない", func(t *testing.T) {
+		itemRepo := new(MockItemRepository)
+		itemRepo.On("FindBrands", mock.Anything).Return(brands, nil)
+		provider := &stubListingProvider{host: "www.chrono24.de", listing: &entity.Listing{
+			Title: "Omega Speedmaster", Brand: "Omega", Category: "時計", Price: 6500, Currency: "EUR",
+		}}
+
+		draft, err := NewListingImportUsecase(itemRepo, provider).DraftFromURL(ctx, ImportListingInput{URL: "https://www.chrono24.de/omega/speedmaster--id2.htm"})
+
+		require.NoError(t, err)
+		assert.Zero(t, draft.Item.PurchasePrice)
+		assert.Equal(t, "Omega", draft.Item.Brand)
+		assert.Equal(t, 6500, draft.Listing.Price)
+	})
+
+	t.Run("異常系: URLではない", func(t *testing.T) {
+		provider := &stubListingProvider{host: "www.chrono24.jp"}
+
+		_, err := NewListingImportUsecase(new(MockItemRepository), provider).DraftFromURL(ctx, ImportListingInput{URL: "chrono24.jp/rolex"})
+
+		assert.True(t, domainErrors.IsValidationError(err))
+		var fieldErrors entity.FieldErrors
+		require.True(t, errors.As(err, &fieldErrors))
+		assert.Equal(t, "url", fieldErrors[0].Field)
+		assert.False(t, provider.fetched)
+	})
+
+	t.Run("異常系: 対応していないサイト", func(t *testing.T) {
+		provider := &stubListingProvider{host: "www.chrono24.jp"}
+
+		_, err := NewListingImportUsecase(new(MockItemRepository), provider).DraftFromURL(ctx, ImportListingInput{URL: "http://localhost:8080/items"})
+
+		assert.ErrorIs(t, err, domainErrors.ErrUnsupportedListing)
+		assert.False(t, provider.fetched)
+	})
+
+	t.Run("異常系: 出品ページを取得できない", func(t *testing.T) {
+		provider := &stubListingProvider{host: "www.chrono24.jp", err: fmt.Errorf("%w: status 503", domainErrors.ErrListingUnavailable)}
+
+		_, err := NewListingImportUsecase(new(MockItemRepository), provider).DraftFromURL(ctx, ImportListingInput{URL: "https://www.chrono24.jp/rolex/daytona--id1.htm"})
+
+		assert.ErrorIs(t, err, domainErrors.ErrListingUnavailable)
+	})
+}
+
+func TestTruncateDraftName(t *testing.T) {
+	// 全角は3バイトのため、100バイトに収まる33文字で切る
+	assert.Equal(t, strings.Repeat("時", 33), truncateDraftName(strings.Repeat("時", 40)))
+	assert.Equal(t, "Rolex Daytona", truncateDraftName("Rolex Daytona"))
+}
@@ -0,0 +1,115 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"Aicon-assignment/internal/domain/entity"
+	domainErrors "Aicon-assignment/internal/domain/errors"
+)
+
+type LoanUsecase interface {
+	LendItem(ctx context.Context, itemID int64, input CreateLoanInput) (*entity.Loan, error)
+	ReturnLoan(ctx context.Context, id int64) (*entity.Loan, error)
+	GetOverdueLoans(ctx context.Context) ([]*entity.Loan, error)
+}
+
+type CreateLoanInput struct {
+	Borrower string `json:"borrower"`
+	DueDate  string `json:"due_date"`
+}
+
+type loanUsecase struct {
+	itemRepo   ItemRepository
+	loanRepo   LoanRepository
+	transactor Transactor
+}
+
+func NewLoanUsecase(itemRepo ItemRepository, loanRepo LoanRepository, transactor Transactor) LoanUsecase {
+	return &loanUsecase{
+		itemRepo:   itemRepo,
+		loanRepo:   loanRepo,
+		transactor: transactor,
+	}
+}
+
+func (u *loanUsecase) LendItem(ctx context.Context, itemID int64, input CreateLoanInput) (*entity.Loan, error) {
+	if itemID <= 0 {
+		return nil, domainErrors.ErrInvalidInput
+	}
+
+	// 貸出状況の確認と登録を1つのトランザクションで行う
+	var createdLoan *entity.Loan
+	err := u.transactor.WithTx(ctx, func(repos Repositories) error {
+		// 貸出対象のアイテムが存在するか確認
+		if _, err := repos.Items.FindByID(ctx, itemID); err != nil {
+			if domainErrors.IsNotFoundError(err) {
+				return domainErrors.ErrItemNotFound
+			}
+			return fmt.Errorf("failed to retrieve item: %w", err)
+		}
+
+		// 既に貸出中なら二重に貸し出さない
+		_, err := repos.Loans.FindActiveByItemID(ctx, itemID)
+		if err == nil {
+			return domainErrors.ErrItemAlreadyOnLoan
+		}
+		if !domainErrors.IsLoanNotFoundError(err) {
+			return fmt.Errorf("failed to check active loan: %w", err)
+		}
+
+		loan, err := entity.NewLoan(itemID, input.Borrower, input.DueDate)
+		if err != nil {
+			return fmt.Errorf("%w: %s", domainErrors.ErrInvalidInput, err.Error())
+		}
+
+		createdLoan, err = repos.Loans.Create(ctx, loan)
+		if err != nil {
+			return fmt.Errorf("failed to create loan: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return createdLoan, nil
+}
+
+func (u *loanUsecase) ReturnLoan(ctx context.Context, id int64) (*entity.Loan, error) {
+	if id <= 0 {
+		return nil, domainErrors.ErrInvalidInput
+	}
+
+	loan, err := u.loanRepo.FindByID(ctx, id)
+	if err != nil {
+		if domainErrors.IsLoanNotFoundError(err) {
+			return nil, domainErrors.ErrLoanNotFound
+		}
+		return nil, fmt.Errorf("failed to retrieve loan: %w", err)
+	}
+
+	if loan.IsReturned() {
+		return nil, domainErrors.ErrLoanAlreadyReturned
+	}
+
+	if err := loan.Return(); err != nil {
+		return nil, fmt.Errorf("%w: %s", domainErrors.ErrInvalidInput, err.Error())
+	}
+
+	if err := u.loanRepo.Update(ctx, loan); err != nil {
+		return nil, fmt.Errorf("failed to update loan: %w", err)
+	}
+
+	return loan, nil
+}
+
+func (u *loanUsecase) GetOverdueLoans(ctx context.Context) ([]*entity.Loan, error) {
+	loans, err := u.loanRepo.FindOverdue(ctx, time.Now().Format("2006-01-02"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve overdue loans: %w", err)
+	}
+
+	return loans, nil
+}
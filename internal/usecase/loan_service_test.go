@@ -0,0 +1,183 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"Aicon-assignment/internal/domain/entity"
+	domainErrors "Aicon-assignment/internal/domain/errors"
+)
+
+// MockLoanRepository はtestify/mockを使用したモックリポジトリ
+type MockLoanRepository struct {
+	mock.Mock
+}
+
+func (m *MockLoanRepository) FindByID(ctx context.Context, id int64) (*entity.Loan, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.Loan), args.Error(1)
+}
+
+func (m *MockLoanRepository) FindActiveByItemID(ctx context.Context, itemID int64) (*entity.Loan, error) {
+	args := m.Called(ctx, itemID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.Loan), args.Error(1)
+}
+
+func (m *MockLoanRepository) FindOverdue(ctx context.Context, today string) ([]*entity.Loan, error) {
+	args := m.Called(ctx, today)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.Loan), args.Error(1)
+}
+
+func (m *MockLoanRepository) Create(ctx context.Context, loan *entity.Loan) (*entity.Loan, error) {
+	args := m.Called(ctx, loan)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.Loan), args.Error(1)
+}
+
+func (m *MockLoanRepository) Update(ctx context.Context, loan *entity.Loan) error {
+	args := m.Called(ctx, loan)
+	return args.Error(0)
+}
+
+func TestLoanUsecase_LendItem(t *testing.T) {
+	validInput := CreateLoanInput{Borrower: "山田 太郎", DueDate: "2023-06-30"}
+
+	tests := []struct {
+		name        string
+		itemID      int64
+		input       CreateLoanInput
+		setupMock   func(*MockItemRepository, *MockLoanRepository)
+		expectedErr error
+	}{
+		{
+			name:   "正常系: アイテムを貸し出す",
+			itemID: 1,
+			input:  validInput,
+			setupMock: func(itemRepo *MockItemRepository, loanRepo *MockLoanRepository) {
+				item, _ := entity.NewItem("時計1", "時計", "ROLEX", 1000000, "2023-01-01")
+				item.ID = 1
+				itemRepo.On("FindByID", mock.Anything, int64(1)).Return(item, nil)
+				loanRepo.On("FindActiveByItemID", mock.Anything, int64(1)).Return(nil, domainErrors.ErrLoanNotFound)
+				createdLoan, _ := entity.NewLoan(1, "山田 太郎", "2023-06-30")
+				createdLoan.ID = 10
+				loanRepo.On("Create", mock.Anything, mock.AnythingOfType("*entity.Loan")).Return(createdLoan, nil)
+			},
+		},
+		{
+			name:   "異常系: 存在しないアイテム",
+			itemID: 999,
+			input:  validInput,
+			setupMock: func(itemRepo *MockItemRepository, loanRepo *MockLoanRepository) {
+				itemRepo.On("FindByID", mock.Anything, int64(999)).Return(nil, domainErrors.ErrItemNotFound)
+			},
+			expectedErr: domainErrors.ErrItemNotFound,
+		},
+		{
+			name:   "異常系: 既に貸出中",
+			itemID: 1,
+			input:  validInput,
+			setupMock: func(itemRepo *MockItemRepository, loanRepo *MockLoanRepository) {
+				item, _ := entity.NewItem("時計1", "時計", "ROLEX", 1000000, "2023-01-01")
+				item.ID = 1
+				itemRepo.On("FindByID", mock.Anything, int64(1)).Return(item, nil)
+				activeLoan, _ := entity.NewLoan(1, "佐藤 花子", "2023-05-31")
+				loanRepo.On("FindActiveByItemID", mock.Anything, int64(1)).Return(activeLoan, nil)
+			},
+			expectedErr: domainErrors.ErrItemAlreadyOnLoan,
+		},
+		{
+			name:   "異常系: 無効な返却期限",
+			itemID: 1,
+			input:  CreateLoanInput{Borrower: "山田 太郎", DueDate: "2023/06/30"},
+			setupMock: func(itemRepo *MockItemRepository, loanRepo *MockLoanRepository) {
+				item, _ := entity.NewItem("時計1", "時計", "ROLEX", 1000000, "2023-01-01")
+				item.ID = 1
+				itemRepo.On("FindByID", mock.Anything, int64(1)).Return(item, nil)
+				loanRepo.On("FindActiveByItemID", mock.Anything, int64(1)).Return(nil, domainErrors.ErrLoanNotFound)
+			},
+			expectedErr: domainErrors.ErrInvalidInput,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			itemRepo := new(MockItemRepository)
+			loanRepo := new(MockLoanRepository)
+			tt.setupMock(itemRepo, loanRepo)
+			usecase := NewLoanUsecase(itemRepo, loanRepo, &MockTransactor{repos: Repositories{Items: itemRepo, Loans: loanRepo}})
+
+			loan, err := usecase.LendItem(context.Background(), tt.itemID, tt.input)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				assert.Nil(t, loan)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, loan)
+				assert.Equal(t, tt.input.Borrower, loan.Borrower)
+				assert.Equal(t, tt.input.DueDate, loan.DueDate)
+			}
+
+			itemRepo.AssertExpectations(t)
+			loanRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestLoanUsecase_ReturnLoan(t *testing.T) {
+	t.Run("正常系: 貸出を返却する", func(t *testing.T) {
+		loanRepo := new(MockLoanRepository)
+		loan, _ := entity.NewLoan(1, "山田 太郎", "2023-06-30")
+		loan.ID = 10
+		loanRepo.On("FindByID", mock.Anything, int64(10)).Return(loan, nil)
+		loanRepo.On("Update", mock.Anything, loan).Return(nil)
+
+		usecase := NewLoanUsecase(new(MockItemRepository), loanRepo, &MockTransactor{})
+		returned, err := usecase.ReturnLoan(context.Background(), 10)
+
+		assert.NoError(t, err)
+		assert.True(t, returned.IsReturned())
+		loanRepo.AssertExpectations(t)
+	})
+
+	t.Run("異常系: 返却済みの貸出", func(t *testing.T) {
+		loanRepo := new(MockLoanRepository)
+		loan, _ := entity.NewLoan(1, "山田 太郎", "2023-06-30")
+		loan.ID = 10
+		returnedAt := time.Now()
+		loan.ReturnedAt = &returnedAt
+		loanRepo.On("FindByID", mock.Anything, int64(10)).Return(loan, nil)
+
+		usecase := NewLoanUsecase(new(MockItemRepository), loanRepo, &MockTransactor{})
+		_, err := usecase.ReturnLoan(context.Background(), 10)
+
+		assert.ErrorIs(t, err, domainErrors.ErrLoanAlreadyReturned)
+		loanRepo.AssertExpectations(t)
+	})
+
+	t.Run("異常系: 存在しない貸出", func(t *testing.T) {
+		loanRepo := new(MockLoanRepository)
+		loanRepo.On("FindByID", mock.Anything, int64(999)).Return(nil, domainErrors.ErrLoanNotFound)
+
+		usecase := NewLoanUsecase(new(MockItemRepository), loanRepo, &MockTransactor{})
+		_, err := usecase.ReturnLoan(context.Background(), 999)
+
+		assert.ErrorIs(t, err, domainErrors.ErrLoanNotFound)
+		loanRepo.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,171 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"Aicon-assignment/internal/domain/entity"
+	domainErrors "Aicon-assignment/internal/domain/errors"
+)
+
+type LocationUsecase interface {
+	GetAllLocations(ctx context.Context) ([]*entity.Location, error)
+	GetLocationByID(ctx context.Context, id int64) (*entity.Location, error)
+	CreateLocation(ctx context.Context, input LocationInput) (*entity.Location, error)
+	UpdateLocation(ctx context.Context, id int64, input LocationInput) (*entity.Location, error)
+	DeleteLocation(ctx context.Context, id int64) error
+	MoveItem(ctx context.Context, itemID int64, input MoveItemInput) (*entity.Item, error)
+	GetItemLocationHistory(ctx context.Context, itemID int64) ([]*entity.LocationMove, error)
+}
+
+type LocationInput struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+// 保管場所の移動用の入力構造体（location_idがnullなら保管場所の解除）
+type MoveItemInput struct {
+	LocationID *int64 `json:"location_id"`
+}
+
+type locationUsecase struct {
+	itemRepo     ItemRepository
+	locationRepo LocationRepository
+	transactor   Transactor
+}
+
+func NewLocationUsecase(itemRepo ItemRepository, locationRepo LocationRepository, transactor Transactor) LocationUsecase {
+	return &locationUsecase{
+		itemRepo:     itemRepo,
+		locationRepo: locationRepo,
+		transactor:   transactor,
+	}
+}
+
+func (u *locationUsecase) GetAllLocations(ctx context.Context) ([]*entity.Location, error) {
+	locations, err := u.locationRepo.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve locations: %w", err)
+	}
+
+	return locations, nil
+}
+
+func (u *locationUsecase) GetLocationByID(ctx context.Context, id int64) (*entity.Location, error) {
+	if id <= 0 {
+		return nil, domainErrors.ErrInvalidInput
+	}
+
+	location, err := u.locationRepo.FindByID(ctx, id)
+	if err != nil {
+		if domainErrors.IsLocationNotFoundError(err) {
+			return nil, domainErrors.ErrLocationNotFound
+		}
+		return nil, fmt.Errorf("failed to retrieve location: %w", err)
+	}
+
+	return location, nil
+}
+
+func (u *locationUsecase) CreateLocation(ctx context.Context, input LocationInput) (*entity.Location, error) {
+	location, err := entity.NewLocation(input.Name, input.Description)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", domainErrors.ErrInvalidInput, err.Error())
+	}
+
+	createdLocation, err := u.locationRepo.Create(ctx, location)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create location: %w", err)
+	}
+
+	return createdLocation, nil
+}
+
+func (u *locationUsecase) UpdateLocation(ctx context.Context, id int64, input LocationInput) (*entity.Location, error) {
+	location, err := u.GetLocationByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := location.Update(input.Name, input.Description); err != nil {
+		return nil, fmt.Errorf("%w: %s", domainErrors.ErrInvalidInput, err.Error())
+	}
+
+	if err := u.locationRepo.Update(ctx, location); err != nil {
+		return nil, fmt.Errorf("failed to update location: %w", err)
+	}
+
+	return location, nil
+}
+
+func (u *locationUsecase) DeleteLocation(ctx context.Context, id int64) error {
+	if _, err := u.GetLocationByID(ctx, id); err != nil {
+		return err
+	}
+
+	if err := u.locationRepo.Delete(ctx, id); err != nil {
+		return fmt.Errorf("failed to delete location: %w", err)
+	}
+
+	return nil
+}
+
+func (u *locationUsecase) MoveItem(ctx context.Context, itemID int64, input MoveItemInput) (*entity.Item, error) {
+	if itemID <= 0 {
+		return nil, domainErrors.ErrInvalidInput
+	}
+
+	item, err := u.itemRepo.FindByID(ctx, itemID)
+	if err != nil {
+		if domainErrors.IsNotFoundError(err) {
+			return nil, domainErrors.ErrItemNotFound
+		}
+		return nil, fmt.Errorf("failed to retrieve item: %w", err)
+	}
+
+	// 移動先の保管場所が存在するか確認
+	if input.LocationID != nil {
+		if _, err := u.GetLocationByID(ctx, *input.LocationID); err != nil {
+			return nil, err
+		}
+	}
+
+	move := item.MoveTo(input.LocationID)
+
+	// 保管場所の更新と履歴の記録を1つのトランザクションで行う
+	err = u.transactor.WithTx(ctx, func(repos Repositories) error {
+		if err := repos.Items.Update(ctx, item); err != nil {
+			return fmt.Errorf("failed to update item: %w", err)
+		}
+
+		if _, err := repos.Locations.CreateMove(ctx, move); err != nil {
+			return fmt.Errorf("failed to record location history: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return item, nil
+}
+
+func (u *locationUsecase) GetItemLocationHistory(ctx context.Context, itemID int64) ([]*entity.LocationMove, error) {
+	if itemID <= 0 {
+		return nil, domainErrors.ErrInvalidInput
+	}
+
+	if _, err := u.itemRepo.FindByID(ctx, itemID); err != nil {
+		if domainErrors.IsNotFoundError(err) {
+			return nil, domainErrors.ErrItemNotFound
+		}
+		return nil, fmt.Errorf("failed to retrieve item: %w", err)
+	}
+
+	moves, err := u.locationRepo.FindMovesByItemID(ctx, itemID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve location history: %w", err)
+	}
+
+	return moves, nil
+}
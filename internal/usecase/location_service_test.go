@@ -0,0 +1,138 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"Aicon-assignment/internal/domain/entity"
+	domainErrors "Aicon-assignment/internal/domain/errors"
+)
+
+// MockLocationRepository はtestify/mockを使用したモックリポジトリ
+type MockLocationRepository struct {
+	mock.Mock
+}
+
+func (m *MockLocationRepository) FindAll(ctx context.Context) ([]*entity.Location, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.Location), args.Error(1)
+}
+
+func (m *MockLocationRepository) FindByID(ctx context.Context, id int64) (*entity.Location, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.Location), args.Error(1)
+}
+
+func (m *MockLocationRepository) Create(ctx context.Context, location *entity.Location) (*entity.Location, error) {
+	args := m.Called(ctx, location)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.Location), args.Error(1)
+}
+
+func (m *MockLocationRepository) Update(ctx context.Context, location *entity.Location) error {
+	args := m.Called(ctx, location)
+	return args.Error(0)
+}
+
+func (m *MockLocationRepository) Delete(ctx context.Context, id int64) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockLocationRepository) CreateMove(ctx context.Context, move *entity.LocationMove) (*entity.LocationMove, error) {
+	args := m.Called(ctx, move)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.LocationMove), args.Error(1)
+}
+
+func (m *MockLocationRepository) FindMovesByItemID(ctx context.Context, itemID int64) ([]*entity.LocationMove, error) {
+	args := m.Called(ctx, itemID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.LocationMove), args.Error(1)
+}
+
+func TestLocationUsecase_MoveItem(t *testing.T) {
+	safeID := int64(1)
+	vaultID := int64(2)
+
+	t.Run("正常系: 保管場所を移動して履歴を記録", func(t *testing.T) {
+		itemRepo := new(MockItemRepository)
+		locationRepo := new(MockLocationRepository)
+
+		item, _ := entity.NewItem("時計1", "時計", "ROLEX", 1000000, "2023-01-01")
+		item.ID = 1
+		item.LocationID = &safeID
+		itemRepo.On("FindByID", mock.Anything, int64(1)).Return(item, nil)
+		itemRepo.On("Update", mock.Anything, item).Return(nil)
+
+		vault, _ := entity.NewLocation("貸金庫", "")
+		vault.ID = vaultID
+		locationRepo.On("FindByID", mock.Anything, vaultID).Return(vault, nil)
+		locationRepo.On("CreateMove", mock.Anything, mock.MatchedBy(func(move *entity.LocationMove) bool {
+			return move.ItemID == 1 && *move.FromLocationID == safeID && *move.ToLocationID == vaultID
+		})).Return(&entity.LocationMove{ID: 1}, nil)
+
+		usecase := NewLocationUsecase(itemRepo, locationRepo, &MockTransactor{repos: Repositories{Items: itemRepo, Locations: locationRepo}})
+		moved, err := usecase.MoveItem(context.Background(), 1, MoveItemInput{LocationID: &vaultID})
+
+		require.NoError(t, err)
+		assert.Equal(t, vaultID, *moved.LocationID)
+		itemRepo.AssertExpectations(t)
+		locationRepo.AssertExpectations(t)
+	})
+
+	t.Run("異常系: 存在しない保管場所", func(t *testing.T) {
+		itemRepo := new(MockItemRepository)
+		locationRepo := new(MockLocationRepository)
+
+		item, _ := entity.NewItem("時計1", "時計", "ROLEX", 1000000, "2023-01-01")
+		item.ID = 1
+		itemRepo.On("FindByID", mock.Anything, int64(1)).Return(item, nil)
+		locationRepo.On("FindByID", mock.Anything, int64(999)).Return(nil, domainErrors.ErrLocationNotFound)
+
+		usecase := NewLocationUsecase(itemRepo, locationRepo, &MockTransactor{repos: Repositories{Items: itemRepo, Locations: locationRepo}})
+		missingID := int64(999)
+		_, err := usecase.MoveItem(context.Background(), 1, MoveItemInput{LocationID: &missingID})
+
+		assert.ErrorIs(t, err, domainErrors.ErrLocationNotFound)
+		itemRepo.AssertExpectations(t)
+		locationRepo.AssertExpectations(t)
+	})
+
+	t.Run("異常系: 存在しないアイテム", func(t *testing.T) {
+		itemRepo := new(MockItemRepository)
+		locationRepo := new(MockLocationRepository)
+		itemRepo.On("FindByID", mock.Anything, int64(999)).Return(nil, domainErrors.ErrItemNotFound)
+
+		usecase := NewLocationUsecase(itemRepo, locationRepo, &MockTransactor{repos: Repositories{Items: itemRepo, Locations: locationRepo}})
+		_, err := usecase.MoveItem(context.Background(), 999, MoveItemInput{LocationID: &vaultID})
+
+		assert.ErrorIs(t, err, domainErrors.ErrItemNotFound)
+		itemRepo.AssertExpectations(t)
+	})
+}
+
+func TestLocationUsecase_CreateLocation(t *testing.T) {
+	t.Run("異常系: 名前が空", func(t *testing.T) {
+		usecase := NewLocationUsecase(new(MockItemRepository), new(MockLocationRepository), &MockTransactor{})
+		_, err := usecase.CreateLocation(context.Background(), LocationInput{Name: " "})
+
+		assert.ErrorIs(t, err, domainErrors.ErrInvalidInput)
+	})
+}
@@ -0,0 +1,76 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"Aicon-assignment/internal/domain/entity"
+	domainErrors "Aicon-assignment/internal/domain/errors"
+)
+
+// 利用者へ通知を送る（送り先は実装ごとの設定で決まる）
+type Notifier interface {
+	Notify(ctx context.Context, notification entity.Notification) error
+}
+
+// 定期的に実行する保守の処理（Scheduler にタスクとして登録する）
+type Maintenance struct {
+	itemRepo       ItemRepository
+	loanRepo       LoanRepository
+	notifier       Notifier
+	trashRetention time.Duration
+}
+
+func NewMaintenance(itemRepo ItemRepository, loanRepo LoanRepository, notifier Notifier, trashRetention time.Duration) *Maintenance {
+	return &Maintenance{
+		itemRepo:       itemRepo,
+		loanRepo:       loanRepo,
+		notifier:       notifier,
+		trashRetention: trashRetention,
+	}
+}
+
+// 統合で論理削除してから保存期間が過ぎたアイテムを完全に削除する
+func (m *Maintenance) PurgeTrash(ctx context.Context) error {
+	deleted, err := m.itemRepo.PurgeDeleted(ctx, time.Now().Add(-m.trashRetention))
+	if err != nil {
+		return fmt.Errorf("failed to purge deleted items: %w", err)
+	}
+	if deleted > 0 {
+		log.Printf("purged %d deleted item(s)", deleted)
+	}
+	return nil
+}
+
+// 返却期限を過ぎた貸出があれば、まとめて1通で通知する
+func (m *Maintenance) CheckOverdueLoans(ctx context.Context) error {
+	today := time.Now().Format("2006-01-02")
+	loans, err := m.loanRepo.FindOverdue(ctx, today)
+	if err != nil {
+		return fmt.Errorf("failed to retrieve overdue loans: %w", err)
+	}
+	if len(loans) == 0 {
+		return nil
+	}
+
+	notice := entity.OverdueLoansNotice{Date: today, Loans: make([]entity.OverdueLoan, 0, len(loans))}
+	for _, loan := range loans {
+		overdue := entity.OverdueLoan{LoanID: loan.ID, ItemID: loan.ItemID, Borrower: loan.Borrower, DueDate: loan.DueDate}
+		item, err := m.itemRepo.FindByID(ctx, loan.ItemID)
+		if err != nil && !domainErrors.IsNotFoundError(err) {
+			return fmt.Errorf("failed to retrieve item %d: %w", loan.ItemID, err)
+		}
+		if item != nil {
+			overdue.ItemName = item.Name
+		}
+		notice.Loans = append(notice.Loans, overdue)
+	}
+
+	if err := m.notifier.Notify(ctx, entity.Notification{Kind: entity.NotificationLoansOverdue, Data: notice}); err != nil {
+		return fmt.Errorf("failed to notify overdue loans: %w", err)
+	}
+	log.Printf("notified %d overdue loan(s)", len(loans))
+	return nil
+}
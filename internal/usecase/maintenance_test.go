@@ -0,0 +1,90 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"Aicon-assignment/internal/domain/entity"
+	domainErrors "Aicon-assignment/internal/domain/errors"
+)
+
+// MockNotifier はtestify/mockを使用した通知のモック
+type MockNotifier struct {
+	mock.Mock
+}
+
+func (m *MockNotifier) Notify(ctx context.Context, notification entity.Notification) error {
+	args := m.Called(ctx, notification)
+	return args.Error(0)
+}
+
+func TestMaintenance_PurgeTrash(t *testing.T) {
+	itemRepo := new(MockItemRepository)
+	start := time.Now()
+	itemRepo.On("PurgeDeleted", mock.Anything, mock.MatchedBy(func(before time.Time) bool {
+		return !before.After(start.Add(-30*24*time.Hour).Add(time.Minute)) && before.Before(start)
+	})).Return(2, nil)
+
+	err := NewMaintenance(itemRepo, new(MockLoanRepository), new(MockNotifier), 30*24*time.Hour).PurgeTrash(context.Background())
+
+	require.NoError(t, err)
+	itemRepo.AssertExpectations(t)
+}
+
+func TestMaintenance_CheckOverdueLoans(t *testing.T) {
+	today := time.Now().Format("2006-01-02")
+
+	t.Run("正常系: 期限を過ぎた貸出をアイテム名と合わせて1通で通知", func(t *testing.T) {
+		itemRepo := new(MockItemRepository)
+		loanRepo := new(MockLoanRepository)
+		notifier := new(MockNotifier)
+		loanRepo.On("FindOverdue", mock.Anything, today).Return([]*entity.Loan{
+			{ID: 1, ItemID: 3, Borrower: "山田 太郎", DueDate: "2026-10-01"},
+			{ID: 2, ItemID: 9, Borrower: "佐藤 花子", DueDate: "2026-10-10"},
+		}, nil)
+		itemRepo.On("FindByID", mock.Anything, int64(3)).Return(&entity.Item{ID: 3, Name: "ロレックス デイトナ"}, nil)
+		itemRepo.On("FindByID", mock.Anything, int64(9)).Return(nil, domainErrors.ErrItemNotFound)
+		notifier.On("Notify", mock.Anything, entity.Notification{
+			Kind: entity.NotificationLoansOverdue,
+			Data: entity.OverdueLoansNotice{Date: today, Loans: []entity.OverdueLoan{
+				{LoanID: 1, ItemID: 3, ItemName: "ロレックス デイトナ", Borrower: "山田 太郎", DueDate: "2026-10-01"},
+				{LoanID: 2, ItemID: 9, Borrower: "佐藤 花子", DueDate: "2026-10-10"},
+			}},
+		}).Return(nil).Once()
+
+		err := NewMaintenance(itemRepo, loanRepo, notifier, time.Hour).CheckOverdueLoans(context.Background())
+
+		require.NoError(t, err)
+		notifier.AssertExpectations(t)
+	})
+
+	t.Run("正常系: 期限を過ぎた貸出がなければ通知しない", func(t *testing.T) {
+		loanRepo := new(MockLoanRepository)
+		notifier := new(MockNotifier)
+		loanRepo.On("FindOverdue", mock.Anything, today).Return([]*entity.Loan{}, nil)
+
+		err := NewMaintenance(new(MockItemRepository), loanRepo, notifier, time.Hour).CheckOverdueLoans(context.Background())
+
+		require.NoError(t, err)
+		notifier.AssertNotCalled(t, "Notify", mock.Anything, mock.Anything)
+	})
+
+	t.Run("異常系: 通知に失敗した場合はエラー", func(t *testing.T) {
+		itemRepo := new(MockItemRepository)
+		loanRepo := new(MockLoanRepository)
+		notifier := new(MockNotifier)
+		loanRepo.On("FindOverdue", mock.Anything, today).Return([]*entity.Loan{{ID: 1, ItemID: 3}}, nil)
+		itemRepo.On("FindByID", mock.Anything, int64(3)).Return(&entity.Item{ID: 3}, nil)
+		notifier.On("Notify", mock.Anything, mock.Anything).Return(errors.New("connection refused"))
+
+		err := NewMaintenance(itemRepo, loanRepo, notifier, time.Hour).CheckOverdueLoans(context.Background())
+
+		assert.EqualError(t, err, "failed to notify overdue loans: connection refused")
+	})
+}
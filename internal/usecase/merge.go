@@ -0,0 +1,85 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"Aicon-assignment/internal/domain/entity"
+	domainErrors "Aicon-assignment/internal/domain/errors"
+)
+
+// 重複アイテムの統合用の入力構造体
+type MergeItemsInput struct {
+	DuplicateIDs []int64 `json:"duplicate_ids"`
+}
+
+func (u *itemUsecase) MergeItems(ctx context.Context, survivorID int64, input MergeItemsInput) (*entity.Item, error) {
+	if survivorID <= 0 {
+		return nil, domainErrors.ErrInvalidInput
+	}
+	if len(input.DuplicateIDs) == 0 {
+		return nil, fmt.Errorf("%w: duplicate_ids is required", domainErrors.ErrInvalidInput)
+	}
+
+	seen := map[int64]bool{survivorID: true}
+	for _, id := range input.DuplicateIDs {
+		if id <= 0 {
+			return nil, fmt.Errorf("%w: duplicate_ids must be positive", domainErrors.ErrInvalidInput)
+		}
+		if seen[id] {
+			return nil, fmt.Errorf("%w: duplicate_ids must be unique and must not contain the surviving item", domainErrors.ErrInvalidInput)
+		}
+		seen[id] = true
+	}
+
+	// 貸出状況の確認から統合後の取得までを1つのトランザクションで行う
+	var merged *entity.Item
+	err := u.transactor.WithTx(ctx, func(repos Repositories) error {
+		survivor, err := repos.Items.FindByID(ctx, survivorID)
+		if err != nil {
+			if domainErrors.IsNotFoundError(err) {
+				return domainErrors.ErrItemNotFound
+			}
+			return fmt.Errorf("failed to retrieve item: %w", err)
+		}
+
+		// 貸出中のアイテムが複数あると統合後に二重貸出になってしまう
+		onLoan := 0
+		if survivor.OnLoan {
+			onLoan++
+		}
+		for _, id := range input.DuplicateIDs {
+			duplicate, err := repos.Items.FindByID(ctx, id)
+			if err != nil {
+				if domainErrors.IsNotFoundError(err) {
+					return domainErrors.ErrItemNotFound
+				}
+				return fmt.Errorf("failed to retrieve item: %w", err)
+			}
+			if duplicate.OnLoan {
+				onLoan++
+			}
+		}
+		if onLoan > 1 {
+			return domainErrors.ErrMultipleActiveLoans
+		}
+
+		if err := repos.Items.Merge(ctx, survivorID, input.DuplicateIDs); err != nil {
+			if domainErrors.IsNotFoundError(err) {
+				return domainErrors.ErrItemNotFound
+			}
+			return fmt.Errorf("failed to merge items: %w", err)
+		}
+
+		merged, err = repos.Items.FindByID(ctx, survivorID)
+		if err != nil {
+			return fmt.Errorf("failed to retrieve item: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return merged, nil
+}
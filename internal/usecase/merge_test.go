@@ -0,0 +1,89 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"Aicon-assignment/internal/domain/entity"
+	domainErrors "Aicon-assignment/internal/domain/errors"
+)
+
+func TestItemUsecase_MergeItems(t *testing.T) {
+	tests := []struct {
+		name        string
+		survivorID  int64
+		input       MergeItemsInput
+		setupMock   func(*MockItemRepository)
+		expectedErr error
+	}{
+		{
+			name:       "正常系: 重複アイテムを統合",
+			survivorID: 1,
+			input:      MergeItemsInput{DuplicateIDs: []int64{2, 3}},
+			setupMock: func(mockRepo *MockItemRepository) {
+				mockRepo.On("FindByID", mock.Anything, int64(1)).Return(&entity.Item{ID: 1}, nil)
+				mockRepo.On("FindByID", mock.Anything, int64(2)).Return(&entity.Item{ID: 2, OnLoan: true}, nil)
+				mockRepo.On("FindByID", mock.Anything, int64(3)).Return(&entity.Item{ID: 3}, nil)
+				mockRepo.On("Merge", mock.Anything, int64(1), []int64{2, 3}).Return(nil)
+			},
+		},
+		{
+			name:        "異常系: 統合対象が空",
+			survivorID:  1,
+			input:       MergeItemsInput{},
+			setupMock:   func(mockRepo *MockItemRepository) {},
+			expectedErr: domainErrors.ErrInvalidInput,
+		},
+		{
+			name:        "異常系: 統合対象に自分自身を含む",
+			survivorID:  1,
+			input:       MergeItemsInput{DuplicateIDs: []int64{1}},
+			setupMock:   func(mockRepo *MockItemRepository) {},
+			expectedErr: domainErrors.ErrInvalidInput,
+		},
+		{
+			name:       "異常系: 存在しない重複アイテム",
+			survivorID: 1,
+			input:      MergeItemsInput{DuplicateIDs: []int64{999}},
+			setupMock: func(mockRepo *MockItemRepository) {
+				mockRepo.On("FindByID", mock.Anything, int64(1)).Return(&entity.Item{ID: 1}, nil)
+				mockRepo.On("FindByID", mock.Anything, int64(999)).Return(nil, domainErrors.ErrItemNotFound)
+			},
+			expectedErr: domainErrors.ErrItemNotFound,
+		},
+		{
+			name:       "異常系: 複数のアイテムが貸出中",
+			survivorID: 1,
+			input:      MergeItemsInput{DuplicateIDs: []int64{2}},
+			setupMock: func(mockRepo *MockItemRepository) {
+				mockRepo.On("FindByID", mock.Anything, int64(1)).Return(&entity.Item{ID: 1, OnLoan: true}, nil)
+				mockRepo.On("FindByID", mock.Anything, int64(2)).Return(&entity.Item{ID: 2, OnLoan: true}, nil)
+			},
+			expectedErr: domainErrors.ErrMultipleActiveLoans,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(MockItemRepository)
+			tt.setupMock(mockRepo)
+			usecase := NewItemUsecase(mockRepo, &MockTransactor{repos: Repositories{Items: mockRepo}})
+
+			item, err := usecase.MergeItems(context.Background(), tt.survivorID, tt.input)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				assert.Nil(t, item)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.survivorID, item.ID)
+			}
+
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
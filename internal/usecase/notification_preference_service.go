@@ -0,0 +1,130 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+
+	"Aicon-assignment/internal/domain/entity"
+	domainErrors "Aicon-assignment/internal/domain/errors"
+)
+
+type NotificationPreferenceUsecase interface {
+	GetPreferences(ctx context.Context) (*entity.NotificationPreferences, error)
+	// 指定した手段・種類だけを変更し、変更後の設定を返す
+	UpdatePreferences(ctx context.Context, input UpdateNotificationPreferencesInput) (*entity.NotificationPreferences, error)
+}
+
+type UpdateNotificationPreferencesInput struct {
+	Channels map[string]bool `json:"channels"`
+	Events   map[string]bool `json:"events"`
+}
+
+type notificationPreferenceUsecase struct {
+	prefRepo NotificationPreferenceRepository
+}
+
+func NewNotificationPreferenceUsecase(prefRepo NotificationPreferenceRepository) NotificationPreferenceUsecase {
+	return &notificationPreferenceUsecase{
+		prefRepo: prefRepo,
+	}
+}
+
+func (u *notificationPreferenceUsecase) GetPreferences(ctx context.Context) (*entity.NotificationPreferences, error) {
+	return loadNotificationPreferences(ctx, u.prefRepo)
+}
+
+func (u *notificationPreferenceUsecase) UpdatePreferences(ctx context.Context, input UpdateNotificationPreferencesInput) (*entity.NotificationPreferences, error) {
+	var prefs []*entity.NotificationPreference
+	var fieldErrors entity.FieldErrors
+	for _, name := range sortedKeys(input.Channels) {
+		if !entity.IsKnownNotificationChannel(name) {
+			fieldErrors = append(fieldErrors, entity.FieldError{Field: "channels." + name, Value: input.Channels[name], Rule: "oneof", Message: fmt.Sprintf("unknown notification channel %q", name)})
+			continue
+		}
+		prefs = append(prefs, &entity.NotificationPreference{Type: entity.NotificationPreferenceChannel, Name: name, Enabled: input.Channels[name]})
+	}
+	for _, name := range sortedKeys(input.Events) {
+		if !entity.IsKnownNotificationKind(name) {
+			fieldErrors = append(fieldErrors, entity.FieldError{Field: "events." + name, Value: input.Events[name], Rule: "oneof", Message: fmt.Sprintf("unknown notification event %q", name)})
+			continue
+		}
+		prefs = append(prefs, &entity.NotificationPreference{Type: entity.NotificationPreferenceEvent, Name: name, Enabled: input.Events[name]})
+	}
+	if len(fieldErrors) > 0 {
+		return nil, fmt.Errorf("%w: %w", domainErrors.ErrInvalidInput, fieldErrors)
+	}
+
+	for _, pref := range prefs {
+		if err := u.prefRepo.Save(ctx, pref); err != nil {
+			return nil, fmt.Errorf("failed to save notification preference: %w", err)
+		}
+	}
+
+	return loadNotificationPreferences(ctx, u.prefRepo)
+}
+
+func loadNotificationPreferences(ctx context.Context, prefRepo NotificationPreferenceRepository) (*entity.NotificationPreferences, error) {
+	saved, err := prefRepo.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve notification preferences: %w", err)
+	}
+	return entity.NewNotificationPreferences(saved), nil
+}
+
+func sortedKeys(m map[string]bool) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+// 通知の設定を確かめてから、有効な手段で送る
+type NotificationDispatcher struct {
+	prefRepo NotificationPreferenceRepository
+	channels map[string]Notifier
+}
+
+// channels は手段ごとの送り先（entity.NotificationChannels の名前をキーにする）
+func NewNotificationDispatcher(prefRepo NotificationPreferenceRepository, channels map[string]Notifier) *NotificationDispatcher {
+	return &NotificationDispatcher{
+		prefRepo: prefRepo,
+		channels: channels,
+	}
+}
+
+// 種類か手段が無効にされている場合は送らない（エラーにはしない）
+// 手段のいずれかで送れなかった場合も、残りの手段では送る
+func (d *NotificationDispatcher) Notify(ctx context.Context, notification entity.Notification) error {
+	prefs, err := loadNotificationPreferences(ctx, d.prefRepo)
+	if err != nil {
+		return err
+	}
+
+	if !prefs.Events[notification.Kind] {
+		log.Printf("skipped %s notification: the event is turned off", notification.Kind)
+		return nil
+	}
+
+	var errs []error
+	sent := false
+	for _, channel := range entity.NotificationChannels {
+		notifier, ok := d.channels[channel]
+		if !ok || !prefs.Channels[channel] {
+			continue
+		}
+		if err := notifier.Notify(ctx, notification); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", channel, err))
+			continue
+		}
+		sent = true
+	}
+	if !sent && len(errs) == 0 {
+		log.Printf("skipped %s notification: every channel is turned off", notification.Kind)
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,185 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"Aicon-assignment/internal/domain/entity"
+	domainErrors "Aicon-assignment/internal/domain/errors"
+)
+
+// MockNotificationPreferenceRepository はtestify/mockを使用したモックリポジトリ
+type MockNotificationPreferenceRepository struct {
+	mock.Mock
+}
+
+func (m *MockNotificationPreferenceRepository) FindAll(ctx context.Context) ([]*entity.NotificationPreference, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.NotificationPreference), args.Error(1)
+}
+
+func (m *MockNotificationPreferenceRepository) Save(ctx context.Context, pref *entity.NotificationPreference) error {
+	args := m.Called(ctx, pref)
+	return args.Error(0)
+}
+
+func TestNotificationPreferenceUsecase_GetPreferences(t *testing.T) {
+	updatedAt := time.Date(2026, 10, 1, 9, 0, 0, 0, time.UTC)
+
+	t.Run("正常系: 保存されていないものは有効", func(t *testing.T) {
+		mockRepo := new(MockNotificationPreferenceRepository)
+		mockRepo.On("FindAll", mock.Anything).Return([]*entity.NotificationPreference(nil), nil)
+		usecase := NewNotificationPreferenceUsecase(mockRepo)
+
+		prefs, err := usecase.GetPreferences(context.Background())
+
+		require.NoError(t, err)
+		assert.Equal(t, &entity.NotificationPreferences{
+			Channels: map[string]bool{entity.NotificationChannelEmail: true},
+			Events:   map[string]bool{entity.NotificationLoansOverdue: true},
+		}, prefs)
+	})
+
+	t.Run("正常系: 保存された値を重ね、なくなった手段の設定は無視する", func(t *testing.T) {
+		mockRepo := new(MockNotificationPreferenceRepository)
+		mockRepo.On("FindAll", mock.Anything).Return([]*entity.NotificationPreference{
+			{Type: entity.NotificationPreferenceChannel, Name: entity.NotificationChannelEmail, Enabled: false, UpdatedAt: updatedAt},
+			{Type: entity.NotificationPreferenceChannel, Name: "sms", Enabled: false, UpdatedAt: updatedAt.Add(time.Hour)},
+		}, nil)
+		usecase := NewNotificationPreferenceUsecase(mockRepo)
+
+		prefs, err := usecase.GetPreferences(context.Background())
+
+		require.NoError(t, err)
+		assert.Equal(t, &entity.NotificationPreferences{
+			Channels:  map[string]bool{entity.NotificationChannelEmail: false},
+			Events:    map[string]bool{entity.NotificationLoansOverdue: true},
+			UpdatedAt: &updatedAt,
+		}, prefs)
+	})
+
+	t.Run("異常系: 取得に失敗", func(t *testing.T) {
+		mockRepo := new(MockNotificationPreferenceRepository)
+		mockRepo.On("FindAll", mock.Anything).Return(nil, domainErrors.ErrDatabaseError)
+		usecase := NewNotificationPreferenceUsecase(mockRepo)
+
+		_, err := usecase.GetPreferences(context.Background())
+
+		assert.ErrorIs(t, err, domainErrors.ErrDatabaseError)
+	})
+}
+
+func TestNotificationPreferenceUsecase_UpdatePreferences(t *testing.T) {
+	t.Run("正常系: 指定した種類だけを保存する", func(t *testing.T) {
+		mockRepo := new(MockNotificationPreferenceRepository)
+		mockRepo.On("Save", mock.Anything, &entity.NotificationPreference{Type: entity.NotificationPreferenceEvent, Name: entity.NotificationLoansOverdue, Enabled: false}).Return(nil)
+		mockRepo.On("FindAll", mock.Anything).Return([]*entity.NotificationPreference{
+			{Type: entity.NotificationPreferenceEvent, Name: entity.NotificationLoansOverdue, Enabled: false},
+		}, nil)
+		usecase := NewNotificationPreferenceUsecase(mockRepo)
+
+		prefs, err := usecase.UpdatePreferences(context.Background(), UpdateNotificationPreferencesInput{
+			Events: map[string]bool{entity.NotificationLoansOverdue: false},
+		})
+
+		require.NoError(t, err)
+		assert.True(t, prefs.Channels[entity.NotificationChannelEmail])
+		assert.False(t, prefs.Events[entity.NotificationLoansOverdue])
+		mockRepo.AssertNumberOfCalls(t, "Save", 1)
+	})
+
+	t.Run("異常系: 知らない手段と種類は保存せずにすべて返す", func(t *testing.T) {
+		mockRepo := new(MockNotificationPreferenceRepository)
+		usecase := NewNotificationPreferenceUsecase(mockRepo)
+
+		_, err := usecase.UpdatePreferences(context.Background(), UpdateNotificationPreferencesInput{
+			Channels: map[string]bool{entity.NotificationChannelEmail: false, "sms": true},
+			Events:   map[string]bool{"price_alert": true},
+		})
+
+		assert.ErrorIs(t, err, domainErrors.ErrInvalidInput)
+		var fieldErrors entity.FieldErrors
+		require.ErrorAs(t, err, &fieldErrors)
+		assert.Equal(t, []string{"channels.sms", "events.price_alert"}, []string{fieldErrors[0].Field, fieldErrors[1].Field})
+		mockRepo.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
+	})
+
+	t.Run("異常系: 保存に失敗", func(t *testing.T) {
+		mockRepo := new(MockNotificationPreferenceRepository)
+		mockRepo.On("Save", mock.Anything, mock.Anything).Return(domainErrors.ErrDatabaseError)
+		usecase := NewNotificationPreferenceUsecase(mockRepo)
+
+		_, err := usecase.UpdatePreferences(context.Background(), UpdateNotificationPreferencesInput{
+			Channels: map[string]bool{entity.NotificationChannelEmail: true},
+		})
+
+		assert.ErrorIs(t, err, domainErrors.ErrDatabaseError)
+	})
+}
+
+func TestNotificationDispatcher_Notify(t *testing.T) {
+	notification := entity.Notification{Kind: entity.NotificationLoansOverdue, Data: entity.OverdueLoansNotice{Date: "2026-10-16"}}
+
+	tests := []struct {
+		name     string
+		saved    []*entity.NotificationPreference
+		sendErr  error
+		wantSent bool
+		wantErr  bool
+	}{
+		{name: "正常系: 既定ではすべて送る", wantSent: true},
+		{
+			name:  "正常系: 種類を無効にした場合は送らない",
+			saved: []*entity.NotificationPreference{{Type: entity.NotificationPreferenceEvent, Name: entity.NotificationLoansOverdue, Enabled: false}},
+		},
+		{
+			name:  "正常系: 手段を無効にした場合はその手段では送らない",
+			saved: []*entity.NotificationPreference{{Type: entity.NotificationPreferenceChannel, Name: entity.NotificationChannelEmail, Enabled: false}},
+		},
+		{name: "異常系: 送信に失敗", sendErr: errors.New("connection refused"), wantSent: true, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(MockNotificationPreferenceRepository)
+			mockRepo.On("FindAll", mock.Anything).Return(tt.saved, nil)
+			email := new(MockNotifier)
+			email.On("Notify", mock.Anything, notification).Return(tt.sendErr)
+			dispatcher := NewNotificationDispatcher(mockRepo, map[string]Notifier{entity.NotificationChannelEmail: email})
+
+			err := dispatcher.Notify(context.Background(), notification)
+
+			if tt.wantErr {
+				assert.ErrorContains(t, err, "email: connection refused")
+			} else {
+				assert.NoError(t, err)
+			}
+			if tt.wantSent {
+				email.AssertCalled(t, "Notify", mock.Anything, notification)
+			} else {
+				email.AssertNotCalled(t, "Notify", mock.Anything, mock.Anything)
+			}
+		})
+	}
+
+	t.Run("異常系: 設定を読めない場合は送らない", func(t *testing.T) {
+		mockRepo := new(MockNotificationPreferenceRepository)
+		mockRepo.On("FindAll", mock.Anything).Return(nil, domainErrors.ErrDatabaseError)
+		email := new(MockNotifier)
+		dispatcher := NewNotificationDispatcher(mockRepo, map[string]Notifier{entity.NotificationChannelEmail: email})
+
+		err := dispatcher.Notify(context.Background(), notification)
+
+		assert.ErrorIs(t, err, domainErrors.ErrDatabaseError)
+		email.AssertNotCalled(t, "Notify", mock.Anything, mock.Anything)
+	})
+}
@@ -0,0 +1,101 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"Aicon-assignment/internal/domain/entity"
+	"Aicon-assignment/internal/domain/event"
+)
+
+const (
+	defaultOutboxPollInterval = 1 * time.Second
+	defaultOutboxBatchSize    = 100
+)
+
+// アウトボックスに記録されたイベントをイベントバスへ配信する
+// 配信後に記録を更新するまでの間に落ちると再送されるため、購読側には少なくとも1回届く
+type OutboxRelay struct {
+	outboxRepo   OutboxRepository
+	eventBus     EventBus
+	pollInterval time.Duration
+	batchSize    int
+}
+
+func NewOutboxRelay(outboxRepo OutboxRepository, eventBus EventBus) *OutboxRelay {
+	return &OutboxRelay{
+		outboxRepo:   outboxRepo,
+		eventBus:     eventBus,
+		pollInterval: defaultOutboxPollInterval,
+		batchSize:    defaultOutboxBatchSize,
+	}
+}
+
+// ctxが終わるまで未配信のイベントを定期的に配信する
+func (r *OutboxRelay) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := r.Drain(ctx); err != nil {
+			log.Printf("failed to relay outbox messages: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// 未配信のイベントがなくなるまで続けて配信する（停止時の最後の配信にも使う）
+func (r *OutboxRelay) Drain(ctx context.Context) error {
+	for {
+		relayed, err := r.RelayPending(ctx)
+		if err != nil {
+			return err
+		}
+		if relayed < r.batchSize {
+			return nil
+		}
+	}
+}
+
+// 未配信のイベントを1バッチ分配信し、取り出した件数を返す
+func (r *OutboxRelay) RelayPending(ctx context.Context) (int, error) {
+	messages, err := r.outboxRepo.FindPending(ctx, r.batchSize)
+	if err != nil {
+		return 0, fmt.Errorf("failed to retrieve outbox messages: %w", err)
+	}
+
+	for _, message := range messages {
+		e, err := restoreEvent(message)
+		if err != nil {
+			if err := r.outboxRepo.MarkFailed(ctx, message.ID, err.Error()); err != nil {
+				return 0, fmt.Errorf("failed to mark outbox message %d as failed: %w", message.ID, err)
+			}
+			continue
+		}
+
+		r.eventBus.Publish(ctx, e)
+
+		if err := r.outboxRepo.MarkPublished(ctx, message.ID); err != nil {
+			return 0, fmt.Errorf("failed to mark outbox message %d as published: %w", message.ID, err)
+		}
+	}
+
+	return len(messages), nil
+}
+
+func restoreEvent(message *entity.OutboxMessage) (event.Event, error) {
+	var item entity.Item
+	if err := json.Unmarshal([]byte(message.Payload), &item); err != nil {
+		return nil, fmt.Errorf("invalid payload: %w", err)
+	}
+
+	return event.RestoreItemEvent(message.EventType, &item, message.CreatedAt)
+}
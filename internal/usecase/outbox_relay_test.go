@@ -0,0 +1,156 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"Aicon-assignment/internal/domain/entity"
+	domainErrors "Aicon-assignment/internal/domain/errors"
+	"Aicon-assignment/internal/domain/event"
+)
+
+// MockEventBus はtestify/mockを使用したモックイベントバス
+type MockEventBus struct {
+	mock.Mock
+}
+
+func (m *MockEventBus) Publish(ctx context.Context, e event.Event) {
+	m.Called(ctx, e)
+}
+
+// アイテム以外のイベント
+type otherEvent struct{}
+
+func (otherEvent) Name() string          { return "other" }
+func (otherEvent) OccurredAt() time.Time { return time.Time{} }
+
+// MockOutboxRepository はtestify/mockを使用したモックリポジトリ
+type MockOutboxRepository struct {
+	mock.Mock
+}
+
+func (m *MockOutboxRepository) FindPending(ctx context.Context, limit int) ([]*entity.OutboxMessage, error) {
+	args := m.Called(ctx, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.OutboxMessage), args.Error(1)
+}
+
+func (m *MockOutboxRepository) MarkPublished(ctx context.Context, id int64) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockOutboxRepository) MarkFailed(ctx context.Context, id int64, reason string) error {
+	args := m.Called(ctx, id, reason)
+	return args.Error(0)
+}
+
+func (m *MockOutboxRepository) FindRecent(ctx context.Context, eventTypes []string, limit int) ([]*entity.OutboxMessage, error) {
+	args := m.Called(ctx, eventTypes, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.OutboxMessage), args.Error(1)
+}
+
+func (m *MockOutboxRepository) FindPrevious(ctx context.Context, aggregateID, beforeID int64) (*entity.OutboxMessage, error) {
+	args := m.Called(ctx, aggregateID, beforeID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.OutboxMessage), args.Error(1)
+}
+
+func TestOutboxRelay_RelayPending(t *testing.T) {
+	createdAt := time.Date(2023, 1, 15, 10, 0, 0, 0, time.UTC)
+
+	t.Run("正常系: 記録されたイベントを復元して配信済みにする", func(t *testing.T) {
+		outboxRepo := new(MockOutboxRepository)
+		eventBus := new(MockEventBus)
+		outboxRepo.On("FindPending", mock.Anything, defaultOutboxBatchSize).Return([]*entity.OutboxMessage{
+			{ID: 10, EventType: entity.EventItemCreated, AggregateID: 1, Payload: `{"id":1,"name":"ロレックス デイトナ"}`, CreatedAt: createdAt},
+		}, nil)
+		eventBus.On("Publish", mock.Anything, mock.MatchedBy(func(e event.Event) bool {
+			created, ok := e.(event.ItemCreated)
+			return ok && created.Item().ID == 1 && created.Item().Name == "ロレックス デイトナ" && created.OccurredAt().Equal(createdAt)
+		})).Once()
+		outboxRepo.On("MarkPublished", mock.Anything, int64(10)).Return(nil)
+
+		relayed, err := NewOutboxRelay(outboxRepo, eventBus).RelayPending(context.Background())
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, relayed)
+		eventBus.AssertExpectations(t)
+		outboxRepo.AssertExpectations(t)
+	})
+
+	t.Run("異常系: 復元できないイベントは配信せず失敗を記録", func(t *testing.T) {
+		outboxRepo := new(MockOutboxRepository)
+		eventBus := new(MockEventBus)
+		outboxRepo.On("FindPending", mock.Anything, defaultOutboxBatchSize).Return([]*entity.OutboxMessage{
+			{ID: 11, EventType: "item.viewed", AggregateID: 1, Payload: `{"id":1}`, CreatedAt: createdAt},
+			{ID: 12, EventType: entity.EventItemUpdated, AggregateID: 2, Payload: `not json`, CreatedAt: createdAt},
+		}, nil)
+		outboxRepo.On("MarkFailed", mock.Anything, int64(11), mock.MatchedBy(func(reason string) bool {
+			return reason == "unknown item event: item.viewed"
+		})).Return(nil)
+		outboxRepo.On("MarkFailed", mock.Anything, int64(12), mock.Anything).Return(nil)
+
+		relayed, err := NewOutboxRelay(outboxRepo, eventBus).RelayPending(context.Background())
+
+		require.NoError(t, err)
+		assert.Equal(t, 2, relayed)
+		eventBus.AssertNotCalled(t, "Publish", mock.Anything, mock.Anything)
+		outboxRepo.AssertExpectations(t)
+	})
+
+	t.Run("異常系: 読み出しに失敗した場合は配信しない", func(t *testing.T) {
+		outboxRepo := new(MockOutboxRepository)
+		eventBus := new(MockEventBus)
+		outboxRepo.On("FindPending", mock.Anything, defaultOutboxBatchSize).Return(nil, domainErrors.ErrDatabaseError)
+
+		_, err := NewOutboxRelay(outboxRepo, eventBus).RelayPending(context.Background())
+
+		assert.ErrorIs(t, err, domainErrors.ErrDatabaseError)
+		eventBus.AssertNotCalled(t, "Publish", mock.Anything, mock.Anything)
+	})
+}
+
+func TestOutboxRelay_Drain(t *testing.T) {
+	t.Run("正常系: 1バッチに満たなくなるまで続けて配信する", func(t *testing.T) {
+		outboxRepo := new(MockOutboxRepository)
+		eventBus := new(MockEventBus)
+		outboxRepo.On("FindPending", mock.Anything, 2).Return([]*entity.OutboxMessage{
+			{ID: 1, EventType: entity.EventItemCreated, AggregateID: 1, Payload: `{"id":1}`},
+			{ID: 2, EventType: entity.EventItemCreated, AggregateID: 2, Payload: `{"id":2}`},
+		}, nil).Once()
+		outboxRepo.On("FindPending", mock.Anything, 2).Return([]*entity.OutboxMessage{
+			{ID: 3, EventType: entity.EventItemDeleted, AggregateID: 3, Payload: `{"id":3}`},
+		}, nil).Once()
+		outboxRepo.On("MarkPublished", mock.Anything, mock.Anything).Return(nil).Times(3)
+		eventBus.On("Publish", mock.Anything, mock.Anything).Times(3)
+
+		relay := NewOutboxRelay(outboxRepo, eventBus)
+		relay.batchSize = 2
+
+		require.NoError(t, relay.Drain(context.Background()))
+		outboxRepo.AssertExpectations(t)
+		eventBus.AssertExpectations(t)
+	})
+
+	t.Run("異常系: 読み出しに失敗した場合はエラーを返す", func(t *testing.T) {
+		outboxRepo := new(MockOutboxRepository)
+		outboxRepo.On("FindPending", mock.Anything, defaultOutboxBatchSize).Return(nil, domainErrors.ErrDatabaseError)
+
+		err := NewOutboxRelay(outboxRepo, new(MockEventBus)).Drain(context.Background())
+
+		assert.ErrorIs(t, err, domainErrors.ErrDatabaseError)
+	})
+}
@@ -0,0 +1,129 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"Aicon-assignment/internal/domain/entity"
+	domainErrors "Aicon-assignment/internal/domain/errors"
+)
+
+// 猶予期間が過ぎた削除依頼を確かめる間隔
+const defaultErasureCheckInterval = 1 * time.Minute
+
+// 利用者のデータの書き出しと削除（利用者ごとのアカウントはないため、保存先の全データが対象）
+type PrivacyUsecase interface {
+	// バックアップと同じ内容を書き出す
+	Export(ctx context.Context) (*entity.Backup, error)
+	GetErasure(ctx context.Context) (*entity.Erasure, error)
+	// 猶予期間の後に全データを削除する（依頼中の場合はその依頼を返す）
+	RequestErasure(ctx context.Context) (*entity.Erasure, error)
+	CancelErasure(ctx context.Context) (*entity.Erasure, error)
+	// 猶予期間が過ぎた依頼を定期的に実行する
+	Run(ctx context.Context)
+}
+
+type privacyUsecase struct {
+	backupRepo    BackupRepository
+	erasureRepo   ErasureRepository
+	gracePeriod   time.Duration
+	checkInterval time.Duration
+}
+
+func NewPrivacyUsecase(backupRepo BackupRepository, erasureRepo ErasureRepository, gracePeriod time.Duration) PrivacyUsecase {
+	return &privacyUsecase{
+		backupRepo:    backupRepo,
+		erasureRepo:   erasureRepo,
+		gracePeriod:   gracePeriod,
+		checkInterval: defaultErasureCheckInterval,
+	}
+}
+
+func (u *privacyUsecase) Export(ctx context.Context) (*entity.Backup, error) {
+	backup, err := u.backupRepo.Dump(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to dump data: %w", err)
+	}
+
+	backup.Version = entity.BackupVersion
+	backup.CreatedAt = time.Now().UTC()
+	return backup, nil
+}
+
+func (u *privacyUsecase) GetErasure(ctx context.Context) (*entity.Erasure, error) {
+	return u.erasureRepo.FindPending(ctx)
+}
+
+func (u *privacyUsecase) RequestErasure(ctx context.Context) (*entity.Erasure, error) {
+	pending, err := u.erasureRepo.FindPending(ctx)
+	if err == nil {
+		return pending, nil
+	}
+	if !domainErrors.IsErasureNotFoundError(err) {
+		return nil, fmt.Errorf("failed to retrieve erasure: %w", err)
+	}
+
+	requestedAt := time.Now().UTC()
+	erasure, err := u.erasureRepo.Create(ctx, &entity.Erasure{
+		RequestedAt: requestedAt,
+		ScheduledAt: requestedAt.Add(u.gracePeriod),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create erasure: %w", err)
+	}
+	return erasure, nil
+}
+
+func (u *privacyUsecase) CancelErasure(ctx context.Context) (*entity.Erasure, error) {
+	pending, err := u.erasureRepo.FindPending(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return u.erasureRepo.Finish(ctx, pending.ID, entity.ErasureCancelled)
+}
+
+func (u *privacyUsecase) Run(ctx context.Context) {
+	ticker := time.NewTicker(u.checkInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := u.eraseDue(ctx); err != nil {
+			log.Printf("failed to erase data: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// 猶予期間が過ぎていれば、バックアップの対象に加えて、イベントや配信ログなどに残るアイテムの内容も含めて全データを削除する
+// 削除してから依頼を完了にするため、途中で落ちても次の確認でもう一度削除する
+func (u *privacyUsecase) eraseDue(ctx context.Context) error {
+	pending, err := u.erasureRepo.FindPending(ctx)
+	if err != nil {
+		if domainErrors.IsErasureNotFoundError(err) {
+			return nil
+		}
+		return err
+	}
+	if !pending.IsDue(time.Now()) {
+		return nil
+	}
+
+	if err := u.backupRepo.Erase(ctx); err != nil {
+		return fmt.Errorf("failed to erase data: %w", err)
+	}
+
+	// 他のインスタンスが先に完了にした場合も削除は済んでいる
+	if _, err := u.erasureRepo.Finish(ctx, pending.ID, entity.ErasureCompleted); err != nil && !domainErrors.IsErasureNotFoundError(err) {
+		return fmt.Errorf("failed to complete erasure: %w", err)
+	}
+	log.Printf("erased all data (erasure %d requested at %s)", pending.ID, pending.RequestedAt.Format(time.RFC3339))
+	return nil
+}
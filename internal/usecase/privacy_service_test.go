@@ -0,0 +1,137 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"Aicon-assignment/internal/domain/entity"
+	domainErrors "Aicon-assignment/internal/domain/errors"
+)
+
+// MockErasureRepository はtestify/mockを使用したモックリポジトリ
+type MockErasureRepository struct {
+	mock.Mock
+}
+
+func (m *MockErasureRepository) FindPending(ctx context.Context) (*entity.Erasure, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.Erasure), args.Error(1)
+}
+
+func (m *MockErasureRepository) Create(ctx context.Context, erasure *entity.Erasure) (*entity.Erasure, error) {
+	args := m.Called(ctx, erasure)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.Erasure), args.Error(1)
+}
+
+func (m *MockErasureRepository) Finish(ctx context.Context, id int64, status string) (*entity.Erasure, error) {
+	args := m.Called(ctx, id, status)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.Erasure), args.Error(1)
+}
+
+func TestPrivacyUsecase_RequestErasure(t *testing.T) {
+	t.Run("正常系: 猶予期間の後に削除する依頼を作る", func(t *testing.T) {
+		erasureRepo := new(MockErasureRepository)
+		erasureRepo.On("FindPending", mock.Anything).Return(nil, domainErrors.ErrErasureNotFound)
+		erasureRepo.On("Create", mock.Anything, mock.MatchedBy(func(e *entity.Erasure) bool {
+			return e.ScheduledAt.Sub(e.RequestedAt) == 72*time.Hour
+		})).Return(&entity.Erasure{ID: 1, Status: entity.ErasurePending}, nil)
+		usecase := NewPrivacyUsecase(new(MockBackupRepository), erasureRepo, 72*time.Hour)
+
+		erasure, err := usecase.RequestErasure(context.Background())
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), erasure.ID)
+		erasureRepo.AssertExpectations(t)
+	})
+
+	t.Run("正常系: 依頼中の場合は新しく作らない", func(t *testing.T) {
+		erasureRepo := new(MockErasureRepository)
+		erasureRepo.On("FindPending", mock.Anything).Return(&entity.Erasure{ID: 3, Status: entity.ErasurePending}, nil)
+		usecase := NewPrivacyUsecase(new(MockBackupRepository), erasureRepo, 72*time.Hour)
+
+		erasure, err := usecase.RequestErasure(context.Background())
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), erasure.ID)
+		erasureRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+}
+
+func TestPrivacyUsecase_CancelErasure(t *testing.T) {
+	t.Run("正常系: 依頼を取り消す", func(t *testing.T) {
+		erasureRepo := new(MockErasureRepository)
+		erasureRepo.On("FindPending", mock.Anything).Return(&entity.Erasure{ID: 3, Status: entity.ErasurePending}, nil)
+		erasureRepo.On("Finish", mock.Anything, int64(3), entity.ErasureCancelled).Return(&entity.Erasure{ID: 3, Status: entity.ErasureCancelled}, nil)
+		usecase := NewPrivacyUsecase(new(MockBackupRepository), erasureRepo, time.Hour)
+
+		erasure, err := usecase.CancelErasure(context.Background())
+
+		require.NoError(t, err)
+		assert.Equal(t, entity.ErasureCancelled, erasure.Status)
+	})
+
+	t.Run("異常系: 依頼がない", func(t *testing.T) {
+		erasureRepo := new(MockErasureRepository)
+		erasureRepo.On("FindPending", mock.Anything).Return(nil, domainErrors.ErrErasureNotFound)
+		usecase := NewPrivacyUsecase(new(MockBackupRepository), erasureRepo, time.Hour)
+
+		_, err := usecase.CancelErasure(context.Background())
+
+		assert.ErrorIs(t, err, domainErrors.ErrErasureNotFound)
+	})
+}
+
+func TestPrivacyUsecase_eraseDue(t *testing.T) {
+	t.Run("正常系: 猶予期間が過ぎていれば全データを削除して完了にする", func(t *testing.T) {
+		backupRepo := new(MockBackupRepository)
+		backupRepo.On("Erase", mock.Anything).Return(nil)
+		erasureRepo := new(MockErasureRepository)
+		erasureRepo.On("FindPending", mock.Anything).Return(&entity.Erasure{ID: 3, Status: entity.ErasurePending, ScheduledAt: time.Now().Add(-time.Minute)}, nil)
+		erasureRepo.On("Finish", mock.Anything, int64(3), entity.ErasureCompleted).Return(&entity.Erasure{ID: 3, Status: entity.ErasureCompleted}, nil)
+		usecase := NewPrivacyUsecase(backupRepo, erasureRepo, time.Hour).(*privacyUsecase)
+
+		err := usecase.eraseDue(context.Background())
+
+		require.NoError(t, err)
+		backupRepo.AssertExpectations(t)
+		erasureRepo.AssertExpectations(t)
+	})
+
+	t.Run("正常系: 猶予期間中は削除しない", func(t *testing.T) {
+		backupRepo := new(MockBackupRepository)
+		erasureRepo := new(MockErasureRepository)
+		erasureRepo.On("FindPending", mock.Anything).Return(&entity.Erasure{ID: 3, Status: entity.ErasurePending, ScheduledAt: time.Now().Add(time.Hour)}, nil)
+		usecase := NewPrivacyUsecase(backupRepo, erasureRepo, time.Hour).(*privacyUsecase)
+
+		err := usecase.eraseDue(context.Background())
+
+		require.NoError(t, err)
+		backupRepo.AssertNotCalled(t, "Erase", mock.Anything)
+	})
+
+	t.Run("正常系: 依頼がなければ何もしない", func(t *testing.T) {
+		backupRepo := new(MockBackupRepository)
+		erasureRepo := new(MockErasureRepository)
+		erasureRepo.On("FindPending", mock.Anything).Return(nil, domainErrors.ErrErasureNotFound)
+		usecase := NewPrivacyUsecase(backupRepo, erasureRepo, time.Hour).(*privacyUsecase)
+
+		err := usecase.eraseDue(context.Background())
+
+		require.NoError(t, err)
+		backupRepo.AssertNotCalled(t, "Erase", mock.Anything)
+	})
+}
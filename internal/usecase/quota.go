@@ -0,0 +1,67 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"Aicon-assignment/internal/domain/entity"
+	domainErrors "Aicon-assignment/internal/domain/errors"
+)
+
+// 利用量の上限（0の場合は上限なし）
+// 利用者ごとのアカウントはないため、保存先全体に対する上限になる
+// アーカイブしたアイテムも保存しているため数に含める
+type Quota struct {
+	MaxItems int
+}
+
+// 現在の利用量と上限
+type Usage struct {
+	Items UsageCounter `json:"items"`
+}
+
+type UsageCounter struct {
+	Used  int  `json:"used"`
+	Limit *int `json:"limit"` // 上限なしの場合はnull
+}
+
+func newUsageCounter(used, limit int) UsageCounter {
+	counter := UsageCounter{Used: used}
+	if limit > 0 {
+		counter.Limit = &limit
+	}
+	return counter
+}
+
+// アイテムをもう1件登録できるか（count は全アイテムの数を返す）
+// 登録と同じトランザクションでは ItemRepository.CountLocked で数え、同時に登録しても上限を超えないようにする
+func (q Quota) ensureItemAvailable(ctx context.Context, count func(ctx context.Context) (int, error)) error {
+	if q.MaxItems == 0 {
+		return nil
+	}
+
+	used, err := count(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to count items: %w", err)
+	}
+	if used >= q.MaxItems {
+		return fmt.Errorf("%w: items %d/%d", domainErrors.ErrQuotaExceeded, used, q.MaxItems)
+	}
+	return nil
+}
+
+// 全アイテムの数（アーカイブしたものを含む）をロックを取らずに数える
+func countAllItems(itemRepo ItemRepository) func(ctx context.Context) (int, error) {
+	return func(ctx context.Context) (int, error) {
+		return itemRepo.Count(ctx, entity.ItemFilter{IncludeArchived: true})
+	}
+}
+
+func (u *itemUsecase) GetUsage(ctx context.Context) (*Usage, error) {
+	count, err := u.readRepo.Count(ctx, entity.ItemFilter{IncludeArchived: true})
+	if err != nil {
+		return nil, fmt.Errorf("failed to count items: %w", err)
+	}
+
+	return &Usage{Items: newUsageCounter(count, u.quota.MaxItems)}, nil
+}
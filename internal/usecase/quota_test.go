@@ -0,0 +1,87 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"Aicon-assignment/internal/domain/entity"
+	domainErrors "Aicon-assignment/internal/domain/errors"
+)
+
+func TestItemUsecase_CreateItem_Quota(t *testing.T) {
+	input := CreateItemInput{
+		Name:          "ロレックス デイトナ",
+		Category:      "時計",
+		Brand:         "ROLEX",
+		PurchasePrice: 1500000,
+		PurchaseDate:  "2023-01-15",
+		SerialNumber:  "D123456",
+	}
+
+	t.Run("正常系: 上限未満なら登録する", func(t *testing.T) {
+		mockRepo := new(MockItemRepository)
+		mockRepo.On("FindBrands", mock.Anything).Return([]string{}, nil)
+		mockRepo.On("CountLocked", mock.Anything).Return(2, nil)
+		mockRepo.On("FindBySerialNumber", mock.Anything, "D123456").Return(nil, domainErrors.ErrItemNotFound)
+		mockRepo.On("Create", mock.Anything, mock.AnythingOfType("*entity.Item")).Return(&entity.Item{ID: 3}, nil)
+		usecase := NewItemUsecaseWithQuota(mockRepo, mockRepo, &MockTransactor{repos: Repositories{Items: mockRepo}}, Quota{MaxItems: 3})
+
+		item, err := usecase.CreateItem(context.Background(), input)
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(3), item.ID)
+	})
+
+	t.Run("異常系: 上限に達している", func(t *testing.T) {
+		mockRepo := new(MockItemRepository)
+		mockRepo.On("FindBrands", mock.Anything).Return([]string{}, nil)
+		mockRepo.On("CountLocked", mock.Anything).Return(3, nil)
+		usecase := NewItemUsecaseWithQuota(mockRepo, mockRepo, &MockTransactor{repos: Repositories{Items: mockRepo}}, Quota{MaxItems: 3})
+
+		_, err := usecase.CreateItem(context.Background(), input)
+
+		assert.ErrorIs(t, err, domainErrors.ErrQuotaExceeded)
+		mockRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("正常系: 上限なしの場合は数えない", func(t *testing.T) {
+		mockRepo := new(MockItemRepository)
+		mockRepo.On("FindBrands", mock.Anything).Return([]string{}, nil)
+		mockRepo.On("FindBySerialNumber", mock.Anything, "D123456").Return(nil, domainErrors.ErrItemNotFound)
+		mockRepo.On("Create", mock.Anything, mock.AnythingOfType("*entity.Item")).Return(&entity.Item{ID: 1}, nil)
+		usecase := NewItemUsecase(mockRepo, &MockTransactor{repos: Repositories{Items: mockRepo}})
+
+		_, err := usecase.CreateItem(context.Background(), input)
+
+		assert.NoError(t, err)
+		mockRepo.AssertNotCalled(t, "CountLocked", mock.Anything)
+	})
+}
+
+func TestItemUsecase_GetUsage(t *testing.T) {
+	tests := []struct {
+		name      string
+		quota     Quota
+		wantLimit *int
+	}{
+		{name: "正常系: 上限あり", quota: Quota{MaxItems: 100}, wantLimit: func() *int { v := 100; return &v }()},
+		{name: "正常系: 上限なしはnull", quota: Quota{}, wantLimit: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(MockItemRepository)
+			mockRepo.On("Count", mock.Anything, entity.ItemFilter{IncludeArchived: true}).Return(42, nil)
+			usecase := NewItemUsecaseWithQuota(mockRepo, mockRepo, &MockTransactor{repos: Repositories{Items: mockRepo}}, tt.quota)
+
+			usage, err := usecase.GetUsage(context.Background())
+
+			assert.NoError(t, err)
+			assert.Equal(t, 42, usage.Items.Used)
+			assert.Equal(t, tt.wantLimit, usage.Items.Limit)
+		})
+	}
+}
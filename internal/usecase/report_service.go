@@ -0,0 +1,75 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"Aicon-assignment/internal/domain/entity"
+	domainErrors "Aicon-assignment/internal/domain/errors"
+)
+
+type ReportUsecase interface {
+	// year に取得・売却したアイテムの明細（税理士に渡す申告用の資料）
+	GetTaxReport(ctx context.Context, year int) (*entity.TaxReport, error)
+	// 保険契約ごとの補償対象のアイテム（policyNumber を指定した場合はその証券番号の契約だけ）
+	GetInsuranceSchedule(ctx context.Context, policyNumber string) (*entity.InsuranceSchedule, error)
+}
+
+type reportUsecase struct {
+	itemRepo      ItemRepository
+	saleRepo      ItemSaleRepository
+	insuranceRepo ItemInsuranceRepository
+}
+
+func NewReportUsecase(itemRepo ItemRepository, saleRepo ItemSaleRepository, insuranceRepo ItemInsuranceRepository) ReportUsecase {
+	return &reportUsecase{
+		itemRepo:      itemRepo,
+		saleRepo:      saleRepo,
+		insuranceRepo: insuranceRepo,
+	}
+}
+
+// 取得はアーカイブしたアイテムも含める（売却したアイテムはアーカイブされるため）
+func (u *reportUsecase) GetTaxReport(ctx context.Context, year int) (*entity.TaxReport, error) {
+	if err := entity.ValidateTaxReportYear(year); err != nil {
+		return nil, fmt.Errorf("%w: %w", domainErrors.ErrInvalidInput, err)
+	}
+
+	items, err := u.itemRepo.FindAll(ctx, entity.ItemFilter{IncludeArchived: true})
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve items: %w", err)
+	}
+
+	sales, err := u.saleRepo.FindSoldBetween(ctx, fmt.Sprintf("%04d-01-01", year), fmt.Sprintf("%04d-12-31", year))
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve sales: %w", err)
+	}
+
+	return entity.NewTaxReport(year, items, sales), nil
+}
+
+// 手元にないアーカイブしたアイテムは補償の対象外として含めない
+func (u *reportUsecase) GetInsuranceSchedule(ctx context.Context, policyNumber string) (*entity.InsuranceSchedule, error) {
+	items, err := u.itemRepo.FindAll(ctx, entity.ItemFilter{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve items: %w", err)
+	}
+
+	insurances, err := u.insuranceRepo.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve insurances: %w", err)
+	}
+
+	if policyNumber = strings.TrimSpace(policyNumber); policyNumber != "" {
+		matched := []*entity.ItemInsurance{}
+		for _, insurance := range insurances {
+			if insurance.PolicyNumber == policyNumber {
+				matched = append(matched, insurance)
+			}
+		}
+		insurances = matched
+	}
+
+	return entity.NewInsuranceSchedule(items, insurances), nil
+}
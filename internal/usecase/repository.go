@@ -2,27 +2,353 @@ package usecase
 
 import (
 	"context"
+	"time"
 
 	"Aicon-assignment/internal/domain/entity"
 )
 
 // ItemRepository defines the interface for item data access
 type ItemRepository interface {
-	// FindAll retrieves all items
-	FindAll(ctx context.Context) ([]*entity.Item, error)
+	// FindAll retrieves all items matching the filter
+	FindAll(ctx context.Context, filter entity.ItemFilter) ([]*entity.Item, error)
+
+	// Count returns the number of items matching the filter
+	Count(ctx context.Context, filter entity.ItemFilter) (int, error)
+
+	// CountLocked counts every item, archived ones included, after taking the item quota lock,
+	// which other transactions calling it wait on until this one ends.
+	// Call it inside Transactor.WithTx before Create so that concurrent creates cannot both pass a quota check.
+	CountLocked(ctx context.Context) (int, error)
 
 	// FindByID retrieves an item by ID
 	FindByID(ctx context.Context, id int64) (*entity.Item, error)
 
+	// FindBySerialNumber retrieves an item by serial number
+	FindBySerialNumber(ctx context.Context, serialNumber string) (*entity.Item, error)
+
 	// Create creates a new item and returns it with the generated ID
 	Create(ctx context.Context, item *entity.Item) (*entity.Item, error)
 
 	// 無かったのでついか
+	// Update increments the version and returns ErrVersionConflict if item.Version no longer matches the stored one
 	Update(ctx context.Context, item *entity.Item) error
 
 	// Delete deletes an item by ID
 	Delete(ctx context.Context, id int64) error
 
+	// Merge reassigns the history of duplicate items to the surviving item and soft-deletes the duplicates in one transaction
+	Merge(ctx context.Context, survivorID int64, duplicateIDs []int64) error
+
+	// PurgeDeleted permanently deletes items that were soft-deleted before the given time and returns how many were deleted
+	PurgeDeleted(ctx context.Context, before time.Time) (int, error)
+
+	// ReassignCategory moves every item in one category to another in one transaction and records a single audit entry.
+	// It returns the IDs of the moved items (none if the category has no items, in which case nothing is recorded)
+	ReassignCategory(ctx context.Context, from, to string) ([]int64, error)
+
+	// FindBrands retrieves the distinct brands of all items including archived ones, in order
+	FindBrands(ctx context.Context) ([]string, error)
+
+	// Suggest returns distinct brands and then distinct names (at most limit of each) of listed items
+	// that start with prefix or have a word that does, ignoring case
+	Suggest(ctx context.Context, prefix string, limit int) ([]entity.ItemSuggestion, error)
+
 	// GetSummaryByCategory returns item counts grouped by category (bonus feature)
 	GetSummaryByCategory(ctx context.Context) (map[string]int, error)
+
+	// GetSummaryByCondition returns item counts grouped by condition grade
+	GetSummaryByCondition(ctx context.Context) (map[string]int, error)
+}
+
+// LoanRepository defines the interface for loan data access
+type LoanRepository interface {
+	// FindByID retrieves a loan by ID
+	FindByID(ctx context.Context, id int64) (*entity.Loan, error)
+
+	// FindActiveByItemID retrieves the loan of an item that has not been returned yet
+	FindActiveByItemID(ctx context.Context, itemID int64) (*entity.Loan, error)
+
+	// FindOverdue retrieves unreturned loans whose due date is before the given date (YYYY-MM-DD)
+	FindOverdue(ctx context.Context, today string) ([]*entity.Loan, error)
+
+	// Create creates a new loan and returns it with the generated ID
+	Create(ctx context.Context, loan *entity.Loan) (*entity.Loan, error)
+
+	// Update updates an existing loan
+	Update(ctx context.Context, loan *entity.Loan) error
+}
+
+// LocationRepository defines the interface for storage location data access
+type LocationRepository interface {
+	// FindAll retrieves all locations
+	FindAll(ctx context.Context) ([]*entity.Location, error)
+
+	// FindByID retrieves a location by ID
+	FindByID(ctx context.Context, id int64) (*entity.Location, error)
+
+	// Create creates a new location and returns it with the generated ID
+	Create(ctx context.Context, location *entity.Location) (*entity.Location, error)
+
+	// Update updates an existing location
+	Update(ctx context.Context, location *entity.Location) error
+
+	// Delete deletes a location by ID
+	Delete(ctx context.Context, id int64) error
+
+	// CreateMove records a location change of an item
+	CreateMove(ctx context.Context, move *entity.LocationMove) (*entity.LocationMove, error)
+
+	// FindMovesByItemID retrieves the location change history of an item
+	FindMovesByItemID(ctx context.Context, itemID int64) ([]*entity.LocationMove, error)
+}
+
+// ItemTemplateRepository defines the interface for item template data access
+type ItemTemplateRepository interface {
+	// FindAll retrieves all templates
+	FindAll(ctx context.Context) ([]*entity.ItemTemplate, error)
+
+	// FindByID retrieves a template by ID
+	FindByID(ctx context.Context, id int64) (*entity.ItemTemplate, error)
+
+	// Create creates a new template and returns it with the generated ID
+	Create(ctx context.Context, template *entity.ItemTemplate) (*entity.ItemTemplate, error)
+
+	// Delete deletes a template by ID
+	Delete(ctx context.Context, id int64) error
+}
+
+// WebhookRepository defines the interface for webhook data access
+type WebhookRepository interface {
+	// FindAll retrieves all registered webhooks
+	FindAll(ctx context.Context) ([]*entity.Webhook, error)
+
+	// FindByID retrieves a webhook by ID
+	FindByID(ctx context.Context, id int64) (*entity.Webhook, error)
+
+	// Create creates a new webhook and returns it with the generated ID
+	Create(ctx context.Context, webhook *entity.Webhook) (*entity.Webhook, error)
+
+	// Delete deletes a webhook and its delivery log by ID
+	Delete(ctx context.Context, id int64) error
+
+	// CreateDelivery records a delivery attempt
+	CreateDelivery(ctx context.Context, delivery *entity.WebhookDelivery) (*entity.WebhookDelivery, error)
+
+	// FindDeliveriesByWebhookID retrieves the delivery log of a webhook, newest first
+	FindDeliveriesByWebhookID(ctx context.Context, webhookID int64) ([]*entity.WebhookDelivery, error)
+}
+
+// FeatureFlagRepository defines the interface for feature flags saved by administrators
+type FeatureFlagRepository interface {
+	// FindAll retrieves every saved flag
+	FindAll(ctx context.Context) ([]*entity.FeatureFlag, error)
+
+	// Save creates or replaces the flag with the same name and returns it with the update timestamp
+	Save(ctx context.Context, flag *entity.FeatureFlag) (*entity.FeatureFlag, error)
+
+	// Delete deletes a saved flag by name (it is not an error if there is none)
+	Delete(ctx context.Context, name string) error
+}
+
+// NotificationPreferenceRepository defines the interface for the saved notification preferences
+type NotificationPreferenceRepository interface {
+	// FindAll retrieves every saved preference
+	FindAll(ctx context.Context) ([]*entity.NotificationPreference, error)
+
+	// Save creates or replaces the preference with the same type and name
+	Save(ctx context.Context, pref *entity.NotificationPreference) error
+}
+
+// ShareLinkRepository defines the interface for public links to individual items
+type ShareLinkRepository interface {
+	// FindByItemID retrieves every link of an item, including expired and revoked ones, newest first
+	FindByItemID(ctx context.Context, itemID int64) ([]*entity.ShareLink, error)
+
+	// FindByTokenHash retrieves a link by the hash of its token (ErrShareLinkNotFound if there is none)
+	FindByTokenHash(ctx context.Context, tokenHash string) (*entity.ShareLink, error)
+
+	// Create creates a new link and returns it with the generated ID
+	Create(ctx context.Context, link *entity.ShareLink) (*entity.ShareLink, error)
+
+	// Revoke marks an unrevoked link of the item as revoked (ErrShareLinkNotFound if the item has no such link or it is already revoked)
+	Revoke(ctx context.Context, itemID, id int64) error
+}
+
+// ItemCommentRepository defines the interface for comments on items
+type ItemCommentRepository interface {
+	// FindByItemID retrieves every comment of an item, oldest first
+	FindByItemID(ctx context.Context, itemID int64) ([]*entity.ItemComment, error)
+
+	// Create creates a new comment and returns it with the generated ID
+	Create(ctx context.Context, comment *entity.ItemComment) (*entity.ItemComment, error)
+
+	// Delete deletes a comment of the item (ErrCommentNotFound if the item has no such comment)
+	Delete(ctx context.Context, itemID, id int64) error
+}
+
+// ItemRelationRepository defines the interface for typed links between items
+type ItemRelationRepository interface {
+	// FindByItemID retrieves every relation the item is on either side of, oldest first
+	FindByItemID(ctx context.Context, itemID int64) ([]*entity.ItemRelation, error)
+
+	// Create creates a new relation and returns it with the generated ID
+	Create(ctx context.Context, relation *entity.ItemRelation) (*entity.ItemRelation, error)
+
+	// Delete deletes a relation the item is on either side of (ErrRelationNotFound otherwise)
+	Delete(ctx context.Context, itemID, id int64) error
+}
+
+// ItemValuationRepository defines the interface for the history of changes to an item's current value
+type ItemValuationRepository interface {
+	// FindByItemID retrieves every valuation of an item, oldest first
+	FindByItemID(ctx context.Context, itemID int64) ([]*entity.ItemValuation, error)
+
+	// Create records a valuation and returns it with the generated ID
+	Create(ctx context.Context, valuation *entity.ItemValuation) (*entity.ItemValuation, error)
+}
+
+// ItemSaleRepository defines the interface for records of items that were sold
+type ItemSaleRepository interface {
+	// FindByItemID retrieves every sale of an item, oldest first (more than one only after merging sold items)
+	FindByItemID(ctx context.Context, itemID int64) ([]*entity.ItemSale, error)
+
+	// FindSoldBetween retrieves the sales whose sold_on is between from and to (YYYY-MM-DD, inclusive), ordered by sold_on and ID
+	FindSoldBetween(ctx context.Context, from, to string) ([]*entity.ItemSale, error)
+
+	// Create records a sale and an item.sold event with the current item in the outbox in one transaction, and returns the sale with the generated ID
+	Create(ctx context.Context, sale *entity.ItemSale) (*entity.ItemSale, error)
+
+	// DeleteByItemID deletes every sale of an item (ErrSaleNotFound if there is none)
+	DeleteByItemID(ctx context.Context, itemID int64) error
+}
+
+// ItemInsuranceRepository defines the interface for the insurance policy that covers each item
+type ItemInsuranceRepository interface {
+	// FindByItemID retrieves the insurance of an item (ErrInsuranceNotFound if none is set)
+	FindByItemID(ctx context.Context, itemID int64) (*entity.ItemInsurance, error)
+
+	// FindAll retrieves the insurance of every item, ordered by item ID
+	FindAll(ctx context.Context) ([]*entity.ItemInsurance, error)
+
+	// Save creates or replaces the insurance of an item (ErrItemNotFound if the item does not exist)
+	Save(ctx context.Context, insurance *entity.ItemInsurance) (*entity.ItemInsurance, error)
+
+	// DeleteByItemID deletes the insurance of an item (ErrInsuranceNotFound if none is set)
+	DeleteByItemID(ctx context.Context, itemID int64) error
+}
+
+// ItemViewRepository defines the interface for the items each viewer has recently looked at
+type ItemViewRepository interface {
+	// Save records a view, replacing the previous view of the same item by the same viewer,
+	// and keeps only the newest keep views of the viewer (ErrItemNotFound if the item does not exist)
+	Save(ctx context.Context, view *entity.ItemView, keep int) error
+
+	// FindRecentByViewer retrieves at most limit views of a viewer, most recent first
+	FindRecentByViewer(ctx context.Context, viewer string, limit int) ([]*entity.ItemView, error)
+}
+
+// ItemFavoriteRepository defines the interface for the items each viewer has marked as a favorite
+// Favorites are stored apart from the items, so changing them never updates an item or its version
+type ItemFavoriteRepository interface {
+	// Add marks an item as a favorite of the viewer; adding it again does nothing (ErrItemNotFound if the item does not exist)
+	Add(ctx context.Context, favorite *entity.ItemFavorite) error
+
+	// Remove unmarks an item for the viewer; removing an item that is not a favorite does nothing
+	Remove(ctx context.Context, viewer string, itemID int64) error
+}
+
+// AuditLogRepository defines the interface for reading the audit log of bulk changes
+type AuditLogRepository interface {
+	// FindRecent retrieves at most limit entries, newest first
+	FindRecent(ctx context.Context, limit int) ([]*entity.AuditEntry, error)
+}
+
+// ErasureRepository defines the interface for requests to erase all data
+type ErasureRepository interface {
+	// FindPending retrieves the request that has been neither cancelled nor completed (ErrErasureNotFound if there is none)
+	FindPending(ctx context.Context) (*entity.Erasure, error)
+
+	// Create creates a new pending request and returns it with the generated ID
+	Create(ctx context.Context, erasure *entity.Erasure) (*entity.Erasure, error)
+
+	// Finish moves a pending request to the given status and records when it happened (ErrErasureNotFound if it is no longer pending)
+	Finish(ctx context.Context, id int64, status string) (*entity.Erasure, error)
+}
+
+// OutboxRepository defines the interface for the transactional outbox of domain events
+type OutboxRepository interface {
+	// FindPending retrieves undelivered messages in the order they were recorded
+	FindPending(ctx context.Context, limit int) ([]*entity.OutboxMessage, error)
+
+	// MarkPublished marks a message as delivered
+	MarkPublished(ctx context.Context, id int64) error
+
+	// MarkFailed records a failed delivery attempt
+	MarkFailed(ctx context.Context, id int64, reason string) error
+
+	// FindRecent retrieves up to limit messages of the given event types, delivered or not, newest first
+	FindRecent(ctx context.Context, eventTypes []string, limit int) ([]*entity.OutboxMessage, error)
+
+	// FindPrevious retrieves the latest message for the same aggregate recorded before beforeID (nil if there is none)
+	FindPrevious(ctx context.Context, aggregateID, beforeID int64) (*entity.OutboxMessage, error)
+}
+
+// JobRepository defines the interface for the persistent queue of background jobs
+type JobRepository interface {
+	// Create adds a pending job and returns it with the generated ID
+	Create(ctx context.Context, job *entity.Job) (*entity.Job, error)
+
+	// FindByID retrieves a job (ErrJobNotFound if it does not exist)
+	FindByID(ctx context.Context, id int64) (*entity.Job, error)
+
+	// FindByStatus retrieves up to limit jobs in any of the statuses, newest first
+	FindByStatus(ctx context.Context, statuses []string, limit int) ([]*entity.Job, error)
+
+	// Claim marks up to limit due jobs as running until lockedUntil, counts the attempt and returns them.
+	// Due jobs are pending jobs whose run_at has passed and running jobs whose lock has expired because their worker stopped.
+	// A job claimed by another worker at the same time is skipped.
+	Claim(ctx context.Context, now, lockedUntil time.Time, limit int) ([]*entity.Job, error)
+
+	// Complete marks a running job as succeeded
+	Complete(ctx context.Context, id int64) error
+
+	// Fail records a failed attempt. The job runs again at retryAt, or is marked as failed if retryAt is nil
+	Fail(ctx context.Context, id int64, reason string, retryAt *time.Time) error
+
+	// Retry moves a failed job back to pending with its attempts reset (ErrJobNotFound, or ErrJobNotFailed if it has not failed)
+	Retry(ctx context.Context, id int64) (*entity.Job, error)
+
+	// DeleteSucceeded deletes jobs that succeeded before the given time and returns how many were deleted
+	DeleteSucceeded(ctx context.Context, before time.Time) (int, error)
+}
+
+// BackupRepository defines the interface for dumping and restoring items and their related tables
+type BackupRepository interface {
+	// Dump reads every location, active item, loan, location move and template, keeping their IDs
+	Dump(ctx context.Context) (*entity.Backup, error)
+
+	// Restore loads a backup with its IDs in one transaction and rebuilds the summaries.
+	// It fails with ErrRestoreNotEmpty if any of the tables has rows, unless replace is true, in which case the existing rows are deleted first.
+	Restore(ctx context.Context, backup *entity.Backup, replace bool) error
+
+	// Erase deletes in one transaction everything Restore would replace, soft-deleted items included, and every other copy of item data:
+	// the outbox, webhook deliveries and delivery jobs, share links, item views and the audit log.
+	// Webhook settings, notification preferences, feature flags and erasure requests are kept.
+	Erase(ctx context.Context) error
+}
+
+// Repositories groups the repositories that share one transaction
+type Repositories struct {
+	Items      ItemRepository
+	Loans      LoanRepository
+	Locations  LocationRepository
+	Valuations ItemValuationRepository
+	Sales      ItemSaleRepository
+}
+
+// Transactor runs several repository calls as one unit of work
+type Transactor interface {
+	// WithTx calls fn with repositories bound to a single transaction, committing when fn returns nil and rolling back otherwise.
+	// Calls made on other repositories inside fn are not part of the transaction.
+	WithTx(ctx context.Context, fn func(repos Repositories) error) error
 }
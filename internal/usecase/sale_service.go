@@ -0,0 +1,105 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"Aicon-assignment/internal/domain/entity"
+	domainErrors "Aicon-assignment/internal/domain/errors"
+)
+
+type ItemSaleUsecase interface {
+	// 売却を記録してアイテムをアーカイブする（既に記録がある場合は ErrItemAlreadySold）
+	RecordSale(ctx context.Context, itemID int64, input RecordSaleInput) (*entity.ItemSale, error)
+	// 誤って記録した売却を取り消す（アーカイブは戻さない）
+	CancelSale(ctx context.Context, itemID int64) error
+}
+
+type RecordSaleInput struct {
+	SoldOn    string `json:"sold_on"`
+	SalePrice int    `json:"sale_price"`
+	Fees      int    `json:"fees"`
+}
+
+type itemSaleUsecase struct {
+	saleRepo   ItemSaleRepository
+	transactor Transactor
+}
+
+func NewItemSaleUsecase(saleRepo ItemSaleRepository, transactor Transactor) ItemSaleUsecase {
+	return &itemSaleUsecase{
+		saleRepo:   saleRepo,
+		transactor: transactor,
+	}
+}
+
+// 記録の有無の確認・アーカイブ・記録（item.sold イベントを含む）を1つのトランザクションで行う
+func (u *itemSaleUsecase) RecordSale(ctx context.Context, itemID int64, input RecordSaleInput) (*entity.ItemSale, error) {
+	if itemID <= 0 {
+		return nil, domainErrors.ErrInvalidInput
+	}
+
+	var created *entity.ItemSale
+	err := u.transactor.WithTx(ctx, func(repos Repositories) error {
+		item, err := repos.Items.FindByID(ctx, itemID)
+		if err != nil {
+			if domainErrors.IsNotFoundError(err) {
+				return domainErrors.ErrItemNotFound
+			}
+			return fmt.Errorf("failed to retrieve item: %w", err)
+		}
+
+		sales, err := repos.Sales.FindByItemID(ctx, itemID)
+		if err != nil {
+			return fmt.Errorf("failed to check sales: %w", err)
+		}
+		if len(sales) > 0 {
+			return domainErrors.ErrItemAlreadySold
+		}
+
+		sale, err := entity.NewItemSale(item, input.SoldOn, input.SalePrice, input.Fees)
+		if err != nil {
+			var fieldErrors entity.FieldErrors
+			if errors.As(err, &fieldErrors) {
+				return fmt.Errorf("%w: %w", domainErrors.ErrInvalidInput, err)
+			}
+			return err
+		}
+
+		// 手元にないアイテムを一覧と集計から外す
+		if !item.IsArchived() {
+			item.Archive()
+			if err := repos.Items.Update(ctx, item); err != nil {
+				return fmt.Errorf("failed to archive item: %w", err)
+			}
+		}
+
+		// item.sold イベントにはアーカイブした後のアイテムを載せる
+		created, err = repos.Sales.Create(ctx, sale)
+		if err != nil {
+			return fmt.Errorf("failed to record sale: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+func (u *itemSaleUsecase) CancelSale(ctx context.Context, itemID int64) error {
+	if itemID <= 0 {
+		return domainErrors.ErrInvalidInput
+	}
+
+	if err := u.saleRepo.DeleteByItemID(ctx, itemID); err != nil {
+		if domainErrors.IsSaleNotFoundError(err) {
+			return domainErrors.ErrSaleNotFound
+		}
+		return fmt.Errorf("failed to cancel sale: %w", err)
+	}
+
+	return nil
+}
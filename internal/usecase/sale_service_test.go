@@ -0,0 +1,177 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"Aicon-assignment/internal/domain/entity"
+	domainErrors "Aicon-assignment/internal/domain/errors"
+)
+
+// MockItemSaleRepository はtestify/mockを使用したモックリポジトリ
+type MockItemSaleRepository struct {
+	mock.Mock
+}
+
+func (m *MockItemSaleRepository) FindByItemID(ctx context.Context, itemID int64) ([]*entity.ItemSale, error) {
+	args := m.Called(ctx, itemID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.ItemSale), args.Error(1)
+}
+
+func (m *MockItemSaleRepository) FindSoldBetween(ctx context.Context, from, to string) ([]*entity.ItemSale, error) {
+	args := m.Called(ctx, from, to)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.ItemSale), args.Error(1)
+}
+
+func (m *MockItemSaleRepository) Create(ctx context.Context, sale *entity.ItemSale) (*entity.ItemSale, error) {
+	args := m.Called(ctx, sale)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.ItemSale), args.Error(1)
+}
+
+func (m *MockItemSaleRepository) DeleteByItemID(ctx context.Context, itemID int64) error {
+	args := m.Called(ctx, itemID)
+	return args.Error(0)
+}
+
+func TestItemSaleUsecase_RecordSale(t *testing.T) {
+	item := func() *entity.Item {
+		return &entity.Item{ID: 1, Name: "ロレックス デイトナ", PurchasePrice: 1500000, PurchaseDate: "2023-01-15"}
+	}
+
+	t.Run("正常系: 購入時の値を残して記録し、アイテムをアーカイブする", func(t *testing.T) {
+		itemRepo := new(MockItemRepository)
+		itemRepo.On("FindByID", mock.Anything, int64(1)).Return(item(), nil)
+		var archived *entity.Item
+		itemRepo.On("Update", mock.Anything, mock.AnythingOfType("*entity.Item")).
+			Run(func(args mock.Arguments) {
+				archived = args.Get(1).(*entity.Item)
+			}).
+			Return(nil)
+		saleRepo := new(MockItemSaleRepository)
+		saleRepo.On("FindByItemID", mock.Anything, int64(1)).Return([]*entity.ItemSale{}, nil)
+		var saved *entity.ItemSale
+		saleRepo.On("Create", mock.Anything, mock.AnythingOfType("*entity.ItemSale")).
+			Run(func(args mock.Arguments) {
+				saved = args.Get(1).(*entity.ItemSale)
+			}).
+			Return(&entity.ItemSale{ID: 5, ItemID: 1}, nil)
+
+		usecase := NewItemSaleUsecase(saleRepo, &MockTransactor{repos: Repositories{Items: itemRepo, Sales: saleRepo}})
+		got, err := usecase.RecordSale(context.Background(), 1, RecordSaleInput{SoldOn: "2024-08-01", SalePrice: 2100000, Fees: 63000})
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(5), got.ID)
+		require.NotNil(t, saved)
+		assert.Equal(t, "ロレックス デイトナ", saved.ItemName)
+		assert.Equal(t, 1500000, saved.PurchasePrice)
+		assert.Equal(t, 537000, saved.Gain())
+		require.NotNil(t, archived)
+		assert.True(t, archived.IsArchived())
+	})
+
+	t.Run("異常系: 既に売却を記録している", func(t *testing.T) {
+		itemRepo := new(MockItemRepository)
+		itemRepo.On("FindByID", mock.Anything, int64(1)).Return(item(), nil)
+		saleRepo := new(MockItemSaleRepository)
+		saleRepo.On("FindByItemID", mock.Anything, int64(1)).Return([]*entity.ItemSale{{ID: 5, ItemID: 1}}, nil)
+
+		usecase := NewItemSaleUsecase(saleRepo, &MockTransactor{repos: Repositories{Items: itemRepo, Sales: saleRepo}})
+		_, err := usecase.RecordSale(context.Background(), 1, RecordSaleInput{SoldOn: "2024-08-01", SalePrice: 2100000})
+
+		assert.ErrorIs(t, err, domainErrors.ErrItemAlreadySold)
+		saleRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+		itemRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+
+	t.Run("異常系: 購入日より前の売却日", func(t *testing.T) {
+		itemRepo := new(MockItemRepository)
+		itemRepo.On("FindByID", mock.Anything, int64(1)).Return(item(), nil)
+		saleRepo := new(MockItemSaleRepository)
+		saleRepo.On("FindByItemID", mock.Anything, int64(1)).Return([]*entity.ItemSale{}, nil)
+
+		usecase := NewItemSaleUsecase(saleRepo, &MockTransactor{repos: Repositories{Items: itemRepo, Sales: saleRepo}})
+		_, err := usecase.RecordSale(context.Background(), 1, RecordSaleInput{SoldOn: "2022-12-31", SalePrice: 2100000})
+
+		assert.ErrorIs(t, err, domainErrors.ErrInvalidInput)
+		saleRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("異常系: 存在しないアイテム", func(t *testing.T) {
+		itemRepo := new(MockItemRepository)
+		itemRepo.On("FindByID", mock.Anything, int64(999)).Return(nil, domainErrors.ErrItemNotFound)
+
+		usecase := NewItemSaleUsecase(new(MockItemSaleRepository), &MockTransactor{repos: Repositories{Items: itemRepo}})
+		_, err := usecase.RecordSale(context.Background(), 999, RecordSaleInput{SoldOn: "2024-08-01"})
+
+		assert.ErrorIs(t, err, domainErrors.ErrItemNotFound)
+	})
+}
+
+func TestItemSaleUsecase_CancelSale(t *testing.T) {
+	t.Run("正常系: 記録を削除する", func(t *testing.T) {
+		saleRepo := new(MockItemSaleRepository)
+		saleRepo.On("DeleteByItemID", mock.Anything, int64(1)).Return(nil)
+
+		usecase := NewItemSaleUsecase(saleRepo, &MockTransactor{})
+
+		assert.NoError(t, usecase.CancelSale(context.Background(), 1))
+		saleRepo.AssertExpectations(t)
+	})
+
+	t.Run("異常系: 売却の記録がない", func(t *testing.T) {
+		saleRepo := new(MockItemSaleRepository)
+		saleRepo.On("DeleteByItemID", mock.Anything, int64(1)).Return(domainErrors.ErrSaleNotFound)
+
+		usecase := NewItemSaleUsecase(saleRepo, &MockTransactor{})
+
+		assert.ErrorIs(t, usecase.CancelSale(context.Background(), 1), domainErrors.ErrSaleNotFound)
+	})
+}
+
+func TestReportUsecase_GetTaxReport(t *testing.T) {
+	t.Run("正常系: その年の取得と売却を集計する", func(t *testing.T) {
+		itemRepo := new(MockItemRepository)
+		itemRepo.On("FindAll", mock.Anything, entity.ItemFilter{IncludeArchived: true}).Return([]*entity.Item{
+			{ID: 2, Name: "バーキン", PurchasePrice: 2000000, PurchaseDate: "2024-06-01"},
+			{ID: 1, Name: "ロレックス デイトナ", PurchasePrice: 1500000, PurchaseDate: "2023-01-15"},
+			{ID: 3, Name: "カルティエ タンク", PurchasePrice: 400000, PurchaseDate: "2024-02-10"},
+		}, nil)
+		saleRepo := new(MockItemSaleRepository)
+		saleRepo.On("FindSoldBetween", mock.Anything, "2024-01-01", "2024-12-31").Return([]*entity.ItemSale{
+			{ItemID: 1, ItemName: "ロレックス デイトナ", PurchaseDate: "2023-01-15", PurchasePrice: 1500000, SoldOn: "2024-08-01", SalePrice: 2100000, Fees: 63000},
+			{ItemID: 3, ItemName: "カルティエ タンク", PurchaseDate: "2024-02-10", PurchasePrice: 400000, SoldOn: "2024-03-01", SalePrice: 350000, Fees: 10000},
+		}, nil)
+
+		usecase := NewReportUsecase(itemRepo, saleRepo, new(MockItemInsuranceRepository))
+		report, err := usecase.GetTaxReport(context.Background(), 2024)
+
+		require.NoError(t, err)
+		require.Len(t, report.Acquisitions, 2)
+		assert.Equal(t, int64(3), report.Acquisitions[0].ItemID)
+		assert.Equal(t, int64(2), report.Acquisitions[1].ItemID)
+		require.Len(t, report.Disposals, 2)
+		assert.Equal(t, int64(3), report.Disposals[0].ItemID)
+		assert.Equal(t, -60000, report.Disposals[0].Gain)
+		assert.Equal(t, entity.TaxReportTotals{Acquired: 2400000, Cost: 1900000, SalePrice: 2450000, Fees: 73000, Gain: 477000}, report.Totals)
+	})
+
+	t.Run("異常系: 範囲外の年", func(t *testing.T) {
+		usecase := NewReportUsecase(new(MockItemRepository), new(MockItemSaleRepository), new(MockItemInsuranceRepository))
+		_, err := usecase.GetTaxReport(context.Background(), 10000)
+
+		assert.ErrorIs(t, err, domainErrors.ErrInvalidInput)
+	})
+}
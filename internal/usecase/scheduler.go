@@ -0,0 +1,155 @@
+package usecase
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"Aicon-assignment/internal/domain/entity"
+)
+
+type SchedulerUsecase interface {
+	// 登録した順にタスクの設定と実行の状態を返す
+	GetTasks(ctx context.Context) []*entity.ScheduledTask
+}
+
+// 登録したタスクをcron形式の時刻に実行する
+// 前の実行が終わっていないタスクは、重ねて実行せずにその回を見送る
+type Scheduler struct {
+	mu    sync.Mutex
+	tasks []*scheduledTask
+
+	// テストで時刻を進めるために差し替える
+	now func() time.Time
+}
+
+type scheduledTask struct {
+	status   entity.ScheduledTask
+	schedule entity.Schedule
+	run      func(ctx context.Context) error
+}
+
+func NewScheduler() *Scheduler {
+	return &Scheduler{now: time.Now}
+}
+
+// タスクを登録する（Run の前に登録する）
+func (s *Scheduler) Add(name string, schedule entity.Schedule, enabled bool, run func(ctx context.Context) error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	task := &scheduledTask{
+		status:   entity.ScheduledTask{Name: name, Schedule: schedule.String(), Enabled: enabled},
+		schedule: schedule,
+		run:      run,
+	}
+	if enabled {
+		task.setNext(s.now())
+	}
+	s.tasks = append(s.tasks, task)
+}
+
+func (s *Scheduler) GetTasks(ctx context.Context) []*entity.ScheduledTask {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tasks := make([]*entity.ScheduledTask, 0, len(s.tasks))
+	for _, task := range s.tasks {
+		status := task.status
+		tasks = append(tasks, &status)
+	}
+	return tasks
+}
+
+// ctxが終わるまでタスクを実行し、終わったら実行中のタスクを待つ
+func (s *Scheduler) Run(ctx context.Context) {
+	var running sync.WaitGroup
+	defer running.Wait()
+
+	for {
+		next, ok := s.nextRunAt()
+		if !ok {
+			// 有効なタスクがない
+			<-ctx.Done()
+			return
+		}
+
+		timer := time.NewTimer(time.Until(next))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+
+		s.startDue(ctx, &running)
+	}
+}
+
+func (s *Scheduler) nextRunAt() (time.Time, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var next time.Time
+	for _, task := range s.tasks {
+		if at := task.status.NextRunAt; at != nil && (next.IsZero() || at.Before(next)) {
+			next = *at
+		}
+	}
+	return next, !next.IsZero()
+}
+
+// 時刻になったタスクを別のgoroutineで実行する
+func (s *Scheduler) startDue(ctx context.Context, running *sync.WaitGroup) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	for _, task := range s.tasks {
+		if task.status.NextRunAt == nil || task.status.NextRunAt.After(now) {
+			continue
+		}
+		task.setNext(now)
+
+		if task.status.Running {
+			task.status.SkippedRuns++
+			log.Printf("skipped scheduled task %s: the previous run has not finished", task.status.Name)
+			continue
+		}
+
+		startedAt := now
+		task.status.Running = true
+		task.status.LastStartedAt = &startedAt
+
+		running.Add(1)
+		go func(task *scheduledTask) {
+			defer running.Done()
+			err := task.run(ctx)
+			s.finish(task, err)
+		}(task)
+	}
+}
+
+func (s *Scheduler) finish(task *scheduledTask, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	finishedAt := s.now()
+	task.status.Running = false
+	task.status.LastFinishedAt = &finishedAt
+	task.status.LastStatus = entity.TaskRunSucceeded
+	task.status.LastError = ""
+	if err != nil {
+		task.status.LastStatus = entity.TaskRunFailed
+		task.status.LastError = err.Error()
+		log.Printf("scheduled task %s failed: %v", task.status.Name, err)
+	}
+}
+
+func (t *scheduledTask) setNext(now time.Time) {
+	t.status.NextRunAt = nil
+	if next := t.schedule.Next(now); !next.IsZero() {
+		t.status.NextRunAt = &next
+	}
+}
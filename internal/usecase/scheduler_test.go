@@ -0,0 +1,103 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"Aicon-assignment/internal/domain/entity"
+)
+
+func TestScheduler_startDue(t *testing.T) {
+	start := time.Date(2026, 10, 16, 2, 59, 30, 0, time.UTC)
+	everyNight, err := entity.ParseSchedule("0 3 * * *")
+	require.NoError(t, err)
+
+	// 時刻を進められるスケジューラー
+	newScheduler := func() (*Scheduler, *time.Time) {
+		now := start
+		scheduler := NewScheduler()
+		scheduler.now = func() time.Time { return now }
+		return scheduler, &now
+	}
+
+	t.Run("正常系: 時刻になったタスクを実行して結果を記録", func(t *testing.T) {
+		scheduler, now := newScheduler()
+		runs := 0
+		scheduler.Add(entity.TaskPurgeTrash, everyNight, true, func(ctx context.Context) error {
+			runs++
+			return nil
+		})
+		scheduler.Add(entity.TaskPurgeJobs, everyNight, true, func(ctx context.Context) error {
+			return errors.New("database is down")
+		})
+
+		var running sync.WaitGroup
+		scheduler.startDue(context.Background(), &running)
+		running.Wait()
+		assert.Equal(t, 0, runs)
+
+		*now = time.Date(2026, 10, 16, 3, 0, 0, 0, time.UTC)
+		scheduler.startDue(context.Background(), &running)
+		running.Wait()
+
+		tasks := scheduler.GetTasks(context.Background())
+		require.Len(t, tasks, 2)
+		assert.Equal(t, 1, runs)
+		assert.Equal(t, entity.TaskRunSucceeded, tasks[0].LastStatus)
+		assert.Equal(t, *now, *tasks[0].LastStartedAt)
+		assert.Equal(t, time.Date(2026, 10, 17, 3, 0, 0, 0, time.UTC), *tasks[0].NextRunAt)
+		assert.False(t, tasks[0].Running)
+		assert.Equal(t, entity.TaskRunFailed, tasks[1].LastStatus)
+		assert.Equal(t, "database is down", tasks[1].LastError)
+	})
+
+	t.Run("正常系: 前の実行が終わっていない場合は重ねて実行しない", func(t *testing.T) {
+		scheduler, now := newScheduler()
+		release := make(chan struct{})
+		runs := 0
+		scheduler.Add(entity.TaskPurgeTrash, everyNight, true, func(ctx context.Context) error {
+			runs++
+			<-release
+			return nil
+		})
+
+		var running sync.WaitGroup
+		*now = time.Date(2026, 10, 16, 3, 0, 0, 0, time.UTC)
+		scheduler.startDue(context.Background(), &running)
+		*now = time.Date(2026, 10, 17, 3, 0, 0, 0, time.UTC)
+		scheduler.startDue(context.Background(), &running)
+
+		tasks := scheduler.GetTasks(context.Background())
+		assert.True(t, tasks[0].Running)
+		assert.Equal(t, 1, tasks[0].SkippedRuns)
+
+		close(release)
+		running.Wait()
+		assert.Equal(t, 1, runs)
+		assert.False(t, scheduler.GetTasks(context.Background())[0].Running)
+	})
+
+	t.Run("正常系: 無効なタスクは実行しない", func(t *testing.T) {
+		scheduler, now := newScheduler()
+		scheduler.Add(entity.TaskPurgeTrash, everyNight, false, func(ctx context.Context) error {
+			t.Error("disabled task must not run")
+			return nil
+		})
+
+		var running sync.WaitGroup
+		*now = time.Date(2026, 10, 16, 3, 0, 0, 0, time.UTC)
+		scheduler.startDue(context.Background(), &running)
+		running.Wait()
+
+		task := scheduler.GetTasks(context.Background())[0]
+		assert.False(t, task.Enabled)
+		assert.Nil(t, task.NextRunAt)
+		assert.Nil(t, task.LastStartedAt)
+	})
+}
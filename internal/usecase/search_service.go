@@ -0,0 +1,316 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"html"
+	"log"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"Aicon-assignment/internal/domain/entity"
+	domainErrors "Aicon-assignment/internal/domain/errors"
+	"Aicon-assignment/internal/domain/event"
+)
+
+// 再構築で1回に書き込むアイテムの数
+const reindexBatchSize = 500
+
+type ItemSearchUsecase interface {
+	// 検索インデックスがあれば関連度の順に、なければデータベースの部分一致で探す
+	Search(ctx context.Context, query entity.ItemSearchQuery) (*entity.ItemSearchResult, error)
+	// 入力中の語で始まる名前・ブランドを候補として返す
+	Suggest(ctx context.Context, prefix string) ([]entity.ItemSuggestion, error)
+	// イベントバスの購読者（アイテムの変更を検索インデックスに反映する）
+	HandleEvent(ctx context.Context, e event.Event)
+	// 全アイテムを検索インデックスに書き込み直し、書き込んだ件数を返す
+	Reindex(ctx context.Context) (int, error)
+}
+
+// アイテムの全文検索のインデックス（OpenSearchなど）
+// Search の結果はアイテムのIDだけを持ち、内容はデータベースから読み直す
+type ItemSearchIndex interface {
+	Search(ctx context.Context, query entity.ItemSearchQuery) (*entity.ItemSearchResult, error)
+	Index(ctx context.Context, items ...*entity.Item) error
+	Remove(ctx context.Context, id int64) error
+	// before より前に書き込まれたまま更新されていない文書を消す
+	RemoveStale(ctx context.Context, before time.Time) error
+}
+
+type itemSearchUsecase struct {
+	itemRepo ItemRepository
+	index    ItemSearchIndex // nilの場合はデータベースで検索する
+}
+
+func NewItemSearchUsecase(itemRepo ItemRepository, index ItemSearchIndex) ItemSearchUsecase {
+	return &itemSearchUsecase{
+		itemRepo: itemRepo,
+		index:    index,
+	}
+}
+
+func (u *itemSearchUsecase) Search(ctx context.Context, query entity.ItemSearchQuery) (*entity.ItemSearchResult, error) {
+	if err := query.Validate(); err != nil {
+		var fieldErrors entity.FieldErrors
+		if errors.As(err, &fieldErrors) {
+			return nil, fmt.Errorf("%w: %w", domainErrors.ErrInvalidInput, err)
+		}
+		return nil, err
+	}
+
+	if u.index == nil {
+		return u.searchDatabase(ctx, query)
+	}
+
+	// 検索インデックスに接続できない間も検索できるよう、データベースで代わりに探す
+	result, err := u.index.Search(ctx, query)
+	if err != nil {
+		log.Printf("failed to search index, falling back to database: %v", err)
+		return u.searchDatabase(ctx, query)
+	}
+
+	hits := make([]*entity.ItemSearchHit, 0, len(result.Hits))
+	for _, hit := range result.Hits {
+		item, err := u.itemRepo.FindByID(ctx, hit.ItemID)
+		if err != nil {
+			// 削除がまだインデックスに反映されていない
+			if domainErrors.IsNotFoundError(err) {
+				continue
+			}
+			return nil, fmt.Errorf("failed to retrieve item %d: %w", hit.ItemID, err)
+		}
+		hit.Item = item
+		hits = append(hits, hit)
+	}
+	result.Hits = hits
+	result.Engine = entity.SearchEngineIndex
+	return result, nil
+}
+
+// 部分一致で探したアイテムを、どのフィールドに検索語を含むかで順位付けする
+// 件数が多い場合は全件を読むため遅くなる（大きなカタログでは検索インデックスを使う）
+func (u *itemSearchUsecase) searchDatabase(ctx context.Context, query entity.ItemSearchQuery) (*entity.ItemSearchResult, error) {
+	keywords := query.Keywords()
+	items, err := u.itemRepo.FindAll(ctx, entity.ItemFilter{Keywords: keywords})
+	if err != nil {
+		return nil, fmt.Errorf("failed to search items: %w", err)
+	}
+
+	categories := make(map[string]int)
+	brands := make(map[string]int)
+	hits := make([]*entity.ItemSearchHit, 0)
+	for _, item := range items {
+		categories[item.Category]++
+		brands[item.Brand]++
+
+		if (query.Category != "" && item.Category != query.Category) || (query.Brand != "" && item.Brand != query.Brand) {
+			continue
+		}
+		hits = append(hits, &entity.ItemSearchHit{
+			ItemID:     item.ID,
+			Item:       item,
+			Score:      relevance(item, keywords),
+			Highlights: highlightItem(item, keywords),
+		})
+	}
+
+	// 同じ点数の場合は一覧と同じく新しい順
+	sort.SliceStable(hits, func(i, j int) bool { return hits[i].Score > hits[j].Score })
+
+	result := &entity.ItemSearchResult{
+		Total:  len(hits),
+		Engine: entity.SearchEngineDatabase,
+		Facets: entity.ItemSearchFacets{
+			Categories: facetCounts(categories),
+			Brands:     facetCounts(brands),
+		},
+	}
+	start := min(query.Offset, len(hits))
+	end := min(start+query.Limit, len(hits))
+	result.Hits = hits[start:end]
+	return result, nil
+}
+
+// 名前に含む語を最も重く数え、名前の先頭に一致する場合はさらに加える
+func relevance(item *entity.Item, keywords []string) float64 {
+	var score float64
+	name := strings.ToLower(item.Name)
+	for _, keyword := range keywords {
+		if strings.Contains(name, keyword) {
+			score += 3
+		}
+		if strings.HasPrefix(name, keyword) {
+			score++
+		}
+		if strings.Contains(strings.ToLower(item.Brand), keyword) {
+			score += 2
+		}
+		if strings.Contains(strings.ToLower(item.Category), keyword) {
+			score++
+		}
+		if strings.Contains(strings.ToLower(item.SerialNumber), keyword) {
+			score++
+		}
+	}
+	return score
+}
+
+func highlightItem(item *entity.Item, keywords []string) map[string][]string {
+	highlights := make(map[string][]string)
+	for field, value := range map[string]string{
+		"name":          item.Name,
+		"brand":         item.Brand,
+		"serial_number": item.SerialNumber,
+	} {
+		if fragment, ok := highlight(value, keywords); ok {
+			highlights[field] = []string{fragment}
+		}
+	}
+	return highlights
+}
+
+// 検索語に一致した部分を <em> で囲む（それ以外はHTMLエスケープする）
+// 大文字・小文字を区別せずに比べるため、文字ごとに小文字にして位置を揃える
+func highlight(text string, keywords []string) (string, bool) {
+	runes := []rune(text)
+	lower := make([]rune, len(runes))
+	for i, r := range runes {
+		lower[i] = unicode.ToLower(r)
+	}
+
+	matched := make([]bool, len(runes))
+	found := false
+	for _, keyword := range keywords {
+		k := []rune(keyword)
+		for i := 0; i+len(k) <= len(lower); i++ {
+			if string(lower[i:i+len(k)]) == keyword {
+				for j := i; j < i+len(k); j++ {
+					matched[j] = true
+				}
+				found = true
+			}
+		}
+	}
+	if !found {
+		return "", false
+	}
+
+	var b strings.Builder
+	for i := 0; i < len(runes); {
+		j := i
+		for j < len(runes) && matched[j] == matched[i] {
+			j++
+		}
+		segment := html.EscapeString(string(runes[i:j]))
+		if matched[i] {
+			segment = "<em>" + segment + "</em>"
+		}
+		b.WriteString(segment)
+		i = j
+	}
+	return b.String(), true
+}
+
+// 件数の多い順（同じ件数は値の順）
+func facetCounts(counts map[string]int) []entity.FacetCount {
+	facets := make([]entity.FacetCount, 0, len(counts))
+	for value, count := range counts {
+		facets = append(facets, entity.FacetCount{Value: value, Count: count})
+	}
+	sort.Slice(facets, func(i, j int) bool {
+		if facets[i].Count != facets[j].Count {
+			return facets[i].Count > facets[j].Count
+		}
+		return facets[i].Value < facets[j].Value
+	})
+	return facets
+}
+
+// ブランドを先にし、大文字・小文字だけが違う候補は先に見つかった方にまとめる
+func (u *itemSearchUsecase) Suggest(ctx context.Context, prefix string) ([]entity.ItemSuggestion, error) {
+	prefix = strings.TrimSpace(prefix)
+	switch {
+	case prefix == "":
+		return nil, fmt.Errorf("%w: %w", domainErrors.ErrInvalidInput, entity.FieldErrors{
+			{Field: "q", Value: prefix, Rule: "required", Message: "q is required"},
+		})
+	case utf8.RuneCountInString(prefix) > entity.MaxSearchQueryChars:
+		return nil, fmt.Errorf("%w: %w", domainErrors.ErrInvalidInput, entity.FieldErrors{
+			{Field: "q", Value: prefix, Rule: "max", Message: "q must be 200 characters or less"},
+		})
+	}
+
+	candidates, err := u.itemRepo.Suggest(ctx, prefix, entity.MaxSuggestions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve suggestions: %w", err)
+	}
+
+	suggestions := make([]entity.ItemSuggestion, 0, entity.MaxSuggestions)
+	seen := make(map[string]bool)
+	for _, candidate := range candidates {
+		key := strings.ToLower(candidate.Value)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		suggestions = append(suggestions, candidate)
+		if len(suggestions) == entity.MaxSuggestions {
+			break
+		}
+	}
+	return suggestions, nil
+}
+
+// 書き込みに失敗した変更は次の変更か再構築まで反映されない
+func (u *itemSearchUsecase) HandleEvent(ctx context.Context, e event.Event) {
+	itemEvent, ok := e.(event.ItemEvent)
+	if !ok || u.index == nil {
+		return
+	}
+	item := itemEvent.Item()
+
+	// リクエストが終わってもキャンセルされないようにする
+	ctx = context.WithoutCancel(ctx)
+
+	var err error
+	if _, deleted := e.(event.ItemDeleted); deleted {
+		err = u.index.Remove(ctx, item.ID)
+	} else {
+		err = u.index.Index(ctx, item)
+	}
+	if err != nil {
+		log.Printf("failed to apply %s of item %d to search index: %v", e.Name(), item.ID, err)
+	}
+}
+
+// カテゴリーの一括変更や復元など、アイテムごとのイベントを送らない変更の後に使う
+// アーカイブしたアイテムも書き込み（検索時に除く）、書き込み直さなかった文書は削除済みとして消す
+func (u *itemSearchUsecase) Reindex(ctx context.Context) (int, error) {
+	if u.index == nil {
+		return 0, errors.New("search index is not configured")
+	}
+
+	// 文書の書き込み時刻はミリ秒で保存されるため、切り捨てて比べる
+	startedAt := time.Now().Truncate(time.Millisecond)
+
+	items, err := u.itemRepo.FindAll(ctx, entity.ItemFilter{IncludeArchived: true})
+	if err != nil {
+		return 0, fmt.Errorf("failed to retrieve items: %w", err)
+	}
+
+	for start := 0; start < len(items); start += reindexBatchSize {
+		if err := u.index.Index(ctx, items[start:min(start+reindexBatchSize, len(items))]...); err != nil {
+			return 0, fmt.Errorf("failed to index items: %w", err)
+		}
+	}
+
+	if err := u.index.RemoveStale(ctx, startedAt); err != nil {
+		return 0, fmt.Errorf("failed to remove stale documents: %w", err)
+	}
+
+	return len(items), nil
+}
@@ -0,0 +1,206 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"Aicon-assignment/internal/domain/entity"
+	domainErrors "Aicon-assignment/internal/domain/errors"
+	"Aicon-assignment/internal/domain/event"
+)
+
+// MockItemSearchIndex はtestify/mockを使用した検索インデックスのモック
+type MockItemSearchIndex struct {
+	mock.Mock
+}
+
+func (m *MockItemSearchIndex) Search(ctx context.Context, query entity.ItemSearchQuery) (*entity.ItemSearchResult, error) {
+	args := m.Called(ctx, query)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.ItemSearchResult), args.Error(1)
+}
+
+func (m *MockItemSearchIndex) Index(ctx context.Context, items ...*entity.Item) error {
+	args := m.Called(ctx, items)
+	return args.Error(0)
+}
+
+func (m *MockItemSearchIndex) Remove(ctx context.Context, id int64) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockItemSearchIndex) RemoveStale(ctx context.Context, before time.Time) error {
+	args := m.Called(ctx, before)
+	return args.Error(0)
+}
+
+func TestItemSearchUsecase_Search(t *testing.T) {
+	items := []*entity.Item{
+		{ID: 3, Name: "オメガ スピードマスター", Category: "時計", Brand: "OMEGA"},
+		{ID: 2, Name: "ROLEX デイトナ", Category: "時計", Brand: "ROLEX", SerialNumber: "R<1>"},
+		{ID: 1, Name: "エルメス バーキン", Category: "バッグ", Brand: "HERMES"},
+	}
+
+	t.Run("正常系: インデックスがない場合はデータベースで探して順位付けする", func(t *testing.T) {
+		itemRepo := new(MockItemRepository)
+		itemRepo.On("FindAll", mock.Anything, entity.ItemFilter{Keywords: []string{"r"}}).Return(items, nil)
+
+		usecase := NewItemSearchUsecase(itemRepo, nil)
+		got, err := usecase.Search(context.Background(), entity.ItemSearchQuery{Query: "R", Limit: 2})
+
+		require.NoError(t, err)
+		assert.Equal(t, entity.SearchEngineDatabase, got.Engine)
+		assert.Equal(t, 3, got.Total)
+		require.Len(t, got.Hits, 2)
+		// 名前の先頭に一致するものが最も上になる
+		assert.Equal(t, int64(2), got.Hits[0].Item.ID)
+		assert.Equal(t, []string{"<em>R</em>OLEX デイトナ"}, got.Hits[0].Highlights["name"])
+		assert.Equal(t, []string{"<em>R</em>&lt;1&gt;"}, got.Hits[0].Highlights["serial_number"])
+		assert.Equal(t, []entity.FacetCount{{Value: "時計", Count: 2}, {Value: "バッグ", Count: 1}}, got.Facets.Categories)
+	})
+
+	t.Run("正常系: カテゴリーで絞り込んでもファセットは絞り込む前の件数", func(t *testing.T) {
+		itemRepo := new(MockItemRepository)
+		itemRepo.On("FindAll", mock.Anything, entity.ItemFilter{Keywords: []string{"r"}}).Return(items, nil)
+
+		usecase := NewItemSearchUsecase(itemRepo, nil)
+		got, err := usecase.Search(context.Background(), entity.ItemSearchQuery{Query: "r", Category: "バッグ", Limit: 20})
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, got.Total)
+		require.Len(t, got.Hits, 1)
+		assert.Equal(t, int64(1), got.Hits[0].Item.ID)
+		assert.Len(t, got.Facets.Brands, 3)
+	})
+
+	t.Run("正常系: インデックスの結果をデータベースから読み直し、削除済みは除く", func(t *testing.T) {
+		query := entity.ItemSearchQuery{Query: "デイトナ", Limit: 20}
+		index := new(MockItemSearchIndex)
+		index.On("Search", mock.Anything, query).Return(&entity.ItemSearchResult{
+			Total: 2,
+			Hits: []*entity.ItemSearchHit{
+				{ItemID: 2, Score: 1.5, Highlights: map[string][]string{"name": {"ROLEX <em>デイトナ</em>"}}},
+				{ItemID: 9, Score: 0.5},
+			},
+		}, nil)
+		itemRepo := new(MockItemRepository)
+		itemRepo.On("FindByID", mock.Anything, int64(2)).Return(items[1], nil)
+		itemRepo.On("FindByID", mock.Anything, int64(9)).Return(nil, domainErrors.ErrItemNotFound)
+
+		usecase := NewItemSearchUsecase(itemRepo, index)
+		got, err := usecase.Search(context.Background(), query)
+
+		require.NoError(t, err)
+		assert.Equal(t, entity.SearchEngineIndex, got.Engine)
+		require.Len(t, got.Hits, 1)
+		assert.Equal(t, items[1], got.Hits[0].Item)
+		itemRepo.AssertNotCalled(t, "FindAll", mock.Anything, mock.Anything)
+	})
+
+	t.Run("正常系: インデックスに接続できない場合はデータベースで探す", func(t *testing.T) {
+		query := entity.ItemSearchQuery{Query: "バーキン", Limit: 20}
+		index := new(MockItemSearchIndex)
+		index.On("Search", mock.Anything, query).Return(nil, errors.New("connection refused"))
+		itemRepo := new(MockItemRepository)
+		itemRepo.On("FindAll", mock.Anything, entity.ItemFilter{Keywords: []string{"バーキン"}}).Return(items[2:], nil)
+
+		usecase := NewItemSearchUsecase(itemRepo, index)
+		got, err := usecase.Search(context.Background(), query)
+
+		require.NoError(t, err)
+		assert.Equal(t, entity.SearchEngineDatabase, got.Engine)
+		assert.Equal(t, 1, got.Total)
+	})
+
+	t.Run("異常系: 入力の誤り", func(t *testing.T) {
+		tests := []struct {
+			name  string
+			query entity.ItemSearchQuery
+		}{
+			{"検索語がない", entity.ItemSearchQuery{Query: "  ", Limit: 20}},
+			{"件数が上限を超える", entity.ItemSearchQuery{Query: "rolex", Limit: 101}},
+			{"負のオフセット", entity.ItemSearchQuery{Query: "rolex", Limit: 20, Offset: -1}},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				itemRepo := new(MockItemRepository)
+				usecase := NewItemSearchUsecase(itemRepo, nil)
+
+				_, err := usecase.Search(context.Background(), tt.query)
+
+				assert.True(t, domainErrors.IsValidationError(err))
+				itemRepo.AssertNotCalled(t, "FindAll", mock.Anything, mock.Anything)
+			})
+		}
+	})
+}
+
+func TestItemSearchUsecase_HandleEvent(t *testing.T) {
+	item := &entity.Item{ID: 1, Name: "ロレックス デイトナ"}
+	index := new(MockItemSearchIndex)
+	index.On("Index", mock.Anything, []*entity.Item{item}).Return(nil)
+	index.On("Remove", mock.Anything, int64(1)).Return(nil)
+
+	usecase := NewItemSearchUsecase(new(MockItemRepository), index)
+	usecase.HandleEvent(context.Background(), event.NewItemUpdated(item))
+	usecase.HandleEvent(context.Background(), event.NewItemDeleted(item))
+
+	index.AssertNumberOfCalls(t, "Index", 1)
+	index.AssertNumberOfCalls(t, "Remove", 1)
+}
+
+func TestItemSearchUsecase_Reindex(t *testing.T) {
+	items := []*entity.Item{{ID: 1}, {ID: 2}}
+	itemRepo := new(MockItemRepository)
+	itemRepo.On("FindAll", mock.Anything, entity.ItemFilter{IncludeArchived: true}).Return(items, nil)
+	index := new(MockItemSearchIndex)
+	index.On("Index", mock.Anything, items).Return(nil)
+	index.On("RemoveStale", mock.Anything, mock.AnythingOfType("time.Time")).Return(nil)
+
+	usecase := NewItemSearchUsecase(itemRepo, index)
+	indexed, err := usecase.Reindex(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, 2, indexed)
+	index.AssertExpectations(t)
+}
+
+func TestItemSearchUsecase_Suggest(t *testing.T) {
+	t.Run("正常系: 大文字・小文字だけが違う候補をまとめる", func(t *testing.T) {
+		itemRepo := new(MockItemRepository)
+		itemRepo.On("Suggest", mock.Anything, "dayt", entity.MaxSuggestions).Return([]entity.ItemSuggestion{
+			{Value: "Daytona", Field: entity.SuggestionFieldBrand},
+			{Value: "DAYTONA", Field: entity.SuggestionFieldName},
+			{Value: "ROLEX Daytona 116500", Field: entity.SuggestionFieldName},
+		}, nil)
+
+		usecase := NewItemSearchUsecase(itemRepo, nil)
+		got, err := usecase.Suggest(context.Background(), " dayt ")
+
+		require.NoError(t, err)
+		assert.Equal(t, []entity.ItemSuggestion{
+			{Value: "Daytona", Field: entity.SuggestionFieldBrand},
+			{Value: "ROLEX Daytona 116500", Field: entity.SuggestionFieldName},
+		}, got)
+	})
+
+	t.Run("異常系: 入力中の語がない", func(t *testing.T) {
+		itemRepo := new(MockItemRepository)
+		usecase := NewItemSearchUsecase(itemRepo, nil)
+
+		_, err := usecase.Suggest(context.Background(), " ")
+
+		assert.True(t, domainErrors.IsValidationError(err))
+		itemRepo.AssertNotCalled(t, "Suggest", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
@@ -3,6 +3,7 @@ package usecase
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"Aicon-assignment/internal/domain/entity"
 	domainErrors "Aicon-assignment/internal/domain/errors"
@@ -10,45 +11,89 @@ import (
 
 // 部分更新用の入力構造体
 type UpdateItemInput struct {
-	Name          *string `json:"name,omitempty"`
-	Brand         *string `json:"brand,omitempty"`
-	PurchasePrice *int    `json:"purchase_price,omitempty"`
+	Name          *string           `json:"name,omitempty"`
+	Brand         *string           `json:"brand,omitempty"`
+	PurchasePrice *int              `json:"purchase_price,omitempty"`
+	Condition     *string           `json:"condition,omitempty"`
+	SerialNumber  *string           `json:"serial_number,omitempty"`
+	Attributes    map[string]string `json:"attributes,omitempty"` // 指定した場合は属性を丸ごと置き換える
+	Version       *int              `json:"version,omitempty"`    // 指定した場合は取得したときの版と違えば更新しない
 }
 
 type ItemUsecase interface {
-	GetAllItems(ctx context.Context) ([]*entity.Item, error)
+	GetAllItems(ctx context.Context, filter entity.ItemFilter) ([]*entity.Item, error)
+	CountItems(ctx context.Context, filter entity.ItemFilter) (int, error)
 	GetItemByID(ctx context.Context, id int64) (*entity.Item, error)
+	GetItemBySerialNumber(ctx context.Context, serialNumber string) (*entity.Item, error)
 	CreateItem(ctx context.Context, input CreateItemInput) (*entity.Item, error)
+	// 登録と同じ確認だけを行い、保存せずに登録される内容を返す（ID・日時は保存時に決まる）
+	ValidateItem(ctx context.Context, input CreateItemInput) (*entity.Item, error)
 	PartialUpdateItem(ctx context.Context, id int64, input UpdateItemInput) (*entity.Item, error) // 追加した
 	DeleteItem(ctx context.Context, id int64) error
 	GetCategorySummary(ctx context.Context) (*CategorySummary, error)
+	FindDuplicateItems(ctx context.Context) ([]*DuplicateGroup, error)
+	MergeItems(ctx context.Context, survivorID int64, input MergeItemsInput) (*entity.Item, error)
+	CloneItem(ctx context.Context, id int64, input CloneItemInput) (*entity.Item, error)
+	ArchiveItem(ctx context.Context, id int64) (*entity.Item, error)
+	UnarchiveItem(ctx context.Context, id int64) (*entity.Item, error)
+	GetUsage(ctx context.Context) (*Usage, error)
 }
 
 type CreateItemInput struct {
-	Name          string `json:"name"`
-	Category      string `json:"category"`
-	Brand         string `json:"brand"`
-	PurchasePrice int    `json:"purchase_price"`
-	PurchaseDate  string `json:"purchase_date"`
+	Name          string            `json:"name"`
+	Category      string            `json:"category"`
+	Brand         string            `json:"brand"`
+	PurchasePrice int               `json:"purchase_price"`
+	PurchaseDate  string            `json:"purchase_date"`
+	Condition     string            `json:"condition"`
+	SerialNumber  string            `json:"serial_number"`
+	Attributes    map[string]string `json:"attributes"`
+
+	// 既存のブランドに似ていても入力のまま登録する（ErrSimilarBrand で候補を確認した後に指定する）
+	ConfirmBrand bool `json:"confirm_brand"`
 }
 
 type CategorySummary struct {
 	Categories map[string]int `json:"categories"`
+	Conditions map[string]int `json:"conditions"`
 	Total      int            `json:"total"`
 }
 
 type itemUsecase struct {
-	itemRepo ItemRepository
+	itemRepo   ItemRepository
+	readRepo   ItemRepository
+	transactor Transactor
+	quota      Quota
+}
+
+// 変更のイベントはリポジトリがアウトボックスに記録し、OutboxRelayが配信する
+// 確認と書き込みを組み合わせる処理は transactor で1つのトランザクションにまとめる
+func NewItemUsecase(itemRepo ItemRepository, transactor Transactor) ItemUsecase {
+	return NewItemUsecaseWithReplica(itemRepo, itemRepo, transactor)
+}
+
+// 読み取りだけの処理（一覧・取得・集計・重複候補の検出）は readRepo（リードレプリカ）から読む
+// レプリカは反映が遅れることがあるため、変更の前の確認は常に itemRepo（プライマリ）で行う
+func NewItemUsecaseWithReplica(itemRepo, readRepo ItemRepository, transactor Transactor) ItemUsecase {
+	return NewItemUsecaseWithQuota(itemRepo, readRepo, transactor, Quota{})
 }
 
-func NewItemUsecase(itemRepo ItemRepository) ItemUsecase {
+// 登録（複製・テンプレートからの登録・CSVの取り込みを含む）で quota を超える場合は ErrQuotaExceeded を返す
+func NewItemUsecaseWithQuota(itemRepo, readRepo ItemRepository, transactor Transactor, quota Quota) ItemUsecase {
 	return &itemUsecase{
-		itemRepo: itemRepo,
+		itemRepo:   itemRepo,
+		readRepo:   readRepo,
+		transactor: transactor,
+		quota:      quota,
 	}
 }
 
-func (u *itemUsecase) GetAllItems(ctx context.Context) ([]*entity.Item, error) {
-	items, err := u.itemRepo.FindAll(ctx)
+func (u *itemUsecase) GetAllItems(ctx context.Context, filter entity.ItemFilter) ([]*entity.Item, error) {
+	if err := filter.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %s", domainErrors.ErrInvalidInput, err.Error())
+	}
+
+	items, err := u.readRepo.FindAll(ctx, filter)
 	if err != nil {
 		return nil, fmt.Errorf("failed to retrieve items: %w", err)
 	}
@@ -56,12 +101,43 @@ func (u *itemUsecase) GetAllItems(ctx context.Context) ([]*entity.Item, error) {
 	return items, nil
 }
 
+// 絞り込み条件に合うアイテムの数（一覧を取得せずにページ数を出すため）
+func (u *itemUsecase) CountItems(ctx context.Context, filter entity.ItemFilter) (int, error) {
+	if err := filter.Validate(); err != nil {
+		return 0, fmt.Errorf("%w: %s", domainErrors.ErrInvalidInput, err.Error())
+	}
+
+	count, err := u.readRepo.Count(ctx, filter)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count items: %w", err)
+	}
+
+	return count, nil
+}
+
 func (u *itemUsecase) GetItemByID(ctx context.Context, id int64) (*entity.Item, error) {
 	if id <= 0 {
 		return nil, domainErrors.ErrInvalidInput
 	}
 
-	item, err := u.itemRepo.FindByID(ctx, id)
+	item, err := u.readRepo.FindByID(ctx, id)
+	if err != nil {
+		if domainErrors.IsNotFoundError(err) {
+			return nil, domainErrors.ErrItemNotFound
+		}
+		return nil, fmt.Errorf("failed to retrieve item: %w", err)
+	}
+
+	return item, nil
+}
+
+func (u *itemUsecase) GetItemBySerialNumber(ctx context.Context, serialNumber string) (*entity.Item, error) {
+	serialNumber = strings.TrimSpace(serialNumber)
+	if serialNumber == "" {
+		return nil, domainErrors.ErrInvalidInput
+	}
+
+	item, err := u.readRepo.FindBySerialNumber(ctx, serialNumber)
 	if err != nil {
 		if domainErrors.IsNotFoundError(err) {
 			return nil, domainErrors.ErrItemNotFound
@@ -73,7 +149,57 @@ func (u *itemUsecase) GetItemByID(ctx context.Context, id int64) (*entity.Item,
 }
 
 func (u *itemUsecase) CreateItem(ctx context.Context, input CreateItemInput) (*entity.Item, error) {
-	// バリデーションして、新しいエンティティを作成
+	item, err := newItemFromInput(input)
+	if err != nil {
+		return nil, err
+	}
+	if err := resolveBrand(ctx, u.itemRepo, item, input.ConfirmBrand); err != nil {
+		return nil, err
+	}
+
+	return u.createItem(ctx, item)
+}
+
+func (u *itemUsecase) ValidateItem(ctx context.Context, input CreateItemInput) (*entity.Item, error) {
+	item, err := newItemFromInput(input)
+	if err != nil {
+		return nil, err
+	}
+
+	// 書き込まないためトランザクションは使わない（登録時にはもう一度確認する）
+	if err := u.quota.ensureItemAvailable(ctx, countAllItems(u.readRepo)); err != nil {
+		return nil, err
+	}
+	if err := ensureSerialNumberAvailable(ctx, u.readRepo, item); err != nil {
+		return nil, err
+	}
+	if err := resolveBrand(ctx, u.readRepo, item, input.ConfirmBrand); err != nil {
+		return nil, err
+	}
+
+	return item, nil
+}
+
+// ブランドごとの集計が表記の揺れで分かれないよう、既存のブランドと照合する
+// 大文字・小文字と空白だけが違う場合は既存の表記に揃え、似ているブランドがある場合は confirm がなければ候補を返す
+func resolveBrand(ctx context.Context, itemRepo ItemRepository, item *entity.Item, confirm bool) error {
+	brands, err := itemRepo.FindBrands(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to retrieve brands: %w", err)
+	}
+
+	match := entity.MatchBrand(item.Brand, brands)
+	switch {
+	case match.Exact != "":
+		item.Brand = match.Exact
+	case len(match.Similar) > 0 && !confirm:
+		return fmt.Errorf("%w: %w", domainErrors.ErrSimilarBrand, &entity.BrandSuggestion{Brand: item.Brand, Suggestions: match.Similar})
+	}
+	return nil
+}
+
+// 入力をバリデーションして、新しいエンティティを作成
+func newItemFromInput(input CreateItemInput) (*entity.Item, error) {
 	item, err := entity.NewItem(
 		input.Name,
 		input.Category,
@@ -82,12 +208,55 @@ func (u *itemUsecase) CreateItem(ctx context.Context, input CreateItemInput) (*e
 		input.PurchaseDate,
 	)
 	if err != nil {
-		return nil, fmt.Errorf("%w: %s", domainErrors.ErrInvalidInput, err.Error())
+		return nil, fmt.Errorf("%w: %w", domainErrors.ErrInvalidInput, err)
+	}
+
+	if input.Condition != "" {
+		if err := item.SetCondition(input.Condition); err != nil {
+			return nil, fmt.Errorf("%w: %w", domainErrors.ErrInvalidInput, err)
+		}
+	}
+
+	if input.SerialNumber != "" {
+		if err := item.SetSerialNumber(input.SerialNumber); err != nil {
+			return nil, fmt.Errorf("%w: %w", domainErrors.ErrInvalidInput, err)
+		}
+	}
+
+	if len(input.Attributes) > 0 {
+		if err := item.SetAttributes(input.Attributes); err != nil {
+			return nil, fmt.Errorf("%w: %w", domainErrors.ErrInvalidInput, err)
+		}
+	}
+
+	// カテゴリー別のルールを評価
+	if err := item.EvaluateRules(); err != nil {
+		return nil, fmt.Errorf("%w: %w", domainErrors.ErrInvalidInput, err)
 	}
 
-	createdItem, err := u.itemRepo.Create(ctx, item)
+	return item, nil
+}
+
+// 上限とシリアル番号の重複の確認と登録を1つのトランザクションで行う
+func (u *itemUsecase) createItem(ctx context.Context, item *entity.Item) (*entity.Item, error) {
+	var createdItem *entity.Item
+	err := u.transactor.WithTx(ctx, func(repos Repositories) error {
+		if err := u.quota.ensureItemAvailable(ctx, repos.Items.CountLocked); err != nil {
+			return err
+		}
+		if err := ensureSerialNumberAvailable(ctx, repos.Items, item); err != nil {
+			return err
+		}
+
+		created, err := repos.Items.Create(ctx, item)
+		if err != nil {
+			return fmt.Errorf("failed to create item: %w", err)
+		}
+		createdItem = created
+		return nil
+	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to create item: %w", err)
+		return nil, err
 	}
 
 	return createdItem, nil
@@ -107,6 +276,11 @@ func (u *itemUsecase) PartialUpdateItem(ctx context.Context, id int64, input Upd
 		return nil, fmt.Errorf("failed to retrieve item: %w", err)
 	}
 
+	// 取得した後に他のリクエストが更新していれば、その変更を上書きしない
+	if input.Version != nil && *input.Version != item.Version {
+		return nil, domainErrors.ErrVersionConflict
+	}
+
 	// 部分更新用のデータを作成
 	updateData := make(map[string]interface{})
 	if input.Name != nil {
@@ -118,20 +292,65 @@ func (u *itemUsecase) PartialUpdateItem(ctx context.Context, id int64, input Upd
 	if input.PurchasePrice != nil {
 		updateData["purchase_price"] = *input.PurchasePrice
 	}
+	if input.Condition != nil {
+		updateData["condition"] = *input.Condition
+	}
+	if input.SerialNumber != nil {
+		updateData["serial_number"] = *input.SerialNumber
+	}
+	if input.Attributes != nil {
+		updateData["attributes"] = input.Attributes
+	}
 
 	// エンティティの部分更新メソッドを呼び出し
 	if err := item.PartialUpdate(updateData); err != nil {
-		return nil, fmt.Errorf("%w: %s", domainErrors.ErrInvalidInput, err.Error())
+		return nil, fmt.Errorf("%w: %w", domainErrors.ErrInvalidInput, err)
 	}
 
-	// データベースに保存
-	if err := u.itemRepo.Update(ctx, item); err != nil {
-		return nil, fmt.Errorf("failed to update item: %w", err)
+	// カテゴリー別のルールを評価
+	if err := item.EvaluateRules(); err != nil {
+		return nil, fmt.Errorf("%w: %w", domainErrors.ErrInvalidInput, err)
+	}
+
+	// シリアル番号の重複確認とデータベースへの保存を1つのトランザクションで行う
+	err = u.transactor.WithTx(ctx, func(repos Repositories) error {
+		if err := ensureSerialNumberAvailable(ctx, repos.Items, item); err != nil {
+			return err
+		}
+
+		if err := repos.Items.Update(ctx, item); err != nil {
+			return fmt.Errorf("failed to update item: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return item, nil
 }
 
+// シリアル番号が他のアイテムで使われていないか確認
+func ensureSerialNumberAvailable(ctx context.Context, itemRepo ItemRepository, item *entity.Item) error {
+	if item.SerialNumber == "" {
+		return nil
+	}
+
+	existing, err := itemRepo.FindBySerialNumber(ctx, item.SerialNumber)
+	if err != nil {
+		if domainErrors.IsNotFoundError(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to check serial number: %w", err)
+	}
+
+	if existing.ID != item.ID {
+		return domainErrors.ErrDuplicateSerial
+	}
+
+	return nil
+}
+
 func (u *itemUsecase) DeleteItem(ctx context.Context, id int64) error {
 	if id <= 0 {
 		return domainErrors.ErrInvalidInput
@@ -154,11 +373,16 @@ func (u *itemUsecase) DeleteItem(ctx context.Context, id int64) error {
 }
 
 func (u *itemUsecase) GetCategorySummary(ctx context.Context) (*CategorySummary, error) {
-	categoryCounts, err := u.itemRepo.GetSummaryByCategory(ctx)
+	categoryCounts, err := u.readRepo.GetSummaryByCategory(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get category summary: %w", err)
 	}
 
+	conditionCounts, err := u.readRepo.GetSummaryByCondition(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get condition summary: %w", err)
+	}
+
 	// 合計計算
 	total := 0
 	for _, count := range categoryCounts {
@@ -174,8 +398,42 @@ func (u *itemUsecase) GetCategorySummary(ctx context.Context) (*CategorySummary,
 		}
 	}
 
+	conditions := make(map[string]int)
+	for _, condition := range entity.GetValidConditions() {
+		conditions[condition] = conditionCounts[condition]
+	}
+
 	return &CategorySummary{
 		Categories: summary,
+		Conditions: conditions,
 		Total:      total,
 	}, nil
 }
+
+// アイテムを読んで change で変更し、変更があった場合だけ保存する（お気に入り・アーカイブの切り替えに使う）
+func (u *itemUsecase) changeItem(ctx context.Context, id int64, change func(item *entity.Item) bool) (*entity.Item, error) {
+	if id <= 0 {
+		return nil, domainErrors.ErrInvalidInput
+	}
+
+	item, err := u.itemRepo.FindByID(ctx, id)
+	if err != nil {
+		if domainErrors.IsNotFoundError(err) {
+			return nil, domainErrors.ErrItemNotFound
+		}
+		return nil, fmt.Errorf("failed to retrieve item: %w", err)
+	}
+
+	if !change(item) {
+		return item, nil
+	}
+
+	if err := u.itemRepo.Update(ctx, item); err != nil {
+		if domainErrors.IsNotFoundError(err) {
+			return nil, domainErrors.ErrItemNotFound
+		}
+		return nil, fmt.Errorf("failed to update item: %w", err)
+	}
+
+	return item, nil
+}
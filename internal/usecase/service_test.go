@@ -3,6 +3,7 @@ package usecase
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -17,11 +18,21 @@ type MockItemRepository struct {
 	mock.Mock
 }
 
-func (m *MockItemRepository) FindAll(ctx context.Context) ([]*entity.Item, error) {
-	args := m.Called(ctx)
+func (m *MockItemRepository) FindAll(ctx context.Context, filter entity.ItemFilter) ([]*entity.Item, error) {
+	args := m.Called(ctx, filter)
 	return args.Get(0).([]*entity.Item), args.Error(1)
 }
 
+func (m *MockItemRepository) Count(ctx context.Context, filter entity.ItemFilter) (int, error) {
+	args := m.Called(ctx, filter)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockItemRepository) CountLocked(ctx context.Context) (int, error) {
+	args := m.Called(ctx)
+	return args.Int(0), args.Error(1)
+}
+
 func (m *MockItemRepository) FindByID(ctx context.Context, id int64) (*entity.Item, error) {
 	args := m.Called(ctx, id)
 	if args.Get(0) == nil {
@@ -30,6 +41,14 @@ func (m *MockItemRepository) FindByID(ctx context.Context, id int64) (*entity.It
 	return args.Get(0).(*entity.Item), args.Error(1)
 }
 
+func (m *MockItemRepository) FindBySerialNumber(ctx context.Context, serialNumber string) (*entity.Item, error) {
+	args := m.Called(ctx, serialNumber)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.Item), args.Error(1)
+}
+
 func (m *MockItemRepository) Create(ctx context.Context, item *entity.Item) (*entity.Item, error) {
 	args := m.Called(ctx, item)
 	if args.Get(0) == nil {
@@ -48,6 +67,24 @@ func (m *MockItemRepository) Delete(ctx context.Context, id int64) error {
 	return args.Error(0)
 }
 
+func (m *MockItemRepository) Merge(ctx context.Context, survivorID int64, duplicateIDs []int64) error {
+	args := m.Called(ctx, survivorID, duplicateIDs)
+	return args.Error(0)
+}
+
+func (m *MockItemRepository) PurgeDeleted(ctx context.Context, before time.Time) (int, error) {
+	args := m.Called(ctx, before)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockItemRepository) ReassignCategory(ctx context.Context, from, to string) ([]int64, error) {
+	args := m.Called(ctx, from, to)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]int64), args.Error(1)
+}
+
 func (m *MockItemRepository) GetSummaryByCategory(ctx context.Context) (map[string]int, error) {
 	args := m.Called(ctx)
 	if args.Get(0) == nil {
@@ -56,13 +93,71 @@ func (m *MockItemRepository) GetSummaryByCategory(ctx context.Context) (map[stri
 	return args.Get(0).(map[string]int), args.Error(1)
 }
 
+func (m *MockItemRepository) FindBrands(ctx context.Context) ([]string, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]string), args.Error(1)
+}
+
+func (m *MockItemRepository) Suggest(ctx context.Context, prefix string, limit int) ([]entity.ItemSuggestion, error) {
+	args := m.Called(ctx, prefix, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.ItemSuggestion), args.Error(1)
+}
+
+func (m *MockItemRepository) GetSummaryByCondition(ctx context.Context) (map[string]int, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[string]int), args.Error(1)
+}
+
+// MockTransactor はトランザクションを使わず、渡されたリポジトリでそのまま実行する
+type MockTransactor struct {
+	repos Repositories
+}
+
+func (m *MockTransactor) WithTx(ctx context.Context, fn func(repos Repositories) error) error {
+	return fn(m.repos)
+}
+
 func TestNewItemUsecase(t *testing.T) {
 	mockRepo := new(MockItemRepository)
-	usecase := NewItemUsecase(mockRepo)
+	usecase := NewItemUsecase(mockRepo, &MockTransactor{repos: Repositories{Items: mockRepo}})
 
 	assert.NotNil(t, usecase)
 }
 
+func TestNewItemUsecaseWithReplica(t *testing.T) {
+	ctx := context.Background()
+	item := &entity.Item{ID: 1, Name: "ロレックス デイトナ", Category: "時計"}
+
+	t.Run("正常系: 読み取りはレプリカ、変更前の確認と変更はプライマリで行う", func(t *testing.T) {
+		primary := new(MockItemRepository)
+		replica := new(MockItemRepository)
+		replica.On("FindByID", ctx, int64(1)).Return(item, nil).Once()
+		replica.On("GetSummaryByCategory", ctx).Return(map[string]int{"時計": 1}, nil)
+		replica.On("GetSummaryByCondition", ctx).Return(map[string]int{}, nil)
+		primary.On("FindByID", ctx, int64(1)).Return(item, nil).Once()
+		primary.On("Delete", ctx, int64(1)).Return(nil)
+		usecase := NewItemUsecaseWithReplica(primary, replica, &MockTransactor{repos: Repositories{Items: primary}})
+
+		_, err := usecase.GetItemByID(ctx, 1)
+		require.NoError(t, err)
+		_, err = usecase.GetCategorySummary(ctx)
+		require.NoError(t, err)
+		require.NoError(t, usecase.DeleteItem(ctx, 1))
+
+		primary.AssertExpectations(t)
+		replica.AssertExpectations(t)
+	})
+}
+
 func TestItemUsecase_GetAllItems(t *testing.T) {
 	tests := []struct {
 		name          string
@@ -76,7 +171,7 @@ func TestItemUsecase_GetAllItems(t *testing.T) {
 				item1, _ := entity.NewItem("時計1", "時計", "ROLEX", 1000000, "2023-01-01")
 				item2, _ := entity.NewItem("バッグ1", "バッグ", "HERMÈS", 500000, "2023-01-02")
 				items := []*entity.Item{item1, item2}
-				mockRepo.On("FindAll", mock.Anything).Return(items, nil)
+				mockRepo.On("FindAll", mock.Anything, entity.ItemFilter{}).Return(items, nil)
 			},
 			expectedCount: 2,
 			expectedErr:   nil,
@@ -85,7 +180,7 @@ func TestItemUsecase_GetAllItems(t *testing.T) {
 			name: "正常系: アイテムが0件",
 			setupMock: func(mockRepo *MockItemRepository) {
 				items := []*entity.Item{}
-				mockRepo.On("FindAll", mock.Anything).Return(items, nil)
+				mockRepo.On("FindAll", mock.Anything, entity.ItemFilter{}).Return(items, nil)
 			},
 			expectedCount: 0,
 			expectedErr:   nil,
@@ -93,7 +188,7 @@ func TestItemUsecase_GetAllItems(t *testing.T) {
 		{
 			name: "異常系: データベースエラー",
 			setupMock: func(mockRepo *MockItemRepository) {
-				mockRepo.On("FindAll", mock.Anything).Return(([]*entity.Item)(nil), domainErrors.ErrDatabaseError)
+				mockRepo.On("FindAll", mock.Anything, entity.ItemFilter{}).Return(([]*entity.Item)(nil), domainErrors.ErrDatabaseError)
 			},
 			expectedCount: 0,
 			expectedErr:   domainErrors.ErrDatabaseError,
@@ -104,10 +199,10 @@ func TestItemUsecase_GetAllItems(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			mockRepo := new(MockItemRepository)
 			tt.setupMock(mockRepo)
-			usecase := NewItemUsecase(mockRepo)
+			usecase := NewItemUsecase(mockRepo, &MockTransactor{repos: Repositories{Items: mockRepo}})
 
 			ctx := context.Background()
-			items, err := usecase.GetAllItems(ctx)
+			items, err := usecase.GetAllItems(ctx, entity.ItemFilter{})
 
 			if tt.expectedErr != nil {
 				assert.Error(t, err)
@@ -123,6 +218,41 @@ func TestItemUsecase_GetAllItems(t *testing.T) {
 	}
 }
 
+func TestItemUsecase_CountItems(t *testing.T) {
+	t.Run("正常系: 絞り込み条件に合う数を返す", func(t *testing.T) {
+		mockRepo := new(MockItemRepository)
+		filter := entity.ItemFilter{Condition: "A"}
+		mockRepo.On("Count", mock.Anything, filter).Return(3, nil)
+		usecase := NewItemUsecase(mockRepo, &MockTransactor{repos: Repositories{Items: mockRepo}})
+
+		count, err := usecase.CountItems(context.Background(), filter)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 3, count)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("異常系: 不正な絞り込み条件", func(t *testing.T) {
+		mockRepo := new(MockItemRepository)
+		usecase := NewItemUsecase(mockRepo, &MockTransactor{repos: Repositories{Items: mockRepo}})
+
+		_, err := usecase.CountItems(context.Background(), entity.ItemFilter{Condition: "Z"})
+
+		assert.ErrorIs(t, err, domainErrors.ErrInvalidInput)
+		mockRepo.AssertNotCalled(t, "Count", mock.Anything, mock.Anything)
+	})
+
+	t.Run("異常系: データベースエラー", func(t *testing.T) {
+		mockRepo := new(MockItemRepository)
+		mockRepo.On("Count", mock.Anything, entity.ItemFilter{}).Return(0, domainErrors.ErrDatabaseError)
+		usecase := NewItemUsecase(mockRepo, &MockTransactor{repos: Repositories{Items: mockRepo}})
+
+		_, err := usecase.CountItems(context.Background(), entity.ItemFilter{})
+
+		assert.ErrorIs(t, err, domainErrors.ErrDatabaseError)
+	})
+}
+
 func TestItemUsecase_GetItemByID(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -173,7 +303,7 @@ func TestItemUsecase_GetItemByID(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			mockRepo := new(MockItemRepository)
 			tt.setupMock(mockRepo)
-			usecase := NewItemUsecase(mockRepo)
+			usecase := NewItemUsecase(mockRepo, &MockTransactor{repos: Repositories{Items: mockRepo}})
 
 			ctx := context.Background()
 			item, err := usecase.GetItemByID(ctx, tt.id)
@@ -211,8 +341,11 @@ func TestItemUsecase_CreateItem(t *testing.T) {
 				Brand:         "ROLEX",
 				PurchasePrice: 1500000,
 				PurchaseDate:  "2023-01-15",
+				SerialNumber:  "D123456",
 			},
 			setupMock: func(mockRepo *MockItemRepository) {
+				mockRepo.On("FindBrands", mock.Anything).Return([]string{"ROLEX"}, nil)
+				mockRepo.On("FindBySerialNumber", mock.Anything, "D123456").Return(nil, domainErrors.ErrItemNotFound)
 				createdItem, _ := entity.NewItem("ロレックス デイトナ", "時計", "ROLEX", 1500000, "2023-01-15")
 				createdItem.ID = 1
 				mockRepo.On("Create", mock.Anything, mock.AnythingOfType("*entity.Item")).Return(createdItem, nil)
@@ -249,16 +382,32 @@ func TestItemUsecase_CreateItem(t *testing.T) {
 			expectError: true,
 			expectedErr: domainErrors.ErrInvalidInput,
 		},
+		{
+			name: "異常系: 時計にシリアル番号が無い",
+			input: CreateItemInput{
+				Name:          "ロレックス デイトナ",
+				Category:      "時計",
+				Brand:         "ROLEX",
+				PurchasePrice: 1500000,
+				PurchaseDate:  "2023-01-15",
+			},
+			setupMock: func(mockRepo *MockItemRepository) {
+				// Createは呼ばれない
+			},
+			expectError: true,
+			expectedErr: domainErrors.ErrInvalidInput,
+		},
 		{
 			name: "異常系: データベースエラー",
 			input: CreateItemInput{
 				Name:          "アイテム",
-				Category:      "時計",
+				Category:      "その他",
 				Brand:         "ブランド",
 				PurchasePrice: 100000,
 				PurchaseDate:  "2023-01-15",
 			},
 			setupMock: func(mockRepo *MockItemRepository) {
+				mockRepo.On("FindBrands", mock.Anything).Return([]string{}, nil)
 				mockRepo.On("Create", mock.Anything, mock.AnythingOfType("*entity.Item")).Return((*entity.Item)(nil), domainErrors.ErrDatabaseError)
 			},
 			expectError: true,
@@ -269,7 +418,7 @@ func TestItemUsecase_CreateItem(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			mockRepo := new(MockItemRepository)
 			tt.setupMock(mockRepo)
-			usecase := NewItemUsecase(mockRepo)
+			usecase := NewItemUsecase(mockRepo, &MockTransactor{repos: Repositories{Items: mockRepo}})
 
 			ctx := context.Background()
 			item, err := usecase.CreateItem(ctx, tt.input)
@@ -295,6 +444,107 @@ func TestItemUsecase_CreateItem(t *testing.T) {
 	}
 }
 
+func TestItemUsecase_ValidateItem(t *testing.T) {
+	input := CreateItemInput{
+		Name:          " ロレックス デイトナ ",
+		Category:      "時計",
+		Brand:         "ROLEX",
+		PurchasePrice: 1500000,
+		PurchaseDate:  "2023-01-15",
+		SerialNumber:  "D123456",
+	}
+
+	t.Run("正常系: 正規化した内容を返し、保存しない", func(t *testing.T) {
+		mockRepo := new(MockItemRepository)
+		mockRepo.On("FindBySerialNumber", mock.Anything, "D123456").Return(nil, domainErrors.ErrItemNotFound)
+		mockRepo.On("FindBrands", mock.Anything).Return([]string{}, nil)
+		usecase := NewItemUsecase(mockRepo, &MockTransactor{repos: Repositories{Items: mockRepo}})
+
+		item, err := usecase.ValidateItem(context.Background(), input)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "ロレックス デイトナ", item.Name)
+		assert.Equal(t, int64(0), item.ID)
+		mockRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("異常系: カテゴリー別のルールに違反", func(t *testing.T) {
+		mockRepo := new(MockItemRepository)
+		usecase := NewItemUsecase(mockRepo, &MockTransactor{repos: Repositories{Items: mockRepo}})
+		withoutSerial := input
+		withoutSerial.SerialNumber = ""
+
+		_, err := usecase.ValidateItem(context.Background(), withoutSerial)
+
+		assert.ErrorIs(t, err, domainErrors.ErrInvalidInput)
+	})
+
+	t.Run("異常系: シリアル番号が登録済み", func(t *testing.T) {
+		mockRepo := new(MockItemRepository)
+		mockRepo.On("FindBySerialNumber", mock.Anything, "D123456").Return(&entity.Item{ID: 7}, nil)
+		usecase := NewItemUsecase(mockRepo, &MockTransactor{repos: Repositories{Items: mockRepo}})
+
+		_, err := usecase.ValidateItem(context.Background(), input)
+
+		assert.ErrorIs(t, err, domainErrors.ErrDuplicateSerial)
+	})
+}
+
+func TestItemUsecase_CreateItem_Brand(t *testing.T) {
+	input := CreateItemInput{
+		Name:          "バーキン 30",
+		Category:      "バッグ",
+		Brand:         "Hermes",
+		PurchasePrice: 2000000,
+		PurchaseDate:  "2023-01-15",
+	}
+
+	t.Run("正常系: 大文字・小文字だけが違う場合は既存の表記に揃える", func(t *testing.T) {
+		mockRepo := new(MockItemRepository)
+		mockRepo.On("FindBrands", mock.Anything).Return([]string{"HERMES", "ROLEX"}, nil)
+		mockRepo.On("Create", mock.Anything, mock.MatchedBy(func(item *entity.Item) bool {
+			return item.Brand == "HERMES"
+		})).Return(&entity.Item{ID: 1, Brand: "HERMES"}, nil)
+		usecase := NewItemUsecase(mockRepo, &MockTransactor{repos: Repositories{Items: mockRepo}})
+
+		_, err := usecase.CreateItem(context.Background(), input)
+
+		require.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("異常系: 似ているブランドがあれば候補を返す", func(t *testing.T) {
+		mockRepo := new(MockItemRepository)
+		mockRepo.On("FindBrands", mock.Anything).Return([]string{"HERMÈS", "ROLEX"}, nil)
+		usecase := NewItemUsecase(mockRepo, &MockTransactor{repos: Repositories{Items: mockRepo}})
+
+		_, err := usecase.CreateItem(context.Background(), input)
+
+		assert.ErrorIs(t, err, domainErrors.ErrSimilarBrand)
+		var suggestion *entity.BrandSuggestion
+		require.ErrorAs(t, err, &suggestion)
+		assert.Equal(t, "Hermes", suggestion.Brand)
+		assert.Equal(t, []string{"HERMÈS"}, suggestion.Suggestions)
+		mockRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("正常系: 確認済みなら入力のまま登録する", func(t *testing.T) {
+		mockRepo := new(MockItemRepository)
+		mockRepo.On("FindBrands", mock.Anything).Return([]string{"HERMÈS", "ROLEX"}, nil)
+		mockRepo.On("Create", mock.Anything, mock.MatchedBy(func(item *entity.Item) bool {
+			return item.Brand == "Hermes"
+		})).Return(&entity.Item{ID: 1, Brand: "Hermes"}, nil)
+		usecase := NewItemUsecase(mockRepo, &MockTransactor{repos: Repositories{Items: mockRepo}})
+		confirmed := input
+		confirmed.ConfirmBrand = true
+
+		_, err := usecase.CreateItem(context.Background(), confirmed)
+
+		require.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+}
+
 func TestItemUsecase_DeleteItem(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -357,7 +607,7 @@ func TestItemUsecase_DeleteItem(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			mockRepo := new(MockItemRepository)
 			tt.setupMock(mockRepo)
-			usecase := NewItemUsecase(mockRepo)
+			usecase := NewItemUsecase(mockRepo, &MockTransactor{repos: Repositories{Items: mockRepo}})
 
 			ctx := context.Background()
 			err := usecase.DeleteItem(ctx, tt.id)
@@ -393,6 +643,7 @@ func TestItemUsecase_GetCategorySummary(t *testing.T) {
 					"バッグ": 1,
 				}
 				mockRepo.On("GetSummaryByCategory", mock.Anything).Return(summary, nil)
+				mockRepo.On("GetSummaryByCondition", mock.Anything).Return(map[string]int{"A": 2}, nil)
 			},
 			expectedTotal:      3,
 			expectedWatchCount: 2,
@@ -404,6 +655,7 @@ func TestItemUsecase_GetCategorySummary(t *testing.T) {
 			setupMock: func(mockRepo *MockItemRepository) {
 				summary := map[string]int{}
 				mockRepo.On("GetSummaryByCategory", mock.Anything).Return(summary, nil)
+				mockRepo.On("GetSummaryByCondition", mock.Anything).Return(map[string]int{}, nil)
 			},
 			expectedTotal:      0,
 			expectedWatchCount: 0,
@@ -423,7 +675,7 @@ func TestItemUsecase_GetCategorySummary(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			mockRepo := new(MockItemRepository)
 			tt.setupMock(mockRepo)
-			usecase := NewItemUsecase(mockRepo)
+			usecase := NewItemUsecase(mockRepo, &MockTransactor{repos: Repositories{Items: mockRepo}})
 
 			ctx := context.Background()
 			summary, err := usecase.GetCategorySummary(ctx)
@@ -448,6 +700,11 @@ func TestItemUsecase_GetCategorySummary(t *testing.T) {
 				assert.Contains(t, summary.Categories, category)
 			}
 
+			// すべてのコンディションランクがレスポンスに含まれているかチェック
+			for _, condition := range []string{"N", "S", "A", "B", "C"} {
+				assert.Contains(t, summary.Conditions, condition)
+			}
+
 			mockRepo.AssertExpectations(t)
 		})
 	}
@@ -469,7 +726,7 @@ func TestItemUsecase_PartialUpdateItem(t *testing.T) {
 				Name: stringPtr("更新された名前"),
 			},
 			setupMock: func(mockRepo *MockItemRepository) {
-				existingItem, _ := entity.NewItem("元の名前", "時計", "ROLEX", 1000000, "2023-01-01")
+				existingItem, _ := entity.NewItem("元の名前", "バッグ", "ROLEX", 1000000, "2023-01-01")
 				existingItem.ID = 1
 				mockRepo.On("FindByID", mock.Anything, int64(1)).Return(existingItem, nil)
 				mockRepo.On("Update", mock.Anything, mock.AnythingOfType("*entity.Item")).Return(nil)
@@ -484,7 +741,7 @@ func TestItemUsecase_PartialUpdateItem(t *testing.T) {
 				PurchasePrice: intPtr(2000000),
 			},
 			setupMock: func(mockRepo *MockItemRepository) {
-				existingItem, _ := entity.NewItem("アイテム", "時計", "元のブランド", 1000000, "2023-01-01")
+				existingItem, _ := entity.NewItem("アイテム", "バッグ", "元のブランド", 1000000, "2023-01-01")
 				existingItem.ID = 2
 				mockRepo.On("FindByID", mock.Anything, int64(2)).Return(existingItem, nil)
 				mockRepo.On("Update", mock.Anything, mock.AnythingOfType("*entity.Item")).Return(nil)
@@ -530,13 +787,61 @@ func TestItemUsecase_PartialUpdateItem(t *testing.T) {
 			expectError: true,
 			expectedErr: domainErrors.ErrInvalidInput,
 		},
+		{
+			name: "異常系: 時計のシリアル番号を空にする",
+			id:   1,
+			input: UpdateItemInput{
+				SerialNumber: stringPtr(""),
+			},
+			setupMock: func(mockRepo *MockItemRepository) {
+				existingItem, _ := entity.NewItem("時計1", "時計", "ROLEX", 1000000, "2023-01-01")
+				existingItem.ID = 1
+				existingItem.SerialNumber = "SN-0001"
+				mockRepo.On("FindByID", mock.Anything, int64(1)).Return(existingItem, nil)
+				// Updateは呼ばれない
+			},
+			expectError: true,
+			expectedErr: domainErrors.ErrInvalidInput,
+		},
+		{
+			name: "異常系: 指定した版が古い",
+			id:   1,
+			input: UpdateItemInput{
+				Name:    stringPtr("新しい名前"),
+				Version: intPtr(1),
+			},
+			setupMock: func(mockRepo *MockItemRepository) {
+				existingItem, _ := entity.NewItem("アイテム", "バッグ", "ブランド", 1000000, "2023-01-01")
+				existingItem.ID = 1
+				existingItem.Version = 2
+				mockRepo.On("FindByID", mock.Anything, int64(1)).Return(existingItem, nil)
+				// Updateは呼ばれない
+			},
+			expectError: true,
+			expectedErr: domainErrors.ErrVersionConflict,
+		},
+		{
+			name: "異常系: 読んだ後に他で更新された",
+			id:   1,
+			input: UpdateItemInput{
+				Name: stringPtr("新しい名前"),
+			},
+			setupMock: func(mockRepo *MockItemRepository) {
+				existingItem, _ := entity.NewItem("アイテム", "バッグ", "ブランド", 1000000, "2023-01-01")
+				existingItem.ID = 1
+				mockRepo.On("FindByID", mock.Anything, int64(1)).Return(existingItem, nil)
+				mockRepo.On("Update", mock.Anything, mock.AnythingOfType("*entity.Item")).Return(domainErrors.ErrVersionConflict)
+			},
+			expectError: true,
+			expectedErr: domainErrors.ErrVersionConflict,
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			mockRepo := new(MockItemRepository)
 			tt.setupMock(mockRepo)
-			usecase := NewItemUsecase(mockRepo)
+			usecase := NewItemUsecase(mockRepo, &MockTransactor{repos: Repositories{Items: mockRepo}})
 
 			ctx := context.Background()
 			item, err := usecase.PartialUpdateItem(ctx, tt.id, tt.input)
@@ -574,3 +879,76 @@ func stringPtr(s string) *string {
 func intPtr(i int) *int {
 	return &i
 }
+
+func TestItemUsecase_SerialNumberUniqueness(t *testing.T) {
+	t.Run("正常系: 未使用のシリアル番号で作成", func(t *testing.T) {
+		mockRepo := new(MockItemRepository)
+		mockRepo.On("FindBrands", mock.Anything).Return([]string{}, nil)
+		mockRepo.On("FindBySerialNumber", mock.Anything, "SN-0001").Return(nil, domainErrors.ErrItemNotFound)
+		mockRepo.On("Create", mock.Anything, mock.AnythingOfType("*entity.Item")).Return(&entity.Item{ID: 1, SerialNumber: "SN-0001"}, nil)
+
+		usecase := NewItemUsecase(mockRepo, &MockTransactor{repos: Repositories{Items: mockRepo}})
+		item, err := usecase.CreateItem(context.Background(), CreateItemInput{
+			Name:          "ロレックス デイトナ",
+			Category:      "時計",
+			Brand:         "ROLEX",
+			PurchasePrice: 1500000,
+			PurchaseDate:  "2023-01-15",
+			SerialNumber:  " SN-0001 ",
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, "SN-0001", item.SerialNumber)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("異常系: 作成時にシリアル番号が重複", func(t *testing.T) {
+		mockRepo := new(MockItemRepository)
+		mockRepo.On("FindBrands", mock.Anything).Return([]string{}, nil)
+		mockRepo.On("FindBySerialNumber", mock.Anything, "SN-0001").Return(&entity.Item{ID: 5, SerialNumber: "SN-0001"}, nil)
+
+		usecase := NewItemUsecase(mockRepo, &MockTransactor{repos: Repositories{Items: mockRepo}})
+		item, err := usecase.CreateItem(context.Background(), CreateItemInput{
+			Name:          "ロレックス デイトナ",
+			Category:      "時計",
+			Brand:         "ROLEX",
+			PurchasePrice: 1500000,
+			PurchaseDate:  "2023-01-15",
+			SerialNumber:  "SN-0001",
+		})
+
+		assert.ErrorIs(t, err, domainErrors.ErrDuplicateSerial)
+		assert.Nil(t, item)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("正常系: 自分自身のシリアル番号は重複扱いしない", func(t *testing.T) {
+		mockRepo := new(MockItemRepository)
+		existingItem, _ := entity.NewItem("時計1", "時計", "ROLEX", 1000000, "2023-01-01")
+		existingItem.ID = 1
+		existingItem.SerialNumber = "SN-0001"
+		mockRepo.On("FindByID", mock.Anything, int64(1)).Return(existingItem, nil)
+		mockRepo.On("FindBySerialNumber", mock.Anything, "SN-0001").Return(existingItem, nil)
+		mockRepo.On("Update", mock.Anything, existingItem).Return(nil)
+
+		usecase := NewItemUsecase(mockRepo, &MockTransactor{repos: Repositories{Items: mockRepo}})
+		_, err := usecase.PartialUpdateItem(context.Background(), 1, UpdateItemInput{Name: stringPtr("時計2")})
+
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("異常系: 更新時に他のアイテムとシリアル番号が重複", func(t *testing.T) {
+		mockRepo := new(MockItemRepository)
+		existingItem, _ := entity.NewItem("時計1", "時計", "ROLEX", 1000000, "2023-01-01")
+		existingItem.ID = 1
+		mockRepo.On("FindByID", mock.Anything, int64(1)).Return(existingItem, nil)
+		mockRepo.On("FindBySerialNumber", mock.Anything, "SN-0002").Return(&entity.Item{ID: 2, SerialNumber: "SN-0002"}, nil)
+
+		usecase := NewItemUsecase(mockRepo, &MockTransactor{repos: Repositories{Items: mockRepo}})
+		_, err := usecase.PartialUpdateItem(context.Background(), 1, UpdateItemInput{SerialNumber: stringPtr("SN-0002")})
+
+		assert.ErrorIs(t, err, domainErrors.ErrDuplicateSerial)
+		mockRepo.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,137 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"Aicon-assignment/internal/domain/entity"
+	domainErrors "Aicon-assignment/internal/domain/errors"
+)
+
+type ShareLinkUsecase interface {
+	CreateShareLink(ctx context.Context, itemID int64, input CreateShareLinkInput) (*CreatedShareLink, error)
+	GetShareLinks(ctx context.Context, itemID int64) ([]*entity.ShareLink, error)
+	// 取り消し済みのリンクを取り消してもエラーにしない
+	RevokeShareLink(ctx context.Context, itemID, id int64) error
+	// 公開ページの内容（期限切れ・取り消し済み・アイテムの削除後は見つからない扱いにする）
+	GetSharedItem(ctx context.Context, token string) (*entity.SharedItem, error)
+}
+
+type CreateShareLinkInput struct {
+	Fields        []string `json:"fields"`          // 省略時は購入価格・購入日を除く項目
+	ExpiresInDays int      `json:"expires_in_days"` // 省略時は7日
+}
+
+// 作成したリンクとトークン（トークンはこのときしか返さない）
+type CreatedShareLink struct {
+	*entity.ShareLink
+	Token string `json:"token"`
+}
+
+type shareLinkUsecase struct {
+	itemRepo      ItemRepository
+	shareLinkRepo ShareLinkRepository
+}
+
+func NewShareLinkUsecase(itemRepo ItemRepository, shareLinkRepo ShareLinkRepository) ShareLinkUsecase {
+	return &shareLinkUsecase{
+		itemRepo:      itemRepo,
+		shareLinkRepo: shareLinkRepo,
+	}
+}
+
+func (u *shareLinkUsecase) CreateShareLink(ctx context.Context, itemID int64, input CreateShareLinkInput) (*CreatedShareLink, error) {
+	if err := u.ensureItemExists(ctx, itemID); err != nil {
+		return nil, err
+	}
+
+	link, token, err := entity.NewShareLink(itemID, input.Fields, input.ExpiresInDays)
+	if err != nil {
+		var fieldErrors entity.FieldErrors
+		if errors.As(err, &fieldErrors) {
+			return nil, fmt.Errorf("%w: %w", domainErrors.ErrInvalidInput, err)
+		}
+		return nil, err
+	}
+
+	created, err := u.shareLinkRepo.Create(ctx, link)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create share link: %w", err)
+	}
+
+	return &CreatedShareLink{ShareLink: created, Token: token}, nil
+}
+
+func (u *shareLinkUsecase) GetShareLinks(ctx context.Context, itemID int64) ([]*entity.ShareLink, error) {
+	if err := u.ensureItemExists(ctx, itemID); err != nil {
+		return nil, err
+	}
+
+	links, err := u.shareLinkRepo.FindByItemID(ctx, itemID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve share links: %w", err)
+	}
+
+	return links, nil
+}
+
+func (u *shareLinkUsecase) RevokeShareLink(ctx context.Context, itemID, id int64) error {
+	links, err := u.GetShareLinks(ctx, itemID)
+	if err != nil {
+		return err
+	}
+
+	for _, link := range links {
+		if link.ID != id {
+			continue
+		}
+		if link.RevokedAt != nil {
+			return nil
+		}
+		if err := u.shareLinkRepo.Revoke(ctx, itemID, id); err != nil && !domainErrors.IsShareLinkNotFoundError(err) {
+			return fmt.Errorf("failed to revoke share link: %w", err)
+		}
+		return nil
+	}
+
+	return domainErrors.ErrShareLinkNotFound
+}
+
+func (u *shareLinkUsecase) GetSharedItem(ctx context.Context, token string) (*entity.SharedItem, error) {
+	link, err := u.shareLinkRepo.FindByTokenHash(ctx, entity.HashShareToken(token))
+	if err != nil {
+		if domainErrors.IsShareLinkNotFoundError(err) {
+			return nil, domainErrors.ErrShareLinkNotFound
+		}
+		return nil, fmt.Errorf("failed to retrieve share link: %w", err)
+	}
+	if !link.IsActive(time.Now()) {
+		return nil, domainErrors.ErrShareLinkNotFound
+	}
+
+	item, err := u.itemRepo.FindByID(ctx, link.ItemID)
+	if err != nil {
+		if domainErrors.IsNotFoundError(err) {
+			return nil, domainErrors.ErrShareLinkNotFound
+		}
+		return nil, fmt.Errorf("failed to retrieve item: %w", err)
+	}
+
+	return link.View(item), nil
+}
+
+func (u *shareLinkUsecase) ensureItemExists(ctx context.Context, itemID int64) error {
+	if itemID <= 0 {
+		return domainErrors.ErrInvalidInput
+	}
+
+	if _, err := u.itemRepo.FindByID(ctx, itemID); err != nil {
+		if domainErrors.IsNotFoundError(err) {
+			return domainErrors.ErrItemNotFound
+		}
+		return fmt.Errorf("failed to retrieve item: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,202 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"Aicon-assignment/internal/domain/entity"
+	domainErrors "Aicon-assignment/internal/domain/errors"
+)
+
+// MockShareLinkRepository はtestify/mockを使用したモックリポジトリ
+type MockShareLinkRepository struct {
+	mock.Mock
+}
+
+func (m *MockShareLinkRepository) FindByItemID(ctx context.Context, itemID int64) ([]*entity.ShareLink, error) {
+	args := m.Called(ctx, itemID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.ShareLink), args.Error(1)
+}
+
+func (m *MockShareLinkRepository) FindByTokenHash(ctx context.Context, tokenHash string) (*entity.ShareLink, error) {
+	args := m.Called(ctx, tokenHash)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.ShareLink), args.Error(1)
+}
+
+func (m *MockShareLinkRepository) Create(ctx context.Context, link *entity.ShareLink) (*entity.ShareLink, error) {
+	args := m.Called(ctx, link)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.ShareLink), args.Error(1)
+}
+
+func (m *MockShareLinkRepository) Revoke(ctx context.Context, itemID, id int64) error {
+	args := m.Called(ctx, itemID, id)
+	return args.Error(0)
+}
+
+func TestShareLinkUsecase_CreateShareLink(t *testing.T) {
+	t.Run("正常系: トークンを返し、ハッシュだけを保存する", func(t *testing.T) {
+		itemRepo := new(MockItemRepository)
+		itemRepo.On("FindByID", mock.Anything, int64(1)).Return(&entity.Item{ID: 1}, nil)
+		shareLinkRepo := new(MockShareLinkRepository)
+		var saved *entity.ShareLink
+		shareLinkRepo.On("Create", mock.Anything, mock.AnythingOfType("*entity.ShareLink")).
+			Run(func(args mock.Arguments) {
+				saved = args.Get(1).(*entity.ShareLink)
+				saved.ID = 5
+			}).
+			Return(&entity.ShareLink{ID: 5}, nil)
+		usecase := NewShareLinkUsecase(itemRepo, shareLinkRepo)
+
+		created, err := usecase.CreateShareLink(context.Background(), 1, CreateShareLinkInput{})
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(5), created.ID)
+		assert.NotEmpty(t, created.Token)
+		assert.Equal(t, entity.HashShareToken(created.Token), saved.TokenHash)
+	})
+
+	t.Run("異常系: 公開できない項目", func(t *testing.T) {
+		itemRepo := new(MockItemRepository)
+		itemRepo.On("FindByID", mock.Anything, int64(1)).Return(&entity.Item{ID: 1}, nil)
+		shareLinkRepo := new(MockShareLinkRepository)
+		usecase := NewShareLinkUsecase(itemRepo, shareLinkRepo)
+
+		_, err := usecase.CreateShareLink(context.Background(), 1, CreateShareLinkInput{Fields: []string{"serial_number"}})
+
+		assert.ErrorIs(t, err, domainErrors.ErrInvalidInput)
+		shareLinkRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("異常系: アイテムが存在しない", func(t *testing.T) {
+		itemRepo := new(MockItemRepository)
+		itemRepo.On("FindByID", mock.Anything, int64(999)).Return(nil, domainErrors.ErrItemNotFound)
+		usecase := NewShareLinkUsecase(itemRepo, new(MockShareLinkRepository))
+
+		_, err := usecase.CreateShareLink(context.Background(), 999, CreateShareLinkInput{})
+
+		assert.ErrorIs(t, err, domainErrors.ErrItemNotFound)
+	})
+}
+
+func TestShareLinkUsecase_RevokeShareLink(t *testing.T) {
+	revokedAt := time.Now()
+
+	tests := []struct {
+		name       string
+		links      []*entity.ShareLink
+		wantRevoke bool
+		wantErr    error
+	}{
+		{name: "正常系: 取り消す", links: []*entity.ShareLink{{ID: 2, ItemID: 1}}, wantRevoke: true},
+		{name: "正常系: 取り消し済みでもエラーにしない", links: []*entity.ShareLink{{ID: 2, ItemID: 1, RevokedAt: &revokedAt}}},
+		{name: "異常系: 他のアイテムのリンク", links: []*entity.ShareLink{}, wantErr: domainErrors.ErrShareLinkNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			itemRepo := new(MockItemRepository)
+			itemRepo.On("FindByID", mock.Anything, int64(1)).Return(&entity.Item{ID: 1}, nil)
+			shareLinkRepo := new(MockShareLinkRepository)
+			shareLinkRepo.On("FindByItemID", mock.Anything, int64(1)).Return(tt.links, nil)
+			shareLinkRepo.On("Revoke", mock.Anything, int64(1), int64(2)).Return(nil)
+			usecase := NewShareLinkUsecase(itemRepo, shareLinkRepo)
+
+			err := usecase.RevokeShareLink(context.Background(), 1, 2)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			if tt.wantRevoke {
+				shareLinkRepo.AssertCalled(t, "Revoke", mock.Anything, int64(1), int64(2))
+			} else {
+				shareLinkRepo.AssertNotCalled(t, "Revoke", mock.Anything, mock.Anything, mock.Anything)
+			}
+		})
+	}
+}
+
+func TestShareLinkUsecase_GetSharedItem(t *testing.T) {
+	const token = "share-token"
+	revokedAt := time.Now().Add(-time.Minute)
+	item := &entity.Item{ID: 1, Name: "ロレックス デイトナ", Brand: "ROLEX", PurchasePrice: 1500000}
+
+	tests := []struct {
+		name     string
+		link     *entity.ShareLink
+		findErr  error
+		itemErr  error
+		wantName string
+		wantErr  error
+	}{
+		{
+			name:     "正常系: 選んだ項目だけを返す",
+			link:     &entity.ShareLink{ItemID: 1, Fields: []string{"name"}, ExpiresAt: time.Now().Add(time.Hour)},
+			wantName: "ロレックス デイトナ",
+		},
+		{
+			name:    "異常系: 期限切れ",
+			link:    &entity.ShareLink{ItemID: 1, Fields: []string{"name"}, ExpiresAt: time.Now().Add(-time.Hour)},
+			wantErr: domainErrors.ErrShareLinkNotFound,
+		},
+		{
+			name:    "異常系: 取り消し済み",
+			link:    &entity.ShareLink{ItemID: 1, Fields: []string{"name"}, ExpiresAt: time.Now().Add(time.Hour), RevokedAt: &revokedAt},
+			wantErr: domainErrors.ErrShareLinkNotFound,
+		},
+		{
+			name:    "異常系: アイテムが削除された",
+			link:    &entity.ShareLink{ItemID: 1, Fields: []string{"name"}, ExpiresAt: time.Now().Add(time.Hour)},
+			itemErr: domainErrors.ErrItemNotFound,
+			wantErr: domainErrors.ErrShareLinkNotFound,
+		},
+		{
+			name:    "異常系: 知らないトークン",
+			findErr: domainErrors.ErrShareLinkNotFound,
+			wantErr: domainErrors.ErrShareLinkNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			itemRepo := new(MockItemRepository)
+			if tt.itemErr != nil {
+				itemRepo.On("FindByID", mock.Anything, int64(1)).Return(nil, tt.itemErr)
+			} else {
+				itemRepo.On("FindByID", mock.Anything, int64(1)).Return(item, nil)
+			}
+			shareLinkRepo := new(MockShareLinkRepository)
+			if tt.findErr != nil {
+				shareLinkRepo.On("FindByTokenHash", mock.Anything, entity.HashShareToken(token)).Return(nil, tt.findErr)
+			} else {
+				shareLinkRepo.On("FindByTokenHash", mock.Anything, entity.HashShareToken(token)).Return(tt.link, nil)
+			}
+			usecase := NewShareLinkUsecase(itemRepo, shareLinkRepo)
+
+			shared, err := usecase.GetSharedItem(context.Background(), token)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantName, shared.Name)
+			assert.Nil(t, shared.PurchasePrice)
+		})
+	}
+}
@@ -0,0 +1,141 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"Aicon-assignment/internal/domain/entity"
+	domainErrors "Aicon-assignment/internal/domain/errors"
+)
+
+type ItemTemplateUsecase interface {
+	GetAllTemplates(ctx context.Context) ([]*entity.ItemTemplate, error)
+	GetTemplateByID(ctx context.Context, id int64) (*entity.ItemTemplate, error)
+	CreateTemplate(ctx context.Context, input CreateTemplateInput) (*entity.ItemTemplate, error)
+	DeleteTemplate(ctx context.Context, id int64) error
+	CreateItemFromTemplate(ctx context.Context, templateID int64, input CreateItemFromTemplateInput) (*entity.Item, error)
+}
+
+type CreateTemplateInput struct {
+	Name          string `json:"name"`
+	Category      string `json:"category"`
+	Brand         string `json:"brand"`
+	PurchasePrice int    `json:"purchase_price"`
+	Condition     string `json:"condition"`
+}
+
+// テンプレートからの登録用の入力構造体（未指定のフィールドはテンプレートの値を使う）
+type CreateItemFromTemplateInput struct {
+	Name          *string           `json:"name,omitempty"`
+	Brand         *string           `json:"brand,omitempty"`
+	PurchasePrice *int              `json:"purchase_price,omitempty"`
+	PurchaseDate  string            `json:"purchase_date"`
+	Condition     *string           `json:"condition,omitempty"`
+	SerialNumber  string            `json:"serial_number"`
+	Attributes    map[string]string `json:"attributes"`
+	ConfirmBrand  bool              `json:"confirm_brand"`
+}
+
+type itemTemplateUsecase struct {
+	templateRepo ItemTemplateRepository
+	itemUsecase  ItemUsecase
+}
+
+func NewItemTemplateUsecase(templateRepo ItemTemplateRepository, itemUsecase ItemUsecase) ItemTemplateUsecase {
+	return &itemTemplateUsecase{
+		templateRepo: templateRepo,
+		itemUsecase:  itemUsecase,
+	}
+}
+
+func (u *itemTemplateUsecase) GetAllTemplates(ctx context.Context) ([]*entity.ItemTemplate, error) {
+	templates, err := u.templateRepo.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve templates: %w", err)
+	}
+
+	return templates, nil
+}
+
+func (u *itemTemplateUsecase) GetTemplateByID(ctx context.Context, id int64) (*entity.ItemTemplate, error) {
+	if id <= 0 {
+		return nil, domainErrors.ErrInvalidInput
+	}
+
+	template, err := u.templateRepo.FindByID(ctx, id)
+	if err != nil {
+		if domainErrors.IsTemplateNotFoundError(err) {
+			return nil, domainErrors.ErrTemplateNotFound
+		}
+		return nil, fmt.Errorf("failed to retrieve template: %w", err)
+	}
+
+	return template, nil
+}
+
+func (u *itemTemplateUsecase) CreateTemplate(ctx context.Context, input CreateTemplateInput) (*entity.ItemTemplate, error) {
+	template, err := entity.NewItemTemplate(
+		input.Name,
+		input.Category,
+		input.Brand,
+		input.PurchasePrice,
+		input.Condition,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", domainErrors.ErrInvalidInput, err.Error())
+	}
+
+	createdTemplate, err := u.templateRepo.Create(ctx, template)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create template: %w", err)
+	}
+
+	return createdTemplate, nil
+}
+
+func (u *itemTemplateUsecase) DeleteTemplate(ctx context.Context, id int64) error {
+	if _, err := u.GetTemplateByID(ctx, id); err != nil {
+		return err
+	}
+
+	if err := u.templateRepo.Delete(ctx, id); err != nil {
+		return fmt.Errorf("failed to delete template: %w", err)
+	}
+
+	return nil
+}
+
+func (u *itemTemplateUsecase) CreateItemFromTemplate(ctx context.Context, templateID int64, input CreateItemFromTemplateInput) (*entity.Item, error) {
+	template, err := u.GetTemplateByID(ctx, templateID)
+	if err != nil {
+		return nil, err
+	}
+
+	createInput := CreateItemInput{
+		Name:          template.Name,
+		Category:      template.Category,
+		Brand:         template.Brand,
+		PurchasePrice: template.PurchasePrice,
+		PurchaseDate:  input.PurchaseDate,
+		Condition:     template.Condition,
+		SerialNumber:  input.SerialNumber,
+		Attributes:    input.Attributes,
+		ConfirmBrand:  input.ConfirmBrand,
+	}
+
+	if input.Name != nil {
+		createInput.Name = *input.Name
+	}
+	if input.Brand != nil {
+		createInput.Brand = *input.Brand
+	}
+	if input.PurchasePrice != nil {
+		createInput.PurchasePrice = *input.PurchasePrice
+	}
+	if input.Condition != nil {
+		createInput.Condition = *input.Condition
+	}
+
+	// バリデーションやシリアル番号の重複チェックは通常の登録と共通
+	return u.itemUsecase.CreateItem(ctx, createInput)
+}
@@ -0,0 +1,115 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"Aicon-assignment/internal/domain/entity"
+	domainErrors "Aicon-assignment/internal/domain/errors"
+)
+
+// MockItemTemplateRepository はtestify/mockを使用したモックリポジトリ
+type MockItemTemplateRepository struct {
+	mock.Mock
+}
+
+func (m *MockItemTemplateRepository) FindAll(ctx context.Context) ([]*entity.ItemTemplate, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.ItemTemplate), args.Error(1)
+}
+
+func (m *MockItemTemplateRepository) FindByID(ctx context.Context, id int64) (*entity.ItemTemplate, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.ItemTemplate), args.Error(1)
+}
+
+func (m *MockItemTemplateRepository) Create(ctx context.Context, template *entity.ItemTemplate) (*entity.ItemTemplate, error) {
+	args := m.Called(ctx, template)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.ItemTemplate), args.Error(1)
+}
+
+func (m *MockItemTemplateRepository) Delete(ctx context.Context, id int64) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func TestItemTemplateUsecase_CreateItemFromTemplate(t *testing.T) {
+	template, _ := entity.NewItemTemplate("ロレックス サブマリーナ", "時計", "ROLEX", 1200000, "N")
+	template.ID = 1
+
+	t.Run("正常系: テンプレートの値と差分を組み合わせて登録", func(t *testing.T) {
+		templateRepo := new(MockItemTemplateRepository)
+		itemRepo := new(MockItemRepository)
+		templateRepo.On("FindByID", mock.Anything, int64(1)).Return(template, nil)
+		itemRepo.On("FindBrands", mock.Anything).Return([]string{"ROLEX"}, nil)
+		itemRepo.On("FindBySerialNumber", mock.Anything, "SUB-0001").Return(nil, domainErrors.ErrItemNotFound)
+		itemRepo.On("Create", mock.Anything, mock.MatchedBy(func(item *entity.Item) bool {
+			return item.Name == "ロレックス サブマリーナ" &&
+				item.Category == "時計" &&
+				item.Brand == "ROLEX" &&
+				item.PurchasePrice == 1300000 &&
+				item.PurchaseDate == "2023-08-01" &&
+				item.Condition == "N" &&
+				item.SerialNumber == "SUB-0001"
+		})).Return(&entity.Item{ID: 10}, nil)
+
+		usecase := NewItemTemplateUsecase(templateRepo, NewItemUsecase(itemRepo, &MockTransactor{repos: Repositories{Items: itemRepo}}))
+		item, err := usecase.CreateItemFromTemplate(context.Background(), 1, CreateItemFromTemplateInput{
+			PurchasePrice: intPtr(1300000),
+			PurchaseDate:  "2023-08-01",
+			SerialNumber:  "SUB-0001",
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(10), item.ID)
+		templateRepo.AssertExpectations(t)
+		itemRepo.AssertExpectations(t)
+	})
+
+	t.Run("異常系: 存在しないテンプレート", func(t *testing.T) {
+		templateRepo := new(MockItemTemplateRepository)
+		templateRepo.On("FindByID", mock.Anything, int64(999)).Return(nil, domainErrors.ErrTemplateNotFound)
+
+		usecase := NewItemTemplateUsecase(templateRepo, NewItemUsecase(new(MockItemRepository), &MockTransactor{}))
+		_, err := usecase.CreateItemFromTemplate(context.Background(), 999, CreateItemFromTemplateInput{PurchaseDate: "2023-08-01"})
+
+		assert.ErrorIs(t, err, domainErrors.ErrTemplateNotFound)
+		templateRepo.AssertExpectations(t)
+	})
+
+	t.Run("異常系: 購入日が不正", func(t *testing.T) {
+		templateRepo := new(MockItemTemplateRepository)
+		templateRepo.On("FindByID", mock.Anything, int64(1)).Return(template, nil)
+
+		usecase := NewItemTemplateUsecase(templateRepo, NewItemUsecase(new(MockItemRepository), &MockTransactor{}))
+		_, err := usecase.CreateItemFromTemplate(context.Background(), 1, CreateItemFromTemplateInput{PurchaseDate: "2023/08/01"})
+
+		assert.ErrorIs(t, err, domainErrors.ErrInvalidInput)
+	})
+}
+
+func TestItemTemplateUsecase_CreateTemplate(t *testing.T) {
+	t.Run("異常系: 無効なカテゴリー", func(t *testing.T) {
+		usecase := NewItemTemplateUsecase(new(MockItemTemplateRepository), NewItemUsecase(new(MockItemRepository), &MockTransactor{}))
+		_, err := usecase.CreateTemplate(context.Background(), CreateTemplateInput{
+			Name:     "テンプレート",
+			Category: "無効なカテゴリー",
+			Brand:    "ROLEX",
+		})
+
+		assert.ErrorIs(t, err, domainErrors.ErrInvalidInput)
+	})
+}
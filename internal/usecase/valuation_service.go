@@ -0,0 +1,146 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"Aicon-assignment/internal/domain/entity"
+	domainErrors "Aicon-assignment/internal/domain/errors"
+)
+
+type ItemValuationUsecase interface {
+	// 絞り込み条件に合うアイテムの評価額を input の調整方法で一括して変更する
+	BulkRevalue(ctx context.Context, input BulkRevalueInput) (*BulkRevalueResult, error)
+	GetValuations(ctx context.Context, itemID int64) ([]*entity.ItemValuation, error)
+}
+
+// 一括再評価の入力（percentage か amount のどちらか一方を指定する）
+type BulkRevalueInput struct {
+	Filter RevalueFilter `json:"filter"`
+	entity.ValueAdjustment
+}
+
+// 一括再評価の対象の絞り込み条件（一覧の絞り込みと同じ意味）
+type RevalueFilter struct {
+	LocationID      *int64            `json:"location_id,omitempty"`
+	Category        string            `json:"category,omitempty"`
+	Brand           string            `json:"brand,omitempty"`
+	Condition       string            `json:"condition,omitempty"`
+	Attributes      map[string]string `json:"attributes,omitempty"`
+	Favorites       bool              `json:"favorites,omitempty"`
+	IncludeArchived bool              `json:"include_archived,omitempty"`
+
+	// お気に入りで絞り込む閲覧者（リクエストから決めるため、本文では受け付けない）
+	Viewer string `json:"-"`
+}
+
+func (f RevalueFilter) itemFilter() entity.ItemFilter {
+	return entity.ItemFilter{
+		LocationID:      f.LocationID,
+		Category:        f.Category,
+		Brand:           f.Brand,
+		Condition:       f.Condition,
+		Attributes:      f.Attributes,
+		Favorites:       f.Favorites,
+		Viewer:          f.Viewer,
+		IncludeArchived: f.IncludeArchived,
+	}
+}
+
+// 誤って全アイテムを変更しないよう、アーカイブ以外の条件を1つ以上求める
+func (f RevalueFilter) isEmpty() bool {
+	return f.LocationID == nil && f.Category == "" && f.Brand == "" && f.Condition == "" && len(f.Attributes) == 0 && !f.Favorites
+}
+
+// 一括再評価の結果（変更したアイテムごとの評価額の記録）
+type BulkRevalueResult struct {
+	Affected   int                     `json:"affected"`
+	Valuations []*entity.ItemValuation `json:"valuations"`
+}
+
+type itemValuationUsecase struct {
+	itemRepo      ItemRepository
+	valuationRepo ItemValuationRepository
+	transactor    Transactor
+}
+
+func NewItemValuationUsecase(itemRepo ItemRepository, valuationRepo ItemValuationRepository, transactor Transactor) ItemValuationUsecase {
+	return &itemValuationUsecase{
+		itemRepo:      itemRepo,
+		valuationRepo: valuationRepo,
+		transactor:    transactor,
+	}
+}
+
+// 未評価のアイテムは購入価格を基準に調整する
+// 評価額の更新と変更の記録は全件で1つのトランザクションにし、途中で失敗した場合はどのアイテムも変更しない
+func (u *itemValuationUsecase) BulkRevalue(ctx context.Context, input BulkRevalueInput) (*BulkRevalueResult, error) {
+	if err := input.ValueAdjustment.Validate(); err != nil {
+		var fieldErrors entity.FieldErrors
+		if errors.As(err, &fieldErrors) {
+			return nil, fmt.Errorf("%w: %w", domainErrors.ErrInvalidInput, err)
+		}
+		return nil, err
+	}
+
+	if input.Filter.isEmpty() {
+		return nil, fmt.Errorf("%w: %w", domainErrors.ErrInvalidInput, entity.FieldErrors{
+			{Field: "filter", Rule: "required", Message: "filter must have at least one condition"},
+		})
+	}
+
+	filter := input.Filter.itemFilter()
+	if err := filter.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %s", domainErrors.ErrInvalidInput, err.Error())
+	}
+
+	result := &BulkRevalueResult{Valuations: []*entity.ItemValuation{}}
+	err := u.transactor.WithTx(ctx, func(repos Repositories) error {
+		items, err := repos.Items.FindAll(ctx, filter)
+		if err != nil {
+			return fmt.Errorf("failed to retrieve items: %w", err)
+		}
+
+		for _, item := range items {
+			valuation := item.Revalue(input.Apply(item.CurrentValueOrPurchasePrice()))
+
+			if err := repos.Items.Update(ctx, item); err != nil {
+				return fmt.Errorf("failed to update item %d: %w", item.ID, err)
+			}
+
+			created, err := repos.Valuations.Create(ctx, valuation)
+			if err != nil {
+				return fmt.Errorf("failed to record valuation of item %d: %w", item.ID, err)
+			}
+			result.Valuations = append(result.Valuations, created)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result.Affected = len(result.Valuations)
+	return result, nil
+}
+
+func (u *itemValuationUsecase) GetValuations(ctx context.Context, itemID int64) ([]*entity.ItemValuation, error) {
+	if itemID <= 0 {
+		return nil, domainErrors.ErrInvalidInput
+	}
+
+	if _, err := u.itemRepo.FindByID(ctx, itemID); err != nil {
+		if domainErrors.IsNotFoundError(err) {
+			return nil, domainErrors.ErrItemNotFound
+		}
+		return nil, fmt.Errorf("failed to retrieve item: %w", err)
+	}
+
+	valuations, err := u.valuationRepo.FindByItemID(ctx, itemID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve valuations: %w", err)
+	}
+
+	return valuations, nil
+}
@@ -0,0 +1,151 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"Aicon-assignment/internal/domain/entity"
+	domainErrors "Aicon-assignment/internal/domain/errors"
+)
+
+// MockItemValuationRepository はtestify/mockを使用したモックリポジトリ
+type MockItemValuationRepository struct {
+	mock.Mock
+}
+
+func (m *MockItemValuationRepository) FindByItemID(ctx context.Context, itemID int64) ([]*entity.ItemValuation, error) {
+	args := m.Called(ctx, itemID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.ItemValuation), args.Error(1)
+}
+
+func (m *MockItemValuationRepository) Create(ctx context.Context, valuation *entity.ItemValuation) (*entity.ItemValuation, error) {
+	args := m.Called(ctx, valuation)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.ItemValuation), args.Error(1)
+}
+
+func TestItemValuationUsecase_BulkRevalue(t *testing.T) {
+	percentage := func(v float64) *float64 { return &v }
+	amount := func(v int) *int { return &v }
+
+	t.Run("正常系: 割合で調整し、未評価のアイテムは購入価格を基準にする", func(t *testing.T) {
+		currentValue := 1000000
+		itemRepo := new(MockItemRepository)
+		itemRepo.On("FindAll", mock.Anything, entity.ItemFilter{Brand: "ROLEX"}).Return([]*entity.Item{
+			{ID: 1, Brand: "ROLEX", PurchasePrice: 1500000},
+			{ID: 2, Brand: "ROLEX", PurchasePrice: 800000, CurrentValue: &currentValue},
+		}, nil)
+		itemRepo.On("Update", mock.Anything, mock.AnythingOfType("*entity.Item")).Return(nil)
+		valuationRepo := new(MockItemValuationRepository)
+		var saved []*entity.ItemValuation
+		valuationRepo.On("Create", mock.Anything, mock.AnythingOfType("*entity.ItemValuation")).
+			Run(func(args mock.Arguments) { saved = append(saved, args.Get(1).(*entity.ItemValuation)) }).
+			Return(&entity.ItemValuation{ID: 1}, nil)
+
+		usecase := NewItemValuationUsecase(itemRepo, valuationRepo, &MockTransactor{repos: Repositories{Items: itemRepo, Valuations: valuationRepo}})
+		got, err := usecase.BulkRevalue(context.Background(), BulkRevalueInput{
+			Filter:          RevalueFilter{Brand: "ROLEX"},
+			ValueAdjustment: entity.ValueAdjustment{Percentage: percentage(8)},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, 2, got.Affected)
+		require.Len(t, saved, 2)
+		assert.Equal(t, 1500000, saved[0].PreviousValue)
+		assert.Equal(t, 1620000, saved[0].Value)
+		assert.Equal(t, 1000000, saved[1].PreviousValue)
+		assert.Equal(t, 1080000, saved[1].Value)
+		itemRepo.AssertNumberOfCalls(t, "Update", 2)
+	})
+
+	t.Run("正常系: 金額で下げても0円を下回らない", func(t *testing.T) {
+		itemRepo := new(MockItemRepository)
+		itemRepo.On("FindAll", mock.Anything, entity.ItemFilter{Category: "バッグ"}).Return([]*entity.Item{
+			{ID: 3, Category: "バッグ", PurchasePrice: 10000},
+		}, nil)
+		var updated *entity.Item
+		itemRepo.On("Update", mock.Anything, mock.AnythingOfType("*entity.Item")).
+			Run(func(args mock.Arguments) { updated = args.Get(1).(*entity.Item) }).
+			Return(nil)
+		valuationRepo := new(MockItemValuationRepository)
+		var saved []*entity.ItemValuation
+		valuationRepo.On("Create", mock.Anything, mock.AnythingOfType("*entity.ItemValuation")).
+			Run(func(args mock.Arguments) { saved = append(saved, args.Get(1).(*entity.ItemValuation)) }).
+			Return(&entity.ItemValuation{ID: 1}, nil)
+
+		usecase := NewItemValuationUsecase(itemRepo, valuationRepo, &MockTransactor{repos: Repositories{Items: itemRepo, Valuations: valuationRepo}})
+		_, err := usecase.BulkRevalue(context.Background(), BulkRevalueInput{
+			Filter:          RevalueFilter{Category: "バッグ"},
+			ValueAdjustment: entity.ValueAdjustment{Amount: amount(-20000)},
+		})
+
+		require.NoError(t, err)
+		require.Len(t, saved, 1)
+		assert.Equal(t, 0, saved[0].Value)
+		require.NotNil(t, updated.CurrentValue)
+		assert.Equal(t, 0, *updated.CurrentValue)
+	})
+
+	t.Run("異常系: 入力の誤り", func(t *testing.T) {
+		tests := []struct {
+			name  string
+			input BulkRevalueInput
+		}{
+			{"絞り込み条件がない", BulkRevalueInput{Filter: RevalueFilter{IncludeArchived: true}, ValueAdjustment: entity.ValueAdjustment{Percentage: percentage(8)}}},
+			{"調整方法がない", BulkRevalueInput{Filter: RevalueFilter{Brand: "ROLEX"}}},
+			{"割合と金額の両方", BulkRevalueInput{Filter: RevalueFilter{Brand: "ROLEX"}, ValueAdjustment: entity.ValueAdjustment{Percentage: percentage(8), Amount: amount(1000)}}},
+			{"-100%以下", BulkRevalueInput{Filter: RevalueFilter{Brand: "ROLEX"}, ValueAdjustment: entity.ValueAdjustment{Percentage: percentage(-100)}}},
+			{"不正なコンディション", BulkRevalueInput{Filter: RevalueFilter{Condition: "X"}, ValueAdjustment: entity.ValueAdjustment{Amount: amount(1000)}}},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				itemRepo := new(MockItemRepository)
+				usecase := NewItemValuationUsecase(itemRepo, new(MockItemValuationRepository), &MockTransactor{repos: Repositories{Items: itemRepo}})
+
+				_, err := usecase.BulkRevalue(context.Background(), tt.input)
+
+				assert.True(t, domainErrors.IsValidationError(err))
+				itemRepo.AssertNotCalled(t, "FindAll", mock.Anything, mock.Anything)
+			})
+		}
+	})
+
+	t.Run("異常系: 記録に失敗した場合はエラーを返す", func(t *testing.T) {
+		itemRepo := new(MockItemRepository)
+		itemRepo.On("FindAll", mock.Anything, entity.ItemFilter{Brand: "ROLEX"}).Return([]*entity.Item{{ID: 1, PurchasePrice: 1000}}, nil)
+		itemRepo.On("Update", mock.Anything, mock.AnythingOfType("*entity.Item")).Return(nil)
+		valuationRepo := new(MockItemValuationRepository)
+		valuationRepo.On("Create", mock.Anything, mock.AnythingOfType("*entity.ItemValuation")).Return(nil, domainErrors.ErrDatabaseError)
+
+		usecase := NewItemValuationUsecase(itemRepo, valuationRepo, &MockTransactor{repos: Repositories{Items: itemRepo, Valuations: valuationRepo}})
+		_, err := usecase.BulkRevalue(context.Background(), BulkRevalueInput{
+			Filter:          RevalueFilter{Brand: "ROLEX"},
+			ValueAdjustment: entity.ValueAdjustment{Percentage: percentage(8)},
+		})
+
+		assert.True(t, errors.Is(err, domainErrors.ErrDatabaseError))
+	})
+}
+
+func TestItemValuationUsecase_GetValuations(t *testing.T) {
+	t.Run("異常系: アイテムが存在しない", func(t *testing.T) {
+		itemRepo := new(MockItemRepository)
+		itemRepo.On("FindByID", mock.Anything, int64(9)).Return(nil, domainErrors.ErrItemNotFound)
+
+		usecase := NewItemValuationUsecase(itemRepo, new(MockItemValuationRepository), &MockTransactor{})
+		_, err := usecase.GetValuations(context.Background(), 9)
+
+		assert.ErrorIs(t, err, domainErrors.ErrItemNotFound)
+	})
+}
@@ -0,0 +1,212 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"Aicon-assignment/internal/domain/entity"
+	domainErrors "Aicon-assignment/internal/domain/errors"
+	"Aicon-assignment/internal/domain/event"
+)
+
+type WebhookUsecase interface {
+	HandleEvent(ctx context.Context, e event.Event)
+	GetAllWebhooks(ctx context.Context) ([]*entity.Webhook, error)
+	RegisterWebhook(ctx context.Context, input RegisterWebhookInput) (*entity.Webhook, error)
+	DeleteWebhook(ctx context.Context, id int64) error
+	GetDeliveries(ctx context.Context, webhookID int64) ([]*entity.WebhookDelivery, error)
+	// ジョブキューから呼ばれ、1件のWebhookへ1回送信する（entity.JobWebhookDelivery の処理）
+	Deliver(ctx context.Context, job *entity.Job) error
+}
+
+type RegisterWebhookInput struct {
+	URL        string   `json:"url"`
+	Secret     string   `json:"secret"`
+	EventTypes []string `json:"event_types"`
+}
+
+// 署名付きでペイロードを送信し、HTTPステータスコードを返す
+type WebhookSender interface {
+	Send(ctx context.Context, webhook *entity.Webhook, eventType string, payload []byte) (int, error)
+	// 送信先に登録してよいURLかを確かめる（サーバー自身や内部のネットワークを指すURLは拒否する）
+	CheckURL(ctx context.Context, rawURL string) error
+}
+
+// Webhookで送るボディ
+type WebhookPayload struct {
+	Event      string       `json:"event"`
+	OccurredAt time.Time    `json:"occurred_at"`
+	Data       *entity.Item `json:"data"`
+}
+
+// Webhookの送信ジョブの内容（ペイロードはイベントの発生時点のもの）
+type webhookDeliveryJob struct {
+	WebhookID int64           `json:"webhook_id"`
+	EventType string          `json:"event_type"`
+	Payload   json.RawMessage `json:"payload"`
+}
+
+type webhookUsecase struct {
+	webhookRepo WebhookRepository
+	sender      WebhookSender
+	jobs        JobEnqueuer
+}
+
+func NewWebhookUsecase(webhookRepo WebhookRepository, sender WebhookSender, jobs JobEnqueuer) WebhookUsecase {
+	return &webhookUsecase{
+		webhookRepo: webhookRepo,
+		sender:      sender,
+		jobs:        jobs,
+	}
+}
+
+func (u *webhookUsecase) GetAllWebhooks(ctx context.Context) ([]*entity.Webhook, error) {
+	webhooks, err := u.webhookRepo.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve webhooks: %w", err)
+	}
+
+	return webhooks, nil
+}
+
+func (u *webhookUsecase) RegisterWebhook(ctx context.Context, input RegisterWebhookInput) (*entity.Webhook, error) {
+	webhook, err := entity.NewWebhook(input.URL, input.Secret, input.EventTypes)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", domainErrors.ErrInvalidInput, err.Error())
+	}
+	if err := u.sender.CheckURL(ctx, webhook.URL); err != nil {
+		return nil, fmt.Errorf("%w: %s", domainErrors.ErrInvalidInput, err.Error())
+	}
+
+	createdWebhook, err := u.webhookRepo.Create(ctx, webhook)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create webhook: %w", err)
+	}
+
+	return createdWebhook, nil
+}
+
+func (u *webhookUsecase) DeleteWebhook(ctx context.Context, id int64) error {
+	if id <= 0 {
+		return domainErrors.ErrInvalidInput
+	}
+
+	if _, err := u.webhookRepo.FindByID(ctx, id); err != nil {
+		if domainErrors.IsWebhookNotFoundError(err) {
+			return domainErrors.ErrWebhookNotFound
+		}
+		return fmt.Errorf("failed to check webhook existence: %w", err)
+	}
+
+	if err := u.webhookRepo.Delete(ctx, id); err != nil {
+		return fmt.Errorf("failed to delete webhook: %w", err)
+	}
+
+	return nil
+}
+
+func (u *webhookUsecase) GetDeliveries(ctx context.Context, webhookID int64) ([]*entity.WebhookDelivery, error) {
+	if webhookID <= 0 {
+		return nil, domainErrors.ErrInvalidInput
+	}
+
+	if _, err := u.webhookRepo.FindByID(ctx, webhookID); err != nil {
+		if domainErrors.IsWebhookNotFoundError(err) {
+			return nil, domainErrors.ErrWebhookNotFound
+		}
+		return nil, fmt.Errorf("failed to retrieve webhook: %w", err)
+	}
+
+	deliveries, err := u.webhookRepo.FindDeliveriesByWebhookID(ctx, webhookID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve deliveries: %w", err)
+	}
+
+	return deliveries, nil
+}
+
+// アイテムのイベントを購読しているWebhookごとに送信のジョブを登録する（アイテムの操作自体は待たせない）
+// 再送はジョブキューが行うため、停止や再起動をまたいでも送信される
+func (u *webhookUsecase) HandleEvent(ctx context.Context, e event.Event) {
+	itemEvent, ok := e.(event.ItemEvent)
+	if !ok {
+		return
+	}
+	eventType := e.Name()
+
+	// リクエストが終わってもキャンセルされないようにする
+	ctx = context.WithoutCancel(ctx)
+
+	webhooks, err := u.webhookRepo.FindAll(ctx)
+	if err != nil {
+		log.Printf("failed to retrieve webhooks for %s: %v", eventType, err)
+		return
+	}
+
+	payload, err := json.Marshal(WebhookPayload{
+		Event:      eventType,
+		OccurredAt: e.OccurredAt(),
+		Data:       itemEvent.Item(),
+	})
+	if err != nil {
+		log.Printf("failed to encode webhook payload for %s: %v", eventType, err)
+		return
+	}
+
+	for _, webhook := range webhooks {
+		if !webhook.Subscribes(eventType) {
+			continue
+		}
+		job := webhookDeliveryJob{WebhookID: webhook.ID, EventType: eventType, Payload: payload}
+		if _, err := u.jobs.Enqueue(ctx, entity.JobWebhookDelivery, job); err != nil {
+			log.Printf("failed to enqueue %s for webhook %d: %v", eventType, webhook.ID, err)
+		}
+	}
+}
+
+// 1回送信して送信ログに残し、2xx以外はエラーを返してジョブキューに再送させる
+func (u *webhookUsecase) Deliver(ctx context.Context, job *entity.Job) error {
+	var delivery webhookDeliveryJob
+	if err := json.Unmarshal([]byte(job.Payload), &delivery); err != nil {
+		return fmt.Errorf("invalid webhook delivery job: %w", err)
+	}
+
+	webhook, err := u.webhookRepo.FindByID(ctx, delivery.WebhookID)
+	if err != nil {
+		// 送信を待つ間に削除されたWebhookには送らない
+		if domainErrors.IsWebhookNotFoundError(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to retrieve webhook: %w", err)
+	}
+
+	statusCode, err := u.sender.Send(ctx, webhook, delivery.EventType, delivery.Payload)
+
+	record := &entity.WebhookDelivery{
+		WebhookID:   webhook.ID,
+		EventType:   delivery.EventType,
+		Payload:     string(delivery.Payload),
+		Attempt:     job.Attempts,
+		StatusCode:  statusCode,
+		Success:     err == nil && statusCode >= 200 && statusCode < 300,
+		DeliveredAt: time.Now(),
+	}
+	if err != nil {
+		record.ErrorMessage = err.Error()
+	} else if !record.Success {
+		record.ErrorMessage = fmt.Sprintf("unexpected status code %d", statusCode)
+	}
+
+	if _, err := u.webhookRepo.CreateDelivery(ctx, record); err != nil {
+		log.Printf("failed to record webhook delivery %d: %v", webhook.ID, err)
+	}
+
+	if !record.Success {
+		return errors.New(record.ErrorMessage)
+	}
+	return nil
+}
@@ -0,0 +1,284 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"Aicon-assignment/internal/domain/entity"
+	domainErrors "Aicon-assignment/internal/domain/errors"
+	"Aicon-assignment/internal/domain/event"
+)
+
+// MockWebhookRepository はtestify/mockを使用したモックリポジトリ
+type MockWebhookRepository struct {
+	mock.Mock
+}
+
+func (m *MockWebhookRepository) FindAll(ctx context.Context) ([]*entity.Webhook, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.Webhook), args.Error(1)
+}
+
+func (m *MockWebhookRepository) FindByID(ctx context.Context, id int64) (*entity.Webhook, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.Webhook), args.Error(1)
+}
+
+func (m *MockWebhookRepository) Create(ctx context.Context, webhook *entity.Webhook) (*entity.Webhook, error) {
+	args := m.Called(ctx, webhook)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.Webhook), args.Error(1)
+}
+
+func (m *MockWebhookRepository) Delete(ctx context.Context, id int64) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockWebhookRepository) CreateDelivery(ctx context.Context, delivery *entity.WebhookDelivery) (*entity.WebhookDelivery, error) {
+	args := m.Called(ctx, delivery)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.WebhookDelivery), args.Error(1)
+}
+
+func (m *MockWebhookRepository) FindDeliveriesByWebhookID(ctx context.Context, webhookID int64) ([]*entity.WebhookDelivery, error) {
+	args := m.Called(ctx, webhookID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.WebhookDelivery), args.Error(1)
+}
+
+// MockWebhookSender はtestify/mockを使用したモック送信
+type MockWebhookSender struct {
+	mock.Mock
+}
+
+func (m *MockWebhookSender) Send(ctx context.Context, webhook *entity.Webhook, eventType string, payload []byte) (int, error) {
+	args := m.Called(ctx, webhook, eventType, payload)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockWebhookSender) CheckURL(ctx context.Context, rawURL string) error {
+	args := m.Called(ctx, rawURL)
+	return args.Error(0)
+}
+
+func TestWebhookUsecase_RegisterWebhook(t *testing.T) {
+	t.Run("正常系: Webhookを登録", func(t *testing.T) {
+		webhookRepo := new(MockWebhookRepository)
+		webhookRepo.On("Create", mock.Anything, mock.MatchedBy(func(webhook *entity.Webhook) bool {
+			return webhook.URL == "https://example.com/hooks/items" &&
+				webhook.Secret == "0123456789abcdef" &&
+				assert.ObjectsAreEqual([]string{"item.created", "item.deleted"}, webhook.EventTypes)
+		})).Return(&entity.Webhook{ID: 1}, nil)
+
+		sender := new(MockWebhookSender)
+		sender.On("CheckURL", mock.Anything, "https://example.com/hooks/items").Return(nil)
+
+		usecase := NewWebhookUsecase(webhookRepo, sender, new(MockJobEnqueuer))
+		webhook, err := usecase.RegisterWebhook(context.Background(), RegisterWebhookInput{
+			URL:        " https://example.com/hooks/items ",
+			Secret:     "0123456789abcdef",
+			EventTypes: []string{"item.created", "item.deleted", "item.created"},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), webhook.ID)
+		webhookRepo.AssertExpectations(t)
+	})
+
+	t.Run("異常系: 送信先がサーバー自身や内部のネットワークを指す", func(t *testing.T) {
+		webhookRepo := new(MockWebhookRepository)
+		sender := new(MockWebhookSender)
+		sender.On("CheckURL", mock.Anything, "http://169.254.169.254/latest/meta-data/").
+			Return(errors.New("url must not point to a loopback, private or link-local address: 169.254.169.254"))
+
+		usecase := NewWebhookUsecase(webhookRepo, sender, new(MockJobEnqueuer))
+		_, err := usecase.RegisterWebhook(context.Background(), RegisterWebhookInput{
+			URL:        "http://169.254.169.254/latest/meta-data/",
+			Secret:     "0123456789abcdef",
+			EventTypes: []string{"item.created"},
+		})
+
+		assert.True(t, domainErrors.IsValidationError(err))
+		webhookRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	tests := []struct {
+		name  string
+		input RegisterWebhookInput
+	}{
+		{
+			name:  "異常系: http(s)以外のURL",
+			input: RegisterWebhookInput{URL: "ftp://example.com", Secret: "0123456789abcdef", EventTypes: []string{"item.created"}},
+		},
+		{
+			name:  "異常系: 短すぎるシークレット",
+			input: RegisterWebhookInput{URL: "https://example.com", Secret: "short", EventTypes: []string{"item.created"}},
+		},
+		{
+			name:  "異常系: 未対応のイベント種別",
+			input: RegisterWebhookInput{URL: "https://example.com", Secret: "0123456789abcdef", EventTypes: []string{"item.viewed"}},
+		},
+		{
+			name:  "異常系: イベント種別の指定なし",
+			input: RegisterWebhookInput{URL: "https://example.com", Secret: "0123456789abcdef"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			usecase := NewWebhookUsecase(new(MockWebhookRepository), new(MockWebhookSender), new(MockJobEnqueuer))
+			_, err := usecase.RegisterWebhook(context.Background(), tt.input)
+
+			assert.True(t, domainErrors.IsValidationError(err))
+		})
+	}
+}
+
+func TestWebhookUsecase_GetDeliveries(t *testing.T) {
+	t.Run("異常系: 存在しないWebhook", func(t *testing.T) {
+		webhookRepo := new(MockWebhookRepository)
+		webhookRepo.On("FindByID", mock.Anything, int64(999)).Return(nil, domainErrors.ErrWebhookNotFound)
+
+		usecase := NewWebhookUsecase(webhookRepo, new(MockWebhookSender), new(MockJobEnqueuer))
+		_, err := usecase.GetDeliveries(context.Background(), 999)
+
+		assert.True(t, domainErrors.IsWebhookNotFoundError(err))
+	})
+}
+
+// MockJobEnqueuer はtestify/mockを使用したジョブ登録のモック
+type MockJobEnqueuer struct {
+	mock.Mock
+}
+
+func (m *MockJobEnqueuer) Enqueue(ctx context.Context, jobType string, payload any) (*entity.Job, error) {
+	args := m.Called(ctx, jobType, payload)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.Job), args.Error(1)
+}
+
+func TestWebhookUsecase_HandleEvent(t *testing.T) {
+	item := &entity.Item{ID: 1, Name: "ロレックス デイトナ"}
+	created := &entity.Webhook{ID: 1, URL: "https://example.com/a", EventTypes: []string{entity.EventItemCreated}}
+	deleted := &entity.Webhook{ID: 2, URL: "https://example.com/b", EventTypes: []string{entity.EventItemDeleted}}
+
+	t.Run("正常系: 購読しているWebhookにのみ送信のジョブを登録", func(t *testing.T) {
+		webhookRepo := new(MockWebhookRepository)
+		jobs := new(MockJobEnqueuer)
+		webhookRepo.On("FindAll", mock.Anything).Return([]*entity.Webhook{created, deleted}, nil)
+		jobs.On("Enqueue", mock.Anything, entity.JobWebhookDelivery, mock.MatchedBy(func(job webhookDeliveryJob) bool {
+			var body WebhookPayload
+			return job.WebhookID == 1 && job.EventType == entity.EventItemCreated &&
+				json.Unmarshal(job.Payload, &body) == nil && body.Data.ID == 1
+		})).Return(&entity.Job{ID: 1}, nil).Once()
+
+		usecase := NewWebhookUsecase(webhookRepo, new(MockWebhookSender), jobs)
+		usecase.HandleEvent(context.Background(), event.NewItemCreated(item))
+
+		webhookRepo.AssertExpectations(t)
+		jobs.AssertExpectations(t)
+	})
+
+	t.Run("正常系: アイテム以外のイベントは無視", func(t *testing.T) {
+		webhookRepo := new(MockWebhookRepository)
+		jobs := new(MockJobEnqueuer)
+
+		usecase := NewWebhookUsecase(webhookRepo, new(MockWebhookSender), jobs)
+		usecase.HandleEvent(context.Background(), otherEvent{})
+
+		webhookRepo.AssertNotCalled(t, "FindAll", mock.Anything)
+		jobs.AssertNotCalled(t, "Enqueue", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestWebhookUsecase_Deliver(t *testing.T) {
+	webhook := &entity.Webhook{ID: 1, URL: "https://example.com/hook", EventTypes: []string{entity.EventItemCreated}}
+	job := &entity.Job{
+		ID:       10,
+		Type:     entity.JobWebhookDelivery,
+		Payload:  `{"webhook_id": 1, "event_type": "item.created", "payload": {"event": "item.created"}}`,
+		Attempts: 2,
+	}
+
+	t.Run("正常系: 送信して試行を記録", func(t *testing.T) {
+		webhookRepo := new(MockWebhookRepository)
+		sender := new(MockWebhookSender)
+		webhookRepo.On("FindByID", mock.Anything, int64(1)).Return(webhook, nil)
+		sender.On("Send", mock.Anything, webhook, entity.EventItemCreated, mock.MatchedBy(func(payload []byte) bool {
+			return string(payload) == `{"event": "item.created"}`
+		})).Return(204, nil).Once()
+		webhookRepo.On("CreateDelivery", mock.Anything, mock.MatchedBy(func(delivery *entity.WebhookDelivery) bool {
+			return delivery.WebhookID == 1 && delivery.Attempt == 2 && delivery.Success
+		})).Return(&entity.WebhookDelivery{ID: 1}, nil).Once()
+
+		usecase := NewWebhookUsecase(webhookRepo, sender, new(MockJobEnqueuer))
+		err := usecase.Deliver(context.Background(), job)
+
+		assert.NoError(t, err)
+		webhookRepo.AssertExpectations(t)
+		sender.AssertExpectations(t)
+	})
+
+	t.Run("異常系: 失敗を記録し、再送させるためにエラーを返す", func(t *testing.T) {
+		tests := []struct {
+			name       string
+			statusCode int
+			sendErr    error
+			wantErr    string
+		}{
+			{"接続できない", 0, errors.New("connection refused"), "connection refused"},
+			{"2xx以外", 500, nil, "unexpected status code 500"},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				webhookRepo := new(MockWebhookRepository)
+				sender := new(MockWebhookSender)
+				webhookRepo.On("FindByID", mock.Anything, int64(1)).Return(webhook, nil)
+				sender.On("Send", mock.Anything, webhook, entity.EventItemCreated, mock.Anything).Return(tt.statusCode, tt.sendErr)
+				webhookRepo.On("CreateDelivery", mock.Anything, mock.MatchedBy(func(delivery *entity.WebhookDelivery) bool {
+					return !delivery.Success && delivery.ErrorMessage == tt.wantErr
+				})).Return(&entity.WebhookDelivery{ID: 1}, nil).Once()
+
+				usecase := NewWebhookUsecase(webhookRepo, sender, new(MockJobEnqueuer))
+				err := usecase.Deliver(context.Background(), job)
+
+				assert.EqualError(t, err, tt.wantErr)
+				webhookRepo.AssertExpectations(t)
+			})
+		}
+	})
+
+	t.Run("正常系: 削除されたWebhookには送らない", func(t *testing.T) {
+		webhookRepo := new(MockWebhookRepository)
+		sender := new(MockWebhookSender)
+		webhookRepo.On("FindByID", mock.Anything, int64(1)).Return(nil, domainErrors.ErrWebhookNotFound)
+
+		usecase := NewWebhookUsecase(webhookRepo, sender, new(MockJobEnqueuer))
+		err := usecase.Deliver(context.Background(), job)
+
+		assert.NoError(t, err)
+		sender.AssertNotCalled(t, "Send", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
@@ -0,0 +1,120 @@
+-- Create locations table for storage places (safe, bank vault, display case, ...)
+CREATE TABLE IF NOT EXISTS locations (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    name VARCHAR(100) NOT NULL COMMENT 'Location name',
+    description VARCHAR(255) NOT NULL DEFAULT '' COMMENT 'Location description',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP COMMENT 'Record creation timestamp',
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT 'Record update timestamp'
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='Table for managing storage locations';
+
+-- Create items table for managing valuable items and collections
+CREATE TABLE IF NOT EXISTS items (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    name VARCHAR(100) NOT NULL COMMENT 'Item name',
+    category VARCHAR(50) NOT NULL COMMENT 'Item category: 時計, バッグ, ジュエリー, 靴, その他',
+    brand VARCHAR(100) NOT NULL COMMENT 'Brand name',
+    purchase_price INT NOT NULL DEFAULT 0 COMMENT 'Purchase price in yen',
+    purchase_date DATE NOT NULL COMMENT 'Purchase date in YYYY-MM-DD format',
+    item_condition VARCHAR(1) NOT NULL DEFAULT '' COMMENT 'Condition grade: N, S, A, B, C (empty if ungraded)',
+    serial_number VARCHAR(100) NULL DEFAULT NULL COMMENT 'Serial number (NULL if not registered)',
+    attributes JSON NULL DEFAULT NULL COMMENT 'Category-specific custom attributes',
+    location_id BIGINT NULL DEFAULT NULL COMMENT 'Storage location',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP COMMENT 'Record creation timestamp',
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT 'Record update timestamp',
+    deleted_at TIMESTAMP NULL DEFAULT NULL COMMENT 'Soft delete timestamp (set when merged into another item)',
+    
+    INDEX idx_category (category),
+    INDEX idx_brand (brand),
+    INDEX idx_purchase_date (purchase_date),
+    INDEX idx_item_condition (item_condition),
+    INDEX idx_deleted_at (deleted_at),
+    INDEX idx_created_at (created_at),
+    UNIQUE KEY uk_serial_number (serial_number),
+    CONSTRAINT fk_items_location_id FOREIGN KEY (location_id) REFERENCES locations(id) ON DELETE SET NULL
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='Table for managing valuable items and collections';
+
+-- Create loans table for tracking items lent to friends and exhibitions
+CREATE TABLE IF NOT EXISTS loans (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    item_id BIGINT NOT NULL COMMENT 'Lent item',
+    borrower VARCHAR(100) NOT NULL COMMENT 'Borrower name',
+    due_date DATE NOT NULL COMMENT 'Due date in YYYY-MM-DD format',
+    loaned_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP COMMENT 'Loan start timestamp',
+    returned_at TIMESTAMP NULL DEFAULT NULL COMMENT 'Return timestamp (NULL while on loan)',
+
+    INDEX idx_item_id_returned_at (item_id, returned_at),
+    INDEX idx_due_date (due_date),
+    CONSTRAINT fk_loans_item_id FOREIGN KEY (item_id) REFERENCES items(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='Table for tracking item loans';
+
+-- Create item_location_history table for recording location changes
+CREATE TABLE IF NOT EXISTS item_location_history (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    item_id BIGINT NOT NULL COMMENT 'Moved item',
+    from_location_id BIGINT NULL DEFAULT NULL COMMENT 'Previous location (NULL if unassigned)',
+    to_location_id BIGINT NULL DEFAULT NULL COMMENT 'New location (NULL if unassigned)',
+    moved_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP COMMENT 'Move timestamp',
+
+    INDEX idx_item_id_moved_at (item_id, moved_at),
+    CONSTRAINT fk_item_location_history_item_id FOREIGN KEY (item_id) REFERENCES items(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='Table for item location change history';
+
+-- Create item_templates table for reusable item defaults
+CREATE TABLE IF NOT EXISTS item_templates (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    name VARCHAR(100) NOT NULL COMMENT 'Template name (also used as the default item name)',
+    category VARCHAR(50) NOT NULL COMMENT 'Default category',
+    brand VARCHAR(100) NOT NULL COMMENT 'Default brand name',
+    purchase_price INT NOT NULL DEFAULT 0 COMMENT 'Default purchase price in yen',
+    item_condition VARCHAR(1) NOT NULL DEFAULT '' COMMENT 'Default condition grade',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP COMMENT 'Record creation timestamp',
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT 'Record update timestamp'
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='Table for item templates';
+
+-- Create webhooks table for item event subscriptions
+CREATE TABLE IF NOT EXISTS webhooks (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    url VARCHAR(2048) NOT NULL COMMENT 'Endpoint receiving event POSTs',
+    secret VARCHAR(255) NOT NULL COMMENT 'HMAC-SHA256 signing key',
+    event_types JSON NOT NULL COMMENT 'Subscribed event types (e.g. ["item.created"])',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP COMMENT 'Record creation timestamp'
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='Table for webhook endpoints';
+
+-- Create webhook_deliveries table for the delivery log
+CREATE TABLE IF NOT EXISTS webhook_deliveries (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    webhook_id BIGINT NOT NULL COMMENT 'Target webhook',
+    event_type VARCHAR(50) NOT NULL COMMENT 'Delivered event type',
+    payload JSON NOT NULL COMMENT 'Request body sent to the endpoint',
+    attempt INT NOT NULL COMMENT 'Attempt number starting from 1',
+    status_code INT NOT NULL DEFAULT 0 COMMENT 'HTTP status code (0 if no response)',
+    success BOOLEAN NOT NULL DEFAULT FALSE COMMENT 'Whether the endpoint returned 2xx',
+    error_message VARCHAR(1000) NOT NULL DEFAULT '' COMMENT 'Failure reason',
+    delivered_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP COMMENT 'Attempt timestamp',
+
+    INDEX idx_webhook_id_delivered_at (webhook_id, delivered_at),
+    CONSTRAINT fk_webhook_deliveries_webhook_id FOREIGN KEY (webhook_id) REFERENCES webhooks(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='Table for webhook delivery attempts';
+
+-- Create item_summary table kept up to date in the same transaction as item changes
+CREATE TABLE IF NOT EXISTS item_summary (
+    dimension VARCHAR(20) NOT NULL COMMENT 'Aggregation axis (category / condition)',
+    dimension_value VARCHAR(50) NOT NULL COMMENT 'Category name or condition grade',
+    item_count INT NOT NULL DEFAULT 0 COMMENT 'Number of active items',
+
+    PRIMARY KEY (dimension, dimension_value)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='Table for materialized item counts';
+
+-- Create outbox table for events recorded in the same transaction as item changes
+CREATE TABLE IF NOT EXISTS outbox (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    event_type VARCHAR(50) NOT NULL COMMENT 'Domain event name (e.g. item.created)',
+    aggregate_id BIGINT NOT NULL COMMENT 'ID of the changed item',
+    payload JSON NOT NULL COMMENT 'Item state carried by the event',
+    attempts INT NOT NULL DEFAULT 0 COMMENT 'Number of relay attempts',
+    last_error VARCHAR(1000) NOT NULL DEFAULT '' COMMENT 'Reason of the last failed attempt',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP COMMENT 'Event occurrence timestamp',
+    published_at TIMESTAMP NULL DEFAULT NULL COMMENT 'Relay timestamp (NULL if pending)',
+
+    INDEX idx_published_at_id (published_at, id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='Table for the transactional outbox';
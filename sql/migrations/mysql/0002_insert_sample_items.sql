@@ -0,0 +1,16 @@
+-- Insert sample data for testing (skipped when items already exist)
+INSERT INTO items (name, category, brand, purchase_price, purchase_date, serial_number)
+SELECT * FROM (
+    SELECT 'ロレックス デイトナ' AS name, '時計' AS category, 'ROLEX' AS brand, 1500000 AS purchase_price, '2023-01-15' AS purchase_date, 'D123456' AS serial_number
+    UNION ALL SELECT 'エルメス バーキン', 'バッグ', 'HERMÈS', 2000000, '2023-02-20', NULL
+    UNION ALL SELECT 'ティファニー ネックレス', 'ジュエリー', 'Tiffany & Co.', 300000, '2023-03-10', NULL
+    UNION ALL SELECT 'ルブタン パンプス', '靴', 'Christian Louboutin', 150000, '2023-04-05', NULL
+    UNION ALL SELECT 'アップルウォッチ', 'その他', 'Apple', 50000, '2023-05-12', NULL
+) AS sample
+WHERE NOT EXISTS (SELECT 1 FROM items);
+
+-- Build item_summary from the current items
+INSERT IGNORE INTO item_summary (dimension, dimension_value, item_count)
+SELECT 'category', category, COUNT(*) FROM items WHERE deleted_at IS NULL GROUP BY category;
+INSERT IGNORE INTO item_summary (dimension, dimension_value, item_count)
+SELECT 'condition', item_condition, COUNT(*) FROM items WHERE item_condition <> '' AND deleted_at IS NULL GROUP BY item_condition;
@@ -0,0 +1,6 @@
+-- Create feature_flags table for features toggled at runtime by administrators
+CREATE TABLE IF NOT EXISTS feature_flags (
+    name VARCHAR(50) NOT NULL PRIMARY KEY COMMENT 'Feature name (e.g. webhooks)',
+    enabled BOOLEAN NOT NULL COMMENT 'Whether the feature is enabled',
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT 'Last toggle timestamp'
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='Table for feature flags overriding the configuration';
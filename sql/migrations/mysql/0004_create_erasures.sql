@@ -0,0 +1,9 @@
+-- Create erasures table for requests to erase all data after a grace period
+CREATE TABLE IF NOT EXISTS erasures (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    status VARCHAR(20) NOT NULL COMMENT 'pending, cancelled or completed',
+    requested_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP COMMENT 'Request timestamp',
+    scheduled_at TIMESTAMP NOT NULL COMMENT 'When the data is erased unless cancelled',
+    finished_at TIMESTAMP NULL COMMENT 'Cancellation or completion timestamp',
+    INDEX idx_erasures_status (status)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='Table for requests to erase all data';
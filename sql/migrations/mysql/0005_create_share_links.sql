@@ -0,0 +1,14 @@
+-- Create share_links table for public read-only links to individual items
+CREATE TABLE IF NOT EXISTS share_links (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    item_id BIGINT NOT NULL COMMENT 'Shared item',
+    token_hash CHAR(64) NOT NULL COMMENT 'SHA-256 of the token in the public URL',
+    fields JSON NOT NULL COMMENT 'Item fields shown on the public page (e.g. ["name","brand"])',
+    expires_at TIMESTAMP NOT NULL COMMENT 'Expiration timestamp',
+    revoked_at TIMESTAMP NULL COMMENT 'Revocation timestamp',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP COMMENT 'Record creation timestamp',
+
+    UNIQUE KEY uk_token_hash (token_hash),
+    INDEX idx_item_id (item_id),
+    CONSTRAINT fk_share_links_item_id FOREIGN KEY (item_id) REFERENCES items(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='Table for public links to items';
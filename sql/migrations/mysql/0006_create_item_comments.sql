@@ -0,0 +1,11 @@
+-- Create item_comments table for notes on items (servicing history, stories, reminders)
+CREATE TABLE IF NOT EXISTS item_comments (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    item_id BIGINT NOT NULL COMMENT 'Commented item',
+    author VARCHAR(100) NOT NULL COMMENT 'Name of the person who wrote the comment',
+    body TEXT NOT NULL COMMENT 'Comment text',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP COMMENT 'Record creation timestamp',
+
+    INDEX idx_item_id (item_id),
+    CONSTRAINT fk_item_comments_item_id FOREIGN KEY (item_id) REFERENCES items(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='Table for comments on items';
@@ -0,0 +1,13 @@
+-- Create item_relations table for links between items (box and papers of a watch, accessories, sets)
+CREATE TABLE IF NOT EXISTS item_relations (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    item_id BIGINT NOT NULL COMMENT 'Item the relation starts from (e.g. the box)',
+    related_item_id BIGINT NOT NULL COMMENT 'Item the relation points to (e.g. the watch)',
+    relation_type VARCHAR(20) NOT NULL COMMENT 'belongs_to, accessory_of or set',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP COMMENT 'Record creation timestamp',
+
+    INDEX idx_item_id (item_id),
+    INDEX idx_related_item_id (related_item_id),
+    CONSTRAINT fk_item_relations_item_id FOREIGN KEY (item_id) REFERENCES items(id) ON DELETE CASCADE,
+    CONSTRAINT fk_item_relations_related_item_id FOREIGN KEY (related_item_id) REFERENCES items(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='Table for relations between items';
@@ -0,0 +1,4 @@
+-- Add favorite flag to items for pinning the pieces checked most often
+ALTER TABLE items
+    ADD COLUMN favorite BOOLEAN NOT NULL DEFAULT FALSE COMMENT 'Pinned as a favorite' AFTER location_id,
+    ADD INDEX idx_favorite (favorite);
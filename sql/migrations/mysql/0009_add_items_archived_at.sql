@@ -0,0 +1,4 @@
+-- Add archived_at to items for pieces kept out of listings and summaries without deleting them
+ALTER TABLE items
+    ADD COLUMN archived_at TIMESTAMP NULL DEFAULT NULL COMMENT 'Archive timestamp (given away, long-term storage)' AFTER favorite,
+    ADD INDEX idx_archived_at (archived_at);
@@ -0,0 +1,7 @@
+-- Create audit_log table for bulk changes that are recorded once instead of as an event per item
+CREATE TABLE IF NOT EXISTS audit_log (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    action VARCHAR(50) NOT NULL COMMENT 'What was done (e.g. category.reassigned)',
+    detail TEXT NOT NULL COMMENT 'Parameters and result of the change (JSON)',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP COMMENT 'Record creation timestamp'
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='Table for the audit log of bulk changes';
@@ -0,0 +1,14 @@
+-- Add current_value to items and item_valuations for the history of changes to it
+ALTER TABLE items
+    ADD COLUMN current_value INT NULL DEFAULT NULL COMMENT 'Current estimated value in yen (NULL until valued)' AFTER purchase_price;
+
+CREATE TABLE IF NOT EXISTS item_valuations (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    item_id BIGINT NOT NULL COMMENT 'Valued item',
+    previous_value INT NOT NULL COMMENT 'Value before the change (the purchase price if the item had not been valued)',
+    value INT NOT NULL COMMENT 'Value after the change',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP COMMENT 'Record creation timestamp',
+
+    INDEX idx_item_id (item_id),
+    CONSTRAINT fk_item_valuations_item_id FOREIGN KEY (item_id) REFERENCES items(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='Table for the valuation history of items';
@@ -0,0 +1,15 @@
+-- Create jobs table for the persistent queue of background jobs
+CREATE TABLE IF NOT EXISTS jobs (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    type VARCHAR(50) NOT NULL COMMENT 'Job type that selects the handler (e.g. webhook.delivery)',
+    payload JSON NOT NULL COMMENT 'JSON passed to the handler',
+    status VARCHAR(20) NOT NULL COMMENT 'pending, running, succeeded or failed',
+    attempts INT NOT NULL DEFAULT 0 COMMENT 'Number of times the job has been started',
+    max_attempts INT NOT NULL COMMENT 'The job is marked as failed after this many attempts',
+    last_error VARCHAR(1000) NOT NULL DEFAULT '' COMMENT 'Reason of the last failed attempt',
+    run_at TIMESTAMP NOT NULL COMMENT 'When the job runs next',
+    locked_until TIMESTAMP NULL COMMENT 'Another worker may take over a running job after this time',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP COMMENT 'Record creation timestamp',
+    finished_at TIMESTAMP NULL COMMENT 'When the job succeeded or finally failed',
+    INDEX idx_jobs_status_run_at (status, run_at)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='Table for the queue of background jobs';
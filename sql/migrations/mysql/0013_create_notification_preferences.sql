@@ -0,0 +1,8 @@
+-- Create notification_preferences table for the channels and kinds of notifications turned on or off
+CREATE TABLE IF NOT EXISTS notification_preferences (
+    type VARCHAR(20) NOT NULL COMMENT 'channel or event',
+    name VARCHAR(50) NOT NULL COMMENT 'Channel (e.g. email) or kind of notification (e.g. loans_overdue)',
+    enabled BOOLEAN NOT NULL COMMENT 'Whether notifications are sent',
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT 'Last update timestamp',
+    PRIMARY KEY (type, name)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='Table for notification preferences';
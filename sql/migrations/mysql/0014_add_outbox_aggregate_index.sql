@@ -0,0 +1,2 @@
+-- Add an index on outbox to find the previous event of an item for the activity feed
+ALTER TABLE outbox ADD INDEX idx_outbox_aggregate_id_id (aggregate_id, id);
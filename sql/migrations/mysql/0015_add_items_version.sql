@@ -0,0 +1,3 @@
+-- Add version to items for optimistic locking (incremented on every update)
+ALTER TABLE items
+    ADD COLUMN version INT NOT NULL DEFAULT 1 COMMENT 'Incremented on every update to detect concurrent changes' AFTER updated_at;
@@ -0,0 +1,16 @@
+-- Create item_sales for records of sold items (the tax report)
+CREATE TABLE IF NOT EXISTS item_sales (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    item_id BIGINT NOT NULL COMMENT 'Sold item',
+    item_name VARCHAR(100) NOT NULL COMMENT 'Item name when it was sold',
+    purchase_date DATE NOT NULL COMMENT 'Purchase date when it was sold',
+    purchase_price INT NOT NULL COMMENT 'Purchase price (cost) in yen when it was sold',
+    sold_on DATE NOT NULL COMMENT 'Sale date in YYYY-MM-DD format',
+    sale_price INT NOT NULL COMMENT 'Sale price in yen',
+    fees INT NOT NULL DEFAULT 0 COMMENT 'Fees and shipping paid for the sale in yen',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP COMMENT 'Record creation timestamp',
+
+    INDEX idx_item_id (item_id),
+    INDEX idx_sold_on (sold_on),
+    CONSTRAINT fk_item_sales_item_id FOREIGN KEY (item_id) REFERENCES items(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='Table for records of sold items';
@@ -0,0 +1,11 @@
+-- Create item_insurances for the insurance policy covering each item (the insurance schedule)
+CREATE TABLE IF NOT EXISTS item_insurances (
+    item_id BIGINT PRIMARY KEY COMMENT 'Covered item',
+    insurer VARCHAR(100) NOT NULL COMMENT 'Insurance company',
+    policy_number VARCHAR(50) NOT NULL COMMENT 'Policy number',
+    photos JSON NOT NULL COMMENT 'References (URLs or file names) to photos of the item',
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP COMMENT 'Record update timestamp',
+
+    INDEX idx_policy (insurer, policy_number),
+    CONSTRAINT fk_item_insurances_item_id FOREIGN KEY (item_id) REFERENCES items(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='Table for the insurance policy covering each item';
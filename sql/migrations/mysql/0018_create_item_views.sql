@@ -0,0 +1,10 @@
+-- Create item_views for the items each viewer has recently looked at (the recently viewed list)
+CREATE TABLE IF NOT EXISTS item_views (
+    viewer VARCHAR(100) NOT NULL COMMENT 'Authenticated user, or empty when the request has none',
+    item_id BIGINT NOT NULL COMMENT 'Viewed item',
+    viewed_at TIMESTAMP NOT NULL COMMENT 'Last time the viewer opened the item',
+
+    PRIMARY KEY (viewer, item_id),
+    INDEX idx_viewer_viewed_at (viewer, viewed_at),
+    CONSTRAINT fk_item_views_item_id FOREIGN KEY (item_id) REFERENCES items(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='Table for the items each viewer has recently looked at';
@@ -0,0 +1,6 @@
+-- Create quota_locks so that checking a quota and creating the row it limits run one transaction at a time
+CREATE TABLE IF NOT EXISTS quota_locks (
+    name VARCHAR(50) NOT NULL PRIMARY KEY COMMENT 'Quota the row serializes (e.g. items)'
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='Table for the rows locked while a quota is checked';
+
+INSERT IGNORE INTO quota_locks (name) VALUES ('items');
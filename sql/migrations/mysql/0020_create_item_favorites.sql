@@ -0,0 +1,20 @@
+-- Move favorites from items.favorite to item_favorites so that each viewer has their own and toggling one does not update the item
+CREATE TABLE IF NOT EXISTS item_favorites (
+    viewer VARCHAR(100) NOT NULL COMMENT 'Authenticated user, or empty when the request has none',
+    item_id BIGINT NOT NULL COMMENT 'Favorite item',
+    created_at TIMESTAMP NOT NULL COMMENT 'When the viewer marked the item',
+
+    PRIMARY KEY (viewer, item_id),
+    INDEX idx_item_id (item_id),
+    CONSTRAINT fk_item_favorites_item_id FOREIGN KEY (item_id) REFERENCES items(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='Table for the items each viewer has marked as a favorite';
+
+-- The old flag was shared by every request, so keep it for both viewers (no token and the admin token)
+INSERT IGNORE INTO item_favorites (viewer, item_id, created_at)
+SELECT viewers.viewer, items.id, items.updated_at
+FROM items CROSS JOIN (SELECT '' AS viewer UNION ALL SELECT 'admin') viewers
+WHERE items.favorite = TRUE;
+
+ALTER TABLE items
+    DROP INDEX idx_favorite,
+    DROP COLUMN favorite;
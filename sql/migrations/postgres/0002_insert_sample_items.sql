@@ -0,0 +1,18 @@
+-- Insert sample data for testing (skipped when items already exist)
+INSERT INTO items (name, category, brand, purchase_price, purchase_date, serial_number)
+SELECT * FROM (VALUES
+    ('ロレックス デイトナ', '時計', 'ROLEX', 1500000, DATE '2023-01-15', 'D123456'),
+    ('エルメス バーキン', 'バッグ', 'HERMÈS', 2000000, DATE '2023-02-20', NULL),
+    ('ティファニー ネックレス', 'ジュエリー', 'Tiffany & Co.', 300000, DATE '2023-03-10', NULL),
+    ('ルブタン パンプス', '靴', 'Christian Louboutin', 150000, DATE '2023-04-05', NULL),
+    ('アップルウォッチ', 'その他', 'Apple', 50000, DATE '2023-05-12', NULL)
+) AS sample (name, category, brand, purchase_price, purchase_date, serial_number)
+WHERE NOT EXISTS (SELECT 1 FROM items);
+
+-- Build item_summary from the current items
+INSERT INTO item_summary (dimension, dimension_value, item_count)
+SELECT 'category', category, COUNT(*) FROM items WHERE deleted_at IS NULL GROUP BY category
+ON CONFLICT (dimension, dimension_value) DO NOTHING;
+INSERT INTO item_summary (dimension, dimension_value, item_count)
+SELECT 'condition', item_condition, COUNT(*) FROM items WHERE item_condition <> '' AND deleted_at IS NULL GROUP BY item_condition
+ON CONFLICT (dimension, dimension_value) DO NOTHING;
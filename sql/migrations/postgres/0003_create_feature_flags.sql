@@ -0,0 +1,6 @@
+-- Create feature_flags table for features toggled at runtime by administrators
+CREATE TABLE IF NOT EXISTS feature_flags (
+    name VARCHAR(50) PRIMARY KEY,
+    enabled BOOLEAN NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
@@ -0,0 +1,10 @@
+-- Create erasures table for requests to erase all data after a grace period
+CREATE TABLE IF NOT EXISTS erasures (
+    id BIGSERIAL PRIMARY KEY,
+    status VARCHAR(20) NOT NULL,
+    requested_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    scheduled_at TIMESTAMPTZ NOT NULL,
+    finished_at TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS idx_erasures_status ON erasures (status);
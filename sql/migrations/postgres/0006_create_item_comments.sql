@@ -0,0 +1,10 @@
+-- Create item_comments table for notes on items (servicing history, stories, reminders)
+CREATE TABLE IF NOT EXISTS item_comments (
+    id BIGSERIAL PRIMARY KEY,
+    item_id BIGINT NOT NULL REFERENCES items(id) ON DELETE CASCADE,
+    author VARCHAR(100) NOT NULL,
+    body TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_item_comments_item_id ON item_comments (item_id);
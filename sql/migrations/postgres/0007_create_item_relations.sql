@@ -0,0 +1,11 @@
+-- Create item_relations table for links between items (box and papers of a watch, accessories, sets)
+CREATE TABLE IF NOT EXISTS item_relations (
+    id BIGSERIAL PRIMARY KEY,
+    item_id BIGINT NOT NULL REFERENCES items(id) ON DELETE CASCADE,
+    related_item_id BIGINT NOT NULL REFERENCES items(id) ON DELETE CASCADE,
+    relation_type VARCHAR(20) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_item_relations_item_id ON item_relations (item_id);
+CREATE INDEX IF NOT EXISTS idx_item_relations_related_item_id ON item_relations (related_item_id);
@@ -0,0 +1,4 @@
+-- Add favorite flag to items for pinning the pieces checked most often
+ALTER TABLE items ADD COLUMN IF NOT EXISTS favorite BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE INDEX IF NOT EXISTS idx_items_favorite ON items (favorite);
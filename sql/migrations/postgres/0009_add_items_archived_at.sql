@@ -0,0 +1,4 @@
+-- Add archived_at to items for pieces kept out of listings and summaries without deleting them
+ALTER TABLE items ADD COLUMN IF NOT EXISTS archived_at TIMESTAMPTZ NULL DEFAULT NULL;
+
+CREATE INDEX IF NOT EXISTS idx_items_archived_at ON items (archived_at);
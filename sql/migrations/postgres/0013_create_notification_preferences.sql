@@ -0,0 +1,8 @@
+-- Create notification_preferences table for the channels and kinds of notifications turned on or off
+CREATE TABLE IF NOT EXISTS notification_preferences (
+    type VARCHAR(20) NOT NULL,
+    name VARCHAR(50) NOT NULL,
+    enabled BOOLEAN NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (type, name)
+);
@@ -0,0 +1,2 @@
+-- Add an index on outbox to find the previous event of an item for the activity feed
+CREATE INDEX IF NOT EXISTS idx_outbox_aggregate_id_id ON outbox (aggregate_id, id);
@@ -0,0 +1,2 @@
+-- Add version to items for optimistic locking (incremented on every update)
+ALTER TABLE items ADD COLUMN IF NOT EXISTS version INTEGER NOT NULL DEFAULT 1;
@@ -0,0 +1,10 @@
+-- Create item_insurances for the insurance policy covering each item (the insurance schedule)
+CREATE TABLE IF NOT EXISTS item_insurances (
+    item_id BIGINT PRIMARY KEY REFERENCES items(id) ON DELETE CASCADE,
+    insurer VARCHAR(100) NOT NULL,
+    policy_number VARCHAR(50) NOT NULL,
+    photos JSONB NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_item_insurances_policy ON item_insurances (insurer, policy_number);
@@ -0,0 +1,6 @@
+-- Create quota_locks so that checking a quota and creating the row it limits run one transaction at a time
+CREATE TABLE IF NOT EXISTS quota_locks (
+    name VARCHAR(50) PRIMARY KEY
+);
+
+INSERT INTO quota_locks (name) VALUES ('items') ON CONFLICT DO NOTHING;
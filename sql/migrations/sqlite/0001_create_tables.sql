@@ -0,0 +1,109 @@
+-- Create locations table for storage places (safe, bank vault, display case, ...)
+CREATE TABLE IF NOT EXISTS locations (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    name VARCHAR(100) NOT NULL,
+    description VARCHAR(255) NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Create items table for managing valuable items and collections
+CREATE TABLE IF NOT EXISTS items (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    name VARCHAR(100) NOT NULL,
+    category VARCHAR(50) NOT NULL,
+    brand VARCHAR(100) NOT NULL,
+    purchase_price INTEGER NOT NULL DEFAULT 0,
+    purchase_date DATE NOT NULL,
+    item_condition VARCHAR(1) NOT NULL DEFAULT '',
+    serial_number VARCHAR(100) NULL DEFAULT NULL UNIQUE,
+    attributes TEXT NULL DEFAULT NULL,
+    location_id INTEGER NULL DEFAULT NULL REFERENCES locations(id) ON DELETE SET NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP NULL DEFAULT NULL
+);
+CREATE INDEX IF NOT EXISTS idx_items_category ON items (category);
+CREATE INDEX IF NOT EXISTS idx_items_brand ON items (brand);
+CREATE INDEX IF NOT EXISTS idx_items_item_condition ON items (item_condition);
+CREATE INDEX IF NOT EXISTS idx_items_deleted_at ON items (deleted_at);
+CREATE INDEX IF NOT EXISTS idx_items_created_at ON items (created_at);
+
+-- Create loans table for tracking items lent to friends and exhibitions
+CREATE TABLE IF NOT EXISTS loans (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    item_id INTEGER NOT NULL REFERENCES items(id) ON DELETE CASCADE,
+    borrower VARCHAR(100) NOT NULL,
+    due_date DATE NOT NULL,
+    loaned_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    returned_at TIMESTAMP NULL DEFAULT NULL
+);
+CREATE INDEX IF NOT EXISTS idx_loans_item_id_returned_at ON loans (item_id, returned_at);
+CREATE INDEX IF NOT EXISTS idx_loans_due_date ON loans (due_date);
+
+-- Create item_location_history table for recording location changes
+CREATE TABLE IF NOT EXISTS item_location_history (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    item_id INTEGER NOT NULL REFERENCES items(id) ON DELETE CASCADE,
+    from_location_id INTEGER NULL DEFAULT NULL,
+    to_location_id INTEGER NULL DEFAULT NULL,
+    moved_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX IF NOT EXISTS idx_item_location_history_item_id_moved_at ON item_location_history (item_id, moved_at);
+
+-- Create item_templates table for reusable item defaults
+CREATE TABLE IF NOT EXISTS item_templates (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    name VARCHAR(100) NOT NULL,
+    category VARCHAR(50) NOT NULL,
+    brand VARCHAR(100) NOT NULL,
+    purchase_price INTEGER NOT NULL DEFAULT 0,
+    item_condition VARCHAR(1) NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Create webhooks table for item event subscriptions
+CREATE TABLE IF NOT EXISTS webhooks (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    url VARCHAR(2048) NOT NULL,
+    secret VARCHAR(255) NOT NULL,
+    event_types TEXT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Create webhook_deliveries table for the delivery log
+CREATE TABLE IF NOT EXISTS webhook_deliveries (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    webhook_id INTEGER NOT NULL REFERENCES webhooks(id) ON DELETE CASCADE,
+    event_type VARCHAR(50) NOT NULL,
+    payload TEXT NOT NULL,
+    attempt INTEGER NOT NULL,
+    status_code INTEGER NOT NULL DEFAULT 0,
+    success BOOLEAN NOT NULL DEFAULT FALSE,
+    error_message VARCHAR(1000) NOT NULL DEFAULT '',
+    delivered_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_webhook_id_delivered_at ON webhook_deliveries (webhook_id, delivered_at);
+
+-- Create item_summary table kept up to date in the same transaction as item changes
+CREATE TABLE IF NOT EXISTS item_summary (
+    dimension VARCHAR(20) NOT NULL,
+    dimension_value VARCHAR(50) NOT NULL,
+    item_count INTEGER NOT NULL DEFAULT 0,
+
+    PRIMARY KEY (dimension, dimension_value)
+);
+
+-- Create outbox table for events recorded in the same transaction as item changes
+CREATE TABLE IF NOT EXISTS outbox (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    event_type VARCHAR(50) NOT NULL,
+    aggregate_id INTEGER NOT NULL,
+    payload TEXT NOT NULL,
+    attempts INTEGER NOT NULL DEFAULT 0,
+    last_error VARCHAR(1000) NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    published_at TIMESTAMP NULL DEFAULT NULL
+);
+CREATE INDEX IF NOT EXISTS idx_outbox_published_at_id ON outbox (published_at, id);
@@ -0,0 +1,4 @@
+-- Add favorite flag to items for pinning the pieces checked most often
+ALTER TABLE items ADD COLUMN favorite BOOLEAN NOT NULL DEFAULT 0;
+
+CREATE INDEX IF NOT EXISTS idx_items_favorite ON items (favorite);
@@ -0,0 +1,7 @@
+-- Create audit_log table for bulk changes that are recorded once instead of as an event per item
+CREATE TABLE IF NOT EXISTS audit_log (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    action VARCHAR(50) NOT NULL,
+    detail TEXT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
@@ -0,0 +1,12 @@
+-- Add current_value to items and item_valuations for the history of changes to it
+ALTER TABLE items ADD COLUMN current_value INTEGER NULL DEFAULT NULL;
+
+CREATE TABLE IF NOT EXISTS item_valuations (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    item_id INTEGER NOT NULL REFERENCES items(id) ON DELETE CASCADE,
+    previous_value INTEGER NOT NULL,
+    value INTEGER NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_item_valuations_item_id ON item_valuations (item_id);
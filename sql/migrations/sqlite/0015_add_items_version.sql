@@ -0,0 +1,2 @@
+-- Add version to items for optimistic locking (incremented on every update)
+ALTER TABLE items ADD COLUMN version INTEGER NOT NULL DEFAULT 1;
@@ -0,0 +1,15 @@
+-- Create item_sales for records of sold items (the tax report)
+CREATE TABLE IF NOT EXISTS item_sales (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    item_id INTEGER NOT NULL REFERENCES items(id) ON DELETE CASCADE,
+    item_name VARCHAR(100) NOT NULL,
+    purchase_date DATE NOT NULL,
+    purchase_price INTEGER NOT NULL,
+    sold_on DATE NOT NULL,
+    sale_price INTEGER NOT NULL,
+    fees INTEGER NOT NULL DEFAULT 0,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_item_sales_item_id ON item_sales (item_id);
+CREATE INDEX IF NOT EXISTS idx_item_sales_sold_on ON item_sales (sold_on);
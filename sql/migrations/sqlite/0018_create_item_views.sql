@@ -0,0 +1,9 @@
+-- Create item_views for the items each viewer has recently looked at (the recently viewed list)
+CREATE TABLE IF NOT EXISTS item_views (
+    viewer VARCHAR(100) NOT NULL,
+    item_id INTEGER NOT NULL REFERENCES items(id) ON DELETE CASCADE,
+    viewed_at TIMESTAMP NOT NULL,
+    PRIMARY KEY (viewer, item_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_item_views_viewer_viewed_at ON item_views (viewer, viewed_at);
@@ -0,0 +1,19 @@
+-- Move favorites from items.favorite to item_favorites so that each viewer has their own and toggling one does not update the item
+CREATE TABLE IF NOT EXISTS item_favorites (
+    viewer VARCHAR(100) NOT NULL,
+    item_id INTEGER NOT NULL REFERENCES items(id) ON DELETE CASCADE,
+    created_at TIMESTAMP NOT NULL,
+    PRIMARY KEY (viewer, item_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_item_favorites_item_id ON item_favorites (item_id);
+
+-- The old flag was shared by every request, so keep it for both viewers (no token and the admin token)
+INSERT OR IGNORE INTO item_favorites (viewer, item_id, created_at)
+SELECT viewers.viewer, items.id, items.updated_at
+FROM items CROSS JOIN (SELECT '' AS viewer UNION ALL SELECT 'admin') AS viewers
+WHERE items.favorite = 1;
+
+DROP INDEX IF EXISTS idx_items_favorite;
+
+ALTER TABLE items DROP COLUMN favorite;
@@ -0,0 +1,9 @@
+// Package sql はバイナリに埋め込むデータベースのマイグレーションを提供する
+package sql
+
+import "embed"
+
+// migrations/<方言>/<バージョン>_<名前>.sql（方言は mysql / postgres / sqlite）
+//
+//go:embed migrations
+var Migrations embed.FS